@@ -0,0 +1,186 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package alarms
+
+import (
+	"context"
+	"time"
+
+	"github.com/absmach/supermq/consumers"
+)
+
+// Alarm statuses.
+const (
+	StatusActive       = "active"
+	StatusAcknowledged = "acknowledged"
+	StatusResolved     = "resolved"
+)
+
+// Alarm severities.
+const (
+	SeverityLow      = "low"
+	SeverityMedium   = "medium"
+	SeverityHigh     = "high"
+	SeverityCritical = "critical"
+)
+
+// Rule raises alarms for messages crossing the rule threshold.
+type Rule struct {
+	ID        string  `json:"id"`
+	UserID    string  `json:"user_id"`
+	Name      string  `json:"name"`
+	ClientID  string  `json:"client_id,omitempty"`
+	ChannelID string  `json:"channel_id,omitempty"`
+	Subtopic  string  `json:"subtopic,omitempty"`
+	Field     string  `json:"field"`
+	Threshold float64 `json:"threshold"`
+	Severity  string  `json:"severity"`
+}
+
+// Alarm is a raised alarm. Repeated triggers of the same rule for the
+// same client and channel update the occurrence count of the open
+// alarm instead of raising a new one.
+type Alarm struct {
+	ID             string     `json:"id"`
+	RuleID         string     `json:"rule_id"`
+	ClientID       string     `json:"client_id"`
+	ChannelID      string     `json:"channel_id"`
+	Subtopic       string     `json:"subtopic,omitempty"`
+	Severity       string     `json:"severity"`
+	Status         string     `json:"status"`
+	Message        string     `json:"message"`
+	Count          uint64     `json:"count"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+	AcknowledgedAt *time.Time `json:"acknowledged_at,omitempty"`
+	ResolvedAt     *time.Time `json:"resolved_at,omitempty"`
+}
+
+// PageMetadata contains the alarms listing filters.
+type PageMetadata struct {
+	Offset    uint64 `json:"offset"`
+	Limit     uint64 `json:"limit"`
+	Status    string `json:"status,omitempty"`
+	Severity  string `json:"severity,omitempty"`
+	ClientID  string `json:"client_id,omitempty"`
+	ChannelID string `json:"channel_id,omitempty"`
+	RuleID    string `json:"rule_id,omitempty"`
+}
+
+// AlarmsPage is a page of alarms with the total count.
+type AlarmsPage struct {
+	PageMetadata
+	Total  uint64  `json:"total"`
+	Alarms []Alarm `json:"alarms"`
+}
+
+// MaintenanceWindow suppresses alarms for a client or channel between
+// its start and end times. Events are published as the window starts
+// and ends; the notified flags record which boundaries have been
+// announced so that restarts do not repeat them.
+type MaintenanceWindow struct {
+	ID              string     `json:"id"`
+	UserID          string     `json:"user_id"`
+	ClientID        string     `json:"client_id,omitempty"`
+	ChannelID       string     `json:"channel_id,omitempty"`
+	StartTime       time.Time  `json:"start_time"`
+	EndTime         time.Time  `json:"end_time"`
+	CreatedAt       time.Time  `json:"created_at"`
+	EndedAt         *time.Time `json:"ended_at,omitempty"`
+	StartedNotified bool       `json:"-"`
+	EndedNotified   bool       `json:"-"`
+}
+
+// Repository specifies the rule, alarm and maintenance window
+// persistence API.
+type Repository interface {
+	// SaveRule persists the rule.
+	SaveRule(ctx context.Context, rule Rule) error
+
+	// RetrieveAllRules retrieves all persisted rules.
+	RetrieveAllRules(ctx context.Context) ([]Rule, error)
+
+	// RetrieveRules retrieves the rules created by the given user.
+	RetrieveRules(ctx context.Context, userID string) ([]Rule, error)
+
+	// RemoveRule removes a rule created by the given user, along with
+	// its alarms.
+	RemoveRule(ctx context.Context, userID, id string) error
+
+	// SaveAlarm persists the alarm.
+	SaveAlarm(ctx context.Context, alarm Alarm) error
+
+	// UpdateAlarm updates the persisted alarm.
+	UpdateAlarm(ctx context.Context, alarm Alarm) error
+
+	// RetrieveAlarm retrieves an alarm raised by a rule of the given
+	// user.
+	RetrieveAlarm(ctx context.Context, userID, id string) (Alarm, error)
+
+	// RetrieveOpenAlarm retrieves the unresolved alarm of the rule for
+	// the given client and channel.
+	RetrieveOpenAlarm(ctx context.Context, ruleID, clientID, channelID string) (Alarm, error)
+
+	// RetrieveAlarms retrieves a page of alarms raised by the rules of
+	// the given user, filtered by the page metadata, newest first.
+	RetrieveAlarms(ctx context.Context, userID string, pm PageMetadata) (AlarmsPage, error)
+
+	// SaveWindow persists the maintenance window.
+	SaveWindow(ctx context.Context, window MaintenanceWindow) error
+
+	// UpdateWindow updates the persisted maintenance window.
+	UpdateWindow(ctx context.Context, window MaintenanceWindow) error
+
+	// RetrieveAllWindows retrieves all persisted maintenance windows.
+	RetrieveAllWindows(ctx context.Context) ([]MaintenanceWindow, error)
+
+	// RetrieveWindows retrieves the maintenance windows scheduled by
+	// the given user, ordered by start time.
+	RetrieveWindows(ctx context.Context, userID string) ([]MaintenanceWindow, error)
+
+	// RetrieveWindow retrieves a maintenance window scheduled by the
+	// given user.
+	RetrieveWindow(ctx context.Context, userID, id string) (MaintenanceWindow, error)
+}
+
+// Service specifies the alarms service API.
+type Service interface {
+	consumers.BlockingConsumer
+
+	// CreateRule creates a new alarm rule.
+	CreateRule(ctx context.Context, rule Rule) (Rule, error)
+
+	// ListRules retrieves the rules created by the given user.
+	ListRules(ctx context.Context, userID string) ([]Rule, error)
+
+	// RemoveRule removes a rule created by the given user.
+	RemoveRule(ctx context.Context, userID, id string) error
+
+	// ListAlarms retrieves a page of alarms raised by the rules of the
+	// given user, filtered by the page metadata.
+	ListAlarms(ctx context.Context, userID string, pm PageMetadata) (AlarmsPage, error)
+
+	// Acknowledge transitions an active alarm to acknowledged.
+	Acknowledge(ctx context.Context, userID, id string) (Alarm, error)
+
+	// Resolve transitions an active or acknowledged alarm to resolved.
+	Resolve(ctx context.Context, userID, id string) (Alarm, error)
+
+	// ScheduleMaintenance schedules a maintenance window for a client
+	// or channel. Rules are not evaluated against messages from the
+	// client or channel while the window is open.
+	ScheduleMaintenance(ctx context.Context, window MaintenanceWindow) (MaintenanceWindow, error)
+
+	// ListMaintenance retrieves the maintenance windows scheduled by
+	// the given user.
+	ListMaintenance(ctx context.Context, userID string) ([]MaintenanceWindow, error)
+
+	// EndMaintenance ends a maintenance window before its end time.
+	EndMaintenance(ctx context.Context, userID, id string) (MaintenanceWindow, error)
+
+	// RunMaintenanceNotifier publishes events as maintenance windows
+	// start and end, checking at the given interval. It blocks until
+	// the context is canceled.
+	RunMaintenanceNotifier(ctx context.Context, interval time.Duration) error
+}
@@ -0,0 +1,265 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package alarms
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/absmach/supermq"
+	api "github.com/absmach/supermq/api/http"
+	apiutil "github.com/absmach/supermq/api/http/util"
+	smqauthn "github.com/absmach/supermq/pkg/authn"
+	"github.com/absmach/supermq/pkg/errors"
+	svcerr "github.com/absmach/supermq/pkg/errors/service"
+	"github.com/absmach/supermq/pkg/messaging"
+	"github.com/go-chi/chi/v5"
+)
+
+// MakeHandler returns a HTTP API handler with health check.
+func MakeHandler(svc Service, authn smqauthn.Authentication, instanceID string) http.Handler {
+	mux := chi.NewRouter()
+
+	mux.Group(func(r chi.Router) {
+		r.Use(api.AuthenticateMiddleware(authn, false))
+		r.Post("/rules", createRuleHandler(svc))
+		r.Get("/rules", listRulesHandler(svc))
+		r.Delete("/rules/{ruleID}", removeRuleHandler(svc))
+		r.Get("/alarms", listAlarmsHandler(svc))
+		r.Post("/alarms/{alarmID}/acknowledge", acknowledgeHandler(svc))
+		r.Post("/alarms/{alarmID}/resolve", resolveHandler(svc))
+		r.Post("/maintenance", scheduleMaintenanceHandler(svc))
+		r.Get("/maintenance", listMaintenanceHandler(svc))
+		r.Post("/maintenance/{windowID}/end", endMaintenanceHandler(svc))
+	})
+
+	mux.Get("/health", supermq.Health("alarms", instanceID))
+
+	return mux
+}
+
+func createRuleHandler(svc Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		session, ok := r.Context().Value(api.SessionKey).(smqauthn.Session)
+		if !ok {
+			api.EncodeError(r.Context(), svcerr.ErrAuthorization, w)
+			return
+		}
+		var rule Rule
+		if err := json.NewDecoder(r.Body).Decode(&rule); err != nil {
+			api.EncodeError(r.Context(), errors.Wrap(apiutil.ErrValidation, err), w)
+			return
+		}
+		rule.UserID = session.UserID
+
+		rule, err := svc.CreateRule(r.Context(), rule)
+		if err != nil {
+			api.EncodeError(r.Context(), err, w)
+			return
+		}
+		encodeJSON(w, http.StatusCreated, rule)
+	}
+}
+
+func listRulesHandler(svc Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		session, ok := r.Context().Value(api.SessionKey).(smqauthn.Session)
+		if !ok {
+			api.EncodeError(r.Context(), svcerr.ErrAuthorization, w)
+			return
+		}
+		rules, err := svc.ListRules(r.Context(), session.UserID)
+		if err != nil {
+			api.EncodeError(r.Context(), err, w)
+			return
+		}
+		encodeJSON(w, http.StatusOK, map[string]interface{}{"rules": rules})
+	}
+}
+
+func removeRuleHandler(svc Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		session, ok := r.Context().Value(api.SessionKey).(smqauthn.Session)
+		if !ok {
+			api.EncodeError(r.Context(), svcerr.ErrAuthorization, w)
+			return
+		}
+		if err := svc.RemoveRule(r.Context(), session.UserID, chi.URLParam(r, "ruleID")); err != nil {
+			api.EncodeError(r.Context(), err, w)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func listAlarmsHandler(svc Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		session, ok := r.Context().Value(api.SessionKey).(smqauthn.Session)
+		if !ok {
+			api.EncodeError(r.Context(), svcerr.ErrAuthorization, w)
+			return
+		}
+		pm, err := decodePageMetadata(r)
+		if err != nil {
+			api.EncodeError(r.Context(), errors.Wrap(apiutil.ErrValidation, err), w)
+			return
+		}
+		page, err := svc.ListAlarms(r.Context(), session.UserID, pm)
+		if err != nil {
+			api.EncodeError(r.Context(), err, w)
+			return
+		}
+		encodeJSON(w, http.StatusOK, page)
+	}
+}
+
+func acknowledgeHandler(svc Service) http.HandlerFunc {
+	return transitionHandler(func(ctx context.Context, svc Service, userID, id string) (Alarm, error) {
+		return svc.Acknowledge(ctx, userID, id)
+	}, svc)
+}
+
+func resolveHandler(svc Service) http.HandlerFunc {
+	return transitionHandler(func(ctx context.Context, svc Service, userID, id string) (Alarm, error) {
+		return svc.Resolve(ctx, userID, id)
+	}, svc)
+}
+
+func transitionHandler(transition func(ctx context.Context, svc Service, userID, id string) (Alarm, error), svc Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		session, ok := r.Context().Value(api.SessionKey).(smqauthn.Session)
+		if !ok {
+			api.EncodeError(r.Context(), svcerr.ErrAuthorization, w)
+			return
+		}
+		alarm, err := transition(r.Context(), svc, session.UserID, chi.URLParam(r, "alarmID"))
+		if err != nil {
+			api.EncodeError(r.Context(), err, w)
+			return
+		}
+		encodeJSON(w, http.StatusOK, alarm)
+	}
+}
+
+func scheduleMaintenanceHandler(svc Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		session, ok := r.Context().Value(api.SessionKey).(smqauthn.Session)
+		if !ok {
+			api.EncodeError(r.Context(), svcerr.ErrAuthorization, w)
+			return
+		}
+		var window MaintenanceWindow
+		if err := json.NewDecoder(r.Body).Decode(&window); err != nil {
+			api.EncodeError(r.Context(), errors.Wrap(apiutil.ErrValidation, err), w)
+			return
+		}
+		window.UserID = session.UserID
+
+		window, err := svc.ScheduleMaintenance(r.Context(), window)
+		if err != nil {
+			api.EncodeError(r.Context(), err, w)
+			return
+		}
+		encodeJSON(w, http.StatusCreated, window)
+	}
+}
+
+func listMaintenanceHandler(svc Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		session, ok := r.Context().Value(api.SessionKey).(smqauthn.Session)
+		if !ok {
+			api.EncodeError(r.Context(), svcerr.ErrAuthorization, w)
+			return
+		}
+		windows, err := svc.ListMaintenance(r.Context(), session.UserID)
+		if err != nil {
+			api.EncodeError(r.Context(), err, w)
+			return
+		}
+		encodeJSON(w, http.StatusOK, map[string]interface{}{"maintenance": windows})
+	}
+}
+
+func endMaintenanceHandler(svc Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		session, ok := r.Context().Value(api.SessionKey).(smqauthn.Session)
+		if !ok {
+			api.EncodeError(r.Context(), svcerr.ErrAuthorization, w)
+			return
+		}
+		window, err := svc.EndMaintenance(r.Context(), session.UserID, chi.URLParam(r, "windowID"))
+		if err != nil {
+			api.EncodeError(r.Context(), err, w)
+			return
+		}
+		encodeJSON(w, http.StatusOK, window)
+	}
+}
+
+func decodePageMetadata(r *http.Request) (PageMetadata, error) {
+	offset, err := apiutil.ReadNumQuery[uint64](r, "offset", 0)
+	if err != nil {
+		return PageMetadata{}, err
+	}
+	limit, err := apiutil.ReadNumQuery[uint64](r, "limit", defLimit)
+	if err != nil {
+		return PageMetadata{}, err
+	}
+	status, err := apiutil.ReadStringQuery(r, "status", "")
+	if err != nil {
+		return PageMetadata{}, err
+	}
+	severity, err := apiutil.ReadStringQuery(r, "severity", "")
+	if err != nil {
+		return PageMetadata{}, err
+	}
+	clientID, err := apiutil.ReadStringQuery(r, "client_id", "")
+	if err != nil {
+		return PageMetadata{}, err
+	}
+	channelID, err := apiutil.ReadStringQuery(r, "channel_id", "")
+	if err != nil {
+		return PageMetadata{}, err
+	}
+	ruleID, err := apiutil.ReadStringQuery(r, "rule_id", "")
+	if err != nil {
+		return PageMetadata{}, err
+	}
+	return PageMetadata{
+		Offset:    offset,
+		Limit:     limit,
+		Status:    status,
+		Severity:  severity,
+		ClientID:  clientID,
+		ChannelID: channelID,
+		RuleID:    ruleID,
+	}, nil
+}
+
+func encodeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", api.ContentType)
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
+type messageHandler struct {
+	svc Service
+}
+
+// NewMessageHandler adapts the service to the messaging handler API, so
+// that it can be subscribed directly to the message broker.
+func NewMessageHandler(svc Service) messaging.MessageHandler {
+	return messageHandler{svc: svc}
+}
+
+func (h messageHandler) Handle(msg *messaging.Message) error {
+	return h.svc.ConsumeBlocking(context.Background(), msg)
+}
+
+func (h messageHandler) Cancel() error {
+	return nil
+}
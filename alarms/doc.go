@@ -0,0 +1,8 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package alarms provides the alarms subsystem. Rules evaluated
+// against incoming messages raise alarms tied to a client and channel,
+// and raised alarms transition through the
+// active->acknowledged->resolved lifecycle via the API.
+package alarms
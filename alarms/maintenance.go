@@ -0,0 +1,184 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package alarms
+
+import (
+	"context"
+	"time"
+
+	"github.com/absmach/supermq/pkg/errors"
+	svcerr "github.com/absmach/supermq/pkg/errors/service"
+	"github.com/absmach/supermq/pkg/events"
+)
+
+// Maintenance window boundary events.
+const (
+	maintenanceStarted = "alarms.maintenance_started"
+	maintenanceEnded   = "alarms.maintenance_ended"
+)
+
+var (
+	errMissingTarget = errors.New("maintenance window requires a client or channel")
+	errInvalidWindow = errors.New("maintenance window end time is not in the future")
+	errAlreadyEnded  = errors.New("maintenance window is already ended")
+)
+
+var _ events.Event = (*maintenanceEvent)(nil)
+
+type maintenanceEvent struct {
+	operation string
+	window    MaintenanceWindow
+}
+
+func (e maintenanceEvent) Encode() (map[string]interface{}, error) {
+	val := map[string]interface{}{
+		"operation":  e.operation,
+		"id":         e.window.ID,
+		"start_time": e.window.StartTime,
+		"end_time":   e.window.EndTime,
+	}
+	if e.window.ClientID != "" {
+		val["client_id"] = e.window.ClientID
+	}
+	if e.window.ChannelID != "" {
+		val["channel_id"] = e.window.ChannelID
+	}
+	if e.window.EndedAt != nil {
+		val["ended_at"] = *e.window.EndedAt
+	}
+	return val, nil
+}
+
+func (svc *service) ScheduleMaintenance(ctx context.Context, window MaintenanceWindow) (MaintenanceWindow, error) {
+	if window.ClientID == "" && window.ChannelID == "" {
+		return MaintenanceWindow{}, errors.Wrap(svcerr.ErrMalformedEntity, errMissingTarget)
+	}
+	now := time.Now()
+	if window.StartTime.IsZero() {
+		window.StartTime = now
+	}
+	if !window.EndTime.After(window.StartTime) || !window.EndTime.After(now) {
+		return MaintenanceWindow{}, errors.Wrap(svcerr.ErrMalformedEntity, errInvalidWindow)
+	}
+	id, err := svc.idProvider.ID()
+	if err != nil {
+		return MaintenanceWindow{}, err
+	}
+	window.ID = id
+	window.CreatedAt = now
+	window.EndedAt = nil
+	window.StartedNotified = false
+	window.EndedNotified = false
+
+	if err := svc.repo.SaveWindow(ctx, window); err != nil {
+		return MaintenanceWindow{}, errors.Wrap(svcerr.ErrCreateEntity, err)
+	}
+
+	return window, nil
+}
+
+func (svc *service) ListMaintenance(ctx context.Context, userID string) ([]MaintenanceWindow, error) {
+	windows, err := svc.repo.RetrieveWindows(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+	return windows, nil
+}
+
+func (svc *service) EndMaintenance(ctx context.Context, userID, id string) (MaintenanceWindow, error) {
+	window, err := svc.repo.RetrieveWindow(ctx, userID, id)
+	if err != nil {
+		return MaintenanceWindow{}, err
+	}
+	now := time.Now()
+	if window.EndedAt != nil || !now.Before(window.EndTime) {
+		return MaintenanceWindow{}, errors.Wrap(svcerr.ErrMalformedEntity, errAlreadyEnded)
+	}
+	window.EndedAt = &now
+	window.EndedNotified = true
+	if err := svc.repo.UpdateWindow(ctx, window); err != nil {
+		return MaintenanceWindow{}, errors.Wrap(svcerr.ErrUpdateEntity, err)
+	}
+
+	if err := svc.publisher.Publish(ctx, maintenanceEvent{operation: maintenanceEnded, window: window}); err != nil {
+		return window, err
+	}
+	return window, nil
+}
+
+func (svc *service) RunMaintenanceNotifier(ctx context.Context, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case now := <-ticker.C:
+			svc.notifyMaintenance(ctx, now)
+		}
+	}
+}
+
+// notifyMaintenance publishes the due boundary events. Boundaries are
+// marked as announced only after a successful publish, so that failed
+// ones are retried on the next tick.
+func (svc *service) notifyMaintenance(ctx context.Context, now time.Time) {
+	for _, e := range svc.dueMaintenanceEvents(ctx, now) {
+		if err := svc.publisher.Publish(ctx, e); err != nil {
+			continue
+		}
+		svc.markNotified(ctx, e)
+	}
+}
+
+// dueMaintenanceEvents returns the boundary events of windows that
+// have started or ended before the given time without being announced.
+func (svc *service) dueMaintenanceEvents(ctx context.Context, now time.Time) []maintenanceEvent {
+	windows, err := svc.repo.RetrieveAllWindows(ctx)
+	if err != nil {
+		return nil
+	}
+	evts := []maintenanceEvent{}
+	for _, window := range windows {
+		if !window.StartedNotified && window.EndedAt == nil && !now.Before(window.StartTime) && now.Before(window.EndTime) {
+			evts = append(evts, maintenanceEvent{operation: maintenanceStarted, window: window})
+		}
+		if !window.EndedNotified && (window.EndedAt != nil || !now.Before(window.EndTime)) {
+			evts = append(evts, maintenanceEvent{operation: maintenanceEnded, window: window})
+		}
+	}
+	return evts
+}
+
+func (svc *service) markNotified(ctx context.Context, e maintenanceEvent) {
+	window := e.window
+	switch e.operation {
+	case maintenanceStarted:
+		window.StartedNotified = true
+	case maintenanceEnded:
+		window.EndedNotified = true
+	}
+	_ = svc.repo.UpdateWindow(ctx, window)
+}
+
+// suppressed reports whether the client or channel is under an open
+// maintenance window at the given time.
+func (svc *service) suppressed(ctx context.Context, clientID, channelID string, now time.Time) bool {
+	windows, err := svc.repo.RetrieveAllWindows(ctx)
+	if err != nil {
+		return false
+	}
+	for _, window := range windows {
+		if window.ClientID != "" && window.ClientID != clientID {
+			continue
+		}
+		if window.ChannelID != "" && window.ChannelID != channelID {
+			continue
+		}
+		if window.EndedAt == nil && !now.Before(window.StartTime) && now.Before(window.EndTime) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,366 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package postgres contains the Postgres repository of the alarms
+// service.
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/absmach/supermq/alarms"
+	"github.com/absmach/supermq/pkg/errors"
+	svcerr "github.com/absmach/supermq/pkg/errors/service"
+	"github.com/jmoiron/sqlx"
+)
+
+var errAlarms = errors.New("failed to access alarms")
+
+var _ alarms.Repository = (*alarmsRepo)(nil)
+
+type alarmsRepo struct {
+	db *sqlx.DB
+}
+
+// NewRepository returns an alarms repository backed by the rules,
+// alarms and windows tables.
+func NewRepository(db *sqlx.DB) alarms.Repository {
+	return &alarmsRepo{db: db}
+}
+
+func (ar *alarmsRepo) SaveRule(ctx context.Context, rule alarms.Rule) error {
+	q := `INSERT INTO rules (id, user_id, name, client_id, channel_id, subtopic, field, threshold, severity)
+          VALUES (:id, :user_id, :name, :client_id, :channel_id, :subtopic, :field, :threshold, :severity);`
+
+	if _, err := ar.db.NamedExecContext(ctx, q, dbRuleOf(rule)); err != nil {
+		return errors.Wrap(errAlarms, err)
+	}
+	return nil
+}
+
+func (ar *alarmsRepo) RetrieveAllRules(ctx context.Context) ([]alarms.Rule, error) {
+	q := `SELECT id, user_id, name, client_id, channel_id, subtopic, field, threshold, severity FROM rules ORDER BY id;`
+
+	rows, err := ar.db.QueryxContext(ctx, q)
+	if err != nil {
+		return nil, errors.Wrap(errAlarms, err)
+	}
+	defer rows.Close()
+
+	return scanRules(rows)
+}
+
+func (ar *alarmsRepo) RetrieveRules(ctx context.Context, userID string) ([]alarms.Rule, error) {
+	q := `SELECT id, user_id, name, client_id, channel_id, subtopic, field, threshold, severity
+          FROM rules WHERE user_id = :user_id ORDER BY id;`
+
+	rows, err := ar.db.NamedQueryContext(ctx, q, map[string]interface{}{"user_id": userID})
+	if err != nil {
+		return nil, errors.Wrap(errAlarms, err)
+	}
+	defer rows.Close()
+
+	return scanRules(rows)
+}
+
+func (ar *alarmsRepo) RemoveRule(ctx context.Context, userID, id string) error {
+	q := `DELETE FROM rules WHERE id = :id AND user_id = :user_id;`
+
+	res, err := ar.db.NamedExecContext(ctx, q, map[string]interface{}{"id": id, "user_id": userID})
+	if err != nil {
+		return errors.Wrap(errAlarms, err)
+	}
+	if count, err := res.RowsAffected(); err == nil && count == 0 {
+		return svcerr.ErrNotFound
+	}
+	return nil
+}
+
+func (ar *alarmsRepo) SaveAlarm(ctx context.Context, alarm alarms.Alarm) error {
+	q := `INSERT INTO alarms (id, rule_id, client_id, channel_id, subtopic, severity, status, message, count, created_at, updated_at, acknowledged_at, resolved_at)
+          VALUES (:id, :rule_id, :client_id, :channel_id, :subtopic, :severity, :status, :message, :count, :created_at, :updated_at, :acknowledged_at, :resolved_at);`
+
+	if _, err := ar.db.NamedExecContext(ctx, q, dbAlarmOf(alarm)); err != nil {
+		return errors.Wrap(errAlarms, err)
+	}
+	return nil
+}
+
+func (ar *alarmsRepo) UpdateAlarm(ctx context.Context, alarm alarms.Alarm) error {
+	q := `UPDATE alarms SET status = :status, message = :message, count = :count, updated_at = :updated_at,
+          acknowledged_at = :acknowledged_at, resolved_at = :resolved_at WHERE id = :id;`
+
+	res, err := ar.db.NamedExecContext(ctx, q, dbAlarmOf(alarm))
+	if err != nil {
+		return errors.Wrap(errAlarms, err)
+	}
+	if count, err := res.RowsAffected(); err == nil && count == 0 {
+		return svcerr.ErrNotFound
+	}
+	return nil
+}
+
+func (ar *alarmsRepo) RetrieveAlarm(ctx context.Context, userID, id string) (alarms.Alarm, error) {
+	q := `SELECT a.id, a.rule_id, a.client_id, a.channel_id, a.subtopic, a.severity, a.status, a.message, a.count,
+          a.created_at, a.updated_at, a.acknowledged_at, a.resolved_at
+          FROM alarms a JOIN rules r ON a.rule_id = r.id
+          WHERE a.id = :id AND r.user_id = :user_id;`
+
+	return ar.retrieveOne(ctx, q, map[string]interface{}{"id": id, "user_id": userID})
+}
+
+func (ar *alarmsRepo) RetrieveOpenAlarm(ctx context.Context, ruleID, clientID, channelID string) (alarms.Alarm, error) {
+	q := `SELECT id, rule_id, client_id, channel_id, subtopic, severity, status, message, count,
+          created_at, updated_at, acknowledged_at, resolved_at
+          FROM alarms WHERE rule_id = :rule_id AND client_id = :client_id AND channel_id = :channel_id AND status <> :resolved;`
+
+	return ar.retrieveOne(ctx, q, map[string]interface{}{
+		"rule_id":    ruleID,
+		"client_id":  clientID,
+		"channel_id": channelID,
+		"resolved":   alarms.StatusResolved,
+	})
+}
+
+func (ar *alarmsRepo) RetrieveAlarms(ctx context.Context, userID string, pm alarms.PageMetadata) (alarms.AlarmsPage, error) {
+	filter := `FROM alarms a JOIN rules r ON a.rule_id = r.id WHERE r.user_id = :user_id`
+	args := map[string]interface{}{
+		"user_id": userID,
+		"offset":  pm.Offset,
+		"limit":   pm.Limit,
+	}
+	if pm.Status != "" {
+		filter += ` AND a.status = :status`
+		args["status"] = pm.Status
+	}
+	if pm.Severity != "" {
+		filter += ` AND a.severity = :severity`
+		args["severity"] = pm.Severity
+	}
+	if pm.ClientID != "" {
+		filter += ` AND a.client_id = :client_id`
+		args["client_id"] = pm.ClientID
+	}
+	if pm.ChannelID != "" {
+		filter += ` AND a.channel_id = :channel_id`
+		args["channel_id"] = pm.ChannelID
+	}
+	if pm.RuleID != "" {
+		filter += ` AND a.rule_id = :rule_id`
+		args["rule_id"] = pm.RuleID
+	}
+
+	q := fmt.Sprintf(`SELECT a.id, a.rule_id, a.client_id, a.channel_id, a.subtopic, a.severity, a.status, a.message, a.count,
+          a.created_at, a.updated_at, a.acknowledged_at, a.resolved_at %s
+          ORDER BY a.updated_at DESC OFFSET :offset LIMIT :limit;`, filter)
+
+	rows, err := ar.db.NamedQueryContext(ctx, q, args)
+	if err != nil {
+		return alarms.AlarmsPage{}, errors.Wrap(errAlarms, err)
+	}
+	defer rows.Close()
+
+	page := alarms.AlarmsPage{
+		PageMetadata: pm,
+		Alarms:       []alarms.Alarm{},
+	}
+	for rows.Next() {
+		var dba dbAlarm
+		if err := rows.StructScan(&dba); err != nil {
+			return alarms.AlarmsPage{}, errors.Wrap(errAlarms, err)
+		}
+		page.Alarms = append(page.Alarms, dba.alarm())
+	}
+
+	cq := fmt.Sprintf(`SELECT COUNT(*) %s;`, filter)
+	crows, err := ar.db.NamedQueryContext(ctx, cq, args)
+	if err != nil {
+		return alarms.AlarmsPage{}, errors.Wrap(errAlarms, err)
+	}
+	defer crows.Close()
+	if crows.Next() {
+		if err := crows.Scan(&page.Total); err != nil {
+			return alarms.AlarmsPage{}, errors.Wrap(errAlarms, err)
+		}
+	}
+
+	return page, nil
+}
+
+func (ar *alarmsRepo) SaveWindow(ctx context.Context, window alarms.MaintenanceWindow) error {
+	q := `INSERT INTO windows (id, user_id, client_id, channel_id, start_time, end_time, created_at, ended_at, started_notified, ended_notified)
+          VALUES (:id, :user_id, :client_id, :channel_id, :start_time, :end_time, :created_at, :ended_at, :started_notified, :ended_notified);`
+
+	if _, err := ar.db.NamedExecContext(ctx, q, dbWindowOf(window)); err != nil {
+		return errors.Wrap(errAlarms, err)
+	}
+	return nil
+}
+
+func (ar *alarmsRepo) UpdateWindow(ctx context.Context, window alarms.MaintenanceWindow) error {
+	q := `UPDATE windows SET start_time = :start_time, end_time = :end_time, ended_at = :ended_at,
+          started_notified = :started_notified, ended_notified = :ended_notified WHERE id = :id;`
+
+	res, err := ar.db.NamedExecContext(ctx, q, dbWindowOf(window))
+	if err != nil {
+		return errors.Wrap(errAlarms, err)
+	}
+	if count, err := res.RowsAffected(); err == nil && count == 0 {
+		return svcerr.ErrNotFound
+	}
+	return nil
+}
+
+func (ar *alarmsRepo) RetrieveAllWindows(ctx context.Context) ([]alarms.MaintenanceWindow, error) {
+	q := `SELECT id, user_id, client_id, channel_id, start_time, end_time, created_at, ended_at, started_notified, ended_notified
+          FROM windows ORDER BY start_time, id;`
+
+	rows, err := ar.db.QueryxContext(ctx, q)
+	if err != nil {
+		return nil, errors.Wrap(errAlarms, err)
+	}
+	defer rows.Close()
+
+	return scanWindows(rows)
+}
+
+func (ar *alarmsRepo) RetrieveWindows(ctx context.Context, userID string) ([]alarms.MaintenanceWindow, error) {
+	q := `SELECT id, user_id, client_id, channel_id, start_time, end_time, created_at, ended_at, started_notified, ended_notified
+          FROM windows WHERE user_id = :user_id ORDER BY start_time, id;`
+
+	rows, err := ar.db.NamedQueryContext(ctx, q, map[string]interface{}{"user_id": userID})
+	if err != nil {
+		return nil, errors.Wrap(errAlarms, err)
+	}
+	defer rows.Close()
+
+	return scanWindows(rows)
+}
+
+func (ar *alarmsRepo) RetrieveWindow(ctx context.Context, userID, id string) (alarms.MaintenanceWindow, error) {
+	q := `SELECT id, user_id, client_id, channel_id, start_time, end_time, created_at, ended_at, started_notified, ended_notified
+          FROM windows WHERE id = :id AND user_id = :user_id;`
+
+	rows, err := ar.db.NamedQueryContext(ctx, q, map[string]interface{}{"id": id, "user_id": userID})
+	if err != nil {
+		return alarms.MaintenanceWindow{}, errors.Wrap(errAlarms, err)
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		return alarms.MaintenanceWindow{}, svcerr.ErrNotFound
+	}
+	var dbw dbWindow
+	if err := rows.StructScan(&dbw); err != nil {
+		return alarms.MaintenanceWindow{}, errors.Wrap(errAlarms, err)
+	}
+	return dbw.window(), nil
+}
+
+func (ar *alarmsRepo) retrieveOne(ctx context.Context, q string, args map[string]interface{}) (alarms.Alarm, error) {
+	rows, err := ar.db.NamedQueryContext(ctx, q, args)
+	if err != nil {
+		return alarms.Alarm{}, errors.Wrap(errAlarms, err)
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		return alarms.Alarm{}, svcerr.ErrNotFound
+	}
+	var dba dbAlarm
+	if err := rows.StructScan(&dba); err != nil {
+		return alarms.Alarm{}, errors.Wrap(errAlarms, err)
+	}
+	return dba.alarm(), nil
+}
+
+func scanRules(rows *sqlx.Rows) ([]alarms.Rule, error) {
+	rules := []alarms.Rule{}
+	for rows.Next() {
+		var dbr dbRule
+		if err := rows.StructScan(&dbr); err != nil {
+			return nil, errors.Wrap(errAlarms, err)
+		}
+		rules = append(rules, dbr.rule())
+	}
+	return rules, nil
+}
+
+func scanWindows(rows *sqlx.Rows) ([]alarms.MaintenanceWindow, error) {
+	windows := []alarms.MaintenanceWindow{}
+	for rows.Next() {
+		var dbw dbWindow
+		if err := rows.StructScan(&dbw); err != nil {
+			return nil, errors.Wrap(errAlarms, err)
+		}
+		windows = append(windows, dbw.window())
+	}
+	return windows, nil
+}
+
+type dbRule struct {
+	ID        string  `db:"id"`
+	UserID    string  `db:"user_id"`
+	Name      string  `db:"name"`
+	ClientID  string  `db:"client_id"`
+	ChannelID string  `db:"channel_id"`
+	Subtopic  string  `db:"subtopic"`
+	Field     string  `db:"field"`
+	Threshold float64 `db:"threshold"`
+	Severity  string  `db:"severity"`
+}
+
+func dbRuleOf(rule alarms.Rule) dbRule {
+	return dbRule(rule)
+}
+
+func (dbr dbRule) rule() alarms.Rule {
+	return alarms.Rule(dbr)
+}
+
+type dbAlarm struct {
+	ID             string     `db:"id"`
+	RuleID         string     `db:"rule_id"`
+	ClientID       string     `db:"client_id"`
+	ChannelID      string     `db:"channel_id"`
+	Subtopic       string     `db:"subtopic"`
+	Severity       string     `db:"severity"`
+	Status         string     `db:"status"`
+	Message        string     `db:"message"`
+	Count          uint64     `db:"count"`
+	CreatedAt      time.Time  `db:"created_at"`
+	UpdatedAt      time.Time  `db:"updated_at"`
+	AcknowledgedAt *time.Time `db:"acknowledged_at"`
+	ResolvedAt     *time.Time `db:"resolved_at"`
+}
+
+func dbAlarmOf(alarm alarms.Alarm) dbAlarm {
+	return dbAlarm(alarm)
+}
+
+func (dba dbAlarm) alarm() alarms.Alarm {
+	return alarms.Alarm(dba)
+}
+
+type dbWindow struct {
+	ID              string     `db:"id"`
+	UserID          string     `db:"user_id"`
+	ClientID        string     `db:"client_id"`
+	ChannelID       string     `db:"channel_id"`
+	StartTime       time.Time  `db:"start_time"`
+	EndTime         time.Time  `db:"end_time"`
+	CreatedAt       time.Time  `db:"created_at"`
+	EndedAt         *time.Time `db:"ended_at"`
+	StartedNotified bool       `db:"started_notified"`
+	EndedNotified   bool       `db:"ended_notified"`
+}
+
+func dbWindowOf(window alarms.MaintenanceWindow) dbWindow {
+	return dbWindow(window)
+}
+
+func (dbw dbWindow) window() alarms.MaintenanceWindow {
+	return alarms.MaintenanceWindow(dbw)
+}
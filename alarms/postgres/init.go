@@ -0,0 +1,68 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	_ "github.com/jackc/pgx/v5/stdlib" // required for SQL access
+	migrate "github.com/rubenv/sql-migrate"
+)
+
+func Migration() *migrate.MemoryMigrationSource {
+	return &migrate.MemoryMigrationSource{
+		Migrations: []*migrate.Migration{
+			{
+				Id: "alarms_01",
+				Up: []string{
+					`CREATE TABLE IF NOT EXISTS rules (
+						id          VARCHAR(36) PRIMARY KEY,
+						user_id     VARCHAR(36) NOT NULL,
+						name        VARCHAR(1024),
+						client_id   VARCHAR(36),
+						channel_id  VARCHAR(36),
+						subtopic    VARCHAR(1024),
+						field       VARCHAR(254) NOT NULL,
+						threshold   FLOAT NOT NULL,
+						severity    VARCHAR(36) NOT NULL
+					)`,
+					`CREATE INDEX idx_rules_user ON rules (user_id)`,
+					`CREATE TABLE IF NOT EXISTS alarms (
+						id              VARCHAR(36) PRIMARY KEY,
+						rule_id         VARCHAR(36) NOT NULL,
+						client_id       VARCHAR(36) NOT NULL,
+						channel_id      VARCHAR(36) NOT NULL,
+						subtopic        VARCHAR(1024),
+						severity        VARCHAR(36) NOT NULL,
+						status          VARCHAR(36) NOT NULL,
+						message         TEXT,
+						count           BIGINT NOT NULL DEFAULT 1,
+						created_at      TIMESTAMP,
+						updated_at      TIMESTAMP,
+						acknowledged_at TIMESTAMP,
+						resolved_at     TIMESTAMP,
+						FOREIGN KEY (rule_id) REFERENCES rules (id) ON DELETE CASCADE
+					)`,
+					`CREATE INDEX idx_alarms_open ON alarms (rule_id, client_id, channel_id) WHERE status <> 'resolved'`,
+					`CREATE TABLE IF NOT EXISTS windows (
+						id               VARCHAR(36) PRIMARY KEY,
+						user_id          VARCHAR(36) NOT NULL,
+						client_id        VARCHAR(36),
+						channel_id       VARCHAR(36),
+						start_time       TIMESTAMP NOT NULL,
+						end_time         TIMESTAMP NOT NULL,
+						created_at       TIMESTAMP,
+						ended_at         TIMESTAMP,
+						started_notified BOOLEAN NOT NULL DEFAULT FALSE,
+						ended_notified   BOOLEAN NOT NULL DEFAULT FALSE
+					)`,
+					`CREATE INDEX idx_windows_user ON windows (user_id)`,
+				},
+				Down: []string{
+					`DROP TABLE IF EXISTS windows`,
+					`DROP TABLE IF EXISTS alarms`,
+					`DROP TABLE IF EXISTS rules`,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,237 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package alarms
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	smq "github.com/absmach/supermq"
+	"github.com/absmach/supermq/pkg/errors"
+	svcerr "github.com/absmach/supermq/pkg/errors/service"
+	"github.com/absmach/supermq/pkg/events"
+	"github.com/absmach/supermq/pkg/messaging"
+)
+
+const (
+	defLimit = uint64(10)
+	maxLimit = uint64(100)
+)
+
+var (
+	errMissingField    = errors.New("missing rule field")
+	errInvalidSeverity = errors.New("invalid alarm severity")
+	errInvalidStatus   = errors.New("invalid alarm status")
+	errNotActive       = errors.New("alarm is not active")
+	errAlreadyResolved = errors.New("alarm is already resolved")
+	validAlarmStatuses = []string{StatusActive, StatusAcknowledged, StatusResolved}
+	validAlarmSeverity = []string{SeverityLow, SeverityMedium, SeverityHigh, SeverityCritical}
+)
+
+type service struct {
+	repo       Repository
+	idProvider smq.IDProvider
+	publisher  events.Publisher
+}
+
+var _ Service = (*service)(nil)
+
+// NewService returns a new alarms service persisting rules, alarms and
+// maintenance windows in the given repository and publishing
+// maintenance window events to the given publisher.
+func NewService(repo Repository, idProvider smq.IDProvider, publisher events.Publisher) Service {
+	return &service{
+		repo:       repo,
+		idProvider: idProvider,
+		publisher:  publisher,
+	}
+}
+
+func (svc *service) CreateRule(ctx context.Context, rule Rule) (Rule, error) {
+	if rule.Field == "" {
+		return Rule{}, errors.Wrap(svcerr.ErrMalformedEntity, errMissingField)
+	}
+	if rule.Severity == "" {
+		rule.Severity = SeverityMedium
+	}
+	if !contains(validAlarmSeverity, rule.Severity) {
+		return Rule{}, errors.Wrap(svcerr.ErrMalformedEntity, errInvalidSeverity)
+	}
+	id, err := svc.idProvider.ID()
+	if err != nil {
+		return Rule{}, err
+	}
+	rule.ID = id
+
+	if err := svc.repo.SaveRule(ctx, rule); err != nil {
+		return Rule{}, errors.Wrap(svcerr.ErrCreateEntity, err)
+	}
+
+	return rule, nil
+}
+
+func (svc *service) ListRules(ctx context.Context, userID string) ([]Rule, error) {
+	rules, err := svc.repo.RetrieveRules(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+	return rules, nil
+}
+
+func (svc *service) RemoveRule(ctx context.Context, userID, id string) error {
+	return svc.repo.RemoveRule(ctx, userID, id)
+}
+
+func (svc *service) ListAlarms(ctx context.Context, userID string, pm PageMetadata) (AlarmsPage, error) {
+	if pm.Status != "" && !contains(validAlarmStatuses, pm.Status) {
+		return AlarmsPage{}, errors.Wrap(svcerr.ErrMalformedEntity, errInvalidStatus)
+	}
+	if pm.Severity != "" && !contains(validAlarmSeverity, pm.Severity) {
+		return AlarmsPage{}, errors.Wrap(svcerr.ErrMalformedEntity, errInvalidSeverity)
+	}
+	if pm.Limit == 0 {
+		pm.Limit = defLimit
+	}
+	pm.Limit = min(pm.Limit, maxLimit)
+
+	page, err := svc.repo.RetrieveAlarms(ctx, userID, pm)
+	if err != nil {
+		return AlarmsPage{}, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+	return page, nil
+}
+
+func (svc *service) Acknowledge(ctx context.Context, userID, id string) (Alarm, error) {
+	alarm, err := svc.repo.RetrieveAlarm(ctx, userID, id)
+	if err != nil {
+		return Alarm{}, err
+	}
+	if alarm.Status != StatusActive {
+		return Alarm{}, errors.Wrap(svcerr.ErrMalformedEntity, errNotActive)
+	}
+
+	now := time.Now()
+	alarm.Status = StatusAcknowledged
+	alarm.AcknowledgedAt = &now
+	alarm.UpdatedAt = now
+	if err := svc.repo.UpdateAlarm(ctx, alarm); err != nil {
+		return Alarm{}, errors.Wrap(svcerr.ErrUpdateEntity, err)
+	}
+
+	return alarm, nil
+}
+
+func (svc *service) Resolve(ctx context.Context, userID, id string) (Alarm, error) {
+	alarm, err := svc.repo.RetrieveAlarm(ctx, userID, id)
+	if err != nil {
+		return Alarm{}, err
+	}
+	if alarm.Status == StatusResolved {
+		return Alarm{}, errors.Wrap(svcerr.ErrMalformedEntity, errAlreadyResolved)
+	}
+
+	now := time.Now()
+	alarm.Status = StatusResolved
+	alarm.ResolvedAt = &now
+	alarm.UpdatedAt = now
+	if err := svc.repo.UpdateAlarm(ctx, alarm); err != nil {
+		return Alarm{}, errors.Wrap(svcerr.ErrUpdateEntity, err)
+	}
+
+	return alarm, nil
+}
+
+// ConsumeBlocking evaluates alarm rules against incoming messages.
+// Message payloads are expected to be flat JSON objects with numeric
+// values.
+func (svc *service) ConsumeBlocking(ctx context.Context, messages interface{}) error {
+	switch msgs := messages.(type) {
+	case *messaging.Message:
+		svc.consume(ctx, msgs)
+	case []*messaging.Message:
+		for _, msg := range msgs {
+			svc.consume(ctx, msg)
+		}
+	}
+	return nil
+}
+
+func (svc *service) consume(ctx context.Context, msg *messaging.Message) {
+	values := map[string]float64{}
+	if err := json.Unmarshal(msg.GetPayload(), &values); err != nil {
+		return
+	}
+
+	if svc.suppressed(ctx, msg.GetPublisher(), msg.GetChannel(), time.Now()) {
+		return
+	}
+
+	rules, err := svc.repo.RetrieveAllRules(ctx)
+	if err != nil {
+		return
+	}
+	for _, rule := range rules {
+		if rule.ClientID != "" && rule.ClientID != msg.GetPublisher() {
+			continue
+		}
+		if rule.ChannelID != "" && rule.ChannelID != msg.GetChannel() {
+			continue
+		}
+		if rule.Subtopic != "" && rule.Subtopic != msg.GetSubtopic() {
+			continue
+		}
+		value, ok := values[rule.Field]
+		if !ok || value <= rule.Threshold {
+			continue
+		}
+		svc.raise(ctx, rule, msg, value)
+	}
+}
+
+// raise raises an alarm for the rule, or updates the occurrence count
+// of the open one.
+func (svc *service) raise(ctx context.Context, rule Rule, msg *messaging.Message, value float64) {
+	now := time.Now()
+
+	if alarm, err := svc.repo.RetrieveOpenAlarm(ctx, rule.ID, msg.GetPublisher(), msg.GetChannel()); err == nil {
+		alarm.Count++
+		alarm.Message = alarmMessage(rule, value)
+		alarm.UpdatedAt = now
+		_ = svc.repo.UpdateAlarm(ctx, alarm)
+		return
+	}
+
+	id, err := svc.idProvider.ID()
+	if err != nil {
+		return
+	}
+	_ = svc.repo.SaveAlarm(ctx, Alarm{
+		ID:        id,
+		RuleID:    rule.ID,
+		ClientID:  msg.GetPublisher(),
+		ChannelID: msg.GetChannel(),
+		Subtopic:  msg.GetSubtopic(),
+		Severity:  rule.Severity,
+		Status:    StatusActive,
+		Message:   alarmMessage(rule, value),
+		Count:     1,
+		CreatedAt: now,
+		UpdatedAt: now,
+	})
+}
+
+func alarmMessage(rule Rule, value float64) string {
+	return fmt.Sprintf("%s: %s %v crossed threshold %v", rule.Name, rule.Field, value, rule.Threshold)
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,551 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package alarms_test
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/absmach/supermq/alarms"
+	"github.com/absmach/supermq/pkg/errors"
+	svcerr "github.com/absmach/supermq/pkg/errors/service"
+	"github.com/absmach/supermq/pkg/events/mocks"
+	"github.com/absmach/supermq/pkg/messaging"
+	"github.com/absmach/supermq/pkg/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	userID    = "user"
+	clientID  = "client"
+	channelID = "channel"
+)
+
+// memRepo is an in-memory alarms.Repository. The tests exercise the
+// service end to end, so the repository has to keep real state rather
+// than replay canned responses.
+type memRepo struct {
+	mu      sync.Mutex
+	rules   map[string]alarms.Rule
+	alarms  map[string]alarms.Alarm
+	windows map[string]alarms.MaintenanceWindow
+}
+
+func newMemRepo() *memRepo {
+	return &memRepo{
+		rules:   make(map[string]alarms.Rule),
+		alarms:  make(map[string]alarms.Alarm),
+		windows: make(map[string]alarms.MaintenanceWindow),
+	}
+}
+
+func (mr *memRepo) SaveRule(_ context.Context, rule alarms.Rule) error {
+	mr.mu.Lock()
+	defer mr.mu.Unlock()
+	mr.rules[rule.ID] = rule
+	return nil
+}
+
+func (mr *memRepo) RetrieveAllRules(_ context.Context) ([]alarms.Rule, error) {
+	mr.mu.Lock()
+	defer mr.mu.Unlock()
+	rules := []alarms.Rule{}
+	for _, rule := range mr.rules {
+		rules = append(rules, rule)
+	}
+	sort.Slice(rules, func(i, j int) bool { return rules[i].ID < rules[j].ID })
+	return rules, nil
+}
+
+func (mr *memRepo) RetrieveRules(_ context.Context, userID string) ([]alarms.Rule, error) {
+	mr.mu.Lock()
+	defer mr.mu.Unlock()
+	rules := []alarms.Rule{}
+	for _, rule := range mr.rules {
+		if rule.UserID == userID {
+			rules = append(rules, rule)
+		}
+	}
+	sort.Slice(rules, func(i, j int) bool { return rules[i].ID < rules[j].ID })
+	return rules, nil
+}
+
+func (mr *memRepo) RemoveRule(_ context.Context, userID, id string) error {
+	mr.mu.Lock()
+	defer mr.mu.Unlock()
+	rule, ok := mr.rules[id]
+	if !ok || rule.UserID != userID {
+		return svcerr.ErrNotFound
+	}
+	delete(mr.rules, id)
+	return nil
+}
+
+func (mr *memRepo) SaveAlarm(_ context.Context, alarm alarms.Alarm) error {
+	mr.mu.Lock()
+	defer mr.mu.Unlock()
+	mr.alarms[alarm.ID] = alarm
+	return nil
+}
+
+func (mr *memRepo) UpdateAlarm(_ context.Context, alarm alarms.Alarm) error {
+	mr.mu.Lock()
+	defer mr.mu.Unlock()
+	if _, ok := mr.alarms[alarm.ID]; !ok {
+		return svcerr.ErrNotFound
+	}
+	mr.alarms[alarm.ID] = alarm
+	return nil
+}
+
+func (mr *memRepo) RetrieveAlarm(_ context.Context, userID, id string) (alarms.Alarm, error) {
+	mr.mu.Lock()
+	defer mr.mu.Unlock()
+	alarm, ok := mr.alarms[id]
+	if !ok {
+		return alarms.Alarm{}, svcerr.ErrNotFound
+	}
+	rule, ok := mr.rules[alarm.RuleID]
+	if !ok || rule.UserID != userID {
+		return alarms.Alarm{}, svcerr.ErrNotFound
+	}
+	return alarm, nil
+}
+
+func (mr *memRepo) RetrieveOpenAlarm(_ context.Context, ruleID, clientID, channelID string) (alarms.Alarm, error) {
+	mr.mu.Lock()
+	defer mr.mu.Unlock()
+	for _, alarm := range mr.alarms {
+		if alarm.RuleID == ruleID && alarm.ClientID == clientID && alarm.ChannelID == channelID && alarm.Status != alarms.StatusResolved {
+			return alarm, nil
+		}
+	}
+	return alarms.Alarm{}, svcerr.ErrNotFound
+}
+
+func (mr *memRepo) RetrieveAlarms(_ context.Context, userID string, pm alarms.PageMetadata) (alarms.AlarmsPage, error) {
+	mr.mu.Lock()
+	defer mr.mu.Unlock()
+	matched := []alarms.Alarm{}
+	for _, alarm := range mr.alarms {
+		rule, ok := mr.rules[alarm.RuleID]
+		if !ok || rule.UserID != userID {
+			continue
+		}
+		if pm.Status != "" && alarm.Status != pm.Status {
+			continue
+		}
+		if pm.Severity != "" && alarm.Severity != pm.Severity {
+			continue
+		}
+		if pm.ClientID != "" && alarm.ClientID != pm.ClientID {
+			continue
+		}
+		if pm.ChannelID != "" && alarm.ChannelID != pm.ChannelID {
+			continue
+		}
+		if pm.RuleID != "" && alarm.RuleID != pm.RuleID {
+			continue
+		}
+		matched = append(matched, alarm)
+	}
+	sort.Slice(matched, func(i, j int) bool {
+		return matched[i].UpdatedAt.After(matched[j].UpdatedAt)
+	})
+
+	page := alarms.AlarmsPage{
+		PageMetadata: pm,
+		Total:        uint64(len(matched)),
+		Alarms:       []alarms.Alarm{},
+	}
+	for i := pm.Offset; i < uint64(len(matched)) && uint64(len(page.Alarms)) < pm.Limit; i++ {
+		page.Alarms = append(page.Alarms, matched[i])
+	}
+	return page, nil
+}
+
+func (mr *memRepo) SaveWindow(_ context.Context, window alarms.MaintenanceWindow) error {
+	mr.mu.Lock()
+	defer mr.mu.Unlock()
+	mr.windows[window.ID] = window
+	return nil
+}
+
+func (mr *memRepo) UpdateWindow(_ context.Context, window alarms.MaintenanceWindow) error {
+	mr.mu.Lock()
+	defer mr.mu.Unlock()
+	if _, ok := mr.windows[window.ID]; !ok {
+		return svcerr.ErrNotFound
+	}
+	mr.windows[window.ID] = window
+	return nil
+}
+
+func (mr *memRepo) RetrieveAllWindows(_ context.Context) ([]alarms.MaintenanceWindow, error) {
+	mr.mu.Lock()
+	defer mr.mu.Unlock()
+	windows := []alarms.MaintenanceWindow{}
+	for _, window := range mr.windows {
+		windows = append(windows, window)
+	}
+	sortWindows(windows)
+	return windows, nil
+}
+
+func (mr *memRepo) RetrieveWindows(_ context.Context, userID string) ([]alarms.MaintenanceWindow, error) {
+	mr.mu.Lock()
+	defer mr.mu.Unlock()
+	windows := []alarms.MaintenanceWindow{}
+	for _, window := range mr.windows {
+		if window.UserID == userID {
+			windows = append(windows, window)
+		}
+	}
+	sortWindows(windows)
+	return windows, nil
+}
+
+func (mr *memRepo) RetrieveWindow(_ context.Context, userID, id string) (alarms.MaintenanceWindow, error) {
+	mr.mu.Lock()
+	defer mr.mu.Unlock()
+	window, ok := mr.windows[id]
+	if !ok || window.UserID != userID {
+		return alarms.MaintenanceWindow{}, svcerr.ErrNotFound
+	}
+	return window, nil
+}
+
+func sortWindows(windows []alarms.MaintenanceWindow) {
+	sort.Slice(windows, func(i, j int) bool {
+		if windows[i].StartTime.Equal(windows[j].StartTime) {
+			return windows[i].ID < windows[j].ID
+		}
+		return windows[i].StartTime.Before(windows[j].StartTime)
+	})
+}
+
+func newService() alarms.Service {
+	publisher := new(mocks.Publisher)
+	publisher.On("Publish", mock.Anything, mock.Anything).Return(nil)
+	return alarms.NewService(newMemRepo(), uuid.NewMock(), publisher)
+}
+
+func createRule(t *testing.T, svc alarms.Service, severity string) alarms.Rule {
+	rule, err := svc.CreateRule(context.Background(), alarms.Rule{
+		UserID:    userID,
+		Name:      "overheating",
+		ChannelID: channelID,
+		Field:     "temperature",
+		Threshold: 30,
+		Severity:  severity,
+	})
+	require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+	return rule
+}
+
+func message(payload string) *messaging.Message {
+	return &messaging.Message{
+		Channel:   channelID,
+		Publisher: clientID,
+		Payload:   []byte(payload),
+	}
+}
+
+func trigger(t *testing.T, svc alarms.Service, payload string) {
+	err := svc.ConsumeBlocking(context.Background(), message(payload))
+	require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+}
+
+func TestCreateRule(t *testing.T) {
+	svc := newService()
+
+	cases := []struct {
+		desc string
+		rule alarms.Rule
+		err  error
+	}{
+		{
+			desc: "create rule",
+			rule: alarms.Rule{UserID: userID, Name: "overheating", Field: "temperature", Threshold: 30, Severity: alarms.SeverityHigh},
+			err:  nil,
+		},
+		{
+			desc: "create rule with default severity",
+			rule: alarms.Rule{UserID: userID, Name: "overheating", Field: "temperature", Threshold: 30},
+			err:  nil,
+		},
+		{
+			desc: "create rule without field",
+			rule: alarms.Rule{UserID: userID, Name: "overheating", Threshold: 30},
+			err:  svcerr.ErrMalformedEntity,
+		},
+		{
+			desc: "create rule with invalid severity",
+			rule: alarms.Rule{UserID: userID, Name: "overheating", Field: "temperature", Severity: "invalid"},
+			err:  svcerr.ErrMalformedEntity,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			rule, err := svc.CreateRule(context.Background(), tc.rule)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("expected error %v got %v", tc.err, err))
+			if tc.err == nil {
+				assert.NotEmpty(t, rule.ID)
+			}
+		})
+	}
+}
+
+func TestRaiseAlarm(t *testing.T) {
+	svc := newService()
+	rule := createRule(t, svc, alarms.SeverityHigh)
+
+	trigger(t, svc, `{"temperature": 25}`)
+	page, err := svc.ListAlarms(context.Background(), userID, alarms.PageMetadata{})
+	require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+	assert.Empty(t, page.Alarms)
+
+	trigger(t, svc, `{"temperature": 42}`)
+	page, err = svc.ListAlarms(context.Background(), userID, alarms.PageMetadata{})
+	require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+	require.Len(t, page.Alarms, 1)
+
+	alarm := page.Alarms[0]
+	assert.Equal(t, rule.ID, alarm.RuleID)
+	assert.Equal(t, clientID, alarm.ClientID)
+	assert.Equal(t, channelID, alarm.ChannelID)
+	assert.Equal(t, alarms.SeverityHigh, alarm.Severity)
+	assert.Equal(t, alarms.StatusActive, alarm.Status)
+	assert.Equal(t, uint64(1), alarm.Count)
+
+	// A repeated trigger updates the occurrence count of the open
+	// alarm instead of raising a new one.
+	trigger(t, svc, `{"temperature": 45}`)
+	page, err = svc.ListAlarms(context.Background(), userID, alarms.PageMetadata{})
+	require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+	require.Len(t, page.Alarms, 1)
+	assert.Equal(t, uint64(2), page.Alarms[0].Count)
+}
+
+func TestLifecycle(t *testing.T) {
+	svc := newService()
+	createRule(t, svc, alarms.SeverityHigh)
+	trigger(t, svc, `{"temperature": 42}`)
+
+	page, err := svc.ListAlarms(context.Background(), userID, alarms.PageMetadata{})
+	require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+	require.Len(t, page.Alarms, 1)
+	id := page.Alarms[0].ID
+
+	_, err = svc.Resolve(context.Background(), "other", id)
+	assert.True(t, errors.Contains(err, svcerr.ErrNotFound), fmt.Sprintf("expected error %v got %v", svcerr.ErrNotFound, err))
+
+	alarm, err := svc.Acknowledge(context.Background(), userID, id)
+	require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+	assert.Equal(t, alarms.StatusAcknowledged, alarm.Status)
+	assert.NotNil(t, alarm.AcknowledgedAt)
+
+	_, err = svc.Acknowledge(context.Background(), userID, id)
+	assert.True(t, errors.Contains(err, svcerr.ErrMalformedEntity), fmt.Sprintf("expected error %v got %v", svcerr.ErrMalformedEntity, err))
+
+	alarm, err = svc.Resolve(context.Background(), userID, id)
+	require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+	assert.Equal(t, alarms.StatusResolved, alarm.Status)
+	assert.NotNil(t, alarm.ResolvedAt)
+
+	_, err = svc.Resolve(context.Background(), userID, id)
+	assert.True(t, errors.Contains(err, svcerr.ErrMalformedEntity), fmt.Sprintf("expected error %v got %v", svcerr.ErrMalformedEntity, err))
+
+	// A trigger after resolution raises a new alarm.
+	trigger(t, svc, `{"temperature": 42}`)
+	page, err = svc.ListAlarms(context.Background(), userID, alarms.PageMetadata{Status: alarms.StatusActive})
+	require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+	require.Len(t, page.Alarms, 1)
+	assert.NotEqual(t, id, page.Alarms[0].ID)
+}
+
+func TestListAlarms(t *testing.T) {
+	svc := newService()
+	rule := createRule(t, svc, alarms.SeverityHigh)
+	trigger(t, svc, `{"temperature": 42}`)
+
+	page, err := svc.ListAlarms(context.Background(), userID, alarms.PageMetadata{})
+	require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+	require.Len(t, page.Alarms, 1)
+	id := page.Alarms[0].ID
+	_, err = svc.Resolve(context.Background(), userID, id)
+	require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+	trigger(t, svc, `{"temperature": 42}`)
+
+	cases := []struct {
+		desc  string
+		pm    alarms.PageMetadata
+		total uint64
+		err   error
+	}{
+		{
+			desc:  "list all alarms",
+			pm:    alarms.PageMetadata{},
+			total: 2,
+		},
+		{
+			desc:  "list active alarms",
+			pm:    alarms.PageMetadata{Status: alarms.StatusActive},
+			total: 1,
+		},
+		{
+			desc:  "list resolved alarms",
+			pm:    alarms.PageMetadata{Status: alarms.StatusResolved},
+			total: 1,
+		},
+		{
+			desc:  "list alarms by severity",
+			pm:    alarms.PageMetadata{Severity: alarms.SeverityHigh},
+			total: 2,
+		},
+		{
+			desc:  "list alarms by another severity",
+			pm:    alarms.PageMetadata{Severity: alarms.SeverityLow},
+			total: 0,
+		},
+		{
+			desc:  "list alarms by client",
+			pm:    alarms.PageMetadata{ClientID: clientID},
+			total: 2,
+		},
+		{
+			desc:  "list alarms by channel",
+			pm:    alarms.PageMetadata{ChannelID: channelID},
+			total: 2,
+		},
+		{
+			desc:  "list alarms by rule",
+			pm:    alarms.PageMetadata{RuleID: rule.ID},
+			total: 2,
+		},
+		{
+			desc:  "list alarms by unknown client",
+			pm:    alarms.PageMetadata{ClientID: "unknown"},
+			total: 0,
+		},
+		{
+			desc: "list alarms with invalid status",
+			pm:   alarms.PageMetadata{Status: "invalid"},
+			err:  svcerr.ErrMalformedEntity,
+		},
+		{
+			desc: "list alarms with invalid severity",
+			pm:   alarms.PageMetadata{Severity: "invalid"},
+			err:  svcerr.ErrMalformedEntity,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			page, err := svc.ListAlarms(context.Background(), userID, tc.pm)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("expected error %v got %v", tc.err, err))
+			if tc.err == nil {
+				assert.Equal(t, tc.total, page.Total)
+			}
+		})
+	}
+
+	page, err = svc.ListAlarms(context.Background(), "other", alarms.PageMetadata{})
+	require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+	assert.Empty(t, page.Alarms)
+}
+
+func TestScheduleMaintenance(t *testing.T) {
+	svc := newService()
+	now := time.Now()
+
+	cases := []struct {
+		desc   string
+		window alarms.MaintenanceWindow
+		err    error
+	}{
+		{
+			desc:   "schedule window for channel",
+			window: alarms.MaintenanceWindow{UserID: userID, ChannelID: channelID, EndTime: now.Add(time.Hour)},
+			err:    nil,
+		},
+		{
+			desc:   "schedule window for client",
+			window: alarms.MaintenanceWindow{UserID: userID, ClientID: clientID, StartTime: now.Add(time.Hour), EndTime: now.Add(2 * time.Hour)},
+			err:    nil,
+		},
+		{
+			desc:   "schedule window without target",
+			window: alarms.MaintenanceWindow{UserID: userID, EndTime: now.Add(time.Hour)},
+			err:    svcerr.ErrMalformedEntity,
+		},
+		{
+			desc:   "schedule window ending before it starts",
+			window: alarms.MaintenanceWindow{UserID: userID, ChannelID: channelID, StartTime: now.Add(time.Hour), EndTime: now},
+			err:    svcerr.ErrMalformedEntity,
+		},
+		{
+			desc:   "schedule window ending in the past",
+			window: alarms.MaintenanceWindow{UserID: userID, ChannelID: channelID, StartTime: now.Add(-2 * time.Hour), EndTime: now.Add(-time.Hour)},
+			err:    svcerr.ErrMalformedEntity,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			window, err := svc.ScheduleMaintenance(context.Background(), tc.window)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("expected error %v got %v", tc.err, err))
+			if tc.err == nil {
+				assert.NotEmpty(t, window.ID)
+				assert.False(t, window.StartTime.IsZero())
+			}
+		})
+	}
+
+	windows, err := svc.ListMaintenance(context.Background(), userID)
+	require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+	assert.Len(t, windows, 2)
+
+	windows, err = svc.ListMaintenance(context.Background(), "other")
+	require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+	assert.Empty(t, windows)
+}
+
+func TestMaintenanceSuppression(t *testing.T) {
+	svc := newService()
+	createRule(t, svc, alarms.SeverityHigh)
+
+	window, err := svc.ScheduleMaintenance(context.Background(), alarms.MaintenanceWindow{
+		UserID:    userID,
+		ChannelID: channelID,
+		EndTime:   time.Now().Add(time.Hour),
+	})
+	require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+
+	// Triggers during the window are suppressed.
+	trigger(t, svc, `{"temperature": 42}`)
+	page, err := svc.ListAlarms(context.Background(), userID, alarms.PageMetadata{})
+	require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+	assert.Empty(t, page.Alarms)
+
+	_, err = svc.EndMaintenance(context.Background(), "other", window.ID)
+	assert.True(t, errors.Contains(err, svcerr.ErrNotFound), fmt.Sprintf("expected error %v got %v", svcerr.ErrNotFound, err))
+
+	ended, err := svc.EndMaintenance(context.Background(), userID, window.ID)
+	require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+	assert.NotNil(t, ended.EndedAt)
+
+	_, err = svc.EndMaintenance(context.Background(), userID, window.ID)
+	assert.True(t, errors.Contains(err, svcerr.ErrMalformedEntity), fmt.Sprintf("expected error %v got %v", svcerr.ErrMalformedEntity, err))
+
+	// Triggers after the window ends raise alarms again.
+	trigger(t, svc, `{"temperature": 42}`)
+	page, err = svc.ListAlarms(context.Background(), userID, alarms.PageMetadata{})
+	require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+	assert.Len(t, page.Alarms, 1)
+}
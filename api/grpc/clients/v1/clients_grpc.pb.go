@@ -30,6 +30,10 @@ const (
 	ClientsService_RemoveConnections_FullMethodName          = "/clients.v1.ClientsService/RemoveConnections"
 	ClientsService_RemoveChannelConnections_FullMethodName   = "/clients.v1.ClientsService/RemoveChannelConnections"
 	ClientsService_UnsetParentGroupFromClient_FullMethodName = "/clients.v1.ClientsService/UnsetParentGroupFromClient"
+	ClientsService_CreateClient_FullMethodName               = "/clients.v1.ClientsService/CreateClient"
+	ClientsService_UpdateClient_FullMethodName               = "/clients.v1.ClientsService/UpdateClient"
+	ClientsService_RemoveClient_FullMethodName               = "/clients.v1.ClientsService/RemoveClient"
+	ClientsService_ListClients_FullMethodName                = "/clients.v1.ClientsService/ListClients"
 )
 
 // ClientsServiceClient is the client API for ClientsService service.
@@ -47,6 +51,10 @@ type ClientsServiceClient interface {
 	RemoveConnections(ctx context.Context, in *v1.RemoveConnectionsReq, opts ...grpc.CallOption) (*v1.RemoveConnectionsRes, error)
 	RemoveChannelConnections(ctx context.Context, in *RemoveChannelConnectionsReq, opts ...grpc.CallOption) (*RemoveChannelConnectionsRes, error)
 	UnsetParentGroupFromClient(ctx context.Context, in *UnsetParentGroupFromClientReq, opts ...grpc.CallOption) (*UnsetParentGroupFromClientRes, error)
+	CreateClient(ctx context.Context, in *CreateClientReq, opts ...grpc.CallOption) (*ClientRes, error)
+	UpdateClient(ctx context.Context, in *UpdateClientReq, opts ...grpc.CallOption) (*ClientRes, error)
+	RemoveClient(ctx context.Context, in *RemoveClientReq, opts ...grpc.CallOption) (*RemoveClientRes, error)
+	ListClients(ctx context.Context, in *ListClientsReq, opts ...grpc.CallOption) (*ListClientsRes, error)
 }
 
 type clientsServiceClient struct {
@@ -127,6 +135,46 @@ func (c *clientsServiceClient) UnsetParentGroupFromClient(ctx context.Context, i
 	return out, nil
 }
 
+func (c *clientsServiceClient) CreateClient(ctx context.Context, in *CreateClientReq, opts ...grpc.CallOption) (*ClientRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClientRes)
+	err := c.cc.Invoke(ctx, ClientsService_CreateClient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientsServiceClient) UpdateClient(ctx context.Context, in *UpdateClientReq, opts ...grpc.CallOption) (*ClientRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClientRes)
+	err := c.cc.Invoke(ctx, ClientsService_UpdateClient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientsServiceClient) RemoveClient(ctx context.Context, in *RemoveClientReq, opts ...grpc.CallOption) (*RemoveClientRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveClientRes)
+	err := c.cc.Invoke(ctx, ClientsService_RemoveClient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientsServiceClient) ListClients(ctx context.Context, in *ListClientsReq, opts ...grpc.CallOption) (*ListClientsRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListClientsRes)
+	err := c.cc.Invoke(ctx, ClientsService_ListClients_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ClientsServiceServer is the server API for ClientsService service.
 // All implementations must embed UnimplementedClientsServiceServer
 // for forward compatibility.
@@ -142,6 +190,10 @@ type ClientsServiceServer interface {
 	RemoveConnections(context.Context, *v1.RemoveConnectionsReq) (*v1.RemoveConnectionsRes, error)
 	RemoveChannelConnections(context.Context, *RemoveChannelConnectionsReq) (*RemoveChannelConnectionsRes, error)
 	UnsetParentGroupFromClient(context.Context, *UnsetParentGroupFromClientReq) (*UnsetParentGroupFromClientRes, error)
+	CreateClient(context.Context, *CreateClientReq) (*ClientRes, error)
+	UpdateClient(context.Context, *UpdateClientReq) (*ClientRes, error)
+	RemoveClient(context.Context, *RemoveClientReq) (*RemoveClientRes, error)
+	ListClients(context.Context, *ListClientsReq) (*ListClientsRes, error)
 	mustEmbedUnimplementedClientsServiceServer()
 }
 
@@ -173,6 +225,18 @@ func (UnimplementedClientsServiceServer) RemoveChannelConnections(context.Contex
 func (UnimplementedClientsServiceServer) UnsetParentGroupFromClient(context.Context, *UnsetParentGroupFromClientReq) (*UnsetParentGroupFromClientRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnsetParentGroupFromClient not implemented")
 }
+func (UnimplementedClientsServiceServer) CreateClient(context.Context, *CreateClientReq) (*ClientRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateClient not implemented")
+}
+func (UnimplementedClientsServiceServer) UpdateClient(context.Context, *UpdateClientReq) (*ClientRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateClient not implemented")
+}
+func (UnimplementedClientsServiceServer) RemoveClient(context.Context, *RemoveClientReq) (*RemoveClientRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveClient not implemented")
+}
+func (UnimplementedClientsServiceServer) ListClients(context.Context, *ListClientsReq) (*ListClientsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClients not implemented")
+}
 func (UnimplementedClientsServiceServer) mustEmbedUnimplementedClientsServiceServer() {}
 func (UnimplementedClientsServiceServer) testEmbeddedByValue()                        {}
 
@@ -320,6 +384,78 @@ func _ClientsService_UnsetParentGroupFromClient_Handler(srv interface{}, ctx con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ClientsService_CreateClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateClientReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientsServiceServer).CreateClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientsService_CreateClient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientsServiceServer).CreateClient(ctx, req.(*CreateClientReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientsService_UpdateClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateClientReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientsServiceServer).UpdateClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientsService_UpdateClient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientsServiceServer).UpdateClient(ctx, req.(*UpdateClientReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientsService_RemoveClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveClientReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientsServiceServer).RemoveClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientsService_RemoveClient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientsServiceServer).RemoveClient(ctx, req.(*RemoveClientReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientsService_ListClients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClientsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientsServiceServer).ListClients(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientsService_ListClients_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientsServiceServer).ListClients(ctx, req.(*ListClientsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ClientsService_ServiceDesc is the grpc.ServiceDesc for ClientsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -355,6 +491,22 @@ var ClientsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UnsetParentGroupFromClient",
 			Handler:    _ClientsService_UnsetParentGroupFromClient_Handler,
 		},
+		{
+			MethodName: "CreateClient",
+			Handler:    _ClientsService_CreateClient_Handler,
+		},
+		{
+			MethodName: "UpdateClient",
+			Handler:    _ClientsService_UpdateClient_Handler,
+		},
+		{
+			MethodName: "RemoveClient",
+			Handler:    _ClientsService_RemoveClient_Handler,
+		},
+		{
+			MethodName: "ListClients",
+			Handler:    _ClientsService_ListClients_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "clients/v1/clients.proto",
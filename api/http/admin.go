@@ -0,0 +1,42 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"net/http"
+
+	smqauthn "github.com/absmach/supermq/pkg/authn"
+	smqauthz "github.com/absmach/supermq/pkg/authz"
+	svcerr "github.com/absmach/supermq/pkg/errors/service"
+	"github.com/absmach/supermq/pkg/policies"
+)
+
+// AuthorizeAdminMiddleware rejects requests whose session user is not a
+// platform administrator. It guards operational endpoints such as the
+// read-only toggle and must be chained after AuthenticateMiddleware,
+// which stores the session under SessionKey.
+func AuthorizeAdminMiddleware(authz smqauthz.Authorization) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			session, ok := r.Context().Value(SessionKey).(smqauthn.Session)
+			if !ok {
+				EncodeError(r.Context(), svcerr.ErrAuthentication, w)
+				return
+			}
+			req := smqauthz.PolicyReq{
+				SubjectType: policies.UserType,
+				SubjectKind: policies.UsersKind,
+				Subject:     session.UserID,
+				Permission:  policies.AdminPermission,
+				ObjectType:  policies.PlatformType,
+				Object:      policies.SuperMQObject,
+			}
+			if err := authz.Authorize(r.Context(), req); err != nil {
+				EncodeError(r.Context(), svcerr.ErrAuthorization, w)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
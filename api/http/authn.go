@@ -4,11 +4,10 @@
 package http
 
 import (
-	"context"
 	"net/http"
 
-	apiutil "github.com/absmach/supermq/api/http/util"
 	smqauthn "github.com/absmach/supermq/pkg/authn"
+	pgclient "github.com/absmach/supermq/pkg/postgres"
 	"github.com/go-chi/chi/v5"
 )
 
@@ -16,34 +15,41 @@ type sessionKeyType string
 
 const SessionKey = sessionKeyType("session")
 
+// AuthenticateMiddleware wraps the shared authentication middleware so that
+// the session is stored under SessionKey and errors are encoded as regular
+// API errors.
 func AuthenticateMiddleware(authn smqauthn.Authentication, domainCheck bool) func(http.Handler) http.Handler {
+	opts := []smqauthn.MiddlewareOption{
+		smqauthn.WithContextKey(SessionKey),
+		smqauthn.WithErrorEncoder(EncodeError),
+	}
+	if domainCheck {
+		opts = append(opts, smqauthn.WithDomainResolver(func(r *http.Request) string {
+			return chi.URLParam(r, "domainID")
+		}))
+	}
+
+	base := smqauthn.Middleware(authn, opts...)
 	return func(next http.Handler) http.Handler {
-		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			token := apiutil.ExtractBearerToken(r)
-			if token == "" {
-				EncodeError(r.Context(), apiutil.ErrBearerToken, w)
-				return
-			}
+		return base(sessionContext(next))
+	}
+}
 
-			resp, err := authn.Authenticate(r.Context(), token)
-			if err != nil {
-				EncodeError(r.Context(), err, w)
-				return
+// sessionContext propagates the authenticated session to the storage
+// layer, where it scopes the row-level security policies.
+func sessionContext(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		if session, ok := ctx.Value(SessionKey).(smqauthn.Session); ok {
+			if session.UserID != "" {
+				ctx = pgclient.WithUser(ctx, session.UserID)
 			}
-
-			if domainCheck {
-				domain := chi.URLParam(r, "domainID")
-				if domain == "" {
-					EncodeError(r.Context(), apiutil.ErrMissingDomainID, w)
-					return
-				}
-				resp.DomainID = domain
-				resp.DomainUserID = domain + "_" + resp.UserID
+			if session.DomainID != "" {
+				ctx = pgclient.WithDomain(ctx, session.DomainID)
+				ctx = pgclient.WithTenant(ctx, session.DomainID)
 			}
-
-			ctx := context.WithValue(r.Context(), SessionKey, resp)
-
-			next.ServeHTTP(w, r.WithContext(ctx))
-		})
-	}
+			r = r.WithContext(ctx)
+		}
+		next.ServeHTTP(w, r)
+	})
 }
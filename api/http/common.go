@@ -7,6 +7,8 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/absmach/supermq"
 	apiutil "github.com/absmach/supermq/api/http/util"
@@ -14,6 +16,7 @@ import (
 	"github.com/absmach/supermq/clients"
 	"github.com/absmach/supermq/groups"
 	"github.com/absmach/supermq/pkg/errors"
+	repoerr "github.com/absmach/supermq/pkg/errors/repository"
 	svcerr "github.com/absmach/supermq/pkg/errors/service"
 	"github.com/absmach/supermq/users"
 	"github.com/gofrs/uuid/v5"
@@ -28,6 +31,7 @@ const (
 	OrderKey         = "order"
 	LimitKey         = "limit"
 	MetadataKey      = "metadata"
+	LabelSelectorKey = "label_selector"
 	ParentKey        = "parent_id"
 	OwnerKey         = "owner_id"
 	ClientKey        = "client"
@@ -78,6 +82,9 @@ const (
 	// ContentType represents JSON content type.
 	ContentType = "application/json"
 
+	// ContentTypeMergePatch represents JSON merge-patch content type.
+	ContentTypeMergePatch = "application/merge-patch+json"
+
 	// MaxNameSize limits name size to prevent making them too complex.
 	MaxLimitSize = 100
 	MaxNameSize  = 1024
@@ -98,8 +105,56 @@ func ValidateUUID(extID string) (err error) {
 	return nil
 }
 
+// MergeMetadata applies a JSON merge-patch to entity metadata following
+// RFC 7396 semantics: patch keys overwrite target keys, null values remove
+// them, and nested objects are merged recursively.
+func MergeMetadata(target, patch map[string]interface{}) map[string]interface{} {
+	merged := make(map[string]interface{}, len(target))
+	for k, v := range target {
+		merged[k] = v
+	}
+	for k, v := range patch {
+		if v == nil {
+			delete(merged, k)
+			continue
+		}
+		if pm, ok := v.(map[string]interface{}); ok {
+			if tm, ok := merged[k].(map[string]interface{}); ok {
+				merged[k] = MergeMetadata(tm, pm)
+				continue
+			}
+		}
+		merged[k] = v
+	}
+	return merged
+}
+
+// LastModified returns the latest of the given times formatted for the
+// Last-Modified response header, or an empty string when none is set. Listing
+// responses use it so that pollers sending If-Modified-Since can skip
+// unchanged pages.
+func LastModified(times ...time.Time) string {
+	var latest time.Time
+	for _, t := range times {
+		if t.After(latest) {
+			latest = t
+		}
+	}
+	if latest.IsZero() {
+		return ""
+	}
+
+	return latest.UTC().Format(http.TimeFormat)
+}
+
 // EncodeResponse encodes successful response.
-func EncodeResponse(_ context.Context, w http.ResponseWriter, response interface{}) error {
+func EncodeResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	if sr, ok := response.(Streamable); ok {
+		if accept, _ := ctx.Value(acceptKey).(string); strings.Contains(accept, ContentTypeNDJSON) {
+			return encodeNDJSON(w, sr)
+		}
+	}
+
 	if ar, ok := response.(supermq.Response); ok {
 		for k, v := range ar.Headers() {
 			w.Header().Set(k, v)
@@ -123,19 +178,25 @@ func EncodeError(_ context.Context, err error, w http.ResponseWriter) {
 	}
 
 	w.Header().Set("Content-Type", ContentType)
+	status := http.StatusInternalServerError
 	switch {
 	case errors.Contains(err, svcerr.ErrAuthorization),
 		errors.Contains(err, svcerr.ErrDomainAuthorization),
 		errors.Contains(err, svcerr.ErrUnauthorizedPAT):
 		err = unwrap(err)
-		w.WriteHeader(http.StatusForbidden)
+		status = http.StatusForbidden
 
 	case errors.Contains(err, svcerr.ErrAuthentication),
 		errors.Contains(err, apiutil.ErrBearerToken),
 		errors.Contains(err, svcerr.ErrLogin),
 		errors.Contains(err, apiutil.ErrUnsupportedTokenType):
 		err = unwrap(err)
-		w.WriteHeader(http.StatusUnauthorized)
+		status = http.StatusUnauthorized
+
+	case errors.Contains(err, apiutil.ErrBodyTooLarge):
+		err = unwrap(err)
+		status = http.StatusRequestEntityTooLarge
+
 	case errors.Contains(err, svcerr.ErrMalformedEntity),
 		errors.Contains(err, apiutil.ErrMalformedPolicy),
 		errors.Contains(err, apiutil.ErrMissingSecret),
@@ -156,6 +217,7 @@ func EncodeError(_ context.Context, err error, w http.ResponseWriter) {
 		errors.Contains(err, apiutil.ErrNameSize),
 		errors.Contains(err, apiutil.ErrInvalidIDFormat),
 		errors.Contains(err, apiutil.ErrInvalidQueryParams),
+		errors.Contains(err, apiutil.ErrInvalidIfMatchHeader),
 		errors.Contains(err, apiutil.ErrMissingRelation),
 		errors.Contains(err, apiutil.ErrValidation),
 		errors.Contains(err, apiutil.ErrMissingPass),
@@ -198,42 +260,50 @@ func EncodeError(_ context.Context, err error, w http.ResponseWriter) {
 		errors.Contains(err, apiutil.ErrMissingPolicyEntityType),
 		errors.Contains(err, apiutil.ErrMissingRoleMembers):
 		err = unwrap(err)
-		w.WriteHeader(http.StatusBadRequest)
+		status = http.StatusBadRequest
+
+	case errors.Contains(err, repoerr.ErrVersionConflict):
+		err = unwrap(err)
+		status = http.StatusPreconditionFailed
 
 	case errors.Contains(err, svcerr.ErrCreateEntity),
 		errors.Contains(err, svcerr.ErrUpdateEntity),
 		errors.Contains(err, svcerr.ErrRemoveEntity),
 		errors.Contains(err, svcerr.ErrEnableClient),
 		errors.Contains(err, svcerr.ErrEnableUser),
-		errors.Contains(err, svcerr.ErrDisableUser):
+		errors.Contains(err, svcerr.ErrDisableUser),
+		errors.Contains(err, svcerr.ErrQuotaExceeded):
 		err = unwrap(err)
-		w.WriteHeader(http.StatusUnprocessableEntity)
+		status = http.StatusUnprocessableEntity
 
 	case errors.Contains(err, svcerr.ErrNotFound):
 		err = unwrap(err)
-		w.WriteHeader(http.StatusNotFound)
+		status = http.StatusNotFound
 
 	case errors.Contains(err, errors.ErrStatusAlreadyAssigned),
 		errors.Contains(err, svcerr.ErrInvitationAlreadyRejected),
 		errors.Contains(err, svcerr.ErrInvitationAlreadyAccepted),
 		errors.Contains(err, svcerr.ErrConflict):
 		err = unwrap(err)
-		w.WriteHeader(http.StatusConflict)
+		status = http.StatusConflict
 
 	case errors.Contains(err, apiutil.ErrUnsupportedContentType):
 		err = unwrap(err)
-		w.WriteHeader(http.StatusUnsupportedMediaType)
-
-	default:
-		w.WriteHeader(http.StatusInternalServerError)
+		status = http.StatusUnsupportedMediaType
 	}
 
+	w.WriteHeader(status)
+
 	if wrapper != nil {
 		err = errors.Wrap(wrapper, err)
 	}
 
 	if errorVal, ok := err.(errors.Error); ok {
-		if err := json.NewEncoder(w).Encode(errorVal); err != nil {
+		code := errors.CodeOf(errorVal)
+		if code == errors.CodeInternal {
+			code = errors.CodeFromHTTPStatus(status)
+		}
+		if err := json.NewEncoder(w).Encode(errors.WithCode(code, errorVal)); err != nil {
 			w.WriteHeader(http.StatusInternalServerError)
 		}
 	}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
@@ -216,6 +217,61 @@ func TestEncodeResponse(t *testing.T) {
 	}
 }
 
+type streamableResponse struct {
+	response
+	items []interface{}
+}
+
+func (res streamableResponse) StreamItems() []interface{} {
+	return res.items
+}
+
+func TestEncodeResponseNDJSON(t *testing.T) {
+	resp := streamableResponse{
+		response: response{code: http.StatusOK},
+		items: []interface{}{
+			map[string]string{"id": "1"},
+			map[string]string{"id": "2"},
+		},
+	}
+
+	cases := []struct {
+		desc        string
+		accept      string
+		contentType string
+		body        string
+	}{
+		{
+			desc:        "ndjson accept header",
+			accept:      api.ContentTypeNDJSON,
+			contentType: api.ContentTypeNDJSON,
+			body:        `{"id":"1"}` + "\n" + `{"id":"2"}` + "\n",
+		},
+		{
+			desc:        "json accept header",
+			accept:      api.ContentType,
+			contentType: api.ContentType,
+			body:        `{"items":["1","2"]}`,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+			r.Header.Set("Accept", c.accept)
+			ctx := api.AcceptToContext(context.Background(), r)
+
+			recorder := httptest.NewRecorder()
+			err := api.EncodeResponse(ctx, recorder, resp)
+			assert.Nil(t, err)
+			assert.Equal(t, c.contentType, recorder.Header().Get("Content-Type"))
+			if c.accept == api.ContentTypeNDJSON {
+				assert.Equal(t, c.body, recorder.Body.String())
+			}
+		})
+	}
+}
+
 func TestEncodeError(t *testing.T) {
 	cases := []struct {
 		desc string
@@ -0,0 +1,191 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+)
+
+const (
+	redactedValue  = "*****"
+	defMaxBodySize = 4096
+)
+
+// defRedactedFields are the body fields redacted by default, covering
+// credentials, tokens and identities.
+var defRedactedFields = []string{
+	"secret",
+	"password",
+	"token",
+	"access_token",
+	"refresh_token",
+	"api_key",
+	"identity",
+	"email",
+}
+
+type bodyLoggingConfig struct {
+	fields  map[string]struct{}
+	routes  []string
+	maxBody int
+}
+
+// BodyLoggingOption configures the body logging middleware.
+type BodyLoggingOption func(*bodyLoggingConfig)
+
+// WithRedactedFields replaces the default set of body fields that are
+// redacted before logging.
+func WithRedactedFields(fields ...string) BodyLoggingOption {
+	return func(cfg *bodyLoggingConfig) {
+		cfg.fields = make(map[string]struct{}, len(fields))
+		for _, f := range fields {
+			cfg.fields[strings.ToLower(f)] = struct{}{}
+		}
+	}
+}
+
+// WithLoggedRoutes limits body logging to requests whose path matches one of
+// the given prefixes. Without it all routes are logged.
+func WithLoggedRoutes(prefixes ...string) BodyLoggingOption {
+	return func(cfg *bodyLoggingConfig) {
+		cfg.routes = prefixes
+	}
+}
+
+// WithMaxBodySize limits how many bytes of each body are logged.
+func WithMaxBodySize(n int) BodyLoggingOption {
+	return func(cfg *bodyLoggingConfig) {
+		cfg.maxBody = n
+	}
+}
+
+// BodyLoggingMiddleware logs request and response bodies at debug level with
+// secrets, tokens and identities redacted, so that payloads can be inspected
+// in production without leaking credentials into logs. It is a no-op unless
+// the logger has debug level enabled.
+func BodyLoggingMiddleware(logger *slog.Logger, opts ...BodyLoggingOption) func(http.Handler) http.Handler {
+	cfg := bodyLoggingConfig{
+		maxBody: defMaxBodySize,
+	}
+	WithRedactedFields(defRedactedFields...)(&cfg)
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !logger.Enabled(r.Context(), slog.LevelDebug) || !cfg.matches(r.URL.Path) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			reqBody := cfg.capture(r)
+			rec := &recordingResponseWriter{ResponseWriter: w, status: http.StatusOK, maxBody: cfg.maxBody}
+
+			next.ServeHTTP(rec, r)
+
+			logger.DebugContext(r.Context(), "HTTP request",
+				slog.String("method", r.Method),
+				slog.String("path", r.URL.Path),
+				slog.Int("status", rec.status),
+				slog.String("request_body", cfg.redact(reqBody)),
+				slog.String("response_body", cfg.redact(rec.body.Bytes())),
+			)
+		})
+	}
+}
+
+func (cfg bodyLoggingConfig) matches(path string) bool {
+	if len(cfg.routes) == 0 {
+		return true
+	}
+	for _, prefix := range cfg.routes {
+		if strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// capture reads up to maxBody bytes of the request body and replaces it so
+// that the handler can still consume it in full.
+func (cfg bodyLoggingConfig) capture(r *http.Request) []byte {
+	if r.Body == nil {
+		return nil
+	}
+	head := make([]byte, cfg.maxBody)
+	n, _ := io.ReadFull(r.Body, head)
+	head = head[:n]
+	r.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(head), r.Body), r.Body}
+	return head
+}
+
+// redact renders the body with sensitive field values replaced. Bodies that
+// are not valid JSON are never logged verbatim.
+func (cfg bodyLoggingConfig) redact(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	var val interface{}
+	if err := json.Unmarshal(body, &val); err != nil {
+		return "<non-JSON body omitted>"
+	}
+	data, err := json.Marshal(cfg.redactValue(val))
+	if err != nil {
+		return "<non-JSON body omitted>"
+	}
+	return string(data)
+}
+
+func (cfg bodyLoggingConfig) redactValue(val interface{}) interface{} {
+	switch v := val.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			if _, ok := cfg.fields[strings.ToLower(k)]; ok {
+				v[k] = redactedValue
+				continue
+			}
+			v[k] = cfg.redactValue(e)
+		}
+		return v
+	case []interface{}:
+		for i, e := range v {
+			v[i] = cfg.redactValue(e)
+		}
+		return v
+	default:
+		return val
+	}
+}
+
+type recordingResponseWriter struct {
+	http.ResponseWriter
+	status  int
+	body    bytes.Buffer
+	maxBody int
+}
+
+func (w *recordingResponseWriter) WriteHeader(status int) {
+	w.status = status
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *recordingResponseWriter) Write(data []byte) (int, error) {
+	if remaining := w.maxBody - w.body.Len(); remaining > 0 {
+		if len(data) > remaining {
+			w.body.Write(data[:remaining])
+		} else {
+			w.body.Write(data)
+		}
+	}
+	return w.ResponseWriter.Write(data)
+}
@@ -0,0 +1,91 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package http_test
+
+import (
+	"bytes"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	api "github.com/absmach/supermq/api/http"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBodyLoggingMiddleware(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.Contains(t, string(body), "top-secret", "handler must see the original body")
+		w.Write([]byte(`{"id": "123", "credentials": {"secret": "top-secret"}}`))
+	})
+
+	cases := []struct {
+		desc     string
+		level    slog.Level
+		opts     []api.BodyLoggingOption
+		path     string
+		contains []string
+		absent   []string
+	}{
+		{
+			desc:     "redacts nested secrets",
+			level:    slog.LevelDebug,
+			path:     "/clients",
+			contains: []string{`\"secret\":\"*****\"`, `\"name\":\"client\"`},
+			absent:   []string{"top-secret"},
+		},
+		{
+			desc:   "no-op above debug level",
+			level:  slog.LevelInfo,
+			path:   "/clients",
+			absent: []string{"HTTP request"},
+		},
+		{
+			desc:   "skips unlisted routes",
+			level:  slog.LevelDebug,
+			opts:   []api.BodyLoggingOption{api.WithLoggedRoutes("/channels")},
+			path:   "/clients",
+			absent: []string{"HTTP request"},
+		},
+		{
+			desc:     "logs listed routes",
+			level:    slog.LevelDebug,
+			opts:     []api.BodyLoggingOption{api.WithLoggedRoutes("/clients")},
+			path:     "/clients",
+			contains: []string{"HTTP request"},
+			absent:   []string{"top-secret"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			out := new(bytes.Buffer)
+			logger := slog.New(slog.NewTextHandler(out, &slog.HandlerOptions{Level: tc.level}))
+
+			srv := httptest.NewServer(api.BodyLoggingMiddleware(logger, tc.opts...)(handler))
+			defer srv.Close()
+
+			body := `{"name": "client", "credentials": {"secret": "top-secret"}}`
+			resp, err := http.Post(srv.URL+tc.path, "application/json", strings.NewReader(body))
+			require.NoError(t, err)
+			data, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			resp.Body.Close()
+			assert.Contains(t, string(data), "top-secret", "response must reach the client unredacted")
+
+			logged := out.String()
+			for _, s := range tc.contains {
+				assert.Contains(t, logged, s)
+			}
+			for _, s := range tc.absent {
+				assert.NotContains(t, logged, s)
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package http_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	api "github.com/absmach/supermq/api/http"
+	apiutil "github.com/absmach/supermq/api/http/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadOnlyMiddleware(t *testing.T) {
+	cases := []struct {
+		desc     string
+		enabled  bool
+		method   string
+		expected int
+	}{
+		{
+			desc:     "mutating request with read-only mode off",
+			enabled:  false,
+			method:   http.MethodPost,
+			expected: http.StatusOK,
+		},
+		{
+			desc:     "mutating request with read-only mode on",
+			enabled:  true,
+			method:   http.MethodPost,
+			expected: http.StatusServiceUnavailable,
+		},
+		{
+			desc:     "read request with read-only mode on",
+			enabled:  true,
+			method:   http.MethodGet,
+			expected: http.StatusOK,
+		},
+		{
+			desc:     "delete request with read-only mode on",
+			enabled:  true,
+			method:   http.MethodDelete,
+			expected: http.StatusServiceUnavailable,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			ro := api.NewReadOnly(tc.enabled)
+			handler := ro.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			}))
+
+			req := httptest.NewRequest(tc.method, "/clients", nil)
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			assert.Equal(t, tc.expected, rec.Code)
+
+			if tc.expected == http.StatusServiceUnavailable {
+				var res apiutil.ErrorRes
+				err := json.NewDecoder(rec.Body).Decode(&res)
+				require.NoError(t, err)
+				assert.Equal(t, "read_only", res.Err)
+			}
+		})
+	}
+}
+
+func TestReadOnlyHandler(t *testing.T) {
+	ro := api.NewReadOnly(false)
+	srv := httptest.NewServer(ro.Handler())
+	defer srv.Close()
+
+	res, err := http.Get(srv.URL)
+	require.NoError(t, err)
+	var state map[string]bool
+	err = json.NewDecoder(res.Body).Decode(&state)
+	res.Body.Close()
+	require.NoError(t, err)
+	assert.False(t, state["read_only"])
+
+	req, err := http.NewRequest(http.MethodPut, srv.URL, strings.NewReader(`{"read_only": true}`))
+	require.NoError(t, err)
+	res, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	err = json.NewDecoder(res.Body).Decode(&state)
+	res.Body.Close()
+	require.NoError(t, err)
+	assert.True(t, state["read_only"])
+	assert.True(t, ro.Enabled())
+
+	req, err = http.NewRequest(http.MethodPut, srv.URL, strings.NewReader(`not json`))
+	require.NoError(t, err)
+	res, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	res.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+}
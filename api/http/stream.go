@@ -0,0 +1,53 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// ContentTypeNDJSON represents newline-delimited JSON content type.
+const ContentTypeNDJSON = "application/x-ndjson"
+
+type acceptKeyType string
+
+// acceptKey is the context key under which AcceptToContext stores the Accept
+// header of the request.
+const acceptKey = acceptKeyType("accept")
+
+// Streamable is implemented by list responses that can be written as an
+// NDJSON stream, one item per line, instead of a single page document.
+type Streamable interface {
+	StreamItems() []interface{}
+}
+
+// AcceptToContext is a go-kit request function that stores the Accept header
+// of the request in the context, so that EncodeResponse can negotiate the
+// response content type.
+func AcceptToContext(ctx context.Context, r *http.Request) context.Context {
+	return context.WithValue(ctx, acceptKey, r.Header.Get("Accept"))
+}
+
+// encodeNDJSON writes the response items one JSON document per line, flushing
+// after every item so that large result sets are streamed to the client
+// instead of buffered.
+func encodeNDJSON(w http.ResponseWriter, response Streamable) error {
+	w.Header().Set("Content-Type", ContentTypeNDJSON)
+	w.WriteHeader(http.StatusOK)
+
+	flusher, _ := w.(http.Flusher)
+	enc := json.NewEncoder(w)
+	for _, item := range response.StreamItems() {
+		if err := enc.Encode(item); err != nil {
+			return err
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+	}
+
+	return nil
+}
@@ -90,6 +90,9 @@ var (
 	// ErrMissingRoleMembers indicates that role members are empty.
 	ErrMissingRoleMembers = errors.New("empty role members")
 
+	// ErrUnknownRoleTemplate indicates that the referenced role template does not exist.
+	ErrUnknownRoleTemplate = errors.New("unknown role template")
+
 	// ErrMalformedPolicy indicates that policies are malformed.
 	ErrMalformedPolicy = errors.New("malformed policy")
 
@@ -150,6 +153,9 @@ var (
 	// ErrMissingRelation indicates missing relation.
 	ErrMissingRelation = errors.New("missing relation")
 
+	// ErrMissingTag indicates missing tag.
+	ErrMissingTag = errors.New("missing tag")
+
 	// ErrInvalidRelation indicates an invalid relation.
 	ErrInvalidRelation = errors.New("invalid relation")
 
@@ -183,12 +189,20 @@ var (
 	// ErrInvalidQueryParams indicates invalid query parameters.
 	ErrInvalidQueryParams = errors.New("invalid query parameters")
 
+	// ErrInvalidIfMatchHeader indicates an invalid If-Match header value.
+	ErrInvalidIfMatchHeader = errors.New("invalid if-match header")
+
 	// ErrInvalidVisibilityType indicates invalid visibility type.
 	ErrInvalidVisibilityType = errors.New("invalid visibility type")
 
 	// ErrUnsupportedContentType indicates unacceptable or lack of Content-Type.
 	ErrUnsupportedContentType = errors.New("unsupported content type")
 
+	// ErrBodyTooLarge indicates that the request body exceeds the configured
+	// size limit. The message matches the error of http.MaxBytesReader, which
+	// caps request bodies in the HTTP server.
+	ErrBodyTooLarge = errors.New("http: request body too large")
+
 	// ErrRollbackTx indicates failed to rollback transaction.
 	ErrRollbackTx = errors.New("failed to rollback transaction")
 
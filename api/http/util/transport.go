@@ -9,6 +9,7 @@ import (
 	"log/slog"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/absmach/supermq/pkg/errors"
 	kithttp "github.com/go-kit/kit/transport/http"
@@ -77,6 +78,27 @@ func ReadBoolQuery(r *http.Request, key string, def bool) (bool, error) {
 	return b, nil
 }
 
+// ReadIfMatchHeader reads the entity version from the If-Match request
+// header. A missing header returns zero, meaning unconditional update.
+func ReadIfMatchHeader(r *http.Request) (uint64, error) {
+	val := r.Header.Get("If-Match")
+	if val == "" || val == "*" {
+		return 0, nil
+	}
+
+	v, err := strconv.ParseUint(strings.Trim(val, `"`), 10, 64)
+	if err != nil {
+		return 0, errors.Wrap(ErrInvalidIfMatchHeader, err)
+	}
+
+	return v, nil
+}
+
+// ETag formats an entity version as an ETag header value.
+func ETag(version uint64) string {
+	return `"` + strconv.FormatUint(version, 10) + `"`
+}
+
 type number interface {
 	int64 | float64 | uint16 | uint64
 }
@@ -0,0 +1,142 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	// VersionV1 is the current stable API version.
+	VersionV1 = "v1"
+
+	// VersionV2 is the next API version, reserved for breaking response
+	// changes.
+	VersionV2 = "v2"
+
+	// VersionHeader carries the API version a response was served with.
+	VersionHeader = "X-API-Version"
+
+	// versionAcceptPrefix is the vendor media type prefix used to
+	// negotiate the API version through the Accept header, e.g.
+	// application/vnd.supermq.v2+json.
+	versionAcceptPrefix = "application/vnd.supermq."
+)
+
+type versionKeyType string
+
+// VersionKey is the context key under which the negotiated API version is
+// stored.
+const VersionKey = versionKeyType("version")
+
+type versionConfig struct {
+	handlers map[string]http.Handler
+	sunsets  map[string]time.Time
+}
+
+// VersionOption configures the versioned handler.
+type VersionOption func(*versionConfig)
+
+// WithVersion mounts a handler serving the given API version.
+func WithVersion(version string, h http.Handler) VersionOption {
+	return func(cfg *versionConfig) {
+		cfg.handlers[version] = h
+	}
+}
+
+// WithDeprecation marks an API version as deprecated with the given sunset
+// time, announced to clients through Deprecation and Sunset headers.
+func WithDeprecation(version string, sunset time.Time) VersionOption {
+	return func(cfg *versionConfig) {
+		cfg.sunsets[version] = sunset
+	}
+}
+
+// VersionHandler dispatches requests to per-version handlers. The version is
+// taken from the path prefix (/v1/..., /v2/...) or, for unprefixed paths,
+// from the vendor media type in the Accept header, defaulting to v1 so that
+// existing unversioned routes keep working. The negotiated version is
+// stripped from the path, stored in the request context and reported in the
+// X-API-Version response header.
+func VersionHandler(v1 http.Handler, opts ...VersionOption) http.Handler {
+	cfg := versionConfig{
+		handlers: map[string]http.Handler{VersionV1: v1},
+		sunsets:  map[string]time.Time{},
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		version, path := splitVersion(r.URL.Path)
+		if version == "" {
+			version = acceptVersion(r.Header.Get("Accept"))
+		}
+		if version == "" {
+			version = VersionV1
+		}
+
+		h, ok := cfg.handlers[version]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+
+		w.Header().Set(VersionHeader, version)
+		if sunset, ok := cfg.sunsets[version]; ok {
+			w.Header().Set("Deprecation", "true")
+			w.Header().Set("Sunset", sunset.UTC().Format(http.TimeFormat))
+		}
+
+		r2 := r.Clone(context.WithValue(r.Context(), VersionKey, version))
+		r2.URL.Path = path
+
+		h.ServeHTTP(w, r2)
+	})
+}
+
+// RequestVersion returns the API version negotiated for the request,
+// defaulting to v1 when the request did not pass through VersionHandler.
+func RequestVersion(ctx context.Context) string {
+	if version, ok := ctx.Value(VersionKey).(string); ok {
+		return version
+	}
+	return VersionV1
+}
+
+// splitVersion extracts a version prefix from the path, returning the
+// version and the remaining path, or an empty version for unprefixed paths.
+func splitVersion(path string) (string, string) {
+	trimmed := strings.TrimPrefix(path, "/")
+	version, rest, _ := strings.Cut(trimmed, "/")
+	if len(version) < 2 || version[0] != 'v' {
+		return "", path
+	}
+	for _, c := range version[1:] {
+		if c < '0' || c > '9' {
+			return "", path
+		}
+	}
+	return version, "/" + rest
+}
+
+// acceptVersion extracts the version from a vendor media type in the Accept
+// header, e.g. application/vnd.supermq.v2+json.
+func acceptVersion(accept string) string {
+	for _, mt := range strings.Split(accept, ",") {
+		mt = strings.TrimSpace(mt)
+		if !strings.HasPrefix(mt, versionAcceptPrefix) {
+			continue
+		}
+		version := strings.TrimPrefix(mt, versionAcceptPrefix)
+		if i := strings.IndexAny(version, "+;"); i != -1 {
+			version = version[:i]
+		}
+		return version
+	}
+	return ""
+}
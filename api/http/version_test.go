@@ -0,0 +1,108 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package http_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	api "github.com/absmach/supermq/api/http"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersionHandler(t *testing.T) {
+	v1 := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("v1:" + r.URL.Path))
+	})
+	v2 := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("v2:" + r.URL.Path))
+	})
+	sunset := time.Date(2027, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	handler := api.VersionHandler(v1,
+		api.WithVersion(api.VersionV2, v2),
+		api.WithDeprecation(api.VersionV1, sunset),
+	)
+	srv := httptest.NewServer(handler)
+	defer srv.Close()
+
+	cases := []struct {
+		desc       string
+		path       string
+		accept     string
+		status     int
+		body       string
+		version    string
+		deprecated bool
+	}{
+		{
+			desc:       "unprefixed path defaults to v1",
+			path:       "/clients",
+			status:     http.StatusOK,
+			body:       "v1:/clients",
+			version:    "v1",
+			deprecated: true,
+		},
+		{
+			desc:       "v1 path prefix",
+			path:       "/v1/clients",
+			status:     http.StatusOK,
+			body:       "v1:/clients",
+			version:    "v1",
+			deprecated: true,
+		},
+		{
+			desc:    "v2 path prefix",
+			path:    "/v2/clients",
+			status:  http.StatusOK,
+			body:    "v2:/clients",
+			version: "v2",
+		},
+		{
+			desc:    "v2 via accept header",
+			path:    "/clients",
+			accept:  "application/vnd.supermq.v2+json",
+			status:  http.StatusOK,
+			body:    "v2:/clients",
+			version: "v2",
+		},
+		{
+			desc:   "unknown version",
+			path:   "/v3/clients",
+			status: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, srv.URL+tc.path, nil)
+			require.NoError(t, err)
+			if tc.accept != "" {
+				req.Header.Set("Accept", tc.accept)
+			}
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			assert.Equal(t, tc.status, resp.StatusCode)
+			if tc.status != http.StatusOK {
+				return
+			}
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			assert.Equal(t, tc.body, string(body))
+			assert.Equal(t, tc.version, resp.Header.Get(api.VersionHeader))
+			if tc.deprecated {
+				assert.Equal(t, "true", resp.Header.Get("Deprecation"))
+				assert.Equal(t, sunset.Format(http.TimeFormat), resp.Header.Get("Sunset"))
+			} else {
+				assert.Empty(t, resp.Header.Get("Deprecation"))
+			}
+		})
+	}
+}
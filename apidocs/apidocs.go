@@ -0,0 +1,54 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package apidocs embeds the OpenAPI specifications of SuperMQ services and
+// serves them over HTTP, so every service can expose its own versioned spec.
+package apidocs
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/absmach/supermq"
+	"gopkg.in/yaml.v3"
+)
+
+//go:embed openapi/*.yml
+var specs embed.FS
+
+const contentTypeJSON = "application/json"
+
+// Handler returns an HTTP handler that serves the embedded OpenAPI 3
+// specification of the named service as JSON, with the info.version field
+// set to the running service version.
+func Handler(svcName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		data, err := specs.ReadFile(fmt.Sprintf("openapi/%s.yml", svcName))
+		if err != nil {
+			http.NotFound(w, r)
+			return
+		}
+
+		var spec map[string]interface{}
+		if err := yaml.Unmarshal(data, &spec); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		if info, ok := spec["info"].(map[string]interface{}); ok {
+			info["version"] = supermq.Version
+		}
+
+		body, err := json.Marshal(spec)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", contentTypeJSON)
+		if _, err := w.Write(body); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"context"
+	"time"
+
+	grpcAuthV1 "github.com/absmach/supermq/api/grpc/auth/v1"
+	"github.com/absmach/supermq/pkg/prometheus"
+	"github.com/go-kit/kit/metrics"
+	"google.golang.org/grpc"
+)
+
+var _ grpcAuthV1.AuthServiceClient = (*metricsClient)(nil)
+
+type metricsClient struct {
+	counter metrics.Counter
+	latency metrics.Histogram
+	client  grpcAuthV1.AuthServiceClient
+}
+
+// NewMetricsClient returns an auth service client that records request count
+// and latency per RPC, so that operators of the calling service can see when
+// the auth service becomes the bottleneck.
+func NewMetricsClient(client grpcAuthV1.AuthServiceClient, counter metrics.Counter, latency metrics.Histogram) grpcAuthV1.AuthServiceClient {
+	return &metricsClient{
+		counter: counter,
+		latency: latency,
+		client:  client,
+	}
+}
+
+func (mc *metricsClient) Authorize(ctx context.Context, in *grpcAuthV1.AuthZReq, opts ...grpc.CallOption) (res *grpcAuthV1.AuthZRes, err error) {
+	defer func(begin time.Time) {
+		mc.counter.With("method", "authorize", "error", prometheus.ErrorClass(err)).Add(1)
+		mc.latency.With("method", "authorize").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mc.client.Authorize(ctx, in, opts...)
+}
+
+func (mc *metricsClient) AuthorizePAT(ctx context.Context, in *grpcAuthV1.AuthZPatReq, opts ...grpc.CallOption) (res *grpcAuthV1.AuthZRes, err error) {
+	defer func(begin time.Time) {
+		mc.counter.With("method", "authorize_pat", "error", prometheus.ErrorClass(err)).Add(1)
+		mc.latency.With("method", "authorize_pat").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mc.client.AuthorizePAT(ctx, in, opts...)
+}
+
+func (mc *metricsClient) Authenticate(ctx context.Context, in *grpcAuthV1.AuthNReq, opts ...grpc.CallOption) (res *grpcAuthV1.AuthNRes, err error) {
+	defer func(begin time.Time) {
+		mc.counter.With("method", "authenticate", "error", prometheus.ErrorClass(err)).Add(1)
+		mc.latency.With("method", "authenticate").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mc.client.Authenticate(ctx, in, opts...)
+}
+
+func (mc *metricsClient) AuthenticatePAT(ctx context.Context, in *grpcAuthV1.AuthNReq, opts ...grpc.CallOption) (res *grpcAuthV1.AuthNRes, err error) {
+	defer func(begin time.Time) {
+		mc.counter.With("method", "authenticate_pat", "error", prometheus.ErrorClass(err)).Add(1)
+		mc.latency.With("method", "authenticate_pat").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mc.client.AuthenticatePAT(ctx, in, opts...)
+}
@@ -42,6 +42,9 @@ func EncodeError(err error) error {
 	case errors.Contains(err, svcerr.ErrConflict):
 		return status.Error(codes.AlreadyExists, err.Error())
 	default:
+		if code := errors.CodeOf(err); code != errors.CodeInternal {
+			return status.Error(code.GRPCStatus(), err.Error())
+		}
 		return status.Error(codes.Internal, err.Error())
 	}
 }
@@ -17,7 +17,7 @@ func createPATEndpoint(svc auth.Service) endpoint.Endpoint {
 			return nil, err
 		}
 
-		pat, err := svc.CreatePAT(ctx, req.token, req.Name, req.Description, req.Duration, req.Scope)
+		pat, err := svc.CreatePAT(ctx, req.token, req.Name, req.Description, req.Duration, req.UsageLimit, req.Scope)
 		if err != nil {
 			return nil, err
 		}
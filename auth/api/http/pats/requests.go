@@ -17,6 +17,7 @@ type createPatReq struct {
 	Name        string        `json:"name,omitempty"`
 	Description string        `json:"description,omitempty"`
 	Duration    time.Duration `json:"duration,omitempty"`
+	UsageLimit  uint64        `json:"usage_limit,omitempty"`
 	Scope       auth.Scope    `json:"scope,omitempty"`
 }
 
@@ -25,6 +26,7 @@ func (cpr *createPatReq) UnmarshalJSON(data []byte) error {
 		Name        string     `json:"name,omitempty"`
 		Description string     `json:"description,omitempty"`
 		Duration    string     `json:"duration,omitempty"`
+		UsageLimit  uint64     `json:"usage_limit,omitempty"`
 		Scope       auth.Scope `json:"scope,omitempty"`
 	}
 	if err := json.Unmarshal(data, &temp); err != nil {
@@ -37,6 +39,7 @@ func (cpr *createPatReq) UnmarshalJSON(data []byte) error {
 	cpr.Name = temp.Name
 	cpr.Description = temp.Description
 	cpr.Duration = duration
+	cpr.UsageLimit = temp.UsageLimit
 	cpr.Scope = temp.Scope
 	return nil
 }
@@ -7,6 +7,7 @@ import (
 	"net/http"
 
 	"github.com/absmach/supermq"
+	"github.com/absmach/supermq/apidocs"
 	"github.com/absmach/supermq/auth"
 	"github.com/absmach/supermq/auth/api/http/keys"
 	"github.com/absmach/supermq/auth/api/http/pats"
@@ -22,6 +23,7 @@ func MakeHandler(svc auth.Service, logger *slog.Logger, instanceID string) http.
 	mux = pats.MakeHandler(svc, mux, logger)
 
 	mux.Get("/health", supermq.Health("auth", instanceID))
+	mux.Get("/api/openapi.json", apidocs.Handler("auth"))
 	mux.Handle("/metrics", promhttp.Handler())
 
 	return mux
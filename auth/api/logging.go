@@ -37,10 +37,10 @@ func (lm *loggingMiddleware) Issue(ctx context.Context, token string, key auth.K
 		}
 		if err != nil {
 			args = append(args, slog.Any("error", err))
-			lm.logger.Warn("Issue key failed", args...)
+			lm.logger.WarnContext(ctx, "Issue key failed", args...)
 			return
 		}
-		lm.logger.Info("Issue key completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Issue key completed successfully", args...)
 	}(time.Now())
 
 	return lm.svc.Issue(ctx, token, key)
@@ -54,10 +54,10 @@ func (lm *loggingMiddleware) Revoke(ctx context.Context, token, id string) (err
 		}
 		if err != nil {
 			args = append(args, slog.Any("error", err))
-			lm.logger.Warn("Revoke key failed", args...)
+			lm.logger.WarnContext(ctx, "Revoke key failed", args...)
 			return
 		}
-		lm.logger.Info("Revoke key completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Revoke key completed successfully", args...)
 	}(time.Now())
 
 	return lm.svc.Revoke(ctx, token, id)
@@ -71,10 +71,10 @@ func (lm *loggingMiddleware) RetrieveKey(ctx context.Context, token, id string)
 		}
 		if err != nil {
 			args = append(args, slog.Any("error", err))
-			lm.logger.Warn("Retrieve key failed", args...)
+			lm.logger.WarnContext(ctx, "Retrieve key failed", args...)
 			return
 		}
-		lm.logger.Info("Retrieve key completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Retrieve key completed successfully", args...)
 	}(time.Now())
 
 	return lm.svc.RetrieveKey(ctx, token, id)
@@ -91,10 +91,10 @@ func (lm *loggingMiddleware) Identify(ctx context.Context, token string) (id aut
 		}
 		if err != nil {
 			args = append(args, slog.Any("error", err))
-			lm.logger.Warn("Identify key failed", args...)
+			lm.logger.WarnContext(ctx, "Identify key failed", args...)
 			return
 		}
-		lm.logger.Info("Identify key completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Identify key completed successfully", args...)
 	}(time.Now())
 
 	return lm.svc.Identify(ctx, token)
@@ -117,31 +117,32 @@ func (lm *loggingMiddleware) Authorize(ctx context.Context, pr policies.Policy)
 		}
 		if err != nil {
 			args = append(args, slog.Any("error", err))
-			lm.logger.Warn("Authorize failed", args...)
+			lm.logger.WarnContext(ctx, "Authorize failed", args...)
 			return
 		}
-		lm.logger.Info("Authorize completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Authorize completed successfully", args...)
 	}(time.Now())
 	return lm.svc.Authorize(ctx, pr)
 }
 
-func (lm *loggingMiddleware) CreatePAT(ctx context.Context, token, name, description string, duration time.Duration, scope auth.Scope) (pa auth.PAT, err error) {
+func (lm *loggingMiddleware) CreatePAT(ctx context.Context, token, name, description string, duration time.Duration, usageLimit uint64, scope auth.Scope) (pa auth.PAT, err error) {
 	defer func(begin time.Time) {
 		args := []any{
 			slog.String("duration", time.Since(begin).String()),
 			slog.String("name", name),
 			slog.String("description", description),
 			slog.String("pat_duration", duration.String()),
+			slog.Uint64("usage_limit", usageLimit),
 			slog.String("scope", scope.String()),
 		}
 		if err != nil {
 			args = append(args, slog.Any("error", err))
-			lm.logger.Warn("Create PAT failed", args...)
+			lm.logger.WarnContext(ctx, "Create PAT failed", args...)
 			return
 		}
-		lm.logger.Info("Create PAT completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Create PAT completed successfully", args...)
 	}(time.Now())
-	return lm.svc.CreatePAT(ctx, token, name, description, duration, scope)
+	return lm.svc.CreatePAT(ctx, token, name, description, duration, usageLimit, scope)
 }
 
 func (lm *loggingMiddleware) UpdatePATName(ctx context.Context, token, patID, name string) (pa auth.PAT, err error) {
@@ -153,10 +154,10 @@ func (lm *loggingMiddleware) UpdatePATName(ctx context.Context, token, patID, na
 		}
 		if err != nil {
 			args = append(args, slog.Any("error", err))
-			lm.logger.Warn("Update PAT name failed", args...)
+			lm.logger.WarnContext(ctx, "Update PAT name failed", args...)
 			return
 		}
-		lm.logger.Info("Update PAT name completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Update PAT name completed successfully", args...)
 	}(time.Now())
 	return lm.svc.UpdatePATName(ctx, token, patID, name)
 }
@@ -170,10 +171,10 @@ func (lm *loggingMiddleware) UpdatePATDescription(ctx context.Context, token, pa
 		}
 		if err != nil {
 			args = append(args, slog.Any("error", err))
-			lm.logger.Warn("Update PAT description failed", args...)
+			lm.logger.WarnContext(ctx, "Update PAT description failed", args...)
 			return
 		}
-		lm.logger.Info("Update PAT description completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Update PAT description completed successfully", args...)
 	}(time.Now())
 	return lm.svc.UpdatePATDescription(ctx, token, patID, description)
 }
@@ -186,10 +187,10 @@ func (lm *loggingMiddleware) RetrievePAT(ctx context.Context, token, patID strin
 		}
 		if err != nil {
 			args = append(args, slog.Any("error", err))
-			lm.logger.Warn("Retrieve PAT  failed", args...)
+			lm.logger.WarnContext(ctx, "Retrieve PAT  failed", args...)
 			return
 		}
-		lm.logger.Info("Retrieve PAT completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Retrieve PAT completed successfully", args...)
 	}(time.Now())
 	return lm.svc.RetrievePAT(ctx, token, patID)
 }
@@ -203,10 +204,10 @@ func (lm *loggingMiddleware) ListPATS(ctx context.Context, token string, pm auth
 		}
 		if err != nil {
 			args = append(args, slog.Any("error", err))
-			lm.logger.Warn("List PATS  failed", args...)
+			lm.logger.WarnContext(ctx, "List PATS  failed", args...)
 			return
 		}
-		lm.logger.Info("List PATS completed successfully", args...)
+		lm.logger.InfoContext(ctx, "List PATS completed successfully", args...)
 	}(time.Now())
 	return lm.svc.ListPATS(ctx, token, pm)
 }
@@ -219,10 +220,10 @@ func (lm *loggingMiddleware) DeletePAT(ctx context.Context, token, patID string)
 		}
 		if err != nil {
 			args = append(args, slog.Any("error", err))
-			lm.logger.Warn("Delete PAT  failed", args...)
+			lm.logger.WarnContext(ctx, "Delete PAT  failed", args...)
 			return
 		}
-		lm.logger.Info("Delete PAT completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Delete PAT completed successfully", args...)
 	}(time.Now())
 	return lm.svc.DeletePAT(ctx, token, patID)
 }
@@ -236,10 +237,10 @@ func (lm *loggingMiddleware) ResetPATSecret(ctx context.Context, token, patID st
 		}
 		if err != nil {
 			args = append(args, slog.Any("error", err))
-			lm.logger.Warn("Reset PAT secret failed", args...)
+			lm.logger.WarnContext(ctx, "Reset PAT secret failed", args...)
 			return
 		}
-		lm.logger.Info("Reset PAT secret completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Reset PAT secret completed successfully", args...)
 	}(time.Now())
 	return lm.svc.ResetPATSecret(ctx, token, patID, duration)
 }
@@ -252,10 +253,10 @@ func (lm *loggingMiddleware) RevokePATSecret(ctx context.Context, token, patID s
 		}
 		if err != nil {
 			args = append(args, slog.Any("error", err))
-			lm.logger.Warn("Revoke PAT secret failed", args...)
+			lm.logger.WarnContext(ctx, "Revoke PAT secret failed", args...)
 			return
 		}
-		lm.logger.Info("Revoke PAT secret completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Revoke PAT secret completed successfully", args...)
 	}(time.Now())
 	return lm.svc.RevokePATSecret(ctx, token, patID)
 }
@@ -273,10 +274,10 @@ func (lm *loggingMiddleware) AddPATScopeEntry(ctx context.Context, token, patID
 		}
 		if err != nil {
 			args = append(args, slog.Any("error", err))
-			lm.logger.Warn("Add entry to PAT scope failed", args...)
+			lm.logger.WarnContext(ctx, "Add entry to PAT scope failed", args...)
 			return
 		}
-		lm.logger.Info("Add entry to PAT scope completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Add entry to PAT scope completed successfully", args...)
 	}(time.Now())
 	return lm.svc.AddPATScopeEntry(ctx, token, patID, platformEntityType, optionalDomainID, optionalDomainEntityType, operation, entityIDs...)
 }
@@ -294,10 +295,10 @@ func (lm *loggingMiddleware) RemovePATScopeEntry(ctx context.Context, token, pat
 		}
 		if err != nil {
 			args = append(args, slog.Any("error", err))
-			lm.logger.Warn("Remove entry from PAT scope failed", args...)
+			lm.logger.WarnContext(ctx, "Remove entry from PAT scope failed", args...)
 			return
 		}
-		lm.logger.Info("Remove entry from PAT scope completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Remove entry from PAT scope completed successfully", args...)
 	}(time.Now())
 	return lm.svc.RemovePATScopeEntry(ctx, token, patID, platformEntityType, optionalDomainID, optionalDomainEntityType, operation, entityIDs...)
 }
@@ -310,10 +311,10 @@ func (lm *loggingMiddleware) ClearPATAllScopeEntry(ctx context.Context, token, p
 		}
 		if err != nil {
 			args = append(args, slog.Any("error", err))
-			lm.logger.Warn("Clear all entry from PAT scope failed", args...)
+			lm.logger.WarnContext(ctx, "Clear all entry from PAT scope failed", args...)
 			return
 		}
-		lm.logger.Info("Clear all entry from PAT scope completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Clear all entry from PAT scope completed successfully", args...)
 	}(time.Now())
 	return lm.svc.ClearPATAllScopeEntry(ctx, token, patID)
 }
@@ -325,10 +326,10 @@ func (lm *loggingMiddleware) IdentifyPAT(ctx context.Context, paToken string) (p
 		}
 		if err != nil {
 			args = append(args, slog.Any("error", err))
-			lm.logger.Warn("Identify PAT failed", args...)
+			lm.logger.WarnContext(ctx, "Identify PAT failed", args...)
 			return
 		}
-		lm.logger.Info("Identify PAT completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Identify PAT completed successfully", args...)
 	}(time.Now())
 	return lm.svc.IdentifyPAT(ctx, paToken)
 }
@@ -345,10 +346,10 @@ func (lm *loggingMiddleware) AuthorizePAT(ctx context.Context, userID, patID str
 		}
 		if err != nil {
 			args = append(args, slog.Any("error", err))
-			lm.logger.Warn("Authorize PAT failed complete successfully", args...)
+			lm.logger.WarnContext(ctx, "Authorize PAT failed complete successfully", args...)
 			return
 		}
-		lm.logger.Info("Authorize PAT completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Authorize PAT completed successfully", args...)
 	}(time.Now())
 	return lm.svc.AuthorizePAT(ctx, userID, patID, platformEntityType, optionalDomainID, optionalDomainEntityType, operation, entityIDs...)
 }
@@ -367,10 +368,10 @@ func (lm *loggingMiddleware) CheckPAT(ctx context.Context, userID, patID string,
 		}
 		if err != nil {
 			args = append(args, slog.Any("error", err))
-			lm.logger.Warn("Check PAT failed complete successfully", args...)
+			lm.logger.WarnContext(ctx, "Check PAT failed complete successfully", args...)
 			return
 		}
-		lm.logger.Info("Check PAT completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Check PAT completed successfully", args...)
 	}(time.Now())
 	return lm.svc.CheckPAT(ctx, userID, patID, platformEntityType, optionalDomainID, optionalDomainEntityType, operation, entityIDs...)
 }
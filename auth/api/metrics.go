@@ -11,6 +11,7 @@ import (
 
 	"github.com/absmach/supermq/auth"
 	"github.com/absmach/supermq/pkg/policies"
+	"github.com/absmach/supermq/pkg/prometheus"
 	"github.com/go-kit/kit/metrics"
 )
 
@@ -31,157 +32,157 @@ func MetricsMiddleware(svc auth.Service, counter metrics.Counter, latency metric
 	}
 }
 
-func (ms *metricsMiddleware) Issue(ctx context.Context, token string, key auth.Key) (auth.Token, error) {
+func (ms *metricsMiddleware) Issue(ctx context.Context, token string, key auth.Key) (tkn auth.Token, err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "issue_key").Add(1)
+		ms.counter.With("method", "issue_key", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "issue_key").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 
 	return ms.svc.Issue(ctx, token, key)
 }
 
-func (ms *metricsMiddleware) Revoke(ctx context.Context, token, id string) error {
+func (ms *metricsMiddleware) Revoke(ctx context.Context, token, id string) (err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "revoke_key").Add(1)
+		ms.counter.With("method", "revoke_key", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "revoke_key").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 
 	return ms.svc.Revoke(ctx, token, id)
 }
 
-func (ms *metricsMiddleware) RetrieveKey(ctx context.Context, token, id string) (auth.Key, error) {
+func (ms *metricsMiddleware) RetrieveKey(ctx context.Context, token, id string) (key auth.Key, err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "retrieve_key").Add(1)
+		ms.counter.With("method", "retrieve_key", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "retrieve_key").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 
 	return ms.svc.RetrieveKey(ctx, token, id)
 }
 
-func (ms *metricsMiddleware) Identify(ctx context.Context, token string) (auth.Key, error) {
+func (ms *metricsMiddleware) Identify(ctx context.Context, token string) (id auth.Key, err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "identify").Add(1)
+		ms.counter.With("method", "identify", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "identify").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 
 	return ms.svc.Identify(ctx, token)
 }
 
-func (ms *metricsMiddleware) Authorize(ctx context.Context, pr policies.Policy) error {
+func (ms *metricsMiddleware) Authorize(ctx context.Context, pr policies.Policy) (err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "authorize").Add(1)
+		ms.counter.With("method", "authorize", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "authorize").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.Authorize(ctx, pr)
 }
 
-func (ms *metricsMiddleware) CreatePAT(ctx context.Context, token, name, description string, duration time.Duration, scope auth.Scope) (auth.PAT, error) {
+func (ms *metricsMiddleware) CreatePAT(ctx context.Context, token, name, description string, duration time.Duration, usageLimit uint64, scope auth.Scope) (pa auth.PAT, err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "create_pat").Add(1)
+		ms.counter.With("method", "create_pat", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "create_pat").Observe(time.Since(begin).Seconds())
 	}(time.Now())
-	return ms.svc.CreatePAT(ctx, token, name, description, duration, scope)
+	return ms.svc.CreatePAT(ctx, token, name, description, duration, usageLimit, scope)
 }
 
-func (ms *metricsMiddleware) UpdatePATName(ctx context.Context, token, patID, name string) (auth.PAT, error) {
+func (ms *metricsMiddleware) UpdatePATName(ctx context.Context, token, patID, name string) (pa auth.PAT, err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "update_pat_name").Add(1)
+		ms.counter.With("method", "update_pat_name", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "update_pat_name").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.UpdatePATName(ctx, token, patID, name)
 }
 
-func (ms *metricsMiddleware) UpdatePATDescription(ctx context.Context, token, patID, description string) (auth.PAT, error) {
+func (ms *metricsMiddleware) UpdatePATDescription(ctx context.Context, token, patID, description string) (pa auth.PAT, err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "update_pat_description").Add(1)
+		ms.counter.With("method", "update_pat_description", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "update_pat_description").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.UpdatePATDescription(ctx, token, patID, description)
 }
 
-func (ms *metricsMiddleware) RetrievePAT(ctx context.Context, token, patID string) (auth.PAT, error) {
+func (ms *metricsMiddleware) RetrievePAT(ctx context.Context, token, patID string) (pa auth.PAT, err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "retrieve_pat").Add(1)
+		ms.counter.With("method", "retrieve_pat", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "retrieve_pat").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.RetrievePAT(ctx, token, patID)
 }
 
-func (ms *metricsMiddleware) ListPATS(ctx context.Context, token string, pm auth.PATSPageMeta) (auth.PATSPage, error) {
+func (ms *metricsMiddleware) ListPATS(ctx context.Context, token string, pm auth.PATSPageMeta) (pp auth.PATSPage, err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "list_pats").Add(1)
+		ms.counter.With("method", "list_pats", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "list_pats").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.ListPATS(ctx, token, pm)
 }
 
-func (ms *metricsMiddleware) DeletePAT(ctx context.Context, token, patID string) error {
+func (ms *metricsMiddleware) DeletePAT(ctx context.Context, token, patID string) (err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "delete_pat").Add(1)
+		ms.counter.With("method", "delete_pat", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "delete_pat").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.DeletePAT(ctx, token, patID)
 }
 
-func (ms *metricsMiddleware) ResetPATSecret(ctx context.Context, token, patID string, duration time.Duration) (auth.PAT, error) {
+func (ms *metricsMiddleware) ResetPATSecret(ctx context.Context, token, patID string, duration time.Duration) (pa auth.PAT, err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "reset_pat_secret").Add(1)
+		ms.counter.With("method", "reset_pat_secret", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "reset_pat_secret").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.ResetPATSecret(ctx, token, patID, duration)
 }
 
-func (ms *metricsMiddleware) RevokePATSecret(ctx context.Context, token, patID string) error {
+func (ms *metricsMiddleware) RevokePATSecret(ctx context.Context, token, patID string) (err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "revoke_pat_secret").Add(1)
+		ms.counter.With("method", "revoke_pat_secret", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "revoke_pat_secret").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.RevokePATSecret(ctx, token, patID)
 }
 
-func (ms *metricsMiddleware) AddPATScopeEntry(ctx context.Context, token, patID string, platformEntityType auth.PlatformEntityType, optionalDomainID string, optionalDomainEntityType auth.DomainEntityType, operation auth.OperationType, entityIDs ...string) (auth.Scope, error) {
+func (ms *metricsMiddleware) AddPATScopeEntry(ctx context.Context, token, patID string, platformEntityType auth.PlatformEntityType, optionalDomainID string, optionalDomainEntityType auth.DomainEntityType, operation auth.OperationType, entityIDs ...string) (sc auth.Scope, err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "add_pat_scope_entry").Add(1)
+		ms.counter.With("method", "add_pat_scope_entry", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "add_pat_scope_entry").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.AddPATScopeEntry(ctx, token, patID, platformEntityType, optionalDomainID, optionalDomainEntityType, operation, entityIDs...)
 }
 
-func (ms *metricsMiddleware) RemovePATScopeEntry(ctx context.Context, token, patID string, platformEntityType auth.PlatformEntityType, optionalDomainID string, optionalDomainEntityType auth.DomainEntityType, operation auth.OperationType, entityIDs ...string) (auth.Scope, error) {
+func (ms *metricsMiddleware) RemovePATScopeEntry(ctx context.Context, token, patID string, platformEntityType auth.PlatformEntityType, optionalDomainID string, optionalDomainEntityType auth.DomainEntityType, operation auth.OperationType, entityIDs ...string) (sc auth.Scope, err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "remove_pat_scope_entry").Add(1)
+		ms.counter.With("method", "remove_pat_scope_entry", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "remove_pat_scope_entry").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.RemovePATScopeEntry(ctx, token, patID, platformEntityType, optionalDomainID, optionalDomainEntityType, operation, entityIDs...)
 }
 
-func (ms *metricsMiddleware) ClearPATAllScopeEntry(ctx context.Context, token, patID string) error {
+func (ms *metricsMiddleware) ClearPATAllScopeEntry(ctx context.Context, token, patID string) (err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "clear_pat_all_scope_entry").Add(1)
+		ms.counter.With("method", "clear_pat_all_scope_entry", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "clear_pat_all_scope_entry").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.ClearPATAllScopeEntry(ctx, token, patID)
 }
 
-func (ms *metricsMiddleware) IdentifyPAT(ctx context.Context, paToken string) (auth.PAT, error) {
+func (ms *metricsMiddleware) IdentifyPAT(ctx context.Context, paToken string) (pa auth.PAT, err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "identify_pat").Add(1)
+		ms.counter.With("method", "identify_pat", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "identify_pat").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.IdentifyPAT(ctx, paToken)
 }
 
-func (ms *metricsMiddleware) AuthorizePAT(ctx context.Context, userID, patID string, platformEntityType auth.PlatformEntityType, optionalDomainID string, optionalDomainEntityType auth.DomainEntityType, operation auth.OperationType, entityIDs ...string) error {
+func (ms *metricsMiddleware) AuthorizePAT(ctx context.Context, userID, patID string, platformEntityType auth.PlatformEntityType, optionalDomainID string, optionalDomainEntityType auth.DomainEntityType, operation auth.OperationType, entityIDs ...string) (err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "authorize_pat").Add(1)
+		ms.counter.With("method", "authorize_pat", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "authorize_pat").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.AuthorizePAT(ctx, userID, patID, platformEntityType, optionalDomainID, optionalDomainEntityType, operation, entityIDs...)
 }
 
-func (ms *metricsMiddleware) CheckPAT(ctx context.Context, userID, patID string, platformEntityType auth.PlatformEntityType, optionalDomainID string, optionalDomainEntityType auth.DomainEntityType, operation auth.OperationType, entityIDs ...string) error {
+func (ms *metricsMiddleware) CheckPAT(ctx context.Context, userID, patID string, platformEntityType auth.PlatformEntityType, optionalDomainID string, optionalDomainEntityType auth.DomainEntityType, operation auth.OperationType, entityIDs ...string) (err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "check_pat").Add(1)
+		ms.counter.With("method", "check_pat", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "check_pat").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.CheckPAT(ctx, userID, patID, platformEntityType, optionalDomainID, optionalDomainEntityType, operation, entityIDs...)
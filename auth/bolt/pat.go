@@ -28,6 +28,8 @@ const (
 	expiresAtKey        = "expires_at"
 	updatedAtKey        = "updated_at"
 	lastUsedAtKey       = "last_used_at"
+	usageLimitKey       = "usage_limit"
+	usageCountKey       = "usage_count"
 	revokedKey          = "revoked"
 	revokedAtKey        = "revoked_at"
 	platformEntitiesKey = "platform_entities"
@@ -374,6 +376,27 @@ func (pr *patRepo) CheckScopeEntry(ctx context.Context, userID, patID string, pl
 	})
 }
 
+func (pr *patRepo) ConsumeUsage(ctx context.Context, userID, patID string) error {
+	return pr.db.Update(func(tx *bolt.Tx) error {
+		b, err := pr.retrieveUserBucket(tx, userID, patID, repoerr.ErrUpdateEntity)
+		if err != nil {
+			return err
+		}
+		limit := bytesToUint64(b.Get([]byte(patID + keySeparator + usageLimitKey)))
+		if limit == 0 {
+			return nil
+		}
+		count := bytesToUint64(b.Get([]byte(patID + keySeparator + usageCountKey)))
+		if count >= limit {
+			return auth.ErrUsageExhausted
+		}
+		if err := b.Put([]byte(patID+keySeparator+usageCountKey), uint64ToBytes(count+1)); err != nil {
+			return errors.Wrap(repoerr.ErrUpdateEntity, err)
+		}
+		return nil
+	})
+}
+
 func (pr *patRepo) RemoveAllScopeEntry(ctx context.Context, userID, patID string) error {
 	return nil
 }
@@ -449,6 +472,8 @@ func patToKeyValue(pat auth.PAT) (map[string][]byte, error) {
 		expiresAtKey:   timeToBytes(pat.ExpiresAt),
 		updatedAtKey:   timeToBytes(pat.UpdatedAt),
 		lastUsedAtKey:  timeToBytes(pat.LastUsedAt),
+		usageLimitKey:  uint64ToBytes(pat.UsageLimit),
+		usageCountKey:  uint64ToBytes(pat.UsageCount),
 		revokedKey:     booleanToBytes(pat.Revoked),
 		revokedAtKey:   timeToBytes(pat.RevokedAt),
 	}
@@ -601,6 +626,10 @@ func keyValueToBasicPAT(kv map[string][]byte) auth.PAT {
 			pat.UpdatedAt = bytesToTime(v)
 		case strings.HasSuffix(k, keySeparator+lastUsedAtKey):
 			pat.LastUsedAt = bytesToTime(v)
+		case strings.HasSuffix(k, keySeparator+usageLimitKey):
+			pat.UsageLimit = bytesToUint64(v)
+		case strings.HasSuffix(k, keySeparator+usageCountKey):
+			pat.UsageCount = bytesToUint64(v)
 		case strings.HasSuffix(k, keySeparator+revokedKey):
 			pat.Revoked = bytesToBoolean(v)
 		case strings.HasSuffix(k, keySeparator+revokedAtKey):
@@ -797,6 +826,19 @@ func bytesToTime(b []byte) time.Time {
 	return time.Unix(int64(timeAtSeconds), 0)
 }
 
+func uint64ToBytes(v uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, v)
+	return b
+}
+
+func bytesToUint64(b []byte) uint64 {
+	if len(b) != 8 {
+		return 0
+	}
+	return binary.BigEndian.Uint64(b)
+}
+
 func booleanToBytes(b bool) []byte {
 	if b {
 		return []byte{1}
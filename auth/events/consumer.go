@@ -0,0 +1,68 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package events
+
+import (
+	"context"
+
+	"github.com/absmach/supermq/auth"
+	"github.com/absmach/supermq/pkg/events"
+	"github.com/absmach/supermq/pkg/events/store"
+)
+
+const (
+	userRemove = "user.remove"
+	userDelete = "user.delete"
+
+	disabledStatus = "disabled"
+)
+
+// Start method starts consuming messages received from Event store.
+func Start(ctx context.Context, consumer string, sub events.Subscriber, keys auth.KeyRepository) error {
+	subCfg := events.SubscriberConfig{
+		Consumer: consumer,
+		Stream:   store.StreamAllEvents,
+		Handler:  Handle(keys),
+	}
+
+	return sub.Subscribe(ctx, subCfg)
+}
+
+// Handle returns an event handler revoking API keys issued for disabled
+// and deleted users. Events of other operations are ignored.
+func Handle(keys auth.KeyRepository) handleFunc {
+	return func(ctx context.Context, event events.Event) error {
+		data, err := event.Encode()
+		if err != nil {
+			return err
+		}
+
+		operation, _ := data["operation"].(string)
+		switch operation {
+		case userRemove:
+			if status, _ := data["status"].(string); status != disabledStatus {
+				return nil
+			}
+		case userDelete:
+		default:
+			return nil
+		}
+		id, _ := data["id"].(string)
+		if id == "" {
+			return nil
+		}
+
+		return keys.RemoveAllBySubject(ctx, id)
+	}
+}
+
+type handleFunc func(ctx context.Context, event events.Event) error
+
+func (h handleFunc) Handle(ctx context.Context, event events.Event) error {
+	return h(ctx, event)
+}
+
+func (h handleFunc) Cancel() error {
+	return nil
+}
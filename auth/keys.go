@@ -103,4 +103,7 @@ type KeyRepository interface {
 
 	// Remove removes Key with provided ID.
 	Remove(ctx context.Context, issuer string, id string) error
+
+	// RemoveAllBySubject removes all Keys issued for the given subject.
+	RemoveAllBySubject(ctx context.Context, subject string) error
 }
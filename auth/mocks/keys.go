@@ -35,6 +35,24 @@ func (_m *KeyRepository) Remove(ctx context.Context, issuer string, id string) e
 	return r0
 }
 
+// RemoveAllBySubject provides a mock function with given fields: ctx, subject
+func (_m *KeyRepository) RemoveAllBySubject(ctx context.Context, subject string) error {
+	ret := _m.Called(ctx, subject)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveAllBySubject")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, subject)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Retrieve provides a mock function with given fields: ctx, issuer, id
 func (_m *KeyRepository) Retrieve(ctx context.Context, issuer string, id string) (auth.Key, error) {
 	ret := _m.Called(ctx, issuer, id)
@@ -122,9 +122,9 @@ func (_m *PATS) ClearPATAllScopeEntry(ctx context.Context, token string, patID s
 	return r0
 }
 
-// CreatePAT provides a mock function with given fields: ctx, token, name, description, duration, scope
-func (_m *PATS) CreatePAT(ctx context.Context, token string, name string, description string, duration time.Duration, scope auth.Scope) (auth.PAT, error) {
-	ret := _m.Called(ctx, token, name, description, duration, scope)
+// CreatePAT provides a mock function with given fields: ctx, token, name, description, duration, usageLimit, scope
+func (_m *PATS) CreatePAT(ctx context.Context, token string, name string, description string, duration time.Duration, usageLimit uint64, scope auth.Scope) (auth.PAT, error) {
+	ret := _m.Called(ctx, token, name, description, duration, usageLimit, scope)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CreatePAT")
@@ -132,17 +132,17 @@ func (_m *PATS) CreatePAT(ctx context.Context, token string, name string, descri
 
 	var r0 auth.PAT
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, time.Duration, auth.Scope) (auth.PAT, error)); ok {
-		return rf(ctx, token, name, description, duration, scope)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, time.Duration, uint64, auth.Scope) (auth.PAT, error)); ok {
+		return rf(ctx, token, name, description, duration, usageLimit, scope)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, time.Duration, auth.Scope) auth.PAT); ok {
-		r0 = rf(ctx, token, name, description, duration, scope)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, time.Duration, uint64, auth.Scope) auth.PAT); ok {
+		r0 = rf(ctx, token, name, description, duration, usageLimit, scope)
 	} else {
 		r0 = ret.Get(0).(auth.PAT)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, time.Duration, auth.Scope) error); ok {
-		r1 = rf(ctx, token, name, description, duration, scope)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, time.Duration, uint64, auth.Scope) error); ok {
+		r1 = rf(ctx, token, name, description, duration, usageLimit, scope)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -79,6 +79,24 @@ func (_m *PATSRepository) CheckScopeEntry(ctx context.Context, userID string, pa
 	return r0
 }
 
+// ConsumeUsage provides a mock function with given fields: ctx, userID, patID
+func (_m *PATSRepository) ConsumeUsage(ctx context.Context, userID string, patID string) error {
+	ret := _m.Called(ctx, userID, patID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConsumeUsage")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userID, patID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Reactivate provides a mock function with given fields: ctx, userID, patID
 func (_m *PATSRepository) Reactivate(ctx context.Context, userID string, patID string) error {
 	ret := _m.Called(ctx, userID, patID)
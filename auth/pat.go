@@ -14,6 +14,9 @@ import (
 
 var errAddEntityToAnyIDs = errors.New("could not add entity id to any ID scope value")
 
+// ErrUsageExhausted indicates that the PAT has reached its usage limit.
+var ErrUsageExhausted = errors.New("pat usage limit exhausted")
+
 // Define OperationType.
 type OperationType uint32
 
@@ -681,6 +684,8 @@ type PAT struct {
 	Description string    `json:"description,omitempty"`
 	Secret      string    `json:"secret,omitempty"`
 	Scope       Scope     `json:"scope,omitempty"`
+	UsageLimit  uint64    `json:"usage_limit,omitempty"`
+	UsageCount  uint64    `json:"usage_count,omitempty"`
 	IssuedAt    time.Time `json:"issued_at,omitempty"`
 	ExpiresAt   time.Time `json:"expires_at,omitempty"`
 	UpdatedAt   time.Time `json:"updated_at,omitempty"`
@@ -717,8 +722,9 @@ func (pat PAT) Expired() bool {
 //go:generate mockery --name PATS --output=./mocks --filename pats.go --quiet --note "Copyright (c) Abstract Machines"
 
 type PATS interface {
-	// Create function creates new PAT for given valid inputs.
-	CreatePAT(ctx context.Context, token, name, description string, duration time.Duration, scope Scope) (PAT, error)
+	// Create function creates new PAT for given valid inputs. A non-zero
+	// usageLimit caps how many create operations the PAT can authorize.
+	CreatePAT(ctx context.Context, token, name, description string, duration time.Duration, usageLimit uint64, scope Scope) (PAT, error)
 
 	// UpdateName function updates the name for the given PAT ID.
 	UpdatePATName(ctx context.Context, token, patID, name string) (PAT, error)
@@ -800,5 +806,9 @@ type PATSRepository interface {
 
 	CheckScopeEntry(ctx context.Context, userID, patID string, platformEntityType PlatformEntityType, optionalDomainID string, optionalDomainEntityType DomainEntityType, operation OperationType, entityIDs ...string) error
 
+	// ConsumeUsage atomically increments the usage count of a PAT and
+	// returns ErrUsageExhausted once the usage limit is reached.
+	ConsumeUsage(ctx context.Context, userID, patID string) error
+
 	RemoveAllScopeEntry(ctx context.Context, userID, patID string) error
 }
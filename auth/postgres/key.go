@@ -71,6 +71,18 @@ func (kr *repo) Remove(ctx context.Context, issuerID, id string) error {
 	return nil
 }
 
+func (kr *repo) RemoveAllBySubject(ctx context.Context, subject string) error {
+	q := `DELETE FROM keys WHERE subject = :subject`
+	key := dbKey{
+		Subject: subject,
+	}
+	if _, err := kr.db.NamedExecContext(ctx, q, key); err != nil {
+		return errors.Wrap(errDelete, err)
+	}
+
+	return nil
+}
+
 type dbKey struct {
 	ID        string       `db:"id"`
 	Type      uint32       `db:"type"`
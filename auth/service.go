@@ -6,6 +6,7 @@ package auth
 import (
 	"context"
 	"encoding/base64"
+	"fmt"
 	"math/rand"
 	"strings"
 	"time"
@@ -108,11 +109,26 @@ type service struct {
 	loginDuration      time.Duration
 	refreshDuration    time.Duration
 	invitationDuration time.Duration
+	explainDenials     bool
+}
+
+// Option configures the auth service.
+type Option func(*service)
+
+// WithDenialExplanations makes denied authorization requests coming from
+// platform administrators carry an explanation of the denial: the missing
+// permission, the resolved subject and object, and the permissions the
+// subject does hold on the object. Regular callers always get the plain
+// denial so policy details are not leaked.
+func WithDenialExplanations() Option {
+	return func(svc *service) {
+		svc.explainDenials = true
+	}
 }
 
 // New instantiates the auth service implementation.
-func New(keys KeyRepository, pats PATSRepository, hasher Hasher, idp supermq.IDProvider, tokenizer Tokenizer, policyEvaluator policies.Evaluator, policyService policies.Service, loginDuration, refreshDuration, invitationDuration time.Duration) Service {
-	return &service{
+func New(keys KeyRepository, pats PATSRepository, hasher Hasher, idp supermq.IDProvider, tokenizer Tokenizer, policyEvaluator policies.Evaluator, policyService policies.Service, loginDuration, refreshDuration, invitationDuration time.Duration, opts ...Option) Service {
+	svc := &service{
 		tokenizer:          tokenizer,
 		keys:               keys,
 		pats:               pats,
@@ -124,6 +140,11 @@ func New(keys KeyRepository, pats PATSRepository, hasher Hasher, idp supermq.IDP
 		refreshDuration:    refreshDuration,
 		invitationDuration: invitationDuration,
 	}
+	for _, opt := range opts {
+		opt(svc)
+	}
+
+	return svc
 }
 
 func (svc service) Issue(ctx context.Context, token string, key Key) (Token, error) {
@@ -229,11 +250,37 @@ func (svc service) checkPolicy(ctx context.Context, pr policies.Policy) error {
 		}
 	}
 	if err := svc.evaluator.CheckPolicy(ctx, pr); err != nil {
-		return errors.Wrap(svcerr.ErrAuthorization, err)
+		return errors.Wrap(svcerr.ErrAuthorization, svc.explainDenial(ctx, pr, err))
 	}
 	return nil
 }
 
+// explainDenial expands a denial for platform administrators with the
+// missing permission, the resolved subject and object, and the permissions
+// the subject does hold on the object, so access issues can be debugged
+// from the error response alone. Any other caller gets the denial as is.
+func (svc service) explainDenial(ctx context.Context, pr policies.Policy, denial error) error {
+	if !svc.explainDenials {
+		return denial
+	}
+	if err := svc.evaluator.CheckPolicy(ctx, policies.Policy{
+		Subject:     pr.Subject,
+		SubjectType: policies.UserType,
+		Permission:  policies.AdminPermission,
+		Object:      policies.SuperMQObject,
+		ObjectType:  policies.PlatformType,
+	}); err != nil {
+		return denial
+	}
+	held, err := svc.policysvc.ListPermissions(ctx, pr, nil)
+	if err != nil {
+		return denial
+	}
+	explanation := errors.New(fmt.Sprintf("subject %s of type %s is missing permission %s on %s %s, granted permissions: [%s]", pr.Subject, pr.SubjectType, pr.Permission, pr.ObjectType, pr.Object, strings.Join(held, ", ")))
+
+	return errors.Wrap(explanation, denial)
+}
+
 func (svc service) checkDomain(ctx context.Context, subjectType, subject, domainID string) error {
 	if err := svc.evaluator.CheckPolicy(ctx, policies.Policy{
 		Subject:     subject,
@@ -457,7 +504,7 @@ func DecodeDomainUserID(domainUserID string) (string, string) {
 	}
 }
 
-func (svc service) CreatePAT(ctx context.Context, token, name, description string, duration time.Duration, scope Scope) (PAT, error) {
+func (svc service) CreatePAT(ctx context.Context, token, name, description string, duration time.Duration, usageLimit uint64, scope Scope) (PAT, error) {
 	key, err := svc.Identify(ctx, token)
 	if err != nil {
 		return PAT{}, err
@@ -482,6 +529,7 @@ func (svc service) CreatePAT(ctx context.Context, token, name, description strin
 		IssuedAt:    now,
 		ExpiresAt:   now.Add(duration),
 		Scope:       scope,
+		UsageLimit:  usageLimit,
 	}
 	if err := svc.pats.Save(ctx, pat); err != nil {
 		return PAT{}, errors.Wrap(errCreatePAT, err)
@@ -656,6 +704,11 @@ func (svc service) AuthorizePAT(ctx context.Context, userID, patID string, platf
 	if err := svc.pats.CheckScopeEntry(ctx, res.User, res.ID, platformEntityType, optionalDomainID, optionalDomainEntityType, operation, entityIDs...); err != nil {
 		return errors.Wrap(svcerr.ErrAuthorization, err)
 	}
+	if operation == CreateOp {
+		if err := svc.pats.ConsumeUsage(ctx, res.User, res.ID); err != nil {
+			return errors.Wrap(svcerr.ErrAuthorization, err)
+		}
+	}
 	return nil
 }
 
@@ -1242,3 +1242,68 @@ func TestDecodeDomainUserID(t *testing.T) {
 		assert.Equal(t, tc.respDomainID, ar, fmt.Sprintf("%s expected %s got %s\n", tc.desc, tc.respDomainID, ar))
 	}
 }
+
+func TestAuthorizeDenialExplanation(t *testing.T) {
+	newService()
+	tokenizer := jwt.New([]byte(secret))
+	svc := auth.New(krepo, patsrepo, hasher, uuid.NewMock(), tokenizer, pEvaluator, pService, loginDuration, refreshDuration, invalidDuration, auth.WithDenialExplanations())
+
+	pr := policies.Policy{
+		Subject:     userID,
+		SubjectType: policies.UserType,
+		Object:      validID,
+		ObjectType:  policies.ChannelType,
+		Permission:  policies.AdminPermission,
+	}
+	adminPr := policies.Policy{
+		Subject:     userID,
+		SubjectType: policies.UserType,
+		Object:      policies.SuperMQObject,
+		ObjectType:  policies.PlatformType,
+		Permission:  policies.AdminPermission,
+	}
+
+	cases := []struct {
+		desc        string
+		adminErr    error
+		listPerms   policies.Permissions
+		listErr     error
+		explanation string
+	}{
+		{
+			desc:        "explain denial for platform admin",
+			adminErr:    nil,
+			listPerms:   policies.Permissions{"view", "membership"},
+			explanation: "granted permissions: [view, membership]",
+		},
+		{
+			desc:     "plain denial for regular caller",
+			adminErr: svcerr.ErrAuthorization,
+		},
+		{
+			desc:     "plain denial when listing permissions fails",
+			adminErr: nil,
+			listErr:  svcerr.ErrViewEntity,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			denialCall := pEvaluator.On("CheckPolicy", mock.Anything, pr).Return(svcerr.ErrAuthorization)
+			adminCall := pEvaluator.On("CheckPolicy", mock.Anything, adminPr).Return(tc.adminErr)
+			listCall := pService.On("ListPermissions", mock.Anything, pr, []string(nil)).Return(tc.listPerms, tc.listErr)
+
+			err := svc.Authorize(context.Background(), pr)
+			assert.True(t, errors.Contains(err, svcerr.ErrAuthorization), fmt.Sprintf("expected %s got %s\n", svcerr.ErrAuthorization, err))
+			if tc.explanation != "" {
+				assert.Contains(t, err.Error(), tc.explanation)
+				assert.Contains(t, err.Error(), fmt.Sprintf("missing permission %s", pr.Permission))
+			} else {
+				assert.NotContains(t, err.Error(), "granted permissions")
+			}
+
+			denialCall.Unset()
+			adminCall.Unset()
+			listCall.Unset()
+		})
+	}
+}
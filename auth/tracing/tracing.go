@@ -76,15 +76,16 @@ func (tm *tracingMiddleware) Authorize(ctx context.Context, pr policies.Policy)
 	return tm.svc.Authorize(ctx, pr)
 }
 
-func (tm *tracingMiddleware) CreatePAT(ctx context.Context, token, name, description string, duration time.Duration, scope auth.Scope) (auth.PAT, error) {
+func (tm *tracingMiddleware) CreatePAT(ctx context.Context, token, name, description string, duration time.Duration, usageLimit uint64, scope auth.Scope) (auth.PAT, error) {
 	ctx, span := tm.tracer.Start(ctx, "create_pat", trace.WithAttributes(
 		attribute.String("name", name),
 		attribute.String("description", description),
 		attribute.String("duration", duration.String()),
+		attribute.Int64("usage_limit", int64(usageLimit)),
 		attribute.String("scope", scope.String()),
 	))
 	defer span.End()
-	return tm.svc.CreatePAT(ctx, token, name, description, duration, scope)
+	return tm.svc.CreatePAT(ctx, token, name, description, duration, usageLimit, scope)
 }
 
 func (tm *tracingMiddleware) UpdatePATName(ctx context.Context, token, patID, name string) (auth.PAT, error) {
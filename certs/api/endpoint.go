@@ -34,6 +34,33 @@ func issueCert(svc certs.Service) endpoint.Endpoint {
 	}
 }
 
+func provision(svc certs.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(provisionReq)
+		if err := req.validate(); err != nil {
+			return nil, errors.Wrap(apiutil.ErrValidation, err)
+		}
+		res, err := svc.Provision(ctx, req.domainID, req.token, []byte(req.Certificate), req.TTL)
+		if err != nil {
+			return provisionRes{}, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		return provisionRes{
+			ClientID:     res.ClientID,
+			ClientSecret: res.ClientSecret,
+			GroupID:      res.GroupID,
+			Cert: certsRes{
+				SerialNumber: res.Cert.SerialNumber,
+				ClientID:     res.Cert.ClientID,
+				Certificate:  res.Cert.Certificate,
+				Key:          res.Cert.Key,
+				ExpiryTime:   res.Cert.ExpiryTime,
+				Revoked:      res.Cert.Revoked,
+			},
+		}, nil
+	}
+}
+
 func listSerials(svc certs.Service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(listReq)
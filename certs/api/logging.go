@@ -36,10 +36,10 @@ func (lm *loggingMiddleware) IssueCert(ctx context.Context, domainID, token, cli
 		}
 		if err != nil {
 			args = append(args, slog.Any("error", err))
-			lm.logger.Warn("Issue certificate failed", args...)
+			lm.logger.WarnContext(ctx, "Issue certificate failed", args...)
 			return
 		}
-		lm.logger.Info("Issue certificate completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Issue certificate completed successfully", args...)
 	}(time.Now())
 
 	return lm.svc.IssueCert(ctx, domainID, token, clientID, ttl)
@@ -59,10 +59,10 @@ func (lm *loggingMiddleware) ListCerts(ctx context.Context, clientID string, pm
 		}
 		if err != nil {
 			args = append(args, slog.Any("error", err))
-			lm.logger.Warn("List certificates failed", args...)
+			lm.logger.WarnContext(ctx, "List certificates failed", args...)
 			return
 		}
-		lm.logger.Info("List certificates completed successfully", args...)
+		lm.logger.InfoContext(ctx, "List certificates completed successfully", args...)
 	}(time.Now())
 
 	return lm.svc.ListCerts(ctx, clientID, pm)
@@ -84,10 +84,10 @@ func (lm *loggingMiddleware) ListSerials(ctx context.Context, clientID string, p
 		}
 		if err != nil {
 			args = append(args, slog.Any("error", err))
-			lm.logger.Warn("List certifcates serials failed", args...)
+			lm.logger.WarnContext(ctx, "List certifcates serials failed", args...)
 			return
 		}
-		lm.logger.Info("List certificates serials completed successfully", args...)
+		lm.logger.InfoContext(ctx, "List certificates serials completed successfully", args...)
 	}(time.Now())
 
 	return lm.svc.ListSerials(ctx, clientID, pm)
@@ -103,10 +103,10 @@ func (lm *loggingMiddleware) ViewCert(ctx context.Context, serialID string) (c c
 		}
 		if err != nil {
 			args = append(args, slog.Any("error", err))
-			lm.logger.Warn("View certificate failed", args...)
+			lm.logger.WarnContext(ctx, "View certificate failed", args...)
 			return
 		}
-		lm.logger.Info("View certificate completed successfully", args...)
+		lm.logger.InfoContext(ctx, "View certificate completed successfully", args...)
 	}(time.Now())
 
 	return lm.svc.ViewCert(ctx, serialID)
@@ -122,11 +122,32 @@ func (lm *loggingMiddleware) RevokeCert(ctx context.Context, domainID, token, cl
 		}
 		if err != nil {
 			args = append(args, slog.Any("error", err))
-			lm.logger.Warn("Revoke certificate failed", args...)
+			lm.logger.WarnContext(ctx, "Revoke certificate failed", args...)
 			return
 		}
-		lm.logger.Info("Revoke certificate completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Revoke certificate completed successfully", args...)
 	}(time.Now())
 
 	return lm.svc.RevokeCert(ctx, domainID, token, clientID)
 }
+
+// Provision logs the provision request. It logs the client ID, group ID and the time it took to complete the request.
+// If the request fails, it logs the error.
+func (lm *loggingMiddleware) Provision(ctx context.Context, domainID, token string, deviceCert []byte, ttl string) (p certs.Provisioning, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("client_id", p.ClientID),
+			slog.String("group_id", p.GroupID),
+			slog.String("ttl", ttl),
+		}
+		if err != nil {
+			args = append(args, slog.Any("error", err))
+			lm.logger.WarnContext(ctx, "Provision client failed", args...)
+			return
+		}
+		lm.logger.InfoContext(ctx, "Provision client completed successfully", args...)
+	}(time.Now())
+
+	return lm.svc.Provision(ctx, domainID, token, deviceCert, ttl)
+}
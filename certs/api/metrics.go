@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/absmach/supermq/certs"
+	"github.com/absmach/supermq/pkg/prometheus"
 	"github.com/go-kit/kit/metrics"
 )
 
@@ -31,9 +32,9 @@ func MetricsMiddleware(svc certs.Service, counter metrics.Counter, latency metri
 }
 
 // IssueCert instruments IssueCert method with metrics.
-func (ms *metricsMiddleware) IssueCert(ctx context.Context, domainID, token, clientID, ttl string) (certs.Cert, error) {
+func (ms *metricsMiddleware) IssueCert(ctx context.Context, domainID, token, clientID, ttl string) (c certs.Cert, err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "issue_cert").Add(1)
+		ms.counter.With("method", "issue_cert", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "issue_cert").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 
@@ -41,9 +42,9 @@ func (ms *metricsMiddleware) IssueCert(ctx context.Context, domainID, token, cli
 }
 
 // ListCerts instruments ListCerts method with metrics.
-func (ms *metricsMiddleware) ListCerts(ctx context.Context, clientID string, pm certs.PageMetadata) (certs.CertPage, error) {
+func (ms *metricsMiddleware) ListCerts(ctx context.Context, clientID string, pm certs.PageMetadata) (cp certs.CertPage, err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "list_certs").Add(1)
+		ms.counter.With("method", "list_certs", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "list_certs").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 
@@ -51,9 +52,9 @@ func (ms *metricsMiddleware) ListCerts(ctx context.Context, clientID string, pm
 }
 
 // ListSerials instruments ListSerials method with metrics.
-func (ms *metricsMiddleware) ListSerials(ctx context.Context, clientID string, pm certs.PageMetadata) (certs.CertPage, error) {
+func (ms *metricsMiddleware) ListSerials(ctx context.Context, clientID string, pm certs.PageMetadata) (cp certs.CertPage, err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "list_serials").Add(1)
+		ms.counter.With("method", "list_serials", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "list_serials").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 
@@ -61,9 +62,9 @@ func (ms *metricsMiddleware) ListSerials(ctx context.Context, clientID string, p
 }
 
 // ViewCert instruments ViewCert method with metrics.
-func (ms *metricsMiddleware) ViewCert(ctx context.Context, serialID string) (certs.Cert, error) {
+func (ms *metricsMiddleware) ViewCert(ctx context.Context, serialID string) (c certs.Cert, err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "view_cert").Add(1)
+		ms.counter.With("method", "view_cert", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "view_cert").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 
@@ -71,11 +72,21 @@ func (ms *metricsMiddleware) ViewCert(ctx context.Context, serialID string) (cer
 }
 
 // RevokeCert instruments RevokeCert method with metrics.
-func (ms *metricsMiddleware) RevokeCert(ctx context.Context, domainID, token, clientID string) (certs.Revoke, error) {
+func (ms *metricsMiddleware) RevokeCert(ctx context.Context, domainID, token, clientID string) (c certs.Revoke, err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "revoke_cert").Add(1)
+		ms.counter.With("method", "revoke_cert", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "revoke_cert").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 
 	return ms.svc.RevokeCert(ctx, domainID, token, clientID)
 }
+
+// Provision instruments Provision method with metrics.
+func (ms *metricsMiddleware) Provision(ctx context.Context, domainID, token string, deviceCert []byte, ttl string) (p certs.Provisioning, err error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "provision", "error", prometheus.ErrorClass(err)).Add(1)
+		ms.latency.With("method", "provision").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.Provision(ctx, domainID, token, deviceCert, ttl)
+}
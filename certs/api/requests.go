@@ -43,6 +43,37 @@ func (req addCertsReq) validate() error {
 	return nil
 }
 
+type provisionReq struct {
+	token       string
+	domainID    string
+	Certificate string `json:"certificate"`
+	TTL         string `json:"ttl"`
+}
+
+func (req provisionReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if req.domainID == "" {
+		return apiutil.ErrMissingDomainID
+	}
+
+	if req.Certificate == "" {
+		return apiutil.ErrMissingCertData
+	}
+
+	if req.TTL == "" {
+		return apiutil.ErrMissingCertData
+	}
+
+	if _, err := time.ParseDuration(req.TTL); err != nil {
+		return apiutil.ErrInvalidCertData
+	}
+
+	return nil
+}
+
 type listReq struct {
 	clientID string
 	pm       certs.PageMetadata
@@ -29,6 +29,13 @@ type certsRes struct {
 	issued       bool
 }
 
+type provisionRes struct {
+	ClientID     string   `json:"client_id"`
+	ClientSecret string   `json:"client_secret"`
+	GroupID      string   `json:"group_id,omitempty"`
+	Cert         certsRes `json:"cert"`
+}
+
 type revokeCertsRes struct {
 	RevocationTime time.Time `json:"revocation_time"`
 }
@@ -60,6 +67,18 @@ func (res certsRes) Empty() bool {
 	return false
 }
 
+func (res provisionRes) Code() int {
+	return http.StatusCreated
+}
+
+func (res provisionRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res provisionRes) Empty() bool {
+	return false
+}
+
 func (res revokeCertsRes) Code() int {
 	return http.StatusOK
 }
@@ -49,6 +49,12 @@ func MakeHandler(svc certs.Service, authn smqauthn.Authentication, logger *slog.
 					api.EncodeResponse,
 					opts...,
 				), "issue").ServeHTTP)
+				r.Post("/provision", otelhttp.NewHandler(kithttp.NewServer(
+					provision(svc),
+					decodeProvision,
+					api.EncodeResponse,
+					opts...,
+				), "provision").ServeHTTP)
 				r.Get("/{certID}", otelhttp.NewHandler(kithttp.NewServer(
 					viewCert(svc),
 					decodeViewCert,
@@ -125,6 +131,22 @@ func decodeCerts(_ context.Context, r *http.Request) (interface{}, error) {
 	return req, nil
 }
 
+func decodeProvision(_ context.Context, r *http.Request) (interface{}, error) {
+	if r.Header.Get("Content-Type") != contentType {
+		return nil, errors.Wrap(apiutil.ErrValidation, apiutil.ErrUnsupportedContentType)
+	}
+
+	req := provisionReq{
+		token:    apiutil.ExtractBearerToken(r),
+		domainID: chi.URLParam(r, "domainID"),
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, err)
+	}
+
+	return req, nil
+}
+
 func decodeRevokeCerts(_ context.Context, r *http.Request) (interface{}, error) {
 	req := revokeReq{
 		token:    apiutil.ExtractBearerToken(r),
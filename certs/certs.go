@@ -29,6 +29,17 @@ type CertPage struct {
 	Certificates []Cert `json:"certificates,omitempty"`
 }
 
+// Provisioning is the bootstrap payload handed to a device that has been
+// provisioned from its manufacturing certificate: the identity of the newly
+// registered client, the group it was assigned to and its operational
+// certificate.
+type Provisioning struct {
+	ClientID     string `json:"client_id"`
+	ClientSecret string `json:"client_secret"`
+	GroupID      string `json:"group_id,omitempty"`
+	Cert         Cert   `json:"cert"`
+}
+
 type PageMetadata struct {
 	Total      uint64 `json:"total,omitempty"`
 	Offset     uint64 `json:"offset,omitempty"`
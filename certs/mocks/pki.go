@@ -140,6 +140,52 @@ func (_c *Agent_ListCerts_Call) RunAndReturn(run func(sdk.PageMetadata) (amcerts
 	return _c
 }
 
+// Renew provides a mock function with given fields: serialNumber
+func (_m *Agent) Renew(serialNumber string) error {
+	ret := _m.Called(serialNumber)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Renew")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(serialNumber)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Agent_Renew_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Renew'
+type Agent_Renew_Call struct {
+	*mock.Call
+}
+
+// Renew is a helper method to define mock.On call
+//   - serialNumber string
+func (_e *Agent_Expecter) Renew(serialNumber interface{}) *Agent_Renew_Call {
+	return &Agent_Renew_Call{Call: _e.mock.On("Renew", serialNumber)}
+}
+
+func (_c *Agent_Renew_Call) Run(run func(serialNumber string)) *Agent_Renew_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Agent_Renew_Call) Return(_a0 error) *Agent_Renew_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Agent_Renew_Call) RunAndReturn(run func(string) error) *Agent_Renew_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Revoke provides a mock function with given fields: serialNumber
 func (_m *Agent) Revoke(serialNumber string) error {
 	ret := _m.Called(serialNumber)
@@ -101,6 +101,34 @@ func (_m *Service) ListSerials(ctx context.Context, clientID string, pm certs.Pa
 	return r0, r1
 }
 
+// Provision provides a mock function with given fields: ctx, domainID, token, deviceCert, ttl
+func (_m *Service) Provision(ctx context.Context, domainID string, token string, deviceCert []byte, ttl string) (certs.Provisioning, error) {
+	ret := _m.Called(ctx, domainID, token, deviceCert, ttl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Provision")
+	}
+
+	var r0 certs.Provisioning
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, []byte, string) (certs.Provisioning, error)); ok {
+		return rf(ctx, domainID, token, deviceCert, ttl)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, []byte, string) certs.Provisioning); ok {
+		r0 = rf(ctx, domainID, token, deviceCert, ttl)
+	} else {
+		r0 = ret.Get(0).(certs.Provisioning)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, []byte, string) error); ok {
+		r1 = rf(ctx, domainID, token, deviceCert, ttl)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RevokeCert provides a mock function with given fields: ctx, domainID, token, clientID
 func (_m *Service) RevokeCert(ctx context.Context, domainID string, token string, clientID string) (certs.Revoke, error) {
 	ret := _m.Called(ctx, domainID, token, clientID)
@@ -0,0 +1,261 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package acme
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/absmach/certs/sdk"
+	"github.com/absmach/supermq/certs/pki/amcerts"
+	"github.com/absmach/supermq/pkg/errors"
+	"golang.org/x/crypto/acme"
+)
+
+const orderTimeout = 2 * time.Minute
+
+var (
+	errNoChallenge = errors.New("no http-01 challenge offered for authorization")
+	// ErrCertNotFound indicates that the requested certificate was not ordered through this agent.
+	ErrCertNotFound = errors.New("certificate not found")
+)
+
+var _ amcerts.Agent = (*agent)(nil)
+
+type agent struct {
+	client *acme.Client
+	certs  map[string]amcerts.Cert
+	ders   map[string][]byte
+	ttls   map[string]string
+	tokens map[string]string
+	mu     sync.RWMutex
+}
+
+// NewAgent registers an ACME account under the given directory URL and
+// returns a PKI agent that orders certificates from it. The returned
+// http.Handler must be mounted on port 80 of the domains being certified
+// so that HTTP-01 challenges can be answered.
+func NewAgent(ctx context.Context, directoryURL string, accountKey *ecdsa.PrivateKey) (amcerts.Agent, http.Handler, error) {
+	if accountKey == nil {
+		var err error
+		accountKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	client := &acme.Client{Key: accountKey, DirectoryURL: directoryURL}
+	if _, err := client.Register(ctx, &acme.Account{}, acme.AcceptTOS); err != nil && err != acme.ErrAccountAlreadyExists {
+		return nil, nil, err
+	}
+
+	a := &agent{
+		client: client,
+		certs:  map[string]amcerts.Cert{},
+		ders:   map[string][]byte{},
+		ttls:   map[string]string{},
+		tokens: map[string]string{},
+	}
+
+	return a, http.HandlerFunc(a.serveChallenge), nil
+}
+
+func (a *agent) Issue(entityID, ttl string, ipAddrs []string) (amcerts.Cert, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), orderTimeout)
+	defer cancel()
+
+	order, err := a.client.AuthorizeOrder(ctx, acme.DomainIDs(entityID))
+	if err != nil {
+		return amcerts.Cert{}, err
+	}
+	for _, authzURL := range order.AuthzURLs {
+		if err := a.authorize(ctx, authzURL); err != nil {
+			return amcerts.Cert{}, err
+		}
+	}
+	if _, err = a.client.WaitOrder(ctx, order.URI); err != nil {
+		return amcerts.Cert{}, err
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return amcerts.Cert{}, err
+	}
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:  pkix.Name{CommonName: entityID},
+		DNSNames: []string{entityID},
+	}, key)
+	if err != nil {
+		return amcerts.Cert{}, err
+	}
+
+	ders, _, err := a.client.CreateOrderCert(ctx, order.FinalizeURL, csr, true)
+	if err != nil {
+		return amcerts.Cert{}, err
+	}
+	leaf, err := x509.ParseCertificate(ders[0])
+	if err != nil {
+		return amcerts.Cert{}, err
+	}
+
+	var chain strings.Builder
+	for _, der := range ders {
+		if err := pem.Encode(&chain, &pem.Block{Type: "CERTIFICATE", Bytes: der}); err != nil {
+			return amcerts.Cert{}, err
+		}
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return amcerts.Cert{}, err
+	}
+
+	cert := amcerts.Cert{
+		SerialNumber: leaf.SerialNumber.Text(16),
+		Certificate:  chain.String(),
+		Key:          string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})),
+		ExpiryTime:   leaf.NotAfter,
+		ClientID:     entityID,
+	}
+
+	a.mu.Lock()
+	a.certs[cert.SerialNumber] = cert
+	a.ders[cert.SerialNumber] = ders[0]
+	a.ttls[cert.SerialNumber] = ttl
+	a.mu.Unlock()
+
+	return cert, nil
+}
+
+func (a *agent) View(serial string) (amcerts.Cert, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	cert, ok := a.certs[serial]
+	if !ok {
+		return amcerts.Cert{}, ErrCertNotFound
+	}
+	return cert, nil
+}
+
+func (a *agent) Revoke(serial string) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	cert, ok := a.certs[serial]
+	if !ok {
+		return ErrCertNotFound
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), orderTimeout)
+	defer cancel()
+	if err := a.client.RevokeCert(ctx, nil, a.ders[serial], acme.CRLReasonCessationOfOperation); err != nil {
+		return err
+	}
+	cert.Revoked = true
+	a.certs[serial] = cert
+
+	return nil
+}
+
+func (a *agent) Renew(serial string) error {
+	a.mu.RLock()
+	cert, ok := a.certs[serial]
+	ttl := a.ttls[serial]
+	a.mu.RUnlock()
+	if !ok {
+		return ErrCertNotFound
+	}
+
+	renewed, err := a.Issue(cert.ClientID, ttl, nil)
+	if err != nil {
+		return err
+	}
+
+	a.mu.Lock()
+	a.ders[serial] = a.ders[renewed.SerialNumber]
+	renewed.SerialNumber = serial
+	a.certs[serial] = renewed
+	a.mu.Unlock()
+
+	return nil
+}
+
+func (a *agent) ListCerts(pm sdk.PageMetadata) (amcerts.CertPage, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	var all []amcerts.Cert
+	for _, cert := range a.certs {
+		if pm.EntityID == "" || pm.EntityID == cert.ClientID {
+			all = append(all, cert)
+		}
+	}
+
+	page := amcerts.CertPage{
+		Total:  uint64(len(all)),
+		Offset: pm.Offset,
+		Limit:  pm.Limit,
+	}
+	if pm.Offset >= uint64(len(all)) {
+		return page, nil
+	}
+	end := pm.Offset + pm.Limit
+	if pm.Limit == 0 || end > uint64(len(all)) {
+		end = uint64(len(all))
+	}
+	page.Certificates = all[pm.Offset:end]
+
+	return page, nil
+}
+
+func (a *agent) authorize(ctx context.Context, authzURL string) error {
+	authz, err := a.client.GetAuthorization(ctx, authzURL)
+	if err != nil {
+		return err
+	}
+	if authz.Status == acme.StatusValid {
+		return nil
+	}
+
+	for _, ch := range authz.Challenges {
+		if ch.Type != "http-01" {
+			continue
+		}
+		resp, err := a.client.HTTP01ChallengeResponse(ch.Token)
+		if err != nil {
+			return err
+		}
+		a.mu.Lock()
+		a.tokens[ch.Token] = resp
+		a.mu.Unlock()
+
+		if _, err := a.client.Accept(ctx, ch); err != nil {
+			return err
+		}
+		_, err = a.client.WaitAuthorization(ctx, authz.URI)
+		return err
+	}
+
+	return errNoChallenge
+}
+
+func (a *agent) serveChallenge(w http.ResponseWriter, r *http.Request) {
+	token := strings.TrimPrefix(r.URL.Path, "/.well-known/acme-challenge/")
+	a.mu.RLock()
+	resp, ok := a.tokens[token]
+	a.mu.RUnlock()
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	if _, err := w.Write([]byte(resp)); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
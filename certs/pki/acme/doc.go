@@ -0,0 +1,8 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package acme provides a PKI agent backed by an ACME directory such as
+// Let's Encrypt. Certificates are ordered through the ACME protocol with
+// HTTP-01 challenges, and the issued certificates are kept in memory for
+// listing and renewal.
+package acme
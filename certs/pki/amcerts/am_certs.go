@@ -32,6 +32,8 @@ type Agent interface {
 
 	Revoke(serialNumber string) error
 
+	Renew(serialNumber string) error
+
 	ListCerts(pm sdk.PageMetadata) (CertPage, error)
 }
 
@@ -91,6 +93,14 @@ func (c sdkAgent) Revoke(serial string) error {
 	return nil
 }
 
+func (c sdkAgent) Renew(serial string) error {
+	if err := c.sdk.RenewCert(serial); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (c sdkAgent) ListCerts(pm sdk.PageMetadata) (CertPage, error) {
 	certPage, err := c.sdk.ListCerts(pm)
 	if err != nil {
@@ -0,0 +1,8 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package localca provides a PKI agent backed by a local certificate
+// authority. It signs client certificates with a CA key pair loaded from
+// disk and keeps the issued certificates in memory, which makes it suitable
+// for development and single-node deployments without an external PKI.
+package localca
@@ -0,0 +1,195 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package localca
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/absmach/certs/sdk"
+	"github.com/absmach/supermq/certs/pki/amcerts"
+	"github.com/absmach/supermq/pkg/errors"
+)
+
+const (
+	defTTL     = 8760 * time.Hour
+	rsaKeyBits = 2048
+)
+
+var (
+	errParseCA     = errors.New("failed to parse CA certificate")
+	errCertExpired = errors.New("certificate expired")
+	// ErrCertNotFound indicates that the requested certificate is not issued by this CA.
+	ErrCertNotFound = errors.New("certificate not found")
+)
+
+var _ amcerts.Agent = (*agent)(nil)
+
+type agent struct {
+	caCert *x509.Certificate
+	caKey  interface{}
+	certs  map[string]amcerts.Cert
+	ttls   map[string]string
+	mu     sync.RWMutex
+}
+
+// NewAgent loads the CA key pair from the given paths and returns a PKI
+// agent that issues certificates signed by it.
+func NewAgent(caPath, caKeyPath string) (amcerts.Agent, error) {
+	tlsCert, err := tls.LoadX509KeyPair(caPath, caKeyPath)
+	if err != nil {
+		return nil, err
+	}
+	caCert, err := x509.ParseCertificate(tlsCert.Certificate[0])
+	if err != nil {
+		return nil, errors.Wrap(errParseCA, err)
+	}
+
+	return &agent{
+		caCert: caCert,
+		caKey:  tlsCert.PrivateKey,
+		certs:  map[string]amcerts.Cert{},
+		ttls:   map[string]string{},
+	}, nil
+}
+
+func (a *agent) Issue(entityID, ttl string, ipAddrs []string) (amcerts.Cert, error) {
+	validity := defTTL
+	if ttl != "" {
+		var err error
+		validity, err = time.ParseDuration(ttl)
+		if err != nil {
+			return amcerts.Cert{}, err
+		}
+	}
+
+	key, err := rsa.GenerateKey(rand.Reader, rsaKeyBits)
+	if err != nil {
+		return amcerts.Cert{}, err
+	}
+
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return amcerts.Cert{}, err
+	}
+
+	var ips []net.IP
+	for _, addr := range ipAddrs {
+		if ip := net.ParseIP(addr); ip != nil {
+			ips = append(ips, ip)
+		}
+	}
+
+	tmpl := x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: entityID},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(validity),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		IPAddresses:  ips,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, &tmpl, a.caCert, &key.PublicKey, a.caKey)
+	if err != nil {
+		return amcerts.Cert{}, err
+	}
+
+	cert := amcerts.Cert{
+		SerialNumber: serial.Text(16),
+		Certificate:  string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		Key:          string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})),
+		ExpiryTime:   tmpl.NotAfter,
+		ClientID:     entityID,
+	}
+
+	a.mu.Lock()
+	a.certs[cert.SerialNumber] = cert
+	a.ttls[cert.SerialNumber] = ttl
+	a.mu.Unlock()
+
+	return cert, nil
+}
+
+func (a *agent) View(serial string) (amcerts.Cert, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	cert, ok := a.certs[serial]
+	if !ok {
+		return amcerts.Cert{}, ErrCertNotFound
+	}
+	return cert, nil
+}
+
+func (a *agent) Revoke(serial string) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	cert, ok := a.certs[serial]
+	if !ok {
+		return ErrCertNotFound
+	}
+	cert.Revoked = true
+	a.certs[serial] = cert
+	return nil
+}
+
+func (a *agent) Renew(serial string) error {
+	a.mu.RLock()
+	cert, ok := a.certs[serial]
+	ttl := a.ttls[serial]
+	a.mu.RUnlock()
+	if !ok {
+		return ErrCertNotFound
+	}
+	if cert.Revoked {
+		return errCertExpired
+	}
+
+	renewed, err := a.Issue(cert.ClientID, ttl, nil)
+	if err != nil {
+		return err
+	}
+
+	a.mu.Lock()
+	renewed.SerialNumber = serial
+	a.certs[serial] = renewed
+	a.mu.Unlock()
+
+	return nil
+}
+
+func (a *agent) ListCerts(pm sdk.PageMetadata) (amcerts.CertPage, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	var all []amcerts.Cert
+	for _, cert := range a.certs {
+		if pm.EntityID == "" || pm.EntityID == cert.ClientID {
+			all = append(all, cert)
+		}
+	}
+
+	page := amcerts.CertPage{
+		Total:  uint64(len(all)),
+		Offset: pm.Offset,
+		Limit:  pm.Limit,
+	}
+	if pm.Offset >= uint64(len(all)) {
+		return page, nil
+	}
+	end := pm.Offset + pm.Limit
+	if pm.Limit == 0 || end > uint64(len(all)) {
+		end = uint64(len(all))
+	}
+	page.Certificates = all[pm.Offset:end]
+
+	return page, nil
+}
@@ -0,0 +1,188 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package pki
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/absmach/certs/sdk"
+	"github.com/absmach/supermq/certs/pki/amcerts"
+	"github.com/absmach/supermq/pkg/errors"
+	"github.com/mitchellh/mapstructure"
+)
+
+const certsList = "certs"
+
+var (
+	errParseCert   = errors.New("failed to parse certificate")
+	errCertMissing = errors.New("certificate not found")
+)
+
+var _ amcerts.Agent = (*agent)(nil)
+
+// agent adapts the Vault PKI secrets engine to the certs service
+// backend interface.
+type agent struct {
+	pki *pkiAgent
+}
+
+// NewAgent returns a PKI agent issuing certificates through the Vault
+// PKI secrets engine mounted at the given path. It logs in to Vault and
+// keeps the token renewed until the context is canceled.
+func NewAgent(ctx context.Context, appRole, appSecret, host, namespace, path, role string, logger *slog.Logger) (amcerts.Agent, error) {
+	a, err := NewVaultClient(appRole, appSecret, host, namespace, path, role, logger)
+	if err != nil {
+		return nil, err
+	}
+	p := a.(*pkiAgent)
+	go func() {
+		if err := p.LoginAndRenew(ctx); err != nil {
+			logger.Error("vault login and renew failed", slog.Any("error", err))
+		}
+	}()
+	return &agent{pki: p}, nil
+}
+
+func (a *agent) Issue(entityId, ttl string, ipAddrs []string) (amcerts.Cert, error) {
+	req := map[string]interface{}{
+		"common_name": entityId,
+		"ttl":         ttl,
+	}
+	if len(ipAddrs) > 0 {
+		req["ip_sans"] = strings.Join(ipAddrs, ",")
+	}
+
+	s, err := a.pki.client.Logical().Write(a.pki.issueURL, req)
+	if err != nil {
+		return amcerts.Cert{}, err
+	}
+
+	var crt Cert
+	if err := mapstructure.Decode(s.Data, &crt); err != nil {
+		return amcerts.Cert{}, errors.Wrap(errFailedCertDecoding, err)
+	}
+
+	return amcerts.Cert{
+		SerialNumber: crt.Serial,
+		Certificate:  crt.ClientCert,
+		Key:          crt.ClientKey,
+		ExpiryTime:   time.Unix(crt.Expire, 0),
+		ClientID:     entityId,
+	}, nil
+}
+
+func (a *agent) View(serialNumber string) (amcerts.Cert, error) {
+	s, err := a.pki.client.Logical().Read(a.pki.readURL + serialNumber)
+	if err != nil {
+		return amcerts.Cert{}, err
+	}
+	if s == nil {
+		return amcerts.Cert{}, errCertMissing
+	}
+
+	pemCert, _ := s.Data["certificate"].(string)
+	crt, err := parseCert(pemCert)
+	if err != nil {
+		return amcerts.Cert{}, err
+	}
+
+	revoked := false
+	if revocationTime, ok := s.Data["revocation_time"]; ok {
+		if n, ok := toInt64(revocationTime); ok && n > 0 {
+			revoked = true
+		}
+	}
+
+	return amcerts.Cert{
+		SerialNumber: serialNumber,
+		Certificate:  pemCert,
+		Revoked:      revoked,
+		ExpiryTime:   crt.NotAfter,
+		ClientID:     crt.Subject.CommonName,
+	}, nil
+}
+
+func (a *agent) Revoke(serialNumber string) error {
+	_, err := a.pki.Revoke(serialNumber)
+	return err
+}
+
+// Renew issues a new certificate with the subject of the existing one.
+// The Vault PKI engine has no renewal operation, so reissuing is the
+// way a certificate's lifetime is extended.
+func (a *agent) Renew(serialNumber string) error {
+	crt, err := a.View(serialNumber)
+	if err != nil {
+		return err
+	}
+	if _, err := a.Issue(crt.ClientID, "", nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (a *agent) ListCerts(pm sdk.PageMetadata) (amcerts.CertPage, error) {
+	s, err := a.pki.client.Logical().List("/" + a.pki.path + "/" + certsList)
+	if err != nil {
+		return amcerts.CertPage{}, err
+	}
+
+	serials := []string{}
+	if s != nil {
+		if keys, ok := s.Data["keys"].([]interface{}); ok {
+			for _, key := range keys {
+				if serial, ok := key.(string); ok {
+					serials = append(serials, serial)
+				}
+			}
+		}
+	}
+
+	page := amcerts.CertPage{
+		Total:  uint64(len(serials)),
+		Offset: pm.Offset,
+		Limit:  pm.Limit,
+	}
+	for i := pm.Offset; i < uint64(len(serials)) && uint64(len(page.Certificates)) < pm.Limit; i++ {
+		crt, err := a.View(serials[i])
+		if err != nil {
+			return amcerts.CertPage{}, err
+		}
+		page.Certificates = append(page.Certificates, crt)
+	}
+
+	return page, nil
+}
+
+func toInt64(v interface{}) (int64, bool) {
+	switch n := v.(type) {
+	case json.Number:
+		i, err := n.Int64()
+		return i, err == nil
+	case float64:
+		return int64(n), true
+	case int64:
+		return n, true
+	default:
+		return 0, false
+	}
+}
+
+func parseCert(pemCert string) (*x509.Certificate, error) {
+	block, _ := pem.Decode([]byte(pemCert))
+	if block == nil {
+		return nil, errParseCert
+	}
+	crt, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, errors.Wrap(errParseCert, err)
+	}
+	return crt, nil
+}
@@ -0,0 +1,111 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package certs
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/absmach/certs/sdk"
+	pki "github.com/absmach/supermq/certs/pki/amcerts"
+	"github.com/absmach/supermq/pkg/events"
+)
+
+const renewListLimit = 10000
+
+// Renewal events.
+const (
+	certRenew       = "cert.renew"
+	certRenewFailed = "cert.renew_failed"
+)
+
+var _ events.Event = (*renewEvent)(nil)
+
+type renewEvent struct {
+	operation string
+	cert      pki.Cert
+	err       error
+}
+
+func (e renewEvent) Encode() (map[string]interface{}, error) {
+	val := map[string]interface{}{
+		"operation":     e.operation,
+		"serial_number": e.cert.SerialNumber,
+		"client_id":     e.cert.ClientID,
+		"expiry_time":   e.cert.ExpiryTime,
+	}
+	if e.err != nil {
+		val["error"] = e.err.Error()
+	}
+	return val, nil
+}
+
+// Renewer periodically renews certificates that are about to expire.
+type Renewer struct {
+	pki       pki.Agent
+	publisher events.Publisher
+	logger    *slog.Logger
+	interval  time.Duration
+	threshold time.Duration
+}
+
+// NewRenewer returns a certificate renewer that checks every interval for
+// certificates expiring within threshold, renews them through the PKI and
+// publishes an event for every renewal attempt.
+func NewRenewer(pkiAgent pki.Agent, publisher events.Publisher, logger *slog.Logger, interval, threshold time.Duration) *Renewer {
+	return &Renewer{
+		pki:       pkiAgent,
+		publisher: publisher,
+		logger:    logger,
+		interval:  interval,
+		threshold: threshold,
+	}
+}
+
+// Run starts the renewal loop and blocks until the context is canceled.
+func (r *Renewer) Run(ctx context.Context) error {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			r.renewExpiring(ctx)
+		}
+	}
+}
+
+func (r *Renewer) renewExpiring(ctx context.Context) {
+	cp, err := r.pki.ListCerts(sdk.PageMetadata{Offset: 0, Limit: renewListLimit})
+	if err != nil {
+		r.logger.Warn(fmt.Sprintf("failed to list certificates for renewal: %s", err))
+		return
+	}
+
+	for _, cert := range cp.Certificates {
+		if cert.Revoked || time.Until(cert.ExpiryTime) > r.threshold {
+			continue
+		}
+		if err := r.pki.Renew(cert.SerialNumber); err != nil {
+			r.logger.Warn(fmt.Sprintf("failed to renew certificate %s for client %s: %s", cert.SerialNumber, cert.ClientID, err))
+			r.publish(ctx, renewEvent{operation: certRenewFailed, cert: cert, err: err})
+			continue
+		}
+		r.logger.Info(fmt.Sprintf("renewed certificate %s for client %s expiring at %s", cert.SerialNumber, cert.ClientID, cert.ExpiryTime))
+		r.publish(ctx, renewEvent{operation: certRenew, cert: cert})
+	}
+}
+
+func (r *Renewer) publish(ctx context.Context, e renewEvent) {
+	if r.publisher == nil {
+		return
+	}
+	if err := r.publisher.Publish(ctx, e); err != nil {
+		r.logger.Warn(fmt.Sprintf("failed to publish %s event for certificate %s: %s", e.operation, e.cert.SerialNumber, err))
+	}
+}
@@ -5,6 +5,7 @@ package certs
 
 import (
 	"context"
+	"crypto/x509"
 	"time"
 
 	"github.com/absmach/certs/sdk"
@@ -24,6 +25,13 @@ var (
 	ErrFailedToRemoveCertFromDB = errors.New("failed to remove cert serial from db")
 
 	ErrFailedReadFromPKI = errors.New("failed to read certificate from PKI")
+
+	// ErrFailedProvision failed to provision client from device certificate.
+	ErrFailedProvision = errors.New("failed to provision client from device certificate")
+
+	errNoManufacturerCA = errors.New("no manufacturing CA registered")
+
+	errMissingCertCN = errors.New("device certificate has no common name")
 )
 
 var _ Service = (*certsService)(nil)
@@ -47,18 +55,25 @@ type Service interface {
 
 	// RevokeCert revokes a certificate for a given client ID
 	RevokeCert(ctx context.Context, domainID, token, clientID string) (Revoke, error)
+
+	// Provision registers a client for a device presenting a certificate chained to a
+	// registered manufacturing CA, assigns it to a group derived from the certificate
+	// OU and issues its operational certificate in one round trip
+	Provision(ctx context.Context, domainID, token string, deviceCert []byte, ttl string) (Provisioning, error)
 }
 
 type certsService struct {
-	sdk mgsdk.SDK
-	pki pki.Agent
+	sdk             mgsdk.SDK
+	pki             pki.Agent
+	manufacturerCAs *x509.CertPool
 }
 
 // New returns new Certs service.
-func New(sdk mgsdk.SDK, pkiAgent pki.Agent) Service {
+func New(sdk mgsdk.SDK, pkiAgent pki.Agent, manufacturerCAs *x509.CertPool) Service {
 	return &certsService{
-		sdk: sdk,
-		pki: pkiAgent,
+		sdk:             sdk,
+		pki:             pkiAgent,
+		manufacturerCAs: manufacturerCAs,
 	}
 }
 
@@ -168,6 +183,81 @@ func (cs *certsService) ListSerials(ctx context.Context, clientID string, pm Pag
 	}, nil
 }
 
+func (cs *certsService) Provision(ctx context.Context, domainID, token string, deviceCert []byte, ttl string) (Provisioning, error) {
+	if cs.manufacturerCAs == nil {
+		return Provisioning{}, errors.Wrap(ErrFailedProvision, errNoManufacturerCA)
+	}
+
+	crt, err := ReadCert(deviceCert)
+	if err != nil {
+		return Provisioning{}, errors.Wrap(ErrFailedProvision, err)
+	}
+	opts := x509.VerifyOptions{
+		Roots:     cs.manufacturerCAs,
+		KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	}
+	if _, err := crt.Verify(opts); err != nil {
+		return Provisioning{}, errors.Wrap(ErrFailedProvision, err)
+	}
+	if crt.Subject.CommonName == "" {
+		return Provisioning{}, errors.Wrap(ErrFailedProvision, errMissingCertCN)
+	}
+
+	client, err := cs.sdk.CreateClient(mgsdk.Client{
+		Name:       crt.Subject.CommonName,
+		ExternalID: crt.SerialNumber.String(),
+	}, domainID, token)
+	if err != nil {
+		return Provisioning{}, errors.Wrap(ErrFailedProvision, err)
+	}
+
+	var groupID string
+	if len(crt.Subject.OrganizationalUnit) > 0 {
+		groupID, err = cs.groupByName(crt.Subject.OrganizationalUnit[0], domainID, token)
+		if err != nil {
+			return Provisioning{}, errors.Wrap(ErrFailedProvision, err)
+		}
+		if err := cs.sdk.SetClientParent(client.ID, domainID, groupID, token); err != nil {
+			return Provisioning{}, errors.Wrap(ErrFailedProvision, err)
+		}
+	}
+
+	cert, err := cs.pki.Issue(client.ID, ttl, []string{})
+	if err != nil {
+		return Provisioning{}, errors.Wrap(ErrFailedCertCreation, err)
+	}
+
+	return Provisioning{
+		ClientID:     client.ID,
+		ClientSecret: client.Credentials.Secret,
+		GroupID:      groupID,
+		Cert: Cert{
+			SerialNumber: cert.SerialNumber,
+			Certificate:  cert.Certificate,
+			Key:          cert.Key,
+			Revoked:      cert.Revoked,
+			ExpiryTime:   cert.ExpiryTime,
+			ClientID:     cert.ClientID,
+		},
+	}, nil
+}
+
+func (cs *certsService) groupByName(name, domainID, token string) (string, error) {
+	gp, err := cs.sdk.Groups(mgsdk.PageMetadata{Name: name, Limit: 1}, domainID, token)
+	if err != nil {
+		return "", err
+	}
+	if len(gp.Groups) > 0 {
+		return gp.Groups[0].ID, nil
+	}
+
+	group, err := cs.sdk.CreateGroup(mgsdk.Group{Name: name}, domainID, token)
+	if err != nil {
+		return "", err
+	}
+	return group.ID, nil
+}
+
 func (cs *certsService) ViewCert(ctx context.Context, serialID string) (Cert, error) {
 	cert, err := cs.pki.View(serialID)
 	if err != nil {
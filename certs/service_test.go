@@ -5,7 +5,13 @@ package certs_test
 
 import (
 	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
 	"fmt"
+	"math/big"
 	"testing"
 	"time"
 
@@ -37,7 +43,14 @@ func newService(_ *testing.T) (certs.Service, *mocks.Agent, *sdkmocks.SDK) {
 	agent := new(mocks.Agent)
 	sdk := new(sdkmocks.SDK)
 
-	return certs.New(sdk, agent), agent, sdk
+	return certs.New(sdk, agent, nil), agent, sdk
+}
+
+func newProvisionService(t *testing.T, cas *x509.CertPool) (certs.Service, *mocks.Agent, *sdkmocks.SDK) {
+	agent := new(mocks.Agent)
+	sdk := new(sdkmocks.SDK)
+
+	return certs.New(sdk, agent, cas), agent, sdk
 }
 
 var cert = mgcrt.Cert{
@@ -343,3 +356,123 @@ func TestViewCert(t *testing.T) {
 		})
 	}
 }
+
+func TestProvision(t *testing.T) {
+	ca, caKey, caPool := newManufacturerCA(t)
+	otherCA, otherKey, _ := newManufacturerCA(t)
+
+	svc, agent, sdk := newProvisionService(t, caPool)
+
+	groupID := "group-1"
+	deviceCert := newDeviceCert(t, ca, caKey, "device-001", "sensors")
+	untrustedCert := newDeviceCert(t, otherCA, otherKey, "device-002", "sensors")
+	anonymousCert := newDeviceCert(t, ca, caKey, "", "")
+
+	cases := []struct {
+		desc       string
+		deviceCert []byte
+		groupsPage mgsdk.GroupsPage
+		clientErr  errors.SDKError
+		groupID    string
+		err        error
+	}{
+		{
+			desc:       "provision device assigned to existing group",
+			deviceCert: deviceCert,
+			groupsPage: mgsdk.GroupsPage{Groups: []mgsdk.Group{{ID: groupID, Name: "sensors"}}},
+			groupID:    groupID,
+		},
+		{
+			desc:       "provision device assigned to new group",
+			deviceCert: deviceCert,
+			groupID:    groupID,
+		},
+		{
+			desc:       "provision device with untrusted certificate",
+			deviceCert: untrustedCert,
+			err:        certs.ErrFailedProvision,
+		},
+		{
+			desc:       "provision device with malformed certificate",
+			deviceCert: []byte("malformed"),
+			err:        certs.ErrFailedProvision,
+		},
+		{
+			desc:       "provision device with certificate without common name",
+			deviceCert: anonymousCert,
+			err:        certs.ErrFailedProvision,
+		},
+		{
+			desc:       "provision device with failed client creation",
+			deviceCert: deviceCert,
+			clientErr:  errors.NewSDKError(svcerr.ErrAuthentication),
+			err:        certs.ErrFailedProvision,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			sdkCall := sdk.On("CreateClient", mock.Anything, domain, token).Return(mgsdk.Client{ID: clientID, Credentials: mgsdk.ClientCredentials{Secret: clientKey}}, tc.clientErr)
+			sdkCall1 := sdk.On("Groups", mock.Anything, domain, token).Return(tc.groupsPage, nil)
+			sdkCall2 := sdk.On("CreateGroup", mock.Anything, domain, token).Return(mgsdk.Group{ID: groupID, Name: "sensors"}, nil)
+			sdkCall3 := sdk.On("SetClientParent", clientID, domain, groupID, token).Return(nil)
+			agentCall := agent.On("Issue", clientID, ttl, []string{}).Return(cert, nil)
+			resp, err := svc.Provision(context.Background(), domain, token, tc.deviceCert, ttl)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			if tc.err == nil {
+				assert.Equal(t, clientID, resp.ClientID, fmt.Sprintf("%s: expected %s got %s\n", tc.desc, clientID, resp.ClientID))
+				assert.Equal(t, tc.groupID, resp.GroupID, fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.groupID, resp.GroupID))
+				assert.Equal(t, cert.SerialNumber, resp.Cert.SerialNumber, fmt.Sprintf("%s: expected %s got %s\n", tc.desc, cert.SerialNumber, resp.Cert.SerialNumber))
+			}
+			sdkCall.Unset()
+			sdkCall1.Unset()
+			sdkCall2.Unset()
+			sdkCall3.Unset()
+			agentCall.Unset()
+		})
+	}
+
+	svcNoCA, _, _ := newService(t)
+	_, err := svcNoCA.Provision(context.Background(), domain, token, deviceCert, ttl)
+	assert.True(t, errors.Contains(err, certs.ErrFailedProvision), fmt.Sprintf("provision without registered manufacturing CA: expected %s got %s\n", certs.ErrFailedProvision, err))
+}
+
+func newManufacturerCA(t *testing.T) (*x509.Certificate, ed25519.PrivateKey, *x509.CertPool) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	assert.Nil(t, err, fmt.Sprintf("generate CA key unexpected error: %s", err))
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Manufacturing CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, pub, priv)
+	assert.Nil(t, err, fmt.Sprintf("create CA certificate unexpected error: %s", err))
+	ca, err := x509.ParseCertificate(der)
+	assert.Nil(t, err, fmt.Sprintf("parse CA certificate unexpected error: %s", err))
+	pool := x509.NewCertPool()
+	pool.AddCert(ca)
+
+	return ca, priv, pool
+}
+
+func newDeviceCert(t *testing.T, ca *x509.Certificate, caKey ed25519.PrivateKey, cn, ou string) []byte {
+	pub, _, err := ed25519.GenerateKey(rand.Reader)
+	assert.Nil(t, err, fmt.Sprintf("generate device key unexpected error: %s", err))
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(42),
+		Subject:      pkix.Name{CommonName: cn},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	if ou != "" {
+		tmpl.Subject.OrganizationalUnit = []string{ou}
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca, pub, caKey)
+	assert.Nil(t, err, fmt.Sprintf("create device certificate unexpected error: %s", err))
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
@@ -77,3 +77,13 @@ func (tm *tracingMiddleware) RevokeCert(ctx context.Context, domainID, token, se
 
 	return tm.svc.RevokeCert(ctx, domainID, token, serialID)
 }
+
+// Provision traces the "Provision" operation of the wrapped certs.Service.
+func (tm *tracingMiddleware) Provision(ctx context.Context, domainID, token string, deviceCert []byte, ttl string) (certs.Provisioning, error) {
+	ctx, span := tm.tracer.Start(ctx, "svc_provision", trace.WithAttributes(
+		attribute.String("ttl", ttl),
+	))
+	defer span.End()
+
+	return tm.svc.Provision(ctx, domainID, token, deviceCert, ttl)
+}
@@ -117,7 +117,7 @@ func TestAuthorize(t *testing.T) {
 				ChannelID:  tc.channelID,
 				Type:       tc.connType,
 			}
-			svcCall := svc.On("Authorize", mock.Anything, authReq).Return(tc.authzErr)
+			svcCall := svc.On("Authorize", mock.Anything, authReq).Return([]string(nil), tc.authzErr)
 			res, err := client.Authorize(context.Background(), &grpcChannelsV1.AuthzReq{
 				DomainId:   tc.domainID,
 				ClientId:   tc.clientID,
@@ -4,7 +4,8 @@
 package grpc
 
 type authorizeRes struct {
-	authorized bool
+	authorized       bool
+	subtopicPatterns []string
 }
 
 type removeClientConnectionsRes struct{}
@@ -81,7 +81,7 @@ func decodeAuthorizeRequest(_ context.Context, grpcReq interface{}) (interface{}
 
 func encodeAuthorizeResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
 	res := grpcRes.(authorizeRes)
-	return &grpcChannelsV1.AuthzRes{Authorized: res.authorized}, nil
+	return &grpcChannelsV1.AuthzRes{Authorized: res.authorized, SubtopicPatterns: res.subtopicPatterns}, nil
 }
 
 func (s *grpcServer) RemoveClientConnections(ctx context.Context, req *grpcChannelsV1.RemoveClientConnectionsReq) (*grpcChannelsV1.RemoveClientConnectionsRes, error) {
@@ -174,6 +174,9 @@ func encodeError(err error) error {
 	case errors.Contains(err, svcerr.ErrAuthorization):
 		return status.Error(codes.PermissionDenied, err.Error())
 	default:
+		if code := errors.CodeOf(err); code != errors.CodeInternal {
+			return status.Error(code.GRPCStatus(), err.Error())
+		}
 		return status.Error(codes.Internal, err.Error())
 	}
 }
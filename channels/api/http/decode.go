@@ -13,6 +13,7 @@ import (
 	apiutil "github.com/absmach/supermq/api/http/util"
 	smqclients "github.com/absmach/supermq/clients"
 	"github.com/absmach/supermq/pkg/errors"
+	"github.com/absmach/supermq/pkg/labels"
 	"github.com/go-chi/chi/v5"
 )
 
@@ -88,6 +89,14 @@ func decodeListChannels(_ context.Context, r *http.Request) (interface{}, error)
 	if err != nil {
 		return nil, errors.Wrap(apiutil.ErrValidation, err)
 	}
+	ls, err := apiutil.ReadStringQuery(r, api.LabelSelectorKey, "")
+	if err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	sel, err := labels.ParseSelector(ls)
+	if err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, err)
+	}
 	st, err := smqclients.ToStatus(s)
 	if err != nil {
 		return nil, errors.Wrap(apiutil.ErrValidation, err)
@@ -101,6 +110,7 @@ func decodeListChannels(_ context.Context, r *http.Request) (interface{}, error)
 		tag:        t,
 		permission: p,
 		listPerms:  lp,
+		selector:   sel,
 		userID:     chi.URLParam(r, "userID"),
 		id:         id,
 	}
@@ -137,6 +147,21 @@ func decodeUpdateChannelTags(_ context.Context, r *http.Request) (interface{}, e
 	return req, nil
 }
 
+func decodeSetChannelPublic(_ context.Context, r *http.Request) (interface{}, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), api.ContentType) {
+		return nil, errors.Wrap(apiutil.ErrValidation, apiutil.ErrUnsupportedContentType)
+	}
+
+	req := setChannelPublicReq{
+		id: chi.URLParam(r, "channelID"),
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, errors.Wrap(errors.ErrMalformedEntity, err))
+	}
+
+	return req, nil
+}
+
 func decodeSetChannelParentGroupStatus(_ context.Context, r *http.Request) (interface{}, error) {
 	if !strings.Contains(r.Header.Get("Content-Type"), api.ContentType) {
 		return nil, errors.Wrap(apiutil.ErrValidation, apiutil.ErrUnsupportedContentType)
@@ -202,6 +227,40 @@ func decodeDisconnectChannelClientsRequest(_ context.Context, r *http.Request) (
 	return req, nil
 }
 
+func decodeListChannelConnectionsRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	o, err := apiutil.ReadNumQuery[uint64](r, api.OffsetKey, api.DefOffset)
+	if err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	l, err := apiutil.ReadNumQuery[uint64](r, api.LimitKey, api.DefLimit)
+	if err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, err)
+	}
+
+	req := listChannelConnectionsReq{
+		channelID: chi.URLParam(r, "channelID"),
+		offset:    o,
+		limit:     l,
+	}
+
+	return req, nil
+}
+
+func decodeUpdateConnectionRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), api.ContentType) {
+		return nil, errors.Wrap(apiutil.ErrValidation, apiutil.ErrUnsupportedContentType)
+	}
+	req := updateConnectionReq{
+		channelID: chi.URLParam(r, "channelID"),
+		clientID:  chi.URLParam(r, "clientID"),
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, errors.Wrap(errors.ErrMalformedEntity, err))
+	}
+
+	return req, nil
+}
+
 func decodeConnectRequest(_ context.Context, r *http.Request) (interface{}, error) {
 	if !strings.Contains(r.Header.Get("Content-Type"), api.ContentType) {
 		return nil, errors.Wrap(apiutil.ErrValidation, apiutil.ErrUnsupportedContentType)
@@ -104,15 +104,16 @@ func listChannelsEndpoint(svc channels.Service) endpoint.Endpoint {
 		}
 
 		pm := channels.PageMetadata{
-			Status:     req.status,
-			Offset:     req.offset,
-			Limit:      req.limit,
-			Name:       req.name,
-			Tag:        req.tag,
-			Permission: req.permission,
-			Metadata:   req.metadata,
-			ListPerms:  req.listPerms,
-			Id:         req.id,
+			Status:        req.status,
+			Offset:        req.offset,
+			Limit:         req.limit,
+			Name:          req.name,
+			Tag:           req.tag,
+			Permission:    req.permission,
+			Metadata:      req.metadata,
+			LabelSelector: req.selector,
+			ListPerms:     req.listPerms,
+			Id:            req.id,
 		}
 		page, err := svc.ListChannels(ctx, session, pm)
 		if err != nil {
@@ -151,6 +152,7 @@ func updateChannelEndpoint(svc channels.Service) endpoint.Endpoint {
 			ID:       req.id,
 			Name:     req.Name,
 			Metadata: req.Metadata,
+			Labels:   req.Labels,
 		}
 		ch, err := svc.UpdateChannel(ctx, session, ch)
 		if err != nil {
@@ -186,6 +188,27 @@ func updateChannelTagsEndpoint(svc channels.Service) endpoint.Endpoint {
 	}
 }
 
+func setChannelPublicEndpoint(svc channels.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(setChannelPublicReq)
+		if err := req.validate(); err != nil {
+			return nil, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(api.SessionKey).(authn.Session)
+		if !ok {
+			return nil, svcerr.ErrAuthentication
+		}
+
+		ch, err := svc.SetChannelPublic(ctx, session, req.id, req.Public)
+		if err != nil {
+			return nil, err
+		}
+
+		return updateChannelRes{Channel: ch}, nil
+	}
+}
+
 func setChannelParentGroupEndpoint(svc channels.Service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(setChannelParentGroupReq)
@@ -288,6 +311,80 @@ func connectChannelClientEndpoint(svc channels.Service) endpoint.Endpoint {
 	}
 }
 
+func listChannelConnectionsEndpoint(svc channels.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(listChannelConnectionsReq)
+		if err := req.validate(); err != nil {
+			return nil, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(api.SessionKey).(authn.Session)
+		if !ok {
+			return nil, svcerr.ErrAuthentication
+		}
+
+		pm := channels.PageMetadata{
+			Offset: req.offset,
+			Limit:  req.limit,
+		}
+		cp, err := svc.ListChannelConnections(ctx, session, req.channelID, pm)
+		if err != nil {
+			return nil, err
+		}
+
+		res := connectionsPageRes{
+			pageRes: pageRes{
+				Limit:  cp.Limit,
+				Offset: cp.Offset,
+				Total:  cp.Total,
+			},
+			Connections: []viewConnectionRes{},
+		}
+		for _, conn := range cp.Connections {
+			res.Connections = append(res.Connections, viewConnectionRes{
+				ClientID:  conn.ClientID,
+				ChannelID: conn.ChannelID,
+				Type:      conn.Type,
+				Metadata:  conn.Metadata,
+			})
+		}
+
+		return res, nil
+	}
+}
+
+func updateConnectionEndpoint(svc channels.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(updateConnectionReq)
+		if err := req.validate(); err != nil {
+			return nil, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(api.SessionKey).(authn.Session)
+		if !ok {
+			return nil, svcerr.ErrAuthentication
+		}
+
+		conn := channels.Connection{
+			ChannelID: req.channelID,
+			ClientID:  req.clientID,
+			Type:      req.Type,
+			Metadata:  req.Metadata,
+		}
+		conn, err := svc.UpdateConnection(ctx, session, conn)
+		if err != nil {
+			return nil, err
+		}
+
+		return updateConnectionRes{viewConnectionRes{
+			ClientID:  conn.ClientID,
+			ChannelID: conn.ChannelID,
+			Type:      conn.Type,
+			Metadata:  conn.Metadata,
+		}}, nil
+	}
+}
+
 func disconnectChannelClientsEndpoint(svc channels.Service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(disconnectChannelClientsRequest)
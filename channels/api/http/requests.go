@@ -11,6 +11,7 @@ import (
 	"github.com/absmach/supermq/channels"
 	smqclients "github.com/absmach/supermq/clients"
 	"github.com/absmach/supermq/pkg/connections"
+	"github.com/absmach/supermq/pkg/labels"
 )
 
 type createChannelReq struct {
@@ -21,6 +22,9 @@ func (req createChannelReq) validate() error {
 	if len(req.Channel.Name) > api.MaxNameSize {
 		return apiutil.ErrNameSize
 	}
+	if err := req.Channel.Labels.Validate(); err != nil {
+		return err
+	}
 	if req.Channel.ID != "" {
 		if strings.TrimSpace(req.Channel.ID) == "" {
 			return apiutil.ErrMissingChannelID
@@ -74,6 +78,7 @@ type listChannelsReq struct {
 	userID     string
 	listPerms  bool
 	metadata   smqclients.Metadata
+	selector   labels.Selector
 	id         string
 }
 
@@ -98,6 +103,7 @@ type updateChannelReq struct {
 	id       string
 	Name     string                 `json:"name,omitempty"`
 	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	Labels   labels.Labels          `json:"labels,omitempty"`
 	Tags     []string               `json:"tags,omitempty"`
 }
 
@@ -109,7 +115,7 @@ func (req updateChannelReq) validate() error {
 		return apiutil.ErrNameSize
 	}
 
-	return nil
+	return req.Labels.Validate()
 }
 
 type updateChannelTagsReq struct {
@@ -125,6 +131,19 @@ func (req updateChannelTagsReq) validate() error {
 	return nil
 }
 
+type setChannelPublicReq struct {
+	id     string
+	Public bool `json:"public"`
+}
+
+func (req setChannelPublicReq) validate() error {
+	if req.id == "" {
+		return apiutil.ErrMissingID
+	}
+
+	return nil
+}
+
 type setChannelParentGroupReq struct {
 	id            string
 	ParentGroupID string `json:"parent_group_id"`
@@ -225,6 +244,44 @@ func (req *disconnectChannelClientsRequest) validate() error {
 	return nil
 }
 
+type listChannelConnectionsReq struct {
+	channelID string
+	offset    uint64
+	limit     uint64
+}
+
+func (req listChannelConnectionsReq) validate() error {
+	if req.channelID == "" {
+		return apiutil.ErrMissingID
+	}
+	if req.limit > api.MaxLimitSize || req.limit < 1 {
+		return apiutil.ErrLimitSize
+	}
+
+	return nil
+}
+
+type updateConnectionReq struct {
+	channelID string
+	clientID  string
+	Type      connections.ConnType   `json:"type,omitempty"`
+	Metadata  map[string]interface{} `json:"metadata,omitempty"`
+}
+
+func (req *updateConnectionReq) validate() error {
+	if req.channelID == "" || req.clientID == "" {
+		return apiutil.ErrMissingID
+	}
+	if err := api.ValidateUUID(req.channelID); err != nil {
+		return err
+	}
+	if err := api.ValidateUUID(req.clientID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 type connectRequest struct {
 	ChannelIds []string               `json:"channel_ids,omitempty"`
 	ClientIds  []string               `json:"client_ids,omitempty"`
@@ -9,6 +9,7 @@ import (
 
 	"github.com/absmach/supermq"
 	"github.com/absmach/supermq/channels"
+	"github.com/absmach/supermq/pkg/connections"
 )
 
 var (
@@ -19,6 +20,8 @@ var (
 	_ supermq.Response = (*deleteChannelRes)(nil)
 	_ supermq.Response = (*connectChannelClientsRes)(nil)
 	_ supermq.Response = (*disconnectChannelClientsRes)(nil)
+	_ supermq.Response = (*connectionsPageRes)(nil)
+	_ supermq.Response = (*updateConnectionRes)(nil)
 	_ supermq.Response = (*connectRes)(nil)
 	_ supermq.Response = (*disconnectRes)(nil)
 	_ supermq.Response = (*changeChannelStatusRes)(nil)
@@ -192,6 +195,46 @@ func (res disconnectChannelClientsRes) Empty() bool {
 	return true
 }
 
+type viewConnectionRes struct {
+	ClientID  string                 `json:"client_id"`
+	ChannelID string                 `json:"channel_id"`
+	Type      connections.ConnType   `json:"type"`
+	Metadata  map[string]interface{} `json:"metadata,omitempty"`
+}
+
+type connectionsPageRes struct {
+	pageRes
+	Connections []viewConnectionRes `json:"connections"`
+}
+
+func (res connectionsPageRes) Code() int {
+	return http.StatusOK
+}
+
+func (res connectionsPageRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res connectionsPageRes) Empty() bool {
+	return false
+}
+
+type updateConnectionRes struct {
+	viewConnectionRes
+}
+
+func (res updateConnectionRes) Code() int {
+	return http.StatusOK
+}
+
+func (res updateConnectionRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res updateConnectionRes) Empty() bool {
+	return false
+}
+
 type connectRes struct{}
 
 func (res connectRes) Code() int {
@@ -82,6 +82,13 @@ func MakeHandler(svc channels.Service, authn smqauthn.Authentication, mux *chi.M
 				opts...,
 			), "update_channel_tag").ServeHTTP)
 
+			r.Patch("/public", otelhttp.NewHandler(kithttp.NewServer(
+				setChannelPublicEndpoint(svc),
+				decodeSetChannelPublic,
+				api.EncodeResponse,
+				opts...,
+			), "set_channel_public").ServeHTTP)
+
 			r.Delete("/", otelhttp.NewHandler(kithttp.NewServer(
 				deleteChannelEndpoint(svc),
 				decodeDeleteChannelReq,
@@ -130,6 +137,20 @@ func MakeHandler(svc channels.Service, authn smqauthn.Authentication, mux *chi.M
 				api.EncodeResponse,
 				opts...,
 			), "disconnect_channel_client").ServeHTTP)
+
+			r.Get("/clients", otelhttp.NewHandler(kithttp.NewServer(
+				listChannelConnectionsEndpoint(svc),
+				decodeListChannelConnectionsRequest,
+				api.EncodeResponse,
+				opts...,
+			), "list_channel_connections").ServeHTTP)
+
+			r.Patch("/clients/{clientID}", otelhttp.NewHandler(kithttp.NewServer(
+				updateConnectionEndpoint(svc),
+				decodeUpdateConnectionRequest,
+				api.EncodeResponse,
+				opts...,
+			), "update_connection").ServeHTTP)
 		})
 	})
 
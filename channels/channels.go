@@ -10,6 +10,7 @@ import (
 	clients "github.com/absmach/supermq/clients"
 	"github.com/absmach/supermq/pkg/authn"
 	"github.com/absmach/supermq/pkg/connections"
+	"github.com/absmach/supermq/pkg/labels"
 	"github.com/absmach/supermq/pkg/roles"
 )
 
@@ -22,11 +23,13 @@ type Channel struct {
 	ParentGroup string           `json:"parent_group_id,omitempty"`
 	Domain      string           `json:"domain_id,omitempty"`
 	Metadata    clients.Metadata `json:"metadata,omitempty"`
+	Labels      labels.Labels    `json:"labels,omitempty"`
 	CreatedAt   time.Time        `json:"created_at,omitempty"`
 	UpdatedAt   time.Time        `json:"updated_at,omitempty"`
 	UpdatedBy   string           `json:"updated_by,omitempty"`
 	Status      clients.Status   `json:"status,omitempty"`      // 1 for enabled, 0 for disabled
 	Permissions []string         `json:"permissions,omitempty"` // 1 for enabled, 0 for disabled
+	Public      bool             `json:"public"`                // allows unauthenticated read-only access
 }
 
 type PageMetadata struct {
@@ -45,6 +48,8 @@ type PageMetadata struct {
 	IDs        []string         `json:"ids,omitempty"`
 	ListPerms  bool             `json:"-"`
 	ClientID   string           `json:"-"`
+	// LabelSelector filters the listing by entity labels.
+	LabelSelector labels.Selector `json:"-"`
 }
 
 // ChannelsPage contains page related metadata as well as list of channels that
@@ -59,6 +64,14 @@ type Connection struct {
 	ChannelID string
 	DomainID  string
 	Type      connections.ConnType
+	Metadata  clients.Metadata
+}
+
+// ConnectionsPage contains page related metadata as well as list of
+// connections of a channel.
+type ConnectionsPage struct {
+	PageMetadata
+	Connections []Connection
 }
 
 type AuthzReq struct {
@@ -85,6 +98,10 @@ type Service interface {
 	// UpdateChannelTags updates the channel's tags.
 	UpdateChannelTags(ctx context.Context, session authn.Session, channel Channel) (Channel, error)
 
+	// SetChannelPublic marks the channel as publicly readable or reverts
+	// it to connected clients only.
+	SetChannelPublic(ctx context.Context, session authn.Session, id string, public bool) (Channel, error)
+
 	EnableChannel(ctx context.Context, session authn.Session, id string) (Channel, error)
 
 	DisableChannel(ctx context.Context, session authn.Session, id string) (Channel, error)
@@ -108,6 +125,14 @@ type Service interface {
 	// Disconnect removes clients from the channels list of connected clients.
 	Disconnect(ctx context.Context, session authn.Session, chIDs, clIDs []string, connType []connections.ConnType) error
 
+	// ListChannelConnections lists the clients connected to the channel
+	// along with per-connection metadata.
+	ListChannelConnections(ctx context.Context, session authn.Session, channelID string, pm PageMetadata) (ConnectionsPage, error)
+
+	// UpdateConnection updates the metadata of the connection between the
+	// channel and the client.
+	UpdateConnection(ctx context.Context, session authn.Session, conn Connection) (Connection, error)
+
 	SetParentGroup(ctx context.Context, session authn.Session, parentGroupID string, id string) error
 
 	RemoveParentGroup(ctx context.Context, session authn.Session, id string) error
@@ -129,6 +154,8 @@ type Repository interface {
 
 	UpdateTags(ctx context.Context, ch Channel) (Channel, error)
 
+	UpdatePublic(ctx context.Context, ch Channel) (Channel, error)
+
 	ChangeStatus(ctx context.Context, channel Channel) (Channel, error)
 
 	// RetrieveByID retrieves the channel having the provided identifier
@@ -150,9 +177,15 @@ type Repository interface {
 
 	RemoveConnections(ctx context.Context, conns []Connection) error
 
+	// RetrieveConnections retrieves the subset of connections of the channel.
+	RetrieveConnections(ctx context.Context, channelID string, pm PageMetadata) (ConnectionsPage, error)
+
+	// UpdateConnection updates the metadata of the connection.
+	UpdateConnection(ctx context.Context, conn Connection) (Connection, error)
+
 	CheckConnection(ctx context.Context, conn Connection) error
 
-	ClientAuthorize(ctx context.Context, conn Connection) error
+	ClientAuthorize(ctx context.Context, conn Connection) (Connection, error)
 
 	ChannelConnectionsCount(ctx context.Context, id string) (uint64, error)
 
@@ -13,17 +13,18 @@ import (
 )
 
 const (
-	channelPrefix       = "channels."
-	channelCreate       = channelPrefix + "create"
-	channelUpdate       = channelPrefix + "update"
-	channelChangeStatus = channelPrefix + "change_status"
-	channelRemove       = channelPrefix + "remove"
-	channelView         = channelPrefix + "view"
-	channelList         = channelPrefix + "list"
-	channelConnect      = channelPrefix + "connect"
-	channelDisconnect   = channelPrefix + "disconnect"
-	channelSetParent    = channelPrefix + "set_parent"
-	channelRemoveParent = channelPrefix + "remove_parent"
+	channelPrefix           = "channels."
+	channelCreate           = channelPrefix + "create"
+	channelUpdate           = channelPrefix + "update"
+	channelChangeStatus     = channelPrefix + "change_status"
+	channelRemove           = channelPrefix + "remove"
+	channelView             = channelPrefix + "view"
+	channelList             = channelPrefix + "list"
+	channelConnect          = channelPrefix + "connect"
+	channelDisconnect       = channelPrefix + "disconnect"
+	channelUpdateConnection = channelPrefix + "update_connection"
+	channelSetParent        = channelPrefix + "set_parent"
+	channelRemoveParent     = channelPrefix + "remove_parent"
 )
 
 var (
@@ -35,6 +36,7 @@ var (
 	_ events.Event = (*removeChannelEvent)(nil)
 	_ events.Event = (*connectEvent)(nil)
 	_ events.Event = (*disconnectEvent)(nil)
+	_ events.Event = (*updateConnectionEvent)(nil)
 )
 
 type createChannelEvent struct {
@@ -63,6 +65,9 @@ func (cce createChannelEvent) Encode() (map[string]interface{}, error) {
 	if cce.Metadata != nil {
 		val["metadata"] = cce.Metadata
 	}
+	if cce.Public {
+		val["public"] = cce.Public
+	}
 
 	return val, nil
 }
@@ -103,6 +108,9 @@ func (uce updateChannelEvent) Encode() (map[string]interface{}, error) {
 	if uce.Status.String() != "" {
 		val["status"] = uce.Status.String()
 	}
+	if uce.Public {
+		val["public"] = uce.Public
+	}
 
 	return val, nil
 }
@@ -276,6 +284,24 @@ func (ce connectEvent) Encode() (map[string]interface{}, error) {
 	}, nil
 }
 
+type updateConnectionEvent struct {
+	conn channels.Connection
+}
+
+func (uce updateConnectionEvent) Encode() (map[string]interface{}, error) {
+	val := map[string]interface{}{
+		"operation":  channelUpdateConnection,
+		"channel_id": uce.conn.ChannelID,
+		"client_id":  uce.conn.ClientID,
+		"type":       uce.conn.Type,
+	}
+	if uce.conn.Metadata != nil {
+		val["metadata"] = uce.conn.Metadata
+	}
+
+	return val, nil
+}
+
 type disconnectEvent struct {
 	chIDs []string
 	thIDs []string
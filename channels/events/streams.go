@@ -137,6 +137,15 @@ func (es *eventStore) ListChannelsByClient(ctx context.Context, session authn.Se
 	return cp, nil
 }
 
+func (es *eventStore) SetChannelPublic(ctx context.Context, session authn.Session, id string, public bool) (channels.Channel, error) {
+	chann, err := es.svc.SetChannelPublic(ctx, session, id, public)
+	if err != nil {
+		return chann, err
+	}
+
+	return es.update(ctx, "public", chann)
+}
+
 func (es *eventStore) EnableChannel(ctx context.Context, session authn.Session, id string) (channels.Channel, error) {
 	cli, err := es.svc.EnableChannel(ctx, session, id)
 	if err != nil {
@@ -211,6 +220,25 @@ func (es *eventStore) Disconnect(ctx context.Context, session authn.Session, chI
 	return nil
 }
 
+func (es *eventStore) ListChannelConnections(ctx context.Context, session authn.Session, channelID string, pm channels.PageMetadata) (channels.ConnectionsPage, error) {
+	return es.svc.ListChannelConnections(ctx, session, channelID, pm)
+}
+
+func (es *eventStore) UpdateConnection(ctx context.Context, session authn.Session, conn channels.Connection) (channels.Connection, error) {
+	conn, err := es.svc.UpdateConnection(ctx, session, conn)
+	if err != nil {
+		return conn, err
+	}
+
+	event := updateConnectionEvent{conn}
+
+	if err := es.Publish(ctx, event); err != nil {
+		return conn, err
+	}
+
+	return conn, nil
+}
+
 func (es *eventStore) SetParentGroup(ctx context.Context, session authn.Session, parentGroupID string, id string) (err error) {
 	if err := es.svc.SetParentGroup(ctx, session, parentGroupID, id); err != nil {
 		return err
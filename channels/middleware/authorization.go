@@ -158,6 +158,19 @@ func (am *authorizationMiddleware) UpdateChannelTags(ctx context.Context, sessio
 	return am.svc.UpdateChannelTags(ctx, session, channel)
 }
 
+func (am *authorizationMiddleware) SetChannelPublic(ctx context.Context, session authn.Session, id string, public bool) (channels.Channel, error) {
+	if err := am.authorize(ctx, channels.OpUpdateChannel, authz.PolicyReq{
+		Domain:      session.DomainID,
+		SubjectType: policies.UserType,
+		Subject:     session.DomainUserID,
+		ObjectType:  policies.ChannelType,
+		Object:      id,
+	}); err != nil {
+		return channels.Channel{}, errors.Wrap(err, errUpdate)
+	}
+	return am.svc.SetChannelPublic(ctx, session, id, public)
+}
+
 func (am *authorizationMiddleware) EnableChannel(ctx context.Context, session authn.Session, id string) (channels.Channel, error) {
 	if err := am.authorize(ctx, channels.OpEnableChannel, authz.PolicyReq{
 		Domain:      session.DomainID,
@@ -224,6 +237,32 @@ func (am *authorizationMiddleware) Connect(ctx context.Context, session authn.Se
 	return am.svc.Connect(ctx, session, chIDs, thIDs, connTypes)
 }
 
+func (am *authorizationMiddleware) ListChannelConnections(ctx context.Context, session authn.Session, channelID string, pm channels.PageMetadata) (channels.ConnectionsPage, error) {
+	if err := am.authorize(ctx, channels.OpViewChannel, authz.PolicyReq{
+		Domain:      session.DomainID,
+		SubjectType: policies.UserType,
+		Subject:     session.DomainUserID,
+		ObjectType:  policies.ChannelType,
+		Object:      channelID,
+	}); err != nil {
+		return channels.ConnectionsPage{}, errors.Wrap(err, errView)
+	}
+	return am.svc.ListChannelConnections(ctx, session, channelID, pm)
+}
+
+func (am *authorizationMiddleware) UpdateConnection(ctx context.Context, session authn.Session, conn channels.Connection) (channels.Connection, error) {
+	if err := am.authorize(ctx, channels.OpConnectClient, authz.PolicyReq{
+		Domain:      session.DomainID,
+		SubjectType: policies.UserType,
+		Subject:     session.DomainUserID,
+		ObjectType:  policies.ChannelType,
+		Object:      conn.ChannelID,
+	}); err != nil {
+		return channels.Connection{}, errors.Wrap(err, errConnect)
+	}
+	return am.svc.UpdateConnection(ctx, session, conn)
+}
+
 func (am *authorizationMiddleware) Disconnect(ctx context.Context, session authn.Session, chIDs, thIDs []string, connTypes []connections.ConnType) error {
 	for _, chID := range chIDs {
 		if err := am.authorize(ctx, channels.OpDisconnectClient, authz.PolicyReq{
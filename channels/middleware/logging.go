@@ -35,10 +35,10 @@ func (lm *loggingMiddleware) CreateChannels(ctx context.Context, session authn.S
 		}
 		if err != nil {
 			args = append(args, slog.String("error", err.Error()))
-			lm.logger.Warn(fmt.Sprintf("Create %d channels failed", len(clients)), args...)
+			lm.logger.WarnContext(ctx, fmt.Sprintf("Create %d channels failed", len(clients)), args...)
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("Create %d channel completed successfully", len(clients)), args...)
+		lm.logger.InfoContext(ctx, fmt.Sprintf("Create %d channel completed successfully", len(clients)), args...)
 	}(time.Now())
 	return lm.svc.CreateChannels(ctx, session, clients...)
 }
@@ -54,10 +54,10 @@ func (lm *loggingMiddleware) ViewChannel(ctx context.Context, session authn.Sess
 		}
 		if err != nil {
 			args = append(args, slog.String("error", err.Error()))
-			lm.logger.Warn("View channel failed", args...)
+			lm.logger.WarnContext(ctx, "View channel failed", args...)
 			return
 		}
-		lm.logger.Info("View channel completed successfully", args...)
+		lm.logger.InfoContext(ctx, "View channel completed successfully", args...)
 	}(time.Now())
 	return lm.svc.ViewChannel(ctx, session, id)
 }
@@ -74,10 +74,10 @@ func (lm *loggingMiddleware) ListChannels(ctx context.Context, session authn.Ses
 		}
 		if err != nil {
 			args = append(args, slog.String("error", err.Error()))
-			lm.logger.Warn("List channels failed", args...)
+			lm.logger.WarnContext(ctx, "List channels failed", args...)
 			return
 		}
-		lm.logger.Info("List channels completed successfully", args...)
+		lm.logger.InfoContext(ctx, "List channels completed successfully", args...)
 	}(time.Now())
 	return lm.svc.ListChannels(ctx, session, pm)
 }
@@ -95,10 +95,10 @@ func (lm *loggingMiddleware) ListChannelsByClient(ctx context.Context, session a
 		}
 		if err != nil {
 			args = append(args, slog.String("error", err.Error()))
-			lm.logger.Warn("List channels by client failed", args...)
+			lm.logger.WarnContext(ctx, "List channels by client failed", args...)
 			return
 		}
-		lm.logger.Info("List channels by client completed successfully", args...)
+		lm.logger.InfoContext(ctx, "List channels by client completed successfully", args...)
 	}(time.Now())
 	return lm.svc.ListChannelsByClient(ctx, session, clientID, pm)
 }
@@ -115,10 +115,10 @@ func (lm *loggingMiddleware) UpdateChannel(ctx context.Context, session authn.Se
 		}
 		if err != nil {
 			args = append(args, slog.String("error", err.Error()))
-			lm.logger.Warn("Update channel failed", args...)
+			lm.logger.WarnContext(ctx, "Update channel failed", args...)
 			return
 		}
-		lm.logger.Info("Update channel completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Update channel completed successfully", args...)
 	}(time.Now())
 	return lm.svc.UpdateChannel(ctx, session, client)
 }
@@ -135,14 +135,33 @@ func (lm *loggingMiddleware) UpdateChannelTags(ctx context.Context, session auth
 		}
 		if err != nil {
 			args := append(args, slog.String("error", err.Error()))
-			lm.logger.Warn("Update channel tags failed", args...)
+			lm.logger.WarnContext(ctx, "Update channel tags failed", args...)
 			return
 		}
-		lm.logger.Info("Update channel tags completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Update channel tags completed successfully", args...)
 	}(time.Now())
 	return lm.svc.UpdateChannelTags(ctx, session, client)
 }
 
+func (lm *loggingMiddleware) SetChannelPublic(ctx context.Context, session authn.Session, id string, public bool) (c channels.Channel, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.Group("channel",
+				slog.String("id", id),
+				slog.Bool("public", public),
+			),
+		}
+		if err != nil {
+			args := append(args, slog.String("error", err.Error()))
+			lm.logger.WarnContext(ctx, "Set channel public failed", args...)
+			return
+		}
+		lm.logger.InfoContext(ctx, "Set channel public completed successfully", args...)
+	}(time.Now())
+	return lm.svc.SetChannelPublic(ctx, session, id, public)
+}
+
 func (lm *loggingMiddleware) EnableChannel(ctx context.Context, session authn.Session, id string) (c channels.Channel, err error) {
 	defer func(begin time.Time) {
 		args := []any{
@@ -154,10 +173,10 @@ func (lm *loggingMiddleware) EnableChannel(ctx context.Context, session authn.Se
 		}
 		if err != nil {
 			args = append(args, slog.String("error", err.Error()))
-			lm.logger.Warn("Enable channel failed", args...)
+			lm.logger.WarnContext(ctx, "Enable channel failed", args...)
 			return
 		}
-		lm.logger.Info("Enable channel completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Enable channel completed successfully", args...)
 	}(time.Now())
 	return lm.svc.EnableChannel(ctx, session, id)
 }
@@ -173,10 +192,10 @@ func (lm *loggingMiddleware) DisableChannel(ctx context.Context, session authn.S
 		}
 		if err != nil {
 			args = append(args, slog.String("error", err.Error()))
-			lm.logger.Warn("Disable channel failed", args...)
+			lm.logger.WarnContext(ctx, "Disable channel failed", args...)
 			return
 		}
-		lm.logger.Info("Disable channel completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Disable channel completed successfully", args...)
 	}(time.Now())
 	return lm.svc.DisableChannel(ctx, session, id)
 }
@@ -189,10 +208,10 @@ func (lm *loggingMiddleware) RemoveChannel(ctx context.Context, session authn.Se
 		}
 		if err != nil {
 			args = append(args, slog.String("error", err.Error()))
-			lm.logger.Warn("Delete channel failed", args...)
+			lm.logger.WarnContext(ctx, "Delete channel failed", args...)
 			return
 		}
-		lm.logger.Info("Delete channel completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Delete channel completed successfully", args...)
 	}(time.Now())
 	return lm.svc.RemoveChannel(ctx, session, id)
 }
@@ -206,10 +225,10 @@ func (lm *loggingMiddleware) Connect(ctx context.Context, session authn.Session,
 		}
 		if err != nil {
 			args = append(args, slog.String("error", err.Error()))
-			lm.logger.Warn("Connect channels and clients failed", args...)
+			lm.logger.WarnContext(ctx, "Connect channels and clients failed", args...)
 			return
 		}
-		lm.logger.Info("Connect channels and clients completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Connect channels and clients completed successfully", args...)
 	}(time.Now())
 	return lm.svc.Connect(ctx, session, chIDs, clIDs, connTypes)
 }
@@ -223,14 +242,54 @@ func (lm *loggingMiddleware) Disconnect(ctx context.Context, session authn.Sessi
 		}
 		if err != nil {
 			args = append(args, slog.String("error", err.Error()))
-			lm.logger.Warn("Disconnect channels and clients failed", args...)
+			lm.logger.WarnContext(ctx, "Disconnect channels and clients failed", args...)
 			return
 		}
-		lm.logger.Info("Disconnect channels and clients completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Disconnect channels and clients completed successfully", args...)
 	}(time.Now())
 	return lm.svc.Disconnect(ctx, session, chIDs, clIDs, connTypes)
 }
 
+func (lm *loggingMiddleware) ListChannelConnections(ctx context.Context, session authn.Session, channelID string, pm channels.PageMetadata) (cp channels.ConnectionsPage, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("channel_id", channelID),
+			slog.Group("page",
+				slog.Uint64("limit", pm.Limit),
+				slog.Uint64("offset", pm.Offset),
+				slog.Uint64("total", cp.Total),
+			),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.WarnContext(ctx, "List channel connections failed", args...)
+			return
+		}
+		lm.logger.InfoContext(ctx, "List channel connections completed successfully", args...)
+	}(time.Now())
+	return lm.svc.ListChannelConnections(ctx, session, channelID, pm)
+}
+
+func (lm *loggingMiddleware) UpdateConnection(ctx context.Context, session authn.Session, conn channels.Connection) (c channels.Connection, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.Group("connection",
+				slog.String("channel_id", conn.ChannelID),
+				slog.String("client_id", conn.ClientID),
+			),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.WarnContext(ctx, "Update connection failed", args...)
+			return
+		}
+		lm.logger.InfoContext(ctx, "Update connection completed successfully", args...)
+	}(time.Now())
+	return lm.svc.UpdateConnection(ctx, session, conn)
+}
+
 func (lm *loggingMiddleware) SetParentGroup(ctx context.Context, session authn.Session, parentGroupID string, id string) (err error) {
 	defer func(begin time.Time) {
 		args := []any{
@@ -240,10 +299,10 @@ func (lm *loggingMiddleware) SetParentGroup(ctx context.Context, session authn.S
 		}
 		if err != nil {
 			args = append(args, slog.String("error", err.Error()))
-			lm.logger.Warn("Set parent group to channel failed", args...)
+			lm.logger.WarnContext(ctx, "Set parent group to channel failed", args...)
 			return
 		}
-		lm.logger.Info("Set parent group to channel completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Set parent group to channel completed successfully", args...)
 	}(time.Now())
 	return lm.svc.SetParentGroup(ctx, session, parentGroupID, id)
 }
@@ -256,10 +315,10 @@ func (lm *loggingMiddleware) RemoveParentGroup(ctx context.Context, session auth
 		}
 		if err != nil {
 			args = append(args, slog.String("error", err.Error()))
-			lm.logger.Warn("Remove parent group from channel failed", args...)
+			lm.logger.WarnContext(ctx, "Remove parent group from channel failed", args...)
 			return
 		}
-		lm.logger.Info("Remove parent group from channel completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Remove parent group from channel completed successfully", args...)
 	}(time.Now())
 	return lm.svc.RemoveParentGroup(ctx, session, id)
 }
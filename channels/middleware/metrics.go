@@ -10,6 +10,7 @@ import (
 	"github.com/absmach/supermq/channels"
 	"github.com/absmach/supermq/pkg/authn"
 	"github.com/absmach/supermq/pkg/connections"
+	"github.com/absmach/supermq/pkg/prometheus"
 	"github.com/absmach/supermq/pkg/roles"
 	rmMW "github.com/absmach/supermq/pkg/roles/rolemanager/middleware"
 	"github.com/go-kit/kit/metrics"
@@ -34,89 +35,113 @@ func MetricsMiddleware(svc channels.Service, counter metrics.Counter, latency me
 	}
 }
 
-func (ms *metricsMiddleware) CreateChannels(ctx context.Context, session authn.Session, chs ...channels.Channel) ([]channels.Channel, []roles.RoleProvision, error) {
+func (ms *metricsMiddleware) CreateChannels(ctx context.Context, session authn.Session, chs ...channels.Channel) (cs []channels.Channel, rps []roles.RoleProvision, err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "register_channels").Add(1)
+		ms.counter.With("method", "register_channels", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "register_channels").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.CreateChannels(ctx, session, chs...)
 }
 
-func (ms *metricsMiddleware) ViewChannel(ctx context.Context, session authn.Session, id string) (channels.Channel, error) {
+func (ms *metricsMiddleware) ViewChannel(ctx context.Context, session authn.Session, id string) (c channels.Channel, err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "view_channel").Add(1)
+		ms.counter.With("method", "view_channel", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "view_channel").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.ViewChannel(ctx, session, id)
 }
 
-func (ms *metricsMiddleware) ListChannels(ctx context.Context, session authn.Session, pm channels.PageMetadata) (channels.Page, error) {
+func (ms *metricsMiddleware) ListChannels(ctx context.Context, session authn.Session, pm channels.PageMetadata) (cp channels.Page, err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "list_channels").Add(1)
+		ms.counter.With("method", "list_channels", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "list_channels").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.ListChannels(ctx, session, pm)
 }
 
-func (ms *metricsMiddleware) ListChannelsByClient(ctx context.Context, session authn.Session, clientID string, pm channels.PageMetadata) (channels.Page, error) {
+func (ms *metricsMiddleware) ListChannelsByClient(ctx context.Context, session authn.Session, clientID string, pm channels.PageMetadata) (cp channels.Page, err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "list_channels_by_client").Add(1)
+		ms.counter.With("method", "list_channels_by_client", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "list_channels_by_client").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.ListChannelsByClient(ctx, session, clientID, pm)
 }
 
-func (ms *metricsMiddleware) UpdateChannel(ctx context.Context, session authn.Session, channel channels.Channel) (channels.Channel, error) {
+func (ms *metricsMiddleware) UpdateChannel(ctx context.Context, session authn.Session, channel channels.Channel) (c channels.Channel, err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "update_channel").Add(1)
+		ms.counter.With("method", "update_channel", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "update_channel").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.UpdateChannel(ctx, session, channel)
 }
 
-func (ms *metricsMiddleware) UpdateChannelTags(ctx context.Context, session authn.Session, channel channels.Channel) (channels.Channel, error) {
+func (ms *metricsMiddleware) UpdateChannelTags(ctx context.Context, session authn.Session, channel channels.Channel) (c channels.Channel, err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "update_channel_tags").Add(1)
+		ms.counter.With("method", "update_channel_tags", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "update_channel_tags").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.UpdateChannelTags(ctx, session, channel)
 }
 
-func (ms *metricsMiddleware) EnableChannel(ctx context.Context, session authn.Session, id string) (channels.Channel, error) {
+func (ms *metricsMiddleware) SetChannelPublic(ctx context.Context, session authn.Session, id string, public bool) (c channels.Channel, err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "enable_channel").Add(1)
+		ms.counter.With("method", "set_channel_public", "error", prometheus.ErrorClass(err)).Add(1)
+		ms.latency.With("method", "set_channel_public").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+	return ms.svc.SetChannelPublic(ctx, session, id, public)
+}
+
+func (ms *metricsMiddleware) EnableChannel(ctx context.Context, session authn.Session, id string) (c channels.Channel, err error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "enable_channel", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "enable_channel").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.EnableChannel(ctx, session, id)
 }
 
-func (ms *metricsMiddleware) DisableChannel(ctx context.Context, session authn.Session, id string) (channels.Channel, error) {
+func (ms *metricsMiddleware) DisableChannel(ctx context.Context, session authn.Session, id string) (c channels.Channel, err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "disable_channel").Add(1)
+		ms.counter.With("method", "disable_channel", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "disable_channel").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.DisableChannel(ctx, session, id)
 }
 
-func (ms *metricsMiddleware) RemoveChannel(ctx context.Context, session authn.Session, id string) error {
+func (ms *metricsMiddleware) RemoveChannel(ctx context.Context, session authn.Session, id string) (err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "delete_channel").Add(1)
+		ms.counter.With("method", "delete_channel", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "delete_channel").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.RemoveChannel(ctx, session, id)
 }
 
-func (ms *metricsMiddleware) Connect(ctx context.Context, session authn.Session, chIDs, thIDs []string, connTypes []connections.ConnType) error {
+func (ms *metricsMiddleware) Connect(ctx context.Context, session authn.Session, chIDs, thIDs []string, connTypes []connections.ConnType) (err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "connect").Add(1)
+		ms.counter.With("method", "connect", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "connect").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.Connect(ctx, session, chIDs, thIDs, connTypes)
 }
 
-func (ms *metricsMiddleware) Disconnect(ctx context.Context, session authn.Session, chIDs, thIDs []string, connTypes []connections.ConnType) error {
+func (ms *metricsMiddleware) ListChannelConnections(ctx context.Context, session authn.Session, channelID string, pm channels.PageMetadata) (cp channels.ConnectionsPage, err error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "list_channel_connections", "error", prometheus.ErrorClass(err)).Add(1)
+		ms.latency.With("method", "list_channel_connections").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+	return ms.svc.ListChannelConnections(ctx, session, channelID, pm)
+}
+
+func (ms *metricsMiddleware) UpdateConnection(ctx context.Context, session authn.Session, conn channels.Connection) (c channels.Connection, err error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "update_connection", "error", prometheus.ErrorClass(err)).Add(1)
+		ms.latency.With("method", "update_connection").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+	return ms.svc.UpdateConnection(ctx, session, conn)
+}
+
+func (ms *metricsMiddleware) Disconnect(ctx context.Context, session authn.Session, chIDs, thIDs []string, connTypes []connections.ConnType) (err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "disconnect").Add(1)
+		ms.counter.With("method", "disconnect", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "disconnect").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.Disconnect(ctx, session, chIDs, thIDs, connTypes)
@@ -124,7 +149,7 @@ func (ms *metricsMiddleware) Disconnect(ctx context.Context, session authn.Sessi
 
 func (ms *metricsMiddleware) SetParentGroup(ctx context.Context, session authn.Session, parentGroupID string, id string) (err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "set_parent_group").Add(1)
+		ms.counter.With("method", "set_parent_group", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "set_parent_group").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.SetParentGroup(ctx, session, parentGroupID, id)
@@ -132,7 +157,7 @@ func (ms *metricsMiddleware) SetParentGroup(ctx context.Context, session authn.S
 
 func (ms *metricsMiddleware) RemoveParentGroup(ctx context.Context, session authn.Session, id string) (err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "remove_parent_group").Add(1)
+		ms.counter.With("method", "remove_parent_group", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "remove_parent_group").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.RemoveParentGroup(ctx, session, id)
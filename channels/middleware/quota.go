@@ -0,0 +1,36 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package middleware
+
+import (
+	"context"
+
+	"github.com/absmach/supermq/channels"
+	"github.com/absmach/supermq/pkg/authn"
+	"github.com/absmach/supermq/pkg/metering"
+	"github.com/absmach/supermq/pkg/roles"
+)
+
+var _ channels.Service = (*quotaMiddleware)(nil)
+
+type quotaMiddleware struct {
+	channels.Service
+	meter *metering.Meter
+}
+
+// QuotaMiddleware rejects channel creation once the domain has reached its
+// configured channels quota.
+func QuotaMiddleware(svc channels.Service, meter *metering.Meter) channels.Service {
+	return &quotaMiddleware{
+		Service: svc,
+		meter:   meter,
+	}
+}
+
+func (qm *quotaMiddleware) CreateChannels(ctx context.Context, session authn.Session, chs ...channels.Channel) ([]channels.Channel, []roles.RoleProvision, error) {
+	if err := qm.meter.CheckQuota(ctx, session.DomainID, metering.ResourceChannels); err != nil {
+		return []channels.Channel{}, []roles.RoleProvision{}, err
+	}
+	return qm.Service.CreateChannels(ctx, session, chs...)
+}
@@ -142,21 +142,31 @@ func (_m *Repository) CheckConnection(ctx context.Context, conn channels.Connect
 }
 
 // ClientAuthorize provides a mock function with given fields: ctx, conn
-func (_m *Repository) ClientAuthorize(ctx context.Context, conn channels.Connection) error {
+func (_m *Repository) ClientAuthorize(ctx context.Context, conn channels.Connection) (channels.Connection, error) {
 	ret := _m.Called(ctx, conn)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ClientAuthorize")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, channels.Connection) error); ok {
+	var r0 channels.Connection
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, channels.Connection) (channels.Connection, error)); ok {
+		return rf(ctx, conn)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, channels.Connection) channels.Connection); ok {
 		r0 = rf(ctx, conn)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(channels.Connection)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, channels.Connection) error); ok {
+		r1 = rf(ctx, conn)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
 // DoesChannelHaveConnections provides a mock function with given fields: ctx, id
@@ -266,6 +276,62 @@ func (_m *Repository) RemoveConnections(ctx context.Context, conns []channels.Co
 	return r0
 }
 
+// ListEntityMembers provides a mock function with given fields: ctx, entityID, limit, offset
+func (_m *Repository) ListEntityMembers(ctx context.Context, entityID string, limit uint64, offset uint64) (roles.EntityMembersPage, error) {
+	ret := _m.Called(ctx, entityID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEntityMembers")
+	}
+
+	var r0 roles.EntityMembersPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, uint64, uint64) (roles.EntityMembersPage, error)); ok {
+		return rf(ctx, entityID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, uint64, uint64) roles.EntityMembersPage); ok {
+		r0 = rf(ctx, entityID, limit, offset)
+	} else {
+		r0 = ret.Get(0).(roles.EntityMembersPage)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, uint64, uint64) error); ok {
+		r1 = rf(ctx, entityID, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListMemberEntities provides a mock function with given fields: ctx, memberID, limit, offset
+func (_m *Repository) ListMemberEntities(ctx context.Context, memberID string, limit uint64, offset uint64) (roles.MemberEntitiesPage, error) {
+	ret := _m.Called(ctx, memberID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListMemberEntities")
+	}
+
+	var r0 roles.MemberEntitiesPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, uint64, uint64) (roles.MemberEntitiesPage, error)); ok {
+		return rf(ctx, memberID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, uint64, uint64) roles.MemberEntitiesPage); ok {
+		r0 = rf(ctx, memberID, limit, offset)
+	} else {
+		r0 = ret.Get(0).(roles.MemberEntitiesPage)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, uint64, uint64) error); ok {
+		r1 = rf(ctx, memberID, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RemoveMemberFromAllRoles provides a mock function with given fields: ctx, memberID
 func (_m *Repository) RemoveMemberFromAllRoles(ctx context.Context, memberID string) error {
 	ret := _m.Called(ctx, memberID)
@@ -404,6 +470,62 @@ func (_m *Repository) RetrieveByID(ctx context.Context, id string) (channels.Cha
 	return r0, r1
 }
 
+// RetrieveConnections provides a mock function with given fields: ctx, channelID, pm
+func (_m *Repository) RetrieveConnections(ctx context.Context, channelID string, pm channels.PageMetadata) (channels.ConnectionsPage, error) {
+	ret := _m.Called(ctx, channelID, pm)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetrieveConnections")
+	}
+
+	var r0 channels.ConnectionsPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, channels.PageMetadata) (channels.ConnectionsPage, error)); ok {
+		return rf(ctx, channelID, pm)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, channels.PageMetadata) channels.ConnectionsPage); ok {
+		r0 = rf(ctx, channelID, pm)
+	} else {
+		r0 = ret.Get(0).(channels.ConnectionsPage)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, channels.PageMetadata) error); ok {
+		r1 = rf(ctx, channelID, pm)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateConnection provides a mock function with given fields: ctx, conn
+func (_m *Repository) UpdateConnection(ctx context.Context, conn channels.Connection) (channels.Connection, error) {
+	ret := _m.Called(ctx, conn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateConnection")
+	}
+
+	var r0 channels.Connection
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, channels.Connection) (channels.Connection, error)); ok {
+		return rf(ctx, conn)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, channels.Connection) channels.Connection); ok {
+		r0 = rf(ctx, conn)
+	} else {
+		r0 = ret.Get(0).(channels.Connection)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, channels.Connection) error); ok {
+		r1 = rf(ctx, conn)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RetrieveEntitiesRolesActionsMembers provides a mock function with given fields: ctx, entityIDs
 func (_m *Repository) RetrieveEntitiesRolesActionsMembers(ctx context.Context, entityIDs []string) ([]roles.EntityActionRole, []roles.EntityMemberRole, error) {
 	ret := _m.Called(ctx, entityIDs)
@@ -876,6 +998,62 @@ func (_m *Repository) Update(ctx context.Context, c channels.Channel) (channels.
 	return r0, r1
 }
 
+// SetRoleInheritance provides a mock function with given fields: ctx, ro
+func (_m *Repository) SetRoleInheritance(ctx context.Context, ro roles.Role) (roles.Role, error) {
+	ret := _m.Called(ctx, ro)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetRoleInheritance")
+	}
+
+	var r0 roles.Role
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, roles.Role) (roles.Role, error)); ok {
+		return rf(ctx, ro)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, roles.Role) roles.Role); ok {
+		r0 = rf(ctx, ro)
+	} else {
+		r0 = ret.Get(0).(roles.Role)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, roles.Role) error); ok {
+		r1 = rf(ctx, ro)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdatePublic provides a mock function with given fields: ctx, ch
+func (_m *Repository) UpdatePublic(ctx context.Context, ch channels.Channel) (channels.Channel, error) {
+	ret := _m.Called(ctx, ch)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePublic")
+	}
+
+	var r0 channels.Channel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, channels.Channel) (channels.Channel, error)); ok {
+		return rf(ctx, ch)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, channels.Channel) channels.Channel); ok {
+		r0 = rf(ctx, ch)
+	} else {
+		r0 = ret.Get(0).(channels.Channel)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, channels.Channel) error); ok {
+		r1 = rf(ctx, ch)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateRole provides a mock function with given fields: ctx, ro
 func (_m *Repository) UpdateRole(ctx context.Context, ro roles.Role) (roles.Role, error) {
 	ret := _m.Called(ctx, ro)
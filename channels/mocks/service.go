@@ -50,6 +50,36 @@ func (_m *Service) AddRole(ctx context.Context, session authn.Session, entityID
 	return r0, r1
 }
 
+// AddRoles provides a mock function with given fields: ctx, session, entityID, rps
+func (_m *Service) AddRoles(ctx context.Context, session authn.Session, entityID string, rps []roles.RoleProvision) ([]roles.RoleProvision, error) {
+	ret := _m.Called(ctx, session, entityID, rps)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddRoles")
+	}
+
+	var r0 []roles.RoleProvision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, string, []roles.RoleProvision) ([]roles.RoleProvision, error)); ok {
+		return rf(ctx, session, entityID, rps)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, string, []roles.RoleProvision) []roles.RoleProvision); ok {
+		r0 = rf(ctx, session, entityID, rps)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]roles.RoleProvision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, authn.Session, string, []roles.RoleProvision) error); ok {
+		r1 = rf(ctx, session, entityID, rps)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Connect provides a mock function with given fields: ctx, session, chIDs, clIDs, connType
 func (_m *Service) Connect(ctx context.Context, session authn.Session, chIDs []string, clIDs []string, connType []connections.ConnType) error {
 	ret := _m.Called(ctx, session, chIDs, clIDs, connType)
@@ -218,6 +248,92 @@ func (_m *Service) ListAvailableActions(ctx context.Context, session authn.Sessi
 	return r0, r1
 }
 
+// ListEntityMembers provides a mock function with given fields: ctx, session, entityID, limit, offset
+func (_m *Service) ListEntityMembers(ctx context.Context, session authn.Session, entityID string, limit uint64, offset uint64) (roles.EntityMembersPage, error) {
+	ret := _m.Called(ctx, session, entityID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEntityMembers")
+	}
+
+	var r0 roles.EntityMembersPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, string, uint64, uint64) (roles.EntityMembersPage, error)); ok {
+		return rf(ctx, session, entityID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, string, uint64, uint64) roles.EntityMembersPage); ok {
+		r0 = rf(ctx, session, entityID, limit, offset)
+	} else {
+		r0 = ret.Get(0).(roles.EntityMembersPage)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, authn.Session, string, uint64, uint64) error); ok {
+		r1 = rf(ctx, session, entityID, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListMemberEntities provides a mock function with given fields: ctx, session, memberID, limit, offset
+func (_m *Service) ListMemberEntities(ctx context.Context, session authn.Session, memberID string, limit uint64, offset uint64) (roles.MemberEntitiesPage, error) {
+	ret := _m.Called(ctx, session, memberID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListMemberEntities")
+	}
+
+	var r0 roles.MemberEntitiesPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, string, uint64, uint64) (roles.MemberEntitiesPage, error)); ok {
+		return rf(ctx, session, memberID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, string, uint64, uint64) roles.MemberEntitiesPage); ok {
+		r0 = rf(ctx, session, memberID, limit, offset)
+	} else {
+		r0 = ret.Get(0).(roles.MemberEntitiesPage)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, authn.Session, string, uint64, uint64) error); ok {
+		r1 = rf(ctx, session, memberID, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRoleTemplates provides a mock function with given fields: ctx, session
+func (_m *Service) ListRoleTemplates(ctx context.Context, session authn.Session) (map[string][]string, error) {
+	ret := _m.Called(ctx, session)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRoleTemplates")
+	}
+
+	var r0 map[string][]string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session) (map[string][]string, error)); ok {
+		return rf(ctx, session)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session) map[string][]string); ok {
+		r0 = rf(ctx, session)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, authn.Session) error); ok {
+		r1 = rf(ctx, session)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListChannels provides a mock function with given fields: ctx, session, pm
 func (_m *Service) ListChannels(ctx context.Context, session authn.Session, pm channels.PageMetadata) (channels.Page, error) {
 	ret := _m.Called(ctx, session, pm)
@@ -246,6 +362,34 @@ func (_m *Service) ListChannels(ctx context.Context, session authn.Session, pm c
 	return r0, r1
 }
 
+// ListChannelConnections provides a mock function with given fields: ctx, session, channelID, pm
+func (_m *Service) ListChannelConnections(ctx context.Context, session authn.Session, channelID string, pm channels.PageMetadata) (channels.ConnectionsPage, error) {
+	ret := _m.Called(ctx, session, channelID, pm)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListChannelConnections")
+	}
+
+	var r0 channels.ConnectionsPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, string, channels.PageMetadata) (channels.ConnectionsPage, error)); ok {
+		return rf(ctx, session, channelID, pm)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, string, channels.PageMetadata) channels.ConnectionsPage); ok {
+		r0 = rf(ctx, session, channelID, pm)
+	} else {
+		r0 = ret.Get(0).(channels.ConnectionsPage)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, authn.Session, string, channels.PageMetadata) error); ok {
+		r1 = rf(ctx, session, channelID, pm)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListChannelsByClient provides a mock function with given fields: ctx, session, id, pm
 func (_m *Service) ListChannelsByClient(ctx context.Context, session authn.Session, id string, pm channels.PageMetadata) (channels.Page, error) {
 	ret := _m.Called(ctx, session, id, pm)
@@ -274,6 +418,34 @@ func (_m *Service) ListChannelsByClient(ctx context.Context, session authn.Sessi
 	return r0, r1
 }
 
+// UpdateConnection provides a mock function with given fields: ctx, session, conn
+func (_m *Service) UpdateConnection(ctx context.Context, session authn.Session, conn channels.Connection) (channels.Connection, error) {
+	ret := _m.Called(ctx, session, conn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateConnection")
+	}
+
+	var r0 channels.Connection
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, channels.Connection) (channels.Connection, error)); ok {
+		return rf(ctx, session, conn)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, channels.Connection) channels.Connection); ok {
+		r0 = rf(ctx, session, conn)
+	} else {
+		r0 = ret.Get(0).(channels.Connection)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, authn.Session, channels.Connection) error); ok {
+		r1 = rf(ctx, session, conn)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RemoveChannel provides a mock function with given fields: ctx, session, id
 func (_m *Service) RemoveChannel(ctx context.Context, session authn.Session, id string) error {
 	ret := _m.Called(ctx, session, id)
@@ -648,6 +820,34 @@ func (_m *Service) RoleRemoveMembers(ctx context.Context, session authn.Session,
 	return r0
 }
 
+// SetChannelPublic provides a mock function with given fields: ctx, session, id, public
+func (_m *Service) SetChannelPublic(ctx context.Context, session authn.Session, id string, public bool) (channels.Channel, error) {
+	ret := _m.Called(ctx, session, id, public)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetChannelPublic")
+	}
+
+	var r0 channels.Channel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, string, bool) (channels.Channel, error)); ok {
+		return rf(ctx, session, id, public)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, string, bool) channels.Channel); ok {
+		r0 = rf(ctx, session, id, public)
+	} else {
+		r0 = ret.Get(0).(channels.Channel)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, authn.Session, string, bool) error); ok {
+		r1 = rf(ctx, session, id, public)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SetParentGroup provides a mock function with given fields: ctx, session, parentGroupID, id
 func (_m *Service) SetParentGroup(ctx context.Context, session authn.Session, parentGroupID string, id string) error {
 	ret := _m.Called(ctx, session, parentGroupID, id)
@@ -722,6 +922,34 @@ func (_m *Service) UpdateChannelTags(ctx context.Context, session authn.Session,
 	return r0, r1
 }
 
+// SetRoleInheritance provides a mock function with given fields: ctx, session, entityID, roleID, inheritsFrom
+func (_m *Service) SetRoleInheritance(ctx context.Context, session authn.Session, entityID string, roleID string, inheritsFrom string) (roles.Role, error) {
+	ret := _m.Called(ctx, session, entityID, roleID, inheritsFrom)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetRoleInheritance")
+	}
+
+	var r0 roles.Role
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, string, string, string) (roles.Role, error)); ok {
+		return rf(ctx, session, entityID, roleID, inheritsFrom)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, string, string, string) roles.Role); ok {
+		r0 = rf(ctx, session, entityID, roleID, inheritsFrom)
+	} else {
+		r0 = ret.Get(0).(roles.Role)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, authn.Session, string, string, string) error); ok {
+		r1 = rf(ctx, session, entityID, roleID, inheritsFrom)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateRoleName provides a mock function with given fields: ctx, session, entityID, roleID, newRoleName
 func (_m *Service) UpdateRoleName(ctx context.Context, session authn.Session, entityID string, roleID string, newRoleName string) (roles.Role, error) {
 	ret := _m.Called(ctx, session, entityID, roleID, newRoleName)
@@ -18,9 +18,11 @@ import (
 	"github.com/absmach/supermq/pkg/connections"
 	"github.com/absmach/supermq/pkg/errors"
 	repoerr "github.com/absmach/supermq/pkg/errors/repository"
+	"github.com/absmach/supermq/pkg/labels"
 	"github.com/absmach/supermq/pkg/postgres"
 	rolesPostgres "github.com/absmach/supermq/pkg/roles/repo/postgres"
 	"github.com/jackc/pgtype"
+	"github.com/lib/pq"
 )
 
 const (
@@ -56,9 +58,9 @@ func (cr *channelRepository) Save(ctx context.Context, chs ...channels.Channel)
 		dbchs = append(dbchs, dbch)
 	}
 
-	q := `INSERT INTO channels (id, name, tags, domain_id, parent_group_id,  metadata, created_at, updated_at, updated_by, status)
-	VALUES (:id, :name, :tags, :domain_id,  :parent_group_id, :metadata, :created_at, :updated_at, :updated_by, :status)
-	RETURNING id, name, tags,  metadata, COALESCE(domain_id, '') AS domain_id, COALESCE(parent_group_id, '') AS parent_group_id, status, created_at, updated_at, updated_by`
+	q := `INSERT INTO channels (id, name, tags, domain_id, parent_group_id,  metadata, labels, labels, created_at, updated_at, updated_by, status, public)
+	VALUES (:id, :name, :tags, :domain_id,  :parent_group_id, :metadata, :labels, :created_at, :updated_at, :updated_by, :status, :public)
+	RETURNING id, name, tags,  metadata, labels, COALESCE(domain_id, '') AS domain_id, COALESCE(parent_group_id, '') AS parent_group_id, status, public, created_at, updated_at, updated_by`
 
 	row, err := cr.db.NamedQueryContext(ctx, q, dbchs)
 	if err != nil {
@@ -93,12 +95,15 @@ func (cr *channelRepository) Update(ctx context.Context, channel channels.Channe
 	if channel.Metadata != nil {
 		query = append(query, "metadata = :metadata,")
 	}
+	if channel.Labels != nil {
+		query = append(query, "labels = :labels,")
+	}
 	if len(query) > 0 {
 		upq = strings.Join(query, " ")
 	}
 	q := fmt.Sprintf(`UPDATE channels SET %s updated_at = :updated_at, updated_by = :updated_by
         WHERE id = :id AND status = :status
-        RETURNING id, name, tags, metadata, COALESCE(domain_id, '') AS domain_id, COALESCE(parent_group_id, '') AS parent_group_id, status, created_at, updated_at, updated_by`,
+        RETURNING id, name, tags, metadata, labels, COALESCE(domain_id, '') AS domain_id, COALESCE(parent_group_id, '') AS parent_group_id, status, public, created_at, updated_at, updated_by`,
 		upq)
 	channel.Status = clients.EnabledStatus
 	return cr.update(ctx, channel, q)
@@ -107,7 +112,15 @@ func (cr *channelRepository) Update(ctx context.Context, channel channels.Channe
 func (cr *channelRepository) UpdateTags(ctx context.Context, channel channels.Channel) (channels.Channel, error) {
 	q := `UPDATE channels SET tags = :tags, updated_at = :updated_at, updated_by = :updated_by
 	WHERE id = :id AND status = :status
-	RETURNING id, name, tags,  metadata, COALESCE(domain_id, '') AS domain_id, COALESCE(parent_group_id, '') AS parent_group_id, status, created_at, updated_at, updated_by`
+	RETURNING id, name, tags,  metadata, labels, COALESCE(domain_id, '') AS domain_id, COALESCE(parent_group_id, '') AS parent_group_id, status, public, created_at, updated_at, updated_by`
+	channel.Status = clients.EnabledStatus
+	return cr.update(ctx, channel, q)
+}
+
+func (cr *channelRepository) UpdatePublic(ctx context.Context, channel channels.Channel) (channels.Channel, error) {
+	q := `UPDATE channels SET public = :public, updated_at = :updated_at, updated_by = :updated_by
+	WHERE id = :id AND status = :status
+	RETURNING id, name, tags,  metadata, labels, COALESCE(domain_id, '') AS domain_id, COALESCE(parent_group_id, '') AS parent_group_id, status, public, created_at, updated_at, updated_by`
 	channel.Status = clients.EnabledStatus
 	return cr.update(ctx, channel, q)
 }
@@ -115,13 +128,13 @@ func (cr *channelRepository) UpdateTags(ctx context.Context, channel channels.Ch
 func (cr *channelRepository) ChangeStatus(ctx context.Context, channel channels.Channel) (channels.Channel, error) {
 	q := `UPDATE channels SET status = :status, updated_at = :updated_at, updated_by = :updated_by
 		WHERE id = :id
-        RETURNING id, name, tags, metadata, COALESCE(domain_id, '') AS domain_id, COALESCE(parent_group_id, '') AS parent_group_id, status, created_at, updated_at, updated_by`
+        RETURNING id, name, tags, metadata, labels, COALESCE(domain_id, '') AS domain_id, COALESCE(parent_group_id, '') AS parent_group_id, status, public, created_at, updated_at, updated_by`
 
 	return cr.update(ctx, channel, q)
 }
 
 func (cr *channelRepository) RetrieveByID(ctx context.Context, id string) (channels.Channel, error) {
-	q := `SELECT id, name, tags, COALESCE(domain_id, '') AS domain_id, COALESCE(parent_group_id, '') AS parent_group_id,  metadata, created_at, updated_at, updated_by, status FROM channels WHERE id = :id`
+	q := `SELECT id, name, tags, COALESCE(domain_id, '') AS domain_id, COALESCE(parent_group_id, '') AS parent_group_id,  metadata, labels, created_at, updated_at, updated_by, status, public FROM channels WHERE id = :id`
 
 	dbch := dbChannel{
 		ID: id,
@@ -151,7 +164,7 @@ func (cr *channelRepository) RetrieveAll(ctx context.Context, pm channels.PageMe
 	}
 	query = applyOrdering(query, pm)
 
-	q := fmt.Sprintf(`SELECT c.id, c.name, c.tags,  c.metadata, COALESCE(c.domain_id, '') AS domain_id, COALESCE(parent_group_id, '') AS parent_group_id, c.status,
+	q := fmt.Sprintf(`SELECT c.id, c.name, c.tags,  c.metadata, c.labels, COALESCE(c.domain_id, '') AS domain_id, COALESCE(parent_group_id, '') AS parent_group_id, c.status, c.public,
 					c.created_at, c.updated_at, COALESCE(c.updated_by, '') AS updated_by FROM channels c %s ORDER BY c.created_at LIMIT :limit OFFSET :offset;`, query)
 
 	dbPage, err := toDBChannelsPage(pm)
@@ -244,9 +257,12 @@ func (cr *channelRepository) RemoveParentGroup(ctx context.Context, ch channels.
 }
 
 func (cr *channelRepository) AddConnections(ctx context.Context, conns []channels.Connection) error {
-	dbConns := toDBConnections(conns)
-	q := `INSERT INTO connections (channel_id, domain_id, client_id, type)
-			VALUES (:channel_id, :domain_id, :client_id, :type );`
+	dbConns, err := toDBConnections(conns)
+	if err != nil {
+		return errors.Wrap(repoerr.ErrCreateEntity, err)
+	}
+	q := `INSERT INTO connections (channel_id, domain_id, client_id, type, metadata)
+			VALUES (:channel_id, :domain_id, :client_id, :type, :metadata );`
 
 	if _, err := cr.db.NamedExecContext(ctx, q, dbConns); err != nil {
 		return postgres.HandleError(repoerr.ErrCreateEntity, err)
@@ -274,7 +290,10 @@ func (cr *channelRepository) RemoveConnections(ctx context.Context, conns []chan
 		if uint8(conn.Type) > 0 {
 			query = query + " AND type = :type "
 		}
-		dbConn := toDBConnection(conn)
+		dbConn, err := toDBConnection(conn)
+		if err != nil {
+			return errors.Wrap(repoerr.ErrRemoveEntity, err)
+		}
 		if _, err := tx.NamedExec(query, dbConn); err != nil {
 			return errors.Wrap(repoerr.ErrRemoveEntity, errors.Wrap(fmt.Errorf("failed to delete connection for channel_id: %s, domain_id: %s client_id %s", conn.ChannelID, conn.DomainID, conn.ClientID), err))
 		}
@@ -285,9 +304,91 @@ func (cr *channelRepository) RemoveConnections(ctx context.Context, conns []chan
 	return nil
 }
 
+func (cr *channelRepository) RetrieveConnections(ctx context.Context, channelID string, pm channels.PageMetadata) (channels.ConnectionsPage, error) {
+	q := `SELECT channel_id, domain_id, client_id, type, metadata FROM connections
+		WHERE channel_id = :channel_id ORDER BY client_id LIMIT :limit OFFSET :offset;`
+
+	dbPage := dbConnectionsPage{
+		ChannelID: channelID,
+		Limit:     pm.Limit,
+		Offset:    pm.Offset,
+	}
+	rows, err := cr.db.NamedQueryContext(ctx, q, dbPage)
+	if err != nil {
+		return channels.ConnectionsPage{}, postgres.HandleError(repoerr.ErrViewEntity, err)
+	}
+	defer rows.Close()
+
+	var items []channels.Connection
+	for rows.Next() {
+		dbConn := dbConnection{}
+		if err := rows.StructScan(&dbConn); err != nil {
+			return channels.ConnectionsPage{}, errors.Wrap(repoerr.ErrViewEntity, err)
+		}
+
+		conn, err := toConnection(dbConn)
+		if err != nil {
+			return channels.ConnectionsPage{}, err
+		}
+
+		items = append(items, conn)
+	}
+
+	cq := `SELECT COUNT(*) FROM connections WHERE channel_id = :channel_id;`
+
+	total, err := postgres.Total(ctx, cr.db, cq, dbPage)
+	if err != nil {
+		return channels.ConnectionsPage{}, errors.Wrap(repoerr.ErrViewEntity, err)
+	}
+
+	page := channels.ConnectionsPage{
+		Connections: items,
+		PageMetadata: channels.PageMetadata{
+			Total:  total,
+			Offset: pm.Offset,
+			Limit:  pm.Limit,
+		},
+	}
+
+	return page, nil
+}
+
+func (cr *channelRepository) UpdateConnection(ctx context.Context, conn channels.Connection) (channels.Connection, error) {
+	q := `UPDATE connections SET metadata = :metadata
+		WHERE channel_id = :channel_id AND domain_id = :domain_id AND client_id = :client_id`
+	if uint8(conn.Type) > 0 {
+		q = q + " AND type = :type"
+	}
+	q = q + ` RETURNING channel_id, domain_id, client_id, type, metadata`
+
+	dbConn, err := toDBConnection(conn)
+	if err != nil {
+		return channels.Connection{}, errors.Wrap(repoerr.ErrUpdateEntity, err)
+	}
+	row, err := cr.db.NamedQueryContext(ctx, q, dbConn)
+	if err != nil {
+		return channels.Connection{}, postgres.HandleError(repoerr.ErrUpdateEntity, err)
+	}
+	defer row.Close()
+
+	dbConn = dbConnection{}
+	if row.Next() {
+		if err := row.StructScan(&dbConn); err != nil {
+			return channels.Connection{}, errors.Wrap(repoerr.ErrUpdateEntity, err)
+		}
+
+		return toConnection(dbConn)
+	}
+
+	return channels.Connection{}, repoerr.ErrNotFound
+}
+
 func (cr *channelRepository) CheckConnection(ctx context.Context, conn channels.Connection) error {
 	query := `SELECT 1 FROM connections WHERE channel_id = :channel_id AND domain_id = :domain_id AND client_id = :client_id AND type = :type LIMIT 1`
-	dbConn := toDBConnection(conn)
+	dbConn, err := toDBConnection(conn)
+	if err != nil {
+		return errors.Wrap(repoerr.ErrViewEntity, err)
+	}
 	rows, err := cr.db.NamedQueryContext(ctx, query, dbConn)
 	if err != nil {
 		return postgres.HandleError(repoerr.ErrViewEntity, err)
@@ -300,19 +401,27 @@ func (cr *channelRepository) CheckConnection(ctx context.Context, conn channels.
 	return nil
 }
 
-func (cr *channelRepository) ClientAuthorize(ctx context.Context, conn channels.Connection) error {
-	query := `SELECT 1 FROM connections WHERE channel_id = :channel_id AND client_id = :client_id AND type = :type LIMIT 1`
-	dbConn := toDBConnection(conn)
+func (cr *channelRepository) ClientAuthorize(ctx context.Context, conn channels.Connection) (channels.Connection, error) {
+	query := `SELECT channel_id, domain_id, client_id, type, metadata FROM connections
+		WHERE channel_id = :channel_id AND client_id = :client_id AND type = :type LIMIT 1`
+	dbConn, err := toDBConnection(conn)
+	if err != nil {
+		return channels.Connection{}, errors.Wrap(repoerr.ErrViewEntity, err)
+	}
 	rows, err := cr.db.NamedQueryContext(ctx, query, dbConn)
 	if err != nil {
-		return postgres.HandleError(repoerr.ErrViewEntity, err)
+		return channels.Connection{}, postgres.HandleError(repoerr.ErrViewEntity, err)
 	}
 	defer rows.Close()
 
+	dbConn = dbConnection{}
 	if !rows.Next() {
-		return repoerr.ErrNotFound
+		return channels.Connection{}, repoerr.ErrNotFound
 	}
-	return nil
+	if err := rows.StructScan(&dbConn); err != nil {
+		return channels.Connection{}, errors.Wrap(repoerr.ErrViewEntity, err)
+	}
+	return toConnection(dbConn)
 }
 
 func (cr *channelRepository) ChannelConnectionsCount(ctx context.Context, id string) (uint64, error) {
@@ -360,7 +469,7 @@ func (cr *channelRepository) RemoveChannelConnections(ctx context.Context, chann
 }
 
 func (cr *channelRepository) RetrieveParentGroupChannels(ctx context.Context, parentGroupID string) ([]channels.Channel, error) {
-	query := `SELECT c.id, c.name, c.tags,  c.metadata, COALESCE(c.domain_id, '') AS domain_id, COALESCE(parent_group_id, '') AS parent_group_id, c.status,
+	query := `SELECT c.id, c.name, c.tags,  c.metadata, c.labels, COALESCE(c.domain_id, '') AS domain_id, COALESCE(parent_group_id, '') AS parent_group_id, c.status, c.public,
 					c.created_at, c.updated_at, COALESCE(c.updated_by, '') AS updated_by FROM channels c WHERE c.parent_group_id = :parent_group_id ;`
 
 	rows, err := cr.db.NamedQueryContext(ctx, query, dbChannel{ParentGroup: toNullString(parentGroupID)})
@@ -426,11 +535,13 @@ type dbChannel struct {
 	Tags        pgtype.TextArray `db:"tags,omitempty"`
 	Domain      string           `db:"domain_id"`
 	Metadata    []byte           `db:"metadata,omitempty"`
+	Labels      []byte           `db:"labels,omitempty"`
 	CreatedAt   time.Time        `db:"created_at,omitempty"`
 	UpdatedAt   sql.NullTime     `db:"updated_at,omitempty"`
 	UpdatedBy   *string          `db:"updated_by,omitempty"`
 	Status      clients.Status   `db:"status,omitempty"`
 	Role        *clients.Role    `db:"role,omitempty"`
+	Public      bool             `db:"public"`
 }
 
 func toDBChannel(ch channels.Channel) (dbChannel, error) {
@@ -442,6 +553,14 @@ func toDBChannel(ch channels.Channel) (dbChannel, error) {
 		}
 		data = b
 	}
+	lbls := []byte("{}")
+	if len(ch.Labels) > 0 {
+		b, err := json.Marshal(ch.Labels)
+		if err != nil {
+			return dbChannel{}, errors.Wrap(repoerr.ErrMalformedEntity, err)
+		}
+		lbls = b
+	}
 	var tags pgtype.TextArray
 	if err := tags.Set(ch.Tags); err != nil {
 		return dbChannel{}, err
@@ -461,10 +580,12 @@ func toDBChannel(ch channels.Channel) (dbChannel, error) {
 		Domain:      ch.Domain,
 		Tags:        tags,
 		Metadata:    data,
+		Labels:      lbls,
 		CreatedAt:   ch.CreatedAt,
 		UpdatedAt:   updatedAt,
 		UpdatedBy:   updatedBy,
 		Status:      ch.Status,
+		Public:      ch.Public,
 	}, nil
 }
 
@@ -493,6 +614,12 @@ func toChannel(ch dbChannel) (channels.Channel, error) {
 			return channels.Channel{}, errors.Wrap(errors.ErrMalformedEntity, err)
 		}
 	}
+	var lbls labels.Labels
+	if len(ch.Labels) > 0 {
+		if err := json.Unmarshal(ch.Labels, &lbls); err != nil {
+			return channels.Channel{}, errors.Wrap(errors.ErrMalformedEntity, err)
+		}
+	}
 	var tags []string
 	for _, e := range ch.Tags.Elements {
 		tags = append(tags, e.String)
@@ -513,10 +640,12 @@ func toChannel(ch dbChannel) (channels.Channel, error) {
 		Domain:      ch.Domain,
 		ParentGroup: toString(ch.ParentGroup),
 		Metadata:    metadata,
+		Labels:      lbls,
 		CreatedAt:   ch.CreatedAt,
 		UpdatedAt:   updatedAt,
 		UpdatedBy:   updatedBy,
 		Status:      ch.Status,
+		Public:      ch.Public,
 	}
 
 	return newCh, nil
@@ -553,8 +682,14 @@ func PageQuery(pm channels.PageMetadata) (string, error) {
 		query = append(query, mq)
 	}
 
-	if len(pm.IDs) != 0 {
-		query = append(query, fmt.Sprintf("id IN ('%s')", strings.Join(pm.IDs, "','")))
+	if lq, _, err := postgres.CreateLabelSelectorQuery("c.", pm.LabelSelector); err != nil {
+		return "", errors.Wrap(errors.ErrMalformedEntity, err)
+	} else if lq != "" {
+		query = append(query, lq)
+	}
+
+	if iq, _ := postgres.CreateIDsQuery("", pm.IDs); iq != "" {
+		query = append(query, iq)
 	}
 	if pm.Status != clients.AllStatus {
 		query = append(query, "c.status = :status")
@@ -585,29 +720,44 @@ func toDBChannelsPage(pm channels.PageMetadata) (dbChannelsPage, error) {
 	if err != nil {
 		return dbChannelsPage{}, errors.Wrap(repoerr.ErrViewEntity, err)
 	}
+	_, ids := postgres.CreateIDsQuery("", pm.IDs)
+	_, sel, err := postgres.CreateLabelSelectorQuery("", pm.LabelSelector)
+	if err != nil {
+		return dbChannelsPage{}, errors.Wrap(repoerr.ErrViewEntity, err)
+	}
 	return dbChannelsPage{
-		Name:     pm.Name,
-		Id:       pm.Id,
-		Metadata: data,
-		Domain:   pm.Domain,
-		Total:    pm.Total,
-		Offset:   pm.Offset,
-		Limit:    pm.Limit,
-		Status:   pm.Status,
-		Tag:      pm.Tag,
+		Name:          pm.Name,
+		Id:            pm.Id,
+		Ids:           ids,
+		Metadata:      data,
+		LabelSelector: sel,
+		Domain:        pm.Domain,
+		Total:         pm.Total,
+		Offset:        pm.Offset,
+		Limit:         pm.Limit,
+		Status:        pm.Status,
+		Tag:           pm.Tag,
 	}, nil
 }
 
 type dbChannelsPage struct {
-	Total    uint64         `db:"total"`
-	Limit    uint64         `db:"limit"`
-	Offset   uint64         `db:"offset"`
-	Name     string         `db:"name"`
-	Id       string         `db:"id"`
-	Domain   string         `db:"domain_id"`
-	Metadata []byte         `db:"metadata"`
-	Tag      string         `db:"tag"`
-	Status   clients.Status `db:"status"`
+	Total         uint64         `db:"total"`
+	Limit         uint64         `db:"limit"`
+	Offset        uint64         `db:"offset"`
+	Name          string         `db:"name"`
+	Id            string         `db:"id"`
+	Ids           pq.StringArray `db:"ids"`
+	Domain        string         `db:"domain_id"`
+	Metadata      []byte         `db:"metadata"`
+	LabelSelector []byte         `db:"label_selector"`
+	Tag           string         `db:"tag"`
+	Status        clients.Status `db:"status"`
+}
+
+type dbConnectionsPage struct {
+	ChannelID string `db:"channel_id"`
+	Limit     uint64 `db:"limit"`
+	Offset    uint64 `db:"offset"`
 }
 
 type dbConnection struct {
@@ -615,21 +765,53 @@ type dbConnection struct {
 	DomainID  string               `db:"domain_id"`
 	ClientID  string               `db:"client_id"`
 	Type      connections.ConnType `db:"type"`
+	Metadata  []byte               `db:"metadata"`
 }
 
-func toDBConnections(conns []channels.Connection) []dbConnection {
+func toDBConnections(conns []channels.Connection) ([]dbConnection, error) {
 	var dbconns []dbConnection
 	for _, conn := range conns {
-		dbconns = append(dbconns, toDBConnection(conn))
+		dbconn, err := toDBConnection(conn)
+		if err != nil {
+			return []dbConnection{}, err
+		}
+		dbconns = append(dbconns, dbconn)
 	}
-	return dbconns
+	return dbconns, nil
 }
 
-func toDBConnection(conn channels.Connection) dbConnection {
+func toDBConnection(conn channels.Connection) (dbConnection, error) {
+	data := []byte("{}")
+	if len(conn.Metadata) > 0 {
+		b, err := json.Marshal(conn.Metadata)
+		if err != nil {
+			return dbConnection{}, errors.Wrap(repoerr.ErrMalformedEntity, err)
+		}
+		data = b
+	}
+
 	return dbConnection{
 		ClientID:  conn.ClientID,
 		ChannelID: conn.ChannelID,
 		DomainID:  conn.DomainID,
 		Type:      conn.Type,
+		Metadata:  data,
+	}, nil
+}
+
+func toConnection(dbConn dbConnection) (channels.Connection, error) {
+	var metadata clients.Metadata
+	if dbConn.Metadata != nil {
+		if err := json.Unmarshal(dbConn.Metadata, &metadata); err != nil {
+			return channels.Connection{}, errors.Wrap(errors.ErrMalformedEntity, err)
+		}
 	}
+
+	return channels.Connection{
+		ClientID:  dbConn.ClientID,
+		ChannelID: dbConn.ChannelID,
+		DomainID:  dbConn.DomainID,
+		Type:      dbConn.Type,
+		Metadata:  metadata,
+	}, nil
 }
@@ -1106,7 +1106,7 @@ func TestClientAuthorize(t *testing.T) {
 
 	for _, tc := range cases {
 		t.Run(tc.desc, func(t *testing.T) {
-			err := repo.ClientAuthorize(context.Background(), tc.connection)
+			_, err := repo.ClientAuthorize(context.Background(), tc.connection)
 			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
 		})
 	}
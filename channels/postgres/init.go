@@ -6,6 +6,7 @@ package postgres
 import (
 	"github.com/absmach/supermq/pkg/errors"
 	repoerr "github.com/absmach/supermq/pkg/errors/repository"
+	"github.com/absmach/supermq/pkg/postgres"
 	rolesPostgres "github.com/absmach/supermq/pkg/roles/repo/postgres"
 	_ "github.com/jackc/pgx/v5/stdlib" // required for SQL access
 	migrate "github.com/rubenv/sql-migrate"
@@ -53,6 +54,42 @@ func Migration() (*migrate.MemoryMigrationSource, error) {
 					`DROP TABLE IF EXISTS connections`,
 				},
 			},
+			{
+				Id: "channels_02",
+				Up: []string{
+					`ALTER TABLE connections ADD COLUMN IF NOT EXISTS metadata JSONB NOT NULL DEFAULT '{}'`,
+				},
+				Down: []string{
+					`ALTER TABLE connections DROP COLUMN IF EXISTS metadata`,
+				},
+			},
+			{
+				Id: "channels_03",
+				Up: []string{
+					`ALTER TABLE channels ADD COLUMN IF NOT EXISTS public BOOLEAN NOT NULL DEFAULT FALSE`,
+				},
+				Down: []string{
+					`ALTER TABLE channels DROP COLUMN IF EXISTS public`,
+				},
+			},
+			{
+				Id: "channels_04",
+				Up: []string{
+					`ALTER TABLE channels ADD COLUMN IF NOT EXISTS labels JSONB`,
+				},
+				Down: []string{
+					`ALTER TABLE channels DROP COLUMN IF EXISTS labels`,
+				},
+			},
+			{
+				Id: "channels_05",
+				Up: postgres.RLSPolicy("channels", "domain_id", postgres.DomainGUC),
+				Down: []string{
+					`DROP POLICY IF EXISTS channels_owner ON channels`,
+					`ALTER TABLE channels NO FORCE ROW LEVEL SECURITY`,
+					`ALTER TABLE channels DISABLE ROW LEVEL SECURITY`,
+				},
+			},
 		},
 	}
 	channelsMigration.Migrations = append(channelsMigration.Migrations, rolesMigration.Migrations...)
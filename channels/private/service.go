@@ -7,14 +7,19 @@ import (
 	"context"
 
 	"github.com/absmach/supermq/channels"
+	"github.com/absmach/supermq/clients"
+	"github.com/absmach/supermq/pkg/connections"
 	"github.com/absmach/supermq/pkg/errors"
 	svcerr "github.com/absmach/supermq/pkg/errors/service"
 	"github.com/absmach/supermq/pkg/policies"
+	"github.com/absmach/supermq/pkg/topics"
 )
 
 //go:generate mockery --name Service  --output=./mocks --filename service.go --quiet --note "Copyright (c) Abstract Machines"
 type Service interface {
-	Authorize(ctx context.Context, req channels.AuthzReq) error
+	// Authorize checks the connection and returns the subtopic patterns
+	// configured for it, if any, to be enforced by the caller.
+	Authorize(ctx context.Context, req channels.AuthzReq) ([]string, error)
 	UnsetParentGroupFromChannels(ctx context.Context, parentGroupID string) error
 	RemoveClientConnections(ctx context.Context, clientID string) error
 	RetrieveByID(ctx context.Context, id string) (channels.Channel, error)
@@ -32,7 +37,22 @@ func New(repo channels.Repository, evaluator policies.Evaluator, policy policies
 	return service{repo, evaluator, policy}
 }
 
-func (svc service) Authorize(ctx context.Context, req channels.AuthzReq) error {
+func (svc service) Authorize(ctx context.Context, req channels.AuthzReq) ([]string, error) {
+	// Anonymous requests are allowed read-only access to public channels.
+	if req.ClientType == "" && req.ClientID == "" {
+		if req.Type != connections.Subscribe {
+			return nil, svcerr.ErrAuthorization
+		}
+		ch, err := svc.repo.RetrieveByID(ctx, req.ChannelID)
+		if err != nil {
+			return nil, errors.Wrap(svcerr.ErrAuthorization, err)
+		}
+		if !ch.Public || ch.Status != clients.EnabledStatus {
+			return nil, svcerr.ErrAuthorization
+		}
+		return nil, nil
+	}
+
 	switch req.ClientType {
 	case policies.UserType:
 		pr := policies.Policy{
@@ -42,21 +62,23 @@ func (svc service) Authorize(ctx context.Context, req channels.AuthzReq) error {
 			ObjectType:  policies.ChannelType,
 		}
 		if err := svc.evaluator.CheckPolicy(ctx, pr); err != nil {
-			return errors.Wrap(svcerr.ErrAuthorization, err)
+			return nil, errors.Wrap(svcerr.ErrAuthorization, err)
 		}
-		return nil
+		return nil, nil
 	case policies.ClientType:
 		// Optimization: Add cache
-		if err := svc.repo.ClientAuthorize(ctx, channels.Connection{
+		conn, err := svc.repo.ClientAuthorize(ctx, channels.Connection{
 			ChannelID: req.ChannelID,
 			ClientID:  req.ClientID,
 			Type:      req.Type,
-		}); err != nil {
-			return errors.Wrap(svcerr.ErrAuthorization, err)
+		})
+		if err != nil {
+			return nil, errors.Wrap(svcerr.ErrAuthorization, err)
 		}
-		return nil
+		patterns := topics.FromMetadata(conn.Metadata, req.Type)
+		return topics.Expand(patterns, req.ClientID), nil
 	default:
-		return svcerr.ErrAuthentication
+		return nil, svcerr.ErrAuthentication
 	}
 }
 
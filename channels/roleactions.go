@@ -19,7 +19,9 @@ const (
 )
 
 const (
-	BuiltInRoleAdmin = "admin"
+	BuiltInRoleAdmin  = "admin"
+	BuiltInRoleEditor = "editor"
+	BuiltInRoleViewer = "viewer"
 )
 
 func AvailableActions() []roles.Action {
@@ -38,6 +40,8 @@ func AvailableActions() []roles.Action {
 
 func BuiltInRoles() map[roles.BuiltInRoleName][]roles.Action {
 	return map[roles.BuiltInRoleName][]roles.Action{
-		BuiltInRoleAdmin: AvailableActions(),
+		BuiltInRoleAdmin:  AvailableActions(),
+		BuiltInRoleEditor: {ChannelUpdate, ChannelRead, ChannelConnectToChannel},
+		BuiltInRoleViewer: {ChannelRead},
 	}
 }
@@ -93,9 +93,7 @@ func (svc service) CreateChannels(ctx context.Context, session authn.Session, ch
 		}
 	}()
 
-	newBuiltInRoleMembers := map[roles.BuiltInRoleName][]roles.Member{
-		BuiltInRoleAdmin: {roles.Member(session.UserID)},
-	}
+	newBuiltInRoleMembers := svc.DefaultRoleMembers(BuiltInRoleAdmin, []roles.Member{roles.Member(session.UserID)})
 
 	optionalPolicies := []policies.Policy{}
 
@@ -122,6 +120,7 @@ func (svc service) UpdateChannel(ctx context.Context, session authn.Session, ch
 		ID:        ch.ID,
 		Name:      ch.Name,
 		Metadata:  ch.Metadata,
+		Labels:    ch.Labels,
 		UpdatedAt: time.Now(),
 		UpdatedBy: session.UserID,
 	}
@@ -146,6 +145,20 @@ func (svc service) UpdateChannelTags(ctx context.Context, session authn.Session,
 	return channel, nil
 }
 
+func (svc service) SetChannelPublic(ctx context.Context, session authn.Session, id string, public bool) (Channel, error) {
+	channel := Channel{
+		ID:        id,
+		Public:    public,
+		UpdatedAt: time.Now(),
+		UpdatedBy: session.UserID,
+	}
+	channel, err := svc.repo.UpdatePublic(ctx, channel)
+	if err != nil {
+		return Channel{}, errors.Wrap(svcerr.ErrUpdateEntity, err)
+	}
+	return channel, nil
+}
+
 func (svc service) EnableChannel(ctx context.Context, session authn.Session, id string) (Channel, error) {
 	channel := Channel{
 		ID:        id,
@@ -407,6 +420,23 @@ func (svc service) Disconnect(ctx context.Context, session authn.Session, chIDs,
 	return nil
 }
 
+func (svc service) ListChannelConnections(ctx context.Context, session authn.Session, channelID string, pm PageMetadata) (ConnectionsPage, error) {
+	cp, err := svc.repo.RetrieveConnections(ctx, channelID, pm)
+	if err != nil {
+		return ConnectionsPage{}, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+	return cp, nil
+}
+
+func (svc service) UpdateConnection(ctx context.Context, session authn.Session, conn Connection) (Connection, error) {
+	conn.DomainID = session.DomainID
+	conn, err := svc.repo.UpdateConnection(ctx, conn)
+	if err != nil {
+		return Connection{}, errors.Wrap(svcerr.ErrUpdateEntity, err)
+	}
+	return conn, nil
+}
+
 func (svc service) SetParentGroup(ctx context.Context, session authn.Session, parentGroupID string, id string) (retErr error) {
 	ch, err := svc.repo.RetrieveByID(ctx, id)
 	if err != nil {
@@ -75,6 +75,17 @@ func (tm *tracingMiddleware) UpdateChannelTags(ctx context.Context, session auth
 	return tm.svc.UpdateChannelTags(ctx, session, cli)
 }
 
+// SetChannelPublic traces the "SetChannelPublic" operation of the wrapped policies.Service.
+func (tm *tracingMiddleware) SetChannelPublic(ctx context.Context, session authn.Session, id string, public bool) (channels.Channel, error) {
+	ctx, span := tm.tracer.Start(ctx, "svc_set_channel_public", trace.WithAttributes(
+		attribute.String("id", id),
+		attribute.Bool("public", public),
+	))
+	defer span.End()
+
+	return tm.svc.SetChannelPublic(ctx, session, id, public)
+}
+
 // EnableChannel traces the "EnableChannel" operation of the wrapped policies.Service.
 func (tm *tracingMiddleware) EnableChannel(ctx context.Context, session authn.Session, id string) (channels.Channel, error) {
 	ctx, span := tm.tracer.Start(ctx, "svc_enable_channel", trace.WithAttributes(attribute.String("id", id)))
@@ -107,6 +118,23 @@ func (tm *tracingMiddleware) Connect(ctx context.Context, session authn.Session,
 	return tm.svc.Connect(ctx, session, chIDs, thIDs, connTypes)
 }
 
+func (tm *tracingMiddleware) ListChannelConnections(ctx context.Context, session authn.Session, channelID string, pm channels.PageMetadata) (channels.ConnectionsPage, error) {
+	ctx, span := tm.tracer.Start(ctx, "list_channel_connections", trace.WithAttributes(
+		attribute.String("channel_id", channelID),
+	))
+	defer span.End()
+	return tm.svc.ListChannelConnections(ctx, session, channelID, pm)
+}
+
+func (tm *tracingMiddleware) UpdateConnection(ctx context.Context, session authn.Session, conn channels.Connection) (channels.Connection, error) {
+	ctx, span := tm.tracer.Start(ctx, "update_connection", trace.WithAttributes(
+		attribute.String("channel_id", conn.ChannelID),
+		attribute.String("client_id", conn.ClientID),
+	))
+	defer span.End()
+	return tm.svc.UpdateConnection(ctx, session, conn)
+}
+
 func (tm *tracingMiddleware) Disconnect(ctx context.Context, session authn.Session, chIDs, thIDs []string, connTypes []connections.ConnType) error {
 	ctx, span := tm.tracer.Start(ctx, "disconnect", trace.WithAttributes(
 		attribute.StringSlice("channel_ids", chIDs),
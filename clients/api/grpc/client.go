@@ -34,6 +34,10 @@ type grpcClient struct {
 	removeConnections          endpoint.Endpoint
 	removeChannelConnections   endpoint.Endpoint
 	unsetParentGroupFromClient endpoint.Endpoint
+	createClient               endpoint.Endpoint
+	updateClient               endpoint.Endpoint
+	removeClient               endpoint.Endpoint
+	listClients                endpoint.Endpoint
 }
 
 // NewClient returns new gRPC client instance.
@@ -102,6 +106,42 @@ func NewClient(conn *grpc.ClientConn, timeout time.Duration) grpcClientsV1.Clien
 			grpcClientsV1.UnsetParentGroupFromClientRes{},
 		).Endpoint(),
 
+		createClient: kitgrpc.NewClient(
+			conn,
+			svcName,
+			"CreateClient",
+			passthroughRequest,
+			passthroughResponse,
+			grpcClientsV1.ClientRes{},
+		).Endpoint(),
+
+		updateClient: kitgrpc.NewClient(
+			conn,
+			svcName,
+			"UpdateClient",
+			passthroughRequest,
+			passthroughResponse,
+			grpcClientsV1.ClientRes{},
+		).Endpoint(),
+
+		removeClient: kitgrpc.NewClient(
+			conn,
+			svcName,
+			"RemoveClient",
+			passthroughRequest,
+			passthroughResponse,
+			grpcClientsV1.RemoveClientRes{},
+		).Endpoint(),
+
+		listClients: kitgrpc.NewClient(
+			conn,
+			svcName,
+			"ListClients",
+			passthroughRequest,
+			passthroughResponse,
+			grpcClientsV1.ListClientsRes{},
+		).Endpoint(),
+
 		timeout: timeout,
 	}
 }
@@ -372,3 +412,57 @@ func decodeError(err error) error {
 	}
 	return err
 }
+
+func (client grpcClient) CreateClient(ctx context.Context, req *grpcClientsV1.CreateClientReq, _ ...grpc.CallOption) (*grpcClientsV1.ClientRes, error) {
+	ctx, cancel := context.WithTimeout(ctx, client.timeout)
+	defer cancel()
+
+	res, err := client.createClient(ctx, req)
+	if err != nil {
+		return &grpcClientsV1.ClientRes{}, decodeError(err)
+	}
+	return res.(*grpcClientsV1.ClientRes), nil
+}
+
+func (client grpcClient) UpdateClient(ctx context.Context, req *grpcClientsV1.UpdateClientReq, _ ...grpc.CallOption) (*grpcClientsV1.ClientRes, error) {
+	ctx, cancel := context.WithTimeout(ctx, client.timeout)
+	defer cancel()
+
+	res, err := client.updateClient(ctx, req)
+	if err != nil {
+		return &grpcClientsV1.ClientRes{}, decodeError(err)
+	}
+	return res.(*grpcClientsV1.ClientRes), nil
+}
+
+func (client grpcClient) RemoveClient(ctx context.Context, req *grpcClientsV1.RemoveClientReq, _ ...grpc.CallOption) (*grpcClientsV1.RemoveClientRes, error) {
+	ctx, cancel := context.WithTimeout(ctx, client.timeout)
+	defer cancel()
+
+	res, err := client.removeClient(ctx, req)
+	if err != nil {
+		return &grpcClientsV1.RemoveClientRes{}, decodeError(err)
+	}
+	return res.(*grpcClientsV1.RemoveClientRes), nil
+}
+
+func (client grpcClient) ListClients(ctx context.Context, req *grpcClientsV1.ListClientsReq, _ ...grpc.CallOption) (*grpcClientsV1.ListClientsRes, error) {
+	ctx, cancel := context.WithTimeout(ctx, client.timeout)
+	defer cancel()
+
+	res, err := client.listClients(ctx, req)
+	if err != nil {
+		return &grpcClientsV1.ListClientsRes{}, decodeError(err)
+	}
+	return res.(*grpcClientsV1.ListClientsRes), nil
+}
+
+// passthroughRequest forwards the protobuf request as-is, since CRUD
+// requests carry no transport-specific representation.
+func passthroughRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	return grpcReq, nil
+}
+
+func passthroughResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	return grpcRes, nil
+}
@@ -0,0 +1,240 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+
+	grpcClientsV1 "github.com/absmach/supermq/api/grpc/clients/v1"
+	apiutil "github.com/absmach/supermq/api/http/util"
+	"github.com/absmach/supermq/clients"
+	smqauthn "github.com/absmach/supermq/pkg/authn"
+	"github.com/absmach/supermq/pkg/errors"
+	svcerr "github.com/absmach/supermq/pkg/errors/service"
+	"github.com/go-kit/kit/endpoint"
+)
+
+type createClientReq struct {
+	token    string
+	domainID string
+	client   clients.Client
+}
+
+type updateClientReq struct {
+	token    string
+	domainID string
+	client   clients.Client
+}
+
+type removeClientReq struct {
+	token    string
+	domainID string
+	id       string
+}
+
+type listClientsReq struct {
+	token    string
+	domainID string
+	offset   uint64
+	limit    uint64
+}
+
+type clientRes struct {
+	client clients.Client
+}
+
+type removeClientRes struct{}
+
+type listClientsRes struct {
+	total   uint64
+	offset  uint64
+	limit   uint64
+	clients []clients.Client
+}
+
+func createClientEndpoint(svc clients.Service, authn smqauthn.Authentication) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(createClientReq)
+		session, err := authenticate(ctx, authn, req.token, req.domainID)
+		if err != nil {
+			return clientRes{}, err
+		}
+		created, _, err := svc.CreateClients(ctx, session, req.client)
+		if err != nil {
+			return clientRes{}, err
+		}
+		return clientRes{client: created[0]}, nil
+	}
+}
+
+func updateClientEndpoint(svc clients.Service, authn smqauthn.Authentication) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(updateClientReq)
+		session, err := authenticate(ctx, authn, req.token, req.domainID)
+		if err != nil {
+			return clientRes{}, err
+		}
+		client, err := svc.Update(ctx, session, req.client)
+		if err != nil {
+			return clientRes{}, err
+		}
+		return clientRes{client: client}, nil
+	}
+}
+
+func removeClientEndpoint(svc clients.Service, authn smqauthn.Authentication) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(removeClientReq)
+		session, err := authenticate(ctx, authn, req.token, req.domainID)
+		if err != nil {
+			return removeClientRes{}, err
+		}
+		if err := svc.Delete(ctx, session, req.id); err != nil {
+			return removeClientRes{}, err
+		}
+		return removeClientRes{}, nil
+	}
+}
+
+func listClientsEndpoint(svc clients.Service, authn smqauthn.Authentication) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(listClientsReq)
+		session, err := authenticate(ctx, authn, req.token, req.domainID)
+		if err != nil {
+			return listClientsRes{}, err
+		}
+		page, err := svc.ListClients(ctx, session, "", clients.Page{Offset: req.offset, Limit: req.limit})
+		if err != nil {
+			return listClientsRes{}, err
+		}
+		return listClientsRes{
+			total:   page.Total,
+			offset:  page.Offset,
+			limit:   page.Limit,
+			clients: page.Clients,
+		}, nil
+	}
+}
+
+// authenticate resolves the bearer token into a session scoped to the domain,
+// mirroring what the HTTP authentication middleware does.
+func authenticate(ctx context.Context, authn smqauthn.Authentication, token, domainID string) (smqauthn.Session, error) {
+	if token == "" {
+		return smqauthn.Session{}, errors.Wrap(svcerr.ErrAuthentication, apiutil.ErrBearerToken)
+	}
+	if domainID == "" {
+		return smqauthn.Session{}, errors.Wrap(svcerr.ErrAuthentication, apiutil.ErrMissingDomainID)
+	}
+	session, err := authn.Authenticate(ctx, token)
+	if err != nil {
+		return smqauthn.Session{}, errors.Wrap(svcerr.ErrAuthentication, err)
+	}
+	session.DomainID = domainID
+	session.DomainUserID = domainID + "_" + session.UserID
+
+	return session, nil
+}
+
+func decodeCreateClientRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*grpcClientsV1.CreateClientReq)
+	client, err := decodeClient(req.GetClient())
+	if err != nil {
+		return nil, err
+	}
+	return createClientReq{token: req.GetToken(), domainID: req.GetDomainId(), client: client}, nil
+}
+
+func decodeUpdateClientRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*grpcClientsV1.UpdateClientReq)
+	client, err := decodeClient(req.GetClient())
+	if err != nil {
+		return nil, err
+	}
+	return updateClientReq{token: req.GetToken(), domainID: req.GetDomainId(), client: client}, nil
+}
+
+func decodeRemoveClientRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*grpcClientsV1.RemoveClientReq)
+	return removeClientReq{token: req.GetToken(), domainID: req.GetDomainId(), id: req.GetId()}, nil
+}
+
+func decodeListClientsRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*grpcClientsV1.ListClientsReq)
+	return listClientsReq{token: req.GetToken(), domainID: req.GetDomainId(), offset: req.GetOffset(), limit: req.GetLimit()}, nil
+}
+
+func encodeClientResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(clientRes)
+	client, err := encodeClient(res.client)
+	if err != nil {
+		return nil, err
+	}
+	return &grpcClientsV1.ClientRes{Client: client}, nil
+}
+
+func encodeRemoveClientResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	return &grpcClientsV1.RemoveClientRes{}, nil
+}
+
+func encodeListClientsResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(listClientsRes)
+	cls := make([]*grpcClientsV1.Client, 0, len(res.clients))
+	for _, c := range res.clients {
+		client, err := encodeClient(c)
+		if err != nil {
+			return nil, err
+		}
+		cls = append(cls, client)
+	}
+	return &grpcClientsV1.ListClientsRes{
+		Total:   res.total,
+		Offset:  res.offset,
+		Limit:   res.limit,
+		Clients: cls,
+	}, nil
+}
+
+func decodeClient(c *grpcClientsV1.Client) (clients.Client, error) {
+	if c == nil {
+		return clients.Client{}, nil
+	}
+	metadata := clients.Metadata{}
+	if len(c.GetMetadata()) > 0 {
+		if err := json.Unmarshal(c.GetMetadata(), &metadata); err != nil {
+			return clients.Client{}, err
+		}
+	}
+	return clients.Client{
+		ID:          c.GetId(),
+		Name:        c.GetName(),
+		Domain:      c.GetDomainId(),
+		ParentGroup: c.GetParentGroupId(),
+		Credentials: clients.Credentials{Identity: c.GetIdentity(), Secret: c.GetSecret()},
+		Tags:        c.GetTags(),
+		Metadata:    metadata,
+		Status:      clients.Status(c.GetStatus()),
+	}, nil
+}
+
+func encodeClient(c clients.Client) (*grpcClientsV1.Client, error) {
+	var metadata []byte
+	if len(c.Metadata) > 0 {
+		var err error
+		if metadata, err = json.Marshal(c.Metadata); err != nil {
+			return nil, err
+		}
+	}
+	return &grpcClientsV1.Client{
+		Id:            c.ID,
+		Name:          c.Name,
+		DomainId:      c.Domain,
+		ParentGroupId: c.ParentGroup,
+		Identity:      c.Credentials.Identity,
+		Secret:        c.Credentials.Secret,
+		Tags:          c.Tags,
+		Metadata:      metadata,
+		Status:        uint32(c.Status),
+	}, nil
+}
@@ -14,8 +14,10 @@ import (
 	grpcCommonV1 "github.com/absmach/supermq/api/grpc/common/v1"
 	"github.com/absmach/supermq/clients"
 	grpcapi "github.com/absmach/supermq/clients/api/grpc"
+	climocks "github.com/absmach/supermq/clients/mocks"
 	"github.com/absmach/supermq/clients/private/mocks"
 	"github.com/absmach/supermq/internal/testsutil"
+	authnmocks "github.com/absmach/supermq/pkg/authn/mocks"
 	"github.com/absmach/supermq/pkg/connections"
 	"github.com/absmach/supermq/pkg/errors"
 	svcerr "github.com/absmach/supermq/pkg/errors/service"
@@ -44,7 +46,7 @@ func startGRPCServer(svc *mocks.Service, port int) *grpc.Server {
 		panic(fmt.Sprintf("failed to obtain port: %s", err))
 	}
 	server := grpc.NewServer()
-	grpcClientsV1.RegisterClientsServiceServer(server, grpcapi.NewServer(svc))
+	grpcClientsV1.RegisterClientsServiceServer(server, grpcapi.NewServer(svc, new(climocks.Service), new(authnmocks.Authentication)))
 	go func() {
 		if err := server.Serve(listener); err != nil {
 			panic(fmt.Sprintf("failed to serve: %s", err))
@@ -10,7 +10,9 @@ import (
 	grpcCommonV1 "github.com/absmach/supermq/api/grpc/common/v1"
 	apiutil "github.com/absmach/supermq/api/http/util"
 	smqauth "github.com/absmach/supermq/auth"
+	smqclients "github.com/absmach/supermq/clients"
 	clients "github.com/absmach/supermq/clients/private"
+	smqauthn "github.com/absmach/supermq/pkg/authn"
 	"github.com/absmach/supermq/pkg/connections"
 	"github.com/absmach/supermq/pkg/errors"
 	svcerr "github.com/absmach/supermq/pkg/errors/service"
@@ -30,10 +32,14 @@ type grpcServer struct {
 	removeConnections          kitgrpc.Handler
 	removeChannelConnections   kitgrpc.Handler
 	unsetParentGroupFromClient kitgrpc.Handler
+	createClient               kitgrpc.Handler
+	updateClient               kitgrpc.Handler
+	removeClient               kitgrpc.Handler
+	listClients                kitgrpc.Handler
 }
 
 // NewServer returns new AuthServiceServer instance.
-func NewServer(svc clients.Service) grpcClientsV1.ClientsServiceServer {
+func NewServer(svc clients.Service, csvc smqclients.Service, authn smqauthn.Authentication) grpcClientsV1.ClientsServiceServer {
 	return &grpcServer{
 		authenticate: kitgrpc.NewServer(
 			authenticateEndpoint(svc),
@@ -70,9 +76,61 @@ func NewServer(svc clients.Service) grpcClientsV1.ClientsServiceServer {
 			decodeUnsetParentGroupFromClientRequest,
 			encodeUnsetParentGroupFromClientResponse,
 		),
+		createClient: kitgrpc.NewServer(
+			createClientEndpoint(csvc, authn),
+			decodeCreateClientRequest,
+			encodeClientResponse,
+		),
+		updateClient: kitgrpc.NewServer(
+			updateClientEndpoint(csvc, authn),
+			decodeUpdateClientRequest,
+			encodeClientResponse,
+		),
+		removeClient: kitgrpc.NewServer(
+			removeClientEndpoint(csvc, authn),
+			decodeRemoveClientRequest,
+			encodeRemoveClientResponse,
+		),
+		listClients: kitgrpc.NewServer(
+			listClientsEndpoint(csvc, authn),
+			decodeListClientsRequest,
+			encodeListClientsResponse,
+		),
 	}
 }
 
+func (s *grpcServer) CreateClient(ctx context.Context, req *grpcClientsV1.CreateClientReq) (*grpcClientsV1.ClientRes, error) {
+	_, res, err := s.createClient.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res.(*grpcClientsV1.ClientRes), nil
+}
+
+func (s *grpcServer) UpdateClient(ctx context.Context, req *grpcClientsV1.UpdateClientReq) (*grpcClientsV1.ClientRes, error) {
+	_, res, err := s.updateClient.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res.(*grpcClientsV1.ClientRes), nil
+}
+
+func (s *grpcServer) RemoveClient(ctx context.Context, req *grpcClientsV1.RemoveClientReq) (*grpcClientsV1.RemoveClientRes, error) {
+	_, res, err := s.removeClient.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res.(*grpcClientsV1.RemoveClientRes), nil
+}
+
+func (s *grpcServer) ListClients(ctx context.Context, req *grpcClientsV1.ListClientsReq) (*grpcClientsV1.ListClientsRes, error) {
+	_, res, err := s.listClients.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res.(*grpcClientsV1.ListClientsRes), nil
+}
+
 func (s *grpcServer) Authenticate(ctx context.Context, req *grpcClientsV1.AuthnReq) (*grpcClientsV1.AuthnRes, error) {
 	_, res, err := s.authenticate.ServeGRPC(ctx, req)
 	if err != nil {
@@ -284,6 +342,9 @@ func encodeError(err error) error {
 	case errors.Contains(err, svcerr.ErrAuthorization):
 		return status.Error(codes.PermissionDenied, err.Error())
 	default:
+		if code := errors.CodeOf(err); code != errors.CodeInternal {
+			return status.Error(code.GRPCStatus(), err.Error())
+		}
 		return status.Error(codes.Internal, err.Error())
 	}
 }
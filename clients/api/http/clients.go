@@ -19,6 +19,7 @@ import (
 func clientsHandler(svc clients.Service, authn smqauthn.Authentication, r *chi.Mux, logger *slog.Logger) *chi.Mux {
 	opts := []kithttp.ServerOption{
 		kithttp.ServerErrorEncoder(apiutil.LoggingErrorEncoder(logger, api.EncodeError)),
+		kithttp.ServerBefore(api.AcceptToContext),
 	}
 	d := roleManagerHttp.NewDecoder("clientID")
 
@@ -46,6 +47,49 @@ func clientsHandler(svc clients.Service, authn smqauthn.Authentication, r *chi.M
 				api.EncodeResponse,
 				opts...,
 			), "create_clients").ServeHTTP)
+
+			r.Put("/external/{externalID}", otelhttp.NewHandler(kithttp.NewServer(
+				ensureClientEndpoint(svc),
+				decodeEnsureClientReq,
+				api.EncodeResponse,
+				opts...,
+			), "ensure_client").ServeHTTP)
+			r.Route("/trash", func(r chi.Router) {
+				r.Get("/", otelhttp.NewHandler(kithttp.NewServer(
+					listClientTrashEndpoint(svc),
+					decodeListClientTrash,
+					api.EncodeResponse,
+					opts...,
+				), "list_client_trash").ServeHTTP)
+
+				r.Post("/{clientID}/restore", otelhttp.NewHandler(kithttp.NewServer(
+					restoreClientEndpoint(svc),
+					decodeRestoreClient,
+					api.EncodeResponse,
+					opts...,
+				), "restore_client").ServeHTTP)
+			})
+
+			r.Post("/claim", otelhttp.NewHandler(kithttp.NewServer(
+				claimClientEndpoint(svc),
+				decodeClaimClient,
+				api.EncodeResponse,
+				opts...,
+			), "claim_client").ServeHTTP)
+
+			r.Get("/tags", otelhttp.NewHandler(kithttp.NewServer(
+				listClientTagsEndpoint(svc),
+				decodeListClientTags,
+				api.EncodeResponse,
+				opts...,
+			), "list_client_tags").ServeHTTP)
+
+			r.Post("/tags/rename", otelhttp.NewHandler(kithttp.NewServer(
+				renameClientTagEndpoint(svc),
+				decodeRenameClientTag,
+				api.EncodeResponse,
+				opts...,
+			), "rename_client_tag").ServeHTTP)
 			r = roleManagerHttp.EntityAvailableActionsRouter(svc, d, r, opts)
 
 			r.Route("/{clientID}", func(r chi.Router) {
@@ -91,6 +135,41 @@ func clientsHandler(svc clients.Service, authn smqauthn.Authentication, r *chi.M
 					opts...,
 				), "disable_client").ServeHTTP)
 
+				r.Post("/share", otelhttp.NewHandler(kithttp.NewServer(
+					shareClientEndpoint(svc),
+					decodeShareClient,
+					api.EncodeResponse,
+					opts...,
+				), "share_client").ServeHTTP)
+
+				r.Get("/share", otelhttp.NewHandler(kithttp.NewServer(
+					listClientSharesEndpoint(svc),
+					decodeListClientShares,
+					api.EncodeResponse,
+					opts...,
+				), "list_client_shares").ServeHTTP)
+
+				r.Post("/relations", otelhttp.NewHandler(kithttp.NewServer(
+					addClientRelationEndpoint(svc),
+					decodeClientRelation,
+					api.EncodeResponse,
+					opts...,
+				), "add_client_relation").ServeHTTP)
+
+				r.Delete("/relations", otelhttp.NewHandler(kithttp.NewServer(
+					removeClientRelationEndpoint(svc),
+					decodeClientRelation,
+					api.EncodeResponse,
+					opts...,
+				), "remove_client_relation").ServeHTTP)
+
+				r.Get("/related", otelhttp.NewHandler(kithttp.NewServer(
+					listRelatedClientsEndpoint(svc),
+					decodeListRelatedClients,
+					api.EncodeResponse,
+					opts...,
+				), "list_related_clients").ServeHTTP)
+
 				r.Post("/parent", otelhttp.NewHandler(kithttp.NewServer(
 					setClientParentGroupEndpoint(svc),
 					decodeSetClientParentGroupStatus,
@@ -13,10 +13,15 @@ import (
 	apiutil "github.com/absmach/supermq/api/http/util"
 	"github.com/absmach/supermq/clients"
 	"github.com/absmach/supermq/pkg/errors"
+	"github.com/absmach/supermq/pkg/labels"
 	"github.com/go-chi/chi/v5"
 )
 
-const clientID = "clientID"
+const (
+	clientID = "clientID"
+	depthKey = "depth"
+	defDepth = uint64(1)
+)
 
 func decodeViewClient(_ context.Context, r *http.Request) (interface{}, error) {
 	req := viewClientReq{
@@ -64,6 +69,14 @@ func decodeListClients(_ context.Context, r *http.Request) (interface{}, error)
 	if err != nil {
 		return nil, errors.Wrap(apiutil.ErrValidation, err)
 	}
+	ls, err := apiutil.ReadStringQuery(r, api.LabelSelectorKey, "")
+	if err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	sel, err := labels.ParseSelector(ls)
+	if err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, err)
+	}
 	st, err := clients.ToStatus(s)
 	if err != nil {
 		return nil, errors.Wrap(apiutil.ErrValidation, err)
@@ -77,6 +90,7 @@ func decodeListClients(_ context.Context, r *http.Request) (interface{}, error)
 		tag:        t,
 		permission: p,
 		listPerms:  lp,
+		selector:   sel,
 		userID:     chi.URLParam(r, "userID"),
 		id:         id,
 	}
@@ -84,12 +98,30 @@ func decodeListClients(_ context.Context, r *http.Request) (interface{}, error)
 }
 
 func decodeUpdateClient(_ context.Context, r *http.Request) (interface{}, error) {
+	version, err := apiutil.ReadIfMatchHeader(r)
+	if err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, err)
+	}
+
+	if strings.Contains(r.Header.Get("Content-Type"), api.ContentTypeMergePatch) {
+		req := updateClientPatchReq{
+			id:      chi.URLParam(r, clientID),
+			version: version,
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			return nil, errors.Wrap(apiutil.ErrValidation, errors.Wrap(errors.ErrMalformedEntity, err))
+		}
+
+		return req, nil
+	}
+
 	if !strings.Contains(r.Header.Get("Content-Type"), api.ContentType) {
 		return nil, errors.Wrap(apiutil.ErrValidation, apiutil.ErrUnsupportedContentType)
 	}
 
 	req := updateClientReq{
-		id: chi.URLParam(r, clientID),
+		id:      chi.URLParam(r, clientID),
+		version: version,
 	}
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		return nil, errors.Wrap(apiutil.ErrValidation, errors.Wrap(errors.ErrMalformedEntity, err))
@@ -103,8 +135,14 @@ func decodeUpdateClientTags(_ context.Context, r *http.Request) (interface{}, er
 		return nil, errors.Wrap(apiutil.ErrValidation, apiutil.ErrUnsupportedContentType)
 	}
 
+	version, err := apiutil.ReadIfMatchHeader(r)
+	if err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, err)
+	}
+
 	req := updateClientTagsReq{
-		id: chi.URLParam(r, clientID),
+		id:      chi.URLParam(r, clientID),
+		version: version,
 	}
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		return nil, errors.Wrap(apiutil.ErrValidation, errors.Wrap(errors.ErrMalformedEntity, err))
@@ -144,6 +182,23 @@ func decodeCreateClientReq(_ context.Context, r *http.Request) (interface{}, err
 	return req, nil
 }
 
+func decodeEnsureClientReq(_ context.Context, r *http.Request) (interface{}, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), api.ContentType) {
+		return nil, errors.Wrap(apiutil.ErrValidation, apiutil.ErrUnsupportedContentType)
+	}
+
+	var c clients.Client
+	if err := json.NewDecoder(r.Body).Decode(&c); err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, errors.Wrap(errors.ErrMalformedEntity, err))
+	}
+	req := ensureClientReq{
+		client:     c,
+		externalID: chi.URLParam(r, "externalID"),
+	}
+
+	return req, nil
+}
+
 func decodeCreateClientsReq(_ context.Context, r *http.Request) (interface{}, error) {
 	if !strings.Contains(r.Header.Get("Content-Type"), api.ContentType) {
 		return nil, errors.Wrap(apiutil.ErrValidation, apiutil.ErrUnsupportedContentType)
@@ -165,6 +220,135 @@ func decodeChangeClientStatus(_ context.Context, r *http.Request) (interface{},
 	return req, nil
 }
 
+func decodeShareClient(_ context.Context, r *http.Request) (interface{}, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), api.ContentType) {
+		return nil, errors.Wrap(apiutil.ErrValidation, apiutil.ErrUnsupportedContentType)
+	}
+
+	req := shareClientReq{
+		id: chi.URLParam(r, clientID),
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, errors.Wrap(errors.ErrMalformedEntity, err))
+	}
+	return req, nil
+}
+
+func decodeListClientShares(_ context.Context, r *http.Request) (interface{}, error) {
+	o, err := apiutil.ReadNumQuery[uint64](r, api.OffsetKey, api.DefOffset)
+	if err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	l, err := apiutil.ReadNumQuery[uint64](r, api.LimitKey, api.DefLimit)
+	if err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, err)
+	}
+
+	req := listClientSharesReq{
+		id:     chi.URLParam(r, clientID),
+		limit:  l,
+		offset: o,
+	}
+	return req, nil
+}
+
+func decodeListClientTags(_ context.Context, r *http.Request) (interface{}, error) {
+	o, err := apiutil.ReadNumQuery[uint64](r, api.OffsetKey, api.DefOffset)
+	if err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	l, err := apiutil.ReadNumQuery[uint64](r, api.LimitKey, api.DefLimit)
+	if err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, err)
+	}
+
+	req := listClientTagsReq{
+		offset: o,
+		limit:  l,
+	}
+	return req, nil
+}
+
+func decodeClaimClient(_ context.Context, r *http.Request) (interface{}, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), api.ContentType) {
+		return nil, errors.Wrap(apiutil.ErrValidation, apiutil.ErrUnsupportedContentType)
+	}
+
+	var req claimClientReq
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, errors.Wrap(errors.ErrMalformedEntity, err))
+	}
+	return req, nil
+}
+
+func decodeClientRelation(_ context.Context, r *http.Request) (interface{}, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), api.ContentType) {
+		return nil, errors.Wrap(apiutil.ErrValidation, apiutil.ErrUnsupportedContentType)
+	}
+
+	var req clientRelationReq
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, errors.Wrap(errors.ErrMalformedEntity, err))
+	}
+	req.subjectID = chi.URLParam(r, clientID)
+	return req, nil
+}
+
+func decodeListRelatedClients(_ context.Context, r *http.Request) (interface{}, error) {
+	rel, err := apiutil.ReadStringQuery(r, api.RelationKey, "")
+	if err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	d, err := apiutil.ReadNumQuery[uint64](r, depthKey, defDepth)
+	if err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, err)
+	}
+
+	req := listRelatedClientsReq{
+		id:       chi.URLParam(r, clientID),
+		relation: rel,
+		depth:    d,
+	}
+	return req, nil
+}
+
+func decodeRenameClientTag(_ context.Context, r *http.Request) (interface{}, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), api.ContentType) {
+		return nil, errors.Wrap(apiutil.ErrValidation, apiutil.ErrUnsupportedContentType)
+	}
+
+	var req renameClientTagReq
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, errors.Wrap(errors.ErrMalformedEntity, err))
+	}
+	return req, nil
+}
+
+func decodeListClientTrash(_ context.Context, r *http.Request) (interface{}, error) {
+	o, err := apiutil.ReadNumQuery[uint64](r, api.OffsetKey, api.DefOffset)
+	if err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	l, err := apiutil.ReadNumQuery[uint64](r, api.LimitKey, api.DefLimit)
+	if err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, err)
+	}
+
+	req := listClientTrashReq{
+		offset: o,
+		limit:  l,
+	}
+	return req, nil
+}
+
+func decodeRestoreClient(_ context.Context, r *http.Request) (interface{}, error) {
+	req := restoreClientReq{
+		id: chi.URLParam(r, clientID),
+	}
+
+	return req, nil
+}
+
 func decodeSetClientParentGroupStatus(_ context.Context, r *http.Request) (interface{}, error) {
 	if !strings.Contains(r.Header.Get("Content-Type"), api.ContentType) {
 		return nil, errors.Wrap(apiutil.ErrValidation, apiutil.ErrUnsupportedContentType)
@@ -11,6 +11,7 @@ import (
 	"github.com/absmach/supermq/clients"
 	"github.com/absmach/supermq/pkg/authn"
 	"github.com/absmach/supermq/pkg/errors"
+	repoerr "github.com/absmach/supermq/pkg/errors/repository"
 	svcerr "github.com/absmach/supermq/pkg/errors/service"
 	"github.com/go-kit/kit/endpoint"
 )
@@ -39,6 +40,42 @@ func createClientEndpoint(svc clients.Service) endpoint.Endpoint {
 	}
 }
 
+func ensureClientEndpoint(svc clients.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(ensureClientReq)
+		if err := req.validate(); err != nil {
+			return nil, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(api.SessionKey).(authn.Session)
+		if !ok {
+			return nil, svcerr.ErrAuthentication
+		}
+
+		client, err := svc.ViewByExternalID(ctx, session, req.externalID)
+		switch {
+		case err == nil:
+			return createClientRes{
+				Client:  client,
+				created: false,
+			}, nil
+		case !errors.Contains(err, repoerr.ErrNotFound):
+			return nil, err
+		}
+
+		req.client.ExternalID = req.externalID
+		clients, _, err := svc.CreateClients(ctx, session, req.client)
+		if err != nil {
+			return nil, err
+		}
+
+		return createClientRes{
+			Client:  clients[0],
+			created: true,
+		}, nil
+	}
+}
+
 func createClientsEndpoint(svc clients.Service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(createClientsReq)
@@ -104,15 +141,16 @@ func listClientsEndpoint(svc clients.Service) endpoint.Endpoint {
 		}
 
 		pm := clients.Page{
-			Status:     req.status,
-			Offset:     req.offset,
-			Limit:      req.limit,
-			Name:       req.name,
-			Tag:        req.tag,
-			Permission: req.permission,
-			Metadata:   req.metadata,
-			ListPerms:  req.listPerms,
-			Id:         req.id,
+			Status:        req.status,
+			Offset:        req.offset,
+			Limit:         req.limit,
+			Name:          req.name,
+			Tag:           req.tag,
+			Permission:    req.permission,
+			Metadata:      req.metadata,
+			LabelSelector: req.selector,
+			ListPerms:     req.listPerms,
+			Id:            req.id,
 		}
 		page, err := svc.ListClients(ctx, session, req.userID, pm)
 		if err != nil {
@@ -137,21 +175,49 @@ func listClientsEndpoint(svc clients.Service) endpoint.Endpoint {
 
 func updateClientEndpoint(svc clients.Service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
-		req := request.(updateClientReq)
-		if err := req.validate(); err != nil {
-			return nil, errors.Wrap(apiutil.ErrValidation, err)
-		}
-
 		session, ok := ctx.Value(api.SessionKey).(authn.Session)
 		if !ok {
 			return nil, svcerr.ErrAuthentication
 		}
 
-		cli := clients.Client{
-			ID:       req.id,
-			Name:     req.Name,
-			Metadata: req.Metadata,
+		var cli clients.Client
+		switch req := request.(type) {
+		case updateClientReq:
+			if err := req.validate(); err != nil {
+				return nil, errors.Wrap(apiutil.ErrValidation, err)
+			}
+
+			cli = clients.Client{
+				ID:       req.id,
+				Name:     req.Name,
+				Metadata: req.Metadata,
+				Labels:   req.Labels,
+				Version:  req.version,
+			}
+		case updateClientPatchReq:
+			if err := req.validate(); err != nil {
+				return nil, errors.Wrap(apiutil.ErrValidation, err)
+			}
+
+			current, err := svc.View(ctx, session, req.id)
+			if err != nil {
+				return nil, err
+			}
+
+			cli = clients.Client{
+				ID:       req.id,
+				Name:     current.Name,
+				Metadata: api.MergeMetadata(current.Metadata, req.Metadata),
+				Version:  req.version,
+			}
+			if req.Name != nil {
+				cli.Name = *req.Name
+			}
+			if cli.Version == 0 {
+				cli.Version = current.Version
+			}
 		}
+
 		client, err := svc.Update(ctx, session, cli)
 		if err != nil {
 			return nil, err
@@ -174,8 +240,9 @@ func updateClientTagsEndpoint(svc clients.Service) endpoint.Endpoint {
 		}
 
 		cli := clients.Client{
-			ID:   req.id,
-			Tags: req.Tags,
+			ID:      req.id,
+			Tags:    req.Tags,
+			Version: req.version,
 		}
 		client, err := svc.UpdateTags(ctx, session, cli)
 		if err != nil {
@@ -249,6 +316,231 @@ func disableClientEndpoint(svc clients.Service) endpoint.Endpoint {
 	}
 }
 
+func shareClientEndpoint(svc clients.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(shareClientReq)
+		if err := req.validate(); err != nil {
+			return nil, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(api.SessionKey).(authn.Session)
+		if !ok {
+			return nil, svcerr.ErrAuthentication
+		}
+		if err := svc.Share(ctx, session, req.id, req.Relation, req.UserIDs...); err != nil {
+			return nil, err
+		}
+
+		return shareClientRes{}, nil
+	}
+}
+
+func listClientSharesEndpoint(svc clients.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(listClientSharesReq)
+		if err := req.validate(); err != nil {
+			return nil, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(api.SessionKey).(authn.Session)
+		if !ok {
+			return nil, svcerr.ErrAuthentication
+		}
+		mp, err := svc.ListShares(ctx, session, req.id, req.limit, req.offset)
+		if err != nil {
+			return nil, err
+		}
+
+		return listClientSharesRes{mp}, nil
+	}
+}
+
+func listClientTrashEndpoint(svc clients.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(listClientTrashReq)
+		if err := req.validate(); err != nil {
+			return nil, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(api.SessionKey).(authn.Session)
+		if !ok {
+			return nil, svcerr.ErrAuthentication
+		}
+
+		pm := clients.Page{
+			Offset: req.offset,
+			Limit:  req.limit,
+		}
+		page, err := svc.ListTrash(ctx, session, pm)
+		if err != nil {
+			return nil, err
+		}
+
+		res := clientsPageRes{
+			clientsPageMetaRes: clientsPageMetaRes{
+				Total:  page.Total,
+				Offset: page.Offset,
+				Limit:  page.Limit,
+			},
+			Clients: []viewClientRes{},
+		}
+		for _, c := range page.Clients {
+			res.Clients = append(res.Clients, viewClientRes{Client: c})
+		}
+
+		return res, nil
+	}
+}
+
+func restoreClientEndpoint(svc clients.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(restoreClientReq)
+		if err := req.validate(); err != nil {
+			return nil, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(api.SessionKey).(authn.Session)
+		if !ok {
+			return nil, svcerr.ErrAuthentication
+		}
+
+		client, err := svc.Restore(ctx, session, req.id)
+		if err != nil {
+			return nil, err
+		}
+
+		return changeClientStatusRes{Client: client}, nil
+	}
+}
+
+func claimClientEndpoint(svc clients.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(claimClientReq)
+		if err := req.validate(); err != nil {
+			return nil, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(api.SessionKey).(authn.Session)
+		if !ok {
+			return nil, svcerr.ErrAuthentication
+		}
+		client, err := svc.Claim(ctx, session, req.ClaimCode)
+		if err != nil {
+			return nil, err
+		}
+
+		return claimClientRes{Client: client}, nil
+	}
+}
+
+func addClientRelationEndpoint(svc clients.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(clientRelationReq)
+		if err := req.validate(); err != nil {
+			return nil, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(api.SessionKey).(authn.Session)
+		if !ok {
+			return nil, svcerr.ErrAuthentication
+		}
+		rel := clients.ClientRelation{
+			SubjectID: req.subjectID,
+			Relation:  req.Relation,
+			ObjectID:  req.ObjectID,
+		}
+		if err := svc.AddRelation(ctx, session, rel); err != nil {
+			return nil, err
+		}
+
+		return addClientRelationRes{}, nil
+	}
+}
+
+func removeClientRelationEndpoint(svc clients.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(clientRelationReq)
+		if err := req.validate(); err != nil {
+			return nil, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(api.SessionKey).(authn.Session)
+		if !ok {
+			return nil, svcerr.ErrAuthentication
+		}
+		rel := clients.ClientRelation{
+			SubjectID: req.subjectID,
+			Relation:  req.Relation,
+			ObjectID:  req.ObjectID,
+		}
+		if err := svc.RemoveRelation(ctx, session, rel); err != nil {
+			return nil, err
+		}
+
+		return removeClientRelationRes{}, nil
+	}
+}
+
+func listRelatedClientsEndpoint(svc clients.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(listRelatedClientsReq)
+		if err := req.validate(); err != nil {
+			return nil, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(api.SessionKey).(authn.Session)
+		if !ok {
+			return nil, svcerr.ErrAuthentication
+		}
+		page, err := svc.ListRelated(ctx, session, req.id, req.relation, req.depth)
+		if err != nil {
+			return nil, err
+		}
+
+		return relatedClientsPageRes{RelatedClientsPage: page}, nil
+	}
+}
+
+func listClientTagsEndpoint(svc clients.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(listClientTagsReq)
+		if err := req.validate(); err != nil {
+			return nil, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(api.SessionKey).(authn.Session)
+		if !ok {
+			return nil, svcerr.ErrAuthentication
+		}
+		tp, err := svc.ListTags(ctx, session, req.offset, req.limit)
+		if err != nil {
+			return nil, err
+		}
+
+		return listClientTagsRes{tp}, nil
+	}
+}
+
+func renameClientTagEndpoint(svc clients.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(renameClientTagReq)
+		if err := req.validate(); err != nil {
+			return nil, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(api.SessionKey).(authn.Session)
+		if !ok {
+			return nil, svcerr.ErrAuthentication
+		}
+		renamed, err := svc.RenameTag(ctx, session, req.OldTag, req.NewTag)
+		if err != nil {
+			return nil, err
+		}
+
+		return renameClientTagRes{Renamed: renamed}, nil
+	}
+}
+
 func setClientParentGroupEndpoint(svc clients.Service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(setClientParentGroupReq)
@@ -7,6 +7,7 @@ import (
 	api "github.com/absmach/supermq/api/http"
 	apiutil "github.com/absmach/supermq/api/http/util"
 	"github.com/absmach/supermq/clients"
+	"github.com/absmach/supermq/pkg/labels"
 )
 
 type createClientReq struct {
@@ -17,6 +18,31 @@ func (req createClientReq) validate() error {
 	if len(req.client.Name) > api.MaxNameSize {
 		return apiutil.ErrNameSize
 	}
+	if err := req.client.Labels.Validate(); err != nil {
+		return err
+	}
+	if req.client.ID != "" {
+		return api.ValidateUUID(req.client.ID)
+	}
+
+	return nil
+}
+
+type ensureClientReq struct {
+	client     clients.Client
+	externalID string
+}
+
+func (req ensureClientReq) validate() error {
+	if req.externalID == "" {
+		return apiutil.ErrMissingID
+	}
+	if len(req.client.Name) > api.MaxNameSize {
+		return apiutil.ErrNameSize
+	}
+	if err := req.client.Labels.Validate(); err != nil {
+		return err
+	}
 	if req.client.ID != "" {
 		return api.ValidateUUID(req.client.ID)
 	}
@@ -81,6 +107,7 @@ type listClientsReq struct {
 	userID     string
 	listPerms  bool
 	metadata   clients.Metadata
+	selector   labels.Selector
 	id         string
 }
 
@@ -116,8 +143,10 @@ func (req listMembersReq) validate() error {
 
 type updateClientReq struct {
 	id       string
+	version  uint64
 	Name     string                 `json:"name,omitempty"`
 	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	Labels   labels.Labels          `json:"labels,omitempty"`
 	Tags     []string               `json:"tags,omitempty"`
 }
 
@@ -130,12 +159,28 @@ func (req updateClientReq) validate() error {
 		return apiutil.ErrNameSize
 	}
 
+	return req.Labels.Validate()
+}
+
+type updateClientPatchReq struct {
+	id       string
+	version  uint64
+	Name     *string                `json:"name,omitempty"`
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+}
+
+func (req updateClientPatchReq) validate() error {
+	if req.id == "" {
+		return apiutil.ErrMissingID
+	}
+
 	return nil
 }
 
 type updateClientTagsReq struct {
-	id   string
-	Tags []string `json:"tags,omitempty"`
+	id      string
+	version uint64
+	Tags    []string `json:"tags,omitempty"`
 }
 
 func (req updateClientTagsReq) validate() error {
@@ -175,6 +220,138 @@ func (req changeClientStatusReq) validate() error {
 	return nil
 }
 
+type shareClientReq struct {
+	id       string
+	Relation string   `json:"relation"`
+	UserIDs  []string `json:"user_ids"`
+}
+
+func (req shareClientReq) validate() error {
+	if req.id == "" {
+		return apiutil.ErrMissingID
+	}
+	if req.Relation == "" {
+		return apiutil.ErrMissingRelation
+	}
+	if len(req.UserIDs) == 0 {
+		return apiutil.ErrEmptyList
+	}
+	return nil
+}
+
+type listClientSharesReq struct {
+	id     string
+	limit  uint64
+	offset uint64
+}
+
+func (req listClientSharesReq) validate() error {
+	if req.id == "" {
+		return apiutil.ErrMissingID
+	}
+	if req.limit > api.MaxLimitSize || req.limit < 1 {
+		return apiutil.ErrLimitSize
+	}
+	return nil
+}
+
+type claimClientReq struct {
+	ClaimCode string `json:"claim_code"`
+}
+
+func (req claimClientReq) validate() error {
+	if req.ClaimCode == "" {
+		return apiutil.ErrMissingSecret
+	}
+
+	return nil
+}
+
+type clientRelationReq struct {
+	subjectID string
+	Relation  string `json:"relation"`
+	ObjectID  string `json:"object_id"`
+}
+
+func (req clientRelationReq) validate() error {
+	if req.subjectID == "" || req.ObjectID == "" {
+		return apiutil.ErrMissingID
+	}
+	if req.Relation == "" {
+		return apiutil.ErrMissingRelation
+	}
+
+	return nil
+}
+
+type listRelatedClientsReq struct {
+	id       string
+	relation string
+	depth    uint64
+}
+
+func (req listRelatedClientsReq) validate() error {
+	if req.id == "" {
+		return apiutil.ErrMissingID
+	}
+	if req.depth < 1 || req.depth > clients.MaxRelationDepth {
+		return apiutil.ErrLevel
+	}
+
+	return nil
+}
+
+type listClientTagsReq struct {
+	offset uint64
+	limit  uint64
+}
+
+func (req listClientTagsReq) validate() error {
+	if req.limit > api.MaxLimitSize || req.limit < 1 {
+		return apiutil.ErrLimitSize
+	}
+
+	return nil
+}
+
+type renameClientTagReq struct {
+	OldTag string `json:"old_tag"`
+	NewTag string `json:"new_tag"`
+}
+
+func (req renameClientTagReq) validate() error {
+	if req.OldTag == "" || req.NewTag == "" {
+		return apiutil.ErrMissingTag
+	}
+
+	return nil
+}
+
+type listClientTrashReq struct {
+	offset uint64
+	limit  uint64
+}
+
+func (req listClientTrashReq) validate() error {
+	if req.limit > api.MaxLimitSize || req.limit < 1 {
+		return apiutil.ErrLimitSize
+	}
+
+	return nil
+}
+
+type restoreClientReq struct {
+	id string
+}
+
+func (req restoreClientReq) validate() error {
+	if req.id == "" {
+		return apiutil.ErrMissingID
+	}
+
+	return nil
+}
+
 type setClientParentGroupReq struct {
 	id            string
 	ParentGroupID string `json:"parent_group_id"`
@@ -6,9 +6,13 @@ package http
 import (
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/absmach/supermq"
+	api "github.com/absmach/supermq/api/http"
+	apiutil "github.com/absmach/supermq/api/http/util"
 	"github.com/absmach/supermq/clients"
+	"github.com/absmach/supermq/pkg/roles"
 )
 
 var (
@@ -17,7 +21,17 @@ var (
 	_ supermq.Response = (*viewClientPermsRes)(nil)
 	_ supermq.Response = (*clientsPageRes)(nil)
 	_ supermq.Response = (*changeClientStatusRes)(nil)
+	_ supermq.Response = (*shareClientRes)(nil)
+	_ supermq.Response = (*listClientSharesRes)(nil)
+	_ supermq.Response = (*claimClientRes)(nil)
+	_ supermq.Response = (*addClientRelationRes)(nil)
+	_ supermq.Response = (*removeClientRelationRes)(nil)
+	_ supermq.Response = (*relatedClientsPageRes)(nil)
+	_ supermq.Response = (*listClientTagsRes)(nil)
+	_ supermq.Response = (*renameClientTagRes)(nil)
 	_ supermq.Response = (*deleteClientRes)(nil)
+
+	_ api.Streamable = (*clientsPageRes)(nil)
 )
 
 type clientsPageMetaRes struct {
@@ -62,6 +76,9 @@ func (res updateClientRes) Code() int {
 }
 
 func (res updateClientRes) Headers() map[string]string {
+	if res.Version > 0 {
+		return map[string]string{"ETag": apiutil.ETag(res.Version)}
+	}
 	return map[string]string{}
 }
 
@@ -78,6 +95,9 @@ func (res viewClientRes) Code() int {
 }
 
 func (res viewClientRes) Headers() map[string]string {
+	if res.Version > 0 {
+		return map[string]string{"ETag": apiutil.ETag(res.Version)}
+	}
 	return map[string]string{}
 }
 
@@ -110,7 +130,24 @@ func (res clientsPageRes) Code() int {
 	return http.StatusOK
 }
 
+func (res clientsPageRes) StreamItems() []interface{} {
+	items := make([]interface{}, 0, len(res.Clients))
+	for _, c := range res.Clients {
+		items = append(items, c)
+	}
+
+	return items
+}
+
 func (res clientsPageRes) Headers() map[string]string {
+	times := make([]time.Time, 0, 2*len(res.Clients))
+	for _, c := range res.Clients {
+		times = append(times, c.CreatedAt, c.UpdatedAt)
+	}
+	if lm := api.LastModified(times...); lm != "" {
+		return map[string]string{"Last-Modified": lm}
+	}
+
 	return map[string]string{}
 }
 
@@ -134,6 +171,128 @@ func (res changeClientStatusRes) Empty() bool {
 	return false
 }
 
+type shareClientRes struct{}
+
+func (res shareClientRes) Code() int {
+	return http.StatusCreated
+}
+
+func (res shareClientRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res shareClientRes) Empty() bool {
+	return true
+}
+
+type listClientSharesRes struct {
+	roles.EntityMembersPage
+}
+
+func (res listClientSharesRes) Code() int {
+	return http.StatusOK
+}
+
+func (res listClientSharesRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res listClientSharesRes) Empty() bool {
+	return false
+}
+
+type claimClientRes struct {
+	clients.Client
+}
+
+func (res claimClientRes) Code() int {
+	return http.StatusOK
+}
+
+func (res claimClientRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res claimClientRes) Empty() bool {
+	return false
+}
+
+type addClientRelationRes struct{}
+
+func (res addClientRelationRes) Code() int {
+	return http.StatusCreated
+}
+
+func (res addClientRelationRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res addClientRelationRes) Empty() bool {
+	return true
+}
+
+type removeClientRelationRes struct{}
+
+func (res removeClientRelationRes) Code() int {
+	return http.StatusNoContent
+}
+
+func (res removeClientRelationRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res removeClientRelationRes) Empty() bool {
+	return true
+}
+
+type relatedClientsPageRes struct {
+	clients.RelatedClientsPage
+}
+
+func (res relatedClientsPageRes) Code() int {
+	return http.StatusOK
+}
+
+func (res relatedClientsPageRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res relatedClientsPageRes) Empty() bool {
+	return false
+}
+
+type listClientTagsRes struct {
+	clients.TagsPage
+}
+
+func (res listClientTagsRes) Code() int {
+	return http.StatusOK
+}
+
+func (res listClientTagsRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res listClientTagsRes) Empty() bool {
+	return false
+}
+
+type renameClientTagRes struct {
+	Renamed uint64 `json:"renamed"`
+}
+
+func (res renameClientTagRes) Code() int {
+	return http.StatusOK
+}
+
+func (res renameClientTagRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res renameClientTagRes) Empty() bool {
+	return false
+}
+
 type setParentGroupRes struct{}
 
 func (res setParentGroupRes) Code() int {
@@ -8,6 +8,7 @@ import (
 	"net/http"
 
 	"github.com/absmach/supermq"
+	"github.com/absmach/supermq/apidocs"
 	"github.com/absmach/supermq/clients"
 	smqauthn "github.com/absmach/supermq/pkg/authn"
 	"github.com/go-chi/chi/v5"
@@ -19,6 +20,7 @@ func MakeHandler(tsvc clients.Service, authn smqauthn.Authentication, mux *chi.M
 	mux = clientsHandler(tsvc, authn, mux, logger)
 
 	mux.Get("/health", supermq.Health("clients", instanceID))
+	mux.Get("/api/openapi.json", apidocs.Handler("clients"))
 	mux.Handle("/metrics", promhttp.Handler())
 
 	return mux
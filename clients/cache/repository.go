@@ -0,0 +1,143 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/absmach/supermq/clients"
+	"github.com/redis/go-redis/v9"
+)
+
+// entityPrefix prefixes cached client entities.
+const entityPrefix = "client_entity"
+
+var _ clients.Repository = (*repository)(nil)
+
+type repository struct {
+	clients.Repository
+	client redis.UniversalClient
+	ttl    time.Duration
+}
+
+// NewRepository returns a read-through cache decorator for the client
+// repository. RetrieveByID answers from Redis when possible, since view and
+// update flows call it on nearly every request. Writes going through the
+// decorator invalidate the cached entity; for writes that bypass it, the TTL
+// bounds staleness, so it should stay short.
+func NewRepository(repo clients.Repository, client redis.UniversalClient, ttl time.Duration) clients.Repository {
+	return &repository{
+		Repository: repo,
+		client:     client,
+		ttl:        ttl,
+	}
+}
+
+func entityKey(id string) string {
+	return fmt.Sprintf("%s:%s", entityPrefix, id)
+}
+
+func (r *repository) RetrieveByID(ctx context.Context, id string) (clients.Client, error) {
+	if data, err := r.client.Get(ctx, entityKey(id)).Bytes(); err == nil {
+		var c clients.Client
+		if err := json.Unmarshal(data, &c); err == nil {
+			return c, nil
+		}
+	}
+
+	c, err := r.Repository.RetrieveByID(ctx, id)
+	if err != nil {
+		return clients.Client{}, err
+	}
+
+	// Caching is best effort, so the entity is returned even if it could
+	// not be stored.
+	if data, err := json.Marshal(c); err == nil {
+		r.client.Set(ctx, entityKey(id), data, r.ttl)
+	}
+
+	return c, nil
+}
+
+func (r *repository) Update(ctx context.Context, client clients.Client) (clients.Client, error) {
+	c, err := r.Repository.Update(ctx, client)
+	if err != nil {
+		return clients.Client{}, err
+	}
+	r.invalidate(ctx, client.ID)
+	return c, nil
+}
+
+func (r *repository) UpdateTags(ctx context.Context, client clients.Client) (clients.Client, error) {
+	c, err := r.Repository.UpdateTags(ctx, client)
+	if err != nil {
+		return clients.Client{}, err
+	}
+	r.invalidate(ctx, client.ID)
+	return c, nil
+}
+
+func (r *repository) UpdateIdentity(ctx context.Context, client clients.Client) (clients.Client, error) {
+	c, err := r.Repository.UpdateIdentity(ctx, client)
+	if err != nil {
+		return clients.Client{}, err
+	}
+	r.invalidate(ctx, client.ID)
+	return c, nil
+}
+
+func (r *repository) UpdateSecret(ctx context.Context, client clients.Client) (clients.Client, error) {
+	c, err := r.Repository.UpdateSecret(ctx, client)
+	if err != nil {
+		return clients.Client{}, err
+	}
+	r.invalidate(ctx, client.ID)
+	return c, nil
+}
+
+func (r *repository) ChangeStatus(ctx context.Context, client clients.Client) (clients.Client, error) {
+	c, err := r.Repository.ChangeStatus(ctx, client)
+	if err != nil {
+		return clients.Client{}, err
+	}
+	r.invalidate(ctx, client.ID)
+	return c, nil
+}
+
+func (r *repository) Delete(ctx context.Context, clientIDs ...string) error {
+	if err := r.Repository.Delete(ctx, clientIDs...); err != nil {
+		return err
+	}
+	r.invalidate(ctx, clientIDs...)
+	return nil
+}
+
+func (r *repository) SetParentGroup(ctx context.Context, cli clients.Client) error {
+	if err := r.Repository.SetParentGroup(ctx, cli); err != nil {
+		return err
+	}
+	r.invalidate(ctx, cli.ID)
+	return nil
+}
+
+func (r *repository) RemoveParentGroup(ctx context.Context, cli clients.Client) error {
+	if err := r.Repository.RemoveParentGroup(ctx, cli); err != nil {
+		return err
+	}
+	r.invalidate(ctx, cli.ID)
+	return nil
+}
+
+// invalidate drops cached entities. Invalidation is best effort: an entry
+// that could not be dropped expires after the TTL.
+func (r *repository) invalidate(ctx context.Context, ids ...string) {
+	keys := make([]string, 0, len(ids))
+	for _, id := range ids {
+		keys = append(keys, entityKey(id))
+	}
+	r.client.Del(ctx, keys...)
+}
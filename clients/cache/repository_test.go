@@ -0,0 +1,81 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package cache_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/absmach/supermq/clients"
+	"github.com/absmach/supermq/clients/cache"
+	"github.com/absmach/supermq/clients/mocks"
+	repoerr "github.com/absmach/supermq/pkg/errors/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepositoryRetrieveByID(t *testing.T) {
+	redisClient.FlushAll(context.Background())
+	base := new(mocks.Repository)
+	repo := cache.NewRepository(base, redisClient, time.Minute)
+	ctx := context.Background()
+
+	client := clients.Client{
+		ID:     testID,
+		Name:   "test-client",
+		Status: clients.EnabledStatus,
+	}
+
+	base.On("RetrieveByID", ctx, testID).Return(client, nil).Once()
+
+	c, err := repo.RetrieveByID(ctx, testID)
+	require.Nil(t, err, "expected no error on cache miss")
+	assert.Equal(t, client, c)
+
+	// The second retrieval is answered from the cache, so the base
+	// repository is called only once.
+	c, err = repo.RetrieveByID(ctx, testID)
+	require.Nil(t, err, "expected no error on cache hit")
+	assert.Equal(t, client, c)
+	base.AssertNumberOfCalls(t, "RetrieveByID", 1)
+}
+
+func TestRepositoryInvalidate(t *testing.T) {
+	redisClient.FlushAll(context.Background())
+	base := new(mocks.Repository)
+	repo := cache.NewRepository(base, redisClient, time.Minute)
+	ctx := context.Background()
+
+	client := clients.Client{
+		ID:     testID,
+		Name:   "test-client",
+		Status: clients.EnabledStatus,
+	}
+
+	base.On("RetrieveByID", ctx, testID).Return(client, nil)
+	base.On("Update", ctx, client).Return(client, nil)
+
+	_, err := repo.RetrieveByID(ctx, testID)
+	require.Nil(t, err, "expected no error on cache miss")
+
+	_, err = repo.Update(ctx, client)
+	require.Nil(t, err, "expected no error on update")
+
+	_, err = repo.RetrieveByID(ctx, testID)
+	require.Nil(t, err, "expected no error after invalidation")
+	base.AssertNumberOfCalls(t, "RetrieveByID", 2)
+}
+
+func TestRepositoryRetrieveByIDError(t *testing.T) {
+	redisClient.FlushAll(context.Background())
+	base := new(mocks.Repository)
+	repo := cache.NewRepository(base, redisClient, time.Minute)
+	ctx := context.Background()
+
+	base.On("RetrieveByID", ctx, testID).Return(clients.Client{}, repoerr.ErrNotFound)
+
+	_, err := repo.RetrieveByID(ctx, testID)
+	assert.Equal(t, repoerr.ErrNotFound, err)
+}
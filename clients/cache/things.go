@@ -22,12 +22,12 @@ const (
 var _ clients.Cache = (*clientCache)(nil)
 
 type clientCache struct {
-	client      *redis.Client
+	client      redis.UniversalClient
 	keyDuration time.Duration
 }
 
 // NewCache returns redis client cache implementation.
-func NewCache(client *redis.Client, duration time.Duration) clients.Cache {
+func NewCache(client redis.UniversalClient, duration time.Duration) clients.Cache {
 	return &clientCache{
 		client:      client,
 		keyDuration: duration,
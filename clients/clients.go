@@ -9,6 +9,7 @@ import (
 
 	"github.com/absmach/supermq/pkg/authn"
 	"github.com/absmach/supermq/pkg/connections"
+	"github.com/absmach/supermq/pkg/labels"
 	"github.com/absmach/supermq/pkg/postgres"
 	"github.com/absmach/supermq/pkg/roles"
 )
@@ -20,6 +21,35 @@ type Connection struct {
 	Type      connections.ConnType
 }
 
+// MaxRelationDepth bounds relation graph traversal to keep recursive
+// queries cheap.
+const MaxRelationDepth = uint64(5)
+
+// ClientRelation is a typed, directed link between two clients in the same
+// domain, e.g. a gateway that contains a sensor or an asset that manages a
+// component. The relation type is a free-form label chosen by the domain.
+type ClientRelation struct {
+	DomainID  string    `json:"domain_id"`
+	SubjectID string    `json:"subject_id"`
+	Relation  string    `json:"relation"`
+	ObjectID  string    `json:"object_id"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+}
+
+// RelatedClient is a client reached by traversing client relations, together
+// with the relation that links it and its distance from the starting client.
+type RelatedClient struct {
+	Client
+	Relation string `json:"relation"`
+	Depth    uint64 `json:"depth"`
+}
+
+// RelatedClientsPage contains the clients reachable from a starting client.
+type RelatedClientsPage struct {
+	Total   uint64          `json:"total"`
+	Clients []RelatedClient `json:"clients"`
+}
+
 type ClientRepository struct {
 	DB postgres.Database
 }
@@ -32,6 +62,13 @@ type Repository interface {
 	// RetrieveByID retrieves client by its unique ID.
 	RetrieveByID(ctx context.Context, id string) (Client, error)
 
+	// RetrieveByExternalID retrieves client by its external ID within the domain.
+	RetrieveByExternalID(ctx context.Context, domainID, externalID string) (Client, error)
+
+	// Claim retrieves the client carrying the claim code within the domain
+	// and clears the code in the same statement, so the code is single-use.
+	Claim(ctx context.Context, domainID, claimCode string) (Client, error)
+
 	// RetrieveAll retrieves all clients.
 	RetrieveAll(ctx context.Context, pm Page) (ClientsPage, error)
 
@@ -66,6 +103,15 @@ type Repository interface {
 	// RetrieveBySecret retrieves a client based on the secret (key).
 	RetrieveBySecret(ctx context.Context, key string) (Client, error)
 
+	// RetrieveAllTags retrieves the tags used within the domain together
+	// with their usage counts.
+	RetrieveAllTags(ctx context.Context, domainID string, offset, limit uint64) (TagsPage, error)
+
+	// RenameTag renames oldTag to newTag on every client in the domain,
+	// merging it with newTag where both are present, and returns the
+	// number of affected clients.
+	RenameTag(ctx context.Context, domainID, oldTag, newTag string) (uint64, error)
+
 	RetrieveByIds(ctx context.Context, ids []string) (ClientsPage, error)
 
 	AddConnections(ctx context.Context, conns []Connection) error
@@ -90,6 +136,17 @@ type Repository interface {
 
 	UnsetParentGroupFromClient(ctx context.Context, parentGroupID string) error
 
+	// AddRelation persists a typed relation between two clients in the domain.
+	AddRelation(ctx context.Context, rel ClientRelation) error
+
+	// RemoveRelation removes the relation between two clients.
+	RemoveRelation(ctx context.Context, rel ClientRelation) error
+
+	// RetrieveRelated returns the clients reachable from the given client by
+	// following relations up to depth hops, optionally restricted to a single
+	// relation type.
+	RetrieveRelated(ctx context.Context, domainID, clientID, relation string, depth uint64) ([]RelatedClient, error)
+
 	roles.Repository
 }
 
@@ -105,6 +162,11 @@ type Service interface {
 	// View retrieves client info for a given client ID and an authorized token.
 	View(ctx context.Context, session authn.Session, id string) (Client, error)
 
+	// ViewByExternalID retrieves client info for a given external ID, so
+	// systems that manage their own device identifiers can look clients up
+	// without storing the generated IDs.
+	ViewByExternalID(ctx context.Context, session authn.Session, externalID string) (Client, error)
+
 	// ListClients retrieves clients list for a valid auth token.
 	ListClients(ctx context.Context, session authn.Session, reqUserID string, pm Page) (ClientsPage, error)
 
@@ -123,13 +185,52 @@ type Service interface {
 	// Disable logically disables the client identified with the provided ID
 	Disable(ctx context.Context, session authn.Session, id string) (Client, error)
 
-	// Delete deletes client with given ID.
+	// Delete moves the client with given ID to the trash. Trashed clients
+	// are purged permanently once the retention period expires.
 	Delete(ctx context.Context, session authn.Session, id string) error
 
+	// ListTrash retrieves clients in the trash awaiting permanent deletion.
+	ListTrash(ctx context.Context, session authn.Session, pm Page) (ClientsPage, error)
+
+	// Restore moves the client with given ID out of the trash back to the
+	// enabled state.
+	Restore(ctx context.Context, session authn.Session, id string) (Client, error)
+
 	SetParentGroup(ctx context.Context, session authn.Session, parentGroupID string, id string) error
 
 	RemoveParentGroup(ctx context.Context, session authn.Session, id string) error
 
+	// Share grants the client role named by relation to the given users.
+	Share(ctx context.Context, session authn.Session, id, relation string, userIDs ...string) error
+
+	// ListShares lists users holding a role on the client together with the
+	// role they hold.
+	ListShares(ctx context.Context, session authn.Session, id string, limit, offset uint64) (roles.EntityMembersPage, error)
+
+	// Claim takes ownership of the pre-registered client carrying the claim
+	// code by granting the session user the client admin role. The code is
+	// single-use and is invalidated as part of the claim.
+	Claim(ctx context.Context, session authn.Session, claimCode string) (Client, error)
+
+	// AddRelation links two clients in the session domain with a typed
+	// relation, e.g. gateway contains sensor.
+	AddRelation(ctx context.Context, session authn.Session, rel ClientRelation) error
+
+	// RemoveRelation removes the typed relation between two clients.
+	RemoveRelation(ctx context.Context, session authn.Session, rel ClientRelation) error
+
+	// ListRelated traverses the relation graph starting from the given client
+	// and returns the clients reachable within the requested depth.
+	ListRelated(ctx context.Context, session authn.Session, id, relation string, depth uint64) (RelatedClientsPage, error)
+
+	// ListTags lists the tags used within the domain with usage counts.
+	ListTags(ctx context.Context, session authn.Session, offset, limit uint64) (TagsPage, error)
+
+	// RenameTag renames a tag on every client in the domain, merging it
+	// into the target tag where both are present, and returns the number
+	// of affected clients.
+	RenameTag(ctx context.Context, session authn.Session, oldTag, newTag string) (uint64, error)
+
 	roles.RoleManager
 }
 
@@ -150,19 +251,23 @@ type Cache interface {
 // Client Struct represents a client.
 
 type Client struct {
-	ID          string      `json:"id"`
-	Name        string      `json:"name,omitempty"`
-	Tags        []string    `json:"tags,omitempty"`
-	Domain      string      `json:"domain_id,omitempty"`
-	ParentGroup string      `json:"parent_group_id,omitempty"`
-	Credentials Credentials `json:"credentials,omitempty"`
-	Metadata    Metadata    `json:"metadata,omitempty"`
-	CreatedAt   time.Time   `json:"created_at,omitempty"`
-	UpdatedAt   time.Time   `json:"updated_at,omitempty"`
-	UpdatedBy   string      `json:"updated_by,omitempty"`
-	Status      Status      `json:"status,omitempty"` // 1 for enabled, 0 for disabled
-	Permissions []string    `json:"permissions,omitempty"`
-	Identity    string      `json:"identity,omitempty"`
+	ID          string        `json:"id"`
+	Name        string        `json:"name,omitempty"`
+	Tags        []string      `json:"tags,omitempty"`
+	Domain      string        `json:"domain_id,omitempty"`
+	ParentGroup string        `json:"parent_group_id,omitempty"`
+	ExternalID  string        `json:"external_id,omitempty"`
+	ClaimCode   string        `json:"claim_code,omitempty"`
+	Credentials Credentials   `json:"credentials,omitempty"`
+	Metadata    Metadata      `json:"metadata,omitempty"`
+	Labels      labels.Labels `json:"labels,omitempty"`
+	CreatedAt   time.Time     `json:"created_at,omitempty"`
+	UpdatedAt   time.Time     `json:"updated_at,omitempty"`
+	UpdatedBy   string        `json:"updated_by,omitempty"`
+	Status      Status        `json:"status,omitempty"` // 1 for enabled, 0 for disabled
+	Version     uint64        `json:"version,omitempty"`
+	Permissions []string      `json:"permissions,omitempty"`
+	Identity    string        `json:"identity,omitempty"`
 }
 
 // ClientsPage contains page related metadata as well as list.
@@ -197,11 +302,28 @@ type Page struct {
 	IDs        []string `json:"ids,omitempty"`
 	Identity   string   `json:"identity,omitempty"`
 	ListPerms  bool     `json:"-"`
+	// LabelSelector filters the listing by entity labels.
+	LabelSelector labels.Selector `json:"-"`
 }
 
 // Metadata represents arbitrary JSON.
 type Metadata map[string]interface{}
 
+// Tag represents a tag in use within a domain together with the number of
+// clients carrying it.
+type Tag struct {
+	Name  string `json:"name"`
+	Count uint64 `json:"count"`
+}
+
+// TagsPage contains a page of the domain tag registry.
+type TagsPage struct {
+	Total  uint64 `json:"total"`
+	Offset uint64 `json:"offset"`
+	Limit  uint64 `json:"limit"`
+	Tags   []Tag  `json:"tags"`
+}
+
 // Credentials represent client credentials: its
 // "identity" which can be a username, email, generated name;
 // and "secret" which can be a password or access token.
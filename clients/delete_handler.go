@@ -0,0 +1,109 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// The DeleteHandler is a cron job that runs periodically to purge clients that
+// have been moved to the trash for longer than the retention period.
+// The handler runs in a separate goroutine and permanently deletes such clients
+// from the database together with their policies from the auth service.
+
+package clients
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/absmach/supermq/pkg/policies"
+)
+
+const defTrashLimit = uint64(100)
+
+type handler struct {
+	clients       Repository
+	policies      policies.Service
+	checkInterval time.Duration
+	deleteAfter   time.Duration
+	logger        *slog.Logger
+}
+
+func NewDeleteHandler(ctx context.Context, clients Repository, policyService policies.Service, defCheckInterval, deleteAfter time.Duration, logger *slog.Logger) {
+	handler := &handler{
+		clients:       clients,
+		policies:      policyService,
+		checkInterval: defCheckInterval,
+		deleteAfter:   deleteAfter,
+		logger:        logger,
+	}
+
+	go func() {
+		ticker := time.NewTicker(handler.checkInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				handler.handle(ctx)
+			}
+		}
+	}()
+}
+
+func (h *handler) handle(ctx context.Context) {
+	pm := Page{Limit: defTrashLimit, Offset: 0, Status: DeletedStatus}
+
+	for {
+		page, err := h.clients.RetrieveAll(ctx, pm)
+		if err != nil {
+			h.logger.Error("failed to retrieve trashed clients", slog.Any("error", err))
+			return
+		}
+		if len(page.Clients) == 0 {
+			return
+		}
+
+		for _, c := range page.Clients {
+			if time.Since(c.UpdatedAt) < h.deleteAfter {
+				pm.Offset++
+				continue
+			}
+
+			filterDeletePolicies := []policies.Policy{
+				{
+					SubjectType: policies.ClientType,
+					Subject:     c.ID,
+				},
+				{
+					ObjectType: policies.ClientType,
+					Object:     c.ID,
+				},
+			}
+			if err := h.deletePolicies(ctx, filterDeletePolicies); err != nil {
+				h.logger.Error("failed to delete client policies", slog.Any("error", err))
+				pm.Offset++
+				continue
+			}
+
+			if err := h.clients.Delete(ctx, c.ID); err != nil {
+				h.logger.Error("failed to delete client", slog.Any("error", err))
+				pm.Offset++
+				continue
+			}
+
+			h.logger.Info("client permanently deleted", slog.Group("client",
+				slog.String("id", c.ID),
+				slog.String("name", c.Name),
+			))
+		}
+	}
+}
+
+func (h *handler) deletePolicies(ctx context.Context, prs []policies.Policy) error {
+	for _, pr := range prs {
+		if err := h.policies.DeletePolicyFilter(ctx, pr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
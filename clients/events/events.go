@@ -16,6 +16,7 @@ const (
 	clientCreate       = clientPrefix + "create"
 	clientUpdate       = clientPrefix + "update"
 	clientChangeStatus = clientPrefix + "change_status"
+	clientDisable      = clientPrefix + "disable"
 	clientRemove       = clientPrefix + "remove"
 	clientView         = clientPrefix + "view"
 	clientViewPerms    = clientPrefix + "view_perms"
@@ -25,12 +26,18 @@ const (
 	clientAuthorize    = clientPrefix + "authorize"
 	clientSetParent    = clientPrefix + "set_parent"
 	clientRemoveParent = clientPrefix + "remove_parent"
+	clientRenameTag    = clientPrefix + "rename_tag"
+	clientRestore      = clientPrefix + "restore"
+	clientClaim        = clientPrefix + "claim"
+	clientAddRelation  = clientPrefix + "add_relation"
+	clientRemoveRel    = clientPrefix + "remove_relation"
 )
 
 var (
 	_ events.Event = (*createClientEvent)(nil)
 	_ events.Event = (*updateClientEvent)(nil)
 	_ events.Event = (*changeStatusClientEvent)(nil)
+	_ events.Event = (*disableClientEvent)(nil)
 	_ events.Event = (*viewClientEvent)(nil)
 	_ events.Event = (*viewClientPermsEvent)(nil)
 	_ events.Event = (*listClientEvent)(nil)
@@ -38,6 +45,10 @@ var (
 	_ events.Event = (*identifyClientEvent)(nil)
 	_ events.Event = (*authorizeClientEvent)(nil)
 	_ events.Event = (*shareClientEvent)(nil)
+	_ events.Event = (*claimClientEvent)(nil)
+	_ events.Event = (*clientRelationEvent)(nil)
+	_ events.Event = (*renameClientTagEvent)(nil)
+	_ events.Event = (*restoreClientEvent)(nil)
 	_ events.Event = (*removeClientEvent)(nil)
 )
 
@@ -70,6 +81,9 @@ func (cce createClientEvent) Encode() (map[string]interface{}, error) {
 	if cce.Credentials.Identity != "" {
 		val["identity"] = cce.Credentials.Identity
 	}
+	if cce.ExternalID != "" {
+		val["external_id"] = cce.ExternalID
+	}
 
 	return val, nil
 }
@@ -156,6 +170,9 @@ func (vce viewClientEvent) Encode() (map[string]interface{}, error) {
 	if vce.Credentials.Identity != "" {
 		val["identity"] = vce.Credentials.Identity
 	}
+	if vce.ExternalID != "" {
+		val["external_id"] = vce.ExternalID
+	}
 	if vce.Metadata != nil {
 		val["metadata"] = vce.Metadata
 	}
@@ -313,6 +330,36 @@ func (ice authorizeClientEvent) Encode() (map[string]interface{}, error) {
 	return val, nil
 }
 
+type renameClientTagEvent struct {
+	oldTag  string
+	newTag  string
+	renamed uint64
+}
+
+func (rte renameClientTagEvent) Encode() (map[string]interface{}, error) {
+	return map[string]interface{}{
+		"operation": clientRenameTag,
+		"old_tag":   rte.oldTag,
+		"new_tag":   rte.newTag,
+		"renamed":   rte.renamed,
+	}, nil
+}
+
+type restoreClientEvent struct {
+	id        string
+	updatedAt time.Time
+	updatedBy string
+}
+
+func (rce restoreClientEvent) Encode() (map[string]interface{}, error) {
+	return map[string]interface{}{
+		"operation":  clientRestore,
+		"id":         rce.id,
+		"updated_at": rce.updatedAt,
+		"updated_by": rce.updatedBy,
+	}, nil
+}
+
 type shareClientEvent struct {
 	action   string
 	id       string
@@ -329,6 +376,44 @@ func (sce shareClientEvent) Encode() (map[string]interface{}, error) {
 	}, nil
 }
 
+type claimClientEvent struct {
+	id     string
+	userID string
+}
+
+func (cce claimClientEvent) Encode() (map[string]interface{}, error) {
+	return map[string]interface{}{
+		"operation": clientClaim,
+		"id":        cce.id,
+		"user_id":   cce.userID,
+	}, nil
+}
+
+type clientRelationEvent struct {
+	operation string
+	rel       clients.ClientRelation
+}
+
+func (cre clientRelationEvent) Encode() (map[string]interface{}, error) {
+	return map[string]interface{}{
+		"operation":  cre.operation,
+		"subject_id": cre.rel.SubjectID,
+		"relation":   cre.rel.Relation,
+		"object_id":  cre.rel.ObjectID,
+	}, nil
+}
+
+type disableClientEvent struct {
+	id string
+}
+
+func (dce disableClientEvent) Encode() (map[string]interface{}, error) {
+	return map[string]interface{}{
+		"operation": clientDisable,
+		"id":        dce.id,
+	}, nil
+}
+
 type removeClientEvent struct {
 	id string
 }
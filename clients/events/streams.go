@@ -114,6 +114,22 @@ func (es *eventStore) View(ctx context.Context, session authn.Session, id string
 	return cli, nil
 }
 
+func (es *eventStore) ViewByExternalID(ctx context.Context, session authn.Session, externalID string) (clients.Client, error) {
+	cli, err := es.svc.ViewByExternalID(ctx, session, externalID)
+	if err != nil {
+		return cli, err
+	}
+
+	event := viewClientEvent{
+		cli,
+	}
+	if err := es.Publish(ctx, event); err != nil {
+		return cli, err
+	}
+
+	return cli, nil
+}
+
 func (es *eventStore) ListClients(ctx context.Context, session authn.Session, reqUserID string, pm clients.Page) (clients.ClientsPage, error) {
 	cp, err := es.svc.ListClients(ctx, session, reqUserID, pm)
 	if err != nil {
@@ -145,7 +161,17 @@ func (es *eventStore) Disable(ctx context.Context, session authn.Session, id str
 		return cli, err
 	}
 
-	return es.changeStatus(ctx, cli)
+	if cli, err = es.changeStatus(ctx, cli); err != nil {
+		return cli, err
+	}
+
+	// A dedicated disable event is issued alongside the status change so
+	// that adapters can terminate live sessions of the disabled client.
+	if err := es.Publish(ctx, disableClientEvent{id: cli.ID}); err != nil {
+		return cli, err
+	}
+
+	return cli, nil
 }
 
 func (es *eventStore) changeStatus(ctx context.Context, cli clients.Client) (clients.Client, error) {
@@ -190,6 +216,113 @@ func (es *eventStore) SetParentGroup(ctx context.Context, session authn.Session,
 	return nil
 }
 
+func (es *eventStore) Share(ctx context.Context, session authn.Session, id, relation string, userIDs ...string) error {
+	if err := es.svc.Share(ctx, session, id, relation, userIDs...); err != nil {
+		return err
+	}
+
+	event := shareClientEvent{action: "share", id: id, relation: relation, userIDs: userIDs}
+
+	if err := es.Publish(ctx, event); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (es *eventStore) Claim(ctx context.Context, session authn.Session, claimCode string) (clients.Client, error) {
+	cli, err := es.svc.Claim(ctx, session, claimCode)
+	if err != nil {
+		return cli, err
+	}
+
+	event := claimClientEvent{id: cli.ID, userID: session.UserID}
+
+	if err := es.Publish(ctx, event); err != nil {
+		return cli, err
+	}
+
+	return cli, nil
+}
+
+func (es *eventStore) AddRelation(ctx context.Context, session authn.Session, rel clients.ClientRelation) error {
+	if err := es.svc.AddRelation(ctx, session, rel); err != nil {
+		return err
+	}
+
+	event := clientRelationEvent{operation: clientAddRelation, rel: rel}
+
+	if err := es.Publish(ctx, event); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (es *eventStore) RemoveRelation(ctx context.Context, session authn.Session, rel clients.ClientRelation) error {
+	if err := es.svc.RemoveRelation(ctx, session, rel); err != nil {
+		return err
+	}
+
+	event := clientRelationEvent{operation: clientRemoveRel, rel: rel}
+
+	if err := es.Publish(ctx, event); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (es *eventStore) ListRelated(ctx context.Context, session authn.Session, id, relation string, depth uint64) (clients.RelatedClientsPage, error) {
+	return es.svc.ListRelated(ctx, session, id, relation, depth)
+}
+
+func (es *eventStore) ListTrash(ctx context.Context, session authn.Session, pm clients.Page) (clients.ClientsPage, error) {
+	return es.svc.ListTrash(ctx, session, pm)
+}
+
+func (es *eventStore) Restore(ctx context.Context, session authn.Session, id string) (clients.Client, error) {
+	cli, err := es.svc.Restore(ctx, session, id)
+	if err != nil {
+		return cli, err
+	}
+
+	event := restoreClientEvent{
+		id:        cli.ID,
+		updatedAt: cli.UpdatedAt,
+		updatedBy: cli.UpdatedBy,
+	}
+
+	if err := es.Publish(ctx, event); err != nil {
+		return cli, err
+	}
+
+	return cli, nil
+}
+
+func (es *eventStore) ListTags(ctx context.Context, session authn.Session, offset, limit uint64) (clients.TagsPage, error) {
+	return es.svc.ListTags(ctx, session, offset, limit)
+}
+
+func (es *eventStore) RenameTag(ctx context.Context, session authn.Session, oldTag, newTag string) (uint64, error) {
+	renamed, err := es.svc.RenameTag(ctx, session, oldTag, newTag)
+	if err != nil {
+		return renamed, err
+	}
+
+	event := renameClientTagEvent{oldTag: oldTag, newTag: newTag, renamed: renamed}
+
+	if err := es.Publish(ctx, event); err != nil {
+		return renamed, err
+	}
+
+	return renamed, nil
+}
+
+func (es *eventStore) ListShares(ctx context.Context, session authn.Session, id string, limit, offset uint64) (roles.EntityMembersPage, error) {
+	return es.svc.ListShares(ctx, session, id, limit, offset)
+}
+
 func (es *eventStore) RemoveParentGroup(ctx context.Context, session authn.Session, id string) (err error) {
 	if err := es.svc.RemoveParentGroup(ctx, session, id); err != nil {
 		return err
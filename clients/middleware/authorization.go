@@ -28,6 +28,16 @@ var (
 	errDelete                  = errors.New("not authorized to delete thing")
 	errSetParentGroup          = errors.New("not authorized to set parent group to thing")
 	errRemoveParentGroup       = errors.New("not authorized to remove parent group from thing")
+	errShare                   = errors.New("not authorized to share thing")
+	errListShares              = errors.New("not authorized to list thing shares")
+	errClaim                   = errors.New("not authorized to claim thing in domain")
+	errAddRelation             = errors.New("not authorized to relate things")
+	errRemoveRelation          = errors.New("not authorized to remove thing relation")
+	errListRelated             = errors.New("not authorized to list related things")
+	errListTags                = errors.New("not authorized to list thing tags in domain")
+	errListTrash               = errors.New("not authorized to list things in trash")
+	errRestore                 = errors.New("not authorized to restore thing")
+	errRenameTag               = errors.New("not authorized to rename thing tag in domain")
 	errDomainCreateClients     = errors.New("not authorized to create thing in domain")
 	errGroupSetChildClients    = errors.New("not authorized to set child thing for group")
 	errGroupRemoveChildClients = errors.New("not authorized to remove child thing for group")
@@ -99,6 +109,10 @@ func (am *authorizationMiddleware) CreateClients(ctx context.Context, session au
 		return []clients.Client{}, []roles.RoleProvision{}, errors.Wrap(err, errDomainCreateClients)
 	}
 
+	if err := am.checkSuperAdmin(ctx, session.UserID); err == nil {
+		session.SuperAdmin = true
+	}
+
 	return am.svc.CreateClients(ctx, session, client...)
 }
 
@@ -129,6 +143,38 @@ func (am *authorizationMiddleware) View(ctx context.Context, session authn.Sessi
 	return am.svc.View(ctx, session, id)
 }
 
+func (am *authorizationMiddleware) ViewByExternalID(ctx context.Context, session authn.Session, externalID string) (clients.Client, error) {
+	cli, err := am.repo.RetrieveByExternalID(ctx, session.DomainID, externalID)
+	if err != nil {
+		return clients.Client{}, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+
+	if session.Type == authn.PersonalAccessToken {
+		if err := am.authz.AuthorizePAT(ctx, smqauthz.PatReq{
+			UserID:                   session.UserID,
+			PatID:                    session.ID,
+			PlatformEntityType:       auth.PlatformDomainsScope,
+			OptionalDomainID:         session.DomainID,
+			OptionalDomainEntityType: auth.DomainClientsScope,
+			Operation:                auth.ReadOp,
+			EntityIDs:                []string{cli.ID},
+		}); err != nil {
+			return clients.Client{}, errors.Wrap(svcerr.ErrUnauthorizedPAT, err)
+		}
+	}
+
+	if err := am.authorize(ctx, clients.OpViewClient, smqauthz.PolicyReq{
+		Domain:      session.DomainID,
+		SubjectType: policies.UserType,
+		Subject:     session.DomainUserID,
+		ObjectType:  policies.ClientType,
+		Object:      cli.ID,
+	}); err != nil {
+		return clients.Client{}, errors.Wrap(err, errView)
+	}
+	return am.svc.ViewByExternalID(ctx, session, externalID)
+}
+
 func (am *authorizationMiddleware) ListClients(ctx context.Context, session authn.Session, reqUserID string, pm clients.Page) (clients.ClientsPage, error) {
 	if session.Type == authn.PersonalAccessToken {
 		if err := am.authz.AuthorizePAT(ctx, smqauthz.PatReq{
@@ -204,6 +250,10 @@ func (am *authorizationMiddleware) UpdateTags(ctx context.Context, session authn
 		return clients.Client{}, errors.Wrap(err, errUpdateTags)
 	}
 
+	if err := am.checkSuperAdmin(ctx, session.UserID); err == nil {
+		session.SuperAdmin = true
+	}
+
 	return am.svc.UpdateTags(ctx, session, client)
 }
 
@@ -316,6 +366,190 @@ func (am *authorizationMiddleware) Delete(ctx context.Context, session authn.Ses
 	return am.svc.Delete(ctx, session, id)
 }
 
+func (am *authorizationMiddleware) ListTrash(ctx context.Context, session authn.Session, pm clients.Page) (clients.ClientsPage, error) {
+	if err := am.extAuthorize(ctx, clients.DomainOpListTrash, smqauthz.PolicyReq{
+		Domain:      session.DomainID,
+		SubjectType: policies.UserType,
+		Subject:     session.DomainUserID,
+		ObjectType:  policies.DomainType,
+		Object:      session.DomainID,
+	}); err != nil {
+		return clients.ClientsPage{}, errors.Wrap(err, errListTrash)
+	}
+	return am.svc.ListTrash(ctx, session, pm)
+}
+
+func (am *authorizationMiddleware) Restore(ctx context.Context, session authn.Session, id string) (clients.Client, error) {
+	if err := am.extAuthorize(ctx, clients.DomainOpRestoreClient, smqauthz.PolicyReq{
+		Domain:      session.DomainID,
+		SubjectType: policies.UserType,
+		Subject:     session.DomainUserID,
+		ObjectType:  policies.DomainType,
+		Object:      session.DomainID,
+	}); err != nil {
+		return clients.Client{}, errors.Wrap(err, errRestore)
+	}
+	return am.svc.Restore(ctx, session, id)
+}
+
+func (am *authorizationMiddleware) ListTags(ctx context.Context, session authn.Session, offset, limit uint64) (clients.TagsPage, error) {
+	if err := am.extAuthorize(ctx, clients.DomainOpListTags, smqauthz.PolicyReq{
+		Domain:      session.DomainID,
+		SubjectType: policies.UserType,
+		Subject:     session.DomainUserID,
+		ObjectType:  policies.DomainType,
+		Object:      session.DomainID,
+	}); err != nil {
+		return clients.TagsPage{}, errors.Wrap(err, errListTags)
+	}
+	return am.svc.ListTags(ctx, session, offset, limit)
+}
+
+func (am *authorizationMiddleware) RenameTag(ctx context.Context, session authn.Session, oldTag, newTag string) (uint64, error) {
+	if err := am.extAuthorize(ctx, clients.DomainOpRenameTag, smqauthz.PolicyReq{
+		Domain:      session.DomainID,
+		SubjectType: policies.UserType,
+		Subject:     session.DomainUserID,
+		ObjectType:  policies.DomainType,
+		Object:      session.DomainID,
+	}); err != nil {
+		return 0, errors.Wrap(err, errRenameTag)
+	}
+
+	if err := am.checkSuperAdmin(ctx, session.UserID); err == nil {
+		session.SuperAdmin = true
+	}
+
+	return am.svc.RenameTag(ctx, session, oldTag, newTag)
+}
+
+func (am *authorizationMiddleware) Share(ctx context.Context, session authn.Session, id, relation string, userIDs ...string) error {
+	if err := am.authorize(ctx, clients.OpShareClient, smqauthz.PolicyReq{
+		Domain:      session.DomainID,
+		SubjectType: policies.UserType,
+		Subject:     session.DomainUserID,
+		ObjectType:  policies.ClientType,
+		Object:      id,
+	}); err != nil {
+		return errors.Wrap(err, errShare)
+	}
+	return am.svc.Share(ctx, session, id, relation, userIDs...)
+}
+
+func (am *authorizationMiddleware) ListShares(ctx context.Context, session authn.Session, id string, limit, offset uint64) (roles.EntityMembersPage, error) {
+	if err := am.authorize(ctx, clients.OpListShares, smqauthz.PolicyReq{
+		Domain:      session.DomainID,
+		SubjectType: policies.UserType,
+		Subject:     session.DomainUserID,
+		ObjectType:  policies.ClientType,
+		Object:      id,
+	}); err != nil {
+		return roles.EntityMembersPage{}, errors.Wrap(err, errListShares)
+	}
+	return am.svc.ListShares(ctx, session, id, limit, offset)
+}
+
+func (am *authorizationMiddleware) Claim(ctx context.Context, session authn.Session, claimCode string) (clients.Client, error) {
+	if session.Type == authn.PersonalAccessToken {
+		if err := am.authz.AuthorizePAT(ctx, smqauthz.PatReq{
+			UserID:                   session.UserID,
+			PatID:                    session.ID,
+			PlatformEntityType:       auth.PlatformDomainsScope,
+			OptionalDomainID:         session.DomainID,
+			OptionalDomainEntityType: auth.DomainClientsScope,
+			Operation:                auth.CreateOp,
+			EntityIDs:                auth.AnyIDs{}.Values(),
+		}); err != nil {
+			return clients.Client{}, errors.Wrap(svcerr.ErrUnauthorizedPAT, err)
+		}
+	}
+
+	if err := am.extAuthorize(ctx, clients.DomainOpClaimClient, smqauthz.PolicyReq{
+		Domain:      session.DomainID,
+		SubjectType: policies.UserType,
+		Subject:     session.DomainUserID,
+		ObjectType:  policies.DomainType,
+		Object:      session.DomainID,
+	}); err != nil {
+		return clients.Client{}, errors.Wrap(err, errClaim)
+	}
+	return am.svc.Claim(ctx, session, claimCode)
+}
+
+func (am *authorizationMiddleware) AddRelation(ctx context.Context, session authn.Session, rel clients.ClientRelation) error {
+	if err := am.authorize(ctx, clients.OpAddClientRelation, smqauthz.PolicyReq{
+		Domain:      session.DomainID,
+		SubjectType: policies.UserType,
+		Subject:     session.DomainUserID,
+		ObjectType:  policies.ClientType,
+		Object:      rel.SubjectID,
+	}); err != nil {
+		return errors.Wrap(err, errAddRelation)
+	}
+
+	// Linking requires visibility of the client on the other end of the
+	// relation as well.
+	if err := am.authorize(ctx, clients.OpViewClient, smqauthz.PolicyReq{
+		Domain:      session.DomainID,
+		SubjectType: policies.UserType,
+		Subject:     session.DomainUserID,
+		ObjectType:  policies.ClientType,
+		Object:      rel.ObjectID,
+	}); err != nil {
+		return errors.Wrap(err, errAddRelation)
+	}
+	return am.svc.AddRelation(ctx, session, rel)
+}
+
+func (am *authorizationMiddleware) RemoveRelation(ctx context.Context, session authn.Session, rel clients.ClientRelation) error {
+	if err := am.authorize(ctx, clients.OpRemoveClientRelation, smqauthz.PolicyReq{
+		Domain:      session.DomainID,
+		SubjectType: policies.UserType,
+		Subject:     session.DomainUserID,
+		ObjectType:  policies.ClientType,
+		Object:      rel.SubjectID,
+	}); err != nil {
+		return errors.Wrap(err, errRemoveRelation)
+	}
+	return am.svc.RemoveRelation(ctx, session, rel)
+}
+
+func (am *authorizationMiddleware) ListRelated(ctx context.Context, session authn.Session, id, relation string, depth uint64) (clients.RelatedClientsPage, error) {
+	if err := am.authorize(ctx, clients.OpListRelatedClients, smqauthz.PolicyReq{
+		Domain:      session.DomainID,
+		SubjectType: policies.UserType,
+		Subject:     session.DomainUserID,
+		ObjectType:  policies.ClientType,
+		Object:      id,
+	}); err != nil {
+		return clients.RelatedClientsPage{}, errors.Wrap(err, errListRelated)
+	}
+
+	page, err := am.svc.ListRelated(ctx, session, id, relation, depth)
+	if err != nil {
+		return clients.RelatedClientsPage{}, err
+	}
+
+	// The traversal may reach clients the user holds no role on, so keep
+	// only the ones the user is allowed to view.
+	visible := []clients.RelatedClient{}
+	for _, related := range page.Clients {
+		if err := am.authorize(ctx, clients.OpViewClient, smqauthz.PolicyReq{
+			Domain:      session.DomainID,
+			SubjectType: policies.UserType,
+			Subject:     session.DomainUserID,
+			ObjectType:  policies.ClientType,
+			Object:      related.ID,
+		}); err != nil {
+			continue
+		}
+		visible = append(visible, related)
+	}
+	page.Clients = visible
+	page.Total = uint64(len(visible))
+	return page, nil
+}
+
 func (am *authorizationMiddleware) SetParentGroup(ctx context.Context, session authn.Session, parentGroupID string, id string) error {
 	if session.Type == authn.PersonalAccessToken {
 		if err := am.authz.AuthorizePAT(ctx, smqauthz.PatReq{
@@ -38,10 +38,10 @@ func (lm *loggingMiddleware) CreateClients(ctx context.Context, session authn.Se
 		}
 		if err != nil {
 			args = append(args, slog.String("error", err.Error()))
-			lm.logger.Warn(fmt.Sprintf("Create %d clients failed", len(clients)), args...)
+			lm.logger.WarnContext(ctx, fmt.Sprintf("Create %d clients failed", len(clients)), args...)
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("Create %d clients completed successfully", len(clients)), args...)
+		lm.logger.InfoContext(ctx, fmt.Sprintf("Create %d clients completed successfully", len(clients)), args...)
 	}(time.Now())
 	return lm.svc.CreateClients(ctx, session, clients...)
 }
@@ -57,14 +57,33 @@ func (lm *loggingMiddleware) View(ctx context.Context, session authn.Session, id
 		}
 		if err != nil {
 			args = append(args, slog.String("error", err.Error()))
-			lm.logger.Warn("View client failed", args...)
+			lm.logger.WarnContext(ctx, "View client failed", args...)
 			return
 		}
-		lm.logger.Info("View client completed successfully", args...)
+		lm.logger.InfoContext(ctx, "View client completed successfully", args...)
 	}(time.Now())
 	return lm.svc.View(ctx, session, id)
 }
 
+func (lm *loggingMiddleware) ViewByExternalID(ctx context.Context, session authn.Session, externalID string) (c clients.Client, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.Group("client",
+				slog.String("id", c.ID),
+				slog.String("external_id", externalID),
+			),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.WarnContext(ctx, "View client by external ID failed", args...)
+			return
+		}
+		lm.logger.InfoContext(ctx, "View client by external ID completed successfully", args...)
+	}(time.Now())
+	return lm.svc.ViewByExternalID(ctx, session, externalID)
+}
+
 func (lm *loggingMiddleware) ListClients(ctx context.Context, session authn.Session, reqUserID string, pm clients.Page) (cp clients.ClientsPage, err error) {
 	defer func(begin time.Time) {
 		args := []any{
@@ -78,10 +97,10 @@ func (lm *loggingMiddleware) ListClients(ctx context.Context, session authn.Sess
 		}
 		if err != nil {
 			args = append(args, slog.String("error", err.Error()))
-			lm.logger.Warn("List clients failed", args...)
+			lm.logger.WarnContext(ctx, "List clients failed", args...)
 			return
 		}
-		lm.logger.Info("List clients completed successfully", args...)
+		lm.logger.InfoContext(ctx, "List clients completed successfully", args...)
 	}(time.Now())
 	return lm.svc.ListClients(ctx, session, reqUserID, pm)
 }
@@ -98,10 +117,10 @@ func (lm *loggingMiddleware) Update(ctx context.Context, session authn.Session,
 		}
 		if err != nil {
 			args = append(args, slog.String("error", err.Error()))
-			lm.logger.Warn("Update client failed", args...)
+			lm.logger.WarnContext(ctx, "Update client failed", args...)
 			return
 		}
-		lm.logger.Info("Update client completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Update client completed successfully", args...)
 	}(time.Now())
 	return lm.svc.Update(ctx, session, client)
 }
@@ -118,10 +137,10 @@ func (lm *loggingMiddleware) UpdateTags(ctx context.Context, session authn.Sessi
 		}
 		if err != nil {
 			args := append(args, slog.String("error", err.Error()))
-			lm.logger.Warn("Update client tags failed", args...)
+			lm.logger.WarnContext(ctx, "Update client tags failed", args...)
 			return
 		}
-		lm.logger.Info("Update client tags completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Update client tags completed successfully", args...)
 	}(time.Now())
 	return lm.svc.UpdateTags(ctx, session, client)
 }
@@ -137,10 +156,10 @@ func (lm *loggingMiddleware) UpdateSecret(ctx context.Context, session authn.Ses
 		}
 		if err != nil {
 			args = append(args, slog.String("error", err.Error()))
-			lm.logger.Warn("Update client secret failed", args...)
+			lm.logger.WarnContext(ctx, "Update client secret failed", args...)
 			return
 		}
-		lm.logger.Info("Update client secret completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Update client secret completed successfully", args...)
 	}(time.Now())
 	return lm.svc.UpdateSecret(ctx, session, oldSecret, newSecret)
 }
@@ -156,10 +175,10 @@ func (lm *loggingMiddleware) Enable(ctx context.Context, session authn.Session,
 		}
 		if err != nil {
 			args = append(args, slog.String("error", err.Error()))
-			lm.logger.Warn("Enable client failed", args...)
+			lm.logger.WarnContext(ctx, "Enable client failed", args...)
 			return
 		}
-		lm.logger.Info("Enable client completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Enable client completed successfully", args...)
 	}(time.Now())
 	return lm.svc.Enable(ctx, session, id)
 }
@@ -175,10 +194,10 @@ func (lm *loggingMiddleware) Disable(ctx context.Context, session authn.Session,
 		}
 		if err != nil {
 			args = append(args, slog.String("error", err.Error()))
-			lm.logger.Warn("Disable client failed", args...)
+			lm.logger.WarnContext(ctx, "Disable client failed", args...)
 			return
 		}
-		lm.logger.Info("Disable client completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Disable client completed successfully", args...)
 	}(time.Now())
 	return lm.svc.Disable(ctx, session, id)
 }
@@ -191,14 +210,198 @@ func (lm *loggingMiddleware) Delete(ctx context.Context, session authn.Session,
 		}
 		if err != nil {
 			args = append(args, slog.String("error", err.Error()))
-			lm.logger.Warn("Delete client failed", args...)
+			lm.logger.WarnContext(ctx, "Delete client failed", args...)
 			return
 		}
-		lm.logger.Info("Delete client completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Delete client completed successfully", args...)
 	}(time.Now())
 	return lm.svc.Delete(ctx, session, id)
 }
 
+func (lm *loggingMiddleware) ListTrash(ctx context.Context, session authn.Session, pm clients.Page) (cp clients.ClientsPage, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.Group("page",
+				slog.Uint64("offset", pm.Offset),
+				slog.Uint64("limit", pm.Limit),
+				slog.Uint64("total", cp.Total),
+			),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.WarnContext(ctx, "List clients in trash failed", args...)
+			return
+		}
+		lm.logger.InfoContext(ctx, "List clients in trash completed successfully", args...)
+	}(time.Now())
+	return lm.svc.ListTrash(ctx, session, pm)
+}
+
+func (lm *loggingMiddleware) Restore(ctx context.Context, session authn.Session, id string) (c clients.Client, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.Group("client",
+				slog.String("id", id),
+				slog.String("name", c.Name),
+			),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.WarnContext(ctx, "Restore client failed", args...)
+			return
+		}
+		lm.logger.InfoContext(ctx, "Restore client completed successfully", args...)
+	}(time.Now())
+	return lm.svc.Restore(ctx, session, id)
+}
+
+func (lm *loggingMiddleware) ListTags(ctx context.Context, session authn.Session, offset, limit uint64) (tp clients.TagsPage, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.Group("page",
+				slog.Uint64("offset", offset),
+				slog.Uint64("limit", limit),
+				slog.Uint64("total", tp.Total),
+			),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.WarnContext(ctx, "List client tags failed", args...)
+			return
+		}
+		lm.logger.InfoContext(ctx, "List client tags completed successfully", args...)
+	}(time.Now())
+	return lm.svc.ListTags(ctx, session, offset, limit)
+}
+
+func (lm *loggingMiddleware) RenameTag(ctx context.Context, session authn.Session, oldTag, newTag string) (renamed uint64, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("old_tag", oldTag),
+			slog.String("new_tag", newTag),
+			slog.Uint64("renamed", renamed),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.WarnContext(ctx, "Rename client tag failed", args...)
+			return
+		}
+		lm.logger.InfoContext(ctx, "Rename client tag completed successfully", args...)
+	}(time.Now())
+	return lm.svc.RenameTag(ctx, session, oldTag, newTag)
+}
+
+func (lm *loggingMiddleware) Share(ctx context.Context, session authn.Session, id, relation string, userIDs ...string) (err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("client_id", id),
+			slog.String("relation", relation),
+			slog.Int("user_count", len(userIDs)),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.WarnContext(ctx, "Share client failed", args...)
+			return
+		}
+		lm.logger.InfoContext(ctx, "Share client completed successfully", args...)
+	}(time.Now())
+	return lm.svc.Share(ctx, session, id, relation, userIDs...)
+}
+
+func (lm *loggingMiddleware) ListShares(ctx context.Context, session authn.Session, id string, limit, offset uint64) (mp roles.EntityMembersPage, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("client_id", id),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.WarnContext(ctx, "List client shares failed", args...)
+			return
+		}
+		lm.logger.InfoContext(ctx, "List client shares completed successfully", args...)
+	}(time.Now())
+	return lm.svc.ListShares(ctx, session, id, limit, offset)
+}
+
+func (lm *loggingMiddleware) Claim(ctx context.Context, session authn.Session, claimCode string) (c clients.Client, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.Group("client",
+				slog.String("id", c.ID),
+				slog.String("name", c.Name),
+			),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.WarnContext(ctx, "Claim client failed", args...)
+			return
+		}
+		lm.logger.InfoContext(ctx, "Claim client completed successfully", args...)
+	}(time.Now())
+	return lm.svc.Claim(ctx, session, claimCode)
+}
+
+func (lm *loggingMiddleware) AddRelation(ctx context.Context, session authn.Session, rel clients.ClientRelation) (err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("subject_id", rel.SubjectID),
+			slog.String("relation", rel.Relation),
+			slog.String("object_id", rel.ObjectID),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.WarnContext(ctx, "Add client relation failed", args...)
+			return
+		}
+		lm.logger.InfoContext(ctx, "Add client relation completed successfully", args...)
+	}(time.Now())
+	return lm.svc.AddRelation(ctx, session, rel)
+}
+
+func (lm *loggingMiddleware) RemoveRelation(ctx context.Context, session authn.Session, rel clients.ClientRelation) (err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("subject_id", rel.SubjectID),
+			slog.String("relation", rel.Relation),
+			slog.String("object_id", rel.ObjectID),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.WarnContext(ctx, "Remove client relation failed", args...)
+			return
+		}
+		lm.logger.InfoContext(ctx, "Remove client relation completed successfully", args...)
+	}(time.Now())
+	return lm.svc.RemoveRelation(ctx, session, rel)
+}
+
+func (lm *loggingMiddleware) ListRelated(ctx context.Context, session authn.Session, id, relation string, depth uint64) (rp clients.RelatedClientsPage, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("client_id", id),
+			slog.String("relation", relation),
+			slog.Uint64("depth", depth),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.WarnContext(ctx, "List related clients failed", args...)
+			return
+		}
+		lm.logger.InfoContext(ctx, "List related clients completed successfully", args...)
+	}(time.Now())
+	return lm.svc.ListRelated(ctx, session, id, relation, depth)
+}
+
 func (lm *loggingMiddleware) SetParentGroup(ctx context.Context, session authn.Session, parentGroupID string, id string) (err error) {
 	defer func(begin time.Time) {
 		args := []any{
@@ -208,10 +411,10 @@ func (lm *loggingMiddleware) SetParentGroup(ctx context.Context, session authn.S
 		}
 		if err != nil {
 			args = append(args, slog.String("error", err.Error()))
-			lm.logger.Warn("Set parent group to client failed", args...)
+			lm.logger.WarnContext(ctx, "Set parent group to client failed", args...)
 			return
 		}
-		lm.logger.Info("Set parent group to client completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Set parent group to client completed successfully", args...)
 	}(time.Now())
 	return lm.svc.SetParentGroup(ctx, session, parentGroupID, id)
 }
@@ -224,10 +427,10 @@ func (lm *loggingMiddleware) RemoveParentGroup(ctx context.Context, session auth
 		}
 		if err != nil {
 			args = append(args, slog.String("error", err.Error()))
-			lm.logger.Warn("Remove parent group from client failed", args...)
+			lm.logger.WarnContext(ctx, "Remove parent group from client failed", args...)
 			return
 		}
-		lm.logger.Info("Remove parent group from client completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Remove parent group from client completed successfully", args...)
 	}(time.Now())
 	return lm.svc.RemoveParentGroup(ctx, session, id)
 }
@@ -9,6 +9,7 @@ import (
 
 	"github.com/absmach/supermq/clients"
 	"github.com/absmach/supermq/pkg/authn"
+	"github.com/absmach/supermq/pkg/prometheus"
 	"github.com/absmach/supermq/pkg/roles"
 	rmMW "github.com/absmach/supermq/pkg/roles/rolemanager/middleware"
 	"github.com/go-kit/kit/metrics"
@@ -33,81 +34,169 @@ func MetricsMiddleware(svc clients.Service, counter metrics.Counter, latency met
 	}
 }
 
-func (ms *metricsMiddleware) CreateClients(ctx context.Context, session authn.Session, clients ...clients.Client) ([]clients.Client, []roles.RoleProvision, error) {
+func (ms *metricsMiddleware) CreateClients(ctx context.Context, session authn.Session, clients ...clients.Client) (cs []clients.Client, rps []roles.RoleProvision, err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "register_clients").Add(1)
+		ms.counter.With("method", "register_clients", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "register_clients").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.CreateClients(ctx, session, clients...)
 }
 
-func (ms *metricsMiddleware) View(ctx context.Context, session authn.Session, id string) (clients.Client, error) {
+func (ms *metricsMiddleware) View(ctx context.Context, session authn.Session, id string) (c clients.Client, err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "view_client").Add(1)
+		ms.counter.With("method", "view_client", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "view_client").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.View(ctx, session, id)
 }
 
-func (ms *metricsMiddleware) ListClients(ctx context.Context, session authn.Session, reqUserID string, pm clients.Page) (clients.ClientsPage, error) {
+func (ms *metricsMiddleware) ViewByExternalID(ctx context.Context, session authn.Session, externalID string) (c clients.Client, err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "list_clients").Add(1)
+		ms.counter.With("method", "view_client_by_external_id", "error", prometheus.ErrorClass(err)).Add(1)
+		ms.latency.With("method", "view_client_by_external_id").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+	return ms.svc.ViewByExternalID(ctx, session, externalID)
+}
+
+func (ms *metricsMiddleware) ListClients(ctx context.Context, session authn.Session, reqUserID string, pm clients.Page) (cp clients.ClientsPage, err error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "list_clients", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "list_clients").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.ListClients(ctx, session, reqUserID, pm)
 }
 
-func (ms *metricsMiddleware) Update(ctx context.Context, session authn.Session, client clients.Client) (clients.Client, error) {
+func (ms *metricsMiddleware) Update(ctx context.Context, session authn.Session, client clients.Client) (c clients.Client, err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "update_client").Add(1)
+		ms.counter.With("method", "update_client", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "update_client").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.Update(ctx, session, client)
 }
 
-func (ms *metricsMiddleware) UpdateTags(ctx context.Context, session authn.Session, client clients.Client) (clients.Client, error) {
+func (ms *metricsMiddleware) UpdateTags(ctx context.Context, session authn.Session, client clients.Client) (c clients.Client, err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "update_client_tags").Add(1)
+		ms.counter.With("method", "update_client_tags", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "update_client_tags").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.UpdateTags(ctx, session, client)
 }
 
-func (ms *metricsMiddleware) UpdateSecret(ctx context.Context, session authn.Session, oldSecret, newSecret string) (clients.Client, error) {
+func (ms *metricsMiddleware) UpdateSecret(ctx context.Context, session authn.Session, oldSecret, newSecret string) (c clients.Client, err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "update_client_secret").Add(1)
+		ms.counter.With("method", "update_client_secret", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "update_client_secret").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.UpdateSecret(ctx, session, oldSecret, newSecret)
 }
 
-func (ms *metricsMiddleware) Enable(ctx context.Context, session authn.Session, id string) (clients.Client, error) {
+func (ms *metricsMiddleware) Enable(ctx context.Context, session authn.Session, id string) (c clients.Client, err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "enable_client").Add(1)
+		ms.counter.With("method", "enable_client", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "enable_client").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.Enable(ctx, session, id)
 }
 
-func (ms *metricsMiddleware) Disable(ctx context.Context, session authn.Session, id string) (clients.Client, error) {
+func (ms *metricsMiddleware) Disable(ctx context.Context, session authn.Session, id string) (c clients.Client, err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "disable_client").Add(1)
+		ms.counter.With("method", "disable_client", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "disable_client").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.Disable(ctx, session, id)
 }
 
-func (ms *metricsMiddleware) Delete(ctx context.Context, session authn.Session, id string) error {
+func (ms *metricsMiddleware) Delete(ctx context.Context, session authn.Session, id string) (err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "delete_client").Add(1)
+		ms.counter.With("method", "delete_client", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "delete_client").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.Delete(ctx, session, id)
 }
 
+func (ms *metricsMiddleware) ListTrash(ctx context.Context, session authn.Session, pm clients.Page) (cp clients.ClientsPage, err error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "list_client_trash", "error", prometheus.ErrorClass(err)).Add(1)
+		ms.latency.With("method", "list_client_trash").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+	return ms.svc.ListTrash(ctx, session, pm)
+}
+
+func (ms *metricsMiddleware) Restore(ctx context.Context, session authn.Session, id string) (c clients.Client, err error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "restore_client", "error", prometheus.ErrorClass(err)).Add(1)
+		ms.latency.With("method", "restore_client").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+	return ms.svc.Restore(ctx, session, id)
+}
+
+func (ms *metricsMiddleware) ListTags(ctx context.Context, session authn.Session, offset, limit uint64) (tp clients.TagsPage, err error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "list_client_tags", "error", prometheus.ErrorClass(err)).Add(1)
+		ms.latency.With("method", "list_client_tags").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+	return ms.svc.ListTags(ctx, session, offset, limit)
+}
+
+func (ms *metricsMiddleware) RenameTag(ctx context.Context, session authn.Session, oldTag, newTag string) (renamed uint64, err error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "rename_client_tag", "error", prometheus.ErrorClass(err)).Add(1)
+		ms.latency.With("method", "rename_client_tag").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+	return ms.svc.RenameTag(ctx, session, oldTag, newTag)
+}
+
+func (ms *metricsMiddleware) Share(ctx context.Context, session authn.Session, id, relation string, userIDs ...string) (err error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "share_client", "error", prometheus.ErrorClass(err)).Add(1)
+		ms.latency.With("method", "share_client").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+	return ms.svc.Share(ctx, session, id, relation, userIDs...)
+}
+
+func (ms *metricsMiddleware) ListShares(ctx context.Context, session authn.Session, id string, limit, offset uint64) (mp roles.EntityMembersPage, err error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "list_client_shares", "error", prometheus.ErrorClass(err)).Add(1)
+		ms.latency.With("method", "list_client_shares").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+	return ms.svc.ListShares(ctx, session, id, limit, offset)
+}
+
+func (ms *metricsMiddleware) Claim(ctx context.Context, session authn.Session, claimCode string) (c clients.Client, err error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "claim_client", "error", prometheus.ErrorClass(err)).Add(1)
+		ms.latency.With("method", "claim_client").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+	return ms.svc.Claim(ctx, session, claimCode)
+}
+
+func (ms *metricsMiddleware) AddRelation(ctx context.Context, session authn.Session, rel clients.ClientRelation) (err error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "add_client_relation", "error", prometheus.ErrorClass(err)).Add(1)
+		ms.latency.With("method", "add_client_relation").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+	return ms.svc.AddRelation(ctx, session, rel)
+}
+
+func (ms *metricsMiddleware) RemoveRelation(ctx context.Context, session authn.Session, rel clients.ClientRelation) (err error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "remove_client_relation", "error", prometheus.ErrorClass(err)).Add(1)
+		ms.latency.With("method", "remove_client_relation").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+	return ms.svc.RemoveRelation(ctx, session, rel)
+}
+
+func (ms *metricsMiddleware) ListRelated(ctx context.Context, session authn.Session, id, relation string, depth uint64) (rp clients.RelatedClientsPage, err error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "list_related_clients", "error", prometheus.ErrorClass(err)).Add(1)
+		ms.latency.With("method", "list_related_clients").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+	return ms.svc.ListRelated(ctx, session, id, relation, depth)
+}
+
 func (ms *metricsMiddleware) SetParentGroup(ctx context.Context, session authn.Session, parentGroupID string, id string) (err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "set_parent_group").Add(1)
+		ms.counter.With("method", "set_parent_group", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "set_parent_group").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.SetParentGroup(ctx, session, parentGroupID, id)
@@ -115,7 +204,7 @@ func (ms *metricsMiddleware) SetParentGroup(ctx context.Context, session authn.S
 
 func (ms *metricsMiddleware) RemoveParentGroup(ctx context.Context, session authn.Session, id string) (err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "remove_parent_group").Add(1)
+		ms.counter.With("method", "remove_parent_group", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "remove_parent_group").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.RemoveParentGroup(ctx, session, id)
@@ -0,0 +1,36 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package middleware
+
+import (
+	"context"
+
+	"github.com/absmach/supermq/clients"
+	"github.com/absmach/supermq/pkg/authn"
+	"github.com/absmach/supermq/pkg/metering"
+	"github.com/absmach/supermq/pkg/roles"
+)
+
+var _ clients.Service = (*quotaMiddleware)(nil)
+
+type quotaMiddleware struct {
+	clients.Service
+	meter *metering.Meter
+}
+
+// QuotaMiddleware rejects client creation once the domain has reached its
+// configured clients quota.
+func QuotaMiddleware(svc clients.Service, meter *metering.Meter) clients.Service {
+	return &quotaMiddleware{
+		Service: svc,
+		meter:   meter,
+	}
+}
+
+func (qm *quotaMiddleware) CreateClients(ctx context.Context, session authn.Session, cls ...clients.Client) ([]clients.Client, []roles.RoleProvision, error) {
+	if err := qm.meter.CheckQuota(ctx, session.DomainID, metering.ResourceClients); err != nil {
+		return []clients.Client{}, []roles.RoleProvision{}, err
+	}
+	return qm.Service.CreateClients(ctx, session, cls...)
+}
@@ -179,6 +179,154 @@ func (_c *ClientsServiceClient_Authenticate_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// CreateClient provides a mock function with given fields: ctx, in, opts
+func (_m *ClientsServiceClient) CreateClient(ctx context.Context, in *clientsv1.CreateClientReq, opts ...grpc.CallOption) (*clientsv1.ClientRes, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateClient")
+	}
+
+	var r0 *clientsv1.ClientRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *clientsv1.CreateClientReq, ...grpc.CallOption) (*clientsv1.ClientRes, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *clientsv1.CreateClientReq, ...grpc.CallOption) *clientsv1.ClientRes); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*clientsv1.ClientRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *clientsv1.CreateClientReq, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClientsServiceClient_CreateClient_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateClient'
+type ClientsServiceClient_CreateClient_Call struct {
+	*mock.Call
+}
+
+// CreateClient is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *clientsv1.CreateClientReq
+//   - opts ...grpc.CallOption
+func (_e *ClientsServiceClient_Expecter) CreateClient(ctx interface{}, in interface{}, opts ...interface{}) *ClientsServiceClient_CreateClient_Call {
+	return &ClientsServiceClient_CreateClient_Call{Call: _e.mock.On("CreateClient",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *ClientsServiceClient_CreateClient_Call) Run(run func(ctx context.Context, in *clientsv1.CreateClientReq, opts ...grpc.CallOption)) *ClientsServiceClient_CreateClient_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*clientsv1.CreateClientReq), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *ClientsServiceClient_CreateClient_Call) Return(_a0 *clientsv1.ClientRes, _a1 error) *ClientsServiceClient_CreateClient_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ClientsServiceClient_CreateClient_Call) RunAndReturn(run func(context.Context, *clientsv1.CreateClientReq, ...grpc.CallOption) (*clientsv1.ClientRes, error)) *ClientsServiceClient_CreateClient_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListClients provides a mock function with given fields: ctx, in, opts
+func (_m *ClientsServiceClient) ListClients(ctx context.Context, in *clientsv1.ListClientsReq, opts ...grpc.CallOption) (*clientsv1.ListClientsRes, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListClients")
+	}
+
+	var r0 *clientsv1.ListClientsRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *clientsv1.ListClientsReq, ...grpc.CallOption) (*clientsv1.ListClientsRes, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *clientsv1.ListClientsReq, ...grpc.CallOption) *clientsv1.ListClientsRes); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*clientsv1.ListClientsRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *clientsv1.ListClientsReq, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClientsServiceClient_ListClients_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListClients'
+type ClientsServiceClient_ListClients_Call struct {
+	*mock.Call
+}
+
+// ListClients is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *clientsv1.ListClientsReq
+//   - opts ...grpc.CallOption
+func (_e *ClientsServiceClient_Expecter) ListClients(ctx interface{}, in interface{}, opts ...interface{}) *ClientsServiceClient_ListClients_Call {
+	return &ClientsServiceClient_ListClients_Call{Call: _e.mock.On("ListClients",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *ClientsServiceClient_ListClients_Call) Run(run func(ctx context.Context, in *clientsv1.ListClientsReq, opts ...grpc.CallOption)) *ClientsServiceClient_ListClients_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*clientsv1.ListClientsReq), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *ClientsServiceClient_ListClients_Call) Return(_a0 *clientsv1.ListClientsRes, _a1 error) *ClientsServiceClient_ListClients_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ClientsServiceClient_ListClients_Call) RunAndReturn(run func(context.Context, *clientsv1.ListClientsReq, ...grpc.CallOption) (*clientsv1.ListClientsRes, error)) *ClientsServiceClient_ListClients_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RemoveChannelConnections provides a mock function with given fields: ctx, in, opts
 func (_m *ClientsServiceClient) RemoveChannelConnections(ctx context.Context, in *clientsv1.RemoveChannelConnectionsReq, opts ...grpc.CallOption) (*clientsv1.RemoveChannelConnectionsRes, error) {
 	_va := make([]interface{}, len(opts))
@@ -253,6 +401,80 @@ func (_c *ClientsServiceClient_RemoveChannelConnections_Call) RunAndReturn(run f
 	return _c
 }
 
+// RemoveClient provides a mock function with given fields: ctx, in, opts
+func (_m *ClientsServiceClient) RemoveClient(ctx context.Context, in *clientsv1.RemoveClientReq, opts ...grpc.CallOption) (*clientsv1.RemoveClientRes, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveClient")
+	}
+
+	var r0 *clientsv1.RemoveClientRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *clientsv1.RemoveClientReq, ...grpc.CallOption) (*clientsv1.RemoveClientRes, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *clientsv1.RemoveClientReq, ...grpc.CallOption) *clientsv1.RemoveClientRes); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*clientsv1.RemoveClientRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *clientsv1.RemoveClientReq, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClientsServiceClient_RemoveClient_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveClient'
+type ClientsServiceClient_RemoveClient_Call struct {
+	*mock.Call
+}
+
+// RemoveClient is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *clientsv1.RemoveClientReq
+//   - opts ...grpc.CallOption
+func (_e *ClientsServiceClient_Expecter) RemoveClient(ctx interface{}, in interface{}, opts ...interface{}) *ClientsServiceClient_RemoveClient_Call {
+	return &ClientsServiceClient_RemoveClient_Call{Call: _e.mock.On("RemoveClient",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *ClientsServiceClient_RemoveClient_Call) Run(run func(ctx context.Context, in *clientsv1.RemoveClientReq, opts ...grpc.CallOption)) *ClientsServiceClient_RemoveClient_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*clientsv1.RemoveClientReq), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *ClientsServiceClient_RemoveClient_Call) Return(_a0 *clientsv1.RemoveClientRes, _a1 error) *ClientsServiceClient_RemoveClient_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ClientsServiceClient_RemoveClient_Call) RunAndReturn(run func(context.Context, *clientsv1.RemoveClientReq, ...grpc.CallOption) (*clientsv1.RemoveClientRes, error)) *ClientsServiceClient_RemoveClient_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RemoveConnections provides a mock function with given fields: ctx, in, opts
 func (_m *ClientsServiceClient) RemoveConnections(ctx context.Context, in *v1.RemoveConnectionsReq, opts ...grpc.CallOption) (*v1.RemoveConnectionsRes, error) {
 	_va := make([]interface{}, len(opts))
@@ -549,6 +771,80 @@ func (_c *ClientsServiceClient_UnsetParentGroupFromClient_Call) RunAndReturn(run
 	return _c
 }
 
+// UpdateClient provides a mock function with given fields: ctx, in, opts
+func (_m *ClientsServiceClient) UpdateClient(ctx context.Context, in *clientsv1.UpdateClientReq, opts ...grpc.CallOption) (*clientsv1.ClientRes, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateClient")
+	}
+
+	var r0 *clientsv1.ClientRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *clientsv1.UpdateClientReq, ...grpc.CallOption) (*clientsv1.ClientRes, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *clientsv1.UpdateClientReq, ...grpc.CallOption) *clientsv1.ClientRes); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*clientsv1.ClientRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *clientsv1.UpdateClientReq, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClientsServiceClient_UpdateClient_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateClient'
+type ClientsServiceClient_UpdateClient_Call struct {
+	*mock.Call
+}
+
+// UpdateClient is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *clientsv1.UpdateClientReq
+//   - opts ...grpc.CallOption
+func (_e *ClientsServiceClient_Expecter) UpdateClient(ctx interface{}, in interface{}, opts ...interface{}) *ClientsServiceClient_UpdateClient_Call {
+	return &ClientsServiceClient_UpdateClient_Call{Call: _e.mock.On("UpdateClient",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *ClientsServiceClient_UpdateClient_Call) Run(run func(ctx context.Context, in *clientsv1.UpdateClientReq, opts ...grpc.CallOption)) *ClientsServiceClient_UpdateClient_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*clientsv1.UpdateClientReq), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *ClientsServiceClient_UpdateClient_Call) Return(_a0 *clientsv1.ClientRes, _a1 error) *ClientsServiceClient_UpdateClient_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ClientsServiceClient_UpdateClient_Call) RunAndReturn(run func(context.Context, *clientsv1.UpdateClientReq, ...grpc.CallOption) (*clientsv1.ClientRes, error)) *ClientsServiceClient_UpdateClient_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewClientsServiceClient creates a new instance of ClientsServiceClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewClientsServiceClient(t interface {
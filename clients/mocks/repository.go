@@ -37,6 +37,24 @@ func (_m *Repository) AddConnections(ctx context.Context, conns []clients.Connec
 	return r0
 }
 
+// AddRelation provides a mock function with given fields: ctx, rel
+func (_m *Repository) AddRelation(ctx context.Context, rel clients.ClientRelation) error {
+	ret := _m.Called(ctx, rel)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddRelation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, clients.ClientRelation) error); ok {
+		r0 = rf(ctx, rel)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // AddRoles provides a mock function with given fields: ctx, rps
 func (_m *Repository) AddRoles(ctx context.Context, rps []roles.RoleProvision) ([]roles.RoleProvision, error) {
 	ret := _m.Called(ctx, rps)
@@ -95,6 +113,34 @@ func (_m *Repository) ChangeStatus(ctx context.Context, client clients.Client) (
 	return r0, r1
 }
 
+// Claim provides a mock function with given fields: ctx, domainID, claimCode
+func (_m *Repository) Claim(ctx context.Context, domainID string, claimCode string) (clients.Client, error) {
+	ret := _m.Called(ctx, domainID, claimCode)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Claim")
+	}
+
+	var r0 clients.Client
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (clients.Client, error)); ok {
+		return rf(ctx, domainID, claimCode)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) clients.Client); ok {
+		r0 = rf(ctx, domainID, claimCode)
+	} else {
+		r0 = ret.Get(0).(clients.Client)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, domainID, claimCode)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ClientConnectionsCount provides a mock function with given fields: ctx, id
 func (_m *Repository) ClientConnectionsCount(ctx context.Context, id string) (uint64, error) {
 	ret := _m.Called(ctx, id)
@@ -230,6 +276,80 @@ func (_m *Repository) RemoveConnections(ctx context.Context, conns []clients.Con
 	return r0
 }
 
+// RemoveRelation provides a mock function with given fields: ctx, rel
+func (_m *Repository) RemoveRelation(ctx context.Context, rel clients.ClientRelation) error {
+	ret := _m.Called(ctx, rel)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveRelation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, clients.ClientRelation) error); ok {
+		r0 = rf(ctx, rel)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListEntityMembers provides a mock function with given fields: ctx, entityID, limit, offset
+func (_m *Repository) ListEntityMembers(ctx context.Context, entityID string, limit uint64, offset uint64) (roles.EntityMembersPage, error) {
+	ret := _m.Called(ctx, entityID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEntityMembers")
+	}
+
+	var r0 roles.EntityMembersPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, uint64, uint64) (roles.EntityMembersPage, error)); ok {
+		return rf(ctx, entityID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, uint64, uint64) roles.EntityMembersPage); ok {
+		r0 = rf(ctx, entityID, limit, offset)
+	} else {
+		r0 = ret.Get(0).(roles.EntityMembersPage)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, uint64, uint64) error); ok {
+		r1 = rf(ctx, entityID, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListMemberEntities provides a mock function with given fields: ctx, memberID, limit, offset
+func (_m *Repository) ListMemberEntities(ctx context.Context, memberID string, limit uint64, offset uint64) (roles.MemberEntitiesPage, error) {
+	ret := _m.Called(ctx, memberID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListMemberEntities")
+	}
+
+	var r0 roles.MemberEntitiesPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, uint64, uint64) (roles.MemberEntitiesPage, error)); ok {
+		return rf(ctx, memberID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, uint64, uint64) roles.MemberEntitiesPage); ok {
+		r0 = rf(ctx, memberID, limit, offset)
+	} else {
+		r0 = ret.Get(0).(roles.MemberEntitiesPage)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, uint64, uint64) error); ok {
+		r1 = rf(ctx, memberID, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RemoveMemberFromAllRoles provides a mock function with given fields: ctx, memberID
 func (_m *Repository) RemoveMemberFromAllRoles(ctx context.Context, memberID string) error {
 	ret := _m.Called(ctx, memberID)
@@ -284,6 +404,34 @@ func (_m *Repository) RemoveRoles(ctx context.Context, roleIDs []string) error {
 	return r0
 }
 
+// RenameTag provides a mock function with given fields: ctx, domainID, oldTag, newTag
+func (_m *Repository) RenameTag(ctx context.Context, domainID string, oldTag string, newTag string) (uint64, error) {
+	ret := _m.Called(ctx, domainID, oldTag, newTag)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RenameTag")
+	}
+
+	var r0 uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (uint64, error)); ok {
+		return rf(ctx, domainID, oldTag, newTag)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) uint64); ok {
+		r0 = rf(ctx, domainID, oldTag, newTag)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, domainID, oldTag, newTag)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RetrieveAll provides a mock function with given fields: ctx, pm
 func (_m *Repository) RetrieveAll(ctx context.Context, pm clients.Page) (clients.ClientsPage, error) {
 	ret := _m.Called(ctx, pm)
@@ -312,6 +460,34 @@ func (_m *Repository) RetrieveAll(ctx context.Context, pm clients.Page) (clients
 	return r0, r1
 }
 
+// RetrieveAllTags provides a mock function with given fields: ctx, domainID, offset, limit
+func (_m *Repository) RetrieveAllTags(ctx context.Context, domainID string, offset uint64, limit uint64) (clients.TagsPage, error) {
+	ret := _m.Called(ctx, domainID, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetrieveAllTags")
+	}
+
+	var r0 clients.TagsPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, uint64, uint64) (clients.TagsPage, error)); ok {
+		return rf(ctx, domainID, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, uint64, uint64) clients.TagsPage); ok {
+		r0 = rf(ctx, domainID, offset, limit)
+	} else {
+		r0 = ret.Get(0).(clients.TagsPage)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, uint64, uint64) error); ok {
+		r1 = rf(ctx, domainID, offset, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RetrieveAllByIDs provides a mock function with given fields: ctx, pm
 func (_m *Repository) RetrieveAllByIDs(ctx context.Context, pm clients.Page) (clients.ClientsPage, error) {
 	ret := _m.Called(ctx, pm)
@@ -396,6 +572,34 @@ func (_m *Repository) RetrieveByID(ctx context.Context, id string) (clients.Clie
 	return r0, r1
 }
 
+// RetrieveByExternalID provides a mock function with given fields: ctx, domainID, externalID
+func (_m *Repository) RetrieveByExternalID(ctx context.Context, domainID string, externalID string) (clients.Client, error) {
+	ret := _m.Called(ctx, domainID, externalID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetrieveByExternalID")
+	}
+
+	var r0 clients.Client
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (clients.Client, error)); ok {
+		return rf(ctx, domainID, externalID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) clients.Client); ok {
+		r0 = rf(ctx, domainID, externalID)
+	} else {
+		r0 = ret.Get(0).(clients.Client)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, domainID, externalID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RetrieveByIds provides a mock function with given fields: ctx, ids
 func (_m *Repository) RetrieveByIds(ctx context.Context, ids []string) (clients.ClientsPage, error) {
 	ret := _m.Called(ctx, ids)
@@ -549,6 +753,36 @@ func (_m *Repository) RetrieveParentGroupClients(ctx context.Context, parentGrou
 	return r0, r1
 }
 
+// RetrieveRelated provides a mock function with given fields: ctx, domainID, clientID, relation, depth
+func (_m *Repository) RetrieveRelated(ctx context.Context, domainID string, clientID string, relation string, depth uint64) ([]clients.RelatedClient, error) {
+	ret := _m.Called(ctx, domainID, clientID, relation, depth)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetrieveRelated")
+	}
+
+	var r0 []clients.RelatedClient
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, uint64) ([]clients.RelatedClient, error)); ok {
+		return rf(ctx, domainID, clientID, relation, depth)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, uint64) []clients.RelatedClient); ok {
+		r0 = rf(ctx, domainID, clientID, relation, depth)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]clients.RelatedClient)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, uint64) error); ok {
+		r1 = rf(ctx, domainID, clientID, relation, depth)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RetrieveRole provides a mock function with given fields: ctx, roleID
 func (_m *Repository) RetrieveRole(ctx context.Context, roleID string) (roles.Role, error) {
 	ret := _m.Called(ctx, roleID)
@@ -980,6 +1214,34 @@ func (_m *Repository) UpdateIdentity(ctx context.Context, client clients.Client)
 	return r0, r1
 }
 
+// SetRoleInheritance provides a mock function with given fields: ctx, ro
+func (_m *Repository) SetRoleInheritance(ctx context.Context, ro roles.Role) (roles.Role, error) {
+	ret := _m.Called(ctx, ro)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetRoleInheritance")
+	}
+
+	var r0 roles.Role
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, roles.Role) (roles.Role, error)); ok {
+		return rf(ctx, ro)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, roles.Role) roles.Role); ok {
+		r0 = rf(ctx, ro)
+	} else {
+		r0 = ret.Get(0).(roles.Role)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, roles.Role) error); ok {
+		r1 = rf(ctx, ro)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateRole provides a mock function with given fields: ctx, ro
 func (_m *Repository) UpdateRole(ctx context.Context, ro roles.Role) (roles.Role, error) {
 	ret := _m.Called(ctx, ro)
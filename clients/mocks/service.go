@@ -48,6 +48,82 @@ func (_m *Service) AddRole(ctx context.Context, session authn.Session, entityID
 	return r0, r1
 }
 
+// AddRoles provides a mock function with given fields: ctx, session, entityID, rps
+func (_m *Service) AddRoles(ctx context.Context, session authn.Session, entityID string, rps []roles.RoleProvision) ([]roles.RoleProvision, error) {
+	ret := _m.Called(ctx, session, entityID, rps)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddRoles")
+	}
+
+	var r0 []roles.RoleProvision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, string, []roles.RoleProvision) ([]roles.RoleProvision, error)); ok {
+		return rf(ctx, session, entityID, rps)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, string, []roles.RoleProvision) []roles.RoleProvision); ok {
+		r0 = rf(ctx, session, entityID, rps)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]roles.RoleProvision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, authn.Session, string, []roles.RoleProvision) error); ok {
+		r1 = rf(ctx, session, entityID, rps)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AddRelation provides a mock function with given fields: ctx, session, rel
+func (_m *Service) AddRelation(ctx context.Context, session authn.Session, rel clients.ClientRelation) error {
+	ret := _m.Called(ctx, session, rel)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddRelation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, clients.ClientRelation) error); ok {
+		r0 = rf(ctx, session, rel)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Claim provides a mock function with given fields: ctx, session, claimCode
+func (_m *Service) Claim(ctx context.Context, session authn.Session, claimCode string) (clients.Client, error) {
+	ret := _m.Called(ctx, session, claimCode)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Claim")
+	}
+
+	var r0 clients.Client
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, string) (clients.Client, error)); ok {
+		return rf(ctx, session, claimCode)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, string) clients.Client); ok {
+		r0 = rf(ctx, session, claimCode)
+	} else {
+		r0 = ret.Get(0).(clients.Client)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, authn.Session, string) error); ok {
+		r1 = rf(ctx, session, claimCode)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateClients provides a mock function with given fields: ctx, session, client
 func (_m *Service) CreateClients(ctx context.Context, session authn.Session, client ...clients.Client) ([]clients.Client, []roles.RoleProvision, error) {
 	_va := make([]interface{}, len(client))
@@ -198,6 +274,92 @@ func (_m *Service) ListAvailableActions(ctx context.Context, session authn.Sessi
 	return r0, r1
 }
 
+// ListEntityMembers provides a mock function with given fields: ctx, session, entityID, limit, offset
+func (_m *Service) ListEntityMembers(ctx context.Context, session authn.Session, entityID string, limit uint64, offset uint64) (roles.EntityMembersPage, error) {
+	ret := _m.Called(ctx, session, entityID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEntityMembers")
+	}
+
+	var r0 roles.EntityMembersPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, string, uint64, uint64) (roles.EntityMembersPage, error)); ok {
+		return rf(ctx, session, entityID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, string, uint64, uint64) roles.EntityMembersPage); ok {
+		r0 = rf(ctx, session, entityID, limit, offset)
+	} else {
+		r0 = ret.Get(0).(roles.EntityMembersPage)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, authn.Session, string, uint64, uint64) error); ok {
+		r1 = rf(ctx, session, entityID, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListMemberEntities provides a mock function with given fields: ctx, session, memberID, limit, offset
+func (_m *Service) ListMemberEntities(ctx context.Context, session authn.Session, memberID string, limit uint64, offset uint64) (roles.MemberEntitiesPage, error) {
+	ret := _m.Called(ctx, session, memberID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListMemberEntities")
+	}
+
+	var r0 roles.MemberEntitiesPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, string, uint64, uint64) (roles.MemberEntitiesPage, error)); ok {
+		return rf(ctx, session, memberID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, string, uint64, uint64) roles.MemberEntitiesPage); ok {
+		r0 = rf(ctx, session, memberID, limit, offset)
+	} else {
+		r0 = ret.Get(0).(roles.MemberEntitiesPage)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, authn.Session, string, uint64, uint64) error); ok {
+		r1 = rf(ctx, session, memberID, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRoleTemplates provides a mock function with given fields: ctx, session
+func (_m *Service) ListRoleTemplates(ctx context.Context, session authn.Session) (map[string][]string, error) {
+	ret := _m.Called(ctx, session)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRoleTemplates")
+	}
+
+	var r0 map[string][]string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session) (map[string][]string, error)); ok {
+		return rf(ctx, session)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session) map[string][]string); ok {
+		r0 = rf(ctx, session)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, authn.Session) error); ok {
+		r1 = rf(ctx, session)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListClients provides a mock function with given fields: ctx, session, reqUserID, pm
 func (_m *Service) ListClients(ctx context.Context, session authn.Session, reqUserID string, pm clients.Page) (clients.ClientsPage, error) {
 	ret := _m.Called(ctx, session, reqUserID, pm)
@@ -226,6 +388,34 @@ func (_m *Service) ListClients(ctx context.Context, session authn.Session, reqUs
 	return r0, r1
 }
 
+// ListRelated provides a mock function with given fields: ctx, session, id, relation, depth
+func (_m *Service) ListRelated(ctx context.Context, session authn.Session, id string, relation string, depth uint64) (clients.RelatedClientsPage, error) {
+	ret := _m.Called(ctx, session, id, relation, depth)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRelated")
+	}
+
+	var r0 clients.RelatedClientsPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, string, string, uint64) (clients.RelatedClientsPage, error)); ok {
+		return rf(ctx, session, id, relation, depth)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, string, string, uint64) clients.RelatedClientsPage); ok {
+		r0 = rf(ctx, session, id, relation, depth)
+	} else {
+		r0 = ret.Get(0).(clients.RelatedClientsPage)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, authn.Session, string, string, uint64) error); ok {
+		r1 = rf(ctx, session, id, relation, depth)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RemoveMemberFromAllRoles provides a mock function with given fields: ctx, session, memberID
 func (_m *Service) RemoveMemberFromAllRoles(ctx context.Context, session authn.Session, memberID string) error {
 	ret := _m.Called(ctx, session, memberID)
@@ -262,6 +452,24 @@ func (_m *Service) RemoveParentGroup(ctx context.Context, session authn.Session,
 	return r0
 }
 
+// RemoveRelation provides a mock function with given fields: ctx, session, rel
+func (_m *Service) RemoveRelation(ctx context.Context, session authn.Session, rel clients.ClientRelation) error {
+	ret := _m.Called(ctx, session, rel)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveRelation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, clients.ClientRelation) error); ok {
+		r0 = rf(ctx, session, rel)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // RemoveRole provides a mock function with given fields: ctx, session, entityID, roleID
 func (_m *Service) RemoveRole(ctx context.Context, session authn.Session, entityID string, roleID string) error {
 	ret := _m.Called(ctx, session, entityID, roleID)
@@ -582,6 +790,164 @@ func (_m *Service) RoleRemoveMembers(ctx context.Context, session authn.Session,
 	return r0
 }
 
+// ListShares provides a mock function with given fields: ctx, session, id, limit, offset
+func (_m *Service) ListShares(ctx context.Context, session authn.Session, id string, limit uint64, offset uint64) (roles.EntityMembersPage, error) {
+	ret := _m.Called(ctx, session, id, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListShares")
+	}
+
+	var r0 roles.EntityMembersPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, string, uint64, uint64) (roles.EntityMembersPage, error)); ok {
+		return rf(ctx, session, id, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, string, uint64, uint64) roles.EntityMembersPage); ok {
+		r0 = rf(ctx, session, id, limit, offset)
+	} else {
+		r0 = ret.Get(0).(roles.EntityMembersPage)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, authn.Session, string, uint64, uint64) error); ok {
+		r1 = rf(ctx, session, id, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTags provides a mock function with given fields: ctx, session, offset, limit
+func (_m *Service) ListTags(ctx context.Context, session authn.Session, offset uint64, limit uint64) (clients.TagsPage, error) {
+	ret := _m.Called(ctx, session, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTags")
+	}
+
+	var r0 clients.TagsPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, uint64, uint64) (clients.TagsPage, error)); ok {
+		return rf(ctx, session, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, uint64, uint64) clients.TagsPage); ok {
+		r0 = rf(ctx, session, offset, limit)
+	} else {
+		r0 = ret.Get(0).(clients.TagsPage)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, authn.Session, uint64, uint64) error); ok {
+		r1 = rf(ctx, session, offset, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTrash provides a mock function with given fields: ctx, session, pm
+func (_m *Service) ListTrash(ctx context.Context, session authn.Session, pm clients.Page) (clients.ClientsPage, error) {
+	ret := _m.Called(ctx, session, pm)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTrash")
+	}
+
+	var r0 clients.ClientsPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, clients.Page) (clients.ClientsPage, error)); ok {
+		return rf(ctx, session, pm)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, clients.Page) clients.ClientsPage); ok {
+		r0 = rf(ctx, session, pm)
+	} else {
+		r0 = ret.Get(0).(clients.ClientsPage)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, authn.Session, clients.Page) error); ok {
+		r1 = rf(ctx, session, pm)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RenameTag provides a mock function with given fields: ctx, session, oldTag, newTag
+func (_m *Service) RenameTag(ctx context.Context, session authn.Session, oldTag string, newTag string) (uint64, error) {
+	ret := _m.Called(ctx, session, oldTag, newTag)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RenameTag")
+	}
+
+	var r0 uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, string, string) (uint64, error)); ok {
+		return rf(ctx, session, oldTag, newTag)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, string, string) uint64); ok {
+		r0 = rf(ctx, session, oldTag, newTag)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, authn.Session, string, string) error); ok {
+		r1 = rf(ctx, session, oldTag, newTag)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Restore provides a mock function with given fields: ctx, session, id
+func (_m *Service) Restore(ctx context.Context, session authn.Session, id string) (clients.Client, error) {
+	ret := _m.Called(ctx, session, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restore")
+	}
+
+	var r0 clients.Client
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, string) (clients.Client, error)); ok {
+		return rf(ctx, session, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, string) clients.Client); ok {
+		r0 = rf(ctx, session, id)
+	} else {
+		r0 = ret.Get(0).(clients.Client)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, authn.Session, string) error); ok {
+		r1 = rf(ctx, session, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Share provides a mock function with given fields: ctx, session, id, relation, userIDs
+func (_m *Service) Share(ctx context.Context, session authn.Session, id string, relation string, userIDs ...string) error {
+	ret := _m.Called(ctx, session, id, relation, userIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Share")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, string, string, ...string) error); ok {
+		r0 = rf(ctx, session, id, relation, userIDs...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SetParentGroup provides a mock function with given fields: ctx, session, parentGroupID, id
 func (_m *Service) SetParentGroup(ctx context.Context, session authn.Session, parentGroupID string, id string) error {
 	ret := _m.Called(ctx, session, parentGroupID, id)
@@ -628,6 +994,34 @@ func (_m *Service) Update(ctx context.Context, session authn.Session, client cli
 	return r0, r1
 }
 
+// SetRoleInheritance provides a mock function with given fields: ctx, session, entityID, roleID, inheritsFrom
+func (_m *Service) SetRoleInheritance(ctx context.Context, session authn.Session, entityID string, roleID string, inheritsFrom string) (roles.Role, error) {
+	ret := _m.Called(ctx, session, entityID, roleID, inheritsFrom)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetRoleInheritance")
+	}
+
+	var r0 roles.Role
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, string, string, string) (roles.Role, error)); ok {
+		return rf(ctx, session, entityID, roleID, inheritsFrom)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, string, string, string) roles.Role); ok {
+		r0 = rf(ctx, session, entityID, roleID, inheritsFrom)
+	} else {
+		r0 = ret.Get(0).(roles.Role)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, authn.Session, string, string, string) error); ok {
+		r1 = rf(ctx, session, entityID, roleID, inheritsFrom)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateRoleName provides a mock function with given fields: ctx, session, entityID, roleID, newRoleName
 func (_m *Service) UpdateRoleName(ctx context.Context, session authn.Session, entityID string, roleID string, newRoleName string) (roles.Role, error) {
 	ret := _m.Called(ctx, session, entityID, roleID, newRoleName)
@@ -740,6 +1134,34 @@ func (_m *Service) View(ctx context.Context, session authn.Session, id string) (
 	return r0, r1
 }
 
+// ViewByExternalID provides a mock function with given fields: ctx, session, externalID
+func (_m *Service) ViewByExternalID(ctx context.Context, session authn.Session, externalID string) (clients.Client, error) {
+	ret := _m.Called(ctx, session, externalID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ViewByExternalID")
+	}
+
+	var r0 clients.Client
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, string) (clients.Client, error)); ok {
+		return rf(ctx, session, externalID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, string) clients.Client); ok {
+		r0 = rf(ctx, session, externalID)
+	} else {
+		r0 = ret.Get(0).(clients.Client)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, authn.Session, string) error); ok {
+		r1 = rf(ctx, session, externalID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewService creates a new instance of Service. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewService(t interface {
@@ -17,9 +17,11 @@ import (
 	"github.com/absmach/supermq/pkg/connections"
 	"github.com/absmach/supermq/pkg/errors"
 	repoerr "github.com/absmach/supermq/pkg/errors/repository"
+	"github.com/absmach/supermq/pkg/labels"
 	"github.com/absmach/supermq/pkg/postgres"
 	rolesPostgres "github.com/absmach/supermq/pkg/roles/repo/postgres"
 	"github.com/jackc/pgtype"
+	"github.com/lib/pq"
 )
 
 const (
@@ -56,9 +58,9 @@ func (repo *clientRepo) Save(ctx context.Context, cls ...clients.Client) ([]clie
 		}
 		dbClients = append(dbClients, dbcli)
 	}
-	q := `INSERT INTO clients (id, name, tags, domain_id, parent_group_id, identity, secret, metadata, created_at, updated_at, updated_by, status)
-	VALUES (:id, :name, :tags, :domain_id, :parent_group_id, :identity, :secret, :metadata, :created_at, :updated_at, :updated_by, :status)
-	RETURNING id, name, tags, identity, secret, metadata, COALESCE(domain_id, '') AS domain_id, COALESCE(parent_group_id, '') AS  parent_group_id, status, created_at, updated_at, updated_by`
+	q := `INSERT INTO clients (id, name, tags, domain_id, parent_group_id, identity, external_id, claim_code, secret, metadata, labels, created_at, updated_at, updated_by, status)
+	VALUES (:id, :name, :tags, :domain_id, :parent_group_id, :identity, :external_id, :claim_code, :secret, :metadata, :labels, :created_at, :updated_at, :updated_by, :status)
+	RETURNING id, name, tags, identity, external_id, secret, metadata, labels, COALESCE(domain_id, '') AS domain_id, COALESCE(parent_group_id, '') AS  parent_group_id, status, created_at, updated_at, updated_by, version`
 
 	row, err := repo.DB.NamedQueryContext(ctx, q, dbClients)
 	if err != nil {
@@ -84,7 +86,7 @@ func (repo *clientRepo) Save(ctx context.Context, cls ...clients.Client) ([]clie
 }
 
 func (repo *clientRepo) RetrieveBySecret(ctx context.Context, key string) (clients.Client, error) {
-	q := fmt.Sprintf(`SELECT id, name, tags, COALESCE(domain_id, '') AS domain_id,  COALESCE(parent_group_id, '') AS parent_group_id, identity, secret, metadata, created_at, updated_at, updated_by, status
+	q := fmt.Sprintf(`SELECT id, name, tags, COALESCE(domain_id, '') AS domain_id,  COALESCE(parent_group_id, '') AS parent_group_id, identity, external_id, secret, metadata, labels, created_at, updated_at, updated_by, status, version
         FROM clients
         WHERE secret = :secret AND status = %d`, clients.EnabledStatus)
 
@@ -115,6 +117,65 @@ func (repo *clientRepo) RetrieveBySecret(ctx context.Context, key string) (clien
 	return clients.Client{}, repoerr.ErrNotFound
 }
 
+func (repo *clientRepo) RetrieveAllTags(ctx context.Context, domainID string, offset, limit uint64) (clients.TagsPage, error) {
+	q := `SELECT tag, COUNT(*) AS count FROM clients, unnest(tags) AS tag
+        WHERE domain_id = :domain_id GROUP BY tag ORDER BY tag LIMIT :limit OFFSET :offset`
+
+	params := map[string]interface{}{
+		"domain_id": domainID,
+		"offset":    offset,
+		"limit":     limit,
+	}
+	rows, err := repo.DB.NamedQueryContext(ctx, q, params)
+	if err != nil {
+		return clients.TagsPage{}, postgres.HandleError(repoerr.ErrViewEntity, err)
+	}
+	defer rows.Close()
+
+	var tags []clients.Tag
+	for rows.Next() {
+		dbt := dbTag{}
+		if err := rows.StructScan(&dbt); err != nil {
+			return clients.TagsPage{}, errors.Wrap(repoerr.ErrViewEntity, err)
+		}
+		tags = append(tags, clients.Tag{Name: dbt.Tag, Count: dbt.Count})
+	}
+
+	cq := `SELECT COUNT(DISTINCT tag) FROM clients, unnest(tags) AS tag WHERE domain_id = :domain_id`
+	total, err := postgres.Total(ctx, repo.DB, cq, params)
+	if err != nil {
+		return clients.TagsPage{}, errors.Wrap(repoerr.ErrViewEntity, err)
+	}
+
+	return clients.TagsPage{
+		Total:  total,
+		Offset: offset,
+		Limit:  limit,
+		Tags:   tags,
+	}, nil
+}
+
+func (repo *clientRepo) RenameTag(ctx context.Context, domainID, oldTag, newTag string) (uint64, error) {
+	q := `UPDATE clients SET tags = ARRAY(SELECT DISTINCT t FROM unnest(array_replace(tags, :old_tag, :new_tag)) AS t)
+        WHERE domain_id = :domain_id AND :old_tag = ANY(tags)`
+
+	params := map[string]interface{}{
+		"domain_id": domainID,
+		"old_tag":   oldTag,
+		"new_tag":   newTag,
+	}
+	res, err := repo.DB.NamedExecContext(ctx, q, params)
+	if err != nil {
+		return 0, postgres.HandleError(repoerr.ErrUpdateEntity, err)
+	}
+	renamed, err := res.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(repoerr.ErrUpdateEntity, err)
+	}
+
+	return uint64(renamed), nil
+}
+
 func (repo *clientRepo) Update(ctx context.Context, client clients.Client) (clients.Client, error) {
 	var query []string
 	var upq string
@@ -124,52 +185,55 @@ func (repo *clientRepo) Update(ctx context.Context, client clients.Client) (clie
 	if client.Metadata != nil {
 		query = append(query, "metadata = :metadata,")
 	}
+	if client.Labels != nil {
+		query = append(query, "labels = :labels,")
+	}
 	if len(query) > 0 {
 		upq = strings.Join(query, " ")
 	}
 
-	q := fmt.Sprintf(`UPDATE clients SET %s updated_at = :updated_at, updated_by = :updated_by
-        WHERE id = :id AND status = :status
-        RETURNING id, name, tags, identity, secret,  metadata, COALESCE(domain_id, '') AS domain_id, COALESCE(parent_group_id, '') AS parent_group_id, status, created_at, updated_at, updated_by`,
+	q := fmt.Sprintf(`UPDATE clients SET %s version = version + 1, updated_at = :updated_at, updated_by = :updated_by
+        WHERE id = :id AND status = :status AND (:version = 0 OR version = :version)
+        RETURNING id, name, tags, identity, external_id, secret,  metadata, labels, COALESCE(domain_id, '') AS domain_id, COALESCE(parent_group_id, '') AS parent_group_id, status, created_at, updated_at, updated_by, version`,
 		upq)
 	client.Status = clients.EnabledStatus
 	return repo.update(ctx, client, q)
 }
 
 func (repo *clientRepo) UpdateTags(ctx context.Context, client clients.Client) (clients.Client, error) {
-	q := `UPDATE clients SET tags = :tags, updated_at = :updated_at, updated_by = :updated_by
-        WHERE id = :id AND status = :status
-        RETURNING id, name, tags, identity, metadata, COALESCE(domain_id, '') AS domain_id, COALESCE(parent_group_id, '') AS parent_group_id, status, created_at, updated_at, updated_by`
+	q := `UPDATE clients SET tags = :tags, version = version + 1, updated_at = :updated_at, updated_by = :updated_by
+        WHERE id = :id AND status = :status AND (:version = 0 OR version = :version)
+        RETURNING id, name, tags, identity, external_id, metadata, labels, COALESCE(domain_id, '') AS domain_id, COALESCE(parent_group_id, '') AS parent_group_id, status, created_at, updated_at, updated_by, version`
 	client.Status = clients.EnabledStatus
 	return repo.update(ctx, client, q)
 }
 
 func (repo *clientRepo) UpdateIdentity(ctx context.Context, client clients.Client) (clients.Client, error) {
-	q := `UPDATE clients SET identity = :identity, updated_at = :updated_at, updated_by = :updated_by
-        WHERE id = :id AND status = :status
-        RETURNING id, name, tags, identity, metadata, COALESCE(domain_id, '') AS domain_id, status, COALESCE(parent_group_id, '') AS parent_group_id, created_at, updated_at, updated_by`
+	q := `UPDATE clients SET identity = :identity, version = version + 1, updated_at = :updated_at, updated_by = :updated_by
+        WHERE id = :id AND status = :status AND (:version = 0 OR version = :version)
+        RETURNING id, name, tags, identity, external_id, metadata, labels, COALESCE(domain_id, '') AS domain_id, status, COALESCE(parent_group_id, '') AS parent_group_id, created_at, updated_at, updated_by, version`
 	client.Status = clients.EnabledStatus
 	return repo.update(ctx, client, q)
 }
 
 func (repo *clientRepo) UpdateSecret(ctx context.Context, client clients.Client) (clients.Client, error) {
-	q := `UPDATE clients SET secret = :secret, updated_at = :updated_at, updated_by = :updated_by
-        WHERE id = :id AND status = :status
-        RETURNING id, name, tags, identity, metadata, COALESCE(domain_id, '') AS domain_id, COALESCE(parent_group_id, '') AS parent_group_id, status, created_at, updated_at, updated_by`
+	q := `UPDATE clients SET secret = :secret, version = version + 1, updated_at = :updated_at, updated_by = :updated_by
+        WHERE id = :id AND status = :status AND (:version = 0 OR version = :version)
+        RETURNING id, name, tags, identity, external_id, metadata, labels, COALESCE(domain_id, '') AS domain_id, COALESCE(parent_group_id, '') AS parent_group_id, status, created_at, updated_at, updated_by, version`
 	client.Status = clients.EnabledStatus
 	return repo.update(ctx, client, q)
 }
 
 func (repo *clientRepo) ChangeStatus(ctx context.Context, client clients.Client) (clients.Client, error) {
-	q := `UPDATE clients SET status = :status, updated_at = :updated_at, updated_by = :updated_by
-		WHERE id = :id
-        RETURNING id, name, tags, identity, metadata, COALESCE(domain_id, '') AS domain_id, COALESCE(parent_group_id, '') AS parent_group_id, status, created_at, updated_at, updated_by`
+	q := `UPDATE clients SET status = :status, version = version + 1, updated_at = :updated_at, updated_by = :updated_by
+		WHERE id = :id AND (:version = 0 OR version = :version)
+        RETURNING id, name, tags, identity, external_id, metadata, labels, COALESCE(domain_id, '') AS domain_id, COALESCE(parent_group_id, '') AS parent_group_id, status, created_at, updated_at, updated_by, version`
 
 	return repo.update(ctx, client, q)
 }
 
 func (repo *clientRepo) RetrieveByID(ctx context.Context, id string) (clients.Client, error) {
-	q := `SELECT id, name, tags, COALESCE(domain_id, '') AS domain_id, COALESCE(parent_group_id, '') AS parent_group_id, identity, secret, metadata, created_at, updated_at, updated_by, status
+	q := `SELECT id, name, tags, COALESCE(domain_id, '') AS domain_id, COALESCE(parent_group_id, '') AS parent_group_id, identity, external_id, secret, metadata, labels, created_at, updated_at, updated_by, status, version
         FROM clients WHERE id = :id`
 
 	dbc := DBClient{
@@ -194,6 +258,61 @@ func (repo *clientRepo) RetrieveByID(ctx context.Context, id string) (clients.Cl
 	return clients.Client{}, repoerr.ErrNotFound
 }
 
+func (repo *clientRepo) RetrieveByExternalID(ctx context.Context, domainID, externalID string) (clients.Client, error) {
+	q := `SELECT id, name, tags, COALESCE(domain_id, '') AS domain_id, COALESCE(parent_group_id, '') AS parent_group_id, identity, external_id, secret, metadata, labels, created_at, updated_at, updated_by, status, version
+        FROM clients WHERE domain_id = :domain_id AND external_id = :external_id`
+
+	dbc := DBClient{
+		Domain:     domainID,
+		ExternalID: toNullString(externalID),
+	}
+
+	row, err := repo.DB.NamedQueryContext(ctx, q, dbc)
+	if err != nil {
+		return clients.Client{}, errors.Wrap(repoerr.ErrViewEntity, err)
+	}
+	defer row.Close()
+
+	dbc = DBClient{}
+	if row.Next() {
+		if err := row.StructScan(&dbc); err != nil {
+			return clients.Client{}, errors.Wrap(repoerr.ErrViewEntity, err)
+		}
+
+		return ToClient(dbc)
+	}
+
+	return clients.Client{}, repoerr.ErrNotFound
+}
+
+func (repo *clientRepo) Claim(ctx context.Context, domainID, claimCode string) (clients.Client, error) {
+	q := `UPDATE clients SET claim_code = NULL
+        WHERE domain_id = :domain_id AND claim_code = :claim_code
+        RETURNING id, name, tags, identity, external_id, metadata, labels, COALESCE(domain_id, '') AS domain_id, COALESCE(parent_group_id, '') AS parent_group_id, status, created_at, updated_at, updated_by, version`
+
+	dbc := DBClient{
+		Domain:    domainID,
+		ClaimCode: toNullString(claimCode),
+	}
+
+	row, err := repo.DB.NamedQueryContext(ctx, q, dbc)
+	if err != nil {
+		return clients.Client{}, errors.Wrap(repoerr.ErrUpdateEntity, err)
+	}
+	defer row.Close()
+
+	dbc = DBClient{}
+	if row.Next() {
+		if err := row.StructScan(&dbc); err != nil {
+			return clients.Client{}, errors.Wrap(repoerr.ErrUpdateEntity, err)
+		}
+
+		return ToClient(dbc)
+	}
+
+	return clients.Client{}, repoerr.ErrNotFound
+}
+
 func (repo *clientRepo) RetrieveAll(ctx context.Context, pm clients.Page) (clients.ClientsPage, error) {
 	query, err := PageQuery(pm)
 	if err != nil {
@@ -201,8 +320,8 @@ func (repo *clientRepo) RetrieveAll(ctx context.Context, pm clients.Page) (clien
 	}
 	query = applyOrdering(query, pm)
 
-	q := fmt.Sprintf(`SELECT c.id, c.name, c.tags, c.identity, c.metadata, COALESCE(c.domain_id, '') AS domain_id, COALESCE(parent_group_id, '') AS parent_group_id, c.status,
-					c.created_at, c.updated_at, COALESCE(c.updated_by, '') AS updated_by FROM clients c %s ORDER BY c.created_at LIMIT :limit OFFSET :offset;`, query)
+	q := fmt.Sprintf(`SELECT c.id, c.name, c.tags, c.identity, c.external_id, c.metadata, c.labels, COALESCE(c.domain_id, '') AS domain_id, COALESCE(parent_group_id, '') AS parent_group_id, c.status,
+					c.created_at, c.updated_at, COALESCE(c.updated_by, '') AS updated_by, c.version FROM clients c %s ORDER BY c.created_at LIMIT :limit OFFSET :offset;`, query)
 
 	dbPage, err := ToDBClientsPage(pm)
 	if err != nil {
@@ -314,8 +433,8 @@ func (repo *clientRepo) RetrieveAllByIDs(ctx context.Context, pm clients.Page) (
 	}
 	query = applyOrdering(query, pm)
 
-	q := fmt.Sprintf(`SELECT c.id, c.name, c.tags, c.identity, c.metadata, COALESCE(c.domain_id, '') AS domain_id, COALESCE(parent_group_id, '') AS parent_group_id, c.status,
-					c.created_at, c.updated_at, COALESCE(c.updated_by, '') AS updated_by FROM clients c %s ORDER BY c.created_at LIMIT :limit OFFSET :offset;`, query)
+	q := fmt.Sprintf(`SELECT c.id, c.name, c.tags, c.identity, c.external_id, c.metadata, c.labels, COALESCE(c.domain_id, '') AS domain_id, COALESCE(parent_group_id, '') AS parent_group_id, c.status,
+					c.created_at, c.updated_at, COALESCE(c.updated_by, '') AS updated_by, c.version FROM clients c %s ORDER BY c.created_at LIMIT :limit OFFSET :offset;`, query)
 
 	dbPage, err := ToDBClientsPage(pm)
 	if err != nil {
@@ -381,6 +500,12 @@ func (repo *clientRepo) update(ctx context.Context, client clients.Client, query
 		return ToClient(dbc)
 	}
 
+	if client.Version > 0 {
+		if _, err := repo.RetrieveByID(ctx, client.ID); err == nil {
+			return clients.Client{}, repoerr.ErrVersionConflict
+		}
+	}
+
 	return clients.Client{}, repoerr.ErrNotFound
 }
 
@@ -406,14 +531,23 @@ type DBClient struct {
 	Name        string           `db:"name,omitempty"`
 	Tags        pgtype.TextArray `db:"tags,omitempty"`
 	Identity    string           `db:"identity"`
+	ExternalID  sql.NullString   `db:"external_id,omitempty"`
+	ClaimCode   sql.NullString   `db:"claim_code,omitempty"`
 	Domain      string           `db:"domain_id"`
 	ParentGroup sql.NullString   `db:"parent_group_id,omitempty"`
 	Secret      string           `db:"secret"`
 	Metadata    []byte           `db:"metadata,omitempty"`
+	Labels      []byte           `db:"labels,omitempty"`
 	CreatedAt   time.Time        `db:"created_at,omitempty"`
 	UpdatedAt   sql.NullTime     `db:"updated_at,omitempty"`
 	UpdatedBy   *string          `db:"updated_by,omitempty"`
 	Status      clients.Status   `db:"status,omitempty"`
+	Version     uint64           `db:"version"`
+}
+
+type dbTag struct {
+	Tag   string `db:"tag"`
+	Count uint64 `db:"count"`
 }
 
 func ToDBClient(c clients.Client) (DBClient, error) {
@@ -425,6 +559,14 @@ func ToDBClient(c clients.Client) (DBClient, error) {
 		}
 		data = b
 	}
+	lbls := []byte("{}")
+	if len(c.Labels) > 0 {
+		b, err := json.Marshal(c.Labels)
+		if err != nil {
+			return DBClient{}, errors.Wrap(repoerr.ErrMalformedEntity, err)
+		}
+		lbls = b
+	}
 	var tags pgtype.TextArray
 	if err := tags.Set(c.Tags); err != nil {
 		return DBClient{}, err
@@ -444,13 +586,17 @@ func ToDBClient(c clients.Client) (DBClient, error) {
 		Tags:        tags,
 		Domain:      c.Domain,
 		ParentGroup: toNullString(c.ParentGroup),
+		ExternalID:  toNullString(c.ExternalID),
+		ClaimCode:   toNullString(c.ClaimCode),
 		Identity:    c.Credentials.Identity,
 		Secret:      c.Credentials.Secret,
 		Metadata:    data,
+		Labels:      lbls,
 		CreatedAt:   c.CreatedAt,
 		UpdatedAt:   updatedAt,
 		UpdatedBy:   updatedBy,
 		Status:      c.Status,
+		Version:     c.Version,
 	}, nil
 }
 
@@ -461,6 +607,12 @@ func ToClient(t DBClient) (clients.Client, error) {
 			return clients.Client{}, errors.Wrap(errors.ErrMalformedEntity, err)
 		}
 	}
+	var lbls labels.Labels
+	if len(t.Labels) > 0 {
+		if err := json.Unmarshal(t.Labels, &lbls); err != nil {
+			return clients.Client{}, errors.Wrap(errors.ErrMalformedEntity, err)
+		}
+	}
 	var tags []string
 	for _, e := range t.Tags.Elements {
 		tags = append(tags, e.String)
@@ -480,15 +632,18 @@ func ToClient(t DBClient) (clients.Client, error) {
 		Tags:        tags,
 		Domain:      t.Domain,
 		ParentGroup: toString(t.ParentGroup),
+		ExternalID:  toString(t.ExternalID),
 		Credentials: clients.Credentials{
 			Identity: t.Identity,
 			Secret:   t.Secret,
 		},
 		Metadata:  metadata,
+		Labels:    lbls,
 		CreatedAt: t.CreatedAt,
 		UpdatedAt: updatedAt,
 		UpdatedBy: updatedBy,
 		Status:    t.Status,
+		Version:   t.Version,
 	}
 	return cli, nil
 }
@@ -498,32 +653,41 @@ func ToDBClientsPage(pm clients.Page) (dbClientsPage, error) {
 	if err != nil {
 		return dbClientsPage{}, errors.Wrap(repoerr.ErrViewEntity, err)
 	}
+	_, ids := postgres.CreateIDsQuery("", pm.IDs)
+	_, sel, err := postgres.CreateLabelSelectorQuery("", pm.LabelSelector)
+	if err != nil {
+		return dbClientsPage{}, errors.Wrap(repoerr.ErrViewEntity, err)
+	}
 	return dbClientsPage{
-		Name:     pm.Name,
-		Identity: pm.Identity,
-		Id:       pm.Id,
-		Metadata: data,
-		Domain:   pm.Domain,
-		Total:    pm.Total,
-		Offset:   pm.Offset,
-		Limit:    pm.Limit,
-		Status:   pm.Status,
-		Tag:      pm.Tag,
+		Name:          pm.Name,
+		Identity:      pm.Identity,
+		Id:            pm.Id,
+		Ids:           ids,
+		Metadata:      data,
+		LabelSelector: sel,
+		Domain:        pm.Domain,
+		Total:         pm.Total,
+		Offset:        pm.Offset,
+		Limit:         pm.Limit,
+		Status:        pm.Status,
+		Tag:           pm.Tag,
 	}, nil
 }
 
 type dbClientsPage struct {
-	Total    uint64         `db:"total"`
-	Limit    uint64         `db:"limit"`
-	Offset   uint64         `db:"offset"`
-	Name     string         `db:"name"`
-	Id       string         `db:"id"`
-	Domain   string         `db:"domain_id"`
-	Identity string         `db:"identity"`
-	Metadata []byte         `db:"metadata"`
-	Tag      string         `db:"tag"`
-	Status   clients.Status `db:"status"`
-	GroupID  string         `db:"group_id"`
+	Total         uint64         `db:"total"`
+	Limit         uint64         `db:"limit"`
+	Offset        uint64         `db:"offset"`
+	Name          string         `db:"name"`
+	Id            string         `db:"id"`
+	Ids           pq.StringArray `db:"ids"`
+	Domain        string         `db:"domain_id"`
+	Identity      string         `db:"identity"`
+	Metadata      []byte         `db:"metadata"`
+	LabelSelector []byte         `db:"label_selector"`
+	Tag           string         `db:"tag"`
+	Status        clients.Status `db:"status"`
+	GroupID       string         `db:"group_id"`
 }
 
 func PageQuery(pm clients.Page) (string, error) {
@@ -555,8 +719,14 @@ func PageQuery(pm clients.Page) (string, error) {
 		query = append(query, mq)
 	}
 
-	if len(pm.IDs) != 0 {
-		query = append(query, fmt.Sprintf("id IN ('%s')", strings.Join(pm.IDs, "','")))
+	if lq, _, err := postgres.CreateLabelSelectorQuery("c.", pm.LabelSelector); err != nil {
+		return "", errors.Wrap(errors.ErrMalformedEntity, err)
+	} else if lq != "" {
+		query = append(query, lq)
+	}
+
+	if iq, _ := postgres.CreateIDsQuery("", pm.IDs); iq != "" {
+		query = append(query, iq)
 	}
 	if pm.Status != clients.AllStatus {
 		query = append(query, "c.status = :status")
@@ -611,8 +781,8 @@ func (repo *clientRepo) RetrieveByIds(ctx context.Context, ids []string) (client
 		return clients.ClientsPage{}, errors.Wrap(repoerr.ErrViewEntity, err)
 	}
 
-	q := fmt.Sprintf(`SELECT c.id, c.name, c.tags, c.identity, c.metadata, COALESCE(c.domain_id, '') AS domain_id,  COALESCE(parent_group_id, '') AS parent_group_id, c.status,
-					c.created_at, c.updated_at, COALESCE(c.updated_by, '') AS updated_by FROM clients c %s ORDER BY c.created_at`, query)
+	q := fmt.Sprintf(`SELECT c.id, c.name, c.tags, c.identity, c.external_id, c.metadata, c.labels, COALESCE(c.domain_id, '') AS domain_id,  COALESCE(parent_group_id, '') AS parent_group_id, c.status,
+					c.created_at, c.updated_at, COALESCE(c.updated_by, '') AS updated_by, c.version FROM clients c %s ORDER BY c.created_at`, query)
 
 	dbPage, err := ToDBClientsPage(pm)
 	if err != nil {
@@ -776,8 +946,8 @@ func (repo *clientRepo) RemoveClientConnections(ctx context.Context, clientID st
 }
 
 func (repo *clientRepo) RetrieveParentGroupClients(ctx context.Context, parentGroupID string) ([]clients.Client, error) {
-	query := `SELECT c.id, c.name, c.tags,  c.metadata, COALESCE(c.domain_id, '') AS domain_id, COALESCE(parent_group_id, '') AS parent_group_id, c.status,
-					c.created_at, c.updated_at, COALESCE(c.updated_by, '') AS updated_by FROM clients c WHERE c.parent_group_id = :parent_group_id ;`
+	query := `SELECT c.id, c.name, c.tags,  c.metadata, c.labels, COALESCE(c.domain_id, '') AS domain_id, COALESCE(parent_group_id, '') AS parent_group_id, c.status,
+					c.created_at, c.updated_at, COALESCE(c.updated_by, '') AS updated_by, c.version FROM clients c WHERE c.parent_group_id = :parent_group_id ;`
 
 	rows, err := repo.DB.NamedQueryContext(ctx, query, DBClient{ParentGroup: toNullString(parentGroupID)})
 	if err != nil {
@@ -811,6 +981,82 @@ func (repo *clientRepo) UnsetParentGroupFromClient(ctx context.Context, parentGr
 	return nil
 }
 
+func (repo *clientRepo) AddRelation(ctx context.Context, rel clients.ClientRelation) error {
+	q := `INSERT INTO client_relations (domain_id, subject_id, relation, object_id, created_at)
+			VALUES (:domain_id, :subject_id, :relation, :object_id, :created_at);`
+	if _, err := repo.DB.NamedExecContext(ctx, q, toDBRelation(rel)); err != nil {
+		return postgres.HandleError(repoerr.ErrCreateEntity, err)
+	}
+
+	return nil
+}
+
+func (repo *clientRepo) RemoveRelation(ctx context.Context, rel clients.ClientRelation) error {
+	q := `DELETE FROM client_relations WHERE domain_id = :domain_id AND subject_id = :subject_id AND relation = :relation AND object_id = :object_id`
+
+	result, err := repo.DB.NamedExecContext(ctx, q, toDBRelation(rel))
+	if err != nil {
+		return postgres.HandleError(repoerr.ErrRemoveEntity, err)
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return repoerr.ErrNotFound
+	}
+	return nil
+}
+
+func (repo *clientRepo) RetrieveRelated(ctx context.Context, domainID, clientID, relation string, depth uint64) ([]clients.RelatedClient, error) {
+	relFilter := ""
+	if relation != "" {
+		relFilter = "AND cr.relation = :relation"
+	}
+	q := fmt.Sprintf(`WITH RECURSIVE related AS (
+			SELECT cr.object_id, cr.relation, 1 AS depth
+			FROM client_relations cr
+			WHERE cr.domain_id = :domain_id AND cr.subject_id = :subject_id %s
+			UNION
+			SELECT cr.object_id, cr.relation, r.depth + 1
+			FROM client_relations cr
+			JOIN related r ON cr.subject_id = r.object_id
+			WHERE cr.domain_id = :domain_id AND r.depth < :depth %s
+		)
+		SELECT c.id, c.name, c.tags, c.metadata, c.labels, COALESCE(c.domain_id, '') AS domain_id, COALESCE(c.parent_group_id, '') AS parent_group_id, c.status,
+			c.created_at, c.updated_at, COALESCE(c.updated_by, '') AS updated_by, c.version, r.relation, r.depth
+		FROM related r JOIN clients c ON c.id = r.object_id
+		ORDER BY r.depth, c.id`, relFilter, relFilter)
+
+	params := map[string]interface{}{
+		"domain_id":  domainID,
+		"subject_id": clientID,
+		"relation":   relation,
+		"depth":      depth,
+	}
+	rows, err := repo.DB.NamedQueryContext(ctx, q, params)
+	if err != nil {
+		return []clients.RelatedClient{}, postgres.HandleError(repoerr.ErrViewEntity, err)
+	}
+	defer rows.Close()
+
+	var related []clients.RelatedClient
+	for rows.Next() {
+		dbr := dbRelatedClient{}
+		if err := rows.StructScan(&dbr); err != nil {
+			return []clients.RelatedClient{}, errors.Wrap(repoerr.ErrViewEntity, err)
+		}
+
+		cli, err := ToClient(dbr.DBClient)
+		if err != nil {
+			return []clients.RelatedClient{}, err
+		}
+
+		related = append(related, clients.RelatedClient{
+			Client:   cli,
+			Relation: dbr.Relation,
+			Depth:    dbr.Depth,
+		})
+	}
+	return related, nil
+}
+
 type dbConnection struct {
 	ClientID  string               `db:"client_id"`
 	ChannelID string               `db:"channel_id"`
@@ -818,6 +1064,30 @@ type dbConnection struct {
 	Type      connections.ConnType `db:"type"`
 }
 
+type dbRelation struct {
+	DomainID  string    `db:"domain_id"`
+	SubjectID string    `db:"subject_id"`
+	Relation  string    `db:"relation"`
+	ObjectID  string    `db:"object_id"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+func toDBRelation(rel clients.ClientRelation) dbRelation {
+	return dbRelation{
+		DomainID:  rel.DomainID,
+		SubjectID: rel.SubjectID,
+		Relation:  rel.Relation,
+		ObjectID:  rel.ObjectID,
+		CreatedAt: rel.CreatedAt,
+	}
+}
+
+type dbRelatedClient struct {
+	DBClient
+	Relation string `db:"relation"`
+	Depth    uint64 `db:"depth"`
+}
+
 func toDBConnections(conns []clients.Connection) []dbConnection {
 	var dbconns []dbConnection
 	for _, conn := range conns {
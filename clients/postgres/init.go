@@ -6,6 +6,8 @@ package postgres
 import (
 	"github.com/absmach/supermq/pkg/errors"
 	repoerr "github.com/absmach/supermq/pkg/errors/repository"
+	outboxPostgres "github.com/absmach/supermq/pkg/outbox/postgres"
+	"github.com/absmach/supermq/pkg/postgres"
 	rolesPostgres "github.com/absmach/supermq/pkg/roles/repo/postgres"
 	_ "github.com/jackc/pgx/v5/stdlib" // required for SQL access
 	migrate "github.com/rubenv/sql-migrate"
@@ -55,10 +57,80 @@ func Migration() (*migrate.MemoryMigrationSource, error) {
 					`DROP TABLE IF EXISTS connections`,
 				},
 			},
+			{
+				Id: "clients_02",
+				Up: []string{
+					`ALTER TABLE clients ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1`,
+				},
+				Down: []string{
+					`ALTER TABLE clients DROP COLUMN IF EXISTS version`,
+				},
+			},
+			{
+				Id: "clients_03",
+				Up: []string{
+					`ALTER TABLE clients ADD COLUMN IF NOT EXISTS external_id VARCHAR(254) DEFAULT NULL`,
+					`CREATE UNIQUE INDEX IF NOT EXISTS clients_external_id_idx ON clients (domain_id, external_id)`,
+				},
+				Down: []string{
+					`DROP INDEX IF EXISTS clients_external_id_idx`,
+					`ALTER TABLE clients DROP COLUMN IF EXISTS external_id`,
+				},
+			},
+			{
+				Id: "clients_04",
+				Up: []string{
+					`ALTER TABLE clients ADD COLUMN IF NOT EXISTS labels JSONB`,
+				},
+				Down: []string{
+					`ALTER TABLE clients DROP COLUMN IF EXISTS labels`,
+				},
+			},
+			{
+				Id: "clients_05",
+				Up: []string{
+					`ALTER TABLE clients ADD COLUMN IF NOT EXISTS claim_code VARCHAR(254) DEFAULT NULL`,
+					`CREATE UNIQUE INDEX IF NOT EXISTS clients_claim_code_idx ON clients (domain_id, claim_code)`,
+				},
+				Down: []string{
+					`DROP INDEX IF EXISTS clients_claim_code_idx`,
+					`ALTER TABLE clients DROP COLUMN IF EXISTS claim_code`,
+				},
+			},
+			{
+				Id: "clients_06",
+				Up: []string{
+					`CREATE TABLE IF NOT EXISTS client_relations (
+						domain_id     VARCHAR(36) NOT NULL,
+						subject_id    VARCHAR(36) NOT NULL,
+						relation      VARCHAR(254) NOT NULL,
+						object_id     VARCHAR(36) NOT NULL,
+						created_at    TIMESTAMP,
+						FOREIGN KEY   (subject_id, domain_id) REFERENCES clients (id, domain_id) ON DELETE CASCADE ON UPDATE CASCADE,
+						FOREIGN KEY   (object_id, domain_id) REFERENCES clients (id, domain_id) ON DELETE CASCADE ON UPDATE CASCADE,
+						PRIMARY KEY   (domain_id, subject_id, relation, object_id)
+					)`,
+					`CREATE INDEX IF NOT EXISTS client_relations_object_idx ON client_relations (domain_id, object_id)`,
+				},
+				Down: []string{
+					`DROP INDEX IF EXISTS client_relations_object_idx`,
+					`DROP TABLE IF EXISTS client_relations`,
+				},
+			},
+			{
+				Id: "clients_07",
+				Up: postgres.RLSPolicy("clients", "domain_id", postgres.DomainGUC),
+				Down: []string{
+					`DROP POLICY IF EXISTS clients_owner ON clients`,
+					`ALTER TABLE clients NO FORCE ROW LEVEL SECURITY`,
+					`ALTER TABLE clients DISABLE ROW LEVEL SECURITY`,
+				},
+			},
 		},
 	}
 
 	clientsMigration.Migrations = append(clientsMigration.Migrations, clientsRolesMigration.Migrations...)
+	clientsMigration.Migrations = append(clientsMigration.Migrations, outboxPostgres.Migration().Migrations...)
 
 	return clientsMigration, nil
 }
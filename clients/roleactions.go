@@ -20,7 +20,9 @@ const (
 )
 
 const (
-	ClientBuiltInRoleAdmin = "admin"
+	ClientBuiltInRoleAdmin  = "admin"
+	ClientBuiltInRoleEditor = "editor"
+	ClientBuiltInRoleViewer = "viewer"
 )
 
 func AvailableActions() []roles.Action {
@@ -39,6 +41,8 @@ func AvailableActions() []roles.Action {
 
 func BuiltInRoles() map[roles.BuiltInRoleName][]roles.Action {
 	return map[roles.BuiltInRoleName][]roles.Action{
-		ClientBuiltInRoleAdmin: AvailableActions(),
+		ClientBuiltInRoleAdmin:  AvailableActions(),
+		ClientBuiltInRoleEditor: {ClientUpdate, ClientRead, ClientConnectToChannel},
+		ClientBuiltInRoleViewer: {ClientRead},
 	}
 }
@@ -22,6 +22,11 @@ const (
 	OpRemoveParentGroup
 	OpConnectToChannel
 	OpDisconnectFromChannel
+	OpShareClient
+	OpListShares
+	OpAddClientRelation
+	OpRemoveClientRelation
+	OpListRelatedClients
 )
 
 var expectedOperations = []svcutil.Operation{
@@ -36,6 +41,11 @@ var expectedOperations = []svcutil.Operation{
 	OpRemoveParentGroup,
 	OpConnectToChannel,
 	OpDisconnectFromChannel,
+	OpShareClient,
+	OpListShares,
+	OpAddClientRelation,
+	OpRemoveClientRelation,
+	OpListRelatedClients,
 }
 
 var operationNames = []string{
@@ -50,6 +60,11 @@ var operationNames = []string{
 	"OpRemoveParentGroup",
 	"OpConnectToChannel",
 	"OpDisconnectFromChannel",
+	"OpShareClient",
+	"OpListShares",
+	"OpAddClientRelation",
+	"OpRemoveClientRelation",
+	"OpListRelatedClients",
 }
 
 func NewOperationPerm() svcutil.OperationPerm {
@@ -60,6 +75,11 @@ func NewOperationPerm() svcutil.OperationPerm {
 const (
 	DomainOpCreateClient svcutil.ExternalOperation = iota
 	DomainOpListClients
+	DomainOpListTags
+	DomainOpRenameTag
+	DomainOpListTrash
+	DomainOpRestoreClient
+	DomainOpClaimClient
 	GroupOpSetChildClient
 	GroupsOpRemoveChildClient
 	ChannelsOpConnectChannel
@@ -69,6 +89,11 @@ const (
 var expectedExternalOperations = []svcutil.ExternalOperation{
 	DomainOpCreateClient,
 	DomainOpListClients,
+	DomainOpListTags,
+	DomainOpRenameTag,
+	DomainOpListTrash,
+	DomainOpRestoreClient,
+	DomainOpClaimClient,
 	GroupOpSetChildClient,
 	GroupsOpRemoveChildClient,
 	ChannelsOpConnectChannel,
@@ -78,6 +103,11 @@ var expectedExternalOperations = []svcutil.ExternalOperation{
 var externalOperationNames = []string{
 	"DomainOpCreateClient",
 	"DomainOpListClients",
+	"DomainOpListTags",
+	"DomainOpRenameTag",
+	"DomainOpListTrash",
+	"DomainOpRestoreClient",
+	"DomainOpClaimClient",
 	"GroupOpSetChildClient",
 	"GroupsOpRemoveChildClient",
 	"ChannelsOpConnectChannel",
@@ -116,6 +146,11 @@ func NewOperationPermissionMap() map[svcutil.Operation]svcutil.Permission {
 		OpRemoveParentGroup:     setParentGroupPermission,
 		OpConnectToChannel:      connectToChannelPermission,
 		OpDisconnectFromChannel: connectToChannelPermission,
+		OpShareClient:           addRoleUsersPermission,
+		OpListShares:            viewRoleUsersPermission,
+		OpAddClientRelation:     updatePermission,
+		OpRemoveClientRelation:  updatePermission,
+		OpListRelatedClients:    readPermission,
 	}
 	return opPerm
 }
@@ -145,6 +180,10 @@ const (
 	// External Permission for domains.
 	domainCreateClientPermission = "client_create_permission"
 	domainListClientsPermission  = "list_clients_permission"
+	domainRenameTagPermission    = "client_create_permission"
+	// Claiming only requires domain membership, since the claimer owns no
+	// client in the domain yet.
+	domainClaimClientPermission = "membership"
 	// External Permission for groups.
 	groupSetChildClientPermission    = "client_create_permission"
 	groupRemoveChildClientPermission = "client_create_permission"
@@ -157,6 +196,11 @@ func NewExternalOperationPermissionMap() map[svcutil.ExternalOperation]svcutil.P
 	extOpPerm := map[svcutil.ExternalOperation]svcutil.Permission{
 		DomainOpCreateClient:        domainCreateClientPermission,
 		DomainOpListClients:         domainListClientsPermission,
+		DomainOpListTags:            domainListClientsPermission,
+		DomainOpRenameTag:           domainRenameTagPermission,
+		DomainOpListTrash:           domainListClientsPermission,
+		DomainOpRestoreClient:       domainCreateClientPermission,
+		DomainOpClaimClient:         domainClaimClientPermission,
 		GroupOpSetChildClient:       groupSetChildClientPermission,
 		GroupsOpRemoveChildClient:   groupRemoveChildClientPermission,
 		ChannelsOpConnectChannel:    channelsConnectClientPermission,
@@ -16,34 +16,79 @@ import (
 	"github.com/absmach/supermq/pkg/authn"
 	"github.com/absmach/supermq/pkg/errors"
 	svcerr "github.com/absmach/supermq/pkg/errors/service"
+	"github.com/absmach/supermq/pkg/outbox"
 	"github.com/absmach/supermq/pkg/policies"
 	"github.com/absmach/supermq/pkg/roles"
 	"golang.org/x/sync/errgroup"
 )
 
 var (
-	errRollbackRepo   = errors.New("failed to rollback repo")
-	errSetParentGroup = errors.New("client already have parent")
+	errRollbackRepo    = errors.New("failed to rollback repo")
+	errSetParentGroup  = errors.New("client already have parent")
+	errUnknownRelation = errors.New("no client role matches the relation")
+	errTagsAdminOnly   = errors.New("tag management is restricted to admins")
+	errNotInTrash      = errors.New("client is not in the trash")
+	errSelfRelation    = errors.New("client cannot relate to itself")
 )
+
+// maxRolesLimit bounds role listing when resolving a role by its name.
+const maxRolesLimit = 100
+
 var _ Service = (*service)(nil)
 
 type service struct {
-	repo       Repository
-	policy     policies.Service
-	channels   grpcChannelsV1.ChannelsServiceClient
-	groups     grpcGroupsV1.GroupsServiceClient
-	cache      Cache
-	idProvider smq.IDProvider
+	repo              Repository
+	policy            policies.Service
+	channels          grpcChannelsV1.ChannelsServiceClient
+	groups            grpcGroupsV1.GroupsServiceClient
+	cache             Cache
+	idProvider        smq.IDProvider
+	outbox            *outbox.Coordinator
+	metadataValidator MetadataValidator
+	adminOnlyTags     bool
 	roles.ProvisionManageService
 }
 
+// MetadataValidator validates entity metadata against the schema
+// registered for the owner.
+type MetadataValidator interface {
+	Validate(ctx context.Context, ownerID string, metadata map[string]interface{}) error
+}
+
+// Option is a functional option for the Clients service.
+type Option func(*service)
+
+// WithOutbox configures the service to record pending policy operations
+// in the given outbox coordinator, so that clients left without policies
+// by a failure are retried or compensated.
+func WithOutbox(ob *outbox.Coordinator) Option {
+	return func(svc *service) {
+		svc.outbox = ob
+	}
+}
+
+// WithMetadataValidator configures the service to validate client metadata
+// on create and update against the schema registered for the domain.
+func WithMetadataValidator(mv MetadataValidator) Option {
+	return func(svc *service) {
+		svc.metadataValidator = mv
+	}
+}
+
+// WithAdminOnlyTags restricts assigning and managing client tags to admins.
+func WithAdminOnlyTags() Option {
+	return func(svc *service) {
+		svc.adminOnlyTags = true
+	}
+}
+
 // NewService returns a new Clients service implementation.
-func NewService(repo Repository, policy policies.Service, cache Cache, channels grpcChannelsV1.ChannelsServiceClient, groups grpcGroupsV1.GroupsServiceClient, idProvider smq.IDProvider, sIDProvider smq.IDProvider) (Service, error) {
+func NewService(repo Repository, policy policies.Service, cache Cache, channels grpcChannelsV1.ChannelsServiceClient, groups grpcGroupsV1.GroupsServiceClient, idProvider smq.IDProvider, sIDProvider smq.IDProvider, opts ...Option) (Service, error) {
 	rpms, err := roles.NewProvisionManageService(policies.ClientType, repo, policy, sIDProvider, AvailableActions(), BuiltInRoles())
 	if err != nil {
 		return service{}, err
 	}
-	return service{
+	svc := service{
 		repo:                   repo,
 		policy:                 policy,
 		channels:               channels,
@@ -51,7 +96,11 @@ func NewService(repo Repository, policy policies.Service, cache Cache, channels
 		cache:                  cache,
 		idProvider:             idProvider,
 		ProvisionManageService: rpms,
-	}, nil
+	}
+	for _, opt := range opts {
+		opt(&svc)
+	}
+	return svc, nil
 }
 
 func (svc service) CreateClients(ctx context.Context, session authn.Session, cls ...Client) (retClients []Client, retRps []roles.RoleProvision, retErr error) {
@@ -75,6 +124,16 @@ func (svc service) CreateClients(ctx context.Context, session authn.Session, cls
 			return []Client{}, []roles.RoleProvision{}, svcerr.ErrInvalidStatus
 		}
 		c.Domain = session.DomainID
+		if svc.metadataValidator != nil {
+			if err := svc.metadataValidator.Validate(ctx, c.Domain, c.Metadata); err != nil {
+				return []Client{}, []roles.RoleProvision{}, errors.Wrap(svcerr.ErrMalformedEntity, err)
+			}
+		}
+		if len(c.Tags) > 0 {
+			if err := svc.checkTagsAllowed(session); err != nil {
+				return []Client{}, []roles.RoleProvision{}, err
+			}
+		}
 		c.CreatedAt = time.Now()
 		clients = append(clients, c)
 	}
@@ -88,17 +147,23 @@ func (svc service) CreateClients(ctx context.Context, session authn.Session, cls
 		newClientIDs = append(newClientIDs, newClient.ID)
 	}
 
+	ops := []outbox.Operation{}
+
 	defer func() {
 		if retErr != nil {
 			if errRollBack := svc.repo.Delete(ctx, newClientIDs...); errRollBack != nil {
 				retErr = errors.Wrap(retErr, errors.Wrap(errRollbackRepo, errRollBack))
+				return
+			}
+			for _, op := range ops {
+				if errCancel := svc.outbox.Cancel(ctx, op); errCancel != nil {
+					retErr = errors.Wrap(retErr, errCancel)
+				}
 			}
 		}
 	}()
 
-	newBuiltInRoleMembers := map[roles.BuiltInRoleName][]roles.Member{
-		ClientBuiltInRoleAdmin: {roles.Member(session.UserID)},
-	}
+	newBuiltInRoleMembers := svc.DefaultRoleMembers(ClientBuiltInRoleAdmin, []roles.Member{roles.Member(session.UserID)})
 
 	optionalPolicies := []policies.Policy{}
 
@@ -115,11 +180,27 @@ func (svc service) CreateClients(ctx context.Context, session authn.Session, cls
 		)
 	}
 
+	if svc.outbox != nil {
+		for i, newClientID := range newClientIDs {
+			op, err := svc.outbox.Record(ctx, policies.ClientType, newClientID, session.DomainID, optionalPolicies[i:i+1])
+			if err != nil {
+				return []Client{}, []roles.RoleProvision{}, errors.Wrap(svcerr.ErrCreateEntity, err)
+			}
+			ops = append(ops, op)
+		}
+	}
+
 	nrps, err := svc.AddNewEntitiesRoles(ctx, session.DomainID, session.UserID, newClientIDs, optionalPolicies, newBuiltInRoleMembers)
 	if err != nil {
 		return []Client{}, []roles.RoleProvision{}, errors.Wrap(svcerr.ErrAddPolicies, err)
 	}
 
+	for _, op := range ops {
+		if err := svc.outbox.Complete(ctx, op); err != nil {
+			return []Client{}, []roles.RoleProvision{}, errors.Wrap(svcerr.ErrCreateEntity, err)
+		}
+	}
+
 	return newClients, nrps, nil
 }
 
@@ -131,18 +212,42 @@ func (svc service) View(ctx context.Context, session authn.Session, id string) (
 	return client, nil
 }
 
+func (svc service) ViewByExternalID(ctx context.Context, session authn.Session, externalID string) (Client, error) {
+	client, err := svc.repo.RetrieveByExternalID(ctx, session.DomainID, externalID)
+	if err != nil {
+		return Client{}, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+	return client, nil
+}
+
 func (svc service) ListClients(ctx context.Context, session authn.Session, reqUserID string, pm Page) (ClientsPage, error) {
 	var ids []string
 	var err error
 	switch {
 	case (reqUserID != "" && reqUserID != session.UserID):
-		rtids, err := svc.listClientIDs(ctx, smqauth.EncodeDomainUserID(session.DomainID, reqUserID), pm.Permission)
-		if err != nil {
+		// The requested user and the session user listings are independent,
+		// so they are fetched concurrently before being intersected.
+		var rtids, atids []string
+		g, gctx := errgroup.WithContext(ctx)
+		g.Go(func() error {
+			var err error
+			rtids, err = svc.listClientIDs(gctx, smqauth.EncodeDomainUserID(session.DomainID, reqUserID), pm.Permission)
+			return err
+		})
+		g.Go(func() error {
+			var err error
+			atids, err = svc.listClientIDs(gctx, session.DomainUserID, pm.Permission)
+			return err
+		})
+		if err := g.Wait(); err != nil {
 			return ClientsPage{}, errors.Wrap(svcerr.ErrNotFound, err)
 		}
-		ids, err = svc.filterAllowedClientIDs(ctx, session.DomainUserID, pm.Permission, rtids)
-		if err != nil {
-			return ClientsPage{}, errors.Wrap(svcerr.ErrNotFound, err)
+		for _, rtid := range rtids {
+			for _, atid := range atids {
+				if rtid == atid {
+					ids = append(ids, rtid)
+				}
+			}
 		}
 	default:
 		switch session.SuperAdmin {
@@ -219,32 +324,17 @@ func (svc service) listClientIDs(ctx context.Context, userID, permission string)
 	return tids.Policies, nil
 }
 
-func (svc service) filterAllowedClientIDs(ctx context.Context, userID, permission string, clientIDs []string) ([]string, error) {
-	var ids []string
-	tids, err := svc.policy.ListAllObjects(ctx, policies.Policy{
-		SubjectType: policies.UserType,
-		Subject:     userID,
-		Permission:  permission,
-		ObjectType:  policies.ClientType,
-	})
-	if err != nil {
-		return nil, errors.Wrap(svcerr.ErrNotFound, err)
-	}
-	for _, clientID := range clientIDs {
-		for _, tid := range tids.Policies {
-			if clientID == tid {
-				ids = append(ids, clientID)
-			}
+func (svc service) Update(ctx context.Context, session authn.Session, cli Client) (Client, error) {
+	if svc.metadataValidator != nil {
+		if err := svc.metadataValidator.Validate(ctx, session.DomainID, cli.Metadata); err != nil {
+			return Client{}, errors.Wrap(svcerr.ErrMalformedEntity, err)
 		}
 	}
-	return ids, nil
-}
-
-func (svc service) Update(ctx context.Context, session authn.Session, cli Client) (Client, error) {
 	client := Client{
 		ID:        cli.ID,
 		Name:      cli.Name,
 		Metadata:  cli.Metadata,
+		Labels:    cli.Labels,
 		UpdatedAt: time.Now(),
 		UpdatedBy: session.UserID,
 	}
@@ -256,6 +346,11 @@ func (svc service) Update(ctx context.Context, session authn.Session, cli Client
 }
 
 func (svc service) UpdateTags(ctx context.Context, session authn.Session, cli Client) (Client, error) {
+	if len(cli.Tags) > 0 {
+		if err := svc.checkTagsAllowed(session); err != nil {
+			return Client{}, err
+		}
+	}
 	client := Client{
 		ID:        cli.ID,
 		Tags:      cli.Tags,
@@ -428,34 +523,141 @@ func (svc service) Delete(ctx context.Context, session authn.Session, id string)
 		return errors.Wrap(svcerr.ErrRemoveEntity, err)
 	}
 
-	filterDeletePolicies := []policies.Policy{
-		{
-			SubjectType: policies.ClientType,
-			Subject:     id,
-		},
-		{
-			ObjectType: policies.ClientType,
-			Object:     id,
-		},
+	return nil
+}
+
+func (svc service) ListTrash(ctx context.Context, session authn.Session, pm Page) (ClientsPage, error) {
+	pm.Status = DeletedStatus
+	pm.Domain = session.DomainID
+
+	cp, err := svc.repo.RetrieveAll(ctx, pm)
+	if err != nil {
+		return ClientsPage{}, errors.Wrap(svcerr.ErrViewEntity, err)
 	}
-	deletePolicies := []policies.Policy{
-		{
-			SubjectType: policies.DomainType,
-			Subject:     session.DomainID,
-			Relation:    policies.DomainRelation,
-			ObjectType:  policies.ClientType,
-			Object:      id,
-		},
+	return cp, nil
+}
+
+func (svc service) Restore(ctx context.Context, session authn.Session, id string) (Client, error) {
+	dbClient, err := svc.repo.RetrieveByID(ctx, id)
+	if err != nil {
+		return Client{}, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+	if dbClient.Status != DeletedStatus {
+		return Client{}, errors.Wrap(svcerr.ErrUpdateEntity, errNotInTrash)
+	}
+
+	client := Client{
+		ID:        id,
+		Status:    EnabledStatus,
+		UpdatedAt: time.Now(),
+		UpdatedBy: session.UserID,
+	}
+	client, err = svc.repo.ChangeStatus(ctx, client)
+	if err != nil {
+		return Client{}, errors.Wrap(svcerr.ErrUpdateEntity, err)
+	}
+	return client, nil
+}
+
+func (svc service) Share(ctx context.Context, session authn.Session, id, relation string, userIDs ...string) error {
+	role, err := svc.retrieveRoleByName(ctx, session, id, relation)
+	if err != nil {
+		return err
+	}
+	if _, err := svc.RoleAddMembers(ctx, session, id, role.ID, userIDs); err != nil {
+		return errors.Wrap(svcerr.ErrUpdateEntity, err)
+	}
+	return nil
+}
+
+func (svc service) ListShares(ctx context.Context, session authn.Session, id string, limit, offset uint64) (roles.EntityMembersPage, error) {
+	return svc.ListEntityMembers(ctx, session, id, limit, offset)
+}
+
+func (svc service) Claim(ctx context.Context, session authn.Session, claimCode string) (Client, error) {
+	client, err := svc.repo.Claim(ctx, session.DomainID, claimCode)
+	if err != nil {
+		return Client{}, errors.Wrap(svcerr.ErrUpdateEntity, err)
+	}
+	role, err := svc.retrieveRoleByName(ctx, session, client.ID, string(ClientBuiltInRoleAdmin))
+	if err != nil {
+		return Client{}, err
+	}
+	if _, err := svc.RoleAddMembers(ctx, session, client.ID, role.ID, []string{session.UserID}); err != nil {
+		return Client{}, errors.Wrap(svcerr.ErrUpdateEntity, err)
+	}
+	return client, nil
+}
+
+func (svc service) retrieveRoleByName(ctx context.Context, session authn.Session, id, name string) (roles.Role, error) {
+	rp, err := svc.RetrieveAllRoles(ctx, session, id, maxRolesLimit, 0)
+	if err != nil {
+		return roles.Role{}, errors.Wrap(svcerr.ErrViewEntity, err)
 	}
+	for _, r := range rp.Roles {
+		if r.Name == name {
+			return r, nil
+		}
+	}
+	return roles.Role{}, errors.Wrap(svcerr.ErrMalformedEntity, errUnknownRelation)
+}
 
-	if err := svc.RemoveEntitiesRoles(ctx, session.DomainID, session.DomainUserID, []string{id}, filterDeletePolicies, deletePolicies); err != nil {
-		return errors.Wrap(svcerr.ErrDeletePolicies, err)
+func (svc service) AddRelation(ctx context.Context, session authn.Session, rel ClientRelation) error {
+	if rel.SubjectID == rel.ObjectID {
+		return errors.Wrap(svcerr.ErrMalformedEntity, errSelfRelation)
 	}
+	rel.DomainID = session.DomainID
+	rel.CreatedAt = time.Now()
+	if err := svc.repo.AddRelation(ctx, rel); err != nil {
+		return errors.Wrap(svcerr.ErrCreateEntity, err)
+	}
+	return nil
+}
 
-	if err := svc.repo.Delete(ctx, id); err != nil {
+func (svc service) RemoveRelation(ctx context.Context, session authn.Session, rel ClientRelation) error {
+	rel.DomainID = session.DomainID
+	if err := svc.repo.RemoveRelation(ctx, rel); err != nil {
 		return errors.Wrap(svcerr.ErrRemoveEntity, err)
 	}
+	return nil
+}
 
+func (svc service) ListRelated(ctx context.Context, session authn.Session, id, relation string, depth uint64) (RelatedClientsPage, error) {
+	related, err := svc.repo.RetrieveRelated(ctx, session.DomainID, id, relation, depth)
+	if err != nil {
+		return RelatedClientsPage{}, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+	return RelatedClientsPage{
+		Total:   uint64(len(related)),
+		Clients: related,
+	}, nil
+}
+
+func (svc service) ListTags(ctx context.Context, session authn.Session, offset, limit uint64) (TagsPage, error) {
+	tp, err := svc.repo.RetrieveAllTags(ctx, session.DomainID, offset, limit)
+	if err != nil {
+		return TagsPage{}, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+	return tp, nil
+}
+
+func (svc service) RenameTag(ctx context.Context, session authn.Session, oldTag, newTag string) (uint64, error) {
+	if err := svc.checkTagsAllowed(session); err != nil {
+		return 0, err
+	}
+	renamed, err := svc.repo.RenameTag(ctx, session.DomainID, oldTag, newTag)
+	if err != nil {
+		return 0, errors.Wrap(svcerr.ErrUpdateEntity, err)
+	}
+	return renamed, nil
+}
+
+// checkTagsAllowed rejects tag changes for non-admins when the service is
+// configured with admin-only tag management.
+func (svc service) checkTagsAllowed(session authn.Session) error {
+	if svc.adminOnlyTags && !session.SuperAdmin {
+		return errors.Wrap(svcerr.ErrAuthorization, errTagsAdminOnly)
+	}
 	return nil
 }
 
@@ -345,6 +345,200 @@ func TestViewClient(t *testing.T) {
 	}
 }
 
+func TestViewClientByExternalID(t *testing.T) {
+	svc := newService()
+
+	externalClient := client
+	externalClient.ExternalID = "asset-001"
+
+	cases := []struct {
+		desc        string
+		externalID  string
+		response    clients.Client
+		retrieveErr error
+		err         error
+	}{
+		{
+			desc:       "view client with existing external id",
+			externalID: externalClient.ExternalID,
+			response:   externalClient,
+			err:        nil,
+		},
+		{
+			desc:        "view client with non-existing external id",
+			externalID:  "asset-002",
+			response:    clients.Client{},
+			retrieveErr: repoerr.ErrNotFound,
+			err:         svcerr.ErrViewEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		repoCall1 := repo.On("RetrieveByExternalID", context.Background(), mock.Anything, tc.externalID).Return(tc.response, tc.retrieveErr)
+		rClient, err := svc.ViewByExternalID(context.Background(), smqauthn.Session{}, tc.externalID)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		assert.Equal(t, tc.response, rClient, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.response, rClient))
+		repoCall1.Unset()
+	}
+}
+
+func TestClaim(t *testing.T) {
+	svc := newService()
+
+	claimedClient := client
+	claimedClient.Domain = testsutil.GenerateUUID(t)
+	userID := testsutil.GenerateUUID(t)
+	session := smqauthn.Session{UserID: userID, DomainID: claimedClient.Domain}
+	adminRole := roles.Role{ID: testsutil.GenerateUUID(t), Name: "admin"}
+
+	cases := []struct {
+		desc          string
+		claimCode     string
+		claimRes      clients.Client
+		claimErr      error
+		listRolesRes  roles.RolePage
+		listRolesErr  error
+		addMembersErr error
+		response      clients.Client
+		err           error
+	}{
+		{
+			desc:         "claim client with valid code",
+			claimCode:    "claim-code-1",
+			claimRes:     claimedClient,
+			listRolesRes: roles.RolePage{Roles: []roles.Role{adminRole}},
+			response:     claimedClient,
+			err:          nil,
+		},
+		{
+			desc:      "claim client with unknown or used code",
+			claimCode: "claim-code-2",
+			claimErr:  repoerr.ErrNotFound,
+			err:       svcerr.ErrUpdateEntity,
+		},
+		{
+			desc:      "claim client without admin role",
+			claimCode: "claim-code-1",
+			claimRes:  claimedClient,
+			err:       svcerr.ErrMalformedEntity,
+		},
+		{
+			desc:          "claim client with failed role member addition",
+			claimCode:     "claim-code-1",
+			claimRes:      claimedClient,
+			listRolesRes:  roles.RolePage{Roles: []roles.Role{adminRole}},
+			addMembersErr: svcerr.ErrCreateEntity,
+			err:           svcerr.ErrUpdateEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		repoCall := repo.On("Claim", context.Background(), session.DomainID, tc.claimCode).Return(tc.claimRes, tc.claimErr)
+		repoCall1 := repo.On("RetrieveAllRoles", context.Background(), mock.Anything, mock.Anything, mock.Anything).Return(tc.listRolesRes, tc.listRolesErr)
+		repoCall2 := repo.On("RetrieveEntityRole", context.Background(), mock.Anything, adminRole.ID).Return(adminRole, nil)
+		policyCall := pService.On("AddPolicies", mock.Anything, mock.Anything).Return(nil)
+		policyCall1 := pService.On("DeletePolicies", mock.Anything, mock.Anything).Return(nil)
+		repoCall3 := repo.On("RoleAddMembers", context.Background(), mock.Anything, []string{userID}).Return([]string{userID}, tc.addMembersErr)
+		rClient, err := svc.Claim(context.Background(), session, tc.claimCode)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		assert.Equal(t, tc.response, rClient, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.response, rClient))
+		repoCall.Unset()
+		repoCall1.Unset()
+		repoCall2.Unset()
+		policyCall.Unset()
+		policyCall1.Unset()
+		repoCall3.Unset()
+	}
+}
+
+func TestAddRelation(t *testing.T) {
+	svc := newService()
+
+	domainID := testsutil.GenerateUUID(t)
+	session := smqauthn.Session{UserID: validID, DomainID: domainID}
+	rel := clients.ClientRelation{
+		SubjectID: testsutil.GenerateUUID(t),
+		Relation:  "contains",
+		ObjectID:  testsutil.GenerateUUID(t),
+	}
+
+	cases := []struct {
+		desc    string
+		rel     clients.ClientRelation
+		repoErr error
+		err     error
+	}{
+		{
+			desc: "add relation successfully",
+			rel:  rel,
+		},
+		{
+			desc: "add relation to itself",
+			rel: clients.ClientRelation{
+				SubjectID: rel.SubjectID,
+				Relation:  "contains",
+				ObjectID:  rel.SubjectID,
+			},
+			err: svcerr.ErrMalformedEntity,
+		},
+		{
+			desc:    "add relation with repo error",
+			rel:     rel,
+			repoErr: repoerr.ErrConflict,
+			err:     svcerr.ErrCreateEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		repoCall := repo.On("AddRelation", context.Background(), mock.Anything).Return(tc.repoErr)
+		err := svc.AddRelation(context.Background(), session, tc.rel)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		repoCall.Unset()
+	}
+}
+
+func TestListRelated(t *testing.T) {
+	svc := newService()
+
+	domainID := testsutil.GenerateUUID(t)
+	session := smqauthn.Session{UserID: validID, DomainID: domainID}
+	related := []clients.RelatedClient{
+		{Client: client, Relation: "contains", Depth: 1},
+	}
+
+	cases := []struct {
+		desc     string
+		related  []clients.RelatedClient
+		repoErr  error
+		response clients.RelatedClientsPage
+		err      error
+	}{
+		{
+			desc:    "list related clients successfully",
+			related: related,
+			response: clients.RelatedClientsPage{
+				Total:   1,
+				Clients: related,
+			},
+		},
+		{
+			desc:    "list related clients with repo error",
+			repoErr: repoerr.ErrViewEntity,
+			err:     svcerr.ErrViewEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		repoCall := repo.On("RetrieveRelated", context.Background(), domainID, client.ID, "contains", uint64(2)).Return(tc.related, tc.repoErr)
+		page, err := svc.ListRelated(context.Background(), session, client.ID, "contains", 2)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		if tc.err == nil {
+			assert.Equal(t, tc.response, page, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.response, page))
+		}
+		repoCall.Unset()
+	}
+}
+
 func TestListClients(t *testing.T) {
 	svc := newService()
 
@@ -897,6 +1091,106 @@ func TestDisable(t *testing.T) {
 	}
 }
 
+func TestListTrash(t *testing.T) {
+	svc := newService()
+
+	deletedClient := client
+	deletedClient.Status = clients.DeletedStatus
+
+	cases := []struct {
+		desc        string
+		session     smqauthn.Session
+		page        clients.Page
+		retrieveRes clients.ClientsPage
+		retrieveErr error
+		err         error
+	}{
+		{
+			desc:    "list trash successfully",
+			session: smqauthn.Session{UserID: validID, DomainID: validID},
+			page:    clients.Page{Offset: 0, Limit: 100},
+			retrieveRes: clients.ClientsPage{
+				Page:    clients.Page{Total: 1, Offset: 0, Limit: 100},
+				Clients: []clients.Client{deletedClient},
+			},
+			err: nil,
+		},
+		{
+			desc:        "list trash with failed to retrieve",
+			session:     smqauthn.Session{UserID: validID, DomainID: validID},
+			page:        clients.Page{Offset: 0, Limit: 100},
+			retrieveErr: repoerr.ErrNotFound,
+			err:         svcerr.ErrViewEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		repoCall := repo.On("RetrieveAll", context.Background(), mock.Anything).Return(tc.retrieveRes, tc.retrieveErr)
+		page, err := svc.ListTrash(context.Background(), tc.session, tc.page)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		if err == nil {
+			assert.Equal(t, tc.retrieveRes, page, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.retrieveRes, page))
+		}
+		repoCall.Unset()
+	}
+}
+
+func TestRestore(t *testing.T) {
+	svc := newService()
+
+	deletedClient := client
+	deletedClient.Status = clients.DeletedStatus
+
+	enabledClient := client
+	enabledClient.Status = clients.EnabledStatus
+
+	cases := []struct {
+		desc            string
+		id              string
+		retrieveRes     clients.Client
+		retrieveErr     error
+		changeStatusRes clients.Client
+		changeStatusErr error
+		err             error
+	}{
+		{
+			desc:            "restore client successfully",
+			id:              deletedClient.ID,
+			retrieveRes:     deletedClient,
+			changeStatusRes: enabledClient,
+			err:             nil,
+		},
+		{
+			desc:        "restore client with failed to retrieve",
+			id:          deletedClient.ID,
+			retrieveErr: repoerr.ErrNotFound,
+			err:         svcerr.ErrViewEntity,
+		},
+		{
+			desc:        "restore client that is not in the trash",
+			id:          enabledClient.ID,
+			retrieveRes: enabledClient,
+			err:         svcerr.ErrUpdateEntity,
+		},
+		{
+			desc:            "restore client with failed to change status",
+			id:              deletedClient.ID,
+			retrieveRes:     deletedClient,
+			changeStatusErr: repoerr.ErrNotFound,
+			err:             svcerr.ErrUpdateEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		repoCall := repo.On("RetrieveByID", context.Background(), tc.id).Return(tc.retrieveRes, tc.retrieveErr)
+		repoCall1 := repo.On("ChangeStatus", context.Background(), mock.Anything).Return(tc.changeStatusRes, tc.changeStatusErr)
+		_, err := svc.Restore(context.Background(), smqauthn.Session{UserID: validID}, tc.id)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		repoCall.Unset()
+		repoCall1.Unset()
+	}
+}
+
 func TestDelete(t *testing.T) {
 	svc := newService()
 
@@ -911,9 +1205,7 @@ func TestDelete(t *testing.T) {
 		checkConnectionsErr  error
 		removeConnectionsErr error
 		changeStatusErr      error
-		deletePoliciesErr    error
 		removeErr            error
-		deleteErr            error
 		err                  error
 	}{
 		{
@@ -952,18 +1244,6 @@ func TestDelete(t *testing.T) {
 			changeStatusErr: svcerr.ErrNotFound,
 			err:             svcerr.ErrRemoveEntity,
 		},
-		{
-			desc:              "Delete client with failed to delete policies",
-			clientID:          client.ID,
-			deletePoliciesErr: svcerr.ErrNotFound,
-			err:               svcerr.ErrDeletePolicies,
-		},
-		{
-			desc:      "Delete client with failed to delete",
-			clientID:  client.ID,
-			deleteErr: svcerr.ErrNotFound,
-			err:       svcerr.ErrRemoveEntity,
-		},
 	}
 
 	for _, tc := range cases {
@@ -971,20 +1251,12 @@ func TestDelete(t *testing.T) {
 		channelsCall := chgRPCClient.On("RemoveClientConnections", context.Background(), &grpcChannelsV1.RemoveClientConnectionsReq{ClientId: tc.clientID}).Return(&grpcChannelsV1.RemoveClientConnectionsRes{}, tc.removeConnectionsErr)
 		repoCall1 := cache.On("Remove", mock.Anything, tc.clientID).Return(tc.removeErr)
 		repoCall2 := repo.On("ChangeStatus", context.Background(), clients.Client{ID: tc.clientID, Status: clients.DeletedStatus}).Return(client, tc.changeStatusErr)
-		repoCall3 := repo.On("RetrieveEntitiesRolesActionsMembers", context.Background(), []string{tc.clientID}).Return([]roles.EntityActionRole{}, []roles.EntityMemberRole{}, nil)
-		policyCall1 := pService.On("DeletePolicies", context.Background(), mock.Anything).Return(tc.deletePoliciesErr)
-		policyCall2 := pService.On("DeletePolicyFilter", context.Background(), mock.Anything).Return(tc.deletePoliciesErr)
-		repoCall4 := repo.On("Delete", context.Background(), tc.clientID).Return(tc.deleteErr)
 		err := svc.Delete(context.Background(), smqauthn.Session{}, tc.clientID)
 		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
 		repoCall.Unset()
 		repoCall1.Unset()
-		policyCall1.Unset()
 		repoCall2.Unset()
 		channelsCall.Unset()
-		repoCall3.Unset()
-		repoCall4.Unset()
-		policyCall2.Unset()
 	}
 }
 
@@ -0,0 +1,1122 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	api "github.com/absmach/supermq/api/http"
+	apiutil "github.com/absmach/supermq/api/http/util"
+	"github.com/absmach/supermq/clients"
+	"github.com/absmach/supermq/pkg/connections"
+	"github.com/absmach/supermq/pkg/errors"
+	repoerr "github.com/absmach/supermq/pkg/errors/repository"
+	"github.com/absmach/supermq/pkg/labels"
+	"github.com/absmach/supermq/pkg/postgres"
+	rolesSQLite "github.com/absmach/supermq/pkg/roles/repo/sqlite"
+	"github.com/absmach/supermq/pkg/sqlite"
+)
+
+const (
+	entityTableName      = "clients"
+	entityIDColumnName   = "id"
+	rolesTableNamePrefix = "clients"
+)
+
+var _ clients.Repository = (*clientRepo)(nil)
+
+type clientRepo struct {
+	DB postgres.Database
+	rolesSQLite.Repository
+}
+
+// NewRepository instantiates a SQLite
+// implementation of Clients repository.
+func NewRepository(db postgres.Database) clients.Repository {
+	repo := rolesSQLite.NewRepository(db, rolesTableNamePrefix, entityTableName, entityIDColumnName)
+
+	return &clientRepo{
+		DB:         db,
+		Repository: repo,
+	}
+}
+
+func (repo *clientRepo) Save(ctx context.Context, cls ...clients.Client) ([]clients.Client, error) {
+	var dbClients []DBClient
+
+	for _, client := range cls {
+		dbcli, err := ToDBClient(client)
+		if err != nil {
+			return []clients.Client{}, errors.Wrap(repoerr.ErrCreateEntity, err)
+		}
+		dbClients = append(dbClients, dbcli)
+	}
+	q := `INSERT INTO clients (id, name, tags, domain_id, parent_group_id, identity, external_id, claim_code, secret, metadata, labels, created_at, updated_at, updated_by, status)
+	VALUES (:id, :name, :tags, :domain_id, :parent_group_id, :identity, :external_id, :claim_code, :secret, :metadata, :labels, :created_at, :updated_at, :updated_by, :status)
+	RETURNING id, name, tags, identity, external_id, secret, metadata, labels, COALESCE(domain_id, '') AS domain_id, COALESCE(parent_group_id, '') AS  parent_group_id, status, created_at, updated_at, updated_by, version`
+
+	row, err := repo.DB.NamedQueryContext(ctx, q, dbClients)
+	if err != nil {
+		return []clients.Client{}, sqlite.HandleError(repoerr.ErrCreateEntity, err)
+	}
+
+	defer row.Close()
+
+	var reClients []clients.Client
+	for row.Next() {
+		dbcli := DBClient{}
+		if err := row.StructScan(&dbcli); err != nil {
+			return []clients.Client{}, errors.Wrap(repoerr.ErrFailedOpDB, err)
+		}
+
+		client, err := ToClient(dbcli)
+		if err != nil {
+			return []clients.Client{}, errors.Wrap(repoerr.ErrFailedOpDB, err)
+		}
+		reClients = append(reClients, client)
+	}
+	return reClients, nil
+}
+
+func (repo *clientRepo) RetrieveBySecret(ctx context.Context, key string) (clients.Client, error) {
+	q := fmt.Sprintf(`SELECT id, name, tags, COALESCE(domain_id, '') AS domain_id,  COALESCE(parent_group_id, '') AS parent_group_id, identity, external_id, secret, metadata, labels, created_at, updated_at, updated_by, status, version
+        FROM clients
+        WHERE secret = :secret AND status = %d`, clients.EnabledStatus)
+
+	dbc := DBClient{
+		Secret: key,
+	}
+
+	rows, err := repo.DB.NamedQueryContext(ctx, q, dbc)
+	if err != nil {
+		return clients.Client{}, sqlite.HandleError(repoerr.ErrViewEntity, err)
+	}
+	defer rows.Close()
+
+	dbc = DBClient{}
+	if rows.Next() {
+		if err = rows.StructScan(&dbc); err != nil {
+			return clients.Client{}, sqlite.HandleError(repoerr.ErrViewEntity, err)
+		}
+
+		client, err := ToClient(dbc)
+		if err != nil {
+			return clients.Client{}, errors.Wrap(repoerr.ErrFailedOpDB, err)
+		}
+
+		return client, nil
+	}
+
+	return clients.Client{}, repoerr.ErrNotFound
+}
+
+func (repo *clientRepo) RetrieveAllTags(ctx context.Context, domainID string, offset, limit uint64) (clients.TagsPage, error) {
+	q := `SELECT je.value AS tag, COUNT(*) AS count FROM clients, json_each(clients.tags) AS je
+        WHERE domain_id = :domain_id GROUP BY je.value ORDER BY je.value LIMIT :limit OFFSET :offset`
+
+	params := map[string]interface{}{
+		"domain_id": domainID,
+		"offset":    offset,
+		"limit":     limit,
+	}
+	rows, err := repo.DB.NamedQueryContext(ctx, q, params)
+	if err != nil {
+		return clients.TagsPage{}, sqlite.HandleError(repoerr.ErrViewEntity, err)
+	}
+	defer rows.Close()
+
+	var tags []clients.Tag
+	for rows.Next() {
+		dbt := dbTag{}
+		if err := rows.StructScan(&dbt); err != nil {
+			return clients.TagsPage{}, errors.Wrap(repoerr.ErrViewEntity, err)
+		}
+		tags = append(tags, clients.Tag{Name: dbt.Tag, Count: dbt.Count})
+	}
+
+	cq := `SELECT COUNT(DISTINCT je.value) FROM clients, json_each(clients.tags) AS je WHERE domain_id = :domain_id`
+	total, err := postgres.Total(ctx, repo.DB, cq, params)
+	if err != nil {
+		return clients.TagsPage{}, errors.Wrap(repoerr.ErrViewEntity, err)
+	}
+
+	return clients.TagsPage{
+		Total:  total,
+		Offset: offset,
+		Limit:  limit,
+		Tags:   tags,
+	}, nil
+}
+
+func (repo *clientRepo) RenameTag(ctx context.Context, domainID, oldTag, newTag string) (uint64, error) {
+	q := `UPDATE clients SET tags = (SELECT json_group_array(DISTINCT CASE WHEN je.value = :old_tag THEN :new_tag ELSE je.value END) FROM json_each(tags) AS je)
+        WHERE domain_id = :domain_id AND EXISTS (SELECT 1 FROM json_each(tags) WHERE value = :old_tag)`
+
+	params := map[string]interface{}{
+		"domain_id": domainID,
+		"old_tag":   oldTag,
+		"new_tag":   newTag,
+	}
+	res, err := repo.DB.NamedExecContext(ctx, q, params)
+	if err != nil {
+		return 0, sqlite.HandleError(repoerr.ErrUpdateEntity, err)
+	}
+	renamed, err := res.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(repoerr.ErrUpdateEntity, err)
+	}
+
+	return uint64(renamed), nil
+}
+
+func (repo *clientRepo) Update(ctx context.Context, client clients.Client) (clients.Client, error) {
+	var query []string
+	var upq string
+	if client.Name != "" {
+		query = append(query, "name = :name,")
+	}
+	if client.Metadata != nil {
+		query = append(query, "metadata = :metadata,")
+	}
+	if client.Labels != nil {
+		query = append(query, "labels = :labels,")
+	}
+	if len(query) > 0 {
+		upq = strings.Join(query, " ")
+	}
+
+	q := fmt.Sprintf(`UPDATE clients SET %s version = version + 1, updated_at = :updated_at, updated_by = :updated_by
+        WHERE id = :id AND status = :status AND (:version = 0 OR version = :version)
+        RETURNING id, name, tags, identity, external_id, secret,  metadata, labels, COALESCE(domain_id, '') AS domain_id, COALESCE(parent_group_id, '') AS parent_group_id, status, created_at, updated_at, updated_by, version`,
+		upq)
+	client.Status = clients.EnabledStatus
+	return repo.update(ctx, client, q)
+}
+
+func (repo *clientRepo) UpdateTags(ctx context.Context, client clients.Client) (clients.Client, error) {
+	q := `UPDATE clients SET tags = :tags, version = version + 1, updated_at = :updated_at, updated_by = :updated_by
+        WHERE id = :id AND status = :status AND (:version = 0 OR version = :version)
+        RETURNING id, name, tags, identity, external_id, metadata, labels, COALESCE(domain_id, '') AS domain_id, COALESCE(parent_group_id, '') AS parent_group_id, status, created_at, updated_at, updated_by, version`
+	client.Status = clients.EnabledStatus
+	return repo.update(ctx, client, q)
+}
+
+func (repo *clientRepo) UpdateIdentity(ctx context.Context, client clients.Client) (clients.Client, error) {
+	q := `UPDATE clients SET identity = :identity, version = version + 1, updated_at = :updated_at, updated_by = :updated_by
+        WHERE id = :id AND status = :status AND (:version = 0 OR version = :version)
+        RETURNING id, name, tags, identity, external_id, metadata, labels, COALESCE(domain_id, '') AS domain_id, status, COALESCE(parent_group_id, '') AS parent_group_id, created_at, updated_at, updated_by, version`
+	client.Status = clients.EnabledStatus
+	return repo.update(ctx, client, q)
+}
+
+func (repo *clientRepo) UpdateSecret(ctx context.Context, client clients.Client) (clients.Client, error) {
+	q := `UPDATE clients SET secret = :secret, version = version + 1, updated_at = :updated_at, updated_by = :updated_by
+        WHERE id = :id AND status = :status AND (:version = 0 OR version = :version)
+        RETURNING id, name, tags, identity, external_id, metadata, labels, COALESCE(domain_id, '') AS domain_id, COALESCE(parent_group_id, '') AS parent_group_id, status, created_at, updated_at, updated_by, version`
+	client.Status = clients.EnabledStatus
+	return repo.update(ctx, client, q)
+}
+
+func (repo *clientRepo) ChangeStatus(ctx context.Context, client clients.Client) (clients.Client, error) {
+	q := `UPDATE clients SET status = :status, version = version + 1, updated_at = :updated_at, updated_by = :updated_by
+		WHERE id = :id AND (:version = 0 OR version = :version)
+        RETURNING id, name, tags, identity, external_id, metadata, labels, COALESCE(domain_id, '') AS domain_id, COALESCE(parent_group_id, '') AS parent_group_id, status, created_at, updated_at, updated_by, version`
+
+	return repo.update(ctx, client, q)
+}
+
+func (repo *clientRepo) RetrieveByID(ctx context.Context, id string) (clients.Client, error) {
+	q := `SELECT id, name, tags, COALESCE(domain_id, '') AS domain_id, COALESCE(parent_group_id, '') AS parent_group_id, identity, external_id, secret, metadata, labels, created_at, updated_at, updated_by, status, version
+        FROM clients WHERE id = :id`
+
+	dbc := DBClient{
+		ID: id,
+	}
+
+	row, err := repo.DB.NamedQueryContext(ctx, q, dbc)
+	if err != nil {
+		return clients.Client{}, errors.Wrap(repoerr.ErrViewEntity, err)
+	}
+	defer row.Close()
+
+	dbc = DBClient{}
+	if row.Next() {
+		if err := row.StructScan(&dbc); err != nil {
+			return clients.Client{}, errors.Wrap(repoerr.ErrViewEntity, err)
+		}
+
+		return ToClient(dbc)
+	}
+
+	return clients.Client{}, repoerr.ErrNotFound
+}
+
+func (repo *clientRepo) RetrieveByExternalID(ctx context.Context, domainID, externalID string) (clients.Client, error) {
+	q := `SELECT id, name, tags, COALESCE(domain_id, '') AS domain_id, COALESCE(parent_group_id, '') AS parent_group_id, identity, external_id, secret, metadata, labels, created_at, updated_at, updated_by, status, version
+        FROM clients WHERE domain_id = :domain_id AND external_id = :external_id`
+
+	dbc := DBClient{
+		Domain:     domainID,
+		ExternalID: toNullString(externalID),
+	}
+
+	row, err := repo.DB.NamedQueryContext(ctx, q, dbc)
+	if err != nil {
+		return clients.Client{}, errors.Wrap(repoerr.ErrViewEntity, err)
+	}
+	defer row.Close()
+
+	dbc = DBClient{}
+	if row.Next() {
+		if err := row.StructScan(&dbc); err != nil {
+			return clients.Client{}, errors.Wrap(repoerr.ErrViewEntity, err)
+		}
+
+		return ToClient(dbc)
+	}
+
+	return clients.Client{}, repoerr.ErrNotFound
+}
+
+func (repo *clientRepo) Claim(ctx context.Context, domainID, claimCode string) (clients.Client, error) {
+	q := `UPDATE clients SET claim_code = NULL
+        WHERE domain_id = :domain_id AND claim_code = :claim_code
+        RETURNING id, name, tags, identity, external_id, metadata, labels, COALESCE(domain_id, '') AS domain_id, COALESCE(parent_group_id, '') AS parent_group_id, status, created_at, updated_at, updated_by, version`
+
+	dbc := DBClient{
+		Domain:    domainID,
+		ClaimCode: toNullString(claimCode),
+	}
+
+	row, err := repo.DB.NamedQueryContext(ctx, q, dbc)
+	if err != nil {
+		return clients.Client{}, errors.Wrap(repoerr.ErrUpdateEntity, err)
+	}
+	defer row.Close()
+
+	dbc = DBClient{}
+	if row.Next() {
+		if err := row.StructScan(&dbc); err != nil {
+			return clients.Client{}, errors.Wrap(repoerr.ErrUpdateEntity, err)
+		}
+
+		return ToClient(dbc)
+	}
+
+	return clients.Client{}, repoerr.ErrNotFound
+}
+
+func (repo *clientRepo) RetrieveAll(ctx context.Context, pm clients.Page) (clients.ClientsPage, error) {
+	query, err := PageQuery(pm)
+	if err != nil {
+		return clients.ClientsPage{}, errors.Wrap(repoerr.ErrViewEntity, err)
+	}
+	query = applyOrdering(query, pm)
+
+	q := fmt.Sprintf(`SELECT c.id, c.name, c.tags, c.identity, c.external_id, c.metadata, c.labels, COALESCE(c.domain_id, '') AS domain_id, COALESCE(parent_group_id, '') AS parent_group_id, c.status,
+					c.created_at, c.updated_at, COALESCE(c.updated_by, '') AS updated_by, c.version FROM clients c %s ORDER BY c.created_at LIMIT :limit OFFSET :offset;`, query)
+
+	dbPage, err := ToDBClientsPage(pm)
+	if err != nil {
+		return clients.ClientsPage{}, errors.Wrap(repoerr.ErrFailedToRetrieveAllGroups, err)
+	}
+	rows, err := repo.DB.NamedQueryContext(ctx, q, dbPage)
+	if err != nil {
+		return clients.ClientsPage{}, errors.Wrap(repoerr.ErrFailedToRetrieveAllGroups, err)
+	}
+	defer rows.Close()
+
+	var items []clients.Client
+	for rows.Next() {
+		dbc := DBClient{}
+		if err := rows.StructScan(&dbc); err != nil {
+			return clients.ClientsPage{}, errors.Wrap(repoerr.ErrViewEntity, err)
+		}
+
+		c, err := ToClient(dbc)
+		if err != nil {
+			return clients.ClientsPage{}, err
+		}
+
+		items = append(items, c)
+	}
+	cq := fmt.Sprintf(`SELECT COUNT(*) FROM clients c %s;`, query)
+
+	total, err := postgres.Total(ctx, repo.DB, cq, dbPage)
+	if err != nil {
+		return clients.ClientsPage{}, errors.Wrap(repoerr.ErrViewEntity, err)
+	}
+
+	page := clients.ClientsPage{
+		Clients: items,
+		Page: clients.Page{
+			Total:  total,
+			Offset: pm.Offset,
+			Limit:  pm.Limit,
+		},
+	}
+
+	return page, nil
+}
+
+func (repo *clientRepo) SearchClients(ctx context.Context, pm clients.Page) (clients.ClientsPage, error) {
+	query, err := PageQuery(pm)
+	if err != nil {
+		return clients.ClientsPage{}, errors.Wrap(repoerr.ErrViewEntity, err)
+	}
+
+	tq := query
+	query = applyOrdering(query, pm)
+
+	q := fmt.Sprintf(`SELECT c.id, c.name, c.created_at, c.updated_at FROM clients c %s LIMIT :limit OFFSET :offset;`, query)
+
+	dbPage, err := ToDBClientsPage(pm)
+	if err != nil {
+		return clients.ClientsPage{}, errors.Wrap(repoerr.ErrFailedToRetrieveAllGroups, err)
+	}
+
+	rows, err := repo.DB.NamedQueryContext(ctx, q, dbPage)
+	if err != nil {
+		return clients.ClientsPage{}, errors.Wrap(repoerr.ErrFailedToRetrieveAllGroups, err)
+	}
+	defer rows.Close()
+
+	var items []clients.Client
+	for rows.Next() {
+		dbc := DBClient{}
+		if err := rows.StructScan(&dbc); err != nil {
+			return clients.ClientsPage{}, errors.Wrap(repoerr.ErrViewEntity, err)
+		}
+
+		c, err := ToClient(dbc)
+		if err != nil {
+			return clients.ClientsPage{}, err
+		}
+
+		items = append(items, c)
+	}
+
+	cq := fmt.Sprintf(`SELECT COUNT(*) FROM clients c %s;`, tq)
+	total, err := postgres.Total(ctx, repo.DB, cq, dbPage)
+	if err != nil {
+		return clients.ClientsPage{}, errors.Wrap(repoerr.ErrViewEntity, err)
+	}
+
+	page := clients.ClientsPage{
+		Clients: items,
+		Page: clients.Page{
+			Total:  total,
+			Offset: pm.Offset,
+			Limit:  pm.Limit,
+		},
+	}
+
+	return page, nil
+}
+
+func (repo *clientRepo) RetrieveAllByIDs(ctx context.Context, pm clients.Page) (clients.ClientsPage, error) {
+	if (len(pm.IDs) == 0) && (pm.Domain == "") {
+		return clients.ClientsPage{
+			Page: clients.Page{Total: pm.Total, Offset: pm.Offset, Limit: pm.Limit},
+		}, nil
+	}
+	query, err := PageQuery(pm)
+	if err != nil {
+		return clients.ClientsPage{}, errors.Wrap(repoerr.ErrViewEntity, err)
+	}
+	query = applyOrdering(query, pm)
+
+	q := fmt.Sprintf(`SELECT c.id, c.name, c.tags, c.identity, c.external_id, c.metadata, c.labels, COALESCE(c.domain_id, '') AS domain_id, COALESCE(parent_group_id, '') AS parent_group_id, c.status,
+					c.created_at, c.updated_at, COALESCE(c.updated_by, '') AS updated_by, c.version FROM clients c %s ORDER BY c.created_at LIMIT :limit OFFSET :offset;`, query)
+
+	dbPage, err := ToDBClientsPage(pm)
+	if err != nil {
+		return clients.ClientsPage{}, errors.Wrap(repoerr.ErrFailedToRetrieveAllGroups, err)
+	}
+	rows, err := repo.DB.NamedQueryContext(ctx, q, dbPage)
+	if err != nil {
+		return clients.ClientsPage{}, errors.Wrap(repoerr.ErrFailedToRetrieveAllGroups, err)
+	}
+	defer rows.Close()
+
+	var items []clients.Client
+	for rows.Next() {
+		dbc := DBClient{}
+		if err := rows.StructScan(&dbc); err != nil {
+			return clients.ClientsPage{}, errors.Wrap(repoerr.ErrViewEntity, err)
+		}
+
+		c, err := ToClient(dbc)
+		if err != nil {
+			return clients.ClientsPage{}, err
+		}
+
+		items = append(items, c)
+	}
+	cq := fmt.Sprintf(`SELECT COUNT(*) FROM clients c %s;`, query)
+
+	total, err := postgres.Total(ctx, repo.DB, cq, dbPage)
+	if err != nil {
+		return clients.ClientsPage{}, errors.Wrap(repoerr.ErrViewEntity, err)
+	}
+
+	page := clients.ClientsPage{
+		Clients: items,
+		Page: clients.Page{
+			Total:  total,
+			Offset: pm.Offset,
+			Limit:  pm.Limit,
+		},
+	}
+
+	return page, nil
+}
+
+func (repo *clientRepo) update(ctx context.Context, client clients.Client, query string) (clients.Client, error) {
+	dbc, err := ToDBClient(client)
+	if err != nil {
+		return clients.Client{}, errors.Wrap(repoerr.ErrUpdateEntity, err)
+	}
+
+	row, err := repo.DB.NamedQueryContext(ctx, query, dbc)
+	if err != nil {
+		return clients.Client{}, sqlite.HandleError(repoerr.ErrUpdateEntity, err)
+	}
+	defer row.Close()
+
+	dbc = DBClient{}
+	if row.Next() {
+		if err := row.StructScan(&dbc); err != nil {
+			return clients.Client{}, errors.Wrap(repoerr.ErrUpdateEntity, err)
+		}
+
+		return ToClient(dbc)
+	}
+
+	if client.Version > 0 {
+		if _, err := repo.RetrieveByID(ctx, client.ID); err == nil {
+			return clients.Client{}, repoerr.ErrVersionConflict
+		}
+	}
+
+	return clients.Client{}, repoerr.ErrNotFound
+}
+
+func (repo *clientRepo) Delete(ctx context.Context, clientIDs ...string) error {
+	q := "DELETE FROM clients AS c  WHERE c.id IN (SELECT value FROM json_each(:client_ids)) ;"
+
+	ids, err := json.Marshal(clientIDs)
+	if err != nil {
+		return errors.Wrap(repoerr.ErrRemoveEntity, err)
+	}
+	params := map[string]interface{}{
+		"client_ids": string(ids),
+	}
+	result, err := repo.DB.NamedExecContext(ctx, q, params)
+	if err != nil {
+		return sqlite.HandleError(repoerr.ErrRemoveEntity, err)
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return repoerr.ErrNotFound
+	}
+
+	return nil
+}
+
+// DBClient represents a client row. Tags, metadata and labels are kept as
+// JSON text so that the SQLite JSON functions can operate on them.
+type DBClient struct {
+	ID          string         `db:"id"`
+	Name        string         `db:"name,omitempty"`
+	Tags        string         `db:"tags,omitempty"`
+	Identity    string         `db:"identity"`
+	ExternalID  sql.NullString `db:"external_id,omitempty"`
+	ClaimCode   sql.NullString `db:"claim_code,omitempty"`
+	Domain      string         `db:"domain_id"`
+	ParentGroup sql.NullString `db:"parent_group_id,omitempty"`
+	Secret      string         `db:"secret"`
+	Metadata    string         `db:"metadata,omitempty"`
+	Labels      string         `db:"labels,omitempty"`
+	CreatedAt   time.Time      `db:"created_at,omitempty"`
+	UpdatedAt   sql.NullTime   `db:"updated_at,omitempty"`
+	UpdatedBy   *string        `db:"updated_by,omitempty"`
+	Status      clients.Status `db:"status,omitempty"`
+	Version     uint64         `db:"version"`
+}
+
+type dbTag struct {
+	Tag   string `db:"tag"`
+	Count uint64 `db:"count"`
+}
+
+func ToDBClient(c clients.Client) (DBClient, error) {
+	data := "{}"
+	if len(c.Metadata) > 0 {
+		b, err := json.Marshal(c.Metadata)
+		if err != nil {
+			return DBClient{}, errors.Wrap(repoerr.ErrMalformedEntity, err)
+		}
+		data = string(b)
+	}
+	lbls := "{}"
+	if len(c.Labels) > 0 {
+		b, err := json.Marshal(c.Labels)
+		if err != nil {
+			return DBClient{}, errors.Wrap(repoerr.ErrMalformedEntity, err)
+		}
+		lbls = string(b)
+	}
+	tags := "[]"
+	if len(c.Tags) > 0 {
+		b, err := json.Marshal(c.Tags)
+		if err != nil {
+			return DBClient{}, errors.Wrap(repoerr.ErrMalformedEntity, err)
+		}
+		tags = string(b)
+	}
+	var updatedBy *string
+	if c.UpdatedBy != "" {
+		updatedBy = &c.UpdatedBy
+	}
+	var updatedAt sql.NullTime
+	if c.UpdatedAt != (time.Time{}) {
+		updatedAt = sql.NullTime{Time: c.UpdatedAt, Valid: true}
+	}
+
+	return DBClient{
+		ID:          c.ID,
+		Name:        c.Name,
+		Tags:        tags,
+		Domain:      c.Domain,
+		ParentGroup: toNullString(c.ParentGroup),
+		ExternalID:  toNullString(c.ExternalID),
+		ClaimCode:   toNullString(c.ClaimCode),
+		Identity:    c.Credentials.Identity,
+		Secret:      c.Credentials.Secret,
+		Metadata:    data,
+		Labels:      lbls,
+		CreatedAt:   c.CreatedAt,
+		UpdatedAt:   updatedAt,
+		UpdatedBy:   updatedBy,
+		Status:      c.Status,
+		Version:     c.Version,
+	}, nil
+}
+
+func ToClient(t DBClient) (clients.Client, error) {
+	var metadata clients.Metadata
+	if t.Metadata != "" {
+		if err := json.Unmarshal([]byte(t.Metadata), &metadata); err != nil {
+			return clients.Client{}, errors.Wrap(errors.ErrMalformedEntity, err)
+		}
+	}
+	var lbls labels.Labels
+	if t.Labels != "" {
+		if err := json.Unmarshal([]byte(t.Labels), &lbls); err != nil {
+			return clients.Client{}, errors.Wrap(errors.ErrMalformedEntity, err)
+		}
+	}
+	var tags []string
+	if t.Tags != "" {
+		if err := json.Unmarshal([]byte(t.Tags), &tags); err != nil {
+			return clients.Client{}, errors.Wrap(errors.ErrMalformedEntity, err)
+		}
+	}
+	var updatedBy string
+	if t.UpdatedBy != nil {
+		updatedBy = *t.UpdatedBy
+	}
+	var updatedAt time.Time
+	if t.UpdatedAt.Valid {
+		updatedAt = t.UpdatedAt.Time
+	}
+
+	cli := clients.Client{
+		ID:          t.ID,
+		Name:        t.Name,
+		Tags:        tags,
+		Domain:      t.Domain,
+		ParentGroup: toString(t.ParentGroup),
+		ExternalID:  toString(t.ExternalID),
+		Credentials: clients.Credentials{
+			Identity: t.Identity,
+			Secret:   t.Secret,
+		},
+		Metadata:  metadata,
+		Labels:    lbls,
+		CreatedAt: t.CreatedAt,
+		UpdatedAt: updatedAt,
+		UpdatedBy: updatedBy,
+		Status:    t.Status,
+		Version:   t.Version,
+	}
+	return cli, nil
+}
+
+func ToDBClientsPage(pm clients.Page) (dbClientsPage, error) {
+	_, data, err := sqlite.CreateMetadataQuery("", pm.Metadata)
+	if err != nil {
+		return dbClientsPage{}, errors.Wrap(repoerr.ErrViewEntity, err)
+	}
+	_, ids, err := sqlite.CreateIDsQuery("", pm.IDs)
+	if err != nil {
+		return dbClientsPage{}, errors.Wrap(repoerr.ErrViewEntity, err)
+	}
+	_, sel, err := sqlite.CreateLabelSelectorQuery("", pm.LabelSelector)
+	if err != nil {
+		return dbClientsPage{}, errors.Wrap(repoerr.ErrViewEntity, err)
+	}
+	return dbClientsPage{
+		Name:          pm.Name,
+		Identity:      pm.Identity,
+		Id:            pm.Id,
+		Ids:           ids,
+		Metadata:      data,
+		LabelSelector: sel,
+		Domain:        pm.Domain,
+		Total:         pm.Total,
+		Offset:        pm.Offset,
+		Limit:         pm.Limit,
+		Status:        pm.Status,
+		Tag:           pm.Tag,
+	}, nil
+}
+
+type dbClientsPage struct {
+	Total         uint64         `db:"total"`
+	Limit         uint64         `db:"limit"`
+	Offset        uint64         `db:"offset"`
+	Name          string         `db:"name"`
+	Id            string         `db:"id"`
+	Ids           string         `db:"ids"`
+	Domain        string         `db:"domain_id"`
+	Identity      string         `db:"identity"`
+	Metadata      string         `db:"metadata"`
+	LabelSelector string         `db:"label_selector"`
+	Tag           string         `db:"tag"`
+	Status        clients.Status `db:"status"`
+	GroupID       string         `db:"group_id"`
+}
+
+func PageQuery(pm clients.Page) (string, error) {
+	mq, _, err := sqlite.CreateMetadataQuery("", pm.Metadata)
+	if err != nil {
+		return "", errors.Wrap(errors.ErrMalformedEntity, err)
+	}
+
+	var query []string
+	if pm.Name != "" {
+		query = append(query, "name LIKE '%' || :name || '%'")
+	}
+	if pm.Identity != "" {
+		query = append(query, "identity LIKE '%' || :identity || '%'")
+	}
+	if pm.Id != "" {
+		query = append(query, "id LIKE '%' || :id || '%'")
+	}
+	if pm.Tag != "" {
+		query = append(query, "EXISTS (SELECT 1 FROM json_each(tags) AS je WHERE je.value LIKE '%' || :tag || '%')")
+	}
+	// If there are search params presents, use search and ignore other options.
+	// Always combine role with search params, so len(query) > 1.
+	if len(query) > 1 {
+		return fmt.Sprintf("WHERE %s", strings.Join(query, " AND ")), nil
+	}
+
+	if mq != "" {
+		query = append(query, mq)
+	}
+
+	if lq, _, err := sqlite.CreateLabelSelectorQuery("c.", pm.LabelSelector); err != nil {
+		return "", errors.Wrap(errors.ErrMalformedEntity, err)
+	} else if lq != "" {
+		query = append(query, lq)
+	}
+
+	if iq, _, err := sqlite.CreateIDsQuery("", pm.IDs); err != nil {
+		return "", errors.Wrap(errors.ErrMalformedEntity, err)
+	} else if iq != "" {
+		query = append(query, iq)
+	}
+	if pm.Status != clients.AllStatus {
+		query = append(query, "c.status = :status")
+	}
+	if pm.Domain != "" {
+		query = append(query, "c.domain_id = :domain_id")
+	}
+	var emq string
+	if len(query) > 0 {
+		emq = fmt.Sprintf("WHERE %s", strings.Join(query, " AND "))
+	}
+	return emq, nil
+}
+
+func applyOrdering(emq string, pm clients.Page) string {
+	switch pm.Order {
+	case "name", "identity", "created_at", "updated_at":
+		emq = fmt.Sprintf("%s ORDER BY %s", emq, pm.Order)
+		if pm.Dir == api.AscDir || pm.Dir == api.DescDir {
+			emq = fmt.Sprintf("%s %s", emq, pm.Dir)
+		}
+	}
+	return emq
+}
+
+func toNullString(s string) sql.NullString {
+	if s == "" {
+		return sql.NullString{}
+	}
+
+	return sql.NullString{
+		String: s,
+		Valid:  true,
+	}
+}
+
+func toString(s sql.NullString) string {
+	if s.Valid {
+		return s.String
+	}
+	return ""
+}
+
+func (repo *clientRepo) RetrieveByIds(ctx context.Context, ids []string) (clients.ClientsPage, error) {
+	if len(ids) == 0 {
+		return clients.ClientsPage{}, nil
+	}
+
+	pm := clients.Page{IDs: ids}
+	query, err := PageQuery(pm)
+	if err != nil {
+		return clients.ClientsPage{}, errors.Wrap(repoerr.ErrViewEntity, err)
+	}
+
+	q := fmt.Sprintf(`SELECT c.id, c.name, c.tags, c.identity, c.external_id, c.metadata, c.labels, COALESCE(c.domain_id, '') AS domain_id,  COALESCE(parent_group_id, '') AS parent_group_id, c.status,
+					c.created_at, c.updated_at, COALESCE(c.updated_by, '') AS updated_by, c.version FROM clients c %s ORDER BY c.created_at`, query)
+
+	dbPage, err := ToDBClientsPage(pm)
+	if err != nil {
+		return clients.ClientsPage{}, errors.Wrap(repoerr.ErrFailedToRetrieveAllGroups, err)
+	}
+	rows, err := repo.DB.NamedQueryContext(ctx, q, dbPage)
+	if err != nil {
+		return clients.ClientsPage{}, errors.Wrap(repoerr.ErrFailedToRetrieveAllGroups, err)
+	}
+	defer rows.Close()
+
+	var items []clients.Client
+	for rows.Next() {
+		dbc := DBClient{}
+		if err := rows.StructScan(&dbc); err != nil {
+			return clients.ClientsPage{}, errors.Wrap(repoerr.ErrViewEntity, err)
+		}
+
+		c, err := ToClient(dbc)
+		if err != nil {
+			return clients.ClientsPage{}, err
+		}
+
+		items = append(items, c)
+	}
+	cq := fmt.Sprintf(`SELECT COUNT(*) FROM clients c %s;`, query)
+
+	total, err := postgres.Total(ctx, repo.DB, cq, dbPage)
+	if err != nil {
+		return clients.ClientsPage{}, errors.Wrap(repoerr.ErrViewEntity, err)
+	}
+
+	page := clients.ClientsPage{
+		Clients: items,
+		Page: clients.Page{
+			Total:  total,
+			Offset: pm.Offset,
+			Limit:  total,
+		},
+	}
+
+	return page, nil
+}
+
+func (repo *clientRepo) AddConnections(ctx context.Context, conns []clients.Connection) error {
+	dbConns := toDBConnections(conns)
+	q := `INSERT INTO connections (channel_id, domain_id, client_id, type)
+			VALUES (:channel_id, :domain_id, :client_id, :type);`
+	if _, err := repo.DB.NamedExecContext(ctx, q, dbConns); err != nil {
+		return sqlite.HandleError(repoerr.ErrCreateEntity, err)
+	}
+
+	return nil
+}
+
+func (repo *clientRepo) RemoveConnections(ctx context.Context, conns []clients.Connection) (retErr error) {
+	tx, err := repo.DB.BeginTxx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(repoerr.ErrRemoveEntity, err)
+	}
+	defer func() {
+		if retErr != nil {
+			if errRollBack := tx.Rollback(); errRollBack != nil {
+				retErr = errors.Wrap(retErr, errors.Wrap(apiutil.ErrRollbackTx, errRollBack))
+			}
+		}
+	}()
+
+	query := `DELETE FROM connections WHERE channel_id = :channel_id AND domain_id = :domain_id AND client_id = :client_id`
+
+	for _, conn := range conns {
+		if uint8(conn.Type) > 0 {
+			query = query + " AND type = :type "
+		}
+		dbConn := toDBConnection(conn)
+		if _, err := tx.NamedExec(query, dbConn); err != nil {
+			return errors.Wrap(repoerr.ErrRemoveEntity, errors.Wrap(fmt.Errorf("failed to delete connection for channel_id: %s, domain_id: %s client_id %s", conn.ChannelID, conn.DomainID, conn.ClientID), err))
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return errors.Wrap(repoerr.ErrRemoveEntity, err)
+	}
+	return nil
+}
+
+func (repo *clientRepo) SetParentGroup(ctx context.Context, cli clients.Client) error {
+	q := "UPDATE clients SET parent_group_id = :parent_group_id, updated_at = :updated_at, updated_by = :updated_by WHERE id = :id"
+
+	dbcli, err := ToDBClient(cli)
+	if err != nil {
+		return errors.Wrap(repoerr.ErrUpdateEntity, err)
+	}
+	result, err := repo.DB.NamedExecContext(ctx, q, dbcli)
+	if err != nil {
+		return sqlite.HandleError(repoerr.ErrUpdateEntity, err)
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return repoerr.ErrNotFound
+	}
+	return nil
+}
+
+func (repo *clientRepo) RemoveParentGroup(ctx context.Context, cli clients.Client) error {
+	q := "UPDATE clients SET parent_group_id = NULL, updated_at = :updated_at, updated_by = :updated_by WHERE id = :id"
+	dbcli, err := ToDBClient(cli)
+	if err != nil {
+		return errors.Wrap(repoerr.ErrUpdateEntity, err)
+	}
+	result, err := repo.DB.NamedExecContext(ctx, q, dbcli)
+	if err != nil {
+		return sqlite.HandleError(repoerr.ErrRemoveEntity, err)
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return repoerr.ErrNotFound
+	}
+	return nil
+}
+
+func (repo *clientRepo) ClientConnectionsCount(ctx context.Context, id string) (uint64, error) {
+	query := `SELECT COUNT(*) FROM connections WHERE client_id = :client_id`
+	dbConn := dbConnection{ClientID: id}
+
+	total, err := postgres.Total(ctx, repo.DB, query, dbConn)
+	if err != nil {
+		return 0, sqlite.HandleError(repoerr.ErrViewEntity, err)
+	}
+	return total, nil
+}
+
+func (repo *clientRepo) DoesClientHaveConnections(ctx context.Context, id string) (bool, error) {
+	query := `SELECT 1 FROM connections WHERE client_id = :client_id`
+	dbConn := dbConnection{ClientID: id}
+
+	rows, err := repo.DB.NamedQueryContext(ctx, query, dbConn)
+	if err != nil {
+		return false, sqlite.HandleError(repoerr.ErrViewEntity, err)
+	}
+	defer rows.Close()
+
+	return rows.Next(), nil
+}
+
+func (repo *clientRepo) RemoveChannelConnections(ctx context.Context, channelID string) error {
+	query := `DELETE FROM connections WHERE channel_id = :channel_id`
+
+	dbConn := dbConnection{ChannelID: channelID}
+	if _, err := repo.DB.NamedExecContext(ctx, query, dbConn); err != nil {
+		return errors.Wrap(repoerr.ErrRemoveEntity, err)
+	}
+	return nil
+}
+
+func (repo *clientRepo) RemoveClientConnections(ctx context.Context, clientID string) error {
+	query := `DELETE FROM connections WHERE client_id = :client_id`
+
+	dbConn := dbConnection{ClientID: clientID}
+	if _, err := repo.DB.NamedExecContext(ctx, query, dbConn); err != nil {
+		return errors.Wrap(repoerr.ErrRemoveEntity, err)
+	}
+	return nil
+}
+
+func (repo *clientRepo) RetrieveParentGroupClients(ctx context.Context, parentGroupID string) ([]clients.Client, error) {
+	query := `SELECT c.id, c.name, c.tags,  c.metadata, c.labels, COALESCE(c.domain_id, '') AS domain_id, COALESCE(parent_group_id, '') AS parent_group_id, c.status,
+					c.created_at, c.updated_at, COALESCE(c.updated_by, '') AS updated_by, c.version FROM clients c WHERE c.parent_group_id = :parent_group_id ;`
+
+	rows, err := repo.DB.NamedQueryContext(ctx, query, DBClient{ParentGroup: toNullString(parentGroupID)})
+	if err != nil {
+		return []clients.Client{}, errors.Wrap(repoerr.ErrViewEntity, err)
+	}
+	defer rows.Close()
+
+	var clis []clients.Client
+	for rows.Next() {
+		dbCli := DBClient{}
+		if err := rows.StructScan(&dbCli); err != nil {
+			return []clients.Client{}, errors.Wrap(repoerr.ErrViewEntity, err)
+		}
+
+		cli, err := ToClient(dbCli)
+		if err != nil {
+			return []clients.Client{}, err
+		}
+
+		clis = append(clis, cli)
+	}
+	return clis, nil
+}
+
+func (repo *clientRepo) UnsetParentGroupFromClient(ctx context.Context, parentGroupID string) error {
+	query := "UPDATE clients SET parent_group_id = NULL WHERE parent_group_id = :parent_group_id"
+
+	if _, err := repo.DB.NamedExecContext(ctx, query, DBClient{ParentGroup: toNullString(parentGroupID)}); err != nil {
+		return errors.Wrap(repoerr.ErrRemoveEntity, err)
+	}
+	return nil
+}
+
+func (repo *clientRepo) AddRelation(ctx context.Context, rel clients.ClientRelation) error {
+	q := `INSERT INTO client_relations (domain_id, subject_id, relation, object_id, created_at)
+			VALUES (:domain_id, :subject_id, :relation, :object_id, :created_at);`
+	if _, err := repo.DB.NamedExecContext(ctx, q, toDBRelation(rel)); err != nil {
+		return sqlite.HandleError(repoerr.ErrCreateEntity, err)
+	}
+
+	return nil
+}
+
+func (repo *clientRepo) RemoveRelation(ctx context.Context, rel clients.ClientRelation) error {
+	q := `DELETE FROM client_relations WHERE domain_id = :domain_id AND subject_id = :subject_id AND relation = :relation AND object_id = :object_id`
+
+	result, err := repo.DB.NamedExecContext(ctx, q, toDBRelation(rel))
+	if err != nil {
+		return sqlite.HandleError(repoerr.ErrRemoveEntity, err)
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return repoerr.ErrNotFound
+	}
+	return nil
+}
+
+func (repo *clientRepo) RetrieveRelated(ctx context.Context, domainID, clientID, relation string, depth uint64) ([]clients.RelatedClient, error) {
+	relFilter := ""
+	if relation != "" {
+		relFilter = "AND cr.relation = :relation"
+	}
+	q := fmt.Sprintf(`WITH RECURSIVE related AS (
+			SELECT cr.object_id, cr.relation, 1 AS depth
+			FROM client_relations cr
+			WHERE cr.domain_id = :domain_id AND cr.subject_id = :subject_id %s
+			UNION
+			SELECT cr.object_id, cr.relation, r.depth + 1
+			FROM client_relations cr
+			JOIN related r ON cr.subject_id = r.object_id
+			WHERE cr.domain_id = :domain_id AND r.depth < :depth %s
+		)
+		SELECT c.id, c.name, c.tags, c.metadata, c.labels, COALESCE(c.domain_id, '') AS domain_id, COALESCE(c.parent_group_id, '') AS parent_group_id, c.status,
+			c.created_at, c.updated_at, COALESCE(c.updated_by, '') AS updated_by, c.version, r.relation, r.depth
+		FROM related r JOIN clients c ON c.id = r.object_id
+		ORDER BY r.depth, c.id`, relFilter, relFilter)
+
+	params := map[string]interface{}{
+		"domain_id":  domainID,
+		"subject_id": clientID,
+		"relation":   relation,
+		"depth":      depth,
+	}
+	rows, err := repo.DB.NamedQueryContext(ctx, q, params)
+	if err != nil {
+		return []clients.RelatedClient{}, sqlite.HandleError(repoerr.ErrViewEntity, err)
+	}
+	defer rows.Close()
+
+	var related []clients.RelatedClient
+	for rows.Next() {
+		dbr := dbRelatedClient{}
+		if err := rows.StructScan(&dbr); err != nil {
+			return []clients.RelatedClient{}, errors.Wrap(repoerr.ErrViewEntity, err)
+		}
+
+		cli, err := ToClient(dbr.DBClient)
+		if err != nil {
+			return []clients.RelatedClient{}, err
+		}
+
+		related = append(related, clients.RelatedClient{
+			Client:   cli,
+			Relation: dbr.Relation,
+			Depth:    dbr.Depth,
+		})
+	}
+	return related, nil
+}
+
+type dbConnection struct {
+	ClientID  string               `db:"client_id"`
+	ChannelID string               `db:"channel_id"`
+	DomainID  string               `db:"domain_id"`
+	Type      connections.ConnType `db:"type"`
+}
+
+func toDBConnections(conns []clients.Connection) []dbConnection {
+	var dbconns []dbConnection
+	for _, conn := range conns {
+		dbconns = append(dbconns, toDBConnection(conn))
+	}
+	return dbconns
+}
+
+func toDBConnection(conn clients.Connection) dbConnection {
+	return dbConnection{
+		ClientID:  conn.ClientID,
+		ChannelID: conn.ChannelID,
+		DomainID:  conn.DomainID,
+		Type:      conn.Type,
+	}
+}
+
+type dbRelation struct {
+	DomainID  string    `db:"domain_id"`
+	SubjectID string    `db:"subject_id"`
+	Relation  string    `db:"relation"`
+	ObjectID  string    `db:"object_id"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+func toDBRelation(rel clients.ClientRelation) dbRelation {
+	return dbRelation{
+		DomainID:  rel.DomainID,
+		SubjectID: rel.SubjectID,
+		Relation:  rel.Relation,
+		ObjectID:  rel.ObjectID,
+		CreatedAt: rel.CreatedAt,
+	}
+}
+
+type dbRelatedClient struct {
+	DBClient
+	Relation string `db:"relation"`
+	Depth    uint64 `db:"depth"`
+}
@@ -0,0 +1,372 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlite_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/0x6flab/namegenerator"
+	"github.com/absmach/supermq/clients"
+	"github.com/absmach/supermq/clients/sqlite"
+	"github.com/absmach/supermq/internal/testsutil"
+	"github.com/absmach/supermq/pkg/errors"
+	repoerr "github.com/absmach/supermq/pkg/errors/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var namegen = namegenerator.NewGenerator()
+
+func TestClientsSave(t *testing.T) {
+	t.Cleanup(func() {
+		_, err := db.Exec("DELETE FROM clients")
+		require.Nil(t, err, fmt.Sprintf("clean clients unexpected error: %s", err))
+	})
+	repo := sqlite.NewRepository(database)
+
+	uid := testsutil.GenerateUUID(t)
+	domainID := testsutil.GenerateUUID(t)
+	secret := testsutil.GenerateUUID(t)
+
+	cases := []struct {
+		desc   string
+		client clients.Client
+		err    error
+	}{
+		{
+			desc: "add new client successfully",
+			client: clients.Client{
+				ID:     uid,
+				Domain: domainID,
+				Name:   namegen.Generate(),
+				Credentials: clients.Credentials{
+					Secret: secret,
+				},
+				Tags:     []string{"tag1", "tag2"},
+				Metadata: clients.Metadata{"key": "value"},
+				Status:   clients.EnabledStatus,
+			},
+			err: nil,
+		},
+		{
+			desc: "add client with duplicate client ID",
+			client: clients.Client{
+				ID:     uid,
+				Domain: domainID,
+				Name:   namegen.Generate(),
+				Credentials: clients.Credentials{
+					Secret: testsutil.GenerateUUID(t),
+				},
+				Status: clients.EnabledStatus,
+			},
+			err: repoerr.ErrConflict,
+		},
+		{
+			desc: "add client with duplicate secret within the domain",
+			client: clients.Client{
+				ID:     testsutil.GenerateUUID(t),
+				Domain: domainID,
+				Name:   namegen.Generate(),
+				Credentials: clients.Credentials{
+					Secret: secret,
+				},
+				Status: clients.EnabledStatus,
+			},
+			err: repoerr.ErrConflict,
+		},
+		{
+			desc: "add client with same secret in different domain",
+			client: clients.Client{
+				ID:     testsutil.GenerateUUID(t),
+				Domain: testsutil.GenerateUUID(t),
+				Name:   namegen.Generate(),
+				Credentials: clients.Credentials{
+					Secret: secret,
+				},
+				Tags:     []string{},
+				Metadata: clients.Metadata{},
+				Status:   clients.EnabledStatus,
+			},
+			err: nil,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			rClients, err := repo.Save(context.Background(), tc.client)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("expected %s got %s\n", tc.err, err))
+			if err == nil {
+				require.Equal(t, 1, len(rClients))
+				rClient := rClients[0]
+				rClient.Credentials.Secret = tc.client.Credentials.Secret
+				assert.Equal(t, tc.client.ID, rClient.ID)
+				assert.Equal(t, tc.client.Name, rClient.Name)
+				assert.Equal(t, tc.client.Tags, rClient.Tags)
+				assert.Equal(t, tc.client.Metadata, rClient.Metadata)
+			}
+		})
+	}
+}
+
+func TestRetrieveByID(t *testing.T) {
+	t.Cleanup(func() {
+		_, err := db.Exec("DELETE FROM clients")
+		require.Nil(t, err, fmt.Sprintf("clean clients unexpected error: %s", err))
+	})
+	repo := sqlite.NewRepository(database)
+
+	client := clients.Client{
+		ID:     testsutil.GenerateUUID(t),
+		Domain: testsutil.GenerateUUID(t),
+		Name:   namegen.Generate(),
+		Credentials: clients.Credentials{
+			Secret: testsutil.GenerateUUID(t),
+		},
+		Tags:     []string{"tag1"},
+		Metadata: clients.Metadata{"key": "value"},
+		Status:   clients.EnabledStatus,
+	}
+	_, err := repo.Save(context.Background(), client)
+	require.Nil(t, err, fmt.Sprintf("save client unexpected error: %s", err))
+
+	cases := []struct {
+		desc string
+		id   string
+		err  error
+	}{
+		{
+			desc: "retrieve existing client",
+			id:   client.ID,
+			err:  nil,
+		},
+		{
+			desc: "retrieve non-existing client",
+			id:   testsutil.GenerateUUID(t),
+			err:  repoerr.ErrNotFound,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			rClient, err := repo.RetrieveByID(context.Background(), tc.id)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("expected %s got %s\n", tc.err, err))
+			if err == nil {
+				assert.Equal(t, client.ID, rClient.ID)
+				assert.Equal(t, client.Name, rClient.Name)
+				assert.Equal(t, client.Tags, rClient.Tags)
+				assert.Equal(t, client.Metadata, rClient.Metadata)
+			}
+		})
+	}
+}
+
+func TestRetrieveAll(t *testing.T) {
+	t.Cleanup(func() {
+		_, err := db.Exec("DELETE FROM clients")
+		require.Nil(t, err, fmt.Sprintf("clean clients unexpected error: %s", err))
+	})
+	repo := sqlite.NewRepository(database)
+
+	domainID := testsutil.GenerateUUID(t)
+	num := 10
+	items := []clients.Client{}
+	for i := 0; i < num; i++ {
+		client := clients.Client{
+			ID:     testsutil.GenerateUUID(t),
+			Domain: domainID,
+			Name:   fmt.Sprintf("client-%d-%s", i, namegen.Generate()),
+			Credentials: clients.Credentials{
+				Secret: testsutil.GenerateUUID(t),
+			},
+			Tags:      []string{fmt.Sprintf("tag%d", i%2)},
+			Metadata:  clients.Metadata{"idx": fmt.Sprintf("%d", i)},
+			CreatedAt: time.Now().UTC().Truncate(time.Millisecond),
+			Status:    clients.EnabledStatus,
+		}
+		_, err := repo.Save(context.Background(), client)
+		require.Nil(t, err, fmt.Sprintf("save client unexpected error: %s", err))
+		items = append(items, client)
+	}
+
+	cases := []struct {
+		desc string
+		page clients.Page
+		size int
+	}{
+		{
+			desc: "retrieve all clients in the domain",
+			page: clients.Page{Domain: domainID, Offset: 0, Limit: uint64(num), Status: clients.AllStatus},
+			size: num,
+		},
+		{
+			desc: "retrieve clients with limit",
+			page: clients.Page{Domain: domainID, Offset: 0, Limit: 3, Status: clients.AllStatus},
+			size: 3,
+		},
+		{
+			desc: "retrieve clients by name",
+			page: clients.Page{Domain: domainID, Name: items[0].Name, Offset: 0, Limit: uint64(num), Status: clients.AllStatus},
+			size: 1,
+		},
+		{
+			desc: "retrieve clients by tag",
+			page: clients.Page{Domain: domainID, Tag: "tag1", Offset: 0, Limit: uint64(num), Status: clients.AllStatus},
+			size: num / 2,
+		},
+		{
+			desc: "retrieve clients by metadata",
+			page: clients.Page{Domain: domainID, Metadata: clients.Metadata{"idx": "3"}, Offset: 0, Limit: uint64(num), Status: clients.AllStatus},
+			size: 1,
+		},
+		{
+			desc: "retrieve clients by IDs",
+			page: clients.Page{Domain: domainID, IDs: []string{items[0].ID, items[1].ID}, Offset: 0, Limit: uint64(num), Status: clients.AllStatus},
+			size: 2,
+		},
+		{
+			desc: "retrieve clients from an empty domain",
+			page: clients.Page{Domain: testsutil.GenerateUUID(t), Offset: 0, Limit: uint64(num), Status: clients.AllStatus},
+			size: 0,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			page, err := repo.RetrieveAll(context.Background(), tc.page)
+			require.Nil(t, err, fmt.Sprintf("retrieve all unexpected error: %s", err))
+			assert.Equal(t, tc.size, len(page.Clients), fmt.Sprintf("expected %d clients got %d\n", tc.size, len(page.Clients)))
+		})
+	}
+}
+
+func TestRetrieveAllTags(t *testing.T) {
+	t.Cleanup(func() {
+		_, err := db.Exec("DELETE FROM clients")
+		require.Nil(t, err, fmt.Sprintf("clean clients unexpected error: %s", err))
+	})
+	repo := sqlite.NewRepository(database)
+
+	domainID := testsutil.GenerateUUID(t)
+	tags := [][]string{
+		{"city", "lake"},
+		{"city"},
+		{"river"},
+	}
+	for _, tg := range tags {
+		client := clients.Client{
+			ID:     testsutil.GenerateUUID(t),
+			Domain: domainID,
+			Name:   namegen.Generate(),
+			Credentials: clients.Credentials{
+				Secret: testsutil.GenerateUUID(t),
+			},
+			Tags:   tg,
+			Status: clients.EnabledStatus,
+		}
+		_, err := repo.Save(context.Background(), client)
+		require.Nil(t, err, fmt.Sprintf("save client unexpected error: %s", err))
+	}
+
+	page, err := repo.RetrieveAllTags(context.Background(), domainID, 0, 10)
+	require.Nil(t, err, fmt.Sprintf("retrieve tags unexpected error: %s", err))
+	assert.Equal(t, uint64(3), page.Total)
+	expected := []clients.Tag{
+		{Name: "city", Count: 2},
+		{Name: "lake", Count: 1},
+		{Name: "river", Count: 1},
+	}
+	assert.Equal(t, expected, page.Tags)
+
+	page, err = repo.RetrieveAllTags(context.Background(), domainID, 1, 1)
+	require.Nil(t, err, fmt.Sprintf("retrieve tags unexpected error: %s", err))
+	assert.Equal(t, uint64(3), page.Total)
+	assert.Equal(t, []clients.Tag{{Name: "lake", Count: 1}}, page.Tags)
+}
+
+func TestRenameTag(t *testing.T) {
+	t.Cleanup(func() {
+		_, err := db.Exec("DELETE FROM clients")
+		require.Nil(t, err, fmt.Sprintf("clean clients unexpected error: %s", err))
+	})
+	repo := sqlite.NewRepository(database)
+
+	domainID := testsutil.GenerateUUID(t)
+	tags := [][]string{
+		{"city", "lake"},
+		{"city"},
+		{"lake"},
+	}
+	ids := []string{}
+	for _, tg := range tags {
+		client := clients.Client{
+			ID:     testsutil.GenerateUUID(t),
+			Domain: domainID,
+			Name:   namegen.Generate(),
+			Credentials: clients.Credentials{
+				Secret: testsutil.GenerateUUID(t),
+			},
+			Tags:   tg,
+			Status: clients.EnabledStatus,
+		}
+		_, err := repo.Save(context.Background(), client)
+		require.Nil(t, err, fmt.Sprintf("save client unexpected error: %s", err))
+		ids = append(ids, client.ID)
+	}
+
+	count, err := repo.RenameTag(context.Background(), domainID, "city", "lake")
+	require.Nil(t, err, fmt.Sprintf("rename tag unexpected error: %s", err))
+	assert.Equal(t, uint64(2), count, fmt.Sprintf("expected 2 updated clients got %d\n", count))
+
+	// Renaming to an existing tag merges instead of duplicating it.
+	client, err := repo.RetrieveByID(context.Background(), ids[0])
+	require.Nil(t, err, fmt.Sprintf("retrieve client unexpected error: %s", err))
+	assert.Equal(t, []string{"lake"}, client.Tags)
+
+	count, err = repo.RenameTag(context.Background(), domainID, "missing", "present")
+	require.Nil(t, err, fmt.Sprintf("rename tag unexpected error: %s", err))
+	assert.Equal(t, uint64(0), count)
+}
+
+func TestDelete(t *testing.T) {
+	t.Cleanup(func() {
+		_, err := db.Exec("DELETE FROM clients")
+		require.Nil(t, err, fmt.Sprintf("clean clients unexpected error: %s", err))
+	})
+	repo := sqlite.NewRepository(database)
+
+	client := clients.Client{
+		ID:     testsutil.GenerateUUID(t),
+		Domain: testsutil.GenerateUUID(t),
+		Name:   namegen.Generate(),
+		Credentials: clients.Credentials{
+			Secret: testsutil.GenerateUUID(t),
+		},
+		Status: clients.EnabledStatus,
+	}
+	_, err := repo.Save(context.Background(), client)
+	require.Nil(t, err, fmt.Sprintf("save client unexpected error: %s", err))
+
+	cases := []struct {
+		desc string
+		id   string
+		err  error
+	}{
+		{
+			desc: "delete existing client",
+			id:   client.ID,
+			err:  nil,
+		},
+		{
+			desc: "delete non-existing client",
+			id:   testsutil.GenerateUUID(t),
+			err:  repoerr.ErrNotFound,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := repo.Delete(context.Background(), tc.id)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("expected %s got %s\n", tc.err, err))
+		})
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlite
+
+import (
+	"github.com/absmach/supermq/pkg/errors"
+	repoerr "github.com/absmach/supermq/pkg/errors/repository"
+	outboxPostgres "github.com/absmach/supermq/pkg/outbox/postgres"
+	rolesSQLite "github.com/absmach/supermq/pkg/roles/repo/sqlite"
+	migrate "github.com/rubenv/sql-migrate"
+	_ "modernc.org/sqlite" // required for SQL access
+)
+
+// Migration returns the SQLite migrations of the Clients service. The
+// backend starts from a fresh database, so a single migration captures the
+// schema the Postgres migrations converge to, with tags, metadata and
+// labels kept as JSON text. The outbox migration is shared with Postgres
+// since its schema is dialect-neutral.
+func Migration() (*migrate.MemoryMigrationSource, error) {
+	clientsRolesMigration, err := rolesSQLite.Migration(rolesTableNamePrefix, entityTableName, entityIDColumnName)
+	if err != nil {
+		return &migrate.MemoryMigrationSource{}, errors.Wrap(repoerr.ErrRoleMigration, err)
+	}
+
+	clientsMigration := &migrate.MemoryMigrationSource{
+		Migrations: []*migrate.Migration{
+			{
+				Id: "clients_sqlite_01",
+				// VARCHAR(36) for columns with IDs as UUIDS have a maximum of 36 characters
+				// STATUS 0 to imply enabled and 1 to imply disabled
+				Up: []string{
+					`CREATE TABLE IF NOT EXISTS clients (
+						id			       VARCHAR(36) PRIMARY KEY,
+						name		       VARCHAR(1024),
+						domain_id	       VARCHAR(36) NOT NULL,
+						parent_group_id    VARCHAR(36) DEFAULT NULL,
+						identity	       VARCHAR(254),
+						external_id        VARCHAR(254) DEFAULT NULL,
+						claim_code         VARCHAR(254) DEFAULT NULL,
+						secret		       VARCHAR(4096) NOT NULL,
+						tags		       TEXT,
+						metadata	       TEXT,
+						labels		       TEXT,
+						created_at	       TIMESTAMP,
+						updated_at	       TIMESTAMP,
+						updated_by         VARCHAR(254),
+						status		       SMALLINT NOT NULL DEFAULT 0 CHECK (status >= 0),
+						version		       BIGINT NOT NULL DEFAULT 1,
+						UNIQUE		       (domain_id, secret),
+						UNIQUE		       (domain_id, name),
+						UNIQUE		       (domain_id, id)
+					)`,
+					`CREATE UNIQUE INDEX IF NOT EXISTS clients_external_id_idx ON clients (domain_id, external_id)`,
+					`CREATE UNIQUE INDEX IF NOT EXISTS clients_claim_code_idx ON clients (domain_id, claim_code)`,
+					`CREATE TABLE IF NOT EXISTS connections (
+						channel_id    VARCHAR(36),
+						domain_id 	  VARCHAR(36),
+						client_id     VARCHAR(36),
+						type          SMALLINT NOT NULL CHECK (type IN (1, 2)),
+						FOREIGN KEY   (client_id, domain_id) REFERENCES clients (id, domain_id) ON DELETE CASCADE ON UPDATE CASCADE,
+						PRIMARY KEY   (channel_id, domain_id, client_id, type)
+					)`,
+					`CREATE TABLE IF NOT EXISTS client_relations (
+						domain_id     VARCHAR(36) NOT NULL,
+						subject_id    VARCHAR(36) NOT NULL,
+						relation      VARCHAR(254) NOT NULL,
+						object_id     VARCHAR(36) NOT NULL,
+						created_at    TIMESTAMP,
+						FOREIGN KEY   (subject_id, domain_id) REFERENCES clients (id, domain_id) ON DELETE CASCADE ON UPDATE CASCADE,
+						FOREIGN KEY   (object_id, domain_id) REFERENCES clients (id, domain_id) ON DELETE CASCADE ON UPDATE CASCADE,
+						PRIMARY KEY   (domain_id, subject_id, relation, object_id)
+					)`,
+					`CREATE INDEX IF NOT EXISTS client_relations_object_idx ON client_relations (domain_id, object_id)`,
+				},
+				Down: []string{
+					`DROP INDEX IF EXISTS client_relations_object_idx`,
+					`DROP TABLE IF EXISTS client_relations`,
+					`DROP TABLE IF EXISTS connections`,
+					`DROP INDEX IF EXISTS clients_claim_code_idx`,
+					`DROP INDEX IF EXISTS clients_external_id_idx`,
+					`DROP TABLE IF EXISTS clients`,
+				},
+			},
+		},
+	}
+
+	clientsMigration.Migrations = append(clientsMigration.Migrations, clientsRolesMigration.Migrations...)
+	clientsMigration.Migrations = append(clientsMigration.Migrations, outboxPostgres.Migration().Migrations...)
+
+	return clientsMigration, nil
+}
@@ -46,6 +46,13 @@ func (tm *tracingMiddleware) View(ctx context.Context, session authn.Session, id
 	return tm.svc.View(ctx, session, id)
 }
 
+// ViewByExternalID traces the "ViewByExternalID" operation of the wrapped clients.Service.
+func (tm *tracingMiddleware) ViewByExternalID(ctx context.Context, session authn.Session, externalID string) (clients.Client, error) {
+	ctx, span := tm.tracer.Start(ctx, "svc_view_client_by_external_id", trace.WithAttributes(attribute.String("external_id", externalID)))
+	defer span.End()
+	return tm.svc.ViewByExternalID(ctx, session, externalID)
+}
+
 // ListClients traces the "ListClients" operation of the wrapped clients.Service.
 func (tm *tracingMiddleware) ListClients(ctx context.Context, session authn.Session, reqUserID string, pm clients.Page) (clients.ClientsPage, error) {
 	ctx, span := tm.tracer.Start(ctx, "svc_list_clients")
@@ -103,6 +110,101 @@ func (tm *tracingMiddleware) Delete(ctx context.Context, session authn.Session,
 	return tm.svc.Delete(ctx, session, id)
 }
 
+// Share traces the "Share" operation of the wrapped clients.Service.
+// ListTrash traces the "ListTrash" operation of the wrapped clients.Service.
+func (tm *tracingMiddleware) ListTrash(ctx context.Context, session authn.Session, pm clients.Page) (clients.ClientsPage, error) {
+	ctx, span := tm.tracer.Start(ctx, "list_client_trash", trace.WithAttributes(
+		attribute.Int64("offset", int64(pm.Offset)),
+		attribute.Int64("limit", int64(pm.Limit)),
+	))
+	defer span.End()
+	return tm.svc.ListTrash(ctx, session, pm)
+}
+
+// Restore traces the "Restore" operation of the wrapped clients.Service.
+func (tm *tracingMiddleware) Restore(ctx context.Context, session authn.Session, id string) (clients.Client, error) {
+	ctx, span := tm.tracer.Start(ctx, "restore_client", trace.WithAttributes(attribute.String("id", id)))
+	defer span.End()
+	return tm.svc.Restore(ctx, session, id)
+}
+
+// ListTags traces the "ListTags" operation of the wrapped clients.Service.
+func (tm *tracingMiddleware) ListTags(ctx context.Context, session authn.Session, offset, limit uint64) (clients.TagsPage, error) {
+	ctx, span := tm.tracer.Start(ctx, "list_client_tags", trace.WithAttributes(
+		attribute.Int64("offset", int64(offset)),
+		attribute.Int64("limit", int64(limit)),
+	))
+	defer span.End()
+	return tm.svc.ListTags(ctx, session, offset, limit)
+}
+
+// RenameTag traces the "RenameTag" operation of the wrapped clients.Service.
+func (tm *tracingMiddleware) RenameTag(ctx context.Context, session authn.Session, oldTag, newTag string) (uint64, error) {
+	ctx, span := tm.tracer.Start(ctx, "rename_client_tag", trace.WithAttributes(
+		attribute.String("old_tag", oldTag),
+		attribute.String("new_tag", newTag),
+	))
+	defer span.End()
+	return tm.svc.RenameTag(ctx, session, oldTag, newTag)
+}
+
+func (tm *tracingMiddleware) Share(ctx context.Context, session authn.Session, id, relation string, userIDs ...string) error {
+	ctx, span := tm.tracer.Start(ctx, "share_client", trace.WithAttributes(
+		attribute.String("id", id),
+		attribute.String("relation", relation),
+		attribute.StringSlice("user_ids", userIDs),
+	))
+	defer span.End()
+	return tm.svc.Share(ctx, session, id, relation, userIDs...)
+}
+
+// ListShares traces the "ListShares" operation of the wrapped clients.Service.
+func (tm *tracingMiddleware) ListShares(ctx context.Context, session authn.Session, id string, limit, offset uint64) (roles.EntityMembersPage, error) {
+	ctx, span := tm.tracer.Start(ctx, "list_client_shares", trace.WithAttributes(attribute.String("id", id)))
+	defer span.End()
+	return tm.svc.ListShares(ctx, session, id, limit, offset)
+}
+
+// Claim traces the "Claim" operation of the wrapped clients.Service.
+func (tm *tracingMiddleware) Claim(ctx context.Context, session authn.Session, claimCode string) (clients.Client, error) {
+	ctx, span := tm.tracer.Start(ctx, "claim_client")
+	defer span.End()
+	return tm.svc.Claim(ctx, session, claimCode)
+}
+
+// AddRelation traces the "AddRelation" operation of the wrapped clients.Service.
+func (tm *tracingMiddleware) AddRelation(ctx context.Context, session authn.Session, rel clients.ClientRelation) error {
+	ctx, span := tm.tracer.Start(ctx, "add_client_relation", trace.WithAttributes(
+		attribute.String("subject_id", rel.SubjectID),
+		attribute.String("relation", rel.Relation),
+		attribute.String("object_id", rel.ObjectID),
+	))
+	defer span.End()
+	return tm.svc.AddRelation(ctx, session, rel)
+}
+
+// RemoveRelation traces the "RemoveRelation" operation of the wrapped clients.Service.
+func (tm *tracingMiddleware) RemoveRelation(ctx context.Context, session authn.Session, rel clients.ClientRelation) error {
+	ctx, span := tm.tracer.Start(ctx, "remove_client_relation", trace.WithAttributes(
+		attribute.String("subject_id", rel.SubjectID),
+		attribute.String("relation", rel.Relation),
+		attribute.String("object_id", rel.ObjectID),
+	))
+	defer span.End()
+	return tm.svc.RemoveRelation(ctx, session, rel)
+}
+
+// ListRelated traces the "ListRelated" operation of the wrapped clients.Service.
+func (tm *tracingMiddleware) ListRelated(ctx context.Context, session authn.Session, id, relation string, depth uint64) (clients.RelatedClientsPage, error) {
+	ctx, span := tm.tracer.Start(ctx, "list_related_clients", trace.WithAttributes(
+		attribute.String("id", id),
+		attribute.String("relation", relation),
+		attribute.Int64("depth", int64(depth)),
+	))
+	defer span.End()
+	return tm.svc.ListRelated(ctx, session, id, relation, depth)
+}
+
 func (tm *tracingMiddleware) SetParentGroup(ctx context.Context, session authn.Session, parentGroupID string, id string) error {
 	ctx, span := tm.tracer.Start(ctx, "set_parent_group", trace.WithAttributes(
 		attribute.String("id", id),
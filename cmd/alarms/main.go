@@ -0,0 +1,168 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package main contains alarms main function to start the alarms service.
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	chclient "github.com/absmach/callhome/pkg/client"
+	"github.com/absmach/supermq"
+	"github.com/absmach/supermq/alarms"
+	alarmspg "github.com/absmach/supermq/alarms/postgres"
+	smqapi "github.com/absmach/supermq/api/http"
+	smqlog "github.com/absmach/supermq/logger"
+	authsvcAuthn "github.com/absmach/supermq/pkg/authn/authsvc"
+	"github.com/absmach/supermq/pkg/events/store"
+	"github.com/absmach/supermq/pkg/grpcclient"
+	"github.com/absmach/supermq/pkg/messaging"
+	"github.com/absmach/supermq/pkg/messaging/brokers"
+	pgclient "github.com/absmach/supermq/pkg/postgres"
+	"github.com/absmach/supermq/pkg/server"
+	"github.com/absmach/supermq/pkg/server/http"
+	"github.com/absmach/supermq/pkg/uuid"
+	"github.com/caarlos0/env/v11"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	svcName        = "alarms"
+	envPrefixHTTP  = "SMQ_ALARMS_HTTP_"
+	envPrefixAuth  = "SMQ_AUTH_GRPC_"
+	envPrefixDB    = "SMQ_ALARMS_DB_"
+	defDB          = "alarms"
+	defSvcHTTPPort = "9027"
+	streamID       = "supermq.alarms"
+)
+
+type config struct {
+	LogLevel      string `env:"SMQ_ALARMS_LOG_LEVEL"   envDefault:"info"`
+	ReadOnly      bool   `env:"SMQ_ALARMS_READ_ONLY"   envDefault:"false"`
+	BrokerURL     string `env:"SMQ_MESSAGE_BROKER_URL" envDefault:"nats://localhost:4222"`
+	ESURL         string `env:"SMQ_ES_URL"             envDefault:"nats://localhost:4222"`
+	SendTelemetry bool   `env:"SMQ_SEND_TELEMETRY"     envDefault:"true"`
+	InstanceID    string `env:"SMQ_ALARMS_INSTANCE_ID" envDefault:""`
+	// How often maintenance window boundaries are checked for events.
+	MaintenanceInterval time.Duration `env:"SMQ_ALARMS_MAINTENANCE_INTERVAL" envDefault:"1m"`
+}
+
+func main() {
+	ctx, cancel := context.WithCancel(context.Background())
+	g, ctx := errgroup.WithContext(ctx)
+
+	cfg := config{}
+	if err := env.Parse(&cfg); err != nil {
+		log.Fatalf("failed to load %s configuration : %s", svcName, err)
+	}
+
+	logger, err := smqlog.New(os.Stdout, cfg.LogLevel)
+	if err != nil {
+		log.Fatalf("failed to init logger: %s", err)
+	}
+
+	var exitCode int
+	defer smqlog.ExitWithError(&exitCode)
+
+	if cfg.InstanceID == "" {
+		if cfg.InstanceID, err = uuid.New().ID(); err != nil {
+			logger.Error(fmt.Sprintf("failed to generate instanceID: %s", err))
+			exitCode = 1
+			return
+		}
+	}
+
+	authClientCfg := grpcclient.Config{}
+	if err := env.ParseWithOptions(&authClientCfg, env.Options{Prefix: envPrefixAuth}); err != nil {
+		logger.Error(fmt.Sprintf("failed to load auth gRPC client configuration : %s", err))
+		exitCode = 1
+		return
+	}
+
+	authn, authnHandler, err := authsvcAuthn.NewAuthentication(ctx, authClientCfg)
+	if err != nil {
+		logger.Error(err.Error())
+		exitCode = 1
+		return
+	}
+	defer authnHandler.Close()
+	logger.Info("AuthN successfully connected to auth gRPC server " + authnHandler.Secure())
+
+	dbConfig := pgclient.Config{Name: defDB}
+	if err := env.ParseWithOptions(&dbConfig, env.Options{Prefix: envPrefixDB}); err != nil {
+		logger.Error(fmt.Sprintf("failed to load %s database configuration : %s", svcName, err))
+		exitCode = 1
+		return
+	}
+	db, err := pgclient.Setup(dbConfig, *alarmspg.Migration())
+	if err != nil {
+		logger.Error(err.Error())
+		exitCode = 1
+		return
+	}
+	defer db.Close()
+
+	es, err := store.NewPublisher(ctx, cfg.ESURL, streamID)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to connect to event store: %s", err))
+		exitCode = 1
+		return
+	}
+	defer es.Close()
+
+	svc := alarms.NewService(alarmspg.NewRepository(db), uuid.New(), es)
+
+	pubsub, err := brokers.NewPubSub(ctx, cfg.BrokerURL, logger)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to connect to message broker: %s", err))
+		exitCode = 1
+		return
+	}
+	defer pubsub.Close()
+
+	subCfg := messaging.SubscriberConfig{
+		ID:      svcName + "-" + cfg.InstanceID,
+		Topic:   brokers.SubjectAllChannels,
+		Handler: alarms.NewMessageHandler(svc),
+	}
+	if err := pubsub.Subscribe(ctx, subCfg); err != nil {
+		logger.Error(fmt.Sprintf("failed to subscribe to message broker: %s", err))
+		exitCode = 1
+		return
+	}
+
+	httpServerConfig := server.Config{Port: defSvcHTTPPort}
+	if err := env.ParseWithOptions(&httpServerConfig, env.Options{Prefix: envPrefixHTTP}); err != nil {
+		logger.Error(fmt.Sprintf("failed to load %s HTTP server configuration : %s", svcName, err.Error()))
+		exitCode = 1
+		return
+	}
+
+	ro := smqapi.NewReadOnly(cfg.ReadOnly)
+	hs := http.NewServer(ctx, cancel, svcName, httpServerConfig, ro.Middleware(smqapi.VersionHandler(alarms.MakeHandler(svc, authn, cfg.InstanceID))), logger)
+
+	if cfg.SendTelemetry {
+		chc := chclient.New(svcName, supermq.Version, logger, cancel)
+		go chc.CallHome(ctx)
+	}
+
+	g.Go(func() error {
+		return svc.RunMaintenanceNotifier(ctx, cfg.MaintenanceInterval)
+	})
+
+	g.Go(func() error {
+		return hs.Start()
+	})
+
+	g.Go(func() error {
+		return server.StopSignalHandler(ctx, cancel, logger, svcName, hs)
+	})
+
+	if err := g.Wait(); err != nil {
+		logger.Error(fmt.Sprintf("%s service terminated: %s", svcName, err))
+	}
+}
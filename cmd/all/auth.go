@@ -0,0 +1,141 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	grpcAuthV1 "github.com/absmach/supermq/api/grpc/auth/v1"
+	grpcTokenV1 "github.com/absmach/supermq/api/grpc/token/v1"
+	smqapi "github.com/absmach/supermq/api/http"
+	"github.com/absmach/supermq/auth"
+	api "github.com/absmach/supermq/auth/api"
+	authgrpcapi "github.com/absmach/supermq/auth/api/grpc/auth"
+	tokengrpcapi "github.com/absmach/supermq/auth/api/grpc/token"
+	httpapi "github.com/absmach/supermq/auth/api/http"
+	"github.com/absmach/supermq/auth/bolt"
+	authevents "github.com/absmach/supermq/auth/events"
+	"github.com/absmach/supermq/auth/hasher"
+	"github.com/absmach/supermq/auth/jwt"
+	apostgres "github.com/absmach/supermq/auth/postgres"
+	"github.com/absmach/supermq/auth/tracing"
+	boltclient "github.com/absmach/supermq/internal/clients/bolt"
+	"github.com/absmach/supermq/pkg/events/store"
+	"github.com/absmach/supermq/pkg/policies/spicedb"
+	pgclient "github.com/absmach/supermq/pkg/postgres"
+	"github.com/absmach/supermq/pkg/prometheus"
+	"github.com/absmach/supermq/pkg/server"
+	grpcserver "github.com/absmach/supermq/pkg/server/grpc"
+	httpserver "github.com/absmach/supermq/pkg/server/http"
+	"github.com/absmach/supermq/pkg/uuid"
+	"github.com/authzed/authzed-go/v1"
+	"github.com/caarlos0/env/v11"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+)
+
+const (
+	authSvcName        = "auth"
+	envPrefixAuthHTTP  = "SMQ_AUTH_HTTP_"
+	envPrefixAuthGRPC  = "SMQ_AUTH_GRPC_"
+	envPrefixAuthDB    = "SMQ_AUTH_DB_"
+	envPrefixAuthPATDB = "SMQ_AUTH_PAT_DB_"
+	defAuthDB          = "auth"
+)
+
+type authConfig struct {
+	SecretKey          string        `env:"SMQ_AUTH_SECRET_KEY"             envDefault:"secret"`
+	AccessDuration     time.Duration `env:"SMQ_AUTH_ACCESS_TOKEN_DURATION"  envDefault:"1h"`
+	RefreshDuration    time.Duration `env:"SMQ_AUTH_REFRESH_TOKEN_DURATION" envDefault:"24h"`
+	InvitationDuration time.Duration `env:"SMQ_AUTH_INVITATION_DURATION"    envDefault:"168h"`
+	RevokeKeysOnDelete bool          `env:"SMQ_AUTH_REVOKE_KEYS_ON_DELETE"  envDefault:"true"`
+}
+
+func runAuth(ctx context.Context, cancel context.CancelFunc, g *errgroup.Group, cfg config, logger *slog.Logger, tp trace.TracerProvider, spicedbClient *authzed.ClientWithExperimental) ([]server.Server, error) {
+	c := authConfig{}
+	if err := env.Parse(&c); err != nil {
+		return nil, fmt.Errorf("failed to load %s configuration : %w", authSvcName, err)
+	}
+
+	dbConfig := pgclient.Config{Name: defAuthDB}
+	if err := env.ParseWithOptions(&dbConfig, env.Options{Prefix: envPrefixAuthDB}); err != nil {
+		return nil, err
+	}
+
+	am := apostgres.Migration()
+	db, err := pgclient.Setup(dbConfig, *am)
+	if err != nil {
+		return nil, err
+	}
+
+	boltDBConfig := boltclient.Config{}
+	if err := env.ParseWithOptions(&boltDBConfig, env.Options{Prefix: envPrefixAuthPATDB}); err != nil {
+		return nil, fmt.Errorf("failed to parse bolt db config : %w", err)
+	}
+
+	bClient, err := boltclient.Connect(boltDBConfig, bolt.Init)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to bolt db : %w", err)
+	}
+
+	tracer := tp.Tracer(authSvcName)
+
+	database := pgclient.NewDatabase(db, dbConfig, tracer)
+	keysRepo := apostgres.New(database)
+	patsRepo := bolt.NewPATSRepository(bClient, boltDBConfig.Bucket)
+	hsr := hasher.New()
+	idProvider := uuid.New()
+
+	pEvaluator := spicedb.NewPolicyEvaluator(spicedbClient, logger)
+	pService := spicedb.NewPolicyService(spicedbClient, logger)
+
+	t := jwt.New([]byte(c.SecretKey))
+
+	svc := auth.New(keysRepo, patsRepo, hsr, idProvider, t, pEvaluator, pService, c.AccessDuration, c.RefreshDuration, c.InvitationDuration)
+	svc = api.LoggingMiddleware(svc, logger)
+	counter, latency := prometheus.MakeMetrics(authSvcName, "api")
+	svc = api.MetricsMiddleware(svc, counter, latency)
+	svc = tracing.New(svc, tracer)
+
+	if c.RevokeKeysOnDelete {
+		subscriber, err := store.NewSubscriber(ctx, cfg.ESURL, logger)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create subscriber: %w", err)
+		}
+		if err := authevents.Start(ctx, authSvcName, subscriber, keysRepo); err != nil {
+			return nil, fmt.Errorf("failed to start %s event consumer: %w", authSvcName, err)
+		}
+	}
+
+	grpcSvrConfig, err := grpcServerConfig(envPrefixAuthGRPC, defAuthGRPCPort)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load %s gRPC server configuration : %w", authSvcName, err)
+	}
+	registerAuthServiceServer := func(srv *grpc.Server) {
+		reflection.Register(srv)
+		grpcTokenV1.RegisterTokenServiceServer(srv, tokengrpcapi.NewTokenServer(svc))
+		grpcAuthV1.RegisterAuthServiceServer(srv, authgrpcapi.NewAuthServer(svc))
+	}
+	gs := grpcserver.NewServer(ctx, cancel, authSvcName, grpcSvrConfig, registerAuthServiceServer, logger)
+
+	httpSvrConfig, err := httpServerConfig(envPrefixAuthHTTP, defAuthHTTPPort)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load %s HTTP server configuration : %w", authSvcName, err)
+	}
+	hs := httpserver.NewServer(ctx, cancel, authSvcName, httpSvrConfig, smqapi.VersionHandler(httpapi.MakeHandler(svc, logger, cfg.InstanceID)), logger)
+
+	g.Go(func() error {
+		return gs.Start()
+	})
+	g.Go(func() error {
+		return hs.Start()
+	})
+
+	return []server.Server{gs, hs}, nil
+}
@@ -0,0 +1,212 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	grpcChannelsV1 "github.com/absmach/supermq/api/grpc/channels/v1"
+	smqapi "github.com/absmach/supermq/api/http"
+	"github.com/absmach/supermq/channels"
+	chgrpcapi "github.com/absmach/supermq/channels/api/grpc"
+	chhttpapi "github.com/absmach/supermq/channels/api/http"
+	chevents "github.com/absmach/supermq/channels/events"
+	chmiddleware "github.com/absmach/supermq/channels/middleware"
+	chpostgres "github.com/absmach/supermq/channels/postgres"
+	pChannels "github.com/absmach/supermq/channels/private"
+	chtracing "github.com/absmach/supermq/channels/tracing"
+	authsvcAuthn "github.com/absmach/supermq/pkg/authn/authsvc"
+	authsvcAuthz "github.com/absmach/supermq/pkg/authz/authsvc"
+	domainsAuthz "github.com/absmach/supermq/pkg/domains/grpcclient"
+	store "github.com/absmach/supermq/pkg/events/store"
+	"github.com/absmach/supermq/pkg/grpcclient"
+	"github.com/absmach/supermq/pkg/metering"
+	"github.com/absmach/supermq/pkg/policies"
+	pg "github.com/absmach/supermq/pkg/postgres"
+	pgclient "github.com/absmach/supermq/pkg/postgres"
+	pgevents "github.com/absmach/supermq/pkg/postgres/events"
+	"github.com/absmach/supermq/pkg/prometheus"
+	"github.com/absmach/supermq/pkg/server"
+	grpcserver "github.com/absmach/supermq/pkg/server/grpc"
+	httpserver "github.com/absmach/supermq/pkg/server/http"
+	"github.com/absmach/supermq/pkg/sid"
+	"github.com/absmach/supermq/pkg/uuid"
+	"github.com/caarlos0/env/v11"
+	"github.com/go-chi/chi/v5"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+)
+
+const (
+	channelsSvcName       = "channels"
+	envPrefixChannelsDB   = "SMQ_CHANNELS_DB_"
+	envPrefixChannelsHTTP = "SMQ_CHANNELS_HTTP_"
+	envPrefixChannelsGRPC = "SMQ_CHANNELS_GRPC_"
+	envPrefixClientsGRPC  = "SMQ_CLIENTS_AUTH_GRPC_"
+	envPrefixGroupsGRPC   = "SMQ_GROUPS_GRPC_"
+	defChannelsDB         = "channels"
+)
+
+type channelsConfig struct {
+	Quota string `env:"SMQ_CHANNELS_QUOTA" envDefault:""`
+}
+
+func runChannels(ctx context.Context, cancel context.CancelFunc, g *errgroup.Group, cfg config, logger *slog.Logger, tp trace.TracerProvider, policyEvaluator policies.Evaluator, policyService policies.Service) ([]server.Server, error) {
+	c := channelsConfig{}
+	if err := env.Parse(&c); err != nil {
+		return nil, fmt.Errorf("failed to load %s configuration : %w", channelsSvcName, err)
+	}
+
+	dbConfig := pgclient.Config{Name: defChannelsDB}
+	if err := env.ParseWithOptions(&dbConfig, env.Options{Prefix: envPrefixChannelsDB}); err != nil {
+		return nil, err
+	}
+	migrations, err := chpostgres.Migration()
+	if err != nil {
+		return nil, err
+	}
+	db, err := pgclient.Setup(dbConfig, *migrations)
+	if err != nil {
+		return nil, err
+	}
+
+	tracer := tp.Tracer(channelsSvcName)
+
+	authClientConfig, err := grpcClientConfig(envPrefixAuthGRPC, defAuthGRPCURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load auth gRPC client configuration : %w", err)
+	}
+	authn, authnClient, err := authsvcAuthn.NewAuthentication(ctx, authClientConfig)
+	if err != nil {
+		return nil, err
+	}
+	logger.Info("AuthN  successfully connected to auth gRPC server " + authnClient.Secure())
+
+	domsGrpcCfg, err := grpcClientConfig(envPrefixDomainsGRPC, defDomainsGRPCURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load domains gRPC client configuration : %w", err)
+	}
+	domAuthz, _, _, err := domainsAuthz.NewAuthorization(ctx, domsGrpcCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	authz, authzClient, err := authsvcAuthz.NewAuthorization(ctx, authClientConfig, domAuthz)
+	if err != nil {
+		return nil, err
+	}
+	logger.Info("AuthZ  successfully connected to auth gRPC server " + authzClient.Secure())
+
+	clientsGrpcCfg, err := grpcClientConfig(envPrefixClientsGRPC, defClientsGRPCURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load clients gRPC client configuration : %w", err)
+	}
+	clientsClient, clientsHandler, err := grpcclient.SetupClientsClient(ctx, clientsGrpcCfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to clients gRPC server: %w", err)
+	}
+	logger.Info("Clients gRPC client successfully connected to clients gRPC server " + clientsHandler.Secure())
+
+	groupsGrpcCfg, err := grpcClientConfig(envPrefixGroupsGRPC, defGroupsGRPCURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load groups gRPC client configuration : %w", err)
+	}
+	groupsClient, groupsHandler, err := grpcclient.SetupGroupsClient(ctx, groupsGrpcCfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to groups gRPC server: %w", err)
+	}
+	logger.Info("Groups gRPC client successfully connected to groups gRPC server " + groupsHandler.Secure())
+
+	quota, err := metering.ParseQuota(c.Quota)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s quota configuration : %w", channelsSvcName, err)
+	}
+	meter := metering.New(quota)
+
+	var database pg.Database = pg.NewDatabase(db, dbConfig, tracer)
+	if dbConfig.TenantSchemas {
+		tenantDB := pg.NewTenantDatabase(database, dbConfig, tracer)
+		subscriber, err := store.NewSubscriber(ctx, cfg.ESURL, logger)
+		if err != nil {
+			return nil, err
+		}
+		migrations, err := chpostgres.Migration()
+		if err != nil {
+			return nil, err
+		}
+		if err := pgevents.Start(ctx, channelsSvcName, subscriber, tenantDB, *migrations); err != nil {
+			return nil, err
+		}
+		database = tenantDB
+	}
+	if dbConfig.RowLevelSecurity {
+		database = pg.NewRLSDatabase(database)
+	}
+	repo := chpostgres.NewRepository(database)
+
+	meter.RegisterCounter(metering.ResourceChannels, func(ctx context.Context, domainID string) (uint64, error) {
+		page, err := repo.RetrieveAll(ctx, channels.PageMetadata{Domain: domainID, Limit: 1})
+		if err != nil {
+			return 0, err
+		}
+		return page.Total, nil
+	})
+
+	idp := uuid.New()
+	sidp, err := sid.New()
+	if err != nil {
+		return nil, err
+	}
+
+	svc, err := channels.New(repo, policyService, idp, clientsClient, groupsClient, sidp)
+	if err != nil {
+		return nil, err
+	}
+	svc = chmiddleware.QuotaMiddleware(svc, meter)
+	svc, err = chevents.NewEventStoreMiddleware(ctx, svc, cfg.ESURL)
+	if err != nil {
+		return nil, err
+	}
+	svc = chtracing.New(svc, tracer)
+	counter, latency := prometheus.MakeMetrics(channelsSvcName, "api")
+	svc = chmiddleware.MetricsMiddleware(svc, counter, latency)
+	svc, err = chmiddleware.AuthorizationMiddleware(svc, repo, authz, channels.NewOperationPermissionMap(), channels.NewRolesOperationPermissionMap(), channels.NewExternalOperationPermissionMap())
+	if err != nil {
+		return nil, err
+	}
+	svc = chmiddleware.LoggingMiddleware(svc, logger)
+
+	psvc := pChannels.New(repo, policyEvaluator, policyService)
+
+	grpcSvrConfig, err := grpcServerConfig(envPrefixChannelsGRPC, defChannelsGRPCPort)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load %s gRPC server configuration : %w", channelsSvcName, err)
+	}
+	registerChannelsServer := func(srv *grpc.Server) {
+		reflection.Register(srv)
+		grpcChannelsV1.RegisterChannelsServiceServer(srv, chgrpcapi.NewServer(psvc))
+	}
+	gs := grpcserver.NewServer(ctx, cancel, channelsSvcName, grpcSvrConfig, registerChannelsServer, logger)
+
+	httpSvrConfig, err := httpServerConfig(envPrefixChannelsHTTP, defChannelsHTTPPort)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load %s HTTP server configuration : %w", channelsSvcName, err)
+	}
+	mux := chi.NewRouter()
+	mux.Handle("/usage", meter.Handler(authn, authz))
+	hs := httpserver.NewServer(ctx, cancel, channelsSvcName, httpSvrConfig, smqapi.VersionHandler(chhttpapi.MakeHandler(svc, authn, mux, logger, cfg.InstanceID)), logger)
+
+	g.Go(func() error {
+		return gs.Start()
+	})
+	g.Go(func() error {
+		return hs.Start()
+	})
+
+	return []server.Server{gs, hs}, nil
+}
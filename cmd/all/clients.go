@@ -0,0 +1,307 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	grpcClientsV1 "github.com/absmach/supermq/api/grpc/clients/v1"
+	smqapi "github.com/absmach/supermq/api/http"
+	"github.com/absmach/supermq/clients"
+	clgrpcapi "github.com/absmach/supermq/clients/api/grpc"
+	clhttpapi "github.com/absmach/supermq/clients/api/http"
+	clcache "github.com/absmach/supermq/clients/cache"
+	clevents "github.com/absmach/supermq/clients/events"
+	clmiddleware "github.com/absmach/supermq/clients/middleware"
+	clpostgres "github.com/absmach/supermq/clients/postgres"
+	pClients "github.com/absmach/supermq/clients/private"
+	cltracing "github.com/absmach/supermq/clients/tracing"
+	redisclient "github.com/absmach/supermq/internal/clients/redis"
+	authsvcAuthn "github.com/absmach/supermq/pkg/authn/authsvc"
+	authsvcAuthz "github.com/absmach/supermq/pkg/authz/authsvc"
+	domainsAuthz "github.com/absmach/supermq/pkg/domains/grpcclient"
+	store "github.com/absmach/supermq/pkg/events/store"
+	"github.com/absmach/supermq/pkg/grpcclient"
+	"github.com/absmach/supermq/pkg/idprovider"
+	"github.com/absmach/supermq/pkg/metadata"
+	"github.com/absmach/supermq/pkg/metering"
+	"github.com/absmach/supermq/pkg/outbox"
+	outboxpg "github.com/absmach/supermq/pkg/outbox/postgres"
+	"github.com/absmach/supermq/pkg/policies"
+	policiescache "github.com/absmach/supermq/pkg/policies/cache"
+	policiesevents "github.com/absmach/supermq/pkg/policies/events"
+	pg "github.com/absmach/supermq/pkg/postgres"
+	pgclient "github.com/absmach/supermq/pkg/postgres"
+	pgevents "github.com/absmach/supermq/pkg/postgres/events"
+	"github.com/absmach/supermq/pkg/prometheus"
+	"github.com/absmach/supermq/pkg/reconciler"
+	"github.com/absmach/supermq/pkg/server"
+	grpcserver "github.com/absmach/supermq/pkg/server/grpc"
+	httpserver "github.com/absmach/supermq/pkg/server/http"
+	"github.com/absmach/supermq/pkg/sid"
+	"github.com/caarlos0/env/v11"
+	"github.com/go-chi/chi/v5"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+)
+
+const (
+	clientsSvcName       = "clients"
+	envPrefixClientsDB   = "SMQ_CLIENTS_DB_"
+	envPrefixClientsHTTP = "SMQ_CLIENTS_HTTP_"
+	envPrefixClientsID   = "SMQ_CLIENTS_ID_"
+	defClientsDB         = "clients"
+)
+
+type clientsConfig struct {
+	AdminOnlyTags       bool          `env:"SMQ_CLIENTS_ADMIN_ONLY_TAGS"       envDefault:"false"`
+	CacheURL            string        `env:"SMQ_CLIENTS_CACHE_URL"             envDefault:"redis://localhost:6379/0"`
+	CacheKeyDuration    time.Duration `env:"SMQ_CLIENTS_CACHE_KEY_DURATION"    envDefault:"10m"`
+	PolicyCacheDuration time.Duration `env:"SMQ_CLIENTS_POLICY_CACHE_DURATION" envDefault:"10s"`
+	CacheEntityDuration time.Duration `env:"SMQ_CLIENTS_CACHE_ENTITY_DURATION" envDefault:"0s"`
+	Quota               string        `env:"SMQ_CLIENTS_QUOTA"                 envDefault:""`
+	DeleteInterval      time.Duration `env:"SMQ_CLIENTS_DELETE_INTERVAL"       envDefault:"24h"`
+	DeleteAfter         time.Duration `env:"SMQ_CLIENTS_DELETE_AFTER"          envDefault:"720h"`
+	OutboxInterval      time.Duration `env:"SMQ_CLIENTS_OUTBOX_INTERVAL"       envDefault:"1m"`
+	OutboxMaxRetries    uint64        `env:"SMQ_CLIENTS_OUTBOX_MAX_RETRIES"    envDefault:"3"`
+	ReconcileInterval   time.Duration `env:"SMQ_CLIENTS_RECONCILE_INTERVAL"    envDefault:"0"`
+	ReconcileRepair     bool          `env:"SMQ_CLIENTS_RECONCILE_REPAIR"      envDefault:"false"`
+	ReconcileDryRun     bool          `env:"SMQ_CLIENTS_RECONCILE_DRY_RUN"     envDefault:"true"`
+}
+
+func runClients(ctx context.Context, cancel context.CancelFunc, g *errgroup.Group, cfg config, logger *slog.Logger, tp trace.TracerProvider, policyEvaluator policies.Evaluator, policyService policies.Service) ([]server.Server, error) {
+	c := clientsConfig{}
+	if err := env.Parse(&c); err != nil {
+		return nil, fmt.Errorf("failed to load %s configuration : %w", clientsSvcName, err)
+	}
+
+	dbConfig := pgclient.Config{Name: defClientsDB}
+	if err := env.ParseWithOptions(&dbConfig, env.Options{Prefix: envPrefixClientsDB}); err != nil {
+		return nil, err
+	}
+	tm, err := clpostgres.Migration()
+	if err != nil {
+		return nil, err
+	}
+	db, err := pgclient.Setup(dbConfig, *tm)
+	if err != nil {
+		return nil, err
+	}
+
+	tracer := tp.Tracer(clientsSvcName)
+
+	cacheclient, err := redisclient.Connect(c.CacheURL)
+	if err != nil {
+		return nil, err
+	}
+
+	ps, err := policiesevents.NewEventStoreMiddleware(ctx, policyService, cfg.ESURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create policy event store middleware : %w", err)
+	}
+	if c.PolicyCacheDuration > 0 {
+		ps = policiescache.NewMiddleware(ps, c.PolicyCacheDuration)
+	}
+
+	authClientConfig, err := grpcClientConfig(envPrefixAuthGRPC, defAuthGRPCURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load auth gRPC client configuration : %w", err)
+	}
+	authCounter, authLatency := prometheus.MakeMetrics(clientsSvcName, "auth_client")
+	authn, authnClient, err := authsvcAuthn.NewAuthentication(ctx, authClientConfig, authsvcAuthn.WithMetrics(authCounter, authLatency))
+	if err != nil {
+		return nil, err
+	}
+	logger.Info("AuthN  successfully connected to auth gRPC server " + authnClient.Secure())
+
+	domsGrpcCfg, err := grpcClientConfig(envPrefixDomainsGRPC, defDomainsGRPCURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load domains gRPC client configuration : %w", err)
+	}
+	domAuthz, _, _, err := domainsAuthz.NewAuthorization(ctx, domsGrpcCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	authz, authzClient, err := authsvcAuthz.NewAuthorization(ctx, authClientConfig, domAuthz, authsvcAuthz.WithMetrics(authCounter, authLatency))
+	if err != nil {
+		return nil, err
+	}
+	logger.Info("AuthZ  successfully connected to auth gRPC server " + authzClient.Secure())
+
+	chGrpcCfg, err := grpcClientConfig(envPrefixChannelsGRPC, defChannelsGRPCURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load channels gRPC client configuration : %w", err)
+	}
+	channelsClient, channelsHandler, err := grpcclient.SetupChannelsClient(ctx, chGrpcCfg)
+	if err != nil {
+		return nil, err
+	}
+	logger.Info("Channels gRPC client successfully connected to channels gRPC server " + channelsHandler.Secure())
+
+	groupsGrpcCfg, err := grpcClientConfig(envPrefixGroupsGRPC, defGroupsGRPCURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load groups gRPC client configuration : %w", err)
+	}
+	groupsClient, groupsHandler, err := grpcclient.SetupGroupsClient(ctx, groupsGrpcCfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to groups gRPC server: %w", err)
+	}
+	logger.Info("Groups gRPC client successfully connected to groups gRPC server " + groupsHandler.Secure())
+
+	quota, err := metering.ParseQuota(c.Quota)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s quota configuration : %w", clientsSvcName, err)
+	}
+	meter := metering.New(quota)
+
+	recOpts := []reconciler.Option{}
+	if c.ReconcileRepair {
+		recOpts = append(recOpts, reconciler.WithRepair(c.ReconcileDryRun))
+	}
+	rec := reconciler.New(ps, recOpts...)
+
+	mdRegistry := metadata.NewRegistry()
+
+	idpConfig := idprovider.Config{}
+	if err := env.ParseWithOptions(&idpConfig, env.Options{Prefix: envPrefixClientsID}); err != nil {
+		return nil, fmt.Errorf("failed to load %s ID provider configuration : %w", clientsSvcName, err)
+	}
+	idp, err := idprovider.New(idpConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s ID provider : %w", clientsSvcName, err)
+	}
+
+	var database pg.Database = pg.NewDatabase(db, dbConfig, tracer)
+	if dbConfig.TenantSchemas {
+		tenantDB := pg.NewTenantDatabase(database, dbConfig, tracer)
+		subscriber, err := store.NewSubscriber(ctx, cfg.ESURL, logger)
+		if err != nil {
+			return nil, err
+		}
+		migrations, err := clpostgres.Migration()
+		if err != nil {
+			return nil, err
+		}
+		if err := pgevents.Start(ctx, clientsSvcName, subscriber, tenantDB, *migrations); err != nil {
+			return nil, err
+		}
+		database = tenantDB
+	}
+	if dbConfig.RowLevelSecurity {
+		database = pg.NewRLSDatabase(database)
+	}
+	var repo clients.Repository = clpostgres.NewRepository(database)
+	if c.CacheEntityDuration > 0 {
+		repo = clcache.NewRepository(repo, cacheclient, c.CacheEntityDuration)
+	}
+
+	meter.RegisterCounter(metering.ResourceClients, func(ctx context.Context, domainID string) (uint64, error) {
+		page, err := repo.RetrieveAll(ctx, clients.Page{Domain: domainID, Limit: 1})
+		if err != nil {
+			return 0, err
+		}
+		return page.Total, nil
+	})
+
+	rec.RegisterEntity(policies.ClientType, func(ctx context.Context) ([]reconciler.Entity, error) {
+		entities := []reconciler.Entity{}
+		for offset := uint64(0); ; offset += 100 {
+			page, err := repo.RetrieveAll(ctx, clients.Page{Offset: offset, Limit: 100, Status: clients.AllStatus})
+			if err != nil {
+				return nil, err
+			}
+			for _, cl := range page.Clients {
+				entities = append(entities, reconciler.Entity{ID: cl.ID, Domain: cl.Domain})
+			}
+			if offset+100 >= page.Total {
+				return entities, nil
+			}
+		}
+	})
+
+	sidp, err := sid.New()
+	if err != nil {
+		return nil, err
+	}
+
+	cache := clcache.NewCache(cacheclient, c.CacheKeyDuration)
+
+	ob := outbox.NewCoordinator(outboxpg.NewRepository(database), ps, idp, c.OutboxMaxRetries)
+	ob.RegisterCompensator(policies.ClientType, func(ctx context.Context, op outbox.Operation) error {
+		return repo.Delete(ctx, op.EntityID)
+	})
+
+	svcOpts := []clients.Option{clients.WithOutbox(ob), clients.WithMetadataValidator(mdRegistry)}
+	if c.AdminOnlyTags {
+		svcOpts = append(svcOpts, clients.WithAdminOnlyTags())
+	}
+	csvc, err := clients.NewService(repo, ps, cache, channelsClient, groupsClient, idp, sidp, svcOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	clients.NewDeleteHandler(ctx, repo, ps, c.DeleteInterval, c.DeleteAfter, logger)
+	csvc = clmiddleware.QuotaMiddleware(csvc, meter)
+	csvc, err = clevents.NewEventStoreMiddleware(ctx, csvc, cfg.ESURL)
+	if err != nil {
+		return nil, err
+	}
+	csvc = cltracing.New(csvc, tracer)
+	counter, latency := prometheus.MakeMetrics(clientsSvcName, "api")
+	csvc = clmiddleware.MetricsMiddleware(csvc, counter, latency)
+	csvc, err = clmiddleware.AuthorizationMiddleware(policies.ClientType, csvc, authz, repo, clients.NewOperationPermissionMap(), clients.NewRolesOperationPermissionMap(), clients.NewExternalOperationPermissionMap())
+	if err != nil {
+		return nil, err
+	}
+	csvc = clmiddleware.LoggingMiddleware(csvc, logger)
+
+	psvc := pClients.New(repo, cache, policyEvaluator, ps)
+
+	ob.Start(ctx, c.OutboxInterval, logger)
+	if c.ReconcileInterval > 0 {
+		rec.Start(ctx, c.ReconcileInterval, logger)
+	}
+
+	httpSvrConfig, err := httpServerConfig(envPrefixClientsHTTP, defClientsHTTPPort)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load %s HTTP server configuration : %w", clientsSvcName, err)
+	}
+	adminOnly := chi.Middlewares{smqapi.AuthenticateMiddleware(authn, false), smqapi.AuthorizeAdminMiddleware(authz)}
+	mux := chi.NewRouter()
+	mux.Get("/ready", httpserver.Ready(clientsSvcName, cfg.InstanceID,
+		httpserver.DBCheck(db),
+		httpserver.CacheCheck(cacheclient),
+		httpserver.GRPCCheck("auth", authnClient.Connection()),
+	))
+	mux.Handle("/usage", meter.Handler(authn, authz))
+	mux.With(adminOnly...).Handle("/outbox", ob.Handler())
+	mux.With(adminOnly...).Handle("/reconcile", rec.Handler())
+	mux.Mount("/metadata", metadata.Handler(mdRegistry, authn, authz))
+	hs := httpserver.NewServer(ctx, cancel, clientsSvcName, httpSvrConfig, smqapi.VersionHandler(clhttpapi.MakeHandler(csvc, authn, mux, logger, cfg.InstanceID)), logger)
+
+	grpcSvrConfig, err := grpcServerConfig(envPrefixClientsGRPC, defClientsGRPCPort)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load %s gRPC server configuration : %w", clientsSvcName, err)
+	}
+	registerClientsServer := func(srv *grpc.Server) {
+		reflection.Register(srv)
+		grpcClientsV1.RegisterClientsServiceServer(srv, clgrpcapi.NewServer(psvc, csvc, authn))
+	}
+	gs := grpcserver.NewServer(ctx, cancel, clientsSvcName, grpcSvrConfig, registerClientsServer, logger)
+
+	g.Go(func() error {
+		return hs.Start()
+	})
+	g.Go(func() error {
+		return gs.Start()
+	})
+
+	return []server.Server{gs, hs}, nil
+}
@@ -0,0 +1,171 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	grpcDomainsV1 "github.com/absmach/supermq/api/grpc/domains/v1"
+	smqapi "github.com/absmach/supermq/api/http"
+	"github.com/absmach/supermq/domains"
+	domainsgrpcapi "github.com/absmach/supermq/domains/api/grpc"
+	httpapi "github.com/absmach/supermq/domains/api/http"
+	dcache "github.com/absmach/supermq/domains/cache"
+	devents "github.com/absmach/supermq/domains/events"
+	dmw "github.com/absmach/supermq/domains/middleware"
+	dpostgres "github.com/absmach/supermq/domains/postgres"
+	"github.com/absmach/supermq/domains/private"
+	dtracing "github.com/absmach/supermq/domains/tracing"
+	redisclient "github.com/absmach/supermq/internal/clients/redis"
+	authsvcAuthn "github.com/absmach/supermq/pkg/authn/authsvc"
+	authsvcAuthz "github.com/absmach/supermq/pkg/authz/authsvc"
+	domainsAuthz "github.com/absmach/supermq/pkg/domains/psvc"
+	"github.com/absmach/supermq/pkg/policies"
+	pgclient "github.com/absmach/supermq/pkg/postgres"
+	"github.com/absmach/supermq/pkg/prometheus"
+	"github.com/absmach/supermq/pkg/roles"
+	"github.com/absmach/supermq/pkg/server"
+	grpcserver "github.com/absmach/supermq/pkg/server/grpc"
+	httpserver "github.com/absmach/supermq/pkg/server/http"
+	"github.com/absmach/supermq/pkg/sid"
+	spicedbdecoder "github.com/absmach/supermq/pkg/spicedb"
+	"github.com/absmach/supermq/pkg/uuid"
+	"github.com/caarlos0/env/v11"
+	"github.com/go-chi/chi/v5"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+)
+
+const (
+	domainsSvcName       = "domains"
+	envPrefixDomainsHTTP = "SMQ_DOMAINS_HTTP_"
+	envPrefixDomainsGRPC = "SMQ_DOMAINS_GRPC_"
+	envPrefixDomainsDB   = "SMQ_DOMAINS_DB_"
+	defDomainsDB         = "domains"
+)
+
+type domainsConfig struct {
+	CacheURL         string        `env:"SMQ_DOMAINS_CACHE_URL"          envDefault:"redis://localhost:6379/0"`
+	CacheKeyDuration time.Duration `env:"SMQ_DOMAINS_CACHE_KEY_DURATION" envDefault:"10m"`
+	RoleTemplates    string        `env:"SMQ_DOMAINS_ROLE_TEMPLATES"     envDefault:""`
+}
+
+func runDomains(ctx context.Context, cancel context.CancelFunc, g *errgroup.Group, cfg config, logger *slog.Logger, tp trace.TracerProvider, policyService policies.Service) ([]server.Server, error) {
+	c := domainsConfig{}
+	if err := env.Parse(&c); err != nil {
+		return nil, fmt.Errorf("failed to load %s configuration : %w", domainsSvcName, err)
+	}
+
+	dbConfig := pgclient.Config{Name: defDomainsDB}
+	if err := env.ParseWithOptions(&dbConfig, env.Options{Prefix: envPrefixDomainsDB}); err != nil {
+		return nil, err
+	}
+
+	dm, err := dpostgres.Migration()
+	if err != nil {
+		return nil, fmt.Errorf("failed create migrations for domain: %w", err)
+	}
+
+	db, err := pgclient.Setup(dbConfig, *dm)
+	if err != nil {
+		return nil, err
+	}
+
+	tracer := tp.Tracer(domainsSvcName)
+
+	authClientConfig, err := grpcClientConfig(envPrefixAuthGRPC, defAuthGRPCURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load auth gRPC client configuration : %w", err)
+	}
+
+	authn, authnHandler, err := authsvcAuthn.NewAuthentication(ctx, authClientConfig)
+	if err != nil {
+		return nil, fmt.Errorf("authn failed to connect to auth gRPC server : %w", err)
+	}
+	logger.Info("Authn successfully connected to auth gRPC server " + authnHandler.Secure())
+
+	database := pgclient.NewDatabase(db, dbConfig, tracer)
+	domainsRepo := dpostgres.New(database)
+
+	cacheclient, err := redisclient.Connect(c.CacheURL)
+	if err != nil {
+		return nil, err
+	}
+	cache := dcache.NewDomainsCache(cacheclient, c.CacheKeyDuration)
+
+	psvc := private.New(domainsRepo, cache)
+
+	domAuthz := domainsAuthz.NewAuthorization(psvc)
+
+	authz, authzHandler, err := authsvcAuthz.NewAuthorization(ctx, authClientConfig, domAuthz)
+	if err != nil {
+		return nil, fmt.Errorf("authz failed to connect to auth gRPC server : %w", err)
+	}
+	logger.Info("Authz successfully connected to auth gRPC server " + authzHandler.Secure())
+
+	idProvider := uuid.New()
+	sidProvider, err := sid.New()
+	if err != nil {
+		return nil, fmt.Errorf("failed to init short id provider : %w", err)
+	}
+
+	availableActions, err := spicedbdecoder.GetActionsFromSchema(cfg.SpicedbSchemaFile, policies.DomainType)
+	if err != nil {
+		return nil, err
+	}
+	builtInRoles := map[roles.BuiltInRoleName][]roles.Action{
+		domains.BuiltInRoleAdmin: availableActions,
+	}
+	builtInRoles, err = roles.OverrideBuiltInRoles(builtInRoles, c.RoleTemplates)
+	if err != nil {
+		return nil, err
+	}
+
+	svc, err := domains.New(domainsRepo, cache, policyService, idProvider, sidProvider, availableActions, builtInRoles)
+	if err != nil {
+		return nil, fmt.Errorf("failed to init domain service: %w", err)
+	}
+	svc, err = devents.NewEventStoreMiddleware(ctx, svc, cfg.ESURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to init domain event store middleware: %w", err)
+	}
+	svc, err = dmw.AuthorizationMiddleware(policies.DomainType, svc, authz, domains.NewOperationPermissionMap(), domains.NewRolesOperationPermissionMap())
+	if err != nil {
+		return nil, err
+	}
+	counter, latency := prometheus.MakeMetrics(domainsSvcName, "api")
+	svc = dmw.MetricsMiddleware(svc, counter, latency)
+	svc = dmw.LoggingMiddleware(svc, logger)
+	svc = dtracing.New(svc, tracer)
+
+	grpcSvrConfig, err := grpcServerConfig(envPrefixDomainsGRPC, defDomainsGRPCPort)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load %s gRPC server configuration : %w", domainsSvcName, err)
+	}
+	registerDomainsServiceServer := func(srv *grpc.Server) {
+		reflection.Register(srv)
+		grpcDomainsV1.RegisterDomainsServiceServer(srv, domainsgrpcapi.NewDomainsServer(psvc))
+	}
+	gs := grpcserver.NewServer(ctx, cancel, domainsSvcName, grpcSvrConfig, registerDomainsServiceServer, logger)
+
+	httpSvrConfig, err := httpServerConfig(envPrefixDomainsHTTP, defDomainsHTTPPort)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load %s HTTP server configuration : %w", domainsSvcName, err)
+	}
+	hs := httpserver.NewServer(ctx, cancel, domainsSvcName, httpSvrConfig, smqapi.VersionHandler(httpapi.MakeHandler(svc, authn, chi.NewMux(), logger, cfg.InstanceID)), logger)
+
+	g.Go(func() error {
+		return gs.Start()
+	})
+	g.Go(func() error {
+		return hs.Start()
+	})
+
+	return []server.Server{gs, hs}, nil
+}
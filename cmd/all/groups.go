@@ -0,0 +1,243 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	grpcGroupsV1 "github.com/absmach/supermq/api/grpc/groups/v1"
+	smqapi "github.com/absmach/supermq/api/http"
+	"github.com/absmach/supermq/groups"
+	gpgrpcapi "github.com/absmach/supermq/groups/api/grpc"
+	gphttpapi "github.com/absmach/supermq/groups/api/http"
+	gpcache "github.com/absmach/supermq/groups/cache"
+	gpevents "github.com/absmach/supermq/groups/events"
+	gpmiddleware "github.com/absmach/supermq/groups/middleware"
+	gppostgres "github.com/absmach/supermq/groups/postgres"
+	pgroups "github.com/absmach/supermq/groups/private"
+	gptracing "github.com/absmach/supermq/groups/tracing"
+	redisclient "github.com/absmach/supermq/internal/clients/redis"
+	authsvcAuthn "github.com/absmach/supermq/pkg/authn/authsvc"
+	authsvcAuthz "github.com/absmach/supermq/pkg/authz/authsvc"
+	domainsAuthz "github.com/absmach/supermq/pkg/domains/grpcclient"
+	store "github.com/absmach/supermq/pkg/events/store"
+	"github.com/absmach/supermq/pkg/grpcclient"
+	"github.com/absmach/supermq/pkg/policies"
+	policiescache "github.com/absmach/supermq/pkg/policies/cache"
+	policiesevents "github.com/absmach/supermq/pkg/policies/events"
+	pg "github.com/absmach/supermq/pkg/postgres"
+	pgclient "github.com/absmach/supermq/pkg/postgres"
+	pgevents "github.com/absmach/supermq/pkg/postgres/events"
+	"github.com/absmach/supermq/pkg/prometheus"
+	"github.com/absmach/supermq/pkg/roles"
+	"github.com/absmach/supermq/pkg/server"
+	grpcserver "github.com/absmach/supermq/pkg/server/grpc"
+	httpserver "github.com/absmach/supermq/pkg/server/http"
+	"github.com/absmach/supermq/pkg/sid"
+	spicedbdecoder "github.com/absmach/supermq/pkg/spicedb"
+	"github.com/absmach/supermq/pkg/uuid"
+	"github.com/caarlos0/env/v11"
+	"github.com/go-chi/chi/v5"
+	"github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+)
+
+const (
+	groupsSvcName       = "groups"
+	envPrefixGroupsDB   = "SMQ_GROUPS_DB_"
+	envPrefixGroupsHTTP = "SMQ_GROUPS_HTTP_"
+	defGroupsDB         = "groups"
+)
+
+type groupsConfig struct {
+	RoleTemplates       string        `env:"SMQ_GROUPS_ROLE_TEMPLATES"        envDefault:""`
+	CacheURL            string        `env:"SMQ_GROUPS_CACHE_URL"             envDefault:"redis://localhost:6379/0"`
+	CacheEntityDuration time.Duration `env:"SMQ_GROUPS_CACHE_ENTITY_DURATION" envDefault:"0s"`
+	PolicyCacheDuration time.Duration `env:"SMQ_GROUPS_POLICY_CACHE_DURATION" envDefault:"10s"`
+	DeleteInterval      time.Duration `env:"SMQ_GROUPS_DELETE_INTERVAL"       envDefault:"24h"`
+	DeleteAfter         time.Duration `env:"SMQ_GROUPS_DELETE_AFTER"          envDefault:"720h"`
+}
+
+func runGroups(ctx context.Context, cancel context.CancelFunc, g *errgroup.Group, cfg config, logger *slog.Logger, tp trace.TracerProvider, policyService policies.Service) ([]server.Server, error) {
+	c := groupsConfig{}
+	if err := env.Parse(&c); err != nil {
+		return nil, fmt.Errorf("failed to load %s configuration : %w", groupsSvcName, err)
+	}
+
+	dbConfig := pgclient.Config{Name: defGroupsDB}
+	if err := env.ParseWithOptions(&dbConfig, env.Options{Prefix: envPrefixGroupsDB}); err != nil {
+		return nil, err
+	}
+	gm, err := gppostgres.Migration()
+	if err != nil {
+		return nil, err
+	}
+	db, err := pgclient.Setup(dbConfig, *gm)
+	if err != nil {
+		return nil, err
+	}
+
+	tracer := tp.Tracer(groupsSvcName)
+
+	authClientConfig, err := grpcClientConfig(envPrefixAuthGRPC, defAuthGRPCURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load %s auth configuration : %w", groupsSvcName, err)
+	}
+
+	authCounter, authLatency := prometheus.MakeMetrics(groupsSvcName, "auth_client")
+	authn, authnHandler, err := authsvcAuthn.NewAuthentication(ctx, authClientConfig, authsvcAuthn.WithMetrics(authCounter, authLatency))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create authn : %w", err)
+	}
+	logger.Info("Authn successfully connected to auth gRPC server " + authnHandler.Secure())
+
+	domsGrpcCfg, err := grpcClientConfig(envPrefixDomainsGRPC, defDomainsGRPCURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load domains gRPC client configuration : %w", err)
+	}
+	domAuthz, _, _, err := domainsAuthz.NewAuthorization(ctx, domsGrpcCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	authz, authzHandler, err := authsvcAuthz.NewAuthorization(ctx, authClientConfig, domAuthz, authsvcAuthz.WithMetrics(authCounter, authLatency))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create authz : %w", err)
+	}
+	logger.Info("Authz successfully connected to auth gRPC server " + authzHandler.Secure())
+
+	policy, err := policiesevents.NewEventStoreMiddleware(ctx, policyService, cfg.ESURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create policy event store middleware : %w", err)
+	}
+	if c.PolicyCacheDuration > 0 {
+		policy = policiescache.NewMiddleware(policy, c.PolicyCacheDuration)
+	}
+
+	chGrpcCfg, err := grpcClientConfig(envPrefixChannelsGRPC, defChannelsGRPCURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load channels gRPC client configuration : %w", err)
+	}
+	channelsClient, channelsHandler, err := grpcclient.SetupChannelsClient(ctx, chGrpcCfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to channels gRPC server: %w", err)
+	}
+	logger.Info("Groups gRPC client successfully connected to channels gRPC server " + channelsHandler.Secure())
+
+	clientsGrpcCfg, err := grpcClientConfig(envPrefixClientsGRPC, defClientsGRPCURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load clients gRPC client configuration : %w", err)
+	}
+	clientsClient, clientsHandler, err := grpcclient.SetupClientsClient(ctx, clientsGrpcCfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to clients gRPC server: %w", err)
+	}
+	logger.Info("Clients gRPC client successfully connected to clients gRPC server " + clientsHandler.Secure())
+
+	var cacheClient redis.UniversalClient
+	if c.CacheEntityDuration > 0 {
+		cacheClient, err = redisclient.Connect(c.CacheURL)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var database pg.Database = pg.NewDatabase(db, dbConfig, tracer)
+	if dbConfig.TenantSchemas {
+		tenantDB := pg.NewTenantDatabase(database, dbConfig, tracer)
+		subscriber, err := store.NewSubscriber(ctx, cfg.ESURL, logger)
+		if err != nil {
+			return nil, err
+		}
+		migrations, err := gppostgres.Migration()
+		if err != nil {
+			return nil, err
+		}
+		if err := pgevents.Start(ctx, groupsSvcName, subscriber, tenantDB, *migrations); err != nil {
+			return nil, err
+		}
+		database = tenantDB
+	}
+	if dbConfig.RowLevelSecurity {
+		database = pg.NewRLSDatabase(database)
+	}
+	idp := uuid.New()
+	sidp, err := sid.New()
+	if err != nil {
+		return nil, err
+	}
+
+	availableActions, err := spicedbdecoder.GetActionsFromSchema(cfg.SpicedbSchemaFile, policies.GroupType)
+	if err != nil {
+		return nil, err
+	}
+	builtInRoles := map[roles.BuiltInRoleName][]roles.Action{
+		groups.BuiltInRoleAdmin: availableActions,
+	}
+	builtInRoles, err = roles.OverrideBuiltInRoles(builtInRoles, c.RoleTemplates)
+	if err != nil {
+		return nil, err
+	}
+
+	var repo groups.Repository = gppostgres.New(database)
+	if c.CacheEntityDuration > 0 {
+		repo = gpcache.NewRepository(repo, cacheClient, c.CacheEntityDuration)
+	}
+	svc, err := groups.NewService(repo, policy, idp, channelsClient, clientsClient, sidp, availableActions, builtInRoles)
+	if err != nil {
+		return nil, err
+	}
+
+	groups.NewDeleteHandler(ctx, repo, policy, c.DeleteInterval, c.DeleteAfter, logger)
+	svc, err = gpevents.New(ctx, svc, cfg.ESURL)
+	if err != nil {
+		return nil, err
+	}
+	svc, err = gpmiddleware.AuthorizationMiddleware(policies.GroupType, svc, repo, authz, groups.NewOperationPermissionMap(), groups.NewRolesOperationPermissionMap(), groups.NewExternalOperationPermissionMap())
+	if err != nil {
+		return nil, err
+	}
+	svc = gptracing.New(svc, tracer)
+	svc = gpmiddleware.LoggingMiddleware(svc, logger)
+	counter, latency := prometheus.MakeMetrics(groupsSvcName, "api")
+	svc = gpmiddleware.MetricsMiddleware(svc, counter, latency)
+
+	psvc := pgroups.New(repo)
+
+	grpcSvrConfig, err := grpcServerConfig(envPrefixGroupsGRPC, defGroupsGRPCPort)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load %s gRPC server configuration : %w", groupsSvcName, err)
+	}
+	registerGroupsServer := func(srv *grpc.Server) {
+		reflection.Register(srv)
+		grpcGroupsV1.RegisterGroupsServiceServer(srv, gpgrpcapi.NewServer(psvc, svc, authn))
+	}
+	gs := grpcserver.NewServer(ctx, cancel, groupsSvcName, grpcSvrConfig, registerGroupsServer, logger)
+
+	httpSvrConfig, err := httpServerConfig(envPrefixGroupsHTTP, defGroupsHTTPPort)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load %s HTTP server configuration : %w", groupsSvcName, err)
+	}
+	mux := chi.NewRouter()
+	mux.Get("/ready", httpserver.Ready(groupsSvcName, cfg.InstanceID,
+		httpserver.DBCheck(db),
+		httpserver.GRPCCheck("auth", authnHandler.Connection()),
+	))
+	hs := httpserver.NewServer(ctx, cancel, groupsSvcName, httpSvrConfig, smqapi.VersionHandler(gphttpapi.MakeHandler(svc, authn, mux, logger, cfg.InstanceID)), logger)
+
+	g.Go(func() error {
+		return gs.Start()
+	})
+	g.Go(func() error {
+		return hs.Start()
+	})
+
+	return []server.Server{gs, hs}, nil
+}
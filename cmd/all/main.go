@@ -0,0 +1,277 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package main contains the embedded (monolith) entry point that runs the
+// core SuperMQ services - auth, domains, users, clients, groups and
+// channels - in a single process. The services are wired together through
+// in-process gRPC servers on localhost and, by default, an embedded
+// in-memory event broker, targeting edge gateways and developer machines
+// where running the full multi-container deployment is impractical. Only
+// PostgreSQL, SpiceDB and Redis remain as external dependencies.
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"os"
+	"time"
+
+	chclient "github.com/absmach/callhome/pkg/client"
+	"github.com/absmach/supermq"
+	smqlog "github.com/absmach/supermq/logger"
+	"github.com/absmach/supermq/pkg/grpcclient"
+	jaegerclient "github.com/absmach/supermq/pkg/jaeger"
+	"github.com/absmach/supermq/pkg/policies/spicedb"
+	"github.com/absmach/supermq/pkg/server"
+	"github.com/absmach/supermq/pkg/uuid"
+	v1 "github.com/authzed/authzed-go/proto/authzed/api/v1"
+	"github.com/authzed/authzed-go/v1"
+	"github.com/authzed/grpcutil"
+	"github.com/caarlos0/env/v11"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	grpchealth "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const (
+	svcName = "all"
+
+	defAuthHTTPPort     = "9001"
+	defAuthGRPCPort     = "7001"
+	defUsersHTTPPort    = "9002"
+	defDomainsHTTPPort  = "9003"
+	defDomainsGRPCPort  = "7003"
+	defGroupsHTTPPort   = "9004"
+	defGroupsGRPCPort   = "7004"
+	defChannelsHTTPPort = "9005"
+	defChannelsGRPCPort = "7005"
+	defClientsHTTPPort  = "9006"
+	defClientsGRPCPort  = "7006"
+
+	defAuthGRPCURL     = "localhost:" + defAuthGRPCPort
+	defDomainsGRPCURL  = "localhost:" + defDomainsGRPCPort
+	defGroupsGRPCURL   = "localhost:" + defGroupsGRPCPort
+	defChannelsGRPCURL = "localhost:" + defChannelsGRPCPort
+	defClientsGRPCURL  = "localhost:" + defClientsGRPCPort
+
+	authReadyTimeout = 30 * time.Second
+)
+
+type config struct {
+	LogLevel            string  `env:"SMQ_ALL_LOG_LEVEL"          envDefault:"info"`
+	InstanceID          string  `env:"SMQ_ALL_INSTANCE_ID"        envDefault:""`
+	JaegerURL           url.URL `env:"SMQ_JAEGER_URL"             envDefault:"http://localhost:4318/v1/traces"`
+	TraceRatio          float64 `env:"SMQ_JAEGER_TRACE_RATIO"     envDefault:"1.0"`
+	SendTelemetry       bool    `env:"SMQ_SEND_TELEMETRY"         envDefault:"true"`
+	ESURL               string  `env:"SMQ_ES_URL"                 envDefault:"inproc://events"`
+	SpicedbHost         string  `env:"SMQ_SPICEDB_HOST"           envDefault:"localhost"`
+	SpicedbPort         string  `env:"SMQ_SPICEDB_PORT"           envDefault:"50051"`
+	SpicedbSchemaFile   string  `env:"SMQ_SPICEDB_SCHEMA_FILE"    envDefault:"./docker/spicedb/schema.zed"`
+	SpicedbPreSharedKey string  `env:"SMQ_SPICEDB_PRE_SHARED_KEY" envDefault:"12345678"`
+}
+
+func main() {
+	ctx, cancel := context.WithCancel(context.Background())
+	g, ctx := errgroup.WithContext(ctx)
+
+	cfg := config{}
+	if err := env.Parse(&cfg); err != nil {
+		log.Fatalf("failed to load %s configuration : %s", svcName, err.Error())
+	}
+
+	logger, err := smqlog.New(os.Stdout, cfg.LogLevel)
+	if err != nil {
+		log.Fatalf("failed to init logger: %s", err.Error())
+	}
+
+	var exitCode int
+	defer smqlog.ExitWithError(&exitCode)
+
+	if cfg.InstanceID == "" {
+		if cfg.InstanceID, err = uuid.New().ID(); err != nil {
+			logger.Error(fmt.Sprintf("failed to generate instanceID: %s", err))
+			exitCode = 1
+			return
+		}
+	}
+
+	tp, err := jaegerclient.NewProvider(ctx, svcName, cfg.JaegerURL, cfg.InstanceID, cfg.TraceRatio)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to init Jaeger: %s", err))
+		exitCode = 1
+		return
+	}
+	defer func() {
+		if err := tp.Shutdown(ctx); err != nil {
+			logger.Error(fmt.Sprintf("error shutting down tracer provider: %v", err))
+		}
+	}()
+
+	spicedbClient, err := initSpiceDB(ctx, cfg)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to init spicedb grpc client : %s", err.Error()))
+		exitCode = 1
+		return
+	}
+	policyEvaluator := spicedb.NewPolicyEvaluator(spicedbClient, logger)
+	policyService := spicedb.NewPolicyService(spicedbClient, logger)
+
+	// The auth service must accept gRPC connections before any dependent
+	// service performs its startup health check, so it is started first
+	// and the rest of the platform waits for it to report serving.
+	authServers, err := runAuth(ctx, cancel, g, cfg, logger, tp, spicedbClient)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to start auth service: %s", err))
+		exitCode = 1
+		return
+	}
+	if err := waitForAuth(ctx); err != nil {
+		logger.Error(fmt.Sprintf("auth gRPC server did not become ready: %s", err))
+		exitCode = 1
+		return
+	}
+
+	domainsServers, err := runDomains(ctx, cancel, g, cfg, logger, tp, policyService)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to start domains service: %s", err))
+		exitCode = 1
+		return
+	}
+
+	channelsServers, err := runChannels(ctx, cancel, g, cfg, logger, tp, policyEvaluator, policyService)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to start channels service: %s", err))
+		exitCode = 1
+		return
+	}
+
+	groupsServers, err := runGroups(ctx, cancel, g, cfg, logger, tp, policyService)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to start groups service: %s", err))
+		exitCode = 1
+		return
+	}
+
+	clientsServers, err := runClients(ctx, cancel, g, cfg, logger, tp, policyEvaluator, policyService)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to start clients service: %s", err))
+		exitCode = 1
+		return
+	}
+
+	usersServers, err := runUsers(ctx, cancel, g, cfg, logger, tp, policyService)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to start users service: %s", err))
+		exitCode = 1
+		return
+	}
+
+	if cfg.SendTelemetry {
+		chc := chclient.New(svcName, supermq.Version, logger, cancel)
+		go chc.CallHome(ctx)
+	}
+
+	var servers []server.Server
+	servers = append(servers, authServers...)
+	servers = append(servers, domainsServers...)
+	servers = append(servers, channelsServers...)
+	servers = append(servers, groupsServers...)
+	servers = append(servers, clientsServers...)
+	servers = append(servers, usersServers...)
+
+	g.Go(func() error {
+		return server.StopSignalHandler(ctx, cancel, logger, svcName, servers...)
+	})
+
+	if err := g.Wait(); err != nil {
+		logger.Error(fmt.Sprintf("%s service terminated: %s", svcName, err))
+	}
+}
+
+func initSpiceDB(ctx context.Context, cfg config) (*authzed.ClientWithExperimental, error) {
+	client, err := authzed.NewClientWithExperimentalAPIs(
+		fmt.Sprintf("%s:%s", cfg.SpicedbHost, cfg.SpicedbPort),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpcutil.WithInsecureBearerToken(cfg.SpicedbPreSharedKey),
+	)
+	if err != nil {
+		return client, err
+	}
+
+	schemaContent, err := os.ReadFile(cfg.SpicedbSchemaFile)
+	if err != nil {
+		return client, fmt.Errorf("failed to read spice db schema file : %w", err)
+	}
+	if _, err = client.SchemaServiceClient.WriteSchema(ctx, &v1.WriteSchemaRequest{Schema: string(schemaContent)}); err != nil {
+		return client, fmt.Errorf("failed to create schema in spicedb : %w", err)
+	}
+
+	return client, nil
+}
+
+// grpcClientConfig loads a gRPC client configuration from the environment
+// and falls back to the in-process server address when no URL is set.
+func grpcClientConfig(prefix, defURL string) (grpcclient.Config, error) {
+	cfg := grpcclient.Config{}
+	if err := env.ParseWithOptions(&cfg, env.Options{Prefix: prefix}); err != nil {
+		return grpcclient.Config{}, err
+	}
+	if cfg.URL == "" {
+		cfg.URL = defURL
+	}
+
+	return cfg, nil
+}
+
+func waitForAuth(ctx context.Context) error {
+	cfg, err := grpcClientConfig(envPrefixAuthGRPC, defAuthGRPCURL)
+	if err != nil {
+		return err
+	}
+	handler, err := grpcclient.NewHandler(cfg)
+	if err != nil {
+		return err
+	}
+	defer handler.Close()
+
+	health := grpchealth.NewHealthClient(handler.Connection())
+	deadline := time.Now().Add(authReadyTimeout)
+	for {
+		resp, err := health.Check(ctx, &grpchealth.HealthCheckRequest{Service: "auth"})
+		if err == nil && resp.GetStatus() == grpchealth.HealthCheckResponse_SERVING {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			if err != nil {
+				return err
+			}
+			return grpcclient.ErrSvcNotServing
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+}
+
+func httpServerConfig(prefix, defPort string) (server.Config, error) {
+	cfg := server.Config{Port: defPort}
+	if err := env.ParseWithOptions(&cfg, env.Options{Prefix: prefix}); err != nil {
+		return server.Config{}, err
+	}
+
+	return cfg, nil
+}
+
+func grpcServerConfig(prefix, defPort string) (server.Config, error) {
+	cfg := server.Config{Port: defPort}
+	if err := env.ParseWithOptions(&cfg, env.Options{Prefix: prefix}); err != nil {
+		return server.Config{}, err
+	}
+
+	return cfg, nil
+}
@@ -0,0 +1,262 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"time"
+
+	smqapi "github.com/absmach/supermq/api/http"
+	"github.com/absmach/supermq/internal/email"
+	authsvcAuthn "github.com/absmach/supermq/pkg/authn/authsvc"
+	smqauthz "github.com/absmach/supermq/pkg/authz"
+	authsvcAuthz "github.com/absmach/supermq/pkg/authz/authsvc"
+	domainsAuthz "github.com/absmach/supermq/pkg/domains/grpcclient"
+	"github.com/absmach/supermq/pkg/grpcclient"
+	"github.com/absmach/supermq/pkg/idprovider"
+	"github.com/absmach/supermq/pkg/metadata"
+	"github.com/absmach/supermq/pkg/oauth2"
+	googleoauth "github.com/absmach/supermq/pkg/oauth2/google"
+	"github.com/absmach/supermq/pkg/policies"
+	policiesevents "github.com/absmach/supermq/pkg/policies/events"
+	pg "github.com/absmach/supermq/pkg/postgres"
+	pgclient "github.com/absmach/supermq/pkg/postgres"
+	"github.com/absmach/supermq/pkg/prometheus"
+	"github.com/absmach/supermq/pkg/server"
+	httpserver "github.com/absmach/supermq/pkg/server/http"
+	"github.com/absmach/supermq/pkg/uuid"
+	"github.com/absmach/supermq/users"
+	uhttpapi "github.com/absmach/supermq/users/api"
+	"github.com/absmach/supermq/users/emailer"
+	uevents "github.com/absmach/supermq/users/events"
+	uhasher "github.com/absmach/supermq/users/hasher"
+	umiddleware "github.com/absmach/supermq/users/middleware"
+	upostgres "github.com/absmach/supermq/users/postgres"
+	utracing "github.com/absmach/supermq/users/tracing"
+	"github.com/caarlos0/env/v11"
+	"github.com/go-chi/chi/v5"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	usersSvcName       = "users"
+	envPrefixUsersDB   = "SMQ_USERS_DB_"
+	envPrefixUsersHTTP = "SMQ_USERS_HTTP_"
+	envPrefixGoogle    = "SMQ_GOOGLE_"
+	envPrefixUsersID   = "SMQ_USERS_ID_"
+	defUsersDB         = "users"
+)
+
+type usersConfig struct {
+	AdminEmail         string        `env:"SMQ_USERS_ADMIN_EMAIL"         envDefault:"admin@example.com"`
+	AdminPassword      string        `env:"SMQ_USERS_ADMIN_PASSWORD"      envDefault:"12345678"`
+	AdminUsername      string        `env:"SMQ_USERS_ADMIN_USERNAME"      envDefault:"admin"`
+	AdminFirstName     string        `env:"SMQ_USERS_ADMIN_FIRST_NAME"    envDefault:"super"`
+	AdminLastName      string        `env:"SMQ_USERS_ADMIN_LAST_NAME"     envDefault:"admin"`
+	PassRegexText      string        `env:"SMQ_USERS_PASS_REGEX"          envDefault:"^.{8,}$"`
+	ResetURL           string        `env:"SMQ_TOKEN_RESET_ENDPOINT"      envDefault:"/reset-request"`
+	SelfRegister       bool          `env:"SMQ_USERS_ALLOW_SELF_REGISTER" envDefault:"false"`
+	OAuthUIRedirectURL string        `env:"SMQ_OAUTH_UI_REDIRECT_URL"     envDefault:"http://localhost:9095/domains"`
+	OAuthUIErrorURL    string        `env:"SMQ_OAUTH_UI_ERROR_URL"        envDefault:"http://localhost:9095/error"`
+	DeleteInterval     time.Duration `env:"SMQ_USERS_DELETE_INTERVAL"     envDefault:"24h"`
+	DeleteAfter        time.Duration `env:"SMQ_USERS_DELETE_AFTER"        envDefault:"720h"`
+	PassRegex          *regexp.Regexp
+}
+
+func runUsers(ctx context.Context, cancel context.CancelFunc, g *errgroup.Group, cfg config, logger *slog.Logger, tp trace.TracerProvider, policyService policies.Service) ([]server.Server, error) {
+	c := usersConfig{}
+	if err := env.Parse(&c); err != nil {
+		return nil, fmt.Errorf("failed to load %s configuration : %w", usersSvcName, err)
+	}
+	passRegex, err := regexp.Compile(c.PassRegexText)
+	if err != nil {
+		return nil, fmt.Errorf("invalid password validation rules %s", c.PassRegexText)
+	}
+	c.PassRegex = passRegex
+
+	ec := email.Config{}
+	if err := env.Parse(&ec); err != nil {
+		return nil, fmt.Errorf("failed to load email configuration : %w", err)
+	}
+
+	dbConfig := pgclient.Config{Name: defUsersDB}
+	if err := env.ParseWithOptions(&dbConfig, env.Options{Prefix: envPrefixUsersDB}); err != nil {
+		return nil, err
+	}
+
+	migration := upostgres.Migration()
+	db, err := pgclient.Setup(dbConfig, *migration)
+	if err != nil {
+		return nil, err
+	}
+
+	tracer := tp.Tracer(usersSvcName)
+
+	authClientConfig, err := grpcClientConfig(envPrefixAuthGRPC, defAuthGRPCURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load %s auth configuration : %w", usersSvcName, err)
+	}
+
+	tokenClient, tokenHandler, err := grpcclient.SetupTokenClient(ctx, authClientConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create token gRPC client : %w", err)
+	}
+	logger.Info("Token service client successfully connected to auth gRPC server " + tokenHandler.Secure())
+
+	authCounter, authLatency := prometheus.MakeMetrics(usersSvcName, "auth_client")
+	authn, authnHandler, err := authsvcAuthn.NewAuthentication(ctx, authClientConfig, authsvcAuthn.WithMetrics(authCounter, authLatency))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create authn : %w", err)
+	}
+	logger.Info("AuthN successfully connected to auth gRPC server " + authnHandler.Secure())
+
+	domsGrpcCfg, err := grpcClientConfig(envPrefixDomainsGRPC, defDomainsGRPCURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load domains gRPC client configuration : %w", err)
+	}
+	domAuthz, domainsClient, _, err := domainsAuthz.NewAuthorization(ctx, domsGrpcCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	authz, authzHandler, err := authsvcAuthz.NewAuthorization(ctx, authClientConfig, domAuthz, authsvcAuthz.WithMetrics(authCounter, authLatency))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create authz : %w", err)
+	}
+	logger.Info("AuthZ successfully connected to auth gRPC server " + authzHandler.Secure())
+
+	policy, err := policiesevents.NewEventStoreMiddleware(ctx, policyService, cfg.ESURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create policy event store middleware : %w", err)
+	}
+
+	mdRegistry := metadata.NewRegistry()
+
+	idpConfig := idprovider.Config{}
+	if err := env.ParseWithOptions(&idpConfig, env.Options{Prefix: envPrefixUsersID}); err != nil {
+		return nil, fmt.Errorf("failed to load %s ID provider configuration : %w", usersSvcName, err)
+	}
+	idp, err := idprovider.New(idpConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s ID provider : %w", usersSvcName, err)
+	}
+
+	database := pg.NewDatabase(db, dbConfig, tracer)
+	hsr := uhasher.New()
+
+	repo := upostgres.NewRepository(database)
+	emailerClient, err := emailer.New(c.ResetURL, &ec)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to configure e-mailing util: %s", err.Error()))
+	}
+
+	svc := users.NewService(tokenClient, repo, policy, emailerClient, hsr, idp, users.WithMetadataValidator(mdRegistry))
+	svc, err = uevents.NewEventStoreMiddleware(ctx, svc, cfg.ESURL)
+	if err != nil {
+		return nil, err
+	}
+	svc = umiddleware.AuthorizationMiddleware(svc, authz, c.SelfRegister)
+	svc = utracing.New(svc, tracer)
+	svc = umiddleware.LoggingMiddleware(svc, logger)
+	counter, latency := prometheus.MakeMetrics(usersSvcName, "api")
+	svc = umiddleware.MetricsMiddleware(svc, counter, latency)
+
+	userID, err := createAdmin(ctx, c, repo, hsr, svc)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to create admin client: %s", err))
+	}
+	if err := createAdminPolicy(ctx, userID, authz, policy); err != nil {
+		return nil, err
+	}
+
+	users.NewDeleteHandler(ctx, repo, policy, domainsClient, c.DeleteInterval, c.DeleteAfter, logger)
+
+	httpSvrConfig, err := httpServerConfig(envPrefixUsersHTTP, defUsersHTTPPort)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load %s HTTP server configuration : %w", usersSvcName, err)
+	}
+
+	oauthConfig := oauth2.Config{}
+	if err := env.ParseWithOptions(&oauthConfig, env.Options{Prefix: envPrefixGoogle}); err != nil {
+		return nil, fmt.Errorf("failed to load %s Google configuration : %w", usersSvcName, err)
+	}
+	oauthProvider := googleoauth.NewProvider(oauthConfig, c.OAuthUIRedirectURL, c.OAuthUIErrorURL)
+
+	mux := chi.NewRouter()
+	mux.Mount("/metadata", metadata.Handler(mdRegistry, authn, authz))
+	hs := httpserver.NewServer(ctx, cancel, usersSvcName, httpSvrConfig, smqapi.VersionHandler(uhttpapi.MakeHandler(svc, authn, tokenClient, c.SelfRegister, mux, logger, cfg.InstanceID, c.PassRegex, oauthProvider)), logger)
+
+	g.Go(func() error {
+		return hs.Start()
+	})
+
+	return []server.Server{hs}, nil
+}
+
+func createAdmin(ctx context.Context, c usersConfig, repo users.Repository, hsr users.Hasher, svc users.Service) (string, error) {
+	id, err := uuid.New().ID()
+	if err != nil {
+		return "", err
+	}
+	hash, err := hsr.Hash(c.AdminPassword)
+	if err != nil {
+		return "", err
+	}
+
+	user := users.User{
+		ID:        id,
+		Email:     c.AdminEmail,
+		FirstName: c.AdminFirstName,
+		LastName:  c.AdminLastName,
+		Credentials: users.Credentials{
+			Username: "admin",
+			Secret:   hash,
+		},
+		Metadata: users.Metadata{
+			"role": "admin",
+		},
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+		Role:      users.AdminRole,
+		Status:    users.EnabledStatus,
+	}
+
+	if u, err := repo.RetrieveByEmail(ctx, user.Email); err == nil {
+		return u.ID, nil
+	}
+
+	if _, err = repo.Save(ctx, user); err != nil {
+		return "", err
+	}
+	if _, err = svc.IssueToken(ctx, c.AdminUsername, c.AdminPassword); err != nil {
+		return "", err
+	}
+	return user.ID, nil
+}
+
+func createAdminPolicy(ctx context.Context, userID string, authz smqauthz.Authorization, policyService policies.Service) error {
+	if err := authz.Authorize(ctx, smqauthz.PolicyReq{
+		SubjectType: policies.UserType,
+		Subject:     userID,
+		Permission:  policies.AdministratorRelation,
+		Object:      policies.SuperMQObject,
+		ObjectType:  policies.PlatformType,
+	}); err != nil {
+		err := policyService.AddPolicy(ctx, policies.Policy{
+			SubjectType: policies.UserType,
+			Subject:     userID,
+			Relation:    policies.AdministratorRelation,
+			Object:      policies.SuperMQObject,
+			ObjectType:  policies.PlatformType,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
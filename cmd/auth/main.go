@@ -16,18 +16,21 @@ import (
 	"github.com/absmach/supermq"
 	grpcAuthV1 "github.com/absmach/supermq/api/grpc/auth/v1"
 	grpcTokenV1 "github.com/absmach/supermq/api/grpc/token/v1"
+	smqapi "github.com/absmach/supermq/api/http"
 	"github.com/absmach/supermq/auth"
 	api "github.com/absmach/supermq/auth/api"
 	authgrpcapi "github.com/absmach/supermq/auth/api/grpc/auth"
 	tokengrpcapi "github.com/absmach/supermq/auth/api/grpc/token"
 	httpapi "github.com/absmach/supermq/auth/api/http"
 	"github.com/absmach/supermq/auth/bolt"
+	authevents "github.com/absmach/supermq/auth/events"
 	"github.com/absmach/supermq/auth/hasher"
 	"github.com/absmach/supermq/auth/jwt"
 	apostgres "github.com/absmach/supermq/auth/postgres"
 	"github.com/absmach/supermq/auth/tracing"
 	boltclient "github.com/absmach/supermq/internal/clients/bolt"
 	smqlog "github.com/absmach/supermq/logger"
+	"github.com/absmach/supermq/pkg/events/store"
 	"github.com/absmach/supermq/pkg/jaeger"
 	"github.com/absmach/supermq/pkg/policies/spicedb"
 	pgclient "github.com/absmach/supermq/pkg/postgres"
@@ -62,6 +65,7 @@ const (
 
 type config struct {
 	LogLevel            string        `env:"SMQ_AUTH_LOG_LEVEL"               envDefault:"info"`
+	ReadOnly            bool          `env:"SMQ_AUTH_READ_ONLY" envDefault:"false"`
 	SecretKey           string        `env:"SMQ_AUTH_SECRET_KEY"              envDefault:"secret"`
 	JaegerURL           url.URL       `env:"SMQ_JAEGER_URL"                   envDefault:"http://localhost:4318/v1/traces"`
 	SendTelemetry       bool          `env:"SMQ_SEND_TELEMETRY"               envDefault:"true"`
@@ -69,12 +73,14 @@ type config struct {
 	AccessDuration      time.Duration `env:"SMQ_AUTH_ACCESS_TOKEN_DURATION"   envDefault:"1h"`
 	RefreshDuration     time.Duration `env:"SMQ_AUTH_REFRESH_TOKEN_DURATION"  envDefault:"24h"`
 	InvitationDuration  time.Duration `env:"SMQ_AUTH_INVITATION_DURATION"     envDefault:"168h"`
+	ExplainDenials      bool          `env:"SMQ_AUTH_EXPLAIN_DENIALS"         envDefault:"false"`
 	SpicedbHost         string        `env:"SMQ_SPICEDB_HOST"                 envDefault:"localhost"`
 	SpicedbPort         string        `env:"SMQ_SPICEDB_PORT"                 envDefault:"50051"`
 	SpicedbSchemaFile   string        `env:"SMQ_SPICEDB_SCHEMA_FILE"          envDefault:"./docker/spicedb/schema.zed"`
 	SpicedbPreSharedKey string        `env:"SMQ_SPICEDB_PRE_SHARED_KEY"       envDefault:"12345678"`
 	TraceRatio          float64       `env:"SMQ_JAEGER_TRACE_RATIO"           envDefault:"1.0"`
 	ESURL               string        `env:"SMQ_ES_URL"                       envDefault:"nats://localhost:4222"`
+	RevokeKeysOnDelete  bool          `env:"SMQ_AUTH_REVOKE_KEYS_ON_DELETE"   envDefault:"true"`
 }
 
 func main() {
@@ -151,7 +157,22 @@ func main() {
 	}
 	defer bClient.Close()
 
-	svc := newService(ctx, db, tracer, cfg, dbConfig, logger, spicedbclient, bClient, boltDBConfig)
+	svc, keysRepo := newService(ctx, db, tracer, cfg, dbConfig, logger, spicedbclient, bClient, boltDBConfig)
+
+	if cfg.RevokeKeysOnDelete {
+		subscriber, err := store.NewSubscriber(ctx, cfg.ESURL, logger)
+		if err != nil {
+			logger.Error(fmt.Sprintf("failed to create subscriber: %s", err))
+			exitCode = 1
+			return
+		}
+
+		if err := authevents.Start(ctx, svcName, subscriber, keysRepo); err != nil {
+			logger.Error(fmt.Sprintf("failed to start %s event consumer: %s", svcName, err))
+			exitCode = 1
+			return
+		}
+	}
 
 	grpcServerConfig := server.Config{Port: defSvcGRPCPort}
 	if err := env.ParseWithOptions(&grpcServerConfig, env.Options{Prefix: envPrefixGrpc}); err != nil {
@@ -181,7 +202,8 @@ func main() {
 		exitCode = 1
 		return
 	}
-	hs := httpserver.NewServer(ctx, cancel, svcName, httpServerConfig, httpapi.MakeHandler(svc, logger, cfg.InstanceID), logger)
+	ro := smqapi.NewReadOnly(cfg.ReadOnly)
+	hs := httpserver.NewServer(ctx, cancel, svcName, httpServerConfig, ro.Middleware(smqapi.VersionHandler(httpapi.MakeHandler(svc, logger, cfg.InstanceID))), logger)
 
 	if cfg.SendTelemetry {
 		chc := chclient.New(svcName, supermq.Version, logger, cancel)
@@ -231,7 +253,7 @@ func initSchema(ctx context.Context, client *authzed.ClientWithExperimental, sch
 	return nil
 }
 
-func newService(_ context.Context, db *sqlx.DB, tracer trace.Tracer, cfg config, dbConfig pgclient.Config, logger *slog.Logger, spicedbClient *authzed.ClientWithExperimental, bClient *bbolt.DB, bConfig boltclient.Config) auth.Service {
+func newService(_ context.Context, db *sqlx.DB, tracer trace.Tracer, cfg config, dbConfig pgclient.Config, logger *slog.Logger, spicedbClient *authzed.ClientWithExperimental, bClient *bbolt.DB, bConfig boltclient.Config) (auth.Service, auth.KeyRepository) {
 	database := pgclient.NewDatabase(db, dbConfig, tracer)
 	keysRepo := apostgres.New(database)
 	patsRepo := bolt.NewPATSRepository(bClient, bConfig.Bucket)
@@ -243,11 +265,15 @@ func newService(_ context.Context, db *sqlx.DB, tracer trace.Tracer, cfg config,
 
 	t := jwt.New([]byte(cfg.SecretKey))
 
-	svc := auth.New(keysRepo, patsRepo, hasher, idProvider, t, pEvaluator, pService, cfg.AccessDuration, cfg.RefreshDuration, cfg.InvitationDuration)
+	opts := []auth.Option{}
+	if cfg.ExplainDenials {
+		opts = append(opts, auth.WithDenialExplanations())
+	}
+	svc := auth.New(keysRepo, patsRepo, hasher, idProvider, t, pEvaluator, pService, cfg.AccessDuration, cfg.RefreshDuration, cfg.InvitationDuration, opts...)
 	svc = api.LoggingMiddleware(svc, logger)
 	counter, latency := prometheus.MakeMetrics("auth", "api")
 	svc = api.MetricsMiddleware(svc, counter, latency)
 	svc = tracing.New(svc, tracer)
 
-	return svc
+	return svc, keysRepo
 }
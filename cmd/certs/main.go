@@ -6,20 +6,27 @@ package main
 
 import (
 	"context"
+	"crypto/x509"
 	"fmt"
 	"log"
 	"log/slog"
 	"net/url"
 	"os"
+	"time"
 
 	chclient "github.com/absmach/callhome/pkg/client"
 	"github.com/absmach/supermq"
+	smqapi "github.com/absmach/supermq/api/http"
 	"github.com/absmach/supermq/certs"
 	httpapi "github.com/absmach/supermq/certs/api"
+	acmepki "github.com/absmach/supermq/certs/pki/acme"
 	pki "github.com/absmach/supermq/certs/pki/amcerts"
+	"github.com/absmach/supermq/certs/pki/localca"
+	vaultpki "github.com/absmach/supermq/certs/pki/vault"
 	"github.com/absmach/supermq/certs/tracing"
 	smqlog "github.com/absmach/supermq/logger"
 	authsvcAuthn "github.com/absmach/supermq/pkg/authn/authsvc"
+	"github.com/absmach/supermq/pkg/events/store"
 	"github.com/absmach/supermq/pkg/grpcclient"
 	jaegerclient "github.com/absmach/supermq/pkg/jaeger"
 	"github.com/absmach/supermq/pkg/prometheus"
@@ -39,24 +46,44 @@ const (
 	envPrefixAuth  = "SMQ_AUTH_GRPC_"
 	defDB          = "certs"
 	defSvcHTTPPort = "9019"
+	streamID       = "supermq.certs"
 )
 
 type config struct {
 	LogLevel      string  `env:"SMQ_CERTS_LOG_LEVEL"        envDefault:"info"`
+	ReadOnly      bool    `env:"SMQ_CERTS_READ_ONLY" envDefault:"false"`
 	ClientsURL    string  `env:"SMQ_CLIENTS_URL"            envDefault:"http://localhost:9000"`
 	JaegerURL     url.URL `env:"SMQ_JAEGER_URL"             envDefault:"http://localhost:4318/v1/traces"`
 	SendTelemetry bool    `env:"SMQ_SEND_TELEMETRY"         envDefault:"true"`
 	InstanceID    string  `env:"SMQ_CERTS_INSTANCE_ID"      envDefault:""`
 	TraceRatio    float64 `env:"SMQ_JAEGER_TRACE_RATIO"     envDefault:"1.0"`
+	ESURL         string  `env:"SMQ_ES_URL"                 envDefault:"nats://localhost:4222"`
 
 	// Sign and issue certificates without 3rd party PKI
 	SignCAPath    string `env:"SMQ_CERTS_SIGN_CA_PATH"        envDefault:"ca.crt"`
 	SignCAKeyPath string `env:"SMQ_CERTS_SIGN_CA_KEY_PATH"    envDefault:"ca.key"`
 
+	// Manufacturing CAs trusted for zero-touch device provisioning
+	ManufacturerCAPath string `env:"SMQ_CERTS_MANUFACTURER_CA_PATH" envDefault:""`
+
 	// Amcerts SDK settings
 	SDKHost         string `env:"SMQ_CERTS_SDK_HOST"             envDefault:""`
 	SDKCertsURL     string `env:"SMQ_CERTS_SDK_CERTS_URL"        envDefault:"http://localhost:9010"`
 	TLSVerification bool   `env:"SMQ_CERTS_SDK_TLS_VERIFICATION" envDefault:"false"`
+
+	// Vault PKI settings
+	VaultHost      string `env:"SMQ_VAULT_HOST"                          envDefault:""`
+	VaultNamespace string `env:"SMQ_VAULT_NAMESPACE"                     envDefault:""`
+	VaultAppRole   string `env:"SMQ_VAULT_APPROLE_ROLE_ID"               envDefault:""`
+	VaultAppSecret string `env:"SMQ_VAULT_APPROLE_SECRET"                envDefault:""`
+	VaultPKIPath   string `env:"SMQ_VAULT_CLIENTS_CERTS_PKI_PATH"        envDefault:"pki_int"`
+	VaultRole      string `env:"SMQ_VAULT_CLIENTS_CERTS_PKI_ROLE_NAME"   envDefault:"supermq_clients_certs"`
+
+	// PKI backend settings
+	PKIBackend     string        `env:"SMQ_CERTS_PKI_BACKEND"          envDefault:"amcerts"`
+	ACMEDirectory  string        `env:"SMQ_CERTS_ACME_DIRECTORY_URL"   envDefault:"https://acme-v02.api.letsencrypt.org/directory"`
+	RenewInterval  time.Duration `env:"SMQ_CERTS_RENEW_INTERVAL"       envDefault:"1h"`
+	RenewThreshold time.Duration `env:"SMQ_CERTS_RENEW_THRESHOLD"      envDefault:"720h"`
 }
 
 func main() {
@@ -84,13 +111,31 @@ func main() {
 		}
 	}
 
-	if cfg.SDKHost == "" {
-		logger.Error("No host specified for PKI engine")
+	var pkiclient pki.Agent
+	switch cfg.PKIBackend {
+	case "amcerts":
+		if cfg.SDKHost == "" {
+			logger.Error("No host specified for PKI engine")
+			exitCode = 1
+			return
+		}
+		pkiclient, err = pki.NewAgent(cfg.SDKHost, cfg.SDKCertsURL, cfg.TLSVerification)
+	case "localca":
+		pkiclient, err = localca.NewAgent(cfg.SignCAPath, cfg.SignCAKeyPath)
+	case "vault":
+		if cfg.VaultHost == "" {
+			logger.Error("No host specified for Vault PKI engine")
+			exitCode = 1
+			return
+		}
+		pkiclient, err = vaultpki.NewAgent(ctx, cfg.VaultAppRole, cfg.VaultAppSecret, cfg.VaultHost, cfg.VaultNamespace, cfg.VaultPKIPath, cfg.VaultRole, logger)
+	case "acme":
+		pkiclient, _, err = acmepki.NewAgent(ctx, cfg.ACMEDirectory, nil)
+	default:
+		logger.Error(fmt.Sprintf("unknown PKI backend: %s", cfg.PKIBackend))
 		exitCode = 1
 		return
 	}
-
-	pkiclient, err := pki.NewAgent(cfg.SDKHost, cfg.SDKCertsURL, cfg.TLSVerification)
 	if err != nil {
 		logger.Error("failed to configure client for PKI engine")
 		exitCode = 1
@@ -133,13 +178,29 @@ func main() {
 		exitCode = 1
 		return
 	}
-	hs := httpserver.NewServer(ctx, cancel, svcName, httpServerConfig, httpapi.MakeHandler(svc, authn, logger, cfg.InstanceID), logger)
+	ro := smqapi.NewReadOnly(cfg.ReadOnly)
+	hs := httpserver.NewServer(ctx, cancel, svcName, httpServerConfig, ro.Middleware(smqapi.VersionHandler(httpapi.MakeHandler(svc, authn, logger, cfg.InstanceID))), logger)
 
 	if cfg.SendTelemetry {
 		chc := chclient.New(svcName, supermq.Version, logger, cancel)
 		go chc.CallHome(ctx)
 	}
 
+	if cfg.RenewInterval > 0 {
+		es, err := store.NewPublisher(ctx, cfg.ESURL, streamID)
+		if err != nil {
+			logger.Error(fmt.Sprintf("failed to connect to event store: %s", err))
+			exitCode = 1
+			return
+		}
+		defer es.Close()
+
+		renewer := certs.NewRenewer(pkiclient, es, logger, cfg.RenewInterval, cfg.RenewThreshold)
+		g.Go(func() error {
+			return renewer.Run(ctx)
+		})
+	}
+
 	g.Go(func() error {
 		return hs.Start()
 	})
@@ -158,7 +219,20 @@ func newService(tracer trace.Tracer, logger *slog.Logger, cfg config, pkiAgent p
 		ClientsURL: cfg.ClientsURL,
 	}
 	sdk := mgsdk.NewSDK(config)
-	svc := certs.New(sdk, pkiAgent)
+	var manufacturerCAs *x509.CertPool
+	if cfg.ManufacturerCAPath != "" {
+		pem, err := os.ReadFile(cfg.ManufacturerCAPath)
+		if err != nil {
+			logger.Error(fmt.Sprintf("failed to read manufacturing CA certificates: %s", err))
+		} else {
+			manufacturerCAs = x509.NewCertPool()
+			if !manufacturerCAs.AppendCertsFromPEM(pem) {
+				logger.Error("failed to parse manufacturing CA certificates")
+				manufacturerCAs = nil
+			}
+		}
+	}
+	svc := certs.New(sdk, pkiAgent, manufacturerCAs)
 	svc = httpapi.LoggingMiddleware(svc, logger)
 	counter, latency := prometheus.MakeMetrics(svcName, "api")
 	svc = httpapi.MetricsMiddleware(svc, counter, latency)
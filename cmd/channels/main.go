@@ -17,6 +17,7 @@ import (
 	grpcChannelsV1 "github.com/absmach/supermq/api/grpc/channels/v1"
 	grpcClientsV1 "github.com/absmach/supermq/api/grpc/clients/v1"
 	grpcGroupsV1 "github.com/absmach/supermq/api/grpc/groups/v1"
+	smqapi "github.com/absmach/supermq/api/http"
 	"github.com/absmach/supermq/channels"
 	grpcapi "github.com/absmach/supermq/channels/api/grpc"
 	httpapi "github.com/absmach/supermq/channels/api/http"
@@ -30,12 +31,15 @@ import (
 	smqauthz "github.com/absmach/supermq/pkg/authz"
 	authsvcAuthz "github.com/absmach/supermq/pkg/authz/authsvc"
 	domainsAuthz "github.com/absmach/supermq/pkg/domains/grpcclient"
+	store "github.com/absmach/supermq/pkg/events/store"
 	"github.com/absmach/supermq/pkg/grpcclient"
 	jaegerclient "github.com/absmach/supermq/pkg/jaeger"
+	"github.com/absmach/supermq/pkg/metering"
 	"github.com/absmach/supermq/pkg/policies"
 	"github.com/absmach/supermq/pkg/policies/spicedb"
 	pg "github.com/absmach/supermq/pkg/postgres"
 	pgclient "github.com/absmach/supermq/pkg/postgres"
+	pgevents "github.com/absmach/supermq/pkg/postgres/events"
 	"github.com/absmach/supermq/pkg/prometheus"
 	"github.com/absmach/supermq/pkg/server"
 	grpcserver "github.com/absmach/supermq/pkg/server/grpc"
@@ -70,6 +74,7 @@ const (
 
 type config struct {
 	LogLevel            string  `env:"SMQ_CHANNELS_LOG_LEVEL"           envDefault:"info"`
+	ReadOnly            bool    `env:"SMQ_CHANNELS_READ_ONLY" envDefault:"false"`
 	InstanceID          string  `env:"SMQ_CHANNELS_INSTANCE_ID"         envDefault:""`
 	JaegerURL           url.URL `env:"SMQ_JAEGER_URL"                   envDefault:"http://localhost:4318/v1/traces"`
 	SendTelemetry       bool    `env:"SMQ_SEND_TELEMETRY"               envDefault:"true"`
@@ -78,6 +83,7 @@ type config struct {
 	SpicedbHost         string  `env:"SMQ_SPICEDB_HOST"                 envDefault:"localhost"`
 	SpicedbPort         string  `env:"SMQ_SPICEDB_PORT"                 envDefault:"50051"`
 	SpicedbPreSharedKey string  `env:"SMQ_SPICEDB_PRE_SHARED_KEY"       envDefault:"12345678"`
+	Quota               string  `env:"SMQ_CHANNELS_QUOTA"               envDefault:""`
 }
 
 func main() {
@@ -217,7 +223,15 @@ func main() {
 	defer groupsHandler.Close()
 	logger.Info("Groups gRPC client successfully connected to groups gRPC server " + groupsHandler.Secure())
 
-	svc, psvc, err := newService(ctx, db, dbConfig, authz, policyEvaluator, policyService, cfg.ESURL, tracer, clientsClient, groupsClient, logger)
+	quota, err := metering.ParseQuota(cfg.Quota)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to parse %s quota configuration : %s", svcName, err))
+		exitCode = 1
+		return
+	}
+	meter := metering.New(quota)
+
+	svc, psvc, err := newService(ctx, db, dbConfig, authz, policyEvaluator, policyService, cfg.ESURL, tracer, clientsClient, groupsClient, meter, logger)
 	if err != nil {
 		logger.Error(fmt.Sprintf("failed to create services: %s", err))
 		exitCode = 1
@@ -243,8 +257,12 @@ func main() {
 		exitCode = 1
 		return
 	}
+	adminOnly := chi.Middlewares{smqapi.AuthenticateMiddleware(authn, false), smqapi.AuthorizeAdminMiddleware(authz)}
 	mux := chi.NewRouter()
-	httpSvc := httpserver.NewServer(ctx, cancel, svcName, httpServerConfig, httpapi.MakeHandler(svc, authn, mux, logger, cfg.InstanceID), logger)
+	mux.Handle("/usage", meter.Handler(authn, authz))
+	ro := smqapi.NewReadOnly(cfg.ReadOnly)
+	mux.With(adminOnly...).Handle("/readonly", ro.Handler())
+	httpSvc := httpserver.NewServer(ctx, cancel, svcName, httpServerConfig, ro.Middleware(smqapi.VersionHandler(httpapi.MakeHandler(svc, authn, mux, logger, cfg.InstanceID))), logger)
 
 	if cfg.SendTelemetry {
 		chc := chclient.New(svcName, supermq.Version, logger, cancel)
@@ -271,11 +289,37 @@ func main() {
 
 func newService(ctx context.Context, db *sqlx.DB, dbConfig pgclient.Config, authz smqauthz.Authorization,
 	pe policies.Evaluator, ps policies.Service, esURL string, tracer trace.Tracer, clientsClient grpcClientsV1.ClientsServiceClient,
-	groupsClient grpcGroupsV1.GroupsServiceClient, logger *slog.Logger,
+	groupsClient grpcGroupsV1.GroupsServiceClient, meter *metering.Meter, logger *slog.Logger,
 ) (channels.Service, pChannels.Service, error) {
-	database := pg.NewDatabase(db, dbConfig, tracer)
+	var database pg.Database = pg.NewDatabase(db, dbConfig, tracer)
+	if dbConfig.TenantSchemas {
+		tenantDB := pg.NewTenantDatabase(database, dbConfig, tracer)
+		subscriber, err := store.NewSubscriber(ctx, esURL, logger)
+		if err != nil {
+			return nil, nil, err
+		}
+		migrations, err := postgres.Migration()
+		if err != nil {
+			return nil, nil, err
+		}
+		if err := pgevents.Start(ctx, svcName, subscriber, tenantDB, *migrations); err != nil {
+			return nil, nil, err
+		}
+		database = tenantDB
+	}
+	if dbConfig.RowLevelSecurity {
+		database = pg.NewRLSDatabase(database)
+	}
 	repo := postgres.NewRepository(database)
 
+	meter.RegisterCounter(metering.ResourceChannels, func(ctx context.Context, domainID string) (uint64, error) {
+		page, err := repo.RetrieveAll(ctx, channels.PageMetadata{Domain: domainID, Limit: 1})
+		if err != nil {
+			return 0, err
+		}
+		return page.Total, nil
+	})
+
 	idp := uuid.New()
 	sidp, err := sid.New()
 	if err != nil {
@@ -286,6 +330,7 @@ func newService(ctx context.Context, db *sqlx.DB, dbConfig pgclient.Config, auth
 	if err != nil {
 		return nil, nil, err
 	}
+	svc = middleware.QuotaMiddleware(svc, meter)
 
 	svc, err = events.NewEventStoreMiddleware(ctx, svc, esURL)
 	if err != nil {
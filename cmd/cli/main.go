@@ -45,6 +45,7 @@ func main() {
 	configCmd := cli.NewConfigCmd()
 	invitationsCmd := cli.NewInvitationsCmd()
 	journalCmd := cli.NewJournalCmd()
+	provisionCmd := cli.NewProvisionCmd()
 
 	// Root Commands
 	rootCmd.AddCommand(healthCmd)
@@ -58,6 +59,7 @@ func main() {
 	rootCmd.AddCommand(configCmd)
 	rootCmd.AddCommand(invitationsCmd)
 	rootCmd.AddCommand(journalCmd)
+	rootCmd.AddCommand(provisionCmd)
 
 	// Root Flags
 	rootCmd.PersistentFlags().StringVarP(
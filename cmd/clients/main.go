@@ -18,6 +18,7 @@ import (
 	grpcChannelsV1 "github.com/absmach/supermq/api/grpc/channels/v1"
 	grpcClientsV1 "github.com/absmach/supermq/api/grpc/clients/v1"
 	grpcGroupsV1 "github.com/absmach/supermq/api/grpc/groups/v1"
+	smqapi "github.com/absmach/supermq/api/http"
 	"github.com/absmach/supermq/clients"
 	grpcapi "github.com/absmach/supermq/clients/api/grpc"
 	httpapi "github.com/absmach/supermq/clients/api/http"
@@ -26,6 +27,7 @@ import (
 	"github.com/absmach/supermq/clients/middleware"
 	"github.com/absmach/supermq/clients/postgres"
 	pClients "github.com/absmach/supermq/clients/private"
+	"github.com/absmach/supermq/clients/sqlite"
 	"github.com/absmach/supermq/clients/tracing"
 	redisclient "github.com/absmach/supermq/internal/clients/redis"
 	smqlog "github.com/absmach/supermq/logger"
@@ -33,17 +35,29 @@ import (
 	smqauthz "github.com/absmach/supermq/pkg/authz"
 	authsvcAuthz "github.com/absmach/supermq/pkg/authz/authsvc"
 	domainsAuthz "github.com/absmach/supermq/pkg/domains/grpcclient"
+	store "github.com/absmach/supermq/pkg/events/store"
 	"github.com/absmach/supermq/pkg/grpcclient"
+	"github.com/absmach/supermq/pkg/idprovider"
 	jaegerclient "github.com/absmach/supermq/pkg/jaeger"
+	"github.com/absmach/supermq/pkg/metadata"
+	"github.com/absmach/supermq/pkg/metering"
+	otelmetrics "github.com/absmach/supermq/pkg/metrics"
+	"github.com/absmach/supermq/pkg/outbox"
+	outboxpg "github.com/absmach/supermq/pkg/outbox/postgres"
 	"github.com/absmach/supermq/pkg/policies"
+	policiescache "github.com/absmach/supermq/pkg/policies/cache"
+	policiesevents "github.com/absmach/supermq/pkg/policies/events"
 	"github.com/absmach/supermq/pkg/policies/spicedb"
 	pg "github.com/absmach/supermq/pkg/postgres"
 	pgclient "github.com/absmach/supermq/pkg/postgres"
+	pgevents "github.com/absmach/supermq/pkg/postgres/events"
 	"github.com/absmach/supermq/pkg/prometheus"
+	"github.com/absmach/supermq/pkg/reconciler"
 	"github.com/absmach/supermq/pkg/server"
 	grpcserver "github.com/absmach/supermq/pkg/server/grpc"
 	httpserver "github.com/absmach/supermq/pkg/server/http"
 	"github.com/absmach/supermq/pkg/sid"
+	sqliteclient "github.com/absmach/supermq/pkg/sqlite"
 	"github.com/absmach/supermq/pkg/uuid"
 	"github.com/authzed/authzed-go/v1"
 	"github.com/authzed/grpcutil"
@@ -67,7 +81,9 @@ const (
 	envPrefixChannels  = "SMQ_CHANNELS_GRPC_"
 	envPrefixGroups    = "SMQ_GROUPS_GRPC_"
 	envPrefixDomains   = "SMQ_DOMAINS_GRPC_"
+	envPrefixID        = "SMQ_CLIENTS_ID_"
 	defDB              = "clients"
+	dbEngineSQLite     = "sqlite"
 	defSvcHTTPPort     = "9000"
 	defSvcAuthGRPCPort = "7000"
 )
@@ -75,17 +91,31 @@ const (
 type config struct {
 	InstanceID          string        `env:"SMQ_CLIENTS_INSTANCE_ID"        envDefault:""`
 	LogLevel            string        `env:"SMQ_CLIENTS_LOG_LEVEL"          envDefault:"info"`
+	ReadOnly            bool          `env:"SMQ_CLIENTS_READ_ONLY" envDefault:"false"`
+	AdminOnlyTags       bool          `env:"SMQ_CLIENTS_ADMIN_ONLY_TAGS"    envDefault:"false"`
 	StandaloneID        string        `env:"SMQ_CLIENTS_STANDALONE_ID"      envDefault:""`
 	StandaloneToken     string        `env:"SMQ_CLIENTS_STANDALONE_TOKEN"   envDefault:""`
+	DBEngine            string        `env:"SMQ_CLIENTS_DB_ENGINE"          envDefault:"postgres"`
 	CacheURL            string        `env:"SMQ_CLIENTS_CACHE_URL"          envDefault:"redis://localhost:6379/0"`
 	CacheKeyDuration    time.Duration `env:"SMQ_CLIENTS_CACHE_KEY_DURATION" envDefault:"10m"`
+	PolicyCacheDuration time.Duration `env:"SMQ_CLIENTS_POLICY_CACHE_DURATION" envDefault:"10s"`
+	CacheEntityDuration time.Duration `env:"SMQ_CLIENTS_CACHE_ENTITY_DURATION" envDefault:"0s"`
 	JaegerURL           url.URL       `env:"SMQ_JAEGER_URL"                 envDefault:"http://localhost:4318/v1/traces"`
+	MetricsURL          url.URL       `env:"SMQ_OTEL_METRICS_URL"           envDefault:""`
 	SendTelemetry       bool          `env:"SMQ_SEND_TELEMETRY"             envDefault:"true"`
 	ESURL               string        `env:"SMQ_ES_URL"                     envDefault:"nats://localhost:4222"`
 	TraceRatio          float64       `env:"SMQ_JAEGER_TRACE_RATIO"         envDefault:"1.0"`
 	SpicedbHost         string        `env:"SMQ_SPICEDB_HOST"               envDefault:"localhost"`
 	SpicedbPort         string        `env:"SMQ_SPICEDB_PORT"               envDefault:"50051"`
 	SpicedbPreSharedKey string        `env:"SMQ_SPICEDB_PRE_SHARED_KEY"     envDefault:"12345678"`
+	Quota               string        `env:"SMQ_CLIENTS_QUOTA"              envDefault:""`
+	DeleteInterval      time.Duration `env:"SMQ_CLIENTS_DELETE_INTERVAL"    envDefault:"24h"`
+	DeleteAfter         time.Duration `env:"SMQ_CLIENTS_DELETE_AFTER"       envDefault:"720h"`
+	OutboxInterval      time.Duration `env:"SMQ_CLIENTS_OUTBOX_INTERVAL"    envDefault:"1m"`
+	OutboxMaxRetries    uint64        `env:"SMQ_CLIENTS_OUTBOX_MAX_RETRIES" envDefault:"3"`
+	ReconcileInterval   time.Duration `env:"SMQ_CLIENTS_RECONCILE_INTERVAL" envDefault:"0"`
+	ReconcileRepair     bool          `env:"SMQ_CLIENTS_RECONCILE_REPAIR"   envDefault:"false"`
+	ReconcileDryRun     bool          `env:"SMQ_CLIENTS_RECONCILE_DRY_RUN"  envDefault:"true"`
 }
 
 func main() {
@@ -117,22 +147,45 @@ func main() {
 
 	// Create new database for clients
 	dbConfig := pgclient.Config{Name: defDB}
-	if err := env.ParseWithOptions(&dbConfig, env.Options{Prefix: envPrefixDB}); err != nil {
-		logger.Error(err.Error())
-		exitCode = 1
-		return
-	}
-	tm, err := postgres.Migration()
-	if err != nil {
-		logger.Error(err.Error())
-		exitCode = 1
-		return
-	}
-	db, err := pgclient.Setup(dbConfig, *tm)
-	if err != nil {
-		logger.Error(err.Error())
-		exitCode = 1
-		return
+	sqliteConfig := sqliteclient.Config{}
+	var db *sqlx.DB
+	switch cfg.DBEngine {
+	case dbEngineSQLite:
+		if err := env.ParseWithOptions(&sqliteConfig, env.Options{Prefix: envPrefixDB}); err != nil {
+			logger.Error(err.Error())
+			exitCode = 1
+			return
+		}
+		tm, err := sqlite.Migration()
+		if err != nil {
+			logger.Error(err.Error())
+			exitCode = 1
+			return
+		}
+		db, err = sqliteclient.Setup(sqliteConfig, *tm)
+		if err != nil {
+			logger.Error(err.Error())
+			exitCode = 1
+			return
+		}
+	default:
+		if err := env.ParseWithOptions(&dbConfig, env.Options{Prefix: envPrefixDB}); err != nil {
+			logger.Error(err.Error())
+			exitCode = 1
+			return
+		}
+		tm, err := postgres.Migration()
+		if err != nil {
+			logger.Error(err.Error())
+			exitCode = 1
+			return
+		}
+		db, err = pgclient.Setup(dbConfig, *tm)
+		if err != nil {
+			logger.Error(err.Error())
+			exitCode = 1
+			return
+		}
 	}
 	defer db.Close()
 
@@ -149,6 +202,18 @@ func main() {
 	}()
 	tracer := tp.Tracer(svcName)
 
+	mp, err := otelmetrics.NewProvider(ctx, svcName, cfg.MetricsURL, cfg.InstanceID)
+	if err != nil {
+		logger.Error(fmt.Sprintf("Failed to init OpenTelemetry metrics: %s", err))
+		exitCode = 1
+		return
+	}
+	defer func() {
+		if err := mp.Shutdown(ctx); err != nil {
+			logger.Error(fmt.Sprintf("Error shutting down meter provider: %v", err))
+		}
+	}()
+
 	// Setup new redis cache client
 	cacheclient, err := redisclient.Connect(cfg.CacheURL)
 	if err != nil {
@@ -166,13 +231,24 @@ func main() {
 	}
 	logger.Info("Policy evaluator and Policy manager are successfully connected to SpiceDB gRPC server")
 
+	policyService, err = policiesevents.NewEventStoreMiddleware(ctx, policyService, cfg.ESURL)
+	if err != nil {
+		logger.Error("failed to create policy event store middleware " + err.Error())
+		exitCode = 1
+		return
+	}
+	if cfg.PolicyCacheDuration > 0 {
+		policyService = policiescache.NewMiddleware(policyService, cfg.PolicyCacheDuration)
+	}
+
 	grpcCfg := grpcclient.Config{}
 	if err := env.ParseWithOptions(&grpcCfg, env.Options{Prefix: envPrefixAuth}); err != nil {
 		logger.Error(fmt.Sprintf("failed to load auth gRPC client configuration : %s", err))
 		exitCode = 1
 		return
 	}
-	authn, authnClient, err := authsvcAuthn.NewAuthentication(ctx, grpcCfg)
+	authCounter, authLatency := prometheus.MakeMetrics(svcName, "auth_client")
+	authn, authnClient, err := authsvcAuthn.NewAuthentication(ctx, grpcCfg, authsvcAuthn.WithMetrics(authCounter, authLatency))
 	if err != nil {
 		logger.Error(err.Error())
 		exitCode = 1
@@ -195,7 +271,7 @@ func main() {
 	}
 	defer domainsHandler.Close()
 
-	authz, authzClient, err := authsvcAuthz.NewAuthorization(ctx, grpcCfg, domAuthz)
+	authz, authzClient, err := authsvcAuthz.NewAuthorization(ctx, grpcCfg, domAuthz, authsvcAuthz.WithMetrics(authCounter, authLatency))
 	if err != nil {
 		logger.Error(err.Error())
 		exitCode = 1
@@ -234,12 +310,45 @@ func main() {
 	defer groupsHandler.Close()
 	logger.Info("Groups gRPC client successfully connected to groups gRPC server " + groupsHandler.Secure())
 
-	svc, psvc, err := newService(ctx, db, dbConfig, authz, policyEvaluator, policyService, cacheclient, cfg.CacheKeyDuration, cfg.ESURL, channelsgRPC, groupsClient, tracer, logger)
+	quota, err := metering.ParseQuota(cfg.Quota)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to parse %s quota configuration : %s", svcName, err))
+		exitCode = 1
+		return
+	}
+	meter := metering.New(quota)
+
+	recOpts := []reconciler.Option{}
+	if cfg.ReconcileRepair {
+		recOpts = append(recOpts, reconciler.WithRepair(cfg.ReconcileDryRun))
+	}
+	rec := reconciler.New(policyService, recOpts...)
+
+	mdRegistry := metadata.NewRegistry()
+
+	idpConfig := idprovider.Config{}
+	if err := env.ParseWithOptions(&idpConfig, env.Options{Prefix: envPrefixID}); err != nil {
+		logger.Error(fmt.Sprintf("failed to load %s ID provider configuration : %s", svcName, err))
+		exitCode = 1
+		return
+	}
+	idp, err := idprovider.New(idpConfig)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to create %s ID provider : %s", svcName, err))
+		exitCode = 1
+		return
+	}
+
+	svc, psvc, ob, err := newService(ctx, db, dbConfig, cfg.DBEngine, sqliteConfig, authz, policyEvaluator, policyService, cacheclient, cfg.CacheKeyDuration, cfg.CacheEntityDuration, cfg.ESURL, channelsgRPC, groupsClient, meter, rec, mdRegistry, idp, cfg.OutboxMaxRetries, cfg.AdminOnlyTags, cfg.DeleteInterval, cfg.DeleteAfter, tracer, logger)
 	if err != nil {
 		logger.Error(fmt.Sprintf("failed to create services: %s", err))
 		exitCode = 1
 		return
 	}
+	ob.Start(ctx, cfg.OutboxInterval, logger)
+	if cfg.ReconcileInterval > 0 {
+		rec.Start(ctx, cfg.ReconcileInterval, logger)
+	}
 
 	httpServerConfig := server.Config{Port: defSvcHTTPPort}
 	if err := env.ParseWithOptions(&httpServerConfig, env.Options{Prefix: envPrefixHTTP}); err != nil {
@@ -247,8 +356,20 @@ func main() {
 		exitCode = 1
 		return
 	}
+	adminOnly := chi.Middlewares{smqapi.AuthenticateMiddleware(authn, false), smqapi.AuthorizeAdminMiddleware(authz)}
 	mux := chi.NewRouter()
-	httpSvc := httpserver.NewServer(ctx, cancel, svcName, httpServerConfig, httpapi.MakeHandler(svc, authn, mux, logger, cfg.InstanceID), logger)
+	mux.Get("/ready", httpserver.Ready(svcName, cfg.InstanceID,
+		httpserver.DBCheck(db),
+		httpserver.CacheCheck(cacheclient),
+		httpserver.GRPCCheck("auth", authnClient.Connection()),
+	))
+	mux.Handle("/usage", meter.Handler(authn, authz))
+	mux.With(adminOnly...).Handle("/outbox", ob.Handler())
+	mux.With(adminOnly...).Handle("/reconcile", rec.Handler())
+	mux.Mount("/metadata", metadata.Handler(mdRegistry, authn, authz))
+	ro := smqapi.NewReadOnly(cfg.ReadOnly)
+	mux.With(adminOnly...).Handle("/readonly", ro.Handler())
+	httpSvc := httpserver.NewServer(ctx, cancel, svcName, httpServerConfig, ro.Middleware(smqapi.VersionHandler(httpapi.MakeHandler(svc, authn, mux, logger, cfg.InstanceID))), logger)
 
 	grpcServerConfig := server.Config{Port: defSvcAuthGRPCPort}
 	if err := env.ParseWithOptions(&grpcServerConfig, env.Options{Prefix: envPrefixGRPC}); err != nil {
@@ -259,7 +380,7 @@ func main() {
 
 	registerClientsServer := func(srv *grpc.Server) {
 		reflection.Register(srv)
-		grpcClientsV1.RegisterClientsServiceServer(srv, grpcapi.NewServer(psvc))
+		grpcClientsV1.RegisterClientsServiceServer(srv, grpcapi.NewServer(psvc, svc, authn))
 	}
 	gs := grpcserver.NewServer(ctx, cancel, svcName, grpcServerConfig, registerClientsServer, logger)
 
@@ -286,27 +407,91 @@ func main() {
 	}
 }
 
-func newService(ctx context.Context, db *sqlx.DB, dbConfig pgclient.Config, authz smqauthz.Authorization, pe policies.Evaluator, ps policies.Service, cacheClient *redis.Client, keyDuration time.Duration, esURL string, channels grpcChannelsV1.ChannelsServiceClient, groups grpcGroupsV1.GroupsServiceClient, tracer trace.Tracer, logger *slog.Logger) (clients.Service, pClients.Service, error) {
-	database := pg.NewDatabase(db, dbConfig, tracer)
-	repo := postgres.NewRepository(database)
+func newService(ctx context.Context, db *sqlx.DB, dbConfig pgclient.Config, dbEngine string, sqliteConfig sqliteclient.Config, authz smqauthz.Authorization, pe policies.Evaluator, ps policies.Service, cacheClient redis.UniversalClient, keyDuration, entityDuration time.Duration, esURL string, channels grpcChannelsV1.ChannelsServiceClient, groups grpcGroupsV1.GroupsServiceClient, meter *metering.Meter, rec *reconciler.Reconciler, mdRegistry metadata.Registry, idp supermq.IDProvider, outboxMaxRetries uint64, adminOnlyTags bool, deleteInterval, deleteAfter time.Duration, tracer trace.Tracer, logger *slog.Logger) (clients.Service, pClients.Service, *outbox.Coordinator, error) {
+	var database pg.Database
+	var repo clients.Repository
+	switch dbEngine {
+	case dbEngineSQLite:
+		database = sqliteclient.NewDatabase(db, sqliteConfig, tracer)
+		repo = sqlite.NewRepository(database)
+	default:
+		database = pg.NewDatabase(db, dbConfig, tracer)
+		if dbConfig.TenantSchemas {
+			tenantDB := pg.NewTenantDatabase(database, dbConfig, tracer)
+			subscriber, err := store.NewSubscriber(ctx, esURL, logger)
+			if err != nil {
+				return nil, nil, nil, err
+			}
+			migrations, err := postgres.Migration()
+			if err != nil {
+				return nil, nil, nil, err
+			}
+			if err := pgevents.Start(ctx, svcName, subscriber, tenantDB, *migrations); err != nil {
+				return nil, nil, nil, err
+			}
+			database = tenantDB
+		}
+		if dbConfig.RowLevelSecurity {
+			database = pg.NewRLSDatabase(database)
+		}
+		repo = postgres.NewRepository(database)
+	}
+	if entityDuration > 0 {
+		repo = cache.NewRepository(repo, cacheClient, entityDuration)
+	}
+
+	meter.RegisterCounter(metering.ResourceClients, func(ctx context.Context, domainID string) (uint64, error) {
+		page, err := repo.RetrieveAll(ctx, clients.Page{Domain: domainID, Limit: 1})
+		if err != nil {
+			return 0, err
+		}
+		return page.Total, nil
+	})
+
+	rec.RegisterEntity(policies.ClientType, func(ctx context.Context) ([]reconciler.Entity, error) {
+		entities := []reconciler.Entity{}
+		for offset := uint64(0); ; offset += 100 {
+			page, err := repo.RetrieveAll(ctx, clients.Page{Offset: offset, Limit: 100, Status: clients.AllStatus})
+			if err != nil {
+				return nil, err
+			}
+			for _, c := range page.Clients {
+				entities = append(entities, reconciler.Entity{ID: c.ID, Domain: c.Domain})
+			}
+			if offset+100 >= page.Total {
+				return entities, nil
+			}
+		}
+	})
 
-	idp := uuid.New()
 	sidp, err := sid.New()
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	// Clients service
 	cache := cache.NewCache(cacheClient, keyDuration)
 
-	csvc, err := clients.NewService(repo, ps, cache, channels, groups, idp, sidp)
+	ob := outbox.NewCoordinator(outboxpg.NewRepository(database), ps, idp, outboxMaxRetries)
+	ob.RegisterCompensator(policies.ClientType, func(ctx context.Context, op outbox.Operation) error {
+		return repo.Delete(ctx, op.EntityID)
+	})
+
+	svcOpts := []clients.Option{clients.WithOutbox(ob), clients.WithMetadataValidator(mdRegistry)}
+	if adminOnlyTags {
+		svcOpts = append(svcOpts, clients.WithAdminOnlyTags())
+	}
+	csvc, err := clients.NewService(repo, ps, cache, channels, groups, idp, sidp, svcOpts...)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
+	clients.NewDeleteHandler(ctx, repo, ps, deleteInterval, deleteAfter, logger)
+	csvc = middleware.QuotaMiddleware(csvc, meter)
+
 	csvc, err = events.NewEventStoreMiddleware(ctx, csvc, esURL)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	csvc = tracing.New(csvc, tracer)
@@ -317,13 +502,13 @@ func newService(ctx context.Context, db *sqlx.DB, dbConfig pgclient.Config, auth
 
 	csvc, err = middleware.AuthorizationMiddleware(policies.ClientType, csvc, authz, repo, clients.NewOperationPermissionMap(), clients.NewRolesOperationPermissionMap(), clients.NewExternalOperationPermissionMap())
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 	csvc = middleware.LoggingMiddleware(csvc, logger)
 
 	isvc := pClients.New(repo, cache, pe, ps)
 
-	return csvc, isvc, err
+	return csvc, isvc, ob, err
 }
 
 func newSpiceDBPolicyServiceEvaluator(cfg config, logger *slog.Logger) (policies.Evaluator, policies.Service, error) {
@@ -17,10 +17,13 @@ import (
 	httpapi "github.com/absmach/supermq/coap/api"
 	"github.com/absmach/supermq/coap/tracing"
 	smqlog "github.com/absmach/supermq/logger"
+	"github.com/absmach/supermq/pkg/events/store"
 	"github.com/absmach/supermq/pkg/grpcclient"
 	jaegerclient "github.com/absmach/supermq/pkg/jaeger"
+	"github.com/absmach/supermq/pkg/messaging"
 	"github.com/absmach/supermq/pkg/messaging/brokers"
 	brokerstracing "github.com/absmach/supermq/pkg/messaging/brokers/tracing"
+	msgevents "github.com/absmach/supermq/pkg/messaging/events"
 	"github.com/absmach/supermq/pkg/prometheus"
 	"github.com/absmach/supermq/pkg/server"
 	coapserver "github.com/absmach/supermq/pkg/server/coap"
@@ -43,6 +46,7 @@ const (
 type config struct {
 	LogLevel      string  `env:"SMQ_COAP_ADAPTER_LOG_LEVEL"   envDefault:"info"`
 	BrokerURL     string  `env:"SMQ_MESSAGE_BROKER_URL"       envDefault:"nats://localhost:4222"`
+	ESURL         string  `env:"SMQ_ES_URL"                   envDefault:"nats://localhost:4222"`
 	JaegerURL     url.URL `env:"SMQ_JAEGER_URL"               envDefault:"http://localhost:4318/v1/traces"`
 	SendTelemetry bool    `env:"SMQ_SEND_TELEMETRY"           envDefault:"true"`
 	InstanceID    string  `env:"SMQ_COAP_ADAPTER_INSTANCE_ID" envDefault:""`
@@ -143,6 +147,28 @@ func main() {
 	defer nps.Close()
 	nps = brokerstracing.NewPubSub(coapServerConfig, tracer, nps)
 
+	subscriber, err := store.NewSubscriber(ctx, cfg.ESURL, logger)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to create subscriber: %s", err))
+		exitCode = 1
+		return
+	}
+	resolver := msgevents.NewOrderingResolver()
+	if err := msgevents.Start(ctx, svcName+"-ordering-"+cfg.InstanceID, subscriber, resolver); err != nil {
+		logger.Error(fmt.Sprintf("failed to start %s event consumer: %s", svcName, err))
+		exitCode = 1
+		return
+	}
+	nps = messaging.NewOrderingPubSub(nps, resolver.Resolve)
+
+	registry := msgevents.NewSchemaRegistry()
+	if err := msgevents.StartSchemas(ctx, svcName+"-schemas", subscriber, registry); err != nil {
+		logger.Error(fmt.Sprintf("failed to start %s schema consumer: %s", svcName, err))
+		exitCode = 1
+		return
+	}
+	nps = msgevents.NewValidatingPubSub(nps, registry)
+
 	svc := coap.New(clientsClient, channelsClient, nps)
 
 	svc = tracing.New(tracer, svc)
@@ -15,6 +15,7 @@ import (
 	chclient "github.com/absmach/callhome/pkg/client"
 	"github.com/absmach/supermq"
 	grpcDomainsV1 "github.com/absmach/supermq/api/grpc/domains/v1"
+	smqapi "github.com/absmach/supermq/api/http"
 	"github.com/absmach/supermq/domains"
 	domainsSvc "github.com/absmach/supermq/domains"
 	domainsgrpcapi "github.com/absmach/supermq/domains/api/grpc"
@@ -69,6 +70,7 @@ const (
 
 type config struct {
 	LogLevel            string        `env:"SMQ_DOMAINS_LOG_LEVEL"            envDefault:"info"`
+	ReadOnly            bool          `env:"SMQ_DOMAINS_READ_ONLY" envDefault:"false"`
 	JaegerURL           url.URL       `env:"SMQ_JAEGER_URL"                   envDefault:"http://localhost:4318/v1/traces"`
 	SendTelemetry       bool          `env:"SMQ_SEND_TELEMETRY"               envDefault:"true"`
 	CacheURL            string        `env:"SMQ_DOMAINS_CACHE_URL"            envDefault:"redis://localhost:6379/0"`
@@ -77,6 +79,7 @@ type config struct {
 	SpicedbHost         string        `env:"SMQ_SPICEDB_HOST"                 envDefault:"localhost"`
 	SpicedbPort         string        `env:"SMQ_SPICEDB_PORT"                 envDefault:"50051"`
 	SpicedbSchemaFile   string        `env:"SMQ_SPICEDB_SCHEMA_FILE"          envDefault:"schema.zed"`
+	RoleTemplates       string        `env:"SMQ_DOMAINS_ROLE_TEMPLATES"       envDefault:""`
 	SpicedbPreSharedKey string        `env:"SMQ_SPICEDB_PRE_SHARED_KEY"       envDefault:"12345678"`
 	TraceRatio          float64       `env:"SMQ_JAEGER_TRACE_RATIO"           envDefault:"1.0"`
 	ESURL               string        `env:"SMQ_ES_URL"                       envDefault:"nats://localhost:4222"`
@@ -222,7 +225,8 @@ func main() {
 		return
 	}
 	mux := chi.NewMux()
-	hs := httpserver.NewServer(ctx, cancel, svcName, httpServerConfig, httpapi.MakeHandler(svc, authn, mux, logger, cfg.InstanceID), logger)
+	ro := smqapi.NewReadOnly(cfg.ReadOnly)
+	hs := httpserver.NewServer(ctx, cancel, svcName, httpServerConfig, ro.Middleware(smqapi.VersionHandler(httpapi.MakeHandler(svc, authn, mux, logger, cfg.InstanceID))), logger)
 
 	g.Go(func() error {
 		return hs.Start()
@@ -249,7 +253,7 @@ func newDomainService(ctx context.Context, domainsRepo domainsSvc.Repository, ca
 		return nil, fmt.Errorf("failed to init short id provider : %w", err)
 	}
 
-	availableActions, builtInRoles, err := availableActionsAndBuiltInRoles(cfg.SpicedbSchemaFile)
+	availableActions, builtInRoles, err := availableActionsAndBuiltInRoles(cfg.SpicedbSchemaFile, cfg.RoleTemplates)
 	if err != nil {
 		return nil, err
 	}
@@ -291,7 +295,7 @@ func newPolicyService(cfg config, logger *slog.Logger) (policies.Service, error)
 	return policySvc, nil
 }
 
-func availableActionsAndBuiltInRoles(spicedbSchemaFile string) ([]roles.Action, map[roles.BuiltInRoleName][]roles.Action, error) {
+func availableActionsAndBuiltInRoles(spicedbSchemaFile, roleTemplates string) ([]roles.Action, map[roles.BuiltInRoleName][]roles.Action, error) {
 	availableActions, err := spicedbdecoder.GetActionsFromSchema(spicedbSchemaFile, policies.DomainType)
 	if err != nil {
 		return []roles.Action{}, map[roles.BuiltInRoleName][]roles.Action{}, err
@@ -301,5 +305,10 @@ func availableActionsAndBuiltInRoles(spicedbSchemaFile string) ([]roles.Action,
 		domains.BuiltInRoleAdmin: availableActions,
 	}
 
+	builtInRoles, err = roles.OverrideBuiltInRoles(builtInRoles, roleTemplates)
+	if err != nil {
+		return []roles.Action{}, map[roles.BuiltInRoleName][]roles.Action{}, err
+	}
+
 	return availableActions, builtInRoles, err
 }
@@ -0,0 +1,228 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package main contains export main function to start the export service.
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	chclient "github.com/absmach/callhome/pkg/client"
+	"github.com/absmach/supermq"
+	smqapi "github.com/absmach/supermq/api/http"
+	"github.com/absmach/supermq/export"
+	smqlog "github.com/absmach/supermq/logger"
+	authsvcAuthn "github.com/absmach/supermq/pkg/authn/authsvc"
+	authsvcAuthz "github.com/absmach/supermq/pkg/authz/authsvc"
+	domainsAuthz "github.com/absmach/supermq/pkg/domains/grpcclient"
+	"github.com/absmach/supermq/pkg/grpcclient"
+	"github.com/absmach/supermq/pkg/messaging"
+	"github.com/absmach/supermq/pkg/messaging/brokers"
+	"github.com/absmach/supermq/pkg/server"
+	"github.com/absmach/supermq/pkg/server/http"
+	"github.com/absmach/supermq/pkg/uuid"
+	"github.com/caarlos0/env/v11"
+	"github.com/go-chi/chi/v5"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	svcName          = "export"
+	envPrefixHTTP    = "SMQ_EXPORT_HTTP_"
+	envPrefixAuth    = "SMQ_AUTH_GRPC_"
+	envPrefixDomains = "SMQ_DOMAINS_GRPC_"
+	defSvcHTTPPort   = "9029"
+)
+
+type config struct {
+	LogLevel      string        `env:"SMQ_EXPORT_LOG_LEVEL"    envDefault:"info"`
+	ConfigPath    string        `env:"SMQ_EXPORT_CONFIG_PATH"  envDefault:"/config.toml"`
+	BrokerURL     string        `env:"SMQ_MESSAGE_BROKER_URL"  envDefault:"nats://localhost:4222"`
+	MQTTAddress   string        `env:"SMQ_EXPORT_MQTT_ADDRESS" envDefault:""`
+	MQTTQoS       uint8         `env:"SMQ_EXPORT_MQTT_QOS"     envDefault:"1"`
+	MQTTTimeout   time.Duration `env:"SMQ_EXPORT_MQTT_TIMEOUT" envDefault:"30s"`
+	HTTPSinkURL   string        `env:"SMQ_EXPORT_HTTP_SINK_URL"   envDefault:""`
+	HTTPSinkToken string        `env:"SMQ_EXPORT_HTTP_SINK_TOKEN" envDefault:""`
+	SendTelemetry bool          `env:"SMQ_SEND_TELEMETRY"      envDefault:"true"`
+	InstanceID    string        `env:"SMQ_EXPORT_INSTANCE_ID"  envDefault:""`
+}
+
+func main() {
+	ctx, cancel := context.WithCancel(context.Background())
+	g, ctx := errgroup.WithContext(ctx)
+
+	cfg := config{}
+	if err := env.Parse(&cfg); err != nil {
+		log.Fatalf("failed to load %s configuration : %s", svcName, err)
+	}
+
+	logger, err := smqlog.New(os.Stdout, cfg.LogLevel)
+	if err != nil {
+		log.Fatalf("failed to init logger: %s", err)
+	}
+
+	var exitCode int
+	defer smqlog.ExitWithError(&exitCode)
+
+	if cfg.InstanceID == "" {
+		if cfg.InstanceID, err = uuid.New().ID(); err != nil {
+			logger.Error(fmt.Sprintf("failed to generate instanceID: %s", err))
+			exitCode = 1
+			return
+		}
+	}
+
+	pipelines, err := export.LoadPipelines(cfg.ConfigPath)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to load pipelines : %s", err))
+		exitCode = 1
+		return
+	}
+
+	sinks := map[string]export.Sink{}
+	if cfg.MQTTAddress != "" {
+		mqttSink, err := export.NewMQTTSink(cfg.MQTTAddress, svcName+"-"+cfg.InstanceID, cfg.MQTTQoS, cfg.MQTTTimeout)
+		if err != nil {
+			logger.Error(fmt.Sprintf("failed to create MQTT sink : %s", err))
+			exitCode = 1
+			return
+		}
+		defer mqttSink.Close()
+		sinks["mqtt"] = mqttSink
+	}
+	if cfg.HTTPSinkURL != "" {
+		sinks["http"] = export.NewHTTPSink(cfg.HTTPSinkURL, cfg.HTTPSinkToken)
+	}
+
+	svc, err := export.New(pipelines, sinks)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to create %s service : %s", svcName, err))
+		exitCode = 1
+		return
+	}
+
+	pubsub, err := brokers.NewPubSub(ctx, cfg.BrokerURL, logger)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to connect to message broker: %s", err))
+		exitCode = 1
+		return
+	}
+	defer pubsub.Close()
+
+	if err := subscribe(ctx, pubsub, svc, pipelines, svcName+"-"+cfg.InstanceID); err != nil {
+		logger.Error(fmt.Sprintf("failed to subscribe to message broker: %s", err))
+		exitCode = 1
+		return
+	}
+
+	authClientCfg := grpcclient.Config{}
+	if err := env.ParseWithOptions(&authClientCfg, env.Options{Prefix: envPrefixAuth}); err != nil {
+		logger.Error(fmt.Sprintf("failed to load auth gRPC client configuration : %s", err))
+		exitCode = 1
+		return
+	}
+
+	authn, authnHandler, err := authsvcAuthn.NewAuthentication(ctx, authClientCfg)
+	if err != nil {
+		logger.Error(err.Error())
+		exitCode = 1
+		return
+	}
+	defer authnHandler.Close()
+	logger.Info("AuthN successfully connected to auth gRPC server " + authnHandler.Secure())
+
+	domsGrpcCfg := grpcclient.Config{}
+	if err := env.ParseWithOptions(&domsGrpcCfg, env.Options{Prefix: envPrefixDomains}); err != nil {
+		logger.Error(fmt.Sprintf("failed to load domains gRPC client configuration : %s", err))
+		exitCode = 1
+		return
+	}
+	domAuthz, _, domainsHandler, err := domainsAuthz.NewAuthorization(ctx, domsGrpcCfg)
+	if err != nil {
+		logger.Error(err.Error())
+		exitCode = 1
+		return
+	}
+	defer domainsHandler.Close()
+
+	authz, authzHandler, err := authsvcAuthz.NewAuthorization(ctx, authClientCfg, domAuthz)
+	if err != nil {
+		logger.Error(err.Error())
+		exitCode = 1
+		return
+	}
+	defer authzHandler.Close()
+	logger.Info("AuthZ successfully connected to auth gRPC server " + authzHandler.Secure())
+
+	httpServerConfig := server.Config{Port: defSvcHTTPPort}
+	if err := env.ParseWithOptions(&httpServerConfig, env.Options{Prefix: envPrefixHTTP}); err != nil {
+		logger.Error(fmt.Sprintf("failed to load %s HTTP server configuration : %s", svcName, err.Error()))
+		exitCode = 1
+		return
+	}
+
+	adminOnly := chi.Middlewares{smqapi.AuthenticateMiddleware(authn, false), smqapi.AuthorizeAdminMiddleware(authz)}
+	mux := chi.NewRouter()
+	mux.Get("/health", supermq.Health(svcName, cfg.InstanceID))
+	mux.With(adminOnly...).Handle("/checkpoints", svc.Handler())
+	hs := http.NewServer(ctx, cancel, svcName, httpServerConfig, smqapi.VersionHandler(mux), logger)
+
+	if cfg.SendTelemetry {
+		chc := chclient.New(svcName, supermq.Version, logger, cancel)
+		go chc.CallHome(ctx)
+	}
+
+	g.Go(func() error {
+		return hs.Start()
+	})
+
+	g.Go(func() error {
+		return server.StopSignalHandler(ctx, cancel, logger, svcName, hs)
+	})
+
+	if err := g.Wait(); err != nil {
+		logger.Error(fmt.Sprintf("%s service terminated: %s", svcName, err))
+	}
+}
+
+type messageHandler struct {
+	svc export.Service
+}
+
+func (h messageHandler) Handle(msg *messaging.Message) error {
+	return h.svc.ConsumeBlocking(context.Background(), msg)
+}
+
+func (h messageHandler) Cancel() error {
+	return nil
+}
+
+// subscribe subscribes the export service to the channel of every
+// pipeline.
+func subscribe(ctx context.Context, pubsub messaging.PubSub, svc export.Service, pipelines []export.Pipeline, id string) error {
+	handler := messageHandler{svc: svc}
+	seen := map[string]bool{}
+	for _, p := range pipelines {
+		topic := fmt.Sprintf("channels.%s", p.Channel)
+		if p.Subtopic != "" {
+			topic = fmt.Sprintf("%s.%s", topic, p.Subtopic)
+		}
+		if seen[topic] {
+			continue
+		}
+		seen[topic] = true
+		subCfg := messaging.SubscriberConfig{
+			ID:      fmt.Sprintf("%s-%d", id, len(seen)),
+			Topic:   topic,
+			Handler: handler,
+		}
+		if err := pubsub.Subscribe(ctx, subCfg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package main contains graphql main function to start the GraphQL gateway service.
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+
+	"github.com/absmach/supermq"
+	"github.com/absmach/supermq/graphql"
+	smqlog "github.com/absmach/supermq/logger"
+	mgsdk "github.com/absmach/supermq/pkg/sdk"
+	"github.com/absmach/supermq/pkg/server"
+	httpserver "github.com/absmach/supermq/pkg/server/http"
+	"github.com/absmach/supermq/pkg/uuid"
+	"github.com/caarlos0/env/v11"
+	"github.com/go-chi/chi/v5"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	svcName        = "graphql"
+	envPrefixHTTP  = "SMQ_GRAPHQL_HTTP_"
+	defSvcHTTPPort = "9023"
+)
+
+type config struct {
+	LogLevel    string `env:"SMQ_GRAPHQL_LOG_LEVEL"   envDefault:"info"`
+	UsersURL    string `env:"SMQ_USERS_URL"           envDefault:"http://localhost:9002"`
+	ClientsURL  string `env:"SMQ_CLIENTS_URL"         envDefault:"http://localhost:9000"`
+	ChannelsURL string `env:"SMQ_CHANNELS_URL"        envDefault:"http://localhost:9005"`
+	GroupsURL   string `env:"SMQ_GROUPS_URL"          envDefault:"http://localhost:9004"`
+	DomainsURL  string `env:"SMQ_DOMAINS_URL"         envDefault:"http://localhost:9003"`
+	InstanceID  string `env:"SMQ_GRAPHQL_INSTANCE_ID" envDefault:""`
+}
+
+func main() {
+	ctx, cancel := context.WithCancel(context.Background())
+	g, ctx := errgroup.WithContext(ctx)
+
+	cfg := config{}
+	if err := env.Parse(&cfg); err != nil {
+		log.Fatalf("failed to load %s configuration : %s", svcName, err)
+	}
+
+	logger, err := smqlog.New(os.Stdout, cfg.LogLevel)
+	if err != nil {
+		log.Fatalf("failed to init logger: %s", err.Error())
+	}
+
+	var exitCode int
+	defer smqlog.ExitWithError(&exitCode)
+
+	if cfg.InstanceID == "" {
+		if cfg.InstanceID, err = uuid.New().ID(); err != nil {
+			logger.Error(fmt.Sprintf("failed to generate instanceID: %s", err))
+			exitCode = 1
+			return
+		}
+	}
+
+	sdk := mgsdk.NewSDK(mgsdk.Config{
+		UsersURL:    cfg.UsersURL,
+		ClientsURL:  cfg.ClientsURL,
+		ChannelsURL: cfg.ChannelsURL,
+		GroupsURL:   cfg.GroupsURL,
+		DomainsURL:  cfg.DomainsURL,
+	})
+
+	handler, err := graphql.MakeHandler(sdk)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to build GraphQL schema: %s", err))
+		exitCode = 1
+		return
+	}
+
+	mux := chi.NewRouter()
+	mux.Post("/graphql", handler.ServeHTTP)
+	mux.Get("/health", supermq.Health(svcName, cfg.InstanceID))
+
+	httpServerConfig := server.Config{Port: defSvcHTTPPort}
+	if err := env.ParseWithOptions(&httpServerConfig, env.Options{Prefix: envPrefixHTTP}); err != nil {
+		logger.Error(fmt.Sprintf("failed to load %s HTTP server configuration : %s", svcName, err))
+		exitCode = 1
+		return
+	}
+	hs := httpserver.NewServer(ctx, cancel, svcName, httpServerConfig, http.Handler(mux), logger)
+
+	g.Go(func() error {
+		return hs.Start()
+	})
+
+	g.Go(func() error {
+		return server.StopSignalHandler(ctx, cancel, logger, svcName, hs)
+	})
+
+	if err := g.Wait(); err != nil {
+		logger.Error(fmt.Sprintf("GraphQL gateway service terminated: %s", err))
+	}
+}
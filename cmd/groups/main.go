@@ -11,32 +11,41 @@ import (
 	"log/slog"
 	"net/url"
 	"os"
+	"time"
 
 	chclient "github.com/absmach/callhome/pkg/client"
 	"github.com/absmach/supermq"
 	grpcChannelsV1 "github.com/absmach/supermq/api/grpc/channels/v1"
 	grpcClientsV1 "github.com/absmach/supermq/api/grpc/clients/v1"
 	grpcGroupsV1 "github.com/absmach/supermq/api/grpc/groups/v1"
+	smqapi "github.com/absmach/supermq/api/http"
 	"github.com/absmach/supermq/groups"
 	gpsvc "github.com/absmach/supermq/groups"
 	grpcapi "github.com/absmach/supermq/groups/api/grpc"
 	httpapi "github.com/absmach/supermq/groups/api/http"
+	"github.com/absmach/supermq/groups/cache"
 	"github.com/absmach/supermq/groups/events"
 	"github.com/absmach/supermq/groups/middleware"
 	"github.com/absmach/supermq/groups/postgres"
 	pgroups "github.com/absmach/supermq/groups/private"
+	"github.com/absmach/supermq/groups/sqlite"
 	"github.com/absmach/supermq/groups/tracing"
+	redisclient "github.com/absmach/supermq/internal/clients/redis"
 	smqlog "github.com/absmach/supermq/logger"
 	authsvcAuthn "github.com/absmach/supermq/pkg/authn/authsvc"
 	smqauthz "github.com/absmach/supermq/pkg/authz"
 	authsvcAuthz "github.com/absmach/supermq/pkg/authz/authsvc"
 	domainsAuthz "github.com/absmach/supermq/pkg/domains/grpcclient"
+	store "github.com/absmach/supermq/pkg/events/store"
 	"github.com/absmach/supermq/pkg/grpcclient"
 	jaegerclient "github.com/absmach/supermq/pkg/jaeger"
 	"github.com/absmach/supermq/pkg/policies"
+	policiescache "github.com/absmach/supermq/pkg/policies/cache"
+	policiesevents "github.com/absmach/supermq/pkg/policies/events"
 	"github.com/absmach/supermq/pkg/policies/spicedb"
 	pg "github.com/absmach/supermq/pkg/postgres"
 	pgclient "github.com/absmach/supermq/pkg/postgres"
+	pgevents "github.com/absmach/supermq/pkg/postgres/events"
 	"github.com/absmach/supermq/pkg/prometheus"
 	"github.com/absmach/supermq/pkg/roles"
 	"github.com/absmach/supermq/pkg/server"
@@ -44,12 +53,14 @@ import (
 	httpserver "github.com/absmach/supermq/pkg/server/http"
 	"github.com/absmach/supermq/pkg/sid"
 	spicedbdecoder "github.com/absmach/supermq/pkg/spicedb"
+	sqliteclient "github.com/absmach/supermq/pkg/sqlite"
 	"github.com/absmach/supermq/pkg/uuid"
 	"github.com/authzed/authzed-go/v1"
 	"github.com/authzed/grpcutil"
 	"github.com/caarlos0/env/v11"
 	"github.com/go-chi/chi/v5"
 	"github.com/jmoiron/sqlx"
+	"github.com/redis/go-redis/v9"
 	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
@@ -67,12 +78,14 @@ const (
 	envPrefixChannels = "SMQ_CHANNELS_GRPC_"
 	envPrefixClients  = "SMQ_CLIENTS_AUTH_GRPC_"
 	defDB             = "groups"
+	dbEngineSQLite    = "sqlite"
 	defSvcHTTPPort    = "9004"
 	defSvcgRPCPort    = "7004"
 )
 
 type config struct {
 	LogLevel            string  `env:"SMQ_GROUPS_LOG_LEVEL"          envDefault:"info"`
+	ReadOnly            bool    `env:"SMQ_GROUPS_READ_ONLY" envDefault:"false"`
 	InstanceID          string  `env:"SMQ_GROUPS_INSTANCE_ID"        envDefault:""`
 	JaegerURL           url.URL `env:"SMQ_JAEGER_URL"                envDefault:"http://localhost:4318/v1/traces"`
 	SendTelemetry       bool    `env:"SMQ_SEND_TELEMETRY"            envDefault:"true"`
@@ -81,7 +94,15 @@ type config struct {
 	SpicedbHost         string  `env:"SMQ_SPICEDB_HOST"              envDefault:"localhost"`
 	SpicedbPort         string  `env:"SMQ_SPICEDB_PORT"              envDefault:"50051"`
 	SpicedbSchemaFile   string  `env:"SMQ_SPICEDB_SCHEMA_FILE"       envDefault:"schema.zed"`
+	RoleTemplates       string  `env:"SMQ_GROUPS_ROLE_TEMPLATES"     envDefault:""`
 	SpicedbPreSharedKey string  `env:"SMQ_SPICEDB_PRE_SHARED_KEY"    envDefault:"12345678"`
+
+	DBEngine            string        `env:"SMQ_GROUPS_DB_ENGINE"             envDefault:"postgres"`
+	CacheURL            string        `env:"SMQ_GROUPS_CACHE_URL"             envDefault:"redis://localhost:6379/0"`
+	CacheEntityDuration time.Duration `env:"SMQ_GROUPS_CACHE_ENTITY_DURATION" envDefault:"0s"`
+	PolicyCacheDuration time.Duration `env:"SMQ_GROUPS_POLICY_CACHE_DURATION" envDefault:"10s"`
+	DeleteInterval      time.Duration `env:"SMQ_GROUPS_DELETE_INTERVAL"       envDefault:"24h"`
+	DeleteAfter         time.Duration `env:"SMQ_GROUPS_DELETE_AFTER"          envDefault:"720h"`
 }
 
 func main() {
@@ -110,22 +131,45 @@ func main() {
 	}
 
 	dbConfig := pgclient.Config{Name: defDB}
-	if err := env.ParseWithOptions(&dbConfig, env.Options{Prefix: envPrefixDB}); err != nil {
-		logger.Error(err.Error())
-		exitCode = 1
-		return
-	}
-	gm, err := postgres.Migration()
-	if err != nil {
-		logger.Error(err.Error())
-		exitCode = 1
-		return
-	}
-	db, err := pgclient.Setup(dbConfig, *gm)
-	if err != nil {
-		logger.Error(err.Error())
-		exitCode = 1
-		return
+	sqliteConfig := sqliteclient.Config{}
+	var db *sqlx.DB
+	switch cfg.DBEngine {
+	case dbEngineSQLite:
+		if err := env.ParseWithOptions(&sqliteConfig, env.Options{Prefix: envPrefixDB}); err != nil {
+			logger.Error(err.Error())
+			exitCode = 1
+			return
+		}
+		gm, err := sqlite.Migration()
+		if err != nil {
+			logger.Error(err.Error())
+			exitCode = 1
+			return
+		}
+		db, err = sqliteclient.Setup(sqliteConfig, *gm)
+		if err != nil {
+			logger.Error(err.Error())
+			exitCode = 1
+			return
+		}
+	default:
+		if err := env.ParseWithOptions(&dbConfig, env.Options{Prefix: envPrefixDB}); err != nil {
+			logger.Error(err.Error())
+			exitCode = 1
+			return
+		}
+		gm, err := postgres.Migration()
+		if err != nil {
+			logger.Error(err.Error())
+			exitCode = 1
+			return
+		}
+		db, err = pgclient.Setup(dbConfig, *gm)
+		if err != nil {
+			logger.Error(err.Error())
+			exitCode = 1
+			return
+		}
 	}
 	defer db.Close()
 
@@ -149,7 +193,8 @@ func main() {
 		return
 	}
 
-	authn, authnHandler, err := authsvcAuthn.NewAuthentication(ctx, authClientConfig)
+	authCounter, authLatency := prometheus.MakeMetrics(svcName, "auth_client")
+	authn, authnHandler, err := authsvcAuthn.NewAuthentication(ctx, authClientConfig, authsvcAuthn.WithMetrics(authCounter, authLatency))
 	if err != nil {
 		logger.Error("failed to create authn " + err.Error())
 		exitCode = 1
@@ -172,7 +217,7 @@ func main() {
 	}
 	defer domainsHandler.Close()
 
-	authz, authzHandler, err := authsvcAuthz.NewAuthorization(ctx, authClientConfig, domAuthz)
+	authz, authzHandler, err := authsvcAuthz.NewAuthorization(ctx, authClientConfig, domAuthz, authsvcAuthz.WithMetrics(authCounter, authLatency))
 	if err != nil {
 		logger.Error("failed to create authz " + err.Error())
 		exitCode = 1
@@ -189,6 +234,16 @@ func main() {
 	}
 	logger.Info("Policy client successfully connected to spicedb gRPC server")
 
+	policyService, err = policiesevents.NewEventStoreMiddleware(ctx, policyService, cfg.ESURL)
+	if err != nil {
+		logger.Error("failed to create policy event store middleware " + err.Error())
+		exitCode = 1
+		return
+	}
+	if cfg.PolicyCacheDuration > 0 {
+		policyService = policiescache.NewMiddleware(policyService, cfg.PolicyCacheDuration)
+	}
+
 	chgrpcCfg := grpcclient.Config{}
 	if err := env.ParseWithOptions(&chgrpcCfg, env.Options{Prefix: envPrefixChannels}); err != nil {
 		logger.Error(fmt.Sprintf("failed to load channels gRPC client configuration : %s", err))
@@ -219,7 +274,18 @@ func main() {
 	defer clientsHandler.Close()
 	logger.Info("Clients gRPC client successfully connected to clients gRPC server " + clientsHandler.Secure())
 
-	svc, psvc, err := newService(ctx, authz, policyService, db, dbConfig, channelsClient, clientsClient, tracer, logger, cfg)
+	var cacheClient redis.UniversalClient
+	if cfg.CacheEntityDuration > 0 {
+		cacheClient, err = redisclient.Connect(cfg.CacheURL)
+		if err != nil {
+			logger.Error(err.Error())
+			exitCode = 1
+			return
+		}
+		defer cacheClient.Close()
+	}
+
+	svc, psvc, err := newService(ctx, authz, policyService, db, dbConfig, sqliteConfig, cacheClient, channelsClient, clientsClient, tracer, logger, cfg)
 	if err != nil {
 		logger.Error(fmt.Sprintf("failed to setup service: %s", err))
 		exitCode = 1
@@ -234,7 +300,14 @@ func main() {
 	}
 
 	mux := chi.NewRouter()
-	httpSrv := httpserver.NewServer(ctx, cancel, svcName, httpServerConfig, httpapi.MakeHandler(svc, authn, mux, logger, cfg.InstanceID), logger)
+	mux.Get("/ready", httpserver.Ready(svcName, cfg.InstanceID,
+		httpserver.DBCheck(db),
+		httpserver.GRPCCheck("auth", authnHandler.Connection()),
+	))
+	adminOnly := chi.Middlewares{smqapi.AuthenticateMiddleware(authn, false), smqapi.AuthorizeAdminMiddleware(authz)}
+	ro := smqapi.NewReadOnly(cfg.ReadOnly)
+	mux.With(adminOnly...).Handle("/readonly", ro.Handler())
+	httpSrv := httpserver.NewServer(ctx, cancel, svcName, httpServerConfig, ro.Middleware(smqapi.VersionHandler(httpapi.MakeHandler(svc, authn, mux, logger, cfg.InstanceID))), logger)
 
 	grpcServerConfig := server.Config{}
 	if err := env.ParseWithOptions(&grpcServerConfig, env.Options{Prefix: envPrefixgRPC}); err != nil {
@@ -245,7 +318,7 @@ func main() {
 
 	registerGroupsServer := func(srv *grpc.Server) {
 		reflection.Register(srv)
-		grpcGroupsV1.RegisterGroupsServiceServer(srv, grpcapi.NewServer(psvc))
+		grpcGroupsV1.RegisterGroupsServiceServer(srv, grpcapi.NewServer(psvc, svc, authn))
 	}
 	gs := grpcserver.NewServer(ctx, cancel, svcName, grpcServerConfig, registerGroupsServer, logger)
 
@@ -271,25 +344,60 @@ func main() {
 	}
 }
 
-func newService(ctx context.Context, authz smqauthz.Authorization, policy policies.Service, db *sqlx.DB, dbConfig pgclient.Config, channels grpcChannelsV1.ChannelsServiceClient, clients grpcClientsV1.ClientsServiceClient, tracer trace.Tracer, logger *slog.Logger, c config) (groups.Service, pgroups.Service, error) {
-	database := pg.NewDatabase(db, dbConfig, tracer)
+func newService(ctx context.Context, authz smqauthz.Authorization, policy policies.Service, db *sqlx.DB, dbConfig pgclient.Config, sqliteConfig sqliteclient.Config, cacheClient redis.UniversalClient, channels grpcChannelsV1.ChannelsServiceClient, clients grpcClientsV1.ClientsServiceClient, tracer trace.Tracer, logger *slog.Logger, c config) (groups.Service, pgroups.Service, error) {
+	var database pg.Database
+	switch c.DBEngine {
+	case dbEngineSQLite:
+		database = sqliteclient.NewDatabase(db, sqliteConfig, tracer)
+	default:
+		database = pg.NewDatabase(db, dbConfig, tracer)
+		if dbConfig.TenantSchemas {
+			tenantDB := pg.NewTenantDatabase(database, dbConfig, tracer)
+			subscriber, err := store.NewSubscriber(ctx, c.ESURL, logger)
+			if err != nil {
+				return nil, nil, err
+			}
+			migrations, err := postgres.Migration()
+			if err != nil {
+				return nil, nil, err
+			}
+			if err := pgevents.Start(ctx, svcName, subscriber, tenantDB, *migrations); err != nil {
+				return nil, nil, err
+			}
+			database = tenantDB
+		}
+		if dbConfig.RowLevelSecurity {
+			database = pg.NewRLSDatabase(database)
+		}
+	}
 	idp := uuid.New()
 	sid, err := sid.New()
 	if err != nil {
 		return nil, nil, err
 	}
 
-	availableActions, builtInRoles, err := availableActionsAndBuiltInRoles(c.SpicedbSchemaFile)
+	availableActions, builtInRoles, err := availableActionsAndBuiltInRoles(c.SpicedbSchemaFile, c.RoleTemplates)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	// Creating groups service
-	repo := postgres.New(database)
+	var repo groups.Repository
+	switch c.DBEngine {
+	case dbEngineSQLite:
+		repo = sqlite.New(database)
+	default:
+		repo = postgres.New(database)
+	}
+	if c.CacheEntityDuration > 0 {
+		repo = cache.NewRepository(repo, cacheClient, c.CacheEntityDuration)
+	}
 	svc, err := gpsvc.NewService(repo, policy, idp, channels, clients, sid, availableActions, builtInRoles)
 	if err != nil {
 		return nil, nil, err
 	}
+
+	groups.NewDeleteHandler(ctx, repo, policy, c.DeleteInterval, c.DeleteAfter, logger)
 	svc, err = events.New(ctx, svc, c.ESURL)
 	if err != nil {
 		return nil, nil, err
@@ -323,7 +431,7 @@ func newPolicyService(cfg config, logger *slog.Logger) (policies.Service, error)
 	return policySvc, nil
 }
 
-func availableActionsAndBuiltInRoles(spicedbSchemaFile string) ([]roles.Action, map[roles.BuiltInRoleName][]roles.Action, error) {
+func availableActionsAndBuiltInRoles(spicedbSchemaFile, roleTemplates string) ([]roles.Action, map[roles.BuiltInRoleName][]roles.Action, error) {
 	availableActions, err := spicedbdecoder.GetActionsFromSchema(spicedbSchemaFile, policies.GroupType)
 	if err != nil {
 		return []roles.Action{}, map[roles.BuiltInRoleName][]roles.Action{}, err
@@ -333,5 +441,10 @@ func availableActionsAndBuiltInRoles(spicedbSchemaFile string) ([]roles.Action,
 		groups.BuiltInRoleAdmin: availableActions,
 	}
 
+	builtInRoles, err = roles.OverrideBuiltInRoles(builtInRoles, roleTemplates)
+	if err != nil {
+		return []roles.Action{}, map[roles.BuiltInRoleName][]roles.Action{}, err
+	}
+
 	return availableActions, builtInRoles, err
 }
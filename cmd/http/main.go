@@ -13,6 +13,7 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"time"
 
 	chclient "github.com/absmach/callhome/pkg/client"
 	"github.com/absmach/mgate"
@@ -26,17 +27,21 @@ import (
 	smqlog "github.com/absmach/supermq/logger"
 	smqauthn "github.com/absmach/supermq/pkg/authn"
 	"github.com/absmach/supermq/pkg/authn/authsvc"
+	"github.com/absmach/supermq/pkg/events/store"
 	"github.com/absmach/supermq/pkg/grpcclient"
 	jaegerclient "github.com/absmach/supermq/pkg/jaeger"
 	"github.com/absmach/supermq/pkg/messaging"
 	"github.com/absmach/supermq/pkg/messaging/brokers"
 	brokerstracing "github.com/absmach/supermq/pkg/messaging/brokers/tracing"
+	msgevents "github.com/absmach/supermq/pkg/messaging/events"
 	"github.com/absmach/supermq/pkg/messaging/handler"
 	"github.com/absmach/supermq/pkg/prometheus"
 	"github.com/absmach/supermq/pkg/server"
 	httpserver "github.com/absmach/supermq/pkg/server/http"
+	"github.com/absmach/supermq/pkg/transformers/pipeline"
 	"github.com/absmach/supermq/pkg/uuid"
 	"github.com/caarlos0/env/v11"
+	"github.com/go-chi/chi/v5"
 	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/sync/errgroup"
 )
@@ -53,12 +58,19 @@ const (
 )
 
 type config struct {
-	LogLevel      string  `env:"SMQ_HTTP_ADAPTER_LOG_LEVEL"   envDefault:"info"`
-	BrokerURL     string  `env:"SMQ_MESSAGE_BROKER_URL"       envDefault:"nats://localhost:4222"`
-	JaegerURL     url.URL `env:"SMQ_JAEGER_URL"               envDefault:"http://localhost:4318/v1/traces"`
-	SendTelemetry bool    `env:"SMQ_SEND_TELEMETRY"           envDefault:"true"`
-	InstanceID    string  `env:"SMQ_HTTP_ADAPTER_INSTANCE_ID" envDefault:""`
-	TraceRatio    float64 `env:"SMQ_JAEGER_TRACE_RATIO"       envDefault:"1.0"`
+	LogLevel       string  `env:"SMQ_HTTP_ADAPTER_LOG_LEVEL"   envDefault:"info"`
+	BrokerURL      string  `env:"SMQ_MESSAGE_BROKER_URL"       envDefault:"nats://localhost:4222"`
+	ESURL          string  `env:"SMQ_ES_URL"                   envDefault:"nats://localhost:4222"`
+	JaegerURL      url.URL `env:"SMQ_JAEGER_URL"               envDefault:"http://localhost:4318/v1/traces"`
+	SendTelemetry  bool    `env:"SMQ_SEND_TELEMETRY"           envDefault:"true"`
+	InstanceID     string  `env:"SMQ_HTTP_ADAPTER_INSTANCE_ID" envDefault:""`
+	TraceRatio     float64 `env:"SMQ_JAEGER_TRACE_RATIO"       envDefault:"1.0"`
+	MaxPayloadSize uint64  `env:"SMQ_HTTP_ADAPTER_MAX_PAYLOAD_SIZE" envDefault:"1048576"`
+	// Admission limits: requests beyond the concurrency limit wait in
+	// the queue; requests beyond the queue are shed with 503.
+	MaxConcurrent uint64        `env:"SMQ_HTTP_ADAPTER_MAX_CONCURRENT" envDefault:"1024"`
+	MaxQueue      uint64        `env:"SMQ_HTTP_ADAPTER_MAX_QUEUE"      envDefault:"2048"`
+	RetryAfter    time.Duration `env:"SMQ_HTTP_ADAPTER_RETRY_AFTER"    envDefault:"5s"`
 }
 
 func main() {
@@ -163,10 +175,40 @@ func main() {
 	defer pub.Close()
 	pub = brokerstracing.NewPublisher(httpServerConfig, tracer, pub)
 
-	svc := newService(pub, authn, clientsClient, channelsClient, logger, tracer)
+	pipelines := pipeline.NewManager()
+	pub = pipeline.NewPublisherMiddleware(pub, pipelines)
+
+	subscriber, err := store.NewSubscriber(ctx, cfg.ESURL, logger)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to create subscriber: %s", err))
+		exitCode = 1
+		return
+	}
+	resolver := msgevents.NewOrderingResolver()
+	if err := msgevents.Start(ctx, svcName+"-ordering-"+cfg.InstanceID, subscriber, resolver); err != nil {
+		logger.Error(fmt.Sprintf("failed to start %s event consumer: %s", svcName, err))
+		exitCode = 1
+		return
+	}
+	pub = messaging.NewOrderingPublisher(pub, resolver.Resolve)
+
+	registry := msgevents.NewSchemaRegistry()
+	if err := msgevents.StartSchemas(ctx, svcName+"-schemas", subscriber, registry); err != nil {
+		logger.Error(fmt.Sprintf("failed to start %s schema consumer: %s", svcName, err))
+		exitCode = 1
+		return
+	}
+	pub = msgevents.NewValidatingPublisher(pub, registry)
+
+	svc := newService(pub, authn, clientsClient, channelsClient, logger, tracer, cfg.MaxPayloadSize)
 	targetServerCfg := server.Config{Port: targetHTTPPort}
 
-	hs := httpserver.NewServer(ctx, cancel, svcName, targetServerCfg, httpapi.MakeHandler(logger, cfg.InstanceID), logger)
+	adm := httpapi.NewAdmission(cfg.MaxConcurrent, cfg.MaxQueue, cfg.RetryAfter)
+
+	mux := chi.NewRouter()
+	mux.Mount("/pipelines", pipelines.Handler(authn, channelsClient))
+	mux.Mount("/", httpapi.MakeHandler(logger, cfg.InstanceID, adm))
+	hs := httpserver.NewServer(ctx, cancel, svcName, targetServerCfg, mux, logger)
 
 	if cfg.SendTelemetry {
 		chc := chclient.New(svcName, supermq.Version, logger, cancel)
@@ -190,8 +232,8 @@ func main() {
 	}
 }
 
-func newService(pub messaging.Publisher, authn smqauthn.Authentication, clients grpcClientsV1.ClientsServiceClient, channels grpcChannelsV1.ChannelsServiceClient, logger *slog.Logger, tracer trace.Tracer) session.Handler {
-	svc := adapter.NewHandler(pub, authn, clients, channels, logger)
+func newService(pub messaging.Publisher, authn smqauthn.Authentication, clients grpcClientsV1.ClientsServiceClient, channels grpcChannelsV1.ChannelsServiceClient, logger *slog.Logger, tracer trace.Tracer, maxPayloadSize uint64) session.Handler {
+	svc := adapter.NewHandler(pub, authn, clients, channels, logger, maxPayloadSize)
 	svc = handler.NewTracing(tracer, svc)
 	svc = handler.LoggingMiddleware(svc, logger)
 	counter, latency := prometheus.MakeMetrics(svcName, "api")
@@ -15,6 +15,7 @@ import (
 	chclient "github.com/absmach/callhome/pkg/client"
 	"github.com/absmach/supermq"
 	grpcTokenV1 "github.com/absmach/supermq/api/grpc/token/v1"
+	smqapi "github.com/absmach/supermq/api/http"
 	"github.com/absmach/supermq/invitations"
 	httpapi "github.com/absmach/supermq/invitations/api"
 	"github.com/absmach/supermq/invitations/middleware"
@@ -51,6 +52,7 @@ const (
 
 type config struct {
 	LogLevel      string  `env:"SMQ_INVITATIONS_LOG_LEVEL"      envDefault:"info"`
+	ReadOnly      bool    `env:"SMQ_INVITATIONS_READ_ONLY" envDefault:"false"`
 	UsersURL      string  `env:"SMQ_USERS_URL"                  envDefault:"http://localhost:9002"`
 	DomainsURL    string  `env:"SMQ_DOMAINS_URL"                envDefault:"http://localhost:9003"`
 	InstanceID    string  `env:"SMQ_INVITATIONS_INSTANCE_ID"    envDefault:""`
@@ -172,7 +174,8 @@ func main() {
 		return
 	}
 
-	httpSvr := http.NewServer(ctx, cancel, svcName, httpServerConfig, httpapi.MakeHandler(svc, logger, authn, cfg.InstanceID), logger)
+	ro := smqapi.NewReadOnly(cfg.ReadOnly)
+	httpSvr := http.NewServer(ctx, cancel, svcName, httpServerConfig, ro.Middleware(smqapi.VersionHandler(httpapi.MakeHandler(svc, logger, authn, cfg.InstanceID))), logger)
 
 	if cfg.SendTelemetry {
 		chc := chclient.New(svcName, supermq.Version, logger, cancel)
@@ -11,9 +11,11 @@ import (
 	"log/slog"
 	"net/url"
 	"os"
+	"time"
 
 	chclient "github.com/absmach/callhome/pkg/client"
 	"github.com/absmach/supermq"
+	smqapi "github.com/absmach/supermq/api/http"
 	"github.com/absmach/supermq/journal"
 	httpapi "github.com/absmach/supermq/journal/api"
 	"github.com/absmach/supermq/journal/events"
@@ -51,11 +53,14 @@ const (
 
 type config struct {
 	LogLevel      string  `env:"SMQ_JOURNAL_LOG_LEVEL"   envDefault:"info"`
+	ReadOnly      bool    `env:"SMQ_JOURNAL_READ_ONLY" envDefault:"false"`
 	ESURL         string  `env:"SMQ_ES_URL"              envDefault:"nats://localhost:4222"`
 	JaegerURL     url.URL `env:"SMQ_JAEGER_URL"          envDefault:"http://localhost:4318/v1/traces"`
 	SendTelemetry bool    `env:"SMQ_SEND_TELEMETRY"      envDefault:"true"`
 	InstanceID    string  `env:"SMQ_JOURNAL_INSTANCE_ID" envDefault:""`
 	TraceRatio    float64 `env:"SMQ_JAEGER_TRACE_RATIO"  envDefault:"1.0"`
+	// Session records older than this are removed. Zero disables the sweep.
+	SessionsRetention time.Duration `env:"SMQ_JOURNAL_SESSIONS_RETENTION" envDefault:"720h"`
 }
 
 func main() {
@@ -149,7 +154,7 @@ func main() {
 	}()
 	tracer := tp.Tracer(svcName)
 
-	svc := newService(db, dbConfig, authz, logger, tracer)
+	svc, repo := newService(db, dbConfig, authz, logger, tracer)
 
 	subscriber, err := store.NewSubscriber(ctx, cfg.ESURL, logger)
 	if err != nil {
@@ -173,13 +178,21 @@ func main() {
 		return
 	}
 
-	hs := http.NewServer(ctx, cancel, svcName, httpServerConfig, httpapi.MakeHandler(svc, authn, logger, svcName, cfg.InstanceID), logger)
+	ro := smqapi.NewReadOnly(cfg.ReadOnly)
+	hs := http.NewServer(ctx, cancel, svcName, httpServerConfig, ro.Middleware(smqapi.VersionHandler(httpapi.MakeHandler(svc, authn, logger, svcName, cfg.InstanceID))), logger)
 
 	if cfg.SendTelemetry {
 		chc := chclient.New(svcName, supermq.Version, logger, cancel)
 		go chc.CallHome(ctx)
 	}
 
+	if cfg.SessionsRetention > 0 {
+		retention := journal.NewSessionRetention(repo, logger, cfg.SessionsRetention)
+		g.Go(func() error {
+			return retention.Run(ctx)
+		})
+	}
+
 	g.Go(func() error {
 		return hs.Start()
 	})
@@ -193,7 +206,7 @@ func main() {
 	}
 }
 
-func newService(db *sqlx.DB, dbConfig pgclient.Config, authz smqauthz.Authorization, logger *slog.Logger, tracer trace.Tracer) journal.Service {
+func newService(db *sqlx.DB, dbConfig pgclient.Config, authz smqauthz.Authorization, logger *slog.Logger, tracer trace.Tracer) (journal.Service, journal.Repository) {
 	database := postgres.NewDatabase(db, dbConfig, tracer)
 	repo := journalpg.NewRepository(database)
 	idp := uuid.New()
@@ -205,5 +218,5 @@ func newService(db *sqlx.DB, dbConfig pgclient.Config, authz smqauthz.Authorizat
 	svc = middleware.MetricsMiddleware(svc, counter, latency)
 	svc = middleware.Tracing(svc, tracer)
 
-	return svc
+	return svc, repo
 }
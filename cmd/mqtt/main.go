@@ -28,10 +28,13 @@ import (
 	"github.com/absmach/supermq/mqtt/events"
 	mqtttracing "github.com/absmach/supermq/mqtt/tracing"
 	"github.com/absmach/supermq/pkg/errors"
+	"github.com/absmach/supermq/pkg/events/store"
 	"github.com/absmach/supermq/pkg/grpcclient"
 	jaegerclient "github.com/absmach/supermq/pkg/jaeger"
+	"github.com/absmach/supermq/pkg/messaging"
 	"github.com/absmach/supermq/pkg/messaging/brokers"
 	brokerstracing "github.com/absmach/supermq/pkg/messaging/brokers/tracing"
+	msgevents "github.com/absmach/supermq/pkg/messaging/events"
 	"github.com/absmach/supermq/pkg/messaging/handler"
 	mqttpub "github.com/absmach/supermq/pkg/messaging/mqtt"
 	"github.com/absmach/supermq/pkg/server"
@@ -67,6 +70,7 @@ type config struct {
 	InstanceID            string        `env:"SMQ_MQTT_ADAPTER_INSTANCE_ID"                  envDefault:""`
 	ESURL                 string        `env:"SMQ_ES_URL"                                    envDefault:"nats://localhost:4222"`
 	TraceRatio            float64       `env:"SMQ_JAEGER_TRACE_RATIO"                        envDefault:"1.0"`
+	MaxPayloadSize        uint64        `env:"SMQ_MQTT_ADAPTER_MAX_PAYLOAD_SIZE"             envDefault:"1048576"`
 }
 
 func main() {
@@ -159,6 +163,28 @@ func main() {
 	defer np.Close()
 	np = brokerstracing.NewPublisher(serverConfig, tracer, np)
 
+	subscriber, err := store.NewSubscriber(ctx, cfg.ESURL, logger)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to create subscriber: %s", err))
+		exitCode = 1
+		return
+	}
+	resolver := msgevents.NewOrderingResolver()
+	if err := msgevents.Start(ctx, svcName+"-ordering-"+cfg.InstanceID, subscriber, resolver); err != nil {
+		logger.Error(fmt.Sprintf("failed to start %s event consumer: %s", svcName, err))
+		exitCode = 1
+		return
+	}
+	np = messaging.NewOrderingPublisher(np, resolver.Resolve)
+
+	registry := msgevents.NewSchemaRegistry()
+	if err := msgevents.StartSchemas(ctx, svcName+"-schemas", subscriber, registry); err != nil {
+		logger.Error(fmt.Sprintf("failed to start %s schema consumer: %s", svcName, err))
+		exitCode = 1
+		return
+	}
+	np = msgevents.NewValidatingPublisher(np, registry)
+
 	es, err := events.NewEventStore(ctx, cfg.ESURL, cfg.Instance)
 	if err != nil {
 		logger.Error(fmt.Sprintf("failed to create %s event store : %s", svcName, err))
@@ -198,8 +224,13 @@ func main() {
 	defer channelsHandler.Close()
 	logger.Info("Channels service gRPC client successfully connected to channels gRPC server " + channelsHandler.Secure())
 
-	h := mqtt.NewHandler(np, es, logger, clientsClient, channelsClient)
-	h = handler.NewTracing(tracer, h)
+	mqttHandler := mqtt.NewHandler(np, es, logger, clientsClient, channelsClient, cfg.MaxPayloadSize)
+	if err := events.Start(ctx, svcName+"-sessions", subscriber, mqttHandler); err != nil {
+		logger.Error(fmt.Sprintf("failed to start %s client event consumer: %s", svcName, err))
+		exitCode = 1
+		return
+	}
+	h := handler.NewTracing(tracer, mqttHandler)
 
 	if cfg.SendTelemetry {
 		chc := chclient.New(svcName, supermq.Version, logger, cancel)
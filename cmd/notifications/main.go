@@ -0,0 +1,160 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package main contains notifications main function to start the notifications service.
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	chclient "github.com/absmach/callhome/pkg/client"
+	"github.com/absmach/supermq"
+	smqapi "github.com/absmach/supermq/api/http"
+	"github.com/absmach/supermq/consumers/notifications"
+	"github.com/absmach/supermq/internal/email"
+	smqlog "github.com/absmach/supermq/logger"
+	authsvcAuthn "github.com/absmach/supermq/pkg/authn/authsvc"
+	"github.com/absmach/supermq/pkg/grpcclient"
+	"github.com/absmach/supermq/pkg/messaging"
+	"github.com/absmach/supermq/pkg/messaging/brokers"
+	"github.com/absmach/supermq/pkg/server"
+	"github.com/absmach/supermq/pkg/server/http"
+	"github.com/absmach/supermq/pkg/uuid"
+	"github.com/caarlos0/env/v11"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	svcName        = "notifications"
+	envPrefixHTTP  = "SMQ_NOTIFICATIONS_HTTP_"
+	envPrefixAuth  = "SMQ_AUTH_GRPC_"
+	defSvcHTTPPort = "9024"
+)
+
+type config struct {
+	LogLevel        string        `env:"SMQ_NOTIFICATIONS_LOG_LEVEL"        envDefault:"info"`
+	ReadOnly        bool          `env:"SMQ_NOTIFICATIONS_READ_ONLY"        envDefault:"false"`
+	BrokerURL       string        `env:"SMQ_MESSAGE_BROKER_URL"             envDefault:"nats://localhost:4222"`
+	OfflineInterval time.Duration `env:"SMQ_NOTIFICATIONS_OFFLINE_INTERVAL" envDefault:"1m"`
+	TwilioSID       string        `env:"SMQ_NOTIFICATIONS_TWILIO_SID"       envDefault:""`
+	TwilioToken     string        `env:"SMQ_NOTIFICATIONS_TWILIO_TOKEN"     envDefault:""`
+	TwilioFrom      string        `env:"SMQ_NOTIFICATIONS_TWILIO_FROM"      envDefault:""`
+	SendTelemetry   bool          `env:"SMQ_SEND_TELEMETRY"                 envDefault:"true"`
+	InstanceID      string        `env:"SMQ_NOTIFICATIONS_INSTANCE_ID"      envDefault:""`
+}
+
+func main() {
+	ctx, cancel := context.WithCancel(context.Background())
+	g, ctx := errgroup.WithContext(ctx)
+
+	cfg := config{}
+	if err := env.Parse(&cfg); err != nil {
+		log.Fatalf("failed to load %s configuration : %s", svcName, err)
+	}
+
+	logger, err := smqlog.New(os.Stdout, cfg.LogLevel)
+	if err != nil {
+		log.Fatalf("failed to init logger: %s", err)
+	}
+
+	var exitCode int
+	defer smqlog.ExitWithError(&exitCode)
+
+	if cfg.InstanceID == "" {
+		if cfg.InstanceID, err = uuid.New().ID(); err != nil {
+			logger.Error(fmt.Sprintf("failed to generate instanceID: %s", err))
+			exitCode = 1
+			return
+		}
+	}
+
+	authClientCfg := grpcclient.Config{}
+	if err := env.ParseWithOptions(&authClientCfg, env.Options{Prefix: envPrefixAuth}); err != nil {
+		logger.Error(fmt.Sprintf("failed to load auth gRPC client configuration : %s", err))
+		exitCode = 1
+		return
+	}
+
+	authn, authnHandler, err := authsvcAuthn.NewAuthentication(ctx, authClientCfg)
+	if err != nil {
+		logger.Error(err.Error())
+		exitCode = 1
+		return
+	}
+	defer authnHandler.Close()
+	logger.Info("AuthN successfully connected to auth gRPC server " + authnHandler.Secure())
+
+	svc := notifications.NewService(uuid.New(), logger)
+
+	emailCfg := email.Config{}
+	if err := env.Parse(&emailCfg); err != nil {
+		logger.Error(fmt.Sprintf("failed to load e-mail configuration : %s", err))
+		exitCode = 1
+		return
+	}
+	if emailCfg.FromAddress != "" {
+		emailDeliverer, err := notifications.NewEmailDeliverer(&emailCfg)
+		if err != nil {
+			logger.Error(fmt.Sprintf("failed to create e-mail deliverer : %s", err))
+			exitCode = 1
+			return
+		}
+		svc.RegisterDeliverer(notifications.ChannelEmail, emailDeliverer)
+	}
+	if cfg.TwilioSID != "" {
+		svc.RegisterDeliverer(notifications.ChannelSMS, notifications.NewSMSDeliverer(cfg.TwilioSID, cfg.TwilioToken, cfg.TwilioFrom))
+	}
+	svc.RegisterDeliverer(notifications.ChannelSlack, notifications.NewSlackDeliverer())
+
+	pubsub, err := brokers.NewPubSub(ctx, cfg.BrokerURL, logger)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to connect to message broker: %s", err))
+		exitCode = 1
+		return
+	}
+	defer pubsub.Close()
+
+	subCfg := messaging.SubscriberConfig{
+		ID:      svcName + "-" + cfg.InstanceID,
+		Topic:   brokers.SubjectAllChannels,
+		Handler: notifications.NewMessageHandler(svc),
+	}
+	if err := pubsub.Subscribe(ctx, subCfg); err != nil {
+		logger.Error(fmt.Sprintf("failed to subscribe to message broker: %s", err))
+		exitCode = 1
+		return
+	}
+
+	svc.Start(ctx, cfg.OfflineInterval)
+
+	httpServerConfig := server.Config{Port: defSvcHTTPPort}
+	if err := env.ParseWithOptions(&httpServerConfig, env.Options{Prefix: envPrefixHTTP}); err != nil {
+		logger.Error(fmt.Sprintf("failed to load %s HTTP server configuration : %s", svcName, err.Error()))
+		exitCode = 1
+		return
+	}
+
+	ro := smqapi.NewReadOnly(cfg.ReadOnly)
+	hs := http.NewServer(ctx, cancel, svcName, httpServerConfig, ro.Middleware(smqapi.VersionHandler(notifications.MakeHandler(svc, authn, cfg.InstanceID))), logger)
+
+	if cfg.SendTelemetry {
+		chc := chclient.New(svcName, supermq.Version, logger, cancel)
+		go chc.CallHome(ctx)
+	}
+
+	g.Go(func() error {
+		return hs.Start()
+	})
+
+	g.Go(func() error {
+		return server.StopSignalHandler(ctx, cancel, logger, svcName, hs)
+	})
+
+	if err := g.Wait(); err != nil {
+		logger.Error(fmt.Sprintf("%s service terminated: %s", svcName, err))
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package main contains simulator main function to run the load generator.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/absmach/supermq/simulator"
+	"github.com/spf13/cobra"
+)
+
+func main() {
+	profile := simulator.Profile{}
+	var address, secret string
+
+	rootCmd := &cobra.Command{
+		Use:   "supermq-simulator",
+		Short: "Publish SenML load against a SuperMQ deployment with virtual clients",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			factory, err := simulator.NewTransportFactory(profile.Transport, address, secret)
+			if err != nil {
+				return err
+			}
+			runner, err := simulator.NewRunner(profile, factory)
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+			defer cancel()
+
+			report := runner.Run(ctx)
+
+			encoder := json.NewEncoder(os.Stdout)
+			encoder.SetIndent("", "  ")
+			return encoder.Encode(report)
+		},
+	}
+
+	rootCmd.Flags().Uint64VarP(&profile.Things, "things", "n", 1, "Number of virtual clients")
+	rootCmd.Flags().StringVarP(&profile.Channel, "channel", "c", "", "Channel to publish to")
+	rootCmd.Flags().StringVar(&profile.Subtopic, "subtopic", "", "Subtopic to publish to")
+	rootCmd.Flags().StringVarP(&profile.Transport, "transport", "t", simulator.TransportHTTP, "Transport to publish over (http, mqtt or coap)")
+	rootCmd.Flags().DurationVarP(&profile.Interval, "interval", "i", 0, "Interval between two messages of one client")
+	rootCmd.Flags().DurationVarP(&profile.Ramp, "ramp", "r", 0, "Period over which client starts are spread")
+	rootCmd.Flags().DurationVarP(&profile.Duration, "duration", "d", 0, "Duration of the run")
+	rootCmd.Flags().StringVar(&profile.Pattern.Name, "name", "temperature", "SenML record name")
+	rootCmd.Flags().StringVar(&profile.Pattern.Unit, "unit", "", "SenML record unit")
+	rootCmd.Flags().Float64Var(&profile.Pattern.Min, "min", 0, "Lower bound of published values")
+	rootCmd.Flags().Float64Var(&profile.Pattern.Max, "max", 100, "Upper bound of published values")
+	rootCmd.Flags().StringVarP(&address, "address", "a", "http://localhost:80", "Adapter address to publish against")
+	rootCmd.Flags().StringVarP(&secret, "secret", "s", "", "Client secret used by the virtual clients")
+
+	if err := rootCmd.Execute(); err != nil {
+		log.Fatal(err)
+	}
+}
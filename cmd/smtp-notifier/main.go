@@ -0,0 +1,132 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package main contains smtp-notifier main function to start the smtp-notifier service.
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	chclient "github.com/absmach/callhome/pkg/client"
+	"github.com/absmach/supermq"
+	smqapi "github.com/absmach/supermq/api/http"
+	"github.com/absmach/supermq/consumers/notifiers"
+	"github.com/absmach/supermq/consumers/notifiers/smtp"
+	"github.com/absmach/supermq/internal/email"
+	smqlog "github.com/absmach/supermq/logger"
+	"github.com/absmach/supermq/pkg/messaging/brokers"
+	"github.com/absmach/supermq/pkg/server"
+	"github.com/absmach/supermq/pkg/server/http"
+	"github.com/absmach/supermq/pkg/uuid"
+	"github.com/caarlos0/env/v11"
+	"github.com/go-chi/chi/v5"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	svcName        = "smtp-notifier"
+	envPrefixHTTP  = "SMQ_SMTP_NOTIFIER_HTTP_"
+	defSvcHTTPPort = "9025"
+)
+
+type config struct {
+	LogLevel      string `env:"SMQ_SMTP_NOTIFIER_LOG_LEVEL"   envDefault:"info"`
+	ConfigPath    string `env:"SMQ_SMTP_NOTIFIER_CONFIG_PATH" envDefault:"/config.toml"`
+	From          string `env:"SMQ_SMTP_NOTIFIER_FROM_ADDR"   envDefault:""`
+	BrokerURL     string `env:"SMQ_MESSAGE_BROKER_URL"        envDefault:"nats://localhost:4222"`
+	SendTelemetry bool   `env:"SMQ_SEND_TELEMETRY"            envDefault:"true"`
+	InstanceID    string `env:"SMQ_SMTP_NOTIFIER_INSTANCE_ID" envDefault:""`
+}
+
+func main() {
+	ctx, cancel := context.WithCancel(context.Background())
+	g, ctx := errgroup.WithContext(ctx)
+
+	cfg := config{}
+	if err := env.Parse(&cfg); err != nil {
+		log.Fatalf("failed to load %s configuration : %s", svcName, err)
+	}
+
+	logger, err := smqlog.New(os.Stdout, cfg.LogLevel)
+	if err != nil {
+		log.Fatalf("failed to init logger: %s", err)
+	}
+
+	var exitCode int
+	defer smqlog.ExitWithError(&exitCode)
+
+	if cfg.InstanceID == "" {
+		if cfg.InstanceID, err = uuid.New().ID(); err != nil {
+			logger.Error(fmt.Sprintf("failed to generate instanceID: %s", err))
+			exitCode = 1
+			return
+		}
+	}
+
+	emailCfg := email.Config{}
+	if err := env.Parse(&emailCfg); err != nil {
+		logger.Error(fmt.Sprintf("failed to load e-mail configuration : %s", err))
+		exitCode = 1
+		return
+	}
+	agent, err := email.New(&emailCfg)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to create e-mail agent : %s", err))
+		exitCode = 1
+		return
+	}
+
+	subs, err := notifiers.LoadSubscriptions(cfg.ConfigPath)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to load subscriptions : %s", err))
+		exitCode = 1
+		return
+	}
+
+	svc := notifiers.New(smtp.New(agent), cfg.From, subs)
+
+	pubsub, err := brokers.NewPubSub(ctx, cfg.BrokerURL, logger)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to connect to message broker: %s", err))
+		exitCode = 1
+		return
+	}
+	defer pubsub.Close()
+
+	if err := notifiers.Subscribe(ctx, pubsub, svc, subs, svcName+"-"+cfg.InstanceID); err != nil {
+		logger.Error(fmt.Sprintf("failed to subscribe to message broker: %s", err))
+		exitCode = 1
+		return
+	}
+
+	httpServerConfig := server.Config{Port: defSvcHTTPPort}
+	if err := env.ParseWithOptions(&httpServerConfig, env.Options{Prefix: envPrefixHTTP}); err != nil {
+		logger.Error(fmt.Sprintf("failed to load %s HTTP server configuration : %s", svcName, err.Error()))
+		exitCode = 1
+		return
+	}
+
+	mux := chi.NewRouter()
+	mux.Get("/health", supermq.Health(svcName, cfg.InstanceID))
+	hs := http.NewServer(ctx, cancel, svcName, httpServerConfig, smqapi.VersionHandler(mux), logger)
+
+	if cfg.SendTelemetry {
+		chc := chclient.New(svcName, supermq.Version, logger, cancel)
+		go chc.CallHome(ctx)
+	}
+
+	g.Go(func() error {
+		return hs.Start()
+	})
+
+	g.Go(func() error {
+		return server.StopSignalHandler(ctx, cancel, logger, svcName, hs)
+	})
+
+	if err := g.Wait(); err != nil {
+		logger.Error(fmt.Sprintf("%s service terminated: %s", svcName, err))
+	}
+}
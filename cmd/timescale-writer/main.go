@@ -0,0 +1,134 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package main contains timescale-writer main function to start the timescale writer service.
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	chclient "github.com/absmach/callhome/pkg/client"
+	"github.com/absmach/supermq"
+	smqapi "github.com/absmach/supermq/api/http"
+	"github.com/absmach/supermq/consumers"
+	"github.com/absmach/supermq/consumers/writers/timescale"
+	smqlog "github.com/absmach/supermq/logger"
+	"github.com/absmach/supermq/pkg/messaging/brokers"
+	pgclient "github.com/absmach/supermq/pkg/postgres"
+	"github.com/absmach/supermq/pkg/server"
+	"github.com/absmach/supermq/pkg/server/http"
+	"github.com/absmach/supermq/pkg/uuid"
+	tsreader "github.com/absmach/supermq/readers/timescale"
+	"github.com/caarlos0/env/v11"
+	"github.com/go-chi/chi/v5"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	svcName        = "timescale-writer"
+	envPrefixHTTP  = "SMQ_TIMESCALE_WRITER_HTTP_"
+	envPrefixDB    = "SMQ_TIMESCALE_"
+	defDB          = "messages"
+	defSvcHTTPPort = "9032"
+)
+
+type config struct {
+	LogLevel          string        `env:"SMQ_TIMESCALE_WRITER_LOG_LEVEL"          envDefault:"info"`
+	ConfigPath        string        `env:"SMQ_TIMESCALE_WRITER_CONFIG_PATH"        envDefault:"/config.toml"`
+	BrokerURL         string        `env:"SMQ_MESSAGE_BROKER_URL"                  envDefault:"nats://localhost:4222"`
+	RetentionInterval time.Duration `env:"SMQ_TIMESCALE_WRITER_RETENTION_INTERVAL" envDefault:"1h"`
+	SendTelemetry     bool          `env:"SMQ_SEND_TELEMETRY"                      envDefault:"true"`
+	InstanceID        string        `env:"SMQ_TIMESCALE_WRITER_INSTANCE_ID"        envDefault:""`
+}
+
+func main() {
+	ctx, cancel := context.WithCancel(context.Background())
+	g, ctx := errgroup.WithContext(ctx)
+
+	cfg := config{}
+	if err := env.Parse(&cfg); err != nil {
+		log.Fatalf("failed to load %s configuration : %s", svcName, err)
+	}
+
+	logger, err := smqlog.New(os.Stdout, cfg.LogLevel)
+	if err != nil {
+		log.Fatalf("failed to init logger: %s", err)
+	}
+
+	var exitCode int
+	defer smqlog.ExitWithError(&exitCode)
+
+	if cfg.InstanceID == "" {
+		if cfg.InstanceID, err = uuid.New().ID(); err != nil {
+			logger.Error(fmt.Sprintf("failed to generate instanceID: %s", err))
+			exitCode = 1
+			return
+		}
+	}
+
+	dbConfig := pgclient.Config{Name: defDB}
+	if err := env.ParseWithOptions(&dbConfig, env.Options{Prefix: envPrefixDB}); err != nil {
+		logger.Error(fmt.Sprintf("failed to load %s database configuration : %s", svcName, err))
+		exitCode = 1
+		return
+	}
+	db, err := pgclient.Setup(dbConfig, *timescale.Migration())
+	if err != nil {
+		logger.Error(err.Error())
+		exitCode = 1
+		return
+	}
+	defer db.Close()
+
+	repo := timescale.New(db)
+
+	pubsub, err := brokers.NewPubSub(ctx, cfg.BrokerURL, logger)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to connect to message broker: %s", err))
+		exitCode = 1
+		return
+	}
+	defer pubsub.Close()
+
+	if err := consumers.Start(ctx, svcName+"-"+cfg.InstanceID, pubsub, repo, cfg.ConfigPath, logger); err != nil {
+		logger.Error(fmt.Sprintf("failed to create Timescale writer: %s", err))
+		exitCode = 1
+		return
+	}
+
+	httpServerConfig := server.Config{Port: defSvcHTTPPort}
+	if err := env.ParseWithOptions(&httpServerConfig, env.Options{Prefix: envPrefixHTTP}); err != nil {
+		logger.Error(fmt.Sprintf("failed to load %s HTTP server configuration : %s", svcName, err.Error()))
+		exitCode = 1
+		return
+	}
+
+	mux := chi.NewRouter()
+	mux.Get("/health", supermq.Health(svcName, cfg.InstanceID))
+	hs := http.NewServer(ctx, cancel, svcName, httpServerConfig, smqapi.VersionHandler(mux), logger)
+
+	if cfg.SendTelemetry {
+		chc := chclient.New(svcName, supermq.Version, logger, cancel)
+		go chc.CallHome(ctx)
+	}
+
+	g.Go(func() error {
+		return hs.Start()
+	})
+
+	g.Go(func() error {
+		return timescale.EnforceRetention(ctx, db, tsreader.NewRetentionRepository(db), logger, cfg.RetentionInterval)
+	})
+
+	g.Go(func() error {
+		return server.StopSignalHandler(ctx, cancel, logger, svcName, hs)
+	})
+
+	if err := g.Wait(); err != nil {
+		logger.Error(fmt.Sprintf("%s service terminated: %s", svcName, err))
+	}
+}
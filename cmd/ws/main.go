@@ -20,17 +20,20 @@ import (
 	grpcClientsV1 "github.com/absmach/supermq/api/grpc/clients/v1"
 	smqlog "github.com/absmach/supermq/logger"
 	"github.com/absmach/supermq/pkg/authn/authsvc"
+	"github.com/absmach/supermq/pkg/events/store"
 	"github.com/absmach/supermq/pkg/grpcclient"
 	jaegerclient "github.com/absmach/supermq/pkg/jaeger"
 	"github.com/absmach/supermq/pkg/messaging"
 	"github.com/absmach/supermq/pkg/messaging/brokers"
 	brokerstracing "github.com/absmach/supermq/pkg/messaging/brokers/tracing"
+	msgevents "github.com/absmach/supermq/pkg/messaging/events"
 	"github.com/absmach/supermq/pkg/prometheus"
 	"github.com/absmach/supermq/pkg/server"
 	httpserver "github.com/absmach/supermq/pkg/server/http"
 	"github.com/absmach/supermq/pkg/uuid"
 	"github.com/absmach/supermq/ws"
 	httpapi "github.com/absmach/supermq/ws/api"
+	wsevents "github.com/absmach/supermq/ws/events"
 	"github.com/absmach/supermq/ws/tracing"
 	"github.com/caarlos0/env/v11"
 	"go.opentelemetry.io/otel/trace"
@@ -49,12 +52,14 @@ const (
 )
 
 type config struct {
-	LogLevel      string  `env:"SMQ_WS_ADAPTER_LOG_LEVEL"    envDefault:"info"`
-	BrokerURL     string  `env:"SMQ_MESSAGE_BROKER_URL"      envDefault:"nats://localhost:4222"`
-	JaegerURL     url.URL `env:"SMQ_JAEGER_URL"              envDefault:"http://localhost:4318/v1/traces"`
-	SendTelemetry bool    `env:"SMQ_SEND_TELEMETRY"          envDefault:"true"`
-	InstanceID    string  `env:"SMQ_WS_ADAPTER_INSTANCE_ID"  envDefault:""`
-	TraceRatio    float64 `env:"SMQ_JAEGER_TRACE_RATIO"      envDefault:"1.0"`
+	LogLevel       string  `env:"SMQ_WS_ADAPTER_LOG_LEVEL"    envDefault:"info"`
+	BrokerURL      string  `env:"SMQ_MESSAGE_BROKER_URL"      envDefault:"nats://localhost:4222"`
+	ESURL          string  `env:"SMQ_ES_URL"                  envDefault:"nats://localhost:4222"`
+	JaegerURL      url.URL `env:"SMQ_JAEGER_URL"              envDefault:"http://localhost:4318/v1/traces"`
+	SendTelemetry  bool    `env:"SMQ_SEND_TELEMETRY"          envDefault:"true"`
+	InstanceID     string  `env:"SMQ_WS_ADAPTER_INSTANCE_ID"  envDefault:""`
+	TraceRatio     float64 `env:"SMQ_JAEGER_TRACE_RATIO"      envDefault:"1.0"`
+	MaxPayloadSize uint64  `env:"SMQ_WS_ADAPTER_MAX_PAYLOAD_SIZE" envDefault:"1048576"`
 }
 
 func main() {
@@ -164,9 +169,38 @@ func main() {
 	}
 	defer nps.Close()
 	nps = brokerstracing.NewPubSub(targetServerConfig, tracer, nps)
+	resolver := msgevents.NewOrderingResolver()
+	nps = messaging.NewOrderingPubSub(nps, resolver.Resolve)
+	registry := msgevents.NewSchemaRegistry()
+	nps = msgevents.NewValidatingPubSub(nps, registry)
 
 	svc := newService(clientsClient, channelsClient, nps, logger, tracer)
 
+	subscriber, err := store.NewSubscriber(ctx, cfg.ESURL, logger)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to create subscriber: %s", err))
+		exitCode = 1
+		return
+	}
+
+	if err := wsevents.Start(ctx, svcName, subscriber, svc); err != nil {
+		logger.Error(fmt.Sprintf("failed to start %s event consumer: %s", svcName, err))
+		exitCode = 1
+		return
+	}
+
+	if err := msgevents.Start(ctx, svcName+"-ordering-"+cfg.InstanceID, subscriber, resolver); err != nil {
+		logger.Error(fmt.Sprintf("failed to start %s ordering consumer: %s", svcName, err))
+		exitCode = 1
+		return
+	}
+
+	if err := msgevents.StartSchemas(ctx, svcName+"-schemas", subscriber, registry); err != nil {
+		logger.Error(fmt.Sprintf("failed to start %s schema consumer: %s", svcName, err))
+		exitCode = 1
+		return
+	}
+
 	hs := httpserver.NewServer(ctx, cancel, svcName, targetServerConfig, httpapi.MakeHandler(ctx, svc, logger, cfg.InstanceID), logger)
 
 	if cfg.SendTelemetry {
@@ -178,7 +212,7 @@ func main() {
 		g.Go(func() error {
 			return hs.Start()
 		})
-		handler := ws.NewHandler(nps, logger, authn, clientsClient, channelsClient)
+		handler := ws.NewHandler(nps, logger, authn, clientsClient, channelsClient, cfg.MaxPayloadSize)
 		return proxyWS(ctx, httpServerConfig, targetServerConfig, logger, handler)
 	})
 
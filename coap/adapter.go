@@ -17,6 +17,7 @@ import (
 	svcerr "github.com/absmach/supermq/pkg/errors/service"
 	"github.com/absmach/supermq/pkg/messaging"
 	"github.com/absmach/supermq/pkg/policies"
+	"github.com/absmach/supermq/pkg/topics"
 )
 
 var errFailedToDisconnectClient = errors.New("failed to disconnect client")
@@ -47,6 +48,7 @@ type adapterService struct {
 	clients  grpcClientsV1.ClientsServiceClient
 	channels grpcChannelsV1.ChannelsServiceClient
 	pubsub   messaging.PubSub
+	topics   *topics.Cache
 }
 
 // New instantiates the CoAP adapter implementation.
@@ -55,6 +57,7 @@ func New(clients grpcClientsV1.ClientsServiceClient, channels grpcChannelsV1.Cha
 		clients:  clients,
 		channels: channels,
 		pubsub:   pubsub,
+		topics:   topics.NewCache(),
 	}
 
 	return as
@@ -83,12 +86,29 @@ func (svc *adapterService) Publish(ctx context.Context, key string, msg *messagi
 	if !authzRes.Authorized {
 		return svcerr.ErrAuthorization
 	}
+	if err := svc.matchSubtopic(key, msg.GetChannel(), msg.GetSubtopic(), connections.Publish, authzRes.GetSubtopicPatterns()); err != nil {
+		return err
+	}
 
 	msg.Publisher = authnRes.GetId()
 
 	return svc.pubsub.Publish(ctx, msg.GetChannel(), msg)
 }
 
+func (svc *adapterService) matchSubtopic(key, chanID, subtopic string, connType connections.ConnType, patterns []string) error {
+	if len(patterns) == 0 {
+		return nil
+	}
+	matcher, err := svc.topics.Matcher(fmt.Sprintf("%s:%s:%d", key, chanID, connType), patterns)
+	if err != nil {
+		return errors.Wrap(svcerr.ErrAuthorization, err)
+	}
+	if !matcher.Match(subtopic) {
+		return svcerr.ErrAuthorization
+	}
+	return nil
+}
+
 func (svc *adapterService) Subscribe(ctx context.Context, key, chanID, subtopic string, c Client) error {
 	authnRes, err := svc.clients.Authenticate(ctx, &grpcClientsV1.AuthnReq{
 		ClientSecret: key,
@@ -113,6 +133,9 @@ func (svc *adapterService) Subscribe(ctx context.Context, key, chanID, subtopic
 	if !authzRes.Authorized {
 		return svcerr.ErrAuthorization
 	}
+	if err := svc.matchSubtopic(key, chanID, subtopic, connections.Subscribe, authzRes.GetSubtopicPatterns()); err != nil {
+		return err
+	}
 
 	subject := fmt.Sprintf("%s.%s", chansPrefix, chanID)
 	if subtopic != "" {
@@ -39,10 +39,10 @@ func (lm *loggingMiddleware) Publish(ctx context.Context, key string, msg *messa
 		}
 		if err != nil {
 			args = append(args, slog.Any("error", err))
-			lm.logger.Warn("Publish message failed", args...)
+			lm.logger.WarnContext(ctx, "Publish message failed", args...)
 			return
 		}
-		lm.logger.Info("Publish message completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Publish message completed successfully", args...)
 	}(time.Now())
 
 	return lm.svc.Publish(ctx, key, msg)
@@ -61,10 +61,10 @@ func (lm *loggingMiddleware) Subscribe(ctx context.Context, key, chanID, subtopi
 		}
 		if err != nil {
 			args = append(args, slog.Any("error", err))
-			lm.logger.Warn("Subscribe failed", args...)
+			lm.logger.WarnContext(ctx, "Subscribe failed", args...)
 			return
 		}
-		lm.logger.Info("Subscribe completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Subscribe completed successfully", args...)
 	}(time.Now())
 
 	return lm.svc.Subscribe(ctx, key, chanID, subtopic, c)
@@ -83,10 +83,10 @@ func (lm *loggingMiddleware) Unsubscribe(ctx context.Context, key, chanID, subto
 		}
 		if err != nil {
 			args = append(args, slog.Any("error", err))
-			lm.logger.Warn("Unsubscribe failed", args...)
+			lm.logger.WarnContext(ctx, "Unsubscribe failed", args...)
 			return
 		}
-		lm.logger.Info("Unsubscribe completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Unsubscribe completed successfully", args...)
 	}(time.Now())
 
 	return lm.svc.Unsubscribe(ctx, key, chanID, subtopic, token)
@@ -106,10 +106,10 @@ func (lm *loggingMiddleware) DisconnectHandler(ctx context.Context, chanID, subt
 		}
 		if err != nil {
 			args = append(args, slog.Any("error", err))
-			lm.logger.Warn("Unsubscribe failed", args...)
+			lm.logger.WarnContext(ctx, "Unsubscribe failed", args...)
 			return
 		}
-		lm.logger.Info("Unsubscribe completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Unsubscribe completed successfully", args...)
 	}(time.Now())
 
 	return lm.svc.DisconnectHandler(ctx, chanID, subtopic, token)
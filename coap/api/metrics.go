@@ -11,6 +11,7 @@ import (
 
 	"github.com/absmach/supermq/coap"
 	"github.com/absmach/supermq/pkg/messaging"
+	"github.com/absmach/supermq/pkg/prometheus"
 	"github.com/go-kit/kit/metrics"
 )
 
@@ -32,9 +33,9 @@ func MetricsMiddleware(svc coap.Service, counter metrics.Counter, latency metric
 }
 
 // Publish instruments Publish method with metrics.
-func (mm *metricsMiddleware) Publish(ctx context.Context, key string, msg *messaging.Message) error {
+func (mm *metricsMiddleware) Publish(ctx context.Context, key string, msg *messaging.Message) (err error) {
 	defer func(begin time.Time) {
-		mm.counter.With("method", "publish").Add(1)
+		mm.counter.With("method", "publish", "error", prometheus.ErrorClass(err)).Add(1)
 		mm.latency.With("method", "publish").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 
@@ -42,9 +43,9 @@ func (mm *metricsMiddleware) Publish(ctx context.Context, key string, msg *messa
 }
 
 // Subscribe instruments Subscribe method with metrics.
-func (mm *metricsMiddleware) Subscribe(ctx context.Context, key, chanID, subtopic string, c coap.Client) error {
+func (mm *metricsMiddleware) Subscribe(ctx context.Context, key, chanID, subtopic string, c coap.Client) (err error) {
 	defer func(begin time.Time) {
-		mm.counter.With("method", "subscribe").Add(1)
+		mm.counter.With("method", "subscribe", "error", prometheus.ErrorClass(err)).Add(1)
 		mm.latency.With("method", "subscribe").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 
@@ -52,9 +53,9 @@ func (mm *metricsMiddleware) Subscribe(ctx context.Context, key, chanID, subtopi
 }
 
 // Unsubscribe instruments Unsubscribe method with metrics.
-func (mm *metricsMiddleware) Unsubscribe(ctx context.Context, key, chanID, subtopic, token string) error {
+func (mm *metricsMiddleware) Unsubscribe(ctx context.Context, key, chanID, subtopic, token string) (err error) {
 	defer func(begin time.Time) {
-		mm.counter.With("method", "unsubscribe").Add(1)
+		mm.counter.With("method", "unsubscribe", "error", prometheus.ErrorClass(err)).Add(1)
 		mm.latency.With("method", "unsubscribe").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 
@@ -62,9 +63,9 @@ func (mm *metricsMiddleware) Unsubscribe(ctx context.Context, key, chanID, subto
 }
 
 // DisconnectHandler instruments DisconnectHandler method with metrics.
-func (mm *metricsMiddleware) DisconnectHandler(ctx context.Context, chanID, subtopic, token string) error {
+func (mm *metricsMiddleware) DisconnectHandler(ctx context.Context, chanID, subtopic, token string) (err error) {
 	defer func(begin time.Time) {
-		mm.counter.With("method", "disconnect_handler").Add(1)
+		mm.counter.With("method", "disconnect_handler", "error", prometheus.ErrorClass(err)).Add(1)
 		mm.latency.With("method", "disconnect_handler").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 
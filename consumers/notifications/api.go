@@ -0,0 +1,144 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package notifications
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/absmach/supermq"
+	api "github.com/absmach/supermq/api/http"
+	apiutil "github.com/absmach/supermq/api/http/util"
+	smqauthn "github.com/absmach/supermq/pkg/authn"
+	"github.com/absmach/supermq/pkg/errors"
+	svcerr "github.com/absmach/supermq/pkg/errors/service"
+	"github.com/absmach/supermq/pkg/messaging"
+	"github.com/go-chi/chi/v5"
+)
+
+const defDeliveriesLimit = 10
+
+// MakeHandler returns a HTTP API handler with health check and metrics.
+func MakeHandler(svc Service, authn smqauthn.Authentication, instanceID string) http.Handler {
+	mux := chi.NewRouter()
+
+	mux.Group(func(r chi.Router) {
+		r.Use(api.AuthenticateMiddleware(authn, false))
+		r.Post("/rules", createRuleHandler(svc))
+		r.Get("/rules", listRulesHandler(svc))
+		r.Delete("/rules/{ruleID}", removeRuleHandler(svc))
+		r.Get("/deliveries", deliveriesHandler(svc))
+	})
+
+	mux.Get("/health", supermq.Health("notifications", instanceID))
+
+	return mux
+}
+
+func createRuleHandler(svc Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		session, ok := r.Context().Value(api.SessionKey).(smqauthn.Session)
+		if !ok {
+			api.EncodeError(r.Context(), svcerr.ErrAuthorization, w)
+			return
+		}
+		var rule Rule
+		if err := json.NewDecoder(r.Body).Decode(&rule); err != nil {
+			api.EncodeError(r.Context(), errors.Wrap(apiutil.ErrValidation, err), w)
+			return
+		}
+		rule.UserID = session.UserID
+
+		rule, err := svc.CreateRule(r.Context(), rule)
+		if err != nil {
+			api.EncodeError(r.Context(), err, w)
+			return
+		}
+		encodeJSON(w, http.StatusCreated, rule)
+	}
+}
+
+func listRulesHandler(svc Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		session, ok := r.Context().Value(api.SessionKey).(smqauthn.Session)
+		if !ok {
+			api.EncodeError(r.Context(), svcerr.ErrAuthorization, w)
+			return
+		}
+		rules, err := svc.ListRules(r.Context(), session.UserID)
+		if err != nil {
+			api.EncodeError(r.Context(), err, w)
+			return
+		}
+		encodeJSON(w, http.StatusOK, map[string]interface{}{"rules": rules})
+	}
+}
+
+func removeRuleHandler(svc Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		session, ok := r.Context().Value(api.SessionKey).(smqauthn.Session)
+		if !ok {
+			api.EncodeError(r.Context(), svcerr.ErrAuthorization, w)
+			return
+		}
+		if err := svc.RemoveRule(r.Context(), session.UserID, chi.URLParam(r, "ruleID")); err != nil {
+			api.EncodeError(r.Context(), err, w)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func deliveriesHandler(svc Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		session, ok := r.Context().Value(api.SessionKey).(smqauthn.Session)
+		if !ok {
+			api.EncodeError(r.Context(), svcerr.ErrAuthorization, w)
+			return
+		}
+		ruleID, err := apiutil.ReadStringQuery(r, "rule_id", "")
+		if err != nil {
+			api.EncodeError(r.Context(), errors.Wrap(apiutil.ErrValidation, err), w)
+			return
+		}
+		limit, err := apiutil.ReadNumQuery[uint64](r, "limit", defDeliveriesLimit)
+		if err != nil {
+			api.EncodeError(r.Context(), errors.Wrap(apiutil.ErrValidation, err), w)
+			return
+		}
+		deliveries, err := svc.Deliveries(r.Context(), session.UserID, ruleID, limit)
+		if err != nil {
+			api.EncodeError(r.Context(), err, w)
+			return
+		}
+		encodeJSON(w, http.StatusOK, map[string]interface{}{"deliveries": deliveries})
+	}
+}
+
+func encodeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", api.ContentType)
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
+type messageHandler struct {
+	svc Service
+}
+
+// NewMessageHandler adapts the service to the messaging handler API, so
+// that it can be subscribed directly to the message broker.
+func NewMessageHandler(svc Service) messaging.MessageHandler {
+	return messageHandler{svc: svc}
+}
+
+func (h messageHandler) Handle(msg *messaging.Message) error {
+	return h.svc.ConsumeBlocking(context.Background(), msg)
+}
+
+func (h messageHandler) Cancel() error {
+	return nil
+}
@@ -0,0 +1,128 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package notifications
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/absmach/supermq/internal/email"
+	"github.com/absmach/supermq/pkg/errors"
+)
+
+const deliverTimeout = 30 * time.Second
+
+var (
+	errSendEmail = errors.New("failed to send e-mail notification")
+	errSendSMS   = errors.New("failed to send SMS notification")
+	errSendSlack = errors.New("failed to send Slack notification")
+)
+
+type emailDeliverer struct {
+	agent *email.Agent
+}
+
+// NewEmailDeliverer returns a deliverer sending notifications over
+// SMTP through the internal e-mail agent.
+func NewEmailDeliverer(c *email.Config) (Deliverer, error) {
+	agent, err := email.New(c)
+	if err != nil {
+		return nil, err
+	}
+	return &emailDeliverer{agent: agent}, nil
+}
+
+func (d *emailDeliverer) Deliver(_ context.Context, target, subject, message string) error {
+	if err := d.agent.Send([]string{target}, "", subject, "", "", message, ""); err != nil {
+		return errors.Wrap(errSendEmail, err)
+	}
+	return nil
+}
+
+type smsDeliverer struct {
+	client *http.Client
+	url    string
+	sid    string
+	token  string
+	from   string
+}
+
+// NewSMSDeliverer returns a deliverer sending notifications as SMS
+// through the Twilio REST API.
+func NewSMSDeliverer(accountSID, authToken, from string) Deliverer {
+	return &smsDeliverer{
+		client: &http.Client{Timeout: deliverTimeout},
+		url:    fmt.Sprintf("https://api.twilio.com/2010-04-01/Accounts/%s/Messages.json", accountSID),
+		sid:    accountSID,
+		token:  authToken,
+		from:   from,
+	}
+}
+
+func (d *smsDeliverer) Deliver(ctx context.Context, target, _, message string) error {
+	form := url.Values{}
+	form.Set("To", target)
+	form.Set("From", d.from)
+	form.Set("Body", message)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.url, strings.NewReader(form.Encode()))
+	if err != nil {
+		return errors.Wrap(errSendSMS, err)
+	}
+	req.SetBasicAuth(d.sid, d.token)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	res, err := d.client.Do(req)
+	if err != nil {
+		return errors.Wrap(errSendSMS, err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusMultipleChoices {
+		return errors.Wrap(errSendSMS, fmt.Errorf("unexpected response code %d", res.StatusCode))
+	}
+
+	return nil
+}
+
+type slackDeliverer struct {
+	client *http.Client
+}
+
+// NewSlackDeliverer returns a deliverer posting notifications to Slack
+// incoming webhooks. The rule target is the webhook URL.
+func NewSlackDeliverer() Deliverer {
+	return &slackDeliverer{
+		client: &http.Client{Timeout: deliverTimeout},
+	}
+}
+
+func (d *slackDeliverer) Deliver(ctx context.Context, target, _, message string) error {
+	body, err := json.Marshal(map[string]string{"text": message})
+	if err != nil {
+		return errors.Wrap(errSendSlack, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, target, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(errSendSlack, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := d.client.Do(req)
+	if err != nil {
+		return errors.Wrap(errSendSlack, err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusMultipleChoices {
+		return errors.Wrap(errSendSlack, fmt.Errorf("unexpected response code %d", res.StatusCode))
+	}
+
+	return nil
+}
@@ -0,0 +1,8 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package notifications provides a notification subsystem: users
+// subscribe rules to delivery channels (e-mail, SMS, Slack) and get
+// notified when a client goes offline or a reported value crosses a
+// threshold.
+package notifications
@@ -0,0 +1,99 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package notifications
+
+import (
+	"context"
+	"time"
+
+	"github.com/absmach/supermq/consumers"
+)
+
+// Rule types.
+const (
+	// RuleThreshold triggers when a reported value crosses the rule
+	// threshold.
+	RuleThreshold = "threshold"
+
+	// RuleOffline triggers when a publisher stops sending messages for
+	// the rule window.
+	RuleOffline = "offline"
+)
+
+// Delivery channels.
+const (
+	ChannelEmail = "email"
+	ChannelSMS   = "sms"
+	ChannelSlack = "slack"
+)
+
+// Delivery statuses.
+const (
+	StatusSent      = "sent"
+	StatusFailed    = "failed"
+	StatusThrottled = "throttled"
+)
+
+// Rule is a notification subscription: it matches incoming messages and
+// delivers rendered notifications to the configured target.
+type Rule struct {
+	ID        string        `json:"id"`
+	UserID    string        `json:"user_id"`
+	Type      string        `json:"type"`
+	Channel   string        `json:"channel,omitempty"`
+	Subtopic  string        `json:"subtopic,omitempty"`
+	Publisher string        `json:"publisher,omitempty"`
+	Field     string        `json:"field,omitempty"`
+	Threshold float64       `json:"threshold,omitempty"`
+	Window    time.Duration `json:"window,omitempty"`
+	Delivery  string        `json:"delivery"`
+	Target    string        `json:"target"`
+	Template  string        `json:"template,omitempty"`
+	Cooldown  time.Duration `json:"cooldown,omitempty"`
+}
+
+// Delivery is a record of one notification delivery attempt.
+type Delivery struct {
+	RuleID    string    `json:"rule_id"`
+	Channel   string    `json:"channel"`
+	Target    string    `json:"target"`
+	Message   string    `json:"message"`
+	Status    string    `json:"status"`
+	Error     string    `json:"error,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Deliverer sends a rendered notification to a target over one delivery
+// channel.
+type Deliverer interface {
+	// Deliver sends the notification message to the target.
+	Deliver(ctx context.Context, target, subject, message string) error
+}
+
+// Service specifies the notifications service API.
+type Service interface {
+	consumers.BlockingConsumer
+
+	// RegisterDeliverer registers the deliverer used for the given
+	// delivery channel. It must be called before rules for the channel
+	// are created.
+	RegisterDeliverer(channel string, d Deliverer)
+
+	// Start periodically checks offline rules until the context is
+	// canceled.
+	Start(ctx context.Context, interval time.Duration)
+
+	// CreateRule subscribes a new notification rule.
+	CreateRule(ctx context.Context, rule Rule) (Rule, error)
+
+	// ListRules retrieves the rules subscribed by the given user.
+	ListRules(ctx context.Context, userID string) ([]Rule, error)
+
+	// RemoveRule removes a rule subscribed by the given user.
+	RemoveRule(ctx context.Context, userID, id string) error
+
+	// Deliveries retrieves the delivery history, newest first,
+	// optionally filtered by rule.
+	Deliveries(ctx context.Context, userID, ruleID string, limit uint64) ([]Delivery, error)
+}
@@ -0,0 +1,359 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package notifications
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	smq "github.com/absmach/supermq"
+	"github.com/absmach/supermq/pkg/errors"
+	svcerr "github.com/absmach/supermq/pkg/errors/service"
+	"github.com/absmach/supermq/pkg/messaging"
+)
+
+const (
+	defWindow   = 5 * time.Minute
+	defCooldown = time.Minute
+	defTemplate = "{{.RuleType}} alert from {{.Publisher}}: {{.Field}} {{.Value}}"
+
+	maxHistory = 1000
+)
+
+var (
+	errUnknownDelivery = errors.New("unknown delivery channel")
+	errUnknownRuleType = errors.New("unknown rule type")
+	errMissingField    = errors.New("threshold rules require a field")
+	errMissingTarget   = errors.New("missing delivery target")
+	errRenderTemplate  = errors.New("failed to render notification template")
+)
+
+// templateData is the data rendered into notification templates.
+type templateData struct {
+	RuleType  string
+	Channel   string
+	Subtopic  string
+	Publisher string
+	Field     string
+	Value     float64
+	At        time.Time
+}
+
+type service struct {
+	idProvider smq.IDProvider
+	logger     *slog.Logger
+
+	mu         sync.RWMutex
+	deliverers map[string]Deliverer
+	rules      map[string]Rule
+	history    []Delivery
+	lastSent   map[string]time.Time
+	lastSeen   map[string]time.Time
+	notified   map[string]time.Time
+}
+
+var _ Service = (*service)(nil)
+
+// NewService returns a new notifications service.
+func NewService(idProvider smq.IDProvider, logger *slog.Logger) Service {
+	return &service{
+		idProvider: idProvider,
+		logger:     logger,
+		deliverers: make(map[string]Deliverer),
+		rules:      make(map[string]Rule),
+		lastSent:   make(map[string]time.Time),
+		lastSeen:   make(map[string]time.Time),
+		notified:   make(map[string]time.Time),
+	}
+}
+
+// RegisterDeliverer registers the deliverer used for the given delivery
+// channel. It must be called before rules for the channel are created.
+func (svc *service) RegisterDeliverer(channel string, d Deliverer) {
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+	svc.deliverers[channel] = d
+}
+
+func (svc *service) CreateRule(ctx context.Context, rule Rule) (Rule, error) {
+	if err := svc.validate(&rule); err != nil {
+		return Rule{}, errors.Wrap(svcerr.ErrMalformedEntity, err)
+	}
+	id, err := svc.idProvider.ID()
+	if err != nil {
+		return Rule{}, err
+	}
+	rule.ID = id
+
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+	svc.rules[rule.ID] = rule
+
+	return rule, nil
+}
+
+func (svc *service) ListRules(ctx context.Context, userID string) ([]Rule, error) {
+	svc.mu.RLock()
+	defer svc.mu.RUnlock()
+	rules := []Rule{}
+	for _, rule := range svc.rules {
+		if rule.UserID == userID {
+			rules = append(rules, rule)
+		}
+	}
+	return rules, nil
+}
+
+func (svc *service) RemoveRule(ctx context.Context, userID, id string) error {
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+	rule, ok := svc.rules[id]
+	if !ok || rule.UserID != userID {
+		return svcerr.ErrNotFound
+	}
+	delete(svc.rules, id)
+	return nil
+}
+
+func (svc *service) Deliveries(ctx context.Context, userID, ruleID string, limit uint64) ([]Delivery, error) {
+	svc.mu.RLock()
+	defer svc.mu.RUnlock()
+	deliveries := []Delivery{}
+	for i := len(svc.history) - 1; i >= 0 && uint64(len(deliveries)) < limit; i-- {
+		d := svc.history[i]
+		rule, ok := svc.rules[d.RuleID]
+		if !ok || rule.UserID != userID {
+			continue
+		}
+		if ruleID != "" && d.RuleID != ruleID {
+			continue
+		}
+		deliveries = append(deliveries, d)
+	}
+	return deliveries, nil
+}
+
+// ConsumeBlocking evaluates threshold rules against incoming messages
+// and tracks publishers for offline rules. Message payloads are
+// expected to be flat JSON objects with numeric values.
+func (svc *service) ConsumeBlocking(ctx context.Context, messages interface{}) error {
+	switch msgs := messages.(type) {
+	case *messaging.Message:
+		svc.consume(ctx, msgs)
+	case []*messaging.Message:
+		for _, msg := range msgs {
+			svc.consume(ctx, msg)
+		}
+	}
+	return nil
+}
+
+func (svc *service) consume(ctx context.Context, msg *messaging.Message) {
+	svc.mu.Lock()
+	svc.lastSeen[seenKey(msg.GetChannel(), msg.GetPublisher())] = time.Now()
+	rules := svc.matching(msg)
+	svc.mu.Unlock()
+
+	if len(rules) == 0 {
+		return
+	}
+
+	values := map[string]float64{}
+	if err := json.Unmarshal(msg.GetPayload(), &values); err != nil {
+		return
+	}
+
+	for _, rule := range rules {
+		value, ok := values[rule.Field]
+		if !ok || value <= rule.Threshold {
+			continue
+		}
+		svc.notify(ctx, rule, templateData{
+			RuleType:  rule.Type,
+			Channel:   msg.GetChannel(),
+			Subtopic:  msg.GetSubtopic(),
+			Publisher: msg.GetPublisher(),
+			Field:     rule.Field,
+			Value:     value,
+			At:        time.Now(),
+		})
+	}
+}
+
+// Start periodically checks offline rules until the context is
+// canceled. A publisher is reported once per offline episode.
+func (svc *service) Start(ctx context.Context, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				svc.checkOffline(ctx)
+			}
+		}
+	}()
+}
+
+func (svc *service) checkOffline(ctx context.Context) {
+	svc.mu.Lock()
+	triggered := []Rule{}
+	for _, rule := range svc.rules {
+		if rule.Type != RuleOffline {
+			continue
+		}
+		window := rule.Window
+		if window == 0 {
+			window = defWindow
+		}
+		seen, ok := svc.lastSeen[seenKey(rule.Channel, rule.Publisher)]
+		if !ok || time.Since(seen) < window {
+			continue
+		}
+		if !svc.notified[rule.ID].Before(seen) {
+			continue
+		}
+		svc.notified[rule.ID] = time.Now()
+		triggered = append(triggered, rule)
+	}
+	svc.mu.Unlock()
+
+	for _, rule := range triggered {
+		svc.notify(ctx, rule, templateData{
+			RuleType:  rule.Type,
+			Channel:   rule.Channel,
+			Subtopic:  rule.Subtopic,
+			Publisher: rule.Publisher,
+			At:        time.Now(),
+		})
+	}
+}
+
+// matching returns threshold rules matching the message. The caller
+// must hold the lock.
+func (svc *service) matching(msg *messaging.Message) []Rule {
+	rules := []Rule{}
+	for _, rule := range svc.rules {
+		if rule.Type != RuleThreshold {
+			continue
+		}
+		if rule.Channel != "" && rule.Channel != msg.GetChannel() {
+			continue
+		}
+		if rule.Subtopic != "" && rule.Subtopic != msg.GetSubtopic() {
+			continue
+		}
+		if rule.Publisher != "" && rule.Publisher != msg.GetPublisher() {
+			continue
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+// notify renders the rule template and delivers the notification,
+// recording the attempt in the delivery history. Deliveries within the
+// rule cooldown are throttled.
+func (svc *service) notify(ctx context.Context, rule Rule, data templateData) {
+	record := Delivery{
+		RuleID:    rule.ID,
+		Channel:   rule.Delivery,
+		Target:    rule.Target,
+		CreatedAt: time.Now(),
+	}
+
+	message, err := svc.render(rule, data)
+	if err != nil {
+		record.Status = StatusFailed
+		record.Error = err.Error()
+		svc.record(record)
+		return
+	}
+	record.Message = message
+
+	cooldown := rule.Cooldown
+	if cooldown == 0 {
+		cooldown = defCooldown
+	}
+	svc.mu.Lock()
+	if time.Since(svc.lastSent[rule.ID]) < cooldown {
+		svc.mu.Unlock()
+		record.Status = StatusThrottled
+		svc.record(record)
+		return
+	}
+	svc.lastSent[rule.ID] = time.Now()
+	deliverer := svc.deliverers[rule.Delivery]
+	svc.mu.Unlock()
+
+	subject := fmt.Sprintf("%s notification", strings.ToTitle(rule.Type[:1])+rule.Type[1:])
+	if err := deliverer.Deliver(ctx, rule.Target, subject, message); err != nil {
+		svc.logger.Warn(fmt.Sprintf("failed to deliver %s notification : %s", rule.Delivery, err))
+		record.Status = StatusFailed
+		record.Error = err.Error()
+		svc.record(record)
+		return
+	}
+	record.Status = StatusSent
+	svc.record(record)
+}
+
+func (svc *service) render(rule Rule, data templateData) (string, error) {
+	text := rule.Template
+	if text == "" {
+		text = defTemplate
+	}
+	tmpl, err := template.New("notification").Parse(text)
+	if err != nil {
+		return "", errors.Wrap(errRenderTemplate, err)
+	}
+	var sb strings.Builder
+	if err := tmpl.Execute(&sb, data); err != nil {
+		return "", errors.Wrap(errRenderTemplate, err)
+	}
+	return sb.String(), nil
+}
+
+func (svc *service) record(d Delivery) {
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+	svc.history = append(svc.history, d)
+	if len(svc.history) > maxHistory {
+		svc.history = svc.history[len(svc.history)-maxHistory:]
+	}
+}
+
+func (svc *service) validate(rule *Rule) error {
+	svc.mu.RLock()
+	_, ok := svc.deliverers[rule.Delivery]
+	svc.mu.RUnlock()
+	if !ok {
+		return errUnknownDelivery
+	}
+	if rule.Target == "" {
+		return errMissingTarget
+	}
+	switch rule.Type {
+	case RuleThreshold:
+		if rule.Field == "" {
+			return errMissingField
+		}
+	case RuleOffline:
+	default:
+		return errUnknownRuleType
+	}
+	return nil
+}
+
+func seenKey(channel, publisher string) string {
+	return channel + "/" + publisher
+}
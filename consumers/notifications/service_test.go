@@ -0,0 +1,286 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package notifications
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	smqlog "github.com/absmach/supermq/logger"
+	"github.com/absmach/supermq/pkg/errors"
+	svcerr "github.com/absmach/supermq/pkg/errors/service"
+	"github.com/absmach/supermq/pkg/messaging"
+	"github.com/absmach/supermq/pkg/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	userID    = "user"
+	channelID = "channel"
+	publisher = "publisher"
+)
+
+type fakeDeliverer struct {
+	mu       sync.Mutex
+	err      error
+	messages []string
+}
+
+func (d *fakeDeliverer) Deliver(_ context.Context, _, _, message string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.err != nil {
+		return d.err
+	}
+	d.messages = append(d.messages, message)
+	return nil
+}
+
+func (d *fakeDeliverer) delivered() []string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return append([]string{}, d.messages...)
+}
+
+func newService() (Service, *fakeDeliverer) {
+	svc := NewService(uuid.NewMock(), smqlog.NewMock())
+	d := &fakeDeliverer{}
+	svc.RegisterDeliverer(ChannelSlack, d)
+	return svc, d
+}
+
+func message(payload string) *messaging.Message {
+	return &messaging.Message{
+		Channel:   channelID,
+		Publisher: publisher,
+		Payload:   []byte(payload),
+	}
+}
+
+func TestCreateRule(t *testing.T) {
+	svc, _ := newService()
+
+	cases := []struct {
+		desc string
+		rule Rule
+		err  error
+	}{
+		{
+			desc: "create threshold rule",
+			rule: Rule{UserID: userID, Type: RuleThreshold, Field: "temperature", Threshold: 30, Delivery: ChannelSlack, Target: "https://hooks.example.com"},
+			err:  nil,
+		},
+		{
+			desc: "create offline rule",
+			rule: Rule{UserID: userID, Type: RuleOffline, Publisher: publisher, Delivery: ChannelSlack, Target: "https://hooks.example.com"},
+			err:  nil,
+		},
+		{
+			desc: "create rule with unknown type",
+			rule: Rule{UserID: userID, Type: "invalid", Delivery: ChannelSlack, Target: "https://hooks.example.com"},
+			err:  svcerr.ErrMalformedEntity,
+		},
+		{
+			desc: "create rule with unknown delivery channel",
+			rule: Rule{UserID: userID, Type: RuleOffline, Delivery: ChannelSMS, Target: "+100"},
+			err:  svcerr.ErrMalformedEntity,
+		},
+		{
+			desc: "create rule without target",
+			rule: Rule{UserID: userID, Type: RuleOffline, Delivery: ChannelSlack},
+			err:  svcerr.ErrMalformedEntity,
+		},
+		{
+			desc: "create threshold rule without field",
+			rule: Rule{UserID: userID, Type: RuleThreshold, Delivery: ChannelSlack, Target: "https://hooks.example.com"},
+			err:  svcerr.ErrMalformedEntity,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			rule, err := svc.CreateRule(context.Background(), tc.rule)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("expected error %v got %v", tc.err, err))
+			if tc.err == nil {
+				assert.NotEmpty(t, rule.ID)
+			}
+		})
+	}
+}
+
+func TestListRemoveRules(t *testing.T) {
+	svc, _ := newService()
+
+	rule, err := svc.CreateRule(context.Background(), Rule{UserID: userID, Type: RuleOffline, Delivery: ChannelSlack, Target: "https://hooks.example.com"})
+	require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+
+	rules, err := svc.ListRules(context.Background(), userID)
+	require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+	assert.Len(t, rules, 1)
+
+	rules, err = svc.ListRules(context.Background(), "other")
+	require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+	assert.Empty(t, rules)
+
+	err = svc.RemoveRule(context.Background(), "other", rule.ID)
+	assert.True(t, errors.Contains(err, svcerr.ErrNotFound), fmt.Sprintf("expected error %v got %v", svcerr.ErrNotFound, err))
+
+	err = svc.RemoveRule(context.Background(), userID, rule.ID)
+	assert.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+
+	rules, err = svc.ListRules(context.Background(), userID)
+	require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+	assert.Empty(t, rules)
+}
+
+func TestConsumeThreshold(t *testing.T) {
+	svc, d := newService()
+
+	_, err := svc.CreateRule(context.Background(), Rule{
+		UserID:    userID,
+		Type:      RuleThreshold,
+		Channel:   channelID,
+		Field:     "temperature",
+		Threshold: 30,
+		Delivery:  ChannelSlack,
+		Target:    "https://hooks.example.com",
+	})
+	require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+
+	err = svc.ConsumeBlocking(context.Background(), message(`{"temperature": 25}`))
+	require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+	assert.Empty(t, d.delivered())
+
+	err = svc.ConsumeBlocking(context.Background(), message(`{"temperature": 42}`))
+	require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+	require.Len(t, d.delivered(), 1)
+	assert.Equal(t, "threshold alert from publisher: temperature 42", d.delivered()[0])
+}
+
+func TestConsumeCooldown(t *testing.T) {
+	svc, d := newService()
+
+	rule, err := svc.CreateRule(context.Background(), Rule{
+		UserID:    userID,
+		Type:      RuleThreshold,
+		Field:     "temperature",
+		Threshold: 30,
+		Delivery:  ChannelSlack,
+		Target:    "https://hooks.example.com",
+		Cooldown:  time.Hour,
+	})
+	require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+
+	for i := 0; i < 3; i++ {
+		err = svc.ConsumeBlocking(context.Background(), message(`{"temperature": 42}`))
+		require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+	}
+	assert.Len(t, d.delivered(), 1)
+
+	deliveries, err := svc.Deliveries(context.Background(), userID, rule.ID, 10)
+	require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+	require.Len(t, deliveries, 3)
+	assert.Equal(t, StatusThrottled, deliveries[0].Status)
+	assert.Equal(t, StatusThrottled, deliveries[1].Status)
+	assert.Equal(t, StatusSent, deliveries[2].Status)
+}
+
+func TestDeliveries(t *testing.T) {
+	svc, d := newService()
+
+	rule, err := svc.CreateRule(context.Background(), Rule{
+		UserID:    userID,
+		Type:      RuleThreshold,
+		Field:     "temperature",
+		Threshold: 30,
+		Delivery:  ChannelSlack,
+		Target:    "https://hooks.example.com",
+	})
+	require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+
+	err = svc.ConsumeBlocking(context.Background(), message(`{"temperature": 42}`))
+	require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+	require.Len(t, d.delivered(), 1)
+
+	cases := []struct {
+		desc   string
+		userID string
+		ruleID string
+		limit  uint64
+		total  int
+	}{
+		{
+			desc:   "list all deliveries",
+			userID: userID,
+			limit:  10,
+			total:  1,
+		},
+		{
+			desc:   "list deliveries filtered by rule",
+			userID: userID,
+			ruleID: rule.ID,
+			limit:  10,
+			total:  1,
+		},
+		{
+			desc:   "list deliveries of unknown rule",
+			userID: userID,
+			ruleID: "unknown",
+			limit:  10,
+			total:  0,
+		},
+		{
+			desc:   "list deliveries of another user",
+			userID: "other",
+			limit:  10,
+			total:  0,
+		},
+		{
+			desc:   "list deliveries with zero limit",
+			userID: userID,
+			limit:  0,
+			total:  0,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			deliveries, err := svc.Deliveries(context.Background(), tc.userID, tc.ruleID, tc.limit)
+			require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+			assert.Len(t, deliveries, tc.total)
+		})
+	}
+}
+
+func TestOffline(t *testing.T) {
+	svc, d := newService()
+
+	_, err := svc.CreateRule(context.Background(), Rule{
+		UserID:    userID,
+		Type:      RuleOffline,
+		Channel:   channelID,
+		Publisher: publisher,
+		Window:    time.Millisecond,
+		Delivery:  ChannelSlack,
+		Target:    "https://hooks.example.com",
+	})
+	require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+
+	err = svc.ConsumeBlocking(context.Background(), message(`{}`))
+	require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	svc.Start(ctx, time.Millisecond)
+
+	assert.Eventually(t, func() bool {
+		return len(d.delivered()) == 1
+	}, time.Second, 5*time.Millisecond, "expected offline notification")
+
+	// The publisher is reported once per offline episode.
+	time.Sleep(50 * time.Millisecond)
+	assert.Len(t, d.delivered(), 1)
+}
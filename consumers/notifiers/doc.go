@@ -0,0 +1,8 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package notifiers contains notifier consumers. They subscribe to
+// alarm channels on the message broker and fan received messages out
+// to the contacts configured per subscription, with per-subscription
+// throttling and deduplication.
+package notifiers
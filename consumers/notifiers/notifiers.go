@@ -0,0 +1,87 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package notifiers
+
+import (
+	"time"
+
+	"github.com/absmach/supermq/pkg/errors"
+	"github.com/pelletier/go-toml"
+)
+
+var (
+	errOpenConfFile  = errors.New("unable to open subscriptions configuration file")
+	errParseConfFile = errors.New("unable to parse subscriptions configuration file")
+	errParseDuration = errors.New("unable to parse subscription duration")
+)
+
+// Subscription designates an alarm channel and the contacts messages
+// published to it are fanned out to.
+type Subscription struct {
+	// Channel is the alarm channel the subscription listens to.
+	Channel string
+
+	// Subtopic optionally narrows the subscription to one subtopic.
+	Subtopic string
+
+	// Contacts are the notification receivers: e-mail addresses or
+	// phone numbers, depending on the notifier.
+	Contacts []string
+
+	// Throttle is the minimal interval between two notifications for
+	// this subscription. Zero disables throttling.
+	Throttle time.Duration
+
+	// DedupWindow is the period within which repeated messages with
+	// the same payload are dropped. Zero disables deduplication.
+	DedupWindow time.Duration
+}
+
+type fileSubscription struct {
+	Channel     string   `toml:"channel"`
+	Subtopic    string   `toml:"subtopic"`
+	Contacts    []string `toml:"contacts"`
+	Throttle    string   `toml:"throttle"`
+	DedupWindow string   `toml:"dedup_window"`
+}
+
+type fileConfig struct {
+	Subscriptions []fileSubscription `toml:"subscriptions"`
+}
+
+// LoadSubscriptions loads notifier subscriptions from the TOML
+// configuration file at the given path. Durations are expressed in the
+// Go duration format, e.g. "30s" or "5m".
+func LoadSubscriptions(configPath string) ([]Subscription, error) {
+	cfg := fileConfig{}
+	data, err := toml.LoadFile(configPath)
+	if err != nil {
+		return nil, errors.Wrap(errOpenConfFile, err)
+	}
+	if err := data.Unmarshal(&cfg); err != nil {
+		return nil, errors.Wrap(errParseConfFile, err)
+	}
+
+	subs := []Subscription{}
+	for _, fs := range cfg.Subscriptions {
+		sub := Subscription{
+			Channel:  fs.Channel,
+			Subtopic: fs.Subtopic,
+			Contacts: fs.Contacts,
+		}
+		if fs.Throttle != "" {
+			if sub.Throttle, err = time.ParseDuration(fs.Throttle); err != nil {
+				return nil, errors.Wrap(errParseDuration, err)
+			}
+		}
+		if fs.DedupWindow != "" {
+			if sub.DedupWindow, err = time.ParseDuration(fs.DedupWindow); err != nil {
+				return nil, errors.Wrap(errParseDuration, err)
+			}
+		}
+		subs = append(subs, sub)
+	}
+
+	return subs, nil
+}
@@ -0,0 +1,156 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package notifiers
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/absmach/supermq/consumers"
+	"github.com/absmach/supermq/pkg/errors"
+	"github.com/absmach/supermq/pkg/messaging"
+)
+
+type service struct {
+	notifier consumers.Notifier
+	from     string
+	subs     []Subscription
+
+	mu       sync.Mutex
+	lastSent []time.Time
+	seen     []map[string]time.Time
+}
+
+var _ consumers.BlockingConsumer = (*service)(nil)
+
+// New returns a notifier consumer fanning received messages out to the
+// given subscriptions.
+func New(notifier consumers.Notifier, from string, subs []Subscription) consumers.BlockingConsumer {
+	seen := make([]map[string]time.Time, len(subs))
+	for i := range seen {
+		seen[i] = make(map[string]time.Time)
+	}
+	return &service{
+		notifier: notifier,
+		from:     from,
+		subs:     subs,
+		lastSent: make([]time.Time, len(subs)),
+		seen:     seen,
+	}
+}
+
+// ConsumeBlocking notifies the contacts of every subscription matching
+// the received messages.
+func (svc *service) ConsumeBlocking(ctx context.Context, messages interface{}) error {
+	switch msgs := messages.(type) {
+	case *messaging.Message:
+		return svc.consume(msgs)
+	case []*messaging.Message:
+		for _, msg := range msgs {
+			if err := svc.consume(msg); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (svc *service) consume(msg *messaging.Message) error {
+	for i, sub := range svc.subs {
+		if sub.Channel != "" && sub.Channel != msg.GetChannel() {
+			continue
+		}
+		if sub.Subtopic != "" && sub.Subtopic != msg.GetSubtopic() {
+			continue
+		}
+		if !svc.admit(i, sub, msg) {
+			continue
+		}
+		if err := svc.notifier.Notify(svc.from, sub.Contacts, msg); err != nil {
+			return errors.Wrap(consumers.ErrNotify, err)
+		}
+	}
+	return nil
+}
+
+// admit applies the subscription throttle and deduplication window to
+// the message and records it as sent when admitted.
+func (svc *service) admit(i int, sub Subscription, msg *messaging.Message) bool {
+	now := time.Now()
+
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+
+	if sub.Throttle > 0 && now.Sub(svc.lastSent[i]) < sub.Throttle {
+		return false
+	}
+	if sub.DedupWindow > 0 {
+		key := dedupKey(msg)
+		if sent, ok := svc.seen[i][key]; ok && now.Sub(sent) < sub.DedupWindow {
+			return false
+		}
+		for k, sent := range svc.seen[i] {
+			if now.Sub(sent) >= sub.DedupWindow {
+				delete(svc.seen[i], k)
+			}
+		}
+		svc.seen[i][key] = now
+	}
+	svc.lastSent[i] = now
+
+	return true
+}
+
+func dedupKey(msg *messaging.Message) string {
+	sum := sha256.Sum256(msg.GetPayload())
+	return msg.GetChannel() + "/" + msg.GetSubtopic() + "/" + hex.EncodeToString(sum[:])
+}
+
+// Subscribe subscribes the notifier consumer to the alarm channel of
+// every subscription on the message broker.
+func Subscribe(ctx context.Context, pubsub messaging.PubSub, consumer consumers.BlockingConsumer, subs []Subscription, id string) error {
+	handler := NewMessageHandler(consumer)
+	seen := map[string]bool{}
+	for _, sub := range subs {
+		topic := fmt.Sprintf("channels.%s", sub.Channel)
+		if sub.Subtopic != "" {
+			topic = fmt.Sprintf("%s.%s", topic, sub.Subtopic)
+		}
+		if seen[topic] {
+			continue
+		}
+		seen[topic] = true
+		subCfg := messaging.SubscriberConfig{
+			ID:      fmt.Sprintf("%s-%d", id, len(seen)),
+			Topic:   topic,
+			Handler: handler,
+		}
+		if err := pubsub.Subscribe(ctx, subCfg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type messageHandler struct {
+	consumer consumers.BlockingConsumer
+}
+
+// NewMessageHandler adapts the consumer to the messaging handler API,
+// so that it can be subscribed directly to the message broker.
+func NewMessageHandler(consumer consumers.BlockingConsumer) messaging.MessageHandler {
+	return messageHandler{consumer: consumer}
+}
+
+func (h messageHandler) Handle(msg *messaging.Message) error {
+	return h.consumer.ConsumeBlocking(context.Background(), msg)
+}
+
+func (h messageHandler) Cancel() error {
+	return nil
+}
@@ -0,0 +1,92 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package notifiers_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/absmach/supermq/consumers"
+	"github.com/absmach/supermq/consumers/mocks"
+	"github.com/absmach/supermq/consumers/notifiers"
+	"github.com/absmach/supermq/pkg/errors"
+	"github.com/absmach/supermq/pkg/messaging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	from      = "alarms@example.com"
+	channelID = "alarm-channel"
+)
+
+var contacts = []string{"admin@example.com"}
+
+func message(channel, payload string) *messaging.Message {
+	return &messaging.Message{
+		Channel:   channel,
+		Publisher: "publisher",
+		Payload:   []byte(payload),
+	}
+}
+
+func TestConsumeFanout(t *testing.T) {
+	notifier := new(mocks.Notifier)
+	svc := notifiers.New(notifier, from, []notifiers.Subscription{
+		{Channel: channelID, Contacts: contacts},
+	})
+
+	msg := message(channelID, `{"alarm": 1}`)
+	call := notifier.On("Notify", from, contacts, msg).Return(nil)
+
+	err := svc.ConsumeBlocking(context.Background(), msg)
+	assert.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+	notifier.AssertNumberOfCalls(t, "Notify", 1)
+
+	err = svc.ConsumeBlocking(context.Background(), message("other", `{"alarm": 1}`))
+	assert.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+	notifier.AssertNumberOfCalls(t, "Notify", 1)
+	call.Unset()
+
+	notifyErr := errors.New("smtp failure")
+	notifier.On("Notify", from, contacts, msg).Return(notifyErr)
+	err = svc.ConsumeBlocking(context.Background(), msg)
+	assert.True(t, errors.Contains(err, consumers.ErrNotify), fmt.Sprintf("expected error %v got %v", consumers.ErrNotify, err))
+}
+
+func TestConsumeThrottle(t *testing.T) {
+	notifier := new(mocks.Notifier)
+	svc := notifiers.New(notifier, from, []notifiers.Subscription{
+		{Channel: channelID, Contacts: contacts, Throttle: time.Hour},
+	})
+	notifier.On("Notify", from, contacts, mock.Anything).Return(nil)
+
+	for i := 0; i < 3; i++ {
+		err := svc.ConsumeBlocking(context.Background(), message(channelID, fmt.Sprintf(`{"alarm": %d}`, i)))
+		require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+	}
+
+	notifier.AssertNumberOfCalls(t, "Notify", 1)
+}
+
+func TestConsumeDedup(t *testing.T) {
+	notifier := new(mocks.Notifier)
+	svc := notifiers.New(notifier, from, []notifiers.Subscription{
+		{Channel: channelID, Contacts: contacts, DedupWindow: time.Hour},
+	})
+	notifier.On("Notify", from, contacts, mock.Anything).Return(nil)
+
+	for i := 0; i < 3; i++ {
+		err := svc.ConsumeBlocking(context.Background(), message(channelID, `{"alarm": 1}`))
+		require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+	}
+	notifier.AssertNumberOfCalls(t, "Notify", 1)
+
+	err := svc.ConsumeBlocking(context.Background(), message(channelID, `{"alarm": 2}`))
+	require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+	notifier.AssertNumberOfCalls(t, "Notify", 2)
+}
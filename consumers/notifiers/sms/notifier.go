@@ -0,0 +1,77 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package sms contains the SMS notifier. It sends notifications as SMS
+// messages through a Twilio-compatible HTTP SMS gateway.
+package sms
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/absmach/supermq/consumers"
+	"github.com/absmach/supermq/pkg/errors"
+	"github.com/absmach/supermq/pkg/messaging"
+)
+
+const sendTimeout = 30 * time.Second
+
+var _ consumers.Notifier = (*notifier)(nil)
+
+type notifier struct {
+	client *http.Client
+	url    string
+	sid    string
+	token  string
+}
+
+// New instantiates SMS message notifier sending messages through the
+// gateway of the given account.
+func New(accountSID, authToken string) consumers.Notifier {
+	return &notifier{
+		client: &http.Client{Timeout: sendTimeout},
+		url:    fmt.Sprintf("https://api.twilio.com/2010-04-01/Accounts/%s/Messages.json", accountSID),
+		sid:    accountSID,
+		token:  authToken,
+	}
+}
+
+func (n *notifier) Notify(from string, to []string, msg *messaging.Message) error {
+	body := fmt.Sprintf("Channel %s: %s", msg.GetChannel(), string(msg.GetPayload()))
+
+	for _, receiver := range to {
+		if err := n.send(from, receiver, body); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (n *notifier) send(from, to, body string) error {
+	form := url.Values{}
+	form.Set("To", to)
+	form.Set("From", from)
+	form.Set("Body", body)
+
+	req, err := http.NewRequest(http.MethodPost, n.url, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(n.sid, n.token)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	res, err := n.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusMultipleChoices {
+		return errors.New(fmt.Sprintf("unexpected response code %d", res.StatusCode))
+	}
+
+	return nil
+}
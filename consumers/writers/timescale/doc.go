@@ -0,0 +1,8 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package timescale contains the Timescale message writer. Besides the
+// raw messages hypertable it maintains continuous aggregates with 1m,
+// 5m and 1h rollups, which the Timescale reader selects transparently
+// for downsampled queries.
+package timescale
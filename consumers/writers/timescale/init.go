@@ -0,0 +1,122 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package timescale
+
+import (
+	"strconv"
+
+	migrate "github.com/rubenv/sql-migrate"
+)
+
+// rollupView creates one continuous aggregate over the messages
+// hypertable. The bucket width is expressed in nanoseconds, matching
+// the time column.
+func rollupView(name string, width int64) string {
+	return `CREATE MATERIALIZED VIEW IF NOT EXISTS ` + name + `
+            WITH (timescaledb.continuous) AS
+            SELECT
+                time_bucket(` + itoa(width) + `, time) AS bucket,
+                channel, subtopic, publisher, protocol, name, unit,
+                AVG(value)   AS value_avg,
+                MIN(value)   AS value_min,
+                MAX(value)   AS value_max,
+                SUM(value)   AS value_sum,
+                COUNT(*)     AS msg_count
+            FROM messages
+            GROUP BY bucket, channel, subtopic, publisher, protocol, name, unit
+            WITH NO DATA;`
+}
+
+func itoa(v int64) string {
+	return strconv.FormatInt(v, 10)
+}
+
+// Migration returns the writer schema migration. The continuous
+// aggregates cannot be created inside a transaction, so their migration
+// runs with transactions disabled.
+func Migration() *migrate.MemoryMigrationSource {
+	return &migrate.MemoryMigrationSource{
+		Migrations: []*migrate.Migration{
+			{
+				Id: "messages_1",
+				Up: []string{
+					`CREATE TABLE IF NOT EXISTS messages (
+                        time          BIGINT NOT NULL,
+                        channel       VARCHAR(254),
+                        subtopic      VARCHAR(254),
+                        publisher     VARCHAR(254),
+                        protocol      TEXT,
+                        name          VARCHAR(254),
+                        unit          TEXT,
+                        value         FLOAT,
+                        string_value  TEXT,
+                        bool_value    BOOL,
+                        data_value    BYTEA,
+                        sum           FLOAT,
+                        update_time   FLOAT
+                    );`,
+					`SELECT create_hypertable('messages', 'time',
+                        chunk_time_interval => 86400000000000,
+                        if_not_exists => TRUE);`,
+					`CREATE OR REPLACE FUNCTION unix_now_ns() RETURNS BIGINT
+                        LANGUAGE SQL STABLE AS $$
+                        SELECT (EXTRACT(EPOCH FROM NOW()) * 1000000000)::BIGINT
+                        $$;`,
+					`SELECT set_integer_now_func('messages', 'unix_now_ns', replace_if_exists => TRUE);`,
+				},
+				Down: []string{
+					`DROP TABLE IF EXISTS messages`,
+				},
+			},
+			{
+				Id:                     "messages_2",
+				DisableTransactionUp:   true,
+				DisableTransactionDown: true,
+				Up: []string{
+					rollupView("messages_1m", minuteNs),
+					rollupView("messages_5m", 5*minuteNs),
+					rollupView("messages_1h", hourNs),
+					`SELECT add_continuous_aggregate_policy('messages_1m',
+                        start_offset => ` + itoa(2*hourNs) + `,
+                        end_offset => ` + itoa(minuteNs) + `,
+                        schedule_interval => INTERVAL '1 minute',
+                        if_not_exists => TRUE);`,
+					`SELECT add_continuous_aggregate_policy('messages_5m',
+                        start_offset => ` + itoa(12*hourNs) + `,
+                        end_offset => ` + itoa(5*minuteNs) + `,
+                        schedule_interval => INTERVAL '5 minutes',
+                        if_not_exists => TRUE);`,
+					`SELECT add_continuous_aggregate_policy('messages_1h',
+                        start_offset => ` + itoa(7*24*hourNs) + `,
+                        end_offset => ` + itoa(hourNs) + `,
+                        schedule_interval => INTERVAL '1 hour',
+                        if_not_exists => TRUE);`,
+				},
+				Down: []string{
+					`DROP MATERIALIZED VIEW IF EXISTS messages_1h`,
+					`DROP MATERIALIZED VIEW IF EXISTS messages_5m`,
+					`DROP MATERIALIZED VIEW IF EXISTS messages_1m`,
+				},
+			},
+			{
+				Id: "messages_3",
+				Up: []string{
+					`CREATE TABLE IF NOT EXISTS retention_policies (
+                        channel          VARCHAR(254) PRIMARY KEY,
+                        raw_retention    TEXT NOT NULL DEFAULT '',
+                        rollup_retention TEXT NOT NULL DEFAULT ''
+                    );`,
+				},
+				Down: []string{
+					`DROP TABLE IF EXISTS retention_policies`,
+				},
+			},
+		},
+	}
+}
+
+const (
+	minuteNs int64 = 60 * 1e9
+	hourNs   int64 = 3600 * 1e9
+)
@@ -0,0 +1,75 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package timescale
+
+import (
+	"context"
+
+	"github.com/absmach/supermq/consumers"
+	"github.com/absmach/supermq/pkg/errors"
+	"github.com/absmach/supermq/pkg/transformers/senml"
+	"github.com/jmoiron/sqlx"
+)
+
+var (
+	errSaveMessage        = errors.New("failed to save message to timescale database")
+	errUnsupportedMessage = errors.New("unsupported message format")
+)
+
+var _ consumers.BlockingConsumer = (*timescaleRepo)(nil)
+
+type timescaleRepo struct {
+	db *sqlx.DB
+}
+
+// New returns new Timescale writer.
+func New(db *sqlx.DB) consumers.BlockingConsumer {
+	return &timescaleRepo{db: db}
+}
+
+func (repo *timescaleRepo) ConsumeBlocking(ctx context.Context, message interface{}) error {
+	switch m := message.(type) {
+	case []senml.Message:
+		return repo.saveSenml(ctx, m)
+	default:
+		return errUnsupportedMessage
+	}
+}
+
+func (repo *timescaleRepo) saveSenml(ctx context.Context, msgs []senml.Message) error {
+	tx, err := repo.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(errSaveMessage, err)
+	}
+
+	q := `INSERT INTO messages (time, channel, subtopic, publisher, protocol,
+          name, unit, value, string_value, bool_value, data_value, sum, update_time)
+          VALUES (:time, :channel, :subtopic, :publisher, :protocol, :name, :unit,
+          :value, :string_value, :bool_value, :data_value, :sum, :update_time);`
+
+	for _, msg := range msgs {
+		m := dbMessage{
+			Message: msg,
+			// The time column holds Unix nanoseconds, as required by
+			// the integer hypertable dimension.
+			Time: int64(msg.Time * 1e9),
+		}
+		if _, err := tx.NamedExecContext(ctx, q, m); err != nil {
+			if txErr := tx.Rollback(); txErr != nil {
+				return errors.Wrap(errSaveMessage, errors.Wrap(err, txErr))
+			}
+			return errors.Wrap(errSaveMessage, err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return errors.Wrap(errSaveMessage, err)
+	}
+
+	return nil
+}
+
+type dbMessage struct {
+	senml.Message
+	Time int64 `db:"time"`
+}
@@ -0,0 +1,86 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package timescale
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/absmach/supermq/readers"
+	"github.com/jmoiron/sqlx"
+)
+
+// retentionRollups are the continuous aggregates retention applies to,
+// matching the views created by the writer migration.
+var retentionRollups = []string{"messages_1m", "messages_5m", "messages_1h"}
+
+// EnforceRetention periodically applies the stored per-channel
+// retention policies, dropping raw messages and rollup rows older than
+// their configured retention. It replaces the manual cron jobs
+// otherwise needed to keep the messages tables bounded.
+func EnforceRetention(ctx context.Context, db *sqlx.DB, policies readers.RetentionRepository, logger *slog.Logger, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := applyRetention(ctx, db, policies); err != nil {
+				logger.Warn(fmt.Sprintf("failed to apply retention policies : %s", err))
+			}
+		}
+	}
+}
+
+func applyRetention(ctx context.Context, db *sqlx.DB, policies readers.RetentionRepository) error {
+	list, err := policies.ListRetention(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, policy := range list {
+		// Policies are validated when saved, so unparsable retentions
+		// are skipped rather than failing the whole sweep.
+		if cutoff, ok := retentionCutoff(now, policy.Raw); ok {
+			q := `DELETE FROM messages WHERE channel = :channel AND time < :cutoff;`
+			if _, err := db.NamedExecContext(ctx, q, retentionParams(policy.ChannelID, cutoff)); err != nil {
+				return err
+			}
+		}
+		if cutoff, ok := retentionCutoff(now, policy.Rollups); ok {
+			for _, view := range retentionRollups {
+				q := fmt.Sprintf(`DELETE FROM %s WHERE channel = :channel AND bucket < :cutoff;`, view)
+				if _, err := db.NamedExecContext(ctx, q, retentionParams(policy.ChannelID, cutoff)); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// retentionCutoff returns the Unix nanosecond time rows retained for
+// the given duration expire at. An unset duration retains forever.
+func retentionCutoff(now time.Time, retention string) (int64, bool) {
+	if retention == "" {
+		return 0, false
+	}
+	d, err := time.ParseDuration(retention)
+	if err != nil || d <= 0 {
+		return 0, false
+	}
+	return now.Add(-d).UnixNano(), true
+}
+
+func retentionParams(chanID string, cutoff int64) map[string]interface{} {
+	return map[string]interface{}{
+		"channel": chanID,
+		"cutoff":  cutoff,
+	}
+}
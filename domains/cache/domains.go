@@ -15,11 +15,11 @@ import (
 )
 
 type domainsCache struct {
-	client   *redis.Client
+	client   redis.UniversalClient
 	duration time.Duration
 }
 
-func NewDomainsCache(client *redis.Client, duration time.Duration) domains.Cache {
+func NewDomainsCache(client redis.UniversalClient, duration time.Duration) domains.Cache {
 	return &domainsCache{
 		client:   client,
 		duration: duration,
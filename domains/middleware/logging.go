@@ -45,10 +45,10 @@ func (lm *loggingMiddleware) CreateDomain(ctx context.Context, session authn.Ses
 		}
 		if err != nil {
 			args := append(args, slog.String("error", err.Error()))
-			lm.logger.Warn("Create domain failed", args...)
+			lm.logger.WarnContext(ctx, "Create domain failed", args...)
 			return
 		}
-		lm.logger.Info("Create domain completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Create domain completed successfully", args...)
 	}(time.Now())
 	return lm.svc.CreateDomain(ctx, session, d)
 }
@@ -61,10 +61,10 @@ func (lm *loggingMiddleware) RetrieveDomain(ctx context.Context, session authn.S
 		}
 		if err != nil {
 			args = append(args, slog.String("error", err.Error()))
-			lm.logger.Warn("Retrieve domain failed", args...)
+			lm.logger.WarnContext(ctx, "Retrieve domain failed", args...)
 			return
 		}
-		lm.logger.Info("Retrieve domain completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Retrieve domain completed successfully", args...)
 	}(time.Now())
 	return lm.svc.RetrieveDomain(ctx, session, id)
 }
@@ -80,10 +80,10 @@ func (lm *loggingMiddleware) UpdateDomain(ctx context.Context, session authn.Ses
 		}
 		if err != nil {
 			args = append(args, slog.String("error", err.Error()))
-			lm.logger.Warn("Update domain failed", args...)
+			lm.logger.WarnContext(ctx, "Update domain failed", args...)
 			return
 		}
-		lm.logger.Info("Update domain completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Update domain completed successfully", args...)
 	}(time.Now())
 	return lm.svc.UpdateDomain(ctx, session, id, d)
 }
@@ -99,10 +99,10 @@ func (lm *loggingMiddleware) EnableDomain(ctx context.Context, session authn.Ses
 		}
 		if err != nil {
 			args = append(args, slog.String("error", err.Error()))
-			lm.logger.Warn("Enable domain failed", args...)
+			lm.logger.WarnContext(ctx, "Enable domain failed", args...)
 			return
 		}
-		lm.logger.Info("Enable domain completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Enable domain completed successfully", args...)
 	}(time.Now())
 	return lm.svc.EnableDomain(ctx, session, id)
 }
@@ -118,10 +118,10 @@ func (lm *loggingMiddleware) DisableDomain(ctx context.Context, session authn.Se
 		}
 		if err != nil {
 			args = append(args, slog.String("error", err.Error()))
-			lm.logger.Warn("Disable domain failed", args...)
+			lm.logger.WarnContext(ctx, "Disable domain failed", args...)
 			return
 		}
-		lm.logger.Info("Disable domain completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Disable domain completed successfully", args...)
 	}(time.Now())
 	return lm.svc.DisableDomain(ctx, session, id)
 }
@@ -137,10 +137,10 @@ func (lm *loggingMiddleware) FreezeDomain(ctx context.Context, session authn.Ses
 		}
 		if err != nil {
 			args = append(args, slog.String("error", err.Error()))
-			lm.logger.Warn("Freeze domain failed", args...)
+			lm.logger.WarnContext(ctx, "Freeze domain failed", args...)
 			return
 		}
-		lm.logger.Info("Freeze domain completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Freeze domain completed successfully", args...)
 	}(time.Now())
 	return lm.svc.FreezeDomain(ctx, session, id)
 }
@@ -157,10 +157,10 @@ func (lm *loggingMiddleware) ListDomains(ctx context.Context, session authn.Sess
 		}
 		if err != nil {
 			args = append(args, slog.String("error", err.Error()))
-			lm.logger.Warn("List domains failed", args...)
+			lm.logger.WarnContext(ctx, "List domains failed", args...)
 			return
 		}
-		lm.logger.Info("List domains completed successfully", args...)
+		lm.logger.InfoContext(ctx, "List domains completed successfully", args...)
 	}(time.Now())
 	return lm.svc.ListDomains(ctx, session, page)
 }
@@ -11,6 +11,7 @@ import (
 
 	"github.com/absmach/supermq/domains"
 	"github.com/absmach/supermq/pkg/authn"
+	"github.com/absmach/supermq/pkg/prometheus"
 	"github.com/absmach/supermq/pkg/roles"
 	rmMW "github.com/absmach/supermq/pkg/roles/rolemanager/middleware"
 	"github.com/go-kit/kit/metrics"
@@ -37,57 +38,57 @@ func MetricsMiddleware(svc domains.Service, counter metrics.Counter, latency met
 	}
 }
 
-func (ms *metricsMiddleware) CreateDomain(ctx context.Context, session authn.Session, d domains.Domain) (domains.Domain, []roles.RoleProvision, error) {
+func (ms *metricsMiddleware) CreateDomain(ctx context.Context, session authn.Session, d domains.Domain) (do domains.Domain, rps []roles.RoleProvision, err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "create_domain").Add(1)
+		ms.counter.With("method", "create_domain", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "create_domain").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.CreateDomain(ctx, session, d)
 }
 
-func (ms *metricsMiddleware) RetrieveDomain(ctx context.Context, session authn.Session, id string) (domains.Domain, error) {
+func (ms *metricsMiddleware) RetrieveDomain(ctx context.Context, session authn.Session, id string) (do domains.Domain, err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "retrieve_domain").Add(1)
+		ms.counter.With("method", "retrieve_domain", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "retrieve_domain").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.RetrieveDomain(ctx, session, id)
 }
 
-func (ms *metricsMiddleware) UpdateDomain(ctx context.Context, session authn.Session, id string, d domains.DomainReq) (domains.Domain, error) {
+func (ms *metricsMiddleware) UpdateDomain(ctx context.Context, session authn.Session, id string, d domains.DomainReq) (do domains.Domain, err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "update_domain").Add(1)
+		ms.counter.With("method", "update_domain", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "update_domain").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.UpdateDomain(ctx, session, id, d)
 }
 
-func (ms *metricsMiddleware) EnableDomain(ctx context.Context, session authn.Session, id string) (domains.Domain, error) {
+func (ms *metricsMiddleware) EnableDomain(ctx context.Context, session authn.Session, id string) (do domains.Domain, err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "enable_domain").Add(1)
+		ms.counter.With("method", "enable_domain", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "enable_domain").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.EnableDomain(ctx, session, id)
 }
 
-func (ms *metricsMiddleware) DisableDomain(ctx context.Context, session authn.Session, id string) (domains.Domain, error) {
+func (ms *metricsMiddleware) DisableDomain(ctx context.Context, session authn.Session, id string) (do domains.Domain, err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "disable_domain").Add(1)
+		ms.counter.With("method", "disable_domain", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "disable_domain").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.DisableDomain(ctx, session, id)
 }
 
-func (ms *metricsMiddleware) FreezeDomain(ctx context.Context, session authn.Session, id string) (domains.Domain, error) {
+func (ms *metricsMiddleware) FreezeDomain(ctx context.Context, session authn.Session, id string) (do domains.Domain, err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "freeze_domain").Add(1)
+		ms.counter.With("method", "freeze_domain", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "freeze_domain").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.FreezeDomain(ctx, session, id)
 }
 
-func (ms *metricsMiddleware) ListDomains(ctx context.Context, session authn.Session, page domains.Page) (domains.DomainsPage, error) {
+func (ms *metricsMiddleware) ListDomains(ctx context.Context, session authn.Session, page domains.Page) (do domains.DomainsPage, err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "list_domains").Add(1)
+		ms.counter.With("method", "list_domains", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "list_domains").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.ListDomains(ctx, session, page)
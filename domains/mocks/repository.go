@@ -94,6 +94,62 @@ func (_m *Repository) ListDomains(ctx context.Context, pm domains.Page) (domains
 	return r0, r1
 }
 
+// ListEntityMembers provides a mock function with given fields: ctx, entityID, limit, offset
+func (_m *Repository) ListEntityMembers(ctx context.Context, entityID string, limit uint64, offset uint64) (roles.EntityMembersPage, error) {
+	ret := _m.Called(ctx, entityID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEntityMembers")
+	}
+
+	var r0 roles.EntityMembersPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, uint64, uint64) (roles.EntityMembersPage, error)); ok {
+		return rf(ctx, entityID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, uint64, uint64) roles.EntityMembersPage); ok {
+		r0 = rf(ctx, entityID, limit, offset)
+	} else {
+		r0 = ret.Get(0).(roles.EntityMembersPage)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, uint64, uint64) error); ok {
+		r1 = rf(ctx, entityID, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListMemberEntities provides a mock function with given fields: ctx, memberID, limit, offset
+func (_m *Repository) ListMemberEntities(ctx context.Context, memberID string, limit uint64, offset uint64) (roles.MemberEntitiesPage, error) {
+	ret := _m.Called(ctx, memberID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListMemberEntities")
+	}
+
+	var r0 roles.MemberEntitiesPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, uint64, uint64) (roles.MemberEntitiesPage, error)); ok {
+		return rf(ctx, memberID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, uint64, uint64) roles.MemberEntitiesPage); ok {
+		r0 = rf(ctx, memberID, limit, offset)
+	} else {
+		r0 = ret.Get(0).(roles.MemberEntitiesPage)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, uint64, uint64) error); ok {
+		r1 = rf(ctx, memberID, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RemoveMemberFromAllRoles provides a mock function with given fields: ctx, memberID
 func (_m *Repository) RemoveMemberFromAllRoles(ctx context.Context, memberID string) error {
 	ret := _m.Called(ctx, memberID)
@@ -639,6 +695,34 @@ func (_m *Repository) Update(ctx context.Context, id string, userID string, d do
 	return r0, r1
 }
 
+// SetRoleInheritance provides a mock function with given fields: ctx, ro
+func (_m *Repository) SetRoleInheritance(ctx context.Context, ro roles.Role) (roles.Role, error) {
+	ret := _m.Called(ctx, ro)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetRoleInheritance")
+	}
+
+	var r0 roles.Role
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, roles.Role) (roles.Role, error)); ok {
+		return rf(ctx, ro)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, roles.Role) roles.Role); ok {
+		r0 = rf(ctx, ro)
+	} else {
+		r0 = ret.Get(0).(roles.Role)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, roles.Role) error); ok {
+		r1 = rf(ctx, ro)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateRole provides a mock function with given fields: ctx, ro
 func (_m *Repository) UpdateRole(ctx context.Context, ro roles.Role) (roles.Role, error) {
 	ret := _m.Called(ctx, ro)
@@ -49,6 +49,36 @@ func (_m *Service) AddRole(ctx context.Context, session authn.Session, entityID
 	return r0, r1
 }
 
+// AddRoles provides a mock function with given fields: ctx, session, entityID, rps
+func (_m *Service) AddRoles(ctx context.Context, session authn.Session, entityID string, rps []roles.RoleProvision) ([]roles.RoleProvision, error) {
+	ret := _m.Called(ctx, session, entityID, rps)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddRoles")
+	}
+
+	var r0 []roles.RoleProvision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, string, []roles.RoleProvision) ([]roles.RoleProvision, error)); ok {
+		return rf(ctx, session, entityID, rps)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, string, []roles.RoleProvision) []roles.RoleProvision); ok {
+		r0 = rf(ctx, session, entityID, rps)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]roles.RoleProvision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, authn.Session, string, []roles.RoleProvision) error); ok {
+		r1 = rf(ctx, session, entityID, rps)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateDomain provides a mock function with given fields: ctx, sesssion, d
 func (_m *Service) CreateDomain(ctx context.Context, sesssion authn.Session, d domains.Domain) (domains.Domain, []roles.RoleProvision, error) {
 	ret := _m.Called(ctx, sesssion, d)
@@ -200,6 +230,92 @@ func (_m *Service) ListAvailableActions(ctx context.Context, session authn.Sessi
 	return r0, r1
 }
 
+// ListEntityMembers provides a mock function with given fields: ctx, session, entityID, limit, offset
+func (_m *Service) ListEntityMembers(ctx context.Context, session authn.Session, entityID string, limit uint64, offset uint64) (roles.EntityMembersPage, error) {
+	ret := _m.Called(ctx, session, entityID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEntityMembers")
+	}
+
+	var r0 roles.EntityMembersPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, string, uint64, uint64) (roles.EntityMembersPage, error)); ok {
+		return rf(ctx, session, entityID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, string, uint64, uint64) roles.EntityMembersPage); ok {
+		r0 = rf(ctx, session, entityID, limit, offset)
+	} else {
+		r0 = ret.Get(0).(roles.EntityMembersPage)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, authn.Session, string, uint64, uint64) error); ok {
+		r1 = rf(ctx, session, entityID, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListMemberEntities provides a mock function with given fields: ctx, session, memberID, limit, offset
+func (_m *Service) ListMemberEntities(ctx context.Context, session authn.Session, memberID string, limit uint64, offset uint64) (roles.MemberEntitiesPage, error) {
+	ret := _m.Called(ctx, session, memberID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListMemberEntities")
+	}
+
+	var r0 roles.MemberEntitiesPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, string, uint64, uint64) (roles.MemberEntitiesPage, error)); ok {
+		return rf(ctx, session, memberID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, string, uint64, uint64) roles.MemberEntitiesPage); ok {
+		r0 = rf(ctx, session, memberID, limit, offset)
+	} else {
+		r0 = ret.Get(0).(roles.MemberEntitiesPage)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, authn.Session, string, uint64, uint64) error); ok {
+		r1 = rf(ctx, session, memberID, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRoleTemplates provides a mock function with given fields: ctx, session
+func (_m *Service) ListRoleTemplates(ctx context.Context, session authn.Session) (map[string][]string, error) {
+	ret := _m.Called(ctx, session)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRoleTemplates")
+	}
+
+	var r0 map[string][]string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session) (map[string][]string, error)); ok {
+		return rf(ctx, session)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session) map[string][]string); ok {
+		r0 = rf(ctx, session)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, authn.Session) error); ok {
+		r1 = rf(ctx, session)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListDomains provides a mock function with given fields: ctx, sesssion, page
 func (_m *Service) ListDomains(ctx context.Context, sesssion authn.Session, page domains.Page) (domains.DomainsPage, error) {
 	ret := _m.Called(ctx, sesssion, page)
@@ -622,6 +738,34 @@ func (_m *Service) UpdateDomain(ctx context.Context, sesssion authn.Session, id
 	return r0, r1
 }
 
+// SetRoleInheritance provides a mock function with given fields: ctx, session, entityID, roleID, inheritsFrom
+func (_m *Service) SetRoleInheritance(ctx context.Context, session authn.Session, entityID string, roleID string, inheritsFrom string) (roles.Role, error) {
+	ret := _m.Called(ctx, session, entityID, roleID, inheritsFrom)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetRoleInheritance")
+	}
+
+	var r0 roles.Role
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, string, string, string) (roles.Role, error)); ok {
+		return rf(ctx, session, entityID, roleID, inheritsFrom)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, string, string, string) roles.Role); ok {
+		r0 = rf(ctx, session, entityID, roleID, inheritsFrom)
+	} else {
+		r0 = ret.Get(0).(roles.Role)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, authn.Session, string, string, string) error); ok {
+		r1 = rf(ctx, session, entityID, roleID, inheritsFrom)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateRoleName provides a mock function with given fields: ctx, session, entityID, roleID, newRoleName
 func (_m *Service) UpdateRoleName(ctx context.Context, session authn.Session, entityID string, roleID string, newRoleName string) (roles.Role, error) {
 	ret := _m.Called(ctx, session, entityID, roleID, newRoleName)
@@ -540,7 +540,7 @@ type dbDomainsPage struct {
 	RoleName string         `db:"role_name"`
 	Actions  pq.StringArray `db:"actions"`
 	ID       string         `db:"id"`
-	IDs      []string       `db:"ids"`
+	IDs      pq.StringArray `db:"ids"`
 	Metadata []byte         `db:"metadata"`
 	Tag      string         `db:"tag"`
 	Status   domains.Status `db:"status"`
@@ -563,7 +563,7 @@ func toDBClientsPage(pm domains.Page) (dbDomainsPage, error) {
 		RoleName: pm.RoleName,
 		Actions:  pm.Actions,
 		ID:       pm.ID,
-		IDs:      pm.IDs,
+		IDs:      pq.StringArray(pm.IDs),
 		Metadata: data,
 		Tag:      pm.Tag,
 		Status:   pm.Status,
@@ -579,8 +579,8 @@ func buildPageQuery(pm domains.Page) (string, error) {
 		query = append(query, "d.id = :id")
 	}
 
-	if len(pm.IDs) != 0 {
-		query = append(query, fmt.Sprintf("d.id IN ('%s')", strings.Join(pm.IDs, "','")))
+	if iq, _ := postgres.CreateIDsQuery("d.", pm.IDs); iq != "" {
+		query = append(query, iq)
 	}
 
 	if (pm.Status >= domains.EnabledStatus) && (pm.Status < domains.AllStatus) {
@@ -73,9 +73,7 @@ func (svc service) CreateDomain(ctx context.Context, session authn.Session, d Do
 		}
 	}()
 
-	newBuiltInRoleMembers := map[roles.BuiltInRoleName][]roles.Member{
-		BuiltInRoleAdmin: {roles.Member(session.UserID)},
-	}
+	newBuiltInRoleMembers := svc.DefaultRoleMembers(BuiltInRoleAdmin, []roles.Member{roles.Member(session.UserID)})
 
 	optionalPolicies := []policies.Policy{
 		{
@@ -0,0 +1,8 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package export provides data export pipelines. Pipelines stream
+// messages from selected channels to external sinks, with
+// per-pipeline transform and filter configuration, checkpointing and
+// lag metrics.
+package export
@@ -0,0 +1,109 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package export
+
+import (
+	"context"
+	"time"
+
+	"github.com/absmach/supermq/pkg/errors"
+	"github.com/pelletier/go-toml"
+)
+
+// Payload formats.
+const (
+	FormatJSON = "json"
+	FormatRaw  = "raw"
+)
+
+var (
+	errOpenConfFile  = errors.New("unable to open pipelines configuration file")
+	errParseConfFile = errors.New("unable to parse pipelines configuration file")
+)
+
+// Pipeline streams messages from a channel to an external sink.
+type Pipeline struct {
+	// Name identifies the pipeline in checkpoints and metrics.
+	Name string
+
+	// Channel is the channel the pipeline exports messages from.
+	Channel string
+
+	// Subtopic optionally narrows the pipeline to one subtopic.
+	Subtopic string
+
+	// Sink is the name of the registered sink messages are sent to.
+	Sink string
+
+	// Topic is the sink-side topic messages are published under.
+	Topic string
+
+	// Format is the payload format: FormatJSON payloads are parsed
+	// and filtered, FormatRaw payloads pass through unchanged.
+	Format string
+
+	// Fields optionally lists the payload fields kept by FormatJSON
+	// pipelines; an empty list keeps all fields.
+	Fields []string
+}
+
+// Checkpoint records the export progress of one pipeline.
+type Checkpoint struct {
+	Pipeline     string    `json:"pipeline"`
+	Exported     uint64    `json:"exported"`
+	Failed       uint64    `json:"failed"`
+	LastCreated  time.Time `json:"last_created,omitempty"`
+	LastExported time.Time `json:"last_exported,omitempty"`
+}
+
+// Sink sends exported payloads to an external system.
+type Sink interface {
+	// Send exports the payload under the given topic.
+	Send(ctx context.Context, topic string, payload []byte) error
+
+	// Close gracefully closes the sink connection.
+	Close() error
+}
+
+type filePipeline struct {
+	Name     string   `toml:"name"`
+	Channel  string   `toml:"channel"`
+	Subtopic string   `toml:"subtopic"`
+	Sink     string   `toml:"sink"`
+	Topic    string   `toml:"topic"`
+	Format   string   `toml:"format"`
+	Fields   []string `toml:"fields"`
+}
+
+type fileConfig struct {
+	Pipelines []filePipeline `toml:"pipelines"`
+}
+
+// LoadPipelines loads export pipelines from the TOML configuration
+// file at the given path.
+func LoadPipelines(configPath string) ([]Pipeline, error) {
+	cfg := fileConfig{}
+	data, err := toml.LoadFile(configPath)
+	if err != nil {
+		return nil, errors.Wrap(errOpenConfFile, err)
+	}
+	if err := data.Unmarshal(&cfg); err != nil {
+		return nil, errors.Wrap(errParseConfFile, err)
+	}
+
+	pipelines := []Pipeline{}
+	for _, fp := range cfg.Pipelines {
+		pipelines = append(pipelines, Pipeline{
+			Name:     fp.Name,
+			Channel:  fp.Channel,
+			Subtopic: fp.Subtopic,
+			Sink:     fp.Sink,
+			Topic:    fp.Topic,
+			Format:   fp.Format,
+			Fields:   fp.Fields,
+		})
+	}
+
+	return pipelines, nil
+}
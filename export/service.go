@@ -0,0 +1,218 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package export
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	api "github.com/absmach/supermq/api/http"
+	"github.com/absmach/supermq/consumers"
+	"github.com/absmach/supermq/pkg/errors"
+	"github.com/absmach/supermq/pkg/messaging"
+	kitprometheus "github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	errUnknownSink   = errors.New("unknown pipeline sink")
+	errInvalidFormat = errors.New("invalid pipeline format")
+)
+
+// exportLag exposes the export lag of every pipeline: the age of the
+// last exported message at the time it was exported.
+var exportLag = kitprometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+	Namespace: "export",
+	Subsystem: "pipeline",
+	Name:      "lag_seconds",
+	Help:      "Age of the last exported message at export time.",
+}, []string{"pipeline"})
+
+// exportedMessages counts the messages exported per pipeline and
+// outcome.
+var exportedMessages = kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{
+	Namespace: "export",
+	Subsystem: "pipeline",
+	Name:      "messages_total",
+	Help:      "Number of exported messages.",
+}, []string{"pipeline", "status"})
+
+// Service specifies the export service API.
+type Service interface {
+	consumers.BlockingConsumer
+
+	// Checkpoints returns the export progress of every pipeline.
+	Checkpoints() []Checkpoint
+
+	// Handler returns a HTTP handler exposing the pipeline
+	// checkpoints.
+	Handler() http.Handler
+}
+
+type service struct {
+	pipelines []Pipeline
+	sinks     map[string]Sink
+
+	mu          sync.RWMutex
+	checkpoints []Checkpoint
+}
+
+var _ Service = (*service)(nil)
+
+// New returns an export service streaming messages of the given
+// pipelines to the given sinks. Every pipeline must reference a
+// registered sink and a valid payload format.
+func New(pipelines []Pipeline, sinks map[string]Sink) (Service, error) {
+	checkpoints := make([]Checkpoint, len(pipelines))
+	for i, p := range pipelines {
+		if _, ok := sinks[p.Sink]; !ok {
+			return nil, errUnknownSink
+		}
+		if p.Format != FormatJSON && p.Format != FormatRaw {
+			return nil, errInvalidFormat
+		}
+		checkpoints[i] = Checkpoint{Pipeline: p.Name}
+	}
+	return &service{
+		pipelines:   pipelines,
+		sinks:       sinks,
+		checkpoints: checkpoints,
+	}, nil
+}
+
+// ConsumeBlocking exports received messages through every matching
+// pipeline.
+func (svc *service) ConsumeBlocking(ctx context.Context, messages interface{}) error {
+	switch msgs := messages.(type) {
+	case *messaging.Message:
+		return svc.consume(ctx, msgs)
+	case []*messaging.Message:
+		for _, msg := range msgs {
+			if err := svc.consume(ctx, msg); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (svc *service) consume(ctx context.Context, msg *messaging.Message) error {
+	for i, p := range svc.pipelines {
+		if p.Channel != "" && p.Channel != msg.GetChannel() {
+			continue
+		}
+		if p.Subtopic != "" && p.Subtopic != msg.GetSubtopic() {
+			continue
+		}
+
+		payload, err := transform(p, msg.GetPayload())
+		if err != nil {
+			svc.checkpoint(i, msg, err)
+			continue
+		}
+		err = svc.sinks[p.Sink].Send(ctx, topic(p, msg), payload)
+		svc.checkpoint(i, msg, err)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Checkpoints returns the export progress of every pipeline.
+func (svc *service) Checkpoints() []Checkpoint {
+	svc.mu.RLock()
+	defer svc.mu.RUnlock()
+	checkpoints := make([]Checkpoint, len(svc.checkpoints))
+	copy(checkpoints, svc.checkpoints)
+	sort.Slice(checkpoints, func(i, j int) bool {
+		return checkpoints[i].Pipeline < checkpoints[j].Pipeline
+	})
+	return checkpoints
+}
+
+// Handler returns a HTTP handler exposing the pipeline checkpoints.
+// The checkpoints describe the export topology, so the handler must be
+// mounted behind platform-admin authorization.
+func (svc *service) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		w.Header().Set("Content-Type", api.ContentType)
+		if err := json.NewEncoder(w).Encode(map[string]interface{}{"checkpoints": svc.Checkpoints()}); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
+
+// checkpoint records the outcome of one export attempt and updates the
+// pipeline metrics.
+func (svc *service) checkpoint(i int, msg *messaging.Message, err error) {
+	now := time.Now()
+	created := time.Unix(0, msg.GetCreated())
+
+	svc.mu.Lock()
+	cp := &svc.checkpoints[i]
+	if err != nil {
+		cp.Failed++
+	} else {
+		cp.Exported++
+		cp.LastCreated = created
+		cp.LastExported = now
+	}
+	name := cp.Pipeline
+	svc.mu.Unlock()
+
+	if err != nil {
+		exportedMessages.With("pipeline", name, "status", "failed").Add(1)
+		return
+	}
+	exportedMessages.With("pipeline", name, "status", "exported").Add(1)
+	if msg.GetCreated() > 0 {
+		exportLag.With("pipeline", name).Set(now.Sub(created).Seconds())
+	}
+}
+
+// transform applies the pipeline format and field filter to the
+// payload.
+func transform(p Pipeline, payload []byte) ([]byte, error) {
+	if p.Format != FormatJSON {
+		return payload, nil
+	}
+
+	values := map[string]interface{}{}
+	if err := json.Unmarshal(payload, &values); err != nil {
+		return nil, err
+	}
+	if len(p.Fields) > 0 {
+		filtered := map[string]interface{}{}
+		for _, field := range p.Fields {
+			if value, ok := values[field]; ok {
+				filtered[field] = value
+			}
+		}
+		values = filtered
+	}
+
+	return json.Marshal(values)
+}
+
+// topic returns the sink-side topic: the configured pipeline topic, or
+// the message channel and subtopic.
+func topic(p Pipeline, msg *messaging.Message) string {
+	if p.Topic != "" {
+		return p.Topic
+	}
+	t := "channels/" + msg.GetChannel()
+	if msg.GetSubtopic() != "" {
+		t = t + "/" + msg.GetSubtopic()
+	}
+	return t
+}
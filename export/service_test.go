@@ -0,0 +1,135 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package export_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/absmach/supermq/export"
+	"github.com/absmach/supermq/pkg/errors"
+	"github.com/absmach/supermq/pkg/messaging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const channelID = "channel"
+
+type sent struct {
+	topic   string
+	payload string
+}
+
+type fakeSink struct {
+	err  error
+	sent []sent
+}
+
+func (s *fakeSink) Send(_ context.Context, topic string, payload []byte) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.sent = append(s.sent, sent{topic: topic, payload: string(payload)})
+	return nil
+}
+
+func (s *fakeSink) Close() error {
+	return nil
+}
+
+func message(channel, subtopic, payload string) *messaging.Message {
+	return &messaging.Message{
+		Channel:  channel,
+		Subtopic: subtopic,
+		Payload:  []byte(payload),
+		Created:  time.Now().UnixNano(),
+	}
+}
+
+func TestNew(t *testing.T) {
+	sinks := map[string]export.Sink{"fake": &fakeSink{}}
+
+	cases := []struct {
+		desc     string
+		pipeline export.Pipeline
+		err      bool
+	}{
+		{
+			desc:     "valid pipeline",
+			pipeline: export.Pipeline{Name: "p", Channel: channelID, Sink: "fake", Format: export.FormatJSON},
+		},
+		{
+			desc:     "unknown sink",
+			pipeline: export.Pipeline{Name: "p", Channel: channelID, Sink: "unknown", Format: export.FormatJSON},
+			err:      true,
+		},
+		{
+			desc:     "invalid format",
+			pipeline: export.Pipeline{Name: "p", Channel: channelID, Sink: "fake", Format: "parquet"},
+			err:      true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := export.New([]export.Pipeline{tc.pipeline}, sinks)
+			assert.Equal(t, tc.err, err != nil, fmt.Sprintf("unexpected error %v", err))
+		})
+	}
+}
+
+func TestConsume(t *testing.T) {
+	sink := &fakeSink{}
+	svc, err := export.New([]export.Pipeline{
+		{Name: "temperatures", Channel: channelID, Sink: "fake", Topic: "export/temp", Format: export.FormatJSON, Fields: []string{"temperature"}},
+	}, map[string]export.Sink{"fake": sink})
+	require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+
+	err = svc.ConsumeBlocking(context.Background(), message(channelID, "", `{"temperature": 42, "humidity": 13}`))
+	require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+	require.Len(t, sink.sent, 1)
+	assert.Equal(t, "export/temp", sink.sent[0].topic)
+	assert.JSONEq(t, `{"temperature": 42}`, sink.sent[0].payload)
+
+	err = svc.ConsumeBlocking(context.Background(), message("other", "", `{"temperature": 42}`))
+	require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+	assert.Len(t, sink.sent, 1)
+
+	checkpoints := svc.Checkpoints()
+	require.Len(t, checkpoints, 1)
+	assert.Equal(t, uint64(1), checkpoints[0].Exported)
+	assert.Equal(t, uint64(0), checkpoints[0].Failed)
+	assert.False(t, checkpoints[0].LastExported.IsZero())
+}
+
+func TestConsumeRaw(t *testing.T) {
+	sink := &fakeSink{}
+	svc, err := export.New([]export.Pipeline{
+		{Name: "raw", Channel: channelID, Sink: "fake", Format: export.FormatRaw},
+	}, map[string]export.Sink{"fake": sink})
+	require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+
+	err = svc.ConsumeBlocking(context.Background(), message(channelID, "measurements", "not json"))
+	require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+	require.Len(t, sink.sent, 1)
+	assert.Equal(t, "channels/"+channelID+"/measurements", sink.sent[0].topic)
+	assert.Equal(t, "not json", sink.sent[0].payload)
+}
+
+func TestConsumeFailure(t *testing.T) {
+	sink := &fakeSink{err: errors.New("sink down")}
+	svc, err := export.New([]export.Pipeline{
+		{Name: "p", Channel: channelID, Sink: "fake", Format: export.FormatRaw},
+	}, map[string]export.Sink{"fake": sink})
+	require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+
+	err = svc.ConsumeBlocking(context.Background(), message(channelID, "", "payload"))
+	assert.NotNil(t, err, "expected sink error")
+
+	checkpoints := svc.Checkpoints()
+	require.Len(t, checkpoints, 1)
+	assert.Equal(t, uint64(0), checkpoints[0].Exported)
+	assert.Equal(t, uint64(1), checkpoints[0].Failed)
+}
@@ -0,0 +1,111 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package export
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/absmach/supermq/pkg/errors"
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+)
+
+const sinkTimeout = 30 * time.Second
+
+var (
+	errMQTTConnect = errors.New("failed to connect to MQTT broker")
+	errMQTTPublish = errors.New("failed to publish to MQTT broker")
+	errHTTPSend    = errors.New("failed to send to HTTP sink")
+)
+
+type mqttSink struct {
+	client  mqtt.Client
+	timeout time.Duration
+	qos     uint8
+}
+
+// NewMQTTSink returns a sink bridging exported messages to an external
+// MQTT broker.
+func NewMQTTSink(address, clientID string, qos uint8, timeout time.Duration) (Sink, error) {
+	opts := mqtt.NewClientOptions().
+		AddBroker(address).
+		SetClientID(clientID)
+	client := mqtt.NewClient(opts)
+	token := client.Connect()
+	if token.Error() != nil {
+		return nil, errors.Wrap(errMQTTConnect, token.Error())
+	}
+	if ok := token.WaitTimeout(timeout); !ok {
+		return nil, errMQTTConnect
+	}
+
+	return &mqttSink{
+		client:  client,
+		timeout: timeout,
+		qos:     qos,
+	}, nil
+}
+
+func (s *mqttSink) Send(_ context.Context, topic string, payload []byte) error {
+	token := s.client.Publish(topic, byte(s.qos), false, payload)
+	if token.Error() != nil {
+		return errors.Wrap(errMQTTPublish, token.Error())
+	}
+	if ok := token.WaitTimeout(s.timeout); !ok {
+		return errMQTTPublish
+	}
+	return nil
+}
+
+func (s *mqttSink) Close() error {
+	s.client.Disconnect(uint(s.timeout))
+	return nil
+}
+
+type httpSink struct {
+	client *http.Client
+	url    string
+	token  string
+}
+
+// NewHTTPSink returns a sink posting exported payloads to the given
+// URL, with the export topic in the X-Export-Topic header. The token,
+// when set, is sent as a bearer token.
+func NewHTTPSink(url, token string) Sink {
+	return &httpSink{
+		client: &http.Client{Timeout: sinkTimeout},
+		url:    url,
+		token:  token,
+	}
+}
+
+func (s *httpSink) Send(ctx context.Context, topic string, payload []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(payload))
+	if err != nil {
+		return errors.Wrap(errHTTPSend, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Export-Topic", topic)
+	if s.token != "" {
+		req.Header.Set("Authorization", "Bearer "+s.token)
+	}
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return errors.Wrap(errHTTPSend, err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusMultipleChoices {
+		return errors.Wrap(errHTTPSend, fmt.Errorf("unexpected response code %d", res.StatusCode))
+	}
+
+	return nil
+}
+
+func (s *httpSink) Close() error {
+	return nil
+}
@@ -0,0 +1,297 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	apiutil "github.com/absmach/supermq/api/http/util"
+	smqsdk "github.com/absmach/supermq/pkg/sdk"
+	"github.com/graphql-go/graphql"
+)
+
+type ctxKey int
+
+const (
+	tokenKey ctxKey = iota
+	domainKey
+)
+
+const (
+	defLimit  = 10
+	defOffset = 0
+)
+
+type gqlRequest struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables"`
+}
+
+// MakeHandler returns an HTTP handler that serves the federated GraphQL
+// schema backed by the given SDK. The bearer token is taken from the
+// Authorization header and the domain from the X-Domain-ID header.
+func MakeHandler(sdk smqsdk.SDK) (http.Handler, error) {
+	schema, err := newSchema(sdk)
+	if err != nil {
+		return nil, err
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req gqlRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		token := strings.TrimPrefix(r.Header.Get("Authorization"), apiutil.BearerPrefix)
+		ctx := context.WithValue(r.Context(), tokenKey, token)
+		ctx = context.WithValue(ctx, domainKey, r.Header.Get("X-Domain-ID"))
+
+		result := graphql.Do(graphql.Params{
+			Schema:         schema,
+			RequestString:  req.Query,
+			VariableValues: req.Variables,
+			Context:        ctx,
+		})
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(result); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	}), nil
+}
+
+func session(ctx context.Context) (token, domainID string) {
+	token, _ = ctx.Value(tokenKey).(string)
+	domainID, _ = ctx.Value(domainKey).(string)
+	return token, domainID
+}
+
+func pageArgs(p graphql.ResolveParams) smqsdk.PageMetadata {
+	pm := smqsdk.PageMetadata{Offset: defOffset, Limit: defLimit}
+	if offset, ok := p.Args["offset"].(int); ok {
+		pm.Offset = uint64(offset)
+	}
+	if limit, ok := p.Args["limit"].(int); ok {
+		pm.Limit = uint64(limit)
+	}
+	return pm
+}
+
+func newSchema(sdk smqsdk.SDK) (graphql.Schema, error) {
+	groupType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Group",
+		Fields: graphql.Fields{
+			"id":          &graphql.Field{Type: graphql.String},
+			"name":        &graphql.Field{Type: graphql.String},
+			"description": &graphql.Field{Type: graphql.String},
+			"status":      &graphql.Field{Type: graphql.String},
+		},
+	})
+	groupType.AddFieldConfig("parent", &graphql.Field{
+		Type: groupType,
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			group, ok := p.Source.(smqsdk.Group)
+			if !ok || group.ParentID == "" {
+				return nil, nil
+			}
+			token, domainID := session(p.Context)
+			parent, err := sdk.Group(group.ParentID, domainID, token)
+			if err != nil {
+				return nil, err
+			}
+			return parent, nil
+		},
+	})
+
+	userType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "User",
+		Fields: graphql.Fields{
+			"id":        &graphql.Field{Type: graphql.String},
+			"firstName": &graphql.Field{Type: graphql.String, Resolve: func(p graphql.ResolveParams) (interface{}, error) { return p.Source.(smqsdk.User).FirstName, nil }},
+			"lastName":  &graphql.Field{Type: graphql.String, Resolve: func(p graphql.ResolveParams) (interface{}, error) { return p.Source.(smqsdk.User).LastName, nil }},
+			"email":     &graphql.Field{Type: graphql.String},
+			"status":    &graphql.Field{Type: graphql.String},
+		},
+	})
+
+	channelType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Channel",
+		Fields: graphql.Fields{
+			"id":     &graphql.Field{Type: graphql.String},
+			"name":   &graphql.Field{Type: graphql.String},
+			"status": &graphql.Field{Type: graphql.String},
+		},
+	})
+	channelType.AddFieldConfig("parentGroup", &graphql.Field{
+		Type: groupType,
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			channel, ok := p.Source.(smqsdk.Channel)
+			if !ok || channel.ParentGroup == "" {
+				return nil, nil
+			}
+			token, domainID := session(p.Context)
+			group, err := sdk.Group(channel.ParentGroup, domainID, token)
+			if err != nil {
+				return nil, err
+			}
+			return group, nil
+		},
+	})
+
+	clientType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Client",
+		Fields: graphql.Fields{
+			"id":     &graphql.Field{Type: graphql.String},
+			"name":   &graphql.Field{Type: graphql.String},
+			"tags":   &graphql.Field{Type: graphql.NewList(graphql.String)},
+			"status": &graphql.Field{Type: graphql.String},
+		},
+	})
+	clientType.AddFieldConfig("parentGroup", &graphql.Field{
+		Type: groupType,
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			client, ok := p.Source.(smqsdk.Client)
+			if !ok || client.ParentGroup == "" {
+				return nil, nil
+			}
+			token, domainID := session(p.Context)
+			group, err := sdk.Group(client.ParentGroup, domainID, token)
+			if err != nil {
+				return nil, err
+			}
+			return group, nil
+		},
+	})
+	clientType.AddFieldConfig("users", &graphql.Field{
+		Type: graphql.NewList(userType),
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			client, ok := p.Source.(smqsdk.Client)
+			if !ok {
+				return nil, nil
+			}
+			token, domainID := session(p.Context)
+			page, err := sdk.ListClientUsers(client.ID, domainID, smqsdk.PageMetadata{Offset: defOffset, Limit: defLimit}, token)
+			if err != nil {
+				return nil, err
+			}
+			return page.Users, nil
+		},
+	})
+
+	idArg := graphql.FieldConfigArgument{
+		"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+	}
+	pageArgsConfig := graphql.FieldConfigArgument{
+		"offset": &graphql.ArgumentConfig{Type: graphql.Int},
+		"limit":  &graphql.ArgumentConfig{Type: graphql.Int},
+	}
+
+	query := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"user": &graphql.Field{
+				Type: userType,
+				Args: idArg,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					token, _ := session(p.Context)
+					user, err := sdk.User(p.Args["id"].(string), token)
+					if err != nil {
+						return nil, err
+					}
+					return user, nil
+				},
+			},
+			"users": &graphql.Field{
+				Type: graphql.NewList(userType),
+				Args: pageArgsConfig,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					token, _ := session(p.Context)
+					page, err := sdk.Users(pageArgs(p), token)
+					if err != nil {
+						return nil, err
+					}
+					return page.Users, nil
+				},
+			},
+			"client": &graphql.Field{
+				Type: clientType,
+				Args: idArg,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					token, domainID := session(p.Context)
+					client, err := sdk.Client(p.Args["id"].(string), domainID, token)
+					if err != nil {
+						return nil, err
+					}
+					return client, nil
+				},
+			},
+			"clients": &graphql.Field{
+				Type: graphql.NewList(clientType),
+				Args: pageArgsConfig,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					token, domainID := session(p.Context)
+					page, err := sdk.Clients(pageArgs(p), domainID, token)
+					if err != nil {
+						return nil, err
+					}
+					return page.Clients, nil
+				},
+			},
+			"channel": &graphql.Field{
+				Type: channelType,
+				Args: idArg,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					token, domainID := session(p.Context)
+					channel, err := sdk.Channel(p.Args["id"].(string), domainID, token)
+					if err != nil {
+						return nil, err
+					}
+					return channel, nil
+				},
+			},
+			"channels": &graphql.Field{
+				Type: graphql.NewList(channelType),
+				Args: pageArgsConfig,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					token, domainID := session(p.Context)
+					page, err := sdk.Channels(pageArgs(p), domainID, token)
+					if err != nil {
+						return nil, err
+					}
+					return page.Channels, nil
+				},
+			},
+			"group": &graphql.Field{
+				Type: groupType,
+				Args: idArg,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					token, domainID := session(p.Context)
+					group, err := sdk.Group(p.Args["id"].(string), domainID, token)
+					if err != nil {
+						return nil, err
+					}
+					return group, nil
+				},
+			},
+			"groups": &graphql.Field{
+				Type: graphql.NewList(groupType),
+				Args: pageArgsConfig,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					token, domainID := session(p.Context)
+					page, err := sdk.Groups(pageArgs(p), domainID, token)
+					if err != nil {
+						return nil, err
+					}
+					return page.Groups, nil
+				},
+			},
+		},
+	})
+
+	return graphql.NewSchema(graphql.SchemaConfig{Query: query})
+}
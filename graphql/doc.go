@@ -0,0 +1,8 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package graphql contains the GraphQL gateway service.
+// The gateway federates users, clients, channels and groups into a single
+// GraphQL schema with relation resolution, so UIs can fetch related
+// entities in one request instead of aggregating REST calls.
+package graphql
@@ -26,6 +26,10 @@ var _ grpcGroupsV1.GroupsServiceClient = (*grpcClient)(nil)
 type grpcClient struct {
 	timeout        time.Duration
 	retrieveEntity endpoint.Endpoint
+	createGroup    endpoint.Endpoint
+	updateGroup    endpoint.Endpoint
+	removeGroup    endpoint.Endpoint
+	listGroups     endpoint.Endpoint
 }
 
 // NewClient returns new gRPC client instance.
@@ -40,6 +44,42 @@ func NewClient(conn *grpc.ClientConn, timeout time.Duration) grpcGroupsV1.Groups
 			grpcCommonV1.RetrieveEntityRes{},
 		).Endpoint(),
 
+		createGroup: kitgrpc.NewClient(
+			conn,
+			svcName,
+			"CreateGroup",
+			passthroughRequest,
+			passthroughResponse,
+			grpcGroupsV1.GroupRes{},
+		).Endpoint(),
+
+		updateGroup: kitgrpc.NewClient(
+			conn,
+			svcName,
+			"UpdateGroup",
+			passthroughRequest,
+			passthroughResponse,
+			grpcGroupsV1.GroupRes{},
+		).Endpoint(),
+
+		removeGroup: kitgrpc.NewClient(
+			conn,
+			svcName,
+			"RemoveGroup",
+			passthroughRequest,
+			passthroughResponse,
+			grpcGroupsV1.RemoveGroupRes{},
+		).Endpoint(),
+
+		listGroups: kitgrpc.NewClient(
+			conn,
+			svcName,
+			"ListGroups",
+			passthroughRequest,
+			passthroughResponse,
+			grpcGroupsV1.ListGroupsRes{},
+		).Endpoint(),
+
 		timeout: timeout,
 	}
 }
@@ -57,10 +97,64 @@ func (client grpcClient) RetrieveEntity(ctx context.Context, req *grpcCommonV1.R
 	return typedRes, nil
 }
 
+func (client grpcClient) CreateGroup(ctx context.Context, req *grpcGroupsV1.CreateGroupReq, _ ...grpc.CallOption) (*grpcGroupsV1.GroupRes, error) {
+	ctx, cancel := context.WithTimeout(ctx, client.timeout)
+	defer cancel()
+
+	res, err := client.createGroup(ctx, req)
+	if err != nil {
+		return &grpcGroupsV1.GroupRes{}, decodeError(err)
+	}
+	return res.(*grpcGroupsV1.GroupRes), nil
+}
+
+func (client grpcClient) UpdateGroup(ctx context.Context, req *grpcGroupsV1.UpdateGroupReq, _ ...grpc.CallOption) (*grpcGroupsV1.GroupRes, error) {
+	ctx, cancel := context.WithTimeout(ctx, client.timeout)
+	defer cancel()
+
+	res, err := client.updateGroup(ctx, req)
+	if err != nil {
+		return &grpcGroupsV1.GroupRes{}, decodeError(err)
+	}
+	return res.(*grpcGroupsV1.GroupRes), nil
+}
+
+func (client grpcClient) RemoveGroup(ctx context.Context, req *grpcGroupsV1.RemoveGroupReq, _ ...grpc.CallOption) (*grpcGroupsV1.RemoveGroupRes, error) {
+	ctx, cancel := context.WithTimeout(ctx, client.timeout)
+	defer cancel()
+
+	res, err := client.removeGroup(ctx, req)
+	if err != nil {
+		return &grpcGroupsV1.RemoveGroupRes{}, decodeError(err)
+	}
+	return res.(*grpcGroupsV1.RemoveGroupRes), nil
+}
+
+func (client grpcClient) ListGroups(ctx context.Context, req *grpcGroupsV1.ListGroupsReq, _ ...grpc.CallOption) (*grpcGroupsV1.ListGroupsRes, error) {
+	ctx, cancel := context.WithTimeout(ctx, client.timeout)
+	defer cancel()
+
+	res, err := client.listGroups(ctx, req)
+	if err != nil {
+		return &grpcGroupsV1.ListGroupsRes{}, decodeError(err)
+	}
+	return res.(*grpcGroupsV1.ListGroupsRes), nil
+}
+
 func encodeRetrieveEntityRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
 	return grpcReq, nil
 }
 
+// passthroughRequest forwards the protobuf request as-is, since CRUD
+// requests carry no transport-specific representation.
+func passthroughRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	return grpcReq, nil
+}
+
+func passthroughResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	return grpcRes, nil
+}
+
 func decodeRetrieveEntityResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
 	return grpcRes, nil
 }
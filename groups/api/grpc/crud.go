@@ -0,0 +1,237 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+
+	grpcGroupsV1 "github.com/absmach/supermq/api/grpc/groups/v1"
+	apiutil "github.com/absmach/supermq/api/http/util"
+	"github.com/absmach/supermq/groups"
+	smqauthn "github.com/absmach/supermq/pkg/authn"
+	"github.com/absmach/supermq/pkg/errors"
+	svcerr "github.com/absmach/supermq/pkg/errors/service"
+	"github.com/go-kit/kit/endpoint"
+)
+
+type createGroupReq struct {
+	token    string
+	domainID string
+	group    groups.Group
+}
+
+type updateGroupReq struct {
+	token    string
+	domainID string
+	group    groups.Group
+}
+
+type removeGroupReq struct {
+	token    string
+	domainID string
+	id       string
+}
+
+type listGroupsReq struct {
+	token    string
+	domainID string
+	offset   uint64
+	limit    uint64
+}
+
+type groupRes struct {
+	group groups.Group
+}
+
+type removeGroupRes struct{}
+
+type listGroupsRes struct {
+	total  uint64
+	offset uint64
+	limit  uint64
+	groups []groups.Group
+}
+
+func createGroupEndpoint(svc groups.Service, authn smqauthn.Authentication) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(createGroupReq)
+		session, err := authenticate(ctx, authn, req.token, req.domainID)
+		if err != nil {
+			return groupRes{}, err
+		}
+		group, _, err := svc.CreateGroup(ctx, session, req.group)
+		if err != nil {
+			return groupRes{}, err
+		}
+		return groupRes{group: group}, nil
+	}
+}
+
+func updateGroupEndpoint(svc groups.Service, authn smqauthn.Authentication) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(updateGroupReq)
+		session, err := authenticate(ctx, authn, req.token, req.domainID)
+		if err != nil {
+			return groupRes{}, err
+		}
+		group, err := svc.UpdateGroup(ctx, session, req.group)
+		if err != nil {
+			return groupRes{}, err
+		}
+		return groupRes{group: group}, nil
+	}
+}
+
+func removeGroupEndpoint(svc groups.Service, authn smqauthn.Authentication) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(removeGroupReq)
+		session, err := authenticate(ctx, authn, req.token, req.domainID)
+		if err != nil {
+			return removeGroupRes{}, err
+		}
+		if err := svc.DeleteGroup(ctx, session, req.id); err != nil {
+			return removeGroupRes{}, err
+		}
+		return removeGroupRes{}, nil
+	}
+}
+
+func listGroupsEndpoint(svc groups.Service, authn smqauthn.Authentication) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(listGroupsReq)
+		session, err := authenticate(ctx, authn, req.token, req.domainID)
+		if err != nil {
+			return listGroupsRes{}, err
+		}
+		page, err := svc.ListGroups(ctx, session, groups.PageMeta{Offset: req.offset, Limit: req.limit})
+		if err != nil {
+			return listGroupsRes{}, err
+		}
+		return listGroupsRes{
+			total:  page.Total,
+			offset: page.Offset,
+			limit:  page.Limit,
+			groups: page.Groups,
+		}, nil
+	}
+}
+
+// authenticate resolves the bearer token into a session scoped to the domain,
+// the same way the HTTP authentication middleware does.
+func authenticate(ctx context.Context, authn smqauthn.Authentication, token, domainID string) (smqauthn.Session, error) {
+	if token == "" {
+		return smqauthn.Session{}, errors.Wrap(svcerr.ErrAuthentication, apiutil.ErrBearerToken)
+	}
+	if domainID == "" {
+		return smqauthn.Session{}, errors.Wrap(svcerr.ErrAuthentication, apiutil.ErrMissingDomainID)
+	}
+	session, err := authn.Authenticate(ctx, token)
+	if err != nil {
+		return smqauthn.Session{}, errors.Wrap(svcerr.ErrAuthentication, err)
+	}
+	session.DomainID = domainID
+	session.DomainUserID = domainID + "_" + session.UserID
+
+	return session, nil
+}
+
+func decodeCreateGroupRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*grpcGroupsV1.CreateGroupReq)
+	group, err := decodeGroup(req.GetGroup())
+	if err != nil {
+		return nil, err
+	}
+	return createGroupReq{token: req.GetToken(), domainID: req.GetDomainId(), group: group}, nil
+}
+
+func decodeUpdateGroupRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*grpcGroupsV1.UpdateGroupReq)
+	group, err := decodeGroup(req.GetGroup())
+	if err != nil {
+		return nil, err
+	}
+	return updateGroupReq{token: req.GetToken(), domainID: req.GetDomainId(), group: group}, nil
+}
+
+func decodeRemoveGroupRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*grpcGroupsV1.RemoveGroupReq)
+	return removeGroupReq{token: req.GetToken(), domainID: req.GetDomainId(), id: req.GetId()}, nil
+}
+
+func decodeListGroupsRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*grpcGroupsV1.ListGroupsReq)
+	return listGroupsReq{token: req.GetToken(), domainID: req.GetDomainId(), offset: req.GetOffset(), limit: req.GetLimit()}, nil
+}
+
+func encodeGroupResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(groupRes)
+	group, err := encodeGroup(res.group)
+	if err != nil {
+		return nil, err
+	}
+	return &grpcGroupsV1.GroupRes{Group: group}, nil
+}
+
+func encodeRemoveGroupResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	return &grpcGroupsV1.RemoveGroupRes{}, nil
+}
+
+func encodeListGroupsResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(listGroupsRes)
+	grps := make([]*grpcGroupsV1.Group, 0, len(res.groups))
+	for _, g := range res.groups {
+		group, err := encodeGroup(g)
+		if err != nil {
+			return nil, err
+		}
+		grps = append(grps, group)
+	}
+	return &grpcGroupsV1.ListGroupsRes{
+		Total:  res.total,
+		Offset: res.offset,
+		Limit:  res.limit,
+		Groups: grps,
+	}, nil
+}
+
+func decodeGroup(g *grpcGroupsV1.Group) (groups.Group, error) {
+	if g == nil {
+		return groups.Group{}, nil
+	}
+	metadata := groups.Metadata{}
+	if len(g.GetMetadata()) > 0 {
+		if err := json.Unmarshal(g.GetMetadata(), &metadata); err != nil {
+			return groups.Group{}, err
+		}
+	}
+	return groups.Group{
+		ID:          g.GetId(),
+		Parent:      g.GetParentId(),
+		Domain:      g.GetDomainId(),
+		Name:        g.GetName(),
+		Description: g.GetDescription(),
+		Metadata:    metadata,
+		Status:      groups.Status(g.GetStatus()),
+	}, nil
+}
+
+func encodeGroup(g groups.Group) (*grpcGroupsV1.Group, error) {
+	var metadata []byte
+	if len(g.Metadata) > 0 {
+		var err error
+		if metadata, err = json.Marshal(g.Metadata); err != nil {
+			return nil, err
+		}
+	}
+	return &grpcGroupsV1.Group{
+		Id:          g.ID,
+		ParentId:    g.Parent,
+		DomainId:    g.Domain,
+		Name:        g.Name,
+		Description: g.Description,
+		Metadata:    metadata,
+		Status:      uint32(g.Status),
+	}, nil
+}
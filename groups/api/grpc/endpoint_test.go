@@ -14,8 +14,10 @@ import (
 	grpcGroupsV1 "github.com/absmach/supermq/api/grpc/groups/v1"
 	"github.com/absmach/supermq/groups"
 	grpcapi "github.com/absmach/supermq/groups/api/grpc"
+	gmocks "github.com/absmach/supermq/groups/mocks"
 	prmocks "github.com/absmach/supermq/groups/private/mocks"
 	"github.com/absmach/supermq/internal/testsutil"
+	authnmocks "github.com/absmach/supermq/pkg/authn/mocks"
 	"github.com/absmach/supermq/pkg/errors"
 	svcerr "github.com/absmach/supermq/pkg/errors/service"
 	"github.com/stretchr/testify/assert"
@@ -52,7 +54,7 @@ func startGRPCServer(svc *prmocks.Service, port int) {
 		panic(fmt.Sprintf("failed to obtain port: %s", err))
 	}
 	server := grpc.NewServer()
-	grpcGroupsV1.RegisterGroupsServiceServer(server, grpcapi.NewServer(svc))
+	grpcGroupsV1.RegisterGroupsServiceServer(server, grpcapi.NewServer(svc, new(gmocks.Service), new(authnmocks.Authentication)))
 	go func() {
 		if err := server.Serve(listener); err != nil {
 			panic(fmt.Sprintf("failed to serve: %s", err))
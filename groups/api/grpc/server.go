@@ -10,7 +10,9 @@ import (
 	grpcGroupsV1 "github.com/absmach/supermq/api/grpc/groups/v1"
 	apiutil "github.com/absmach/supermq/api/http/util"
 	smqauth "github.com/absmach/supermq/auth"
+	smqgroups "github.com/absmach/supermq/groups"
 	groups "github.com/absmach/supermq/groups/private"
+	smqauthn "github.com/absmach/supermq/pkg/authn"
 	"github.com/absmach/supermq/pkg/errors"
 	svcerr "github.com/absmach/supermq/pkg/errors/service"
 	kitgrpc "github.com/go-kit/kit/transport/grpc"
@@ -23,16 +25,40 @@ var _ grpcGroupsV1.GroupsServiceServer = (*grpcServer)(nil)
 type grpcServer struct {
 	grpcGroupsV1.UnimplementedGroupsServiceServer
 	retrieveEntity kitgrpc.Handler
+	createGroup    kitgrpc.Handler
+	updateGroup    kitgrpc.Handler
+	removeGroup    kitgrpc.Handler
+	listGroups     kitgrpc.Handler
 }
 
 // NewServer returns new AuthServiceServer instance.
-func NewServer(svc groups.Service) grpcGroupsV1.GroupsServiceServer {
+func NewServer(svc groups.Service, gsvc smqgroups.Service, authn smqauthn.Authentication) grpcGroupsV1.GroupsServiceServer {
 	return &grpcServer{
 		retrieveEntity: kitgrpc.NewServer(
 			retrieveEntityEndpoint(svc),
 			decodeRetrieveEntityRequest,
 			encodeRetrieveEntityResponse,
 		),
+		createGroup: kitgrpc.NewServer(
+			createGroupEndpoint(gsvc, authn),
+			decodeCreateGroupRequest,
+			encodeGroupResponse,
+		),
+		updateGroup: kitgrpc.NewServer(
+			updateGroupEndpoint(gsvc, authn),
+			decodeUpdateGroupRequest,
+			encodeGroupResponse,
+		),
+		removeGroup: kitgrpc.NewServer(
+			removeGroupEndpoint(gsvc, authn),
+			decodeRemoveGroupRequest,
+			encodeRemoveGroupResponse,
+		),
+		listGroups: kitgrpc.NewServer(
+			listGroupsEndpoint(gsvc, authn),
+			decodeListGroupsRequest,
+			encodeListGroupsResponse,
+		),
 	}
 }
 
@@ -44,6 +70,38 @@ func (s *grpcServer) RetrieveEntity(ctx context.Context, req *grpcCommonV1.Retri
 	return res.(*grpcCommonV1.RetrieveEntityRes), nil
 }
 
+func (s *grpcServer) CreateGroup(ctx context.Context, req *grpcGroupsV1.CreateGroupReq) (*grpcGroupsV1.GroupRes, error) {
+	_, res, err := s.createGroup.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res.(*grpcGroupsV1.GroupRes), nil
+}
+
+func (s *grpcServer) UpdateGroup(ctx context.Context, req *grpcGroupsV1.UpdateGroupReq) (*grpcGroupsV1.GroupRes, error) {
+	_, res, err := s.updateGroup.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res.(*grpcGroupsV1.GroupRes), nil
+}
+
+func (s *grpcServer) RemoveGroup(ctx context.Context, req *grpcGroupsV1.RemoveGroupReq) (*grpcGroupsV1.RemoveGroupRes, error) {
+	_, res, err := s.removeGroup.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res.(*grpcGroupsV1.RemoveGroupRes), nil
+}
+
+func (s *grpcServer) ListGroups(ctx context.Context, req *grpcGroupsV1.ListGroupsReq) (*grpcGroupsV1.ListGroupsRes, error) {
+	_, res, err := s.listGroups.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res.(*grpcGroupsV1.ListGroupsRes), nil
+}
+
 func decodeRetrieveEntityRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
 	req := grpcReq.(*grpcCommonV1.RetrieveEntityReq)
 	return retrieveEntityReq{
@@ -84,6 +142,9 @@ func encodeError(err error) error {
 	case errors.Contains(err, svcerr.ErrAuthorization):
 		return status.Error(codes.PermissionDenied, err.Error())
 	default:
+		if code := errors.CodeOf(err); code != errors.CodeInternal {
+			return status.Error(code.GRPCStatus(), err.Error())
+		}
 		return status.Error(codes.Internal, err.Error())
 	}
 }
@@ -13,6 +13,7 @@ import (
 	apiutil "github.com/absmach/supermq/api/http/util"
 	groups "github.com/absmach/supermq/groups"
 	"github.com/absmach/supermq/pkg/errors"
+	"github.com/absmach/supermq/pkg/labels"
 	"github.com/go-chi/chi/v5"
 )
 
@@ -56,11 +57,26 @@ func DecodeListGroupsRequest(_ context.Context, r *http.Request) (interface{}, e
 }
 
 func DecodeGroupUpdate(_ context.Context, r *http.Request) (interface{}, error) {
+	version, err := apiutil.ReadIfMatchHeader(r)
+	if err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	if strings.Contains(r.Header.Get("Content-Type"), api.ContentTypeMergePatch) {
+		req := updateGroupPatchReq{
+			id:      chi.URLParam(r, "groupID"),
+			version: version,
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			return nil, errors.Wrap(apiutil.ErrValidation, errors.Wrap(err, errors.ErrMalformedEntity))
+		}
+		return req, nil
+	}
 	if !strings.Contains(r.Header.Get("Content-Type"), api.ContentType) {
 		return nil, errors.Wrap(apiutil.ErrValidation, apiutil.ErrUnsupportedContentType)
 	}
 	req := updateGroupReq{
-		id: chi.URLParam(r, "groupID"),
+		id:      chi.URLParam(r, "groupID"),
+		version: version,
 	}
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		return nil, errors.Wrap(apiutil.ErrValidation, errors.Wrap(err, errors.ErrMalformedEntity))
@@ -68,6 +84,42 @@ func DecodeGroupUpdate(_ context.Context, r *http.Request) (interface{}, error)
 	return req, nil
 }
 
+func DecodeViewGroupRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	listPerms, err := apiutil.ReadBoolQuery(r, api.ListPerms, api.DefListPerms)
+	if err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	listCounts, err := apiutil.ReadBoolQuery(r, "member_counts", false)
+	if err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	req := viewGroupReq{
+		id:         chi.URLParam(r, "groupID"),
+		listPerms:  listPerms,
+		listCounts: listCounts,
+	}
+	return req, nil
+}
+
+func DecodeListGroupTrashRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	o, err := apiutil.ReadNumQuery[uint64](r, api.OffsetKey, api.DefOffset)
+	if err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	l, err := apiutil.ReadNumQuery[uint64](r, api.LimitKey, api.DefLimit)
+	if err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, err)
+	}
+
+	req := listGroupTrashReq{
+		PageMeta: groups.PageMeta{
+			Offset: o,
+			Limit:  l,
+		},
+	}
+	return req, nil
+}
+
 func DecodeGroupRequest(_ context.Context, r *http.Request) (interface{}, error) {
 	req := groupReq{
 		id: chi.URLParam(r, "groupID"),
@@ -76,8 +128,13 @@ func DecodeGroupRequest(_ context.Context, r *http.Request) (interface{}, error)
 }
 
 func DecodeChangeGroupStatusRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	c, err := apiutil.ReadBoolQuery(r, "cascade", false)
+	if err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, err)
+	}
 	req := changeGroupStatusReq{
-		id: chi.URLParam(r, "groupID"),
+		id:      chi.URLParam(r, "groupID"),
+		cascade: c,
 	}
 	return req, nil
 }
@@ -252,17 +309,33 @@ func decodePageMeta(r *http.Request) (groups.PageMeta, error) {
 		return groups.PageMeta{}, errors.Wrap(apiutil.ErrValidation, err)
 	}
 
+	permission, err := apiutil.ReadStringQuery(r, api.PermissionKey, "")
+	if err != nil {
+		return groups.PageMeta{}, errors.Wrap(apiutil.ErrValidation, err)
+	}
+
+	ls, err := apiutil.ReadStringQuery(r, api.LabelSelectorKey, "")
+	if err != nil {
+		return groups.PageMeta{}, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	sel, err := labels.ParseSelector(ls)
+	if err != nil {
+		return groups.PageMeta{}, errors.Wrap(apiutil.ErrValidation, err)
+	}
+
 	ret := groups.PageMeta{
-		Offset:     offset,
-		Limit:      limit,
-		Name:       name,
-		ID:         id,
-		Metadata:   meta,
-		Status:     st,
-		RoleName:   roleName,
-		RoleID:     roleID,
-		Actions:    actions,
-		AccessType: accessType,
+		Offset:        offset,
+		Limit:         limit,
+		Name:          name,
+		ID:            id,
+		Metadata:      meta,
+		Status:        st,
+		RoleName:      roleName,
+		RoleID:        roleID,
+		Actions:       actions,
+		AccessType:    accessType,
+		Permission:    permission,
+		LabelSelector: sel,
 	}
 	return ret, nil
 }
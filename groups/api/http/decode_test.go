@@ -53,7 +53,8 @@ func TestDecodeListGroupsRequest(t *testing.T) {
 					Metadata: groups.Metadata{
 						"test": "test",
 					},
-					Actions: []string{},
+					Actions:    []string{},
+					Permission: "random",
 				},
 			},
 			err: nil,
@@ -187,7 +188,8 @@ func TestDecodeListChildrenRequest(t *testing.T) {
 					Metadata: groups.Metadata{
 						"test": "test",
 					},
-					Actions: []string{},
+					Actions:    []string{},
+					Permission: "random",
 				},
 			},
 			err: nil,
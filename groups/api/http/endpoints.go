@@ -38,7 +38,7 @@ func CreateGroupEndpoint(svc groups.Service) endpoint.Endpoint {
 
 func ViewGroupEndpoint(svc groups.Service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
-		req := request.(groupReq)
+		req := request.(viewGroupReq)
 		if err := req.validate(); err != nil {
 			return viewGroupRes{}, errors.Wrap(apiutil.ErrValidation, err)
 		}
@@ -48,7 +48,7 @@ func ViewGroupEndpoint(svc groups.Service) endpoint.Endpoint {
 			return viewGroupRes{}, svcerr.ErrAuthentication
 		}
 
-		group, err := svc.ViewGroup(ctx, session, req.id)
+		group, err := svc.ViewGroup(ctx, session, req.id, req.listPerms, req.listCounts)
 		if err != nil {
 			return viewGroupRes{}, err
 		}
@@ -59,21 +59,52 @@ func ViewGroupEndpoint(svc groups.Service) endpoint.Endpoint {
 
 func UpdateGroupEndpoint(svc groups.Service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
-		req := request.(updateGroupReq)
-		if err := req.validate(); err != nil {
-			return updateGroupRes{}, errors.Wrap(apiutil.ErrValidation, err)
-		}
-
 		session, ok := ctx.Value(api.SessionKey).(authn.Session)
 		if !ok {
 			return updateGroupRes{}, svcerr.ErrAuthentication
 		}
 
-		group := groups.Group{
-			ID:          req.id,
-			Name:        req.Name,
-			Description: req.Description,
-			Metadata:    req.Metadata,
+		var group groups.Group
+		switch req := request.(type) {
+		case updateGroupReq:
+			if err := req.validate(); err != nil {
+				return updateGroupRes{}, errors.Wrap(apiutil.ErrValidation, err)
+			}
+
+			group = groups.Group{
+				ID:          req.id,
+				Name:        req.Name,
+				Description: req.Description,
+				Metadata:    req.Metadata,
+				Labels:      req.Labels,
+				Version:     req.version,
+			}
+		case updateGroupPatchReq:
+			if err := req.validate(); err != nil {
+				return updateGroupRes{}, errors.Wrap(apiutil.ErrValidation, err)
+			}
+
+			current, err := svc.ViewGroup(ctx, session, req.id, false, false)
+			if err != nil {
+				return updateGroupRes{}, err
+			}
+
+			group = groups.Group{
+				ID:          req.id,
+				Name:        current.Name,
+				Description: current.Description,
+				Metadata:    api.MergeMetadata(current.Metadata, req.Metadata),
+				Version:     req.version,
+			}
+			if req.Name != nil {
+				group.Name = *req.Name
+			}
+			if req.Description != nil {
+				group.Description = *req.Description
+			}
+			if group.Version == 0 {
+				group.Version = current.Version
+			}
 		}
 
 		group, err := svc.UpdateGroup(ctx, session, group)
@@ -117,7 +148,7 @@ func DisableGroupEndpoint(svc groups.Service) endpoint.Endpoint {
 			return changeStatusRes{}, svcerr.ErrAuthentication
 		}
 
-		group, err := svc.DisableGroup(ctx, session, req.id)
+		group, err := svc.DisableGroup(ctx, session, req.id, req.cascade)
 		if err != nil {
 			return changeStatusRes{}, err
 		}
@@ -166,6 +197,59 @@ func ListGroupsEndpoint(svc groups.Service) endpoint.Endpoint {
 	}
 }
 
+func ListGroupTrashEndpoint(svc groups.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(listGroupTrashReq)
+		if err := req.validate(); err != nil {
+			return groupPageRes{}, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(api.SessionKey).(authn.Session)
+		if !ok {
+			return groupPageRes{}, svcerr.ErrAuthentication
+		}
+
+		page, err := svc.ListTrash(ctx, session, req.PageMeta)
+		if err != nil {
+			return groupPageRes{}, err
+		}
+
+		groups := []viewGroupRes{}
+		for _, g := range page.Groups {
+			groups = append(groups, toViewGroupRes(g))
+		}
+
+		return groupPageRes{
+			pageRes: pageRes{
+				Limit:  page.Limit,
+				Offset: page.Offset,
+				Total:  page.Total,
+			},
+			Groups: groups,
+		}, nil
+	}
+}
+
+func RestoreGroupEndpoint(svc groups.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(groupReq)
+		if err := req.validate(); err != nil {
+			return changeStatusRes{}, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(api.SessionKey).(authn.Session)
+		if !ok {
+			return changeStatusRes{}, svcerr.ErrAuthentication
+		}
+
+		group, err := svc.Restore(ctx, session, req.id)
+		if err != nil {
+			return changeStatusRes{}, err
+		}
+		return changeStatusRes{Group: group}, nil
+	}
+}
+
 func DeleteGroupEndpoint(svc groups.Service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(groupReq)
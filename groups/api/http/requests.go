@@ -7,6 +7,7 @@ import (
 	api "github.com/absmach/supermq/api/http"
 	apiutil "github.com/absmach/supermq/api/http/util"
 	"github.com/absmach/supermq/groups"
+	"github.com/absmach/supermq/pkg/labels"
 )
 
 type createGroupReq struct {
@@ -18,14 +19,32 @@ func (req createGroupReq) validate() error {
 		return apiutil.ErrNameSize
 	}
 
-	return nil
+	return req.Labels.Validate()
 }
 
 type updateGroupReq struct {
 	id          string
+	version     uint64
 	Name        string                 `json:"name,omitempty"`
 	Description string                 `json:"description,omitempty"`
 	Metadata    map[string]interface{} `json:"metadata,omitempty"`
+	Labels      labels.Labels          `json:"labels,omitempty"`
+}
+
+type updateGroupPatchReq struct {
+	id          string
+	version     uint64
+	Name        *string                `json:"name,omitempty"`
+	Description *string                `json:"description,omitempty"`
+	Metadata    map[string]interface{} `json:"metadata,omitempty"`
+}
+
+func (req updateGroupPatchReq) validate() error {
+	if req.id == "" {
+		return apiutil.ErrMissingID
+	}
+
+	return nil
 }
 
 func (req updateGroupReq) validate() error {
@@ -35,7 +54,7 @@ func (req updateGroupReq) validate() error {
 	if len(req.Name) > api.MaxNameSize {
 		return apiutil.ErrNameSize
 	}
-	return nil
+	return req.Labels.Validate()
 }
 
 type listGroupsReq struct {
@@ -55,6 +74,32 @@ func (req listGroupsReq) validate() error {
 	return nil
 }
 
+type viewGroupReq struct {
+	id         string
+	listPerms  bool
+	listCounts bool
+}
+
+func (req viewGroupReq) validate() error {
+	if req.id == "" {
+		return apiutil.ErrMissingID
+	}
+
+	return nil
+}
+
+type listGroupTrashReq struct {
+	groups.PageMeta
+}
+
+func (req listGroupTrashReq) validate() error {
+	if req.Limit > api.MaxLimitSize || req.Limit < 1 {
+		return apiutil.ErrLimitSize
+	}
+
+	return nil
+}
+
 type groupReq struct {
 	id string
 }
@@ -68,7 +113,8 @@ func (req groupReq) validate() error {
 }
 
 type changeGroupStatusReq struct {
-	id string
+	id      string
+	cascade bool
 }
 
 func (req changeGroupStatusReq) validate() error {
@@ -6,8 +6,11 @@ package api
 import (
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/absmach/supermq"
+	api "github.com/absmach/supermq/api/http"
+	apiutil "github.com/absmach/supermq/api/http/util"
 	"github.com/absmach/supermq/groups"
 )
 
@@ -24,6 +27,8 @@ var (
 	_ supermq.Response = (*removeChildrenGroupsRes)(nil)
 	_ supermq.Response = (*removeAllChildrenGroupsRes)(nil)
 	_ supermq.Response = (*listChildrenGroupsRes)(nil)
+
+	_ api.Streamable = (*groupPageRes)(nil)
 )
 
 type viewGroupRes struct {
@@ -35,6 +40,9 @@ func (res viewGroupRes) Code() int {
 }
 
 func (res viewGroupRes) Headers() map[string]string {
+	if res.Version > 0 {
+		return map[string]string{"ETag": apiutil.ETag(res.Version)}
+	}
 	return map[string]string{}
 }
 
@@ -84,7 +92,24 @@ func (res groupPageRes) Code() int {
 	return http.StatusOK
 }
 
+func (res groupPageRes) StreamItems() []interface{} {
+	items := make([]interface{}, 0, len(res.Groups))
+	for _, g := range res.Groups {
+		items = append(items, g)
+	}
+
+	return items
+}
+
 func (res groupPageRes) Headers() map[string]string {
+	times := make([]time.Time, 0, 2*len(res.Groups))
+	for _, g := range res.Groups {
+		times = append(times, g.CreatedAt, g.UpdatedAt)
+	}
+	if lm := api.LastModified(times...); lm != "" {
+		return map[string]string{"Last-Modified": lm}
+	}
+
 	return map[string]string{}
 }
 
@@ -101,6 +126,9 @@ func (res updateGroupRes) Code() int {
 }
 
 func (res updateGroupRes) Headers() map[string]string {
+	if res.Version > 0 {
+		return map[string]string{"ETag": apiutil.ETag(res.Version)}
+	}
 	return map[string]string{}
 }
 
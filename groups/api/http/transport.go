@@ -9,6 +9,7 @@ import (
 	"github.com/absmach/supermq"
 	api "github.com/absmach/supermq/api/http"
 	apiutil "github.com/absmach/supermq/api/http/util"
+	"github.com/absmach/supermq/apidocs"
 	"github.com/absmach/supermq/groups"
 	"github.com/absmach/supermq/pkg/authn"
 	roleManagerHttp "github.com/absmach/supermq/pkg/roles/rolemanager/api"
@@ -22,6 +23,7 @@ import (
 func MakeHandler(svc groups.Service, authn authn.Authentication, mux *chi.Mux, logger *slog.Logger, instanceID string) *chi.Mux {
 	opts := []kithttp.ServerOption{
 		kithttp.ServerErrorEncoder(apiutil.LoggingErrorEncoder(logger, api.EncodeError)),
+		kithttp.ServerBefore(api.AcceptToContext),
 	}
 	d := roleManagerHttp.NewDecoder("groupID")
 
@@ -40,12 +42,27 @@ func MakeHandler(svc groups.Service, authn authn.Authentication, mux *chi.Mux, l
 			api.EncodeResponse,
 			opts...,
 		), "list_groups").ServeHTTP)
+		r.Route("/trash", func(r chi.Router) {
+			r.Get("/", otelhttp.NewHandler(kithttp.NewServer(
+				ListGroupTrashEndpoint(svc),
+				DecodeListGroupTrashRequest,
+				api.EncodeResponse,
+				opts...,
+			), "list_group_trash").ServeHTTP)
+
+			r.Post("/{groupID}/restore", otelhttp.NewHandler(kithttp.NewServer(
+				RestoreGroupEndpoint(svc),
+				DecodeGroupRequest,
+				api.EncodeResponse,
+				opts...,
+			), "restore_group").ServeHTTP)
+		})
 		r = roleManagerHttp.EntityAvailableActionsRouter(svc, d, r, opts)
 
 		r.Route("/{groupID}", func(r chi.Router) {
 			r.Get("/", otelhttp.NewHandler(kithttp.NewServer(
 				ViewGroupEndpoint(svc),
-				DecodeGroupRequest,
+				DecodeViewGroupRequest,
 				api.EncodeResponse,
 				opts...,
 			), "view_group").ServeHTTP)
@@ -57,6 +74,13 @@ func MakeHandler(svc groups.Service, authn authn.Authentication, mux *chi.Mux, l
 				opts...,
 			), "update_group").ServeHTTP)
 
+			r.Patch("/", otelhttp.NewHandler(kithttp.NewServer(
+				UpdateGroupEndpoint(svc),
+				DecodeGroupUpdate,
+				api.EncodeResponse,
+				opts...,
+			), "patch_group").ServeHTTP)
+
 			r.Delete("/", otelhttp.NewHandler(kithttp.NewServer(
 				DeleteGroupEndpoint(svc),
 				DecodeGroupRequest,
@@ -136,6 +160,7 @@ func MakeHandler(svc groups.Service, authn authn.Authentication, mux *chi.Mux, l
 	})
 
 	mux.Get("/health", supermq.Health("groups", instanceID))
+	mux.Get("/api/openapi.json", apidocs.Handler("groups"))
 	mux.Handle("/metrics", promhttp.Handler())
 
 	return mux
@@ -0,0 +1,6 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package cache contains the domain concept definitions needed to
+// support SuperMQ groups cache service functionality.
+package cache
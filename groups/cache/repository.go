@@ -0,0 +1,116 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/absmach/supermq/groups"
+	"github.com/redis/go-redis/v9"
+)
+
+// entityPrefix prefixes cached group entities.
+const entityPrefix = "group_entity"
+
+var _ groups.Repository = (*repository)(nil)
+
+type repository struct {
+	groups.Repository
+	client redis.UniversalClient
+	ttl    time.Duration
+}
+
+// NewRepository returns a read-through cache decorator for the group
+// repository. RetrieveByID answers from Redis when possible, since view and
+// update flows call it on nearly every request. Writes going through the
+// decorator invalidate the cached entity; for writes that bypass it, the TTL
+// bounds staleness, so it should stay short.
+func NewRepository(repo groups.Repository, client redis.UniversalClient, ttl time.Duration) groups.Repository {
+	return &repository{
+		Repository: repo,
+		client:     client,
+		ttl:        ttl,
+	}
+}
+
+func entityKey(id string) string {
+	return fmt.Sprintf("%s:%s", entityPrefix, id)
+}
+
+func (r *repository) RetrieveByID(ctx context.Context, id string) (groups.Group, error) {
+	if data, err := r.client.Get(ctx, entityKey(id)).Bytes(); err == nil {
+		var g groups.Group
+		if err := json.Unmarshal(data, &g); err == nil {
+			return g, nil
+		}
+	}
+
+	g, err := r.Repository.RetrieveByID(ctx, id)
+	if err != nil {
+		return groups.Group{}, err
+	}
+
+	// Caching is best effort, so the entity is returned even if it could
+	// not be stored.
+	if data, err := json.Marshal(g); err == nil {
+		r.client.Set(ctx, entityKey(id), data, r.ttl)
+	}
+
+	return g, nil
+}
+
+func (r *repository) Update(ctx context.Context, g groups.Group) (groups.Group, error) {
+	group, err := r.Repository.Update(ctx, g)
+	if err != nil {
+		return groups.Group{}, err
+	}
+	r.invalidate(ctx, g.ID)
+	return group, nil
+}
+
+func (r *repository) ChangeStatus(ctx context.Context, g groups.Group) (groups.Group, error) {
+	group, err := r.Repository.ChangeStatus(ctx, g)
+	if err != nil {
+		return groups.Group{}, err
+	}
+	r.invalidate(ctx, g.ID)
+	return group, nil
+}
+
+func (r *repository) AssignParentGroup(ctx context.Context, parentGroupID string, groupIDs ...string) error {
+	if err := r.Repository.AssignParentGroup(ctx, parentGroupID, groupIDs...); err != nil {
+		return err
+	}
+	r.invalidate(ctx, groupIDs...)
+	return nil
+}
+
+func (r *repository) UnassignParentGroup(ctx context.Context, parentGroupID string, groupIDs ...string) error {
+	if err := r.Repository.UnassignParentGroup(ctx, parentGroupID, groupIDs...); err != nil {
+		return err
+	}
+	r.invalidate(ctx, groupIDs...)
+	return nil
+}
+
+func (r *repository) Delete(ctx context.Context, groupID string) error {
+	if err := r.Repository.Delete(ctx, groupID); err != nil {
+		return err
+	}
+	r.invalidate(ctx, groupID)
+	return nil
+}
+
+// invalidate drops cached entities. Invalidation is best effort: an entry
+// that could not be dropped expires after the TTL.
+func (r *repository) invalidate(ctx context.Context, ids ...string) {
+	keys := make([]string, 0, len(ids))
+	for _, id := range ids {
+		keys = append(keys, entityKey(id))
+	}
+	r.client.Del(ctx, keys...)
+}
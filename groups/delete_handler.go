@@ -0,0 +1,109 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// The DeleteHandler is a cron job that runs periodically to purge groups that
+// have been moved to the trash for longer than the retention period.
+// The handler runs in a separate goroutine and permanently deletes such groups
+// from the database together with their policies from the auth service.
+
+package groups
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/absmach/supermq/pkg/policies"
+)
+
+const defTrashLimit = uint64(100)
+
+type handler struct {
+	groups        Repository
+	policies      policies.Service
+	checkInterval time.Duration
+	deleteAfter   time.Duration
+	logger        *slog.Logger
+}
+
+func NewDeleteHandler(ctx context.Context, groups Repository, policyService policies.Service, defCheckInterval, deleteAfter time.Duration, logger *slog.Logger) {
+	handler := &handler{
+		groups:        groups,
+		policies:      policyService,
+		checkInterval: defCheckInterval,
+		deleteAfter:   deleteAfter,
+		logger:        logger,
+	}
+
+	go func() {
+		ticker := time.NewTicker(handler.checkInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				handler.handle(ctx)
+			}
+		}
+	}()
+}
+
+func (h *handler) handle(ctx context.Context) {
+	pm := PageMeta{Limit: defTrashLimit, Offset: 0, Status: DeletedStatus}
+
+	for {
+		page, err := h.groups.RetrieveAll(ctx, pm)
+		if err != nil {
+			h.logger.Error("failed to retrieve trashed groups", slog.Any("error", err))
+			return
+		}
+		if len(page.Groups) == 0 {
+			return
+		}
+
+		for _, g := range page.Groups {
+			if time.Since(g.UpdatedAt) < h.deleteAfter {
+				pm.Offset++
+				continue
+			}
+
+			filterDeletePolicies := []policies.Policy{
+				{
+					SubjectType: policies.GroupType,
+					Subject:     g.ID,
+				},
+				{
+					ObjectType: policies.GroupType,
+					Object:     g.ID,
+				},
+			}
+			if err := h.deletePolicies(ctx, filterDeletePolicies); err != nil {
+				h.logger.Error("failed to delete group policies", slog.Any("error", err))
+				pm.Offset++
+				continue
+			}
+
+			if err := h.groups.Delete(ctx, g.ID); err != nil {
+				h.logger.Error("failed to delete group", slog.Any("error", err))
+				pm.Offset++
+				continue
+			}
+
+			h.logger.Info("group permanently deleted", slog.Group("group",
+				slog.String("id", g.ID),
+				slog.String("name", g.Name),
+			))
+		}
+	}
+}
+
+func (h *handler) deletePolicies(ctx context.Context, prs []policies.Policy) error {
+	for _, pr := range prs {
+		if err := h.policies.DeletePolicyFilter(ctx, pr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -20,6 +20,7 @@ var (
 	groupList                    = groupPrefix + "list"
 	groupListUserGroups          = groupPrefix + "list_user_groups"
 	groupRemove                  = groupPrefix + "remove"
+	groupRestore                 = groupPrefix + "restore"
 	groupRetrieveGroupHierarchy  = groupPrefix + "retrieve_group_hierarchy"
 	groupAddParentGroup          = groupPrefix + "add_parent_group"
 	groupRemoveParentGroup       = groupPrefix + "remove_parent_group"
@@ -36,6 +37,7 @@ var (
 	_ events.Event = (*changeStatusGroupEvent)(nil)
 	_ events.Event = (*viewGroupEvent)(nil)
 	_ events.Event = (*deleteGroupEvent)(nil)
+	_ events.Event = (*restoreGroupEvent)(nil)
 	_ events.Event = (*viewGroupEvent)(nil)
 	_ events.Event = (*listGroupEvent)(nil)
 	_ events.Event = (*addParentGroupEvent)(nil)
@@ -245,6 +247,21 @@ func (luge listUserGroupEvent) Encode() (map[string]interface{}, error) {
 	return val, nil
 }
 
+type restoreGroupEvent struct {
+	id        string
+	updatedAt time.Time
+	updatedBy string
+}
+
+func (rge restoreGroupEvent) Encode() (map[string]interface{}, error) {
+	return map[string]interface{}{
+		"operation":  groupRestore,
+		"id":         rge.id,
+		"updated_at": rge.updatedAt,
+		"updated_by": rge.updatedBy,
+	}, nil
+}
+
 type deleteGroupEvent struct {
 	id string
 }
@@ -75,8 +75,8 @@ func (es eventStore) UpdateGroup(ctx context.Context, session authn.Session, gro
 	return group, nil
 }
 
-func (es eventStore) ViewGroup(ctx context.Context, session authn.Session, id string) (groups.Group, error) {
-	group, err := es.svc.ViewGroup(ctx, session, id)
+func (es eventStore) ViewGroup(ctx context.Context, session authn.Session, id string, listPerms, listCounts bool) (groups.Group, error) {
+	group, err := es.svc.ViewGroup(ctx, session, id, listPerms, listCounts)
 	if err != nil {
 		return group, err
 	}
@@ -133,8 +133,8 @@ func (es eventStore) EnableGroup(ctx context.Context, session authn.Session, id
 	return es.changeStatus(ctx, group)
 }
 
-func (es eventStore) DisableGroup(ctx context.Context, session authn.Session, id string) (groups.Group, error) {
-	group, err := es.svc.DisableGroup(ctx, session, id)
+func (es eventStore) DisableGroup(ctx context.Context, session authn.Session, id string, cascade bool) (groups.Group, error) {
+	group, err := es.svc.DisableGroup(ctx, session, id, cascade)
 	if err != nil {
 		return group, err
 	}
@@ -167,6 +167,29 @@ func (es eventStore) DeleteGroup(ctx context.Context, session authn.Session, id
 	return nil
 }
 
+func (es eventStore) ListTrash(ctx context.Context, session authn.Session, pm groups.PageMeta) (groups.Page, error) {
+	return es.svc.ListTrash(ctx, session, pm)
+}
+
+func (es eventStore) Restore(ctx context.Context, session authn.Session, id string) (groups.Group, error) {
+	group, err := es.svc.Restore(ctx, session, id)
+	if err != nil {
+		return group, err
+	}
+
+	event := restoreGroupEvent{
+		id:        group.ID,
+		updatedAt: group.UpdatedAt,
+		updatedBy: group.UpdatedBy,
+	}
+
+	if err := es.Publish(ctx, event); err != nil {
+		return group, err
+	}
+
+	return group, nil
+}
+
 func (es eventStore) RetrieveGroupHierarchy(ctx context.Context, session authn.Session, id string, hm groups.HierarchyPageMeta) (groups.HierarchyPage, error) {
 	g, err := es.svc.RetrieveGroupHierarchy(ctx, session, id, hm)
 	if err != nil {
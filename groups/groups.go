@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/absmach/supermq/pkg/authn"
+	"github.com/absmach/supermq/pkg/labels"
 	"github.com/absmach/supermq/pkg/roles"
 )
 
@@ -25,27 +26,39 @@ type Metadata map[string]interface{}
 // Path in a tree consisting of group IDs
 // Paths are unique per domain.
 type Group struct {
-	ID                        string    `json:"id"`
-	Domain                    string    `json:"domain_id,omitempty"`
-	Parent                    string    `json:"parent_id,omitempty"`
-	Name                      string    `json:"name"`
-	Description               string    `json:"description,omitempty"`
-	Metadata                  Metadata  `json:"metadata,omitempty"`
-	Level                     int       `json:"level,omitempty"`
-	Path                      string    `json:"path,omitempty"`
-	Children                  []*Group  `json:"children,omitempty"`
-	CreatedAt                 time.Time `json:"created_at"`
-	UpdatedAt                 time.Time `json:"updated_at,omitempty"`
-	UpdatedBy                 string    `json:"updated_by,omitempty"`
-	Status                    Status    `json:"status"`
-	RoleID                    string    `json:"role_id,omitempty"`
-	RoleName                  string    `json:"role_name,omitempty"`
-	Actions                   []string  `json:"actions,omitempty"`
-	AccessType                string    `json:"access_type,omitempty"`
-	AccessProviderId          string    `json:"access_provider_id,omitempty"`
-	AccessProviderRoleId      string    `json:"access_provider_role_id,omitempty"`
-	AccessProviderRoleName    string    `json:"access_provider_role_name,omitempty"`
-	AccessProviderRoleActions []string  `json:"access_provider_role_actions,omitempty"`
+	ID                        string        `json:"id"`
+	Domain                    string        `json:"domain_id,omitempty"`
+	Parent                    string        `json:"parent_id,omitempty"`
+	Name                      string        `json:"name"`
+	Description               string        `json:"description,omitempty"`
+	Metadata                  Metadata      `json:"metadata,omitempty"`
+	Labels                    labels.Labels `json:"labels,omitempty"`
+	Level                     int           `json:"level,omitempty"`
+	Path                      string        `json:"path,omitempty"`
+	Children                  []*Group      `json:"children,omitempty"`
+	CreatedAt                 time.Time     `json:"created_at"`
+	UpdatedAt                 time.Time     `json:"updated_at,omitempty"`
+	UpdatedBy                 string        `json:"updated_by,omitempty"`
+	Status                    Status        `json:"status"`
+	Version                   uint64        `json:"version,omitempty"`
+	RoleID                    string        `json:"role_id,omitempty"`
+	RoleName                  string        `json:"role_name,omitempty"`
+	Actions                   []string      `json:"actions,omitempty"`
+	AccessType                string        `json:"access_type,omitempty"`
+	AccessProviderId          string        `json:"access_provider_id,omitempty"`
+	AccessProviderRoleId      string        `json:"access_provider_role_id,omitempty"`
+	AccessProviderRoleName    string        `json:"access_provider_role_name,omitempty"`
+	AccessProviderRoleActions []string      `json:"access_provider_role_actions,omitempty"`
+
+	// MemberCounts and Permissions are populated on demand when viewing a group.
+	MemberCounts *MemberCounts `json:"member_counts,omitempty"`
+	Permissions  []string      `json:"permissions,omitempty"`
+}
+
+// MemberCounts summarizes group membership per member kind.
+type MemberCounts struct {
+	Users  uint64 `json:"users"`
+	Groups uint64 `json:"groups"`
 }
 
 type Member struct {
@@ -107,6 +120,11 @@ type Repository interface {
 	// ChangeStatus changes groups status to active or inactive
 	ChangeStatus(ctx context.Context, group Group) (Group, error)
 
+	// ChangeStatusWithDescendants changes the group status together with its
+	// descendant groups. Disabling marks the descendants it disables, so that
+	// enabling the group again restores only those.
+	ChangeStatusWithDescendants(ctx context.Context, group Group) (Group, error)
+
 	// AssignParentGroup assigns parent group id to a given group id
 	AssignParentGroup(ctx context.Context, parentGroupID string, groupIDs ...string) error
 
@@ -115,7 +133,10 @@ type Repository interface {
 
 	UnassignAllChildrenGroups(ctx context.Context, id string) error
 
-	RetrieveUserGroups(ctx context.Context, domainID, userID string, pm PageMeta) (Page, error)
+	RetrieveUserGroups(ctx context.Context, domainID, userID string, pm PageMeta, ids ...string) (Page, error)
+
+	// RetrieveMemberCounts retrieves membership counts of the group per member kind.
+	RetrieveMemberCounts(ctx context.Context, id string) (MemberCounts, error)
 
 	// RetrieveChildrenGroups at given level in ltree
 	// Condition: startLevel == 0 and endLevel < 0, Retrieve all children groups from parent group level, Example: If we pass startLevel 0 and endLevel -1, then function will return all children of parent group
@@ -139,23 +160,35 @@ type Service interface {
 	// UpdateGroup updates the group identified by the provided ID.
 	UpdateGroup(ctx context.Context, session authn.Session, g Group) (Group, error)
 
-	// ViewGroup retrieves data about the group identified by ID.
-	ViewGroup(ctx context.Context, session authn.Session, id string) (Group, error)
+	// ViewGroup retrieves data about the group identified by ID. It can
+	// additionally report the caller's effective permissions on the group
+	// and membership counts per member kind.
+	ViewGroup(ctx context.Context, session authn.Session, id string, listPerms, listCounts bool) (Group, error)
 
 	// ListGroups retrieves
 	ListGroups(ctx context.Context, session authn.Session, pm PageMeta) (Page, error)
 
 	ListUserGroups(ctx context.Context, session authn.Session, userID string, pm PageMeta) (Page, error)
 
-	// EnableGroup logically enables the group identified with the provided ID.
+	// EnableGroup logically enables the group identified with the provided ID,
+	// restoring descendant groups that were disabled together with it.
 	EnableGroup(ctx context.Context, session authn.Session, id string) (Group, error)
 
 	// DisableGroup logically disables the group identified with the provided ID.
-	DisableGroup(ctx context.Context, session authn.Session, id string) (Group, error)
+	// If cascade is true, its descendant groups are disabled as well.
+	DisableGroup(ctx context.Context, session authn.Session, id string, cascade bool) (Group, error)
 
-	// DeleteGroup delete the given group id
+	// DeleteGroup moves the group with the given id to the trash. Trashed
+	// groups are purged permanently once the retention period expires.
 	DeleteGroup(ctx context.Context, session authn.Session, id string) error
 
+	// ListTrash retrieves groups in the trash awaiting permanent deletion.
+	ListTrash(ctx context.Context, session authn.Session, pm PageMeta) (Page, error)
+
+	// Restore moves the group with the given id out of the trash back to the
+	// enabled state.
+	Restore(ctx context.Context, session authn.Session, id string) (Group, error)
+
 	RetrieveGroupHierarchy(ctx context.Context, session authn.Session, id string, hm HierarchyPageMeta) (HierarchyPage, error)
 
 	AddParentGroup(ctx context.Context, session authn.Session, id, parentID string) error
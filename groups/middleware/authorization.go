@@ -25,6 +25,8 @@ var (
 	errEnable                      = errors.New("not authorized to enable group")
 	errDisable                     = errors.New("not authorized to disable group")
 	errDelete                      = errors.New("not authorized to delete group")
+	errListTrash                   = errors.New("not authorized to list groups in trash")
+	errRestore                     = errors.New("not authorized to restore group")
 	errViewHierarchy               = errors.New("not authorized to view group parent/children hierarchy")
 	errListChildrenGroups          = errors.New("not authorized to view chidden groups of group")
 	errSetParentGroup              = errors.New("not authorized to set parent group to group")
@@ -36,6 +38,7 @@ var (
 	errChildGroupSetParentGroup    = errors.New("not authorized to set parent group to child group")
 	errDomainCreateGroups          = errors.New("not authorized to create groups in domain")
 	errDomainListGroups            = errors.New("not authorized to list groups in domain")
+	errGroupDisabled               = errors.New("group is disabled")
 )
 
 var _ groups.Service = (*authorizationMiddleware)(nil)
@@ -73,6 +76,7 @@ func AuthorizationMiddleware(entityType string, svc groups.Service, repo groups.
 	}
 	return &authorizationMiddleware{
 		svc:                                svc,
+		repo:                               repo,
 		authz:                              authz,
 		opp:                                opp,
 		extOpp:                             extOpp,
@@ -109,7 +113,7 @@ func (am *authorizationMiddleware) CreateGroup(ctx context.Context, session auth
 }
 
 func (am *authorizationMiddleware) UpdateGroup(ctx context.Context, session authn.Session, g groups.Group) (groups.Group, error) {
-	if err := am.authorize(ctx, groups.OpUpdateGroup, smqauthz.PolicyReq{
+	if err := am.authorizeActive(ctx, groups.OpUpdateGroup, smqauthz.PolicyReq{
 		Domain:      session.DomainID,
 		SubjectType: policies.UserType,
 		SubjectKind: policies.UsersKind,
@@ -123,7 +127,7 @@ func (am *authorizationMiddleware) UpdateGroup(ctx context.Context, session auth
 	return am.svc.UpdateGroup(ctx, session, g)
 }
 
-func (am *authorizationMiddleware) ViewGroup(ctx context.Context, session authn.Session, id string) (groups.Group, error) {
+func (am *authorizationMiddleware) ViewGroup(ctx context.Context, session authn.Session, id string, listPerms, listCounts bool) (groups.Group, error) {
 	if err := am.authorize(ctx, groups.OpViewGroup, smqauthz.PolicyReq{
 		Domain:      session.DomainID,
 		SubjectType: policies.UserType,
@@ -135,7 +139,7 @@ func (am *authorizationMiddleware) ViewGroup(ctx context.Context, session authn.
 		return groups.Group{}, errors.Wrap(errView, err)
 	}
 
-	return am.svc.ViewGroup(ctx, session, id)
+	return am.svc.ViewGroup(ctx, session, id, listPerms, listCounts)
 }
 
 func (am *authorizationMiddleware) ListGroups(ctx context.Context, session authn.Session, gm groups.PageMeta) (groups.Page, error) {
@@ -190,7 +194,7 @@ func (am *authorizationMiddleware) EnableGroup(ctx context.Context, session auth
 	return am.svc.EnableGroup(ctx, session, id)
 }
 
-func (am *authorizationMiddleware) DisableGroup(ctx context.Context, session authn.Session, id string) (groups.Group, error) {
+func (am *authorizationMiddleware) DisableGroup(ctx context.Context, session authn.Session, id string, cascade bool) (groups.Group, error) {
 	if err := am.authorize(ctx, groups.OpDisableGroup, smqauthz.PolicyReq{
 		Domain:      session.DomainID,
 		SubjectType: policies.UserType,
@@ -201,7 +205,7 @@ func (am *authorizationMiddleware) DisableGroup(ctx context.Context, session aut
 		return groups.Group{}, errors.Wrap(errDisable, err)
 	}
 
-	return am.svc.DisableGroup(ctx, session, id)
+	return am.svc.DisableGroup(ctx, session, id, cascade)
 }
 
 func (am *authorizationMiddleware) DeleteGroup(ctx context.Context, session authn.Session, id string) error {
@@ -218,8 +222,36 @@ func (am *authorizationMiddleware) DeleteGroup(ctx context.Context, session auth
 	return am.svc.DeleteGroup(ctx, session, id)
 }
 
+func (am *authorizationMiddleware) ListTrash(ctx context.Context, session authn.Session, pm groups.PageMeta) (groups.Page, error) {
+	if err := am.extAuthorize(ctx, groups.DomainOpListTrash, smqauthz.PolicyReq{
+		Domain:      session.DomainID,
+		SubjectType: policies.UserType,
+		Subject:     session.DomainUserID,
+		Object:      session.DomainID,
+		ObjectType:  policies.DomainType,
+	}); err != nil {
+		return groups.Page{}, errors.Wrap(errListTrash, err)
+	}
+
+	return am.svc.ListTrash(ctx, session, pm)
+}
+
+func (am *authorizationMiddleware) Restore(ctx context.Context, session authn.Session, id string) (groups.Group, error) {
+	if err := am.extAuthorize(ctx, groups.DomainOpRestoreGroup, smqauthz.PolicyReq{
+		Domain:      session.DomainID,
+		SubjectType: policies.UserType,
+		Subject:     session.DomainUserID,
+		Object:      session.DomainID,
+		ObjectType:  policies.DomainType,
+	}); err != nil {
+		return groups.Group{}, errors.Wrap(errRestore, err)
+	}
+
+	return am.svc.Restore(ctx, session, id)
+}
+
 func (am *authorizationMiddleware) RetrieveGroupHierarchy(ctx context.Context, session authn.Session, id string, hm groups.HierarchyPageMeta) (groups.HierarchyPage, error) {
-	if err := am.authorize(ctx, groups.OpRetrieveGroupHierarchy, smqauthz.PolicyReq{
+	if err := am.authorizeActive(ctx, groups.OpRetrieveGroupHierarchy, smqauthz.PolicyReq{
 		Domain:      session.DomainID,
 		SubjectType: policies.UserType,
 		Subject:     session.DomainUserID,
@@ -285,7 +317,7 @@ func (am *authorizationMiddleware) RemoveParentGroup(ctx context.Context, sessio
 }
 
 func (am *authorizationMiddleware) AddChildrenGroups(ctx context.Context, session authn.Session, id string, childrenGroupIDs []string) error {
-	if err := am.authorize(ctx, groups.OpAddChildrenGroups, smqauthz.PolicyReq{
+	if err := am.authorizeActive(ctx, groups.OpAddChildrenGroups, smqauthz.PolicyReq{
 		Domain:      session.DomainID,
 		SubjectType: policies.UserType,
 		Subject:     session.DomainUserID,
@@ -339,7 +371,7 @@ func (am *authorizationMiddleware) RemoveAllChildrenGroups(ctx context.Context,
 }
 
 func (am *authorizationMiddleware) ListChildrenGroups(ctx context.Context, session authn.Session, id string, startLevel, endLevel int64, pm groups.PageMeta) (groups.Page, error) {
-	if err := am.authorize(ctx, groups.OpListChildrenGroups, smqauthz.PolicyReq{
+	if err := am.authorizeActive(ctx, groups.OpListChildrenGroups, smqauthz.PolicyReq{
 		Domain:      session.DomainID,
 		SubjectType: policies.UserType,
 		Subject:     session.DomainUserID,
@@ -378,6 +410,23 @@ func (am *authorizationMiddleware) authorize(ctx context.Context, op svcutil.Ope
 	return nil
 }
 
+// authorizeActive is a status-aware authorize used by member operations: on
+// top of the policy check it rejects groups that are disabled, directly or
+// through a cascading disable of an ancestor.
+func (am *authorizationMiddleware) authorizeActive(ctx context.Context, op svcutil.Operation, pr authz.PolicyReq) error {
+	if err := am.authorize(ctx, op, pr); err != nil {
+		return err
+	}
+	g, err := am.repo.RetrieveByID(ctx, pr.Object)
+	if err != nil {
+		return errors.Wrap(svcerr.ErrAuthorization, err)
+	}
+	if g.Status == groups.DisabledStatus {
+		return errors.Wrap(svcerr.ErrAuthorization, errGroupDisabled)
+	}
+	return nil
+}
+
 func (am *authorizationMiddleware) extAuthorize(ctx context.Context, extOp svcutil.ExternalOperation, req authz.PolicyReq) error {
 	perm, err := am.extOpp.GetPermission(extOp)
 	if err != nil {
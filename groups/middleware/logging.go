@@ -40,10 +40,10 @@ func (lm *loggingMiddleware) CreateGroup(ctx context.Context, session authn.Sess
 		}
 		if err != nil {
 			args = append(args, slog.String("error", err.Error()))
-			lm.logger.Warn("Create group failed", args...)
+			lm.logger.WarnContext(ctx, "Create group failed", args...)
 			return
 		}
-		lm.logger.Info("Create group completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Create group completed successfully", args...)
 	}(time.Now())
 	return lm.svc.CreateGroup(ctx, session, group)
 }
@@ -62,17 +62,17 @@ func (lm *loggingMiddleware) UpdateGroup(ctx context.Context, session authn.Sess
 		}
 		if err != nil {
 			args = append(args, slog.String("error", err.Error()))
-			lm.logger.Warn("Update group failed", args...)
+			lm.logger.WarnContext(ctx, "Update group failed", args...)
 			return
 		}
-		lm.logger.Info("Update group completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Update group completed successfully", args...)
 	}(time.Now())
 	return lm.svc.UpdateGroup(ctx, session, group)
 }
 
 // ViewGroup logs the view_group request. It logs the group name, id and the time it took to complete the request.
 // If the request fails, it logs the error.
-func (lm *loggingMiddleware) ViewGroup(ctx context.Context, session authn.Session, id string) (g groups.Group, err error) {
+func (lm *loggingMiddleware) ViewGroup(ctx context.Context, session authn.Session, id string, listPerms, listCounts bool) (g groups.Group, err error) {
 	defer func(begin time.Time) {
 		args := []any{
 			slog.String("duration", time.Since(begin).String()),
@@ -83,12 +83,12 @@ func (lm *loggingMiddleware) ViewGroup(ctx context.Context, session authn.Sessio
 		}
 		if err != nil {
 			args = append(args, slog.String("error", err.Error()))
-			lm.logger.Warn("View group failed", args...)
+			lm.logger.WarnContext(ctx, "View group failed", args...)
 			return
 		}
-		lm.logger.Info("View group completed successfully", args...)
+		lm.logger.InfoContext(ctx, "View group completed successfully", args...)
 	}(time.Now())
-	return lm.svc.ViewGroup(ctx, session, id)
+	return lm.svc.ViewGroup(ctx, session, id, listPerms, listCounts)
 }
 
 // ListGroups logs the list_groups request. It logs the page metadata and the time it took to complete the request.
@@ -105,10 +105,10 @@ func (lm *loggingMiddleware) ListGroups(ctx context.Context, session authn.Sessi
 		}
 		if err != nil {
 			args = append(args, slog.String("error", err.Error()))
-			lm.logger.Warn("List groups failed", args...)
+			lm.logger.WarnContext(ctx, "List groups failed", args...)
 			return
 		}
-		lm.logger.Info("List groups completed successfully", args...)
+		lm.logger.InfoContext(ctx, "List groups completed successfully", args...)
 	}(time.Now())
 	return lm.svc.ListGroups(ctx, session, pm)
 }
@@ -126,10 +126,10 @@ func (lm *loggingMiddleware) ListUserGroups(ctx context.Context, session authn.S
 		}
 		if err != nil {
 			args = append(args, slog.String("error", err.Error()))
-			lm.logger.Warn("List user groups failed", args...)
+			lm.logger.WarnContext(ctx, "List user groups failed", args...)
 			return
 		}
-		lm.logger.Info("List user groups completed successfully", args...)
+		lm.logger.InfoContext(ctx, "List user groups completed successfully", args...)
 	}(time.Now())
 	return lm.svc.ListUserGroups(ctx, session, userID, pm)
 }
@@ -147,17 +147,17 @@ func (lm *loggingMiddleware) EnableGroup(ctx context.Context, session authn.Sess
 		}
 		if err != nil {
 			args = append(args, slog.String("error", err.Error()))
-			lm.logger.Warn("Enable group failed", args...)
+			lm.logger.WarnContext(ctx, "Enable group failed", args...)
 			return
 		}
-		lm.logger.Info("Enable group completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Enable group completed successfully", args...)
 	}(time.Now())
 	return lm.svc.EnableGroup(ctx, session, id)
 }
 
 // DisableGroup logs the disable_group request. It logs the group id and the time it took to complete the request.
 // If the request fails, it logs the error.
-func (lm *loggingMiddleware) DisableGroup(ctx context.Context, session authn.Session, id string) (g groups.Group, err error) {
+func (lm *loggingMiddleware) DisableGroup(ctx context.Context, session authn.Session, id string, cascade bool) (g groups.Group, err error) {
 	defer func(begin time.Time) {
 		args := []any{
 			slog.String("duration", time.Since(begin).String()),
@@ -165,15 +165,16 @@ func (lm *loggingMiddleware) DisableGroup(ctx context.Context, session authn.Ses
 				slog.String("id", id),
 				slog.String("name", g.Name),
 			),
+			slog.Bool("cascade", cascade),
 		}
 		if err != nil {
 			args = append(args, slog.String("error", err.Error()))
-			lm.logger.Warn("Disable group failed", args...)
+			lm.logger.WarnContext(ctx, "Disable group failed", args...)
 			return
 		}
-		lm.logger.Info("Disable group completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Disable group completed successfully", args...)
 	}(time.Now())
-	return lm.svc.DisableGroup(ctx, session, id)
+	return lm.svc.DisableGroup(ctx, session, id, cascade)
 }
 
 func (lm *loggingMiddleware) DeleteGroup(ctx context.Context, session authn.Session, id string) (err error) {
@@ -184,14 +185,53 @@ func (lm *loggingMiddleware) DeleteGroup(ctx context.Context, session authn.Sess
 		}
 		if err != nil {
 			args = append(args, slog.String("error", err.Error()))
-			lm.logger.Warn("Delete group failed", args...)
+			lm.logger.WarnContext(ctx, "Delete group failed", args...)
 			return
 		}
-		lm.logger.Info("Delete group completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Delete group completed successfully", args...)
 	}(time.Now())
 	return lm.svc.DeleteGroup(ctx, session, id)
 }
 
+func (lm *loggingMiddleware) ListTrash(ctx context.Context, session authn.Session, pm groups.PageMeta) (gp groups.Page, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.Group("page",
+				slog.Uint64("offset", pm.Offset),
+				slog.Uint64("limit", pm.Limit),
+				slog.Uint64("total", gp.Total),
+			),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.WarnContext(ctx, "List groups in trash failed", args...)
+			return
+		}
+		lm.logger.InfoContext(ctx, "List groups in trash completed successfully", args...)
+	}(time.Now())
+	return lm.svc.ListTrash(ctx, session, pm)
+}
+
+func (lm *loggingMiddleware) Restore(ctx context.Context, session authn.Session, id string) (g groups.Group, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.Group("group",
+				slog.String("id", id),
+				slog.String("name", g.Name),
+			),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.WarnContext(ctx, "Restore group failed", args...)
+			return
+		}
+		lm.logger.InfoContext(ctx, "Restore group completed successfully", args...)
+	}(time.Now())
+	return lm.svc.Restore(ctx, session, id)
+}
+
 func (lm *loggingMiddleware) RetrieveGroupHierarchy(ctx context.Context, session authn.Session, id string, hm groups.HierarchyPageMeta) (gp groups.HierarchyPage, err error) {
 	defer func(begin time.Time) {
 		args := []any{
@@ -205,10 +245,10 @@ func (lm *loggingMiddleware) RetrieveGroupHierarchy(ctx context.Context, session
 		}
 		if err != nil {
 			args = append(args, slog.String("error", err.Error()))
-			lm.logger.Warn("Retrieve group hierarchy failed", args...)
+			lm.logger.WarnContext(ctx, "Retrieve group hierarchy failed", args...)
 			return
 		}
-		lm.logger.Info("Retrieve group hierarchy completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Retrieve group hierarchy completed successfully", args...)
 	}(time.Now())
 	return lm.svc.RetrieveGroupHierarchy(ctx, session, id, hm)
 }
@@ -222,10 +262,10 @@ func (lm *loggingMiddleware) AddParentGroup(ctx context.Context, session authn.S
 		}
 		if err != nil {
 			args = append(args, slog.String("error", err.Error()))
-			lm.logger.Warn("Add parent group failed", args...)
+			lm.logger.WarnContext(ctx, "Add parent group failed", args...)
 			return
 		}
-		lm.logger.Info("Add parent group completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Add parent group completed successfully", args...)
 	}(time.Now())
 	return lm.svc.AddParentGroup(ctx, session, id, parentID)
 }
@@ -238,10 +278,10 @@ func (lm *loggingMiddleware) RemoveParentGroup(ctx context.Context, session auth
 		}
 		if err != nil {
 			args = append(args, slog.String("error", err.Error()))
-			lm.logger.Warn("Remove parent group failed", args...)
+			lm.logger.WarnContext(ctx, "Remove parent group failed", args...)
 			return
 		}
-		lm.logger.Info("Remove parent group completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Remove parent group completed successfully", args...)
 	}(time.Now())
 	return lm.svc.RemoveParentGroup(ctx, session, id)
 }
@@ -255,10 +295,10 @@ func (lm *loggingMiddleware) AddChildrenGroups(ctx context.Context, session auth
 		}
 		if err != nil {
 			args = append(args, slog.String("error", err.Error()))
-			lm.logger.Warn("Add children groups failed", args...)
+			lm.logger.WarnContext(ctx, "Add children groups failed", args...)
 			return
 		}
-		lm.logger.Info("Add parent group completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Add parent group completed successfully", args...)
 	}(time.Now())
 	return lm.svc.AddChildrenGroups(ctx, session, id, childrenGroupIDs)
 }
@@ -272,10 +312,10 @@ func (lm *loggingMiddleware) RemoveChildrenGroups(ctx context.Context, session a
 		}
 		if err != nil {
 			args = append(args, slog.String("error", err.Error()))
-			lm.logger.Warn("Remove children groups failed", args...)
+			lm.logger.WarnContext(ctx, "Remove children groups failed", args...)
 			return
 		}
-		lm.logger.Info("Remove parent group completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Remove parent group completed successfully", args...)
 	}(time.Now())
 	return lm.svc.RemoveChildrenGroups(ctx, session, id, childrenGroupIDs)
 }
@@ -288,10 +328,10 @@ func (lm *loggingMiddleware) RemoveAllChildrenGroups(ctx context.Context, sessio
 		}
 		if err != nil {
 			args = append(args, slog.String("error", err.Error()))
-			lm.logger.Warn("Remove all children groups failed", args...)
+			lm.logger.WarnContext(ctx, "Remove all children groups failed", args...)
 			return
 		}
-		lm.logger.Info("Remove all parent group completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Remove all parent group completed successfully", args...)
 	}(time.Now())
 	return lm.svc.RemoveAllChildrenGroups(ctx, session, id)
 }
@@ -309,10 +349,10 @@ func (lm *loggingMiddleware) ListChildrenGroups(ctx context.Context, session aut
 		}
 		if err != nil {
 			args = append(args, slog.String("error", err.Error()))
-			lm.logger.Warn("List children groups failed", args...)
+			lm.logger.WarnContext(ctx, "List children groups failed", args...)
 			return
 		}
-		lm.logger.Info("List children groups completed successfully", args...)
+		lm.logger.InfoContext(ctx, "List children groups completed successfully", args...)
 	}(time.Now())
 	return lm.svc.ListChildrenGroups(ctx, session, id, startLevel, endLevel, pm)
 }
@@ -9,6 +9,7 @@ import (
 
 	"github.com/absmach/supermq/groups"
 	"github.com/absmach/supermq/pkg/authn"
+	"github.com/absmach/supermq/pkg/prometheus"
 	"github.com/absmach/supermq/pkg/roles"
 	rmMW "github.com/absmach/supermq/pkg/roles/rolemanager/middleware"
 	"github.com/go-kit/kit/metrics"
@@ -35,9 +36,9 @@ func MetricsMiddleware(svc groups.Service, counter metrics.Counter, latency metr
 }
 
 // CreateGroup instruments CreateGroup method with metrics.
-func (ms *metricsMiddleware) CreateGroup(ctx context.Context, session authn.Session, g groups.Group) (groups.Group, []roles.RoleProvision, error) {
+func (ms *metricsMiddleware) CreateGroup(ctx context.Context, session authn.Session, g groups.Group) (gr groups.Group, rps []roles.RoleProvision, err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "create_group").Add(1)
+		ms.counter.With("method", "create_group", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "create_group").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.CreateGroup(ctx, session, g)
@@ -46,25 +47,25 @@ func (ms *metricsMiddleware) CreateGroup(ctx context.Context, session authn.Sess
 // UpdateGroup instruments UpdateGroup method with metrics.
 func (ms *metricsMiddleware) UpdateGroup(ctx context.Context, session authn.Session, group groups.Group) (rGroup groups.Group, err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "update_group").Add(1)
+		ms.counter.With("method", "update_group", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "update_group").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.UpdateGroup(ctx, session, group)
 }
 
 // ViewGroup instruments ViewGroup method with metrics.
-func (ms *metricsMiddleware) ViewGroup(ctx context.Context, session authn.Session, id string) (g groups.Group, err error) {
+func (ms *metricsMiddleware) ViewGroup(ctx context.Context, session authn.Session, id string, listPerms, listCounts bool) (g groups.Group, err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "view_group").Add(1)
+		ms.counter.With("method", "view_group", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "view_group").Observe(time.Since(begin).Seconds())
 	}(time.Now())
-	return ms.svc.ViewGroup(ctx, session, id)
+	return ms.svc.ViewGroup(ctx, session, id, listPerms, listCounts)
 }
 
 // ListGroups instruments ListGroups method with metrics.
 func (ms *metricsMiddleware) ListGroups(ctx context.Context, session authn.Session, pm groups.PageMeta) (cg groups.Page, err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "list_groups").Add(1)
+		ms.counter.With("method", "list_groups", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "list_groups").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.ListGroups(ctx, session, pm)
@@ -72,7 +73,7 @@ func (ms *metricsMiddleware) ListGroups(ctx context.Context, session authn.Sessi
 
 func (ms *metricsMiddleware) ListUserGroups(ctx context.Context, session authn.Session, userID string, pm groups.PageMeta) (cg groups.Page, err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "list_user_groups").Add(1)
+		ms.counter.With("method", "list_user_groups", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "list_user_groups").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.ListUserGroups(ctx, session, userID, pm)
@@ -81,80 +82,96 @@ func (ms *metricsMiddleware) ListUserGroups(ctx context.Context, session authn.S
 // EnableGroup instruments EnableGroup method with metrics.
 func (ms *metricsMiddleware) EnableGroup(ctx context.Context, session authn.Session, id string) (g groups.Group, err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "enable_group").Add(1)
+		ms.counter.With("method", "enable_group", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "enable_group").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.EnableGroup(ctx, session, id)
 }
 
 // DisableGroup instruments DisableGroup method with metrics.
-func (ms *metricsMiddleware) DisableGroup(ctx context.Context, session authn.Session, id string) (g groups.Group, err error) {
+func (ms *metricsMiddleware) DisableGroup(ctx context.Context, session authn.Session, id string, cascade bool) (g groups.Group, err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "disable_group").Add(1)
+		ms.counter.With("method", "disable_group", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "disable_group").Observe(time.Since(begin).Seconds())
 	}(time.Now())
-	return ms.svc.DisableGroup(ctx, session, id)
+	return ms.svc.DisableGroup(ctx, session, id, cascade)
 }
 
 func (ms *metricsMiddleware) DeleteGroup(ctx context.Context, session authn.Session, id string) (err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "delete_group").Add(1)
+		ms.counter.With("method", "delete_group", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "delete_group").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.DeleteGroup(ctx, session, id)
 }
 
-func (ms *metricsMiddleware) RetrieveGroupHierarchy(ctx context.Context, session authn.Session, id string, hm groups.HierarchyPageMeta) (groups.HierarchyPage, error) {
+func (ms *metricsMiddleware) ListTrash(ctx context.Context, session authn.Session, pm groups.PageMeta) (gp groups.Page, err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "list_parent_groups").Add(1)
+		ms.counter.With("method", "list_group_trash", "error", prometheus.ErrorClass(err)).Add(1)
+		ms.latency.With("method", "list_group_trash").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+	return ms.svc.ListTrash(ctx, session, pm)
+}
+
+func (ms *metricsMiddleware) Restore(ctx context.Context, session authn.Session, id string) (g groups.Group, err error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "restore_group", "error", prometheus.ErrorClass(err)).Add(1)
+		ms.latency.With("method", "restore_group").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+	return ms.svc.Restore(ctx, session, id)
+}
+
+func (ms *metricsMiddleware) RetrieveGroupHierarchy(ctx context.Context, session authn.Session, id string, hm groups.HierarchyPageMeta) (gp groups.HierarchyPage, err error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "list_parent_groups", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "list_parent_groups").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.RetrieveGroupHierarchy(ctx, session, id, hm)
 }
 
-func (ms *metricsMiddleware) AddParentGroup(ctx context.Context, session authn.Session, id, parentID string) error {
+func (ms *metricsMiddleware) AddParentGroup(ctx context.Context, session authn.Session, id, parentID string) (err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "add_parent_group").Add(1)
+		ms.counter.With("method", "add_parent_group", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "add_parent_group").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.AddParentGroup(ctx, session, id, parentID)
 }
 
-func (ms *metricsMiddleware) RemoveParentGroup(ctx context.Context, session authn.Session, id string) error {
+func (ms *metricsMiddleware) RemoveParentGroup(ctx context.Context, session authn.Session, id string) (err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "remove_parent_group").Add(1)
+		ms.counter.With("method", "remove_parent_group", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "remove_parent_group").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.RemoveParentGroup(ctx, session, id)
 }
 
-func (ms *metricsMiddleware) AddChildrenGroups(ctx context.Context, session authn.Session, id string, childrenGroupIDs []string) error {
+func (ms *metricsMiddleware) AddChildrenGroups(ctx context.Context, session authn.Session, id string, childrenGroupIDs []string) (err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "add_children_groups").Add(1)
+		ms.counter.With("method", "add_children_groups", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "add_children_groups").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.AddChildrenGroups(ctx, session, id, childrenGroupIDs)
 }
 
-func (ms *metricsMiddleware) RemoveChildrenGroups(ctx context.Context, session authn.Session, id string, childrenGroupIDs []string) error {
+func (ms *metricsMiddleware) RemoveChildrenGroups(ctx context.Context, session authn.Session, id string, childrenGroupIDs []string) (err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "remove_children_groups").Add(1)
+		ms.counter.With("method", "remove_children_groups", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "remove_children_groups").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.RemoveChildrenGroups(ctx, session, id, childrenGroupIDs)
 }
 
-func (ms *metricsMiddleware) RemoveAllChildrenGroups(ctx context.Context, session authn.Session, id string) error {
+func (ms *metricsMiddleware) RemoveAllChildrenGroups(ctx context.Context, session authn.Session, id string) (err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "remove_all_children_groups").Add(1)
+		ms.counter.With("method", "remove_all_children_groups", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "remove_all_children_groups").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.RemoveAllChildrenGroups(ctx, session, id)
 }
 
-func (ms *metricsMiddleware) ListChildrenGroups(ctx context.Context, session authn.Session, id string, startLevel, endLevel int64, pm groups.PageMeta) (groups.Page, error) {
+func (ms *metricsMiddleware) ListChildrenGroups(ctx context.Context, session authn.Session, id string, startLevel, endLevel int64, pm groups.PageMeta) (gp groups.Page, err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "list_children_groups").Add(1)
+		ms.counter.With("method", "list_children_groups", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "list_children_groups").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.ListChildrenGroups(ctx, session, id, startLevel, endLevel, pm)
@@ -11,6 +11,8 @@ import (
 
 	grpc "google.golang.org/grpc"
 
+	groupsv1 "github.com/absmach/supermq/api/grpc/groups/v1"
+
 	mock "github.com/stretchr/testify/mock"
 
 	v1 "github.com/absmach/supermq/api/grpc/common/v1"
@@ -29,6 +31,228 @@ func (_m *GroupsServiceClient) EXPECT() *GroupsServiceClient_Expecter {
 	return &GroupsServiceClient_Expecter{mock: &_m.Mock}
 }
 
+// CreateGroup provides a mock function with given fields: ctx, in, opts
+func (_m *GroupsServiceClient) CreateGroup(ctx context.Context, in *groupsv1.CreateGroupReq, opts ...grpc.CallOption) (*groupsv1.GroupRes, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateGroup")
+	}
+
+	var r0 *groupsv1.GroupRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *groupsv1.CreateGroupReq, ...grpc.CallOption) (*groupsv1.GroupRes, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *groupsv1.CreateGroupReq, ...grpc.CallOption) *groupsv1.GroupRes); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*groupsv1.GroupRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *groupsv1.CreateGroupReq, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GroupsServiceClient_CreateGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateGroup'
+type GroupsServiceClient_CreateGroup_Call struct {
+	*mock.Call
+}
+
+// CreateGroup is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *groupsv1.CreateGroupReq
+//   - opts ...grpc.CallOption
+func (_e *GroupsServiceClient_Expecter) CreateGroup(ctx interface{}, in interface{}, opts ...interface{}) *GroupsServiceClient_CreateGroup_Call {
+	return &GroupsServiceClient_CreateGroup_Call{Call: _e.mock.On("CreateGroup",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *GroupsServiceClient_CreateGroup_Call) Run(run func(ctx context.Context, in *groupsv1.CreateGroupReq, opts ...grpc.CallOption)) *GroupsServiceClient_CreateGroup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*groupsv1.CreateGroupReq), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *GroupsServiceClient_CreateGroup_Call) Return(_a0 *groupsv1.GroupRes, _a1 error) *GroupsServiceClient_CreateGroup_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GroupsServiceClient_CreateGroup_Call) RunAndReturn(run func(context.Context, *groupsv1.CreateGroupReq, ...grpc.CallOption) (*groupsv1.GroupRes, error)) *GroupsServiceClient_CreateGroup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListGroups provides a mock function with given fields: ctx, in, opts
+func (_m *GroupsServiceClient) ListGroups(ctx context.Context, in *groupsv1.ListGroupsReq, opts ...grpc.CallOption) (*groupsv1.ListGroupsRes, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListGroups")
+	}
+
+	var r0 *groupsv1.ListGroupsRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *groupsv1.ListGroupsReq, ...grpc.CallOption) (*groupsv1.ListGroupsRes, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *groupsv1.ListGroupsReq, ...grpc.CallOption) *groupsv1.ListGroupsRes); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*groupsv1.ListGroupsRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *groupsv1.ListGroupsReq, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GroupsServiceClient_ListGroups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListGroups'
+type GroupsServiceClient_ListGroups_Call struct {
+	*mock.Call
+}
+
+// ListGroups is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *groupsv1.ListGroupsReq
+//   - opts ...grpc.CallOption
+func (_e *GroupsServiceClient_Expecter) ListGroups(ctx interface{}, in interface{}, opts ...interface{}) *GroupsServiceClient_ListGroups_Call {
+	return &GroupsServiceClient_ListGroups_Call{Call: _e.mock.On("ListGroups",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *GroupsServiceClient_ListGroups_Call) Run(run func(ctx context.Context, in *groupsv1.ListGroupsReq, opts ...grpc.CallOption)) *GroupsServiceClient_ListGroups_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*groupsv1.ListGroupsReq), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *GroupsServiceClient_ListGroups_Call) Return(_a0 *groupsv1.ListGroupsRes, _a1 error) *GroupsServiceClient_ListGroups_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GroupsServiceClient_ListGroups_Call) RunAndReturn(run func(context.Context, *groupsv1.ListGroupsReq, ...grpc.CallOption) (*groupsv1.ListGroupsRes, error)) *GroupsServiceClient_ListGroups_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveGroup provides a mock function with given fields: ctx, in, opts
+func (_m *GroupsServiceClient) RemoveGroup(ctx context.Context, in *groupsv1.RemoveGroupReq, opts ...grpc.CallOption) (*groupsv1.RemoveGroupRes, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveGroup")
+	}
+
+	var r0 *groupsv1.RemoveGroupRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *groupsv1.RemoveGroupReq, ...grpc.CallOption) (*groupsv1.RemoveGroupRes, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *groupsv1.RemoveGroupReq, ...grpc.CallOption) *groupsv1.RemoveGroupRes); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*groupsv1.RemoveGroupRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *groupsv1.RemoveGroupReq, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GroupsServiceClient_RemoveGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveGroup'
+type GroupsServiceClient_RemoveGroup_Call struct {
+	*mock.Call
+}
+
+// RemoveGroup is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *groupsv1.RemoveGroupReq
+//   - opts ...grpc.CallOption
+func (_e *GroupsServiceClient_Expecter) RemoveGroup(ctx interface{}, in interface{}, opts ...interface{}) *GroupsServiceClient_RemoveGroup_Call {
+	return &GroupsServiceClient_RemoveGroup_Call{Call: _e.mock.On("RemoveGroup",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *GroupsServiceClient_RemoveGroup_Call) Run(run func(ctx context.Context, in *groupsv1.RemoveGroupReq, opts ...grpc.CallOption)) *GroupsServiceClient_RemoveGroup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*groupsv1.RemoveGroupReq), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *GroupsServiceClient_RemoveGroup_Call) Return(_a0 *groupsv1.RemoveGroupRes, _a1 error) *GroupsServiceClient_RemoveGroup_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GroupsServiceClient_RemoveGroup_Call) RunAndReturn(run func(context.Context, *groupsv1.RemoveGroupReq, ...grpc.CallOption) (*groupsv1.RemoveGroupRes, error)) *GroupsServiceClient_RemoveGroup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RetrieveEntity provides a mock function with given fields: ctx, in, opts
 func (_m *GroupsServiceClient) RetrieveEntity(ctx context.Context, in *v1.RetrieveEntityReq, opts ...grpc.CallOption) (*v1.RetrieveEntityRes, error) {
 	_va := make([]interface{}, len(opts))
@@ -103,6 +327,80 @@ func (_c *GroupsServiceClient_RetrieveEntity_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// UpdateGroup provides a mock function with given fields: ctx, in, opts
+func (_m *GroupsServiceClient) UpdateGroup(ctx context.Context, in *groupsv1.UpdateGroupReq, opts ...grpc.CallOption) (*groupsv1.GroupRes, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateGroup")
+	}
+
+	var r0 *groupsv1.GroupRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *groupsv1.UpdateGroupReq, ...grpc.CallOption) (*groupsv1.GroupRes, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *groupsv1.UpdateGroupReq, ...grpc.CallOption) *groupsv1.GroupRes); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*groupsv1.GroupRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *groupsv1.UpdateGroupReq, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GroupsServiceClient_UpdateGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateGroup'
+type GroupsServiceClient_UpdateGroup_Call struct {
+	*mock.Call
+}
+
+// UpdateGroup is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *groupsv1.UpdateGroupReq
+//   - opts ...grpc.CallOption
+func (_e *GroupsServiceClient_Expecter) UpdateGroup(ctx interface{}, in interface{}, opts ...interface{}) *GroupsServiceClient_UpdateGroup_Call {
+	return &GroupsServiceClient_UpdateGroup_Call{Call: _e.mock.On("UpdateGroup",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *GroupsServiceClient_UpdateGroup_Call) Run(run func(ctx context.Context, in *groupsv1.UpdateGroupReq, opts ...grpc.CallOption)) *GroupsServiceClient_UpdateGroup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*groupsv1.UpdateGroupReq), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *GroupsServiceClient_UpdateGroup_Call) Return(_a0 *groupsv1.GroupRes, _a1 error) *GroupsServiceClient_UpdateGroup_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GroupsServiceClient_UpdateGroup_Call) RunAndReturn(run func(context.Context, *groupsv1.UpdateGroupReq, ...grpc.CallOption) (*groupsv1.GroupRes, error)) *GroupsServiceClient_UpdateGroup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewGroupsServiceClient creates a new instance of GroupsServiceClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewGroupsServiceClient(t interface {
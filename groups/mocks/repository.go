@@ -94,6 +94,34 @@ func (_m *Repository) ChangeStatus(ctx context.Context, group groups.Group) (gro
 	return r0, r1
 }
 
+// ChangeStatusWithDescendants provides a mock function with given fields: ctx, group
+func (_m *Repository) ChangeStatusWithDescendants(ctx context.Context, group groups.Group) (groups.Group, error) {
+	ret := _m.Called(ctx, group)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ChangeStatusWithDescendants")
+	}
+
+	var r0 groups.Group
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, groups.Group) (groups.Group, error)); ok {
+		return rf(ctx, group)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, groups.Group) groups.Group); ok {
+		r0 = rf(ctx, group)
+	} else {
+		r0 = ret.Get(0).(groups.Group)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, groups.Group) error); ok {
+		r1 = rf(ctx, group)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Delete provides a mock function with given fields: ctx, groupID
 func (_m *Repository) Delete(ctx context.Context, groupID string) error {
 	ret := _m.Called(ctx, groupID)
@@ -112,6 +140,62 @@ func (_m *Repository) Delete(ctx context.Context, groupID string) error {
 	return r0
 }
 
+// ListEntityMembers provides a mock function with given fields: ctx, entityID, limit, offset
+func (_m *Repository) ListEntityMembers(ctx context.Context, entityID string, limit uint64, offset uint64) (roles.EntityMembersPage, error) {
+	ret := _m.Called(ctx, entityID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEntityMembers")
+	}
+
+	var r0 roles.EntityMembersPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, uint64, uint64) (roles.EntityMembersPage, error)); ok {
+		return rf(ctx, entityID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, uint64, uint64) roles.EntityMembersPage); ok {
+		r0 = rf(ctx, entityID, limit, offset)
+	} else {
+		r0 = ret.Get(0).(roles.EntityMembersPage)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, uint64, uint64) error); ok {
+		r1 = rf(ctx, entityID, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListMemberEntities provides a mock function with given fields: ctx, memberID, limit, offset
+func (_m *Repository) ListMemberEntities(ctx context.Context, memberID string, limit uint64, offset uint64) (roles.MemberEntitiesPage, error) {
+	ret := _m.Called(ctx, memberID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListMemberEntities")
+	}
+
+	var r0 roles.MemberEntitiesPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, uint64, uint64) (roles.MemberEntitiesPage, error)); ok {
+		return rf(ctx, memberID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, uint64, uint64) roles.MemberEntitiesPage); ok {
+		r0 = rf(ctx, memberID, limit, offset)
+	} else {
+		r0 = ret.Get(0).(roles.MemberEntitiesPage)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, uint64, uint64) error); ok {
+		r1 = rf(ctx, memberID, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RemoveMemberFromAllRoles provides a mock function with given fields: ctx, memberID
 func (_m *Repository) RemoveMemberFromAllRoles(ctx context.Context, memberID string) error {
 	ret := _m.Called(ctx, memberID)
@@ -467,9 +551,37 @@ func (_m *Repository) RetrieveRole(ctx context.Context, roleID string) (roles.Ro
 	return r0, r1
 }
 
-// RetrieveUserGroups provides a mock function with given fields: ctx, domainID, userID, pm
-func (_m *Repository) RetrieveUserGroups(ctx context.Context, domainID string, userID string, pm groups.PageMeta) (groups.Page, error) {
-	ret := _m.Called(ctx, domainID, userID, pm)
+// RetrieveMemberCounts provides a mock function with given fields: ctx, id
+func (_m *Repository) RetrieveMemberCounts(ctx context.Context, id string) (groups.MemberCounts, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetrieveMemberCounts")
+	}
+
+	var r0 groups.MemberCounts
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (groups.MemberCounts, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) groups.MemberCounts); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(groups.MemberCounts)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RetrieveUserGroups provides a mock function with given fields: ctx, domainID, userID, pm, ids
+func (_m *Repository) RetrieveUserGroups(ctx context.Context, domainID string, userID string, pm groups.PageMeta, ids ...string) (groups.Page, error) {
+	ret := _m.Called(ctx, domainID, userID, pm, ids)
 
 	if len(ret) == 0 {
 		panic("no return value specified for RetrieveUserGroups")
@@ -477,17 +589,17 @@ func (_m *Repository) RetrieveUserGroups(ctx context.Context, domainID string, u
 
 	var r0 groups.Page
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, groups.PageMeta) (groups.Page, error)); ok {
-		return rf(ctx, domainID, userID, pm)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, groups.PageMeta, ...string) (groups.Page, error)); ok {
+		return rf(ctx, domainID, userID, pm, ids...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, groups.PageMeta) groups.Page); ok {
-		r0 = rf(ctx, domainID, userID, pm)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, groups.PageMeta, ...string) groups.Page); ok {
+		r0 = rf(ctx, domainID, userID, pm, ids...)
 	} else {
 		r0 = ret.Get(0).(groups.Page)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string, groups.PageMeta) error); ok {
-		r1 = rf(ctx, domainID, userID, pm)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, groups.PageMeta, ...string) error); ok {
+		r1 = rf(ctx, domainID, userID, pm, ids...)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -833,6 +945,34 @@ func (_m *Repository) Update(ctx context.Context, g groups.Group) (groups.Group,
 	return r0, r1
 }
 
+// SetRoleInheritance provides a mock function with given fields: ctx, ro
+func (_m *Repository) SetRoleInheritance(ctx context.Context, ro roles.Role) (roles.Role, error) {
+	ret := _m.Called(ctx, ro)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetRoleInheritance")
+	}
+
+	var r0 roles.Role
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, roles.Role) (roles.Role, error)); ok {
+		return rf(ctx, ro)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, roles.Role) roles.Role); ok {
+		r0 = rf(ctx, ro)
+	} else {
+		r0 = ret.Get(0).(roles.Role)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, roles.Role) error); ok {
+		r1 = rf(ctx, ro)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateRole provides a mock function with given fields: ctx, ro
 func (_m *Repository) UpdateRole(ctx context.Context, ro roles.Role) (roles.Role, error) {
 	ret := _m.Called(ctx, ro)
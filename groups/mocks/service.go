@@ -85,6 +85,36 @@ func (_m *Service) AddRole(ctx context.Context, session authn.Session, entityID
 	return r0, r1
 }
 
+// AddRoles provides a mock function with given fields: ctx, session, entityID, rps
+func (_m *Service) AddRoles(ctx context.Context, session authn.Session, entityID string, rps []roles.RoleProvision) ([]roles.RoleProvision, error) {
+	ret := _m.Called(ctx, session, entityID, rps)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddRoles")
+	}
+
+	var r0 []roles.RoleProvision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, string, []roles.RoleProvision) ([]roles.RoleProvision, error)); ok {
+		return rf(ctx, session, entityID, rps)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, string, []roles.RoleProvision) []roles.RoleProvision); ok {
+		r0 = rf(ctx, session, entityID, rps)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]roles.RoleProvision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, authn.Session, string, []roles.RoleProvision) error); ok {
+		r1 = rf(ctx, session, entityID, rps)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateGroup provides a mock function with given fields: ctx, session, g
 func (_m *Service) CreateGroup(ctx context.Context, session authn.Session, g groups.Group) (groups.Group, []roles.RoleProvision, error) {
 	ret := _m.Called(ctx, session, g)
@@ -140,9 +170,9 @@ func (_m *Service) DeleteGroup(ctx context.Context, session authn.Session, id st
 	return r0
 }
 
-// DisableGroup provides a mock function with given fields: ctx, session, id
-func (_m *Service) DisableGroup(ctx context.Context, session authn.Session, id string) (groups.Group, error) {
-	ret := _m.Called(ctx, session, id)
+// DisableGroup provides a mock function with given fields: ctx, session, id, cascade
+func (_m *Service) DisableGroup(ctx context.Context, session authn.Session, id string, cascade bool) (groups.Group, error) {
+	ret := _m.Called(ctx, session, id, cascade)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DisableGroup")
@@ -150,17 +180,17 @@ func (_m *Service) DisableGroup(ctx context.Context, session authn.Session, id s
 
 	var r0 groups.Group
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, string) (groups.Group, error)); ok {
-		return rf(ctx, session, id)
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, string, bool) (groups.Group, error)); ok {
+		return rf(ctx, session, id, cascade)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, string) groups.Group); ok {
-		r0 = rf(ctx, session, id)
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, string, bool) groups.Group); ok {
+		r0 = rf(ctx, session, id, cascade)
 	} else {
 		r0 = ret.Get(0).(groups.Group)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, authn.Session, string) error); ok {
-		r1 = rf(ctx, session, id)
+	if rf, ok := ret.Get(1).(func(context.Context, authn.Session, string, bool) error); ok {
+		r1 = rf(ctx, session, id, cascade)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -226,6 +256,92 @@ func (_m *Service) ListAvailableActions(ctx context.Context, session authn.Sessi
 	return r0, r1
 }
 
+// ListEntityMembers provides a mock function with given fields: ctx, session, entityID, limit, offset
+func (_m *Service) ListEntityMembers(ctx context.Context, session authn.Session, entityID string, limit uint64, offset uint64) (roles.EntityMembersPage, error) {
+	ret := _m.Called(ctx, session, entityID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEntityMembers")
+	}
+
+	var r0 roles.EntityMembersPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, string, uint64, uint64) (roles.EntityMembersPage, error)); ok {
+		return rf(ctx, session, entityID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, string, uint64, uint64) roles.EntityMembersPage); ok {
+		r0 = rf(ctx, session, entityID, limit, offset)
+	} else {
+		r0 = ret.Get(0).(roles.EntityMembersPage)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, authn.Session, string, uint64, uint64) error); ok {
+		r1 = rf(ctx, session, entityID, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListMemberEntities provides a mock function with given fields: ctx, session, memberID, limit, offset
+func (_m *Service) ListMemberEntities(ctx context.Context, session authn.Session, memberID string, limit uint64, offset uint64) (roles.MemberEntitiesPage, error) {
+	ret := _m.Called(ctx, session, memberID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListMemberEntities")
+	}
+
+	var r0 roles.MemberEntitiesPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, string, uint64, uint64) (roles.MemberEntitiesPage, error)); ok {
+		return rf(ctx, session, memberID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, string, uint64, uint64) roles.MemberEntitiesPage); ok {
+		r0 = rf(ctx, session, memberID, limit, offset)
+	} else {
+		r0 = ret.Get(0).(roles.MemberEntitiesPage)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, authn.Session, string, uint64, uint64) error); ok {
+		r1 = rf(ctx, session, memberID, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRoleTemplates provides a mock function with given fields: ctx, session
+func (_m *Service) ListRoleTemplates(ctx context.Context, session authn.Session) (map[string][]string, error) {
+	ret := _m.Called(ctx, session)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRoleTemplates")
+	}
+
+	var r0 map[string][]string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session) (map[string][]string, error)); ok {
+		return rf(ctx, session)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session) map[string][]string); ok {
+		r0 = rf(ctx, session)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, authn.Session) error); ok {
+		r1 = rf(ctx, session)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListChildrenGroups provides a mock function with given fields: ctx, session, id, startLevel, endLevel, pm
 func (_m *Service) ListChildrenGroups(ctx context.Context, session authn.Session, id string, startLevel int64, endLevel int64, pm groups.PageMeta) (groups.Page, error) {
 	ret := _m.Called(ctx, session, id, startLevel, endLevel, pm)
@@ -282,6 +398,34 @@ func (_m *Service) ListGroups(ctx context.Context, session authn.Session, pm gro
 	return r0, r1
 }
 
+// ListTrash provides a mock function with given fields: ctx, session, pm
+func (_m *Service) ListTrash(ctx context.Context, session authn.Session, pm groups.PageMeta) (groups.Page, error) {
+	ret := _m.Called(ctx, session, pm)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTrash")
+	}
+
+	var r0 groups.Page
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, groups.PageMeta) (groups.Page, error)); ok {
+		return rf(ctx, session, pm)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, groups.PageMeta) groups.Page); ok {
+		r0 = rf(ctx, session, pm)
+	} else {
+		r0 = ret.Get(0).(groups.Page)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, authn.Session, groups.PageMeta) error); ok {
+		r1 = rf(ctx, session, pm)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListUserGroups provides a mock function with given fields: ctx, session, userID, pm
 func (_m *Service) ListUserGroups(ctx context.Context, session authn.Session, userID string, pm groups.PageMeta) (groups.Page, error) {
 	ret := _m.Called(ctx, session, userID, pm)
@@ -400,6 +544,34 @@ func (_m *Service) RemoveRole(ctx context.Context, session authn.Session, entity
 	return r0
 }
 
+// Restore provides a mock function with given fields: ctx, session, id
+func (_m *Service) Restore(ctx context.Context, session authn.Session, id string) (groups.Group, error) {
+	ret := _m.Called(ctx, session, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restore")
+	}
+
+	var r0 groups.Group
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, string) (groups.Group, error)); ok {
+		return rf(ctx, session, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, string) groups.Group); ok {
+		r0 = rf(ctx, session, id)
+	} else {
+		r0 = ret.Get(0).(groups.Group)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, authn.Session, string) error); ok {
+		r1 = rf(ctx, session, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RetrieveAllRoles provides a mock function with given fields: ctx, session, entityID, limit, offset
 func (_m *Service) RetrieveAllRoles(ctx context.Context, session authn.Session, entityID string, limit uint64, offset uint64) (roles.RolePage, error) {
 	ret := _m.Called(ctx, session, entityID, limit, offset)
@@ -758,6 +930,34 @@ func (_m *Service) UpdateGroup(ctx context.Context, session authn.Session, g gro
 	return r0, r1
 }
 
+// SetRoleInheritance provides a mock function with given fields: ctx, session, entityID, roleID, inheritsFrom
+func (_m *Service) SetRoleInheritance(ctx context.Context, session authn.Session, entityID string, roleID string, inheritsFrom string) (roles.Role, error) {
+	ret := _m.Called(ctx, session, entityID, roleID, inheritsFrom)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetRoleInheritance")
+	}
+
+	var r0 roles.Role
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, string, string, string) (roles.Role, error)); ok {
+		return rf(ctx, session, entityID, roleID, inheritsFrom)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, string, string, string) roles.Role); ok {
+		r0 = rf(ctx, session, entityID, roleID, inheritsFrom)
+	} else {
+		r0 = ret.Get(0).(roles.Role)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, authn.Session, string, string, string) error); ok {
+		r1 = rf(ctx, session, entityID, roleID, inheritsFrom)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateRoleName provides a mock function with given fields: ctx, session, entityID, roleID, newRoleName
 func (_m *Service) UpdateRoleName(ctx context.Context, session authn.Session, entityID string, roleID string, newRoleName string) (roles.Role, error) {
 	ret := _m.Called(ctx, session, entityID, roleID, newRoleName)
@@ -786,9 +986,9 @@ func (_m *Service) UpdateRoleName(ctx context.Context, session authn.Session, en
 	return r0, r1
 }
 
-// ViewGroup provides a mock function with given fields: ctx, session, id
-func (_m *Service) ViewGroup(ctx context.Context, session authn.Session, id string) (groups.Group, error) {
-	ret := _m.Called(ctx, session, id)
+// ViewGroup provides a mock function with given fields: ctx, session, id, listPerms, listCounts
+func (_m *Service) ViewGroup(ctx context.Context, session authn.Session, id string, listPerms bool, listCounts bool) (groups.Group, error) {
+	ret := _m.Called(ctx, session, id, listPerms, listCounts)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ViewGroup")
@@ -796,17 +996,17 @@ func (_m *Service) ViewGroup(ctx context.Context, session authn.Session, id stri
 
 	var r0 groups.Group
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, string) (groups.Group, error)); ok {
-		return rf(ctx, session, id)
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, string, bool, bool) (groups.Group, error)); ok {
+		return rf(ctx, session, id, listPerms, listCounts)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, string) groups.Group); ok {
-		r0 = rf(ctx, session, id)
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, string, bool, bool) groups.Group); ok {
+		r0 = rf(ctx, session, id, listPerms, listCounts)
 	} else {
 		r0 = ret.Get(0).(groups.Group)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, authn.Session, string) error); ok {
-		r1 = rf(ctx, session, id)
+	if rf, ok := ret.Get(1).(func(context.Context, authn.Session, string, bool, bool) error); ok {
+		r1 = rf(ctx, session, id, listPerms, listCounts)
 	} else {
 		r1 = ret.Error(1)
 	}
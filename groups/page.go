@@ -3,6 +3,8 @@
 
 package groups
 
+import "github.com/absmach/supermq/pkg/labels"
+
 // PageMeta contains page metadata that helps navigation.
 type PageMeta struct {
 	Total      uint64   `json:"total"`
@@ -19,4 +21,7 @@ type PageMeta struct {
 	RoleID     string   `json:"role_id,omitempty"`
 	Actions    []string `json:"actions,omitempty"`
 	AccessType string   `json:"access_type,omitempty"`
+	Permission string   `json:"permission,omitempty"`
+	// LabelSelector filters the listing by entity labels.
+	LabelSelector labels.Selector `json:"-"`
 }
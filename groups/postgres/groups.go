@@ -14,6 +14,7 @@ import (
 	groups "github.com/absmach/supermq/groups"
 	"github.com/absmach/supermq/pkg/errors"
 	repoerr "github.com/absmach/supermq/pkg/errors/repository"
+	"github.com/absmach/supermq/pkg/labels"
 	"github.com/absmach/supermq/pkg/postgres"
 	rolesPostgres "github.com/absmach/supermq/pkg/roles/repo/postgres"
 	"github.com/jmoiron/sqlx"
@@ -51,7 +52,7 @@ func New(db postgres.Database) groups.Repository {
 }
 
 func (repo groupRepository) Save(ctx context.Context, g groups.Group) (groups.Group, error) {
-	q, err := repo.getInsertQuery(ctx, g)
+	q, path, err := repo.getInsertQuery(ctx, g)
 	if err != nil {
 		return groups.Group{}, errors.Wrap(repoerr.ErrCreateEntity, err)
 	}
@@ -59,6 +60,9 @@ func (repo groupRepository) Save(ctx context.Context, g groups.Group) (groups.Gr
 	if err != nil {
 		return groups.Group{}, err
 	}
+	if path != "" {
+		dbg.Path = path
+	}
 
 	row, err := repo.db.NamedQueryContext(ctx, q, dbg)
 	if err != nil {
@@ -87,13 +91,16 @@ func (repo groupRepository) Update(ctx context.Context, g groups.Group) (groups.
 	if g.Metadata != nil {
 		query = append(query, "metadata = :metadata,")
 	}
+	if g.Labels != nil {
+		query = append(query, "labels = :labels,")
+	}
 	if len(query) > 0 {
 		upq = strings.Join(query, " ")
 	}
 	g.Status = groups.EnabledStatus
-	q := fmt.Sprintf(`UPDATE groups SET %s updated_at = :updated_at, updated_by = :updated_by
-		WHERE id = :id AND status = :status
-		RETURNING id, name, description, domain_id, COALESCE(parent_id, '') AS parent_id, metadata, created_at, updated_at, updated_by, status`, upq)
+	q := fmt.Sprintf(`UPDATE groups SET %s version = version + 1, updated_at = :updated_at, updated_by = :updated_by
+		WHERE id = :id AND status = :status AND (:version = 0 OR version = :version)
+		RETURNING id, name, description, domain_id, COALESCE(parent_id, '') AS parent_id, metadata, labels, created_at, updated_at, updated_by, status, version`, upq)
 
 	dbu, err := toDBGroup(g)
 	if err != nil {
@@ -107,6 +114,11 @@ func (repo groupRepository) Update(ctx context.Context, g groups.Group) (groups.
 
 	defer row.Close()
 	if ok := row.Next(); !ok {
+		if g.Version > 0 {
+			if _, err := repo.RetrieveByID(ctx, g.ID); err == nil {
+				return groups.Group{}, repoerr.ErrVersionConflict
+			}
+		}
 		return groups.Group{}, errors.Wrap(repoerr.ErrNotFound, row.Err())
 	}
 	dbu = dbGroup{}
@@ -117,8 +129,8 @@ func (repo groupRepository) Update(ctx context.Context, g groups.Group) (groups.
 }
 
 func (repo groupRepository) ChangeStatus(ctx context.Context, group groups.Group) (groups.Group, error) {
-	qc := `UPDATE groups SET status = :status, updated_at = :updated_at, updated_by = :updated_by WHERE id = :id
-	RETURNING id, name, description, domain_id, COALESCE(parent_id, '') AS parent_id, metadata, created_at, updated_at, updated_by, status`
+	qc := `UPDATE groups SET status = :status, version = version + 1, updated_at = :updated_at, updated_by = :updated_by WHERE id = :id AND (:version = 0 OR version = :version)
+	RETURNING id, name, description, domain_id, COALESCE(parent_id, '') AS parent_id, metadata, labels, created_at, updated_at, updated_by, status, version`
 
 	dbg, err := toDBGroup(group)
 	if err != nil {
@@ -140,8 +152,78 @@ func (repo groupRepository) ChangeStatus(ctx context.Context, group groups.Group
 	return toGroup(dbg)
 }
 
+func (repo groupRepository) ChangeStatusWithDescendants(ctx context.Context, group groups.Group) (g groups.Group, err error) {
+	tx, err := repo.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return groups.Group{}, errors.Wrap(repoerr.ErrUpdateEntity, err)
+	}
+	defer func() {
+		if err != nil {
+			if errRollback := tx.Rollback(); errRollback != nil {
+				err = errors.Wrap(err, errRollback)
+			}
+		}
+	}()
+
+	qc := `UPDATE groups SET status = :status, version = version + 1, updated_at = :updated_at, updated_by = :updated_by WHERE id = :id AND (:version = 0 OR version = :version)
+	RETURNING id, name, description, domain_id, COALESCE(parent_id, '') AS parent_id, metadata, labels, created_at, updated_at, updated_by, status, version`
+
+	dbg, err := toDBGroup(group)
+	if err != nil {
+		return groups.Group{}, errors.Wrap(repoerr.ErrUpdateEntity, err)
+	}
+	row, err := tx.NamedQuery(qc, dbg)
+	if err != nil {
+		return groups.Group{}, postgres.HandleError(repoerr.ErrUpdateEntity, err)
+	}
+	defer row.Close()
+	if ok := row.Next(); !ok {
+		err = errors.Wrap(repoerr.ErrNotFound, row.Err())
+		return groups.Group{}, err
+	}
+	dbg = dbGroup{}
+	if err := row.StructScan(&dbg); err != nil {
+		return groups.Group{}, errors.Wrap(err, repoerr.ErrUpdateEntity)
+	}
+	row.Close()
+
+	// Disabling flips only enabled descendants and marks them, so that
+	// enabling restores the descendants disabled by the cascade and leaves
+	// the ones disabled on their own untouched.
+	var qd string
+	prev := groups.EnabledStatus
+	switch group.Status {
+	case groups.DisabledStatus:
+		qd = `UPDATE groups SET status = :status, disabled_by_parent = TRUE, updated_at = :updated_at, updated_by = :updated_by
+		WHERE path <@ (SELECT path FROM groups WHERE id = :id LIMIT 1) AND id <> :id AND status = :prev_status`
+	case groups.EnabledStatus:
+		prev = groups.DisabledStatus
+		qd = `UPDATE groups SET status = :status, disabled_by_parent = FALSE, updated_at = :updated_at, updated_by = :updated_by
+		WHERE path <@ (SELECT path FROM groups WHERE id = :id LIMIT 1) AND id <> :id AND status = :prev_status AND disabled_by_parent = TRUE`
+	}
+
+	if qd != "" {
+		params := map[string]interface{}{
+			"id":          group.ID,
+			"status":      group.Status,
+			"prev_status": prev,
+			"updated_at":  group.UpdatedAt,
+			"updated_by":  group.UpdatedBy,
+		}
+		if _, err := tx.NamedExec(qd, params); err != nil {
+			return groups.Group{}, postgres.HandleError(repoerr.ErrUpdateEntity, err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return groups.Group{}, errors.Wrap(repoerr.ErrUpdateEntity, err)
+	}
+
+	return toGroup(dbg)
+}
+
 func (repo groupRepository) RetrieveByID(ctx context.Context, id string) (groups.Group, error) {
-	q := `SELECT id, name, domain_id, COALESCE(parent_id, '') AS parent_id, description, metadata, created_at, updated_at, updated_by, status, path FROM groups
+	q := `SELECT id, name, domain_id, COALESCE(parent_id, '') AS parent_id, description, metadata, labels, created_at, updated_at, updated_by, status, version, path FROM groups
 	    WHERE id = :id`
 
 	dbg := dbGroup{
@@ -165,9 +247,7 @@ func (repo groupRepository) RetrieveByID(ctx context.Context, id string) (groups
 }
 
 func (repo groupRepository) RetrieveByIDAndUser(ctx context.Context, domainID, userID, groupID string) (groups.Group, error) {
-	baseQuery := repo.userGroupsBaseQuery(domainID, userID)
-
-	dbg := dbGroup{ID: groupID}
+	dbg := dbGroup{ID: groupID, DomainID: domainID, MemberID: userID}
 	q := fmt.Sprintf(`%s
 					SELECT
 						g.id,
@@ -176,6 +256,7 @@ func (repo groupRepository) RetrieveByIDAndUser(ctx context.Context, domainID, u
 						COALESCE(g.parent_id, '') AS parent_id,
 						g.description,
 						g.metadata,
+						g.labels,
 						g.created_at,
 						g.updated_at,
 						g.updated_by,
@@ -196,7 +277,7 @@ func (repo groupRepository) RetrieveByIDAndUser(ctx context.Context, domainID, u
 					LIMIT 1
 					;
 					`,
-		baseQuery)
+		userGroupsBaseQuery)
 
 	row, err := repo.db.NamedQueryContext(ctx, q, dbg)
 	if err != nil {
@@ -219,7 +300,7 @@ func (repo groupRepository) RetrieveAll(ctx context.Context, pm groups.PageMeta)
 	query := buildQuery(pm)
 
 	q = fmt.Sprintf(`SELECT DISTINCT g.id, g.domain_id, COALESCE(g.parent_id, '') AS parent_id, g.name, g.description,
-		g.metadata, g.created_at, g.updated_at, g.updated_by, g.status FROM groups g %s ORDER BY g.created_at LIMIT :limit OFFSET :offset;`, query)
+		g.metadata, g.labels, g.created_at, g.updated_at, g.updated_by, g.status, g.version FROM groups g %s ORDER BY g.created_at LIMIT :limit OFFSET :offset;`, query)
 
 	dbPageMeta, err := toDBGroupPageMeta(pm)
 	if err != nil {
@@ -239,7 +320,7 @@ func (repo groupRepository) RetrieveAll(ctx context.Context, pm groups.PageMeta)
 	cq := fmt.Sprintf(`	SELECT COUNT(*) AS total_count
 						FROM (
 							SELECT DISTINCT g.id, g.domain_id, COALESCE(g.parent_id, '') AS parent_id, g.name, g.description,
-							g.metadata, g.created_at, g.updated_at, g.updated_by, g.status FROM groups g %s
+							g.metadata, g.labels, g.created_at, g.updated_at, g.updated_by, g.status, g.version FROM groups g %s
 						) AS subquery;
 						`, query)
 
@@ -262,12 +343,13 @@ func (repo groupRepository) RetrieveByIDs(ctx context.Context, pm groups.PageMet
 	query := buildQuery(pm, ids...)
 
 	q = fmt.Sprintf(`SELECT DISTINCT g.id, g.domain_id, COALESCE(g.parent_id, '') AS parent_id, g.name, g.description,
-		g.metadata, g.created_at, g.updated_at, g.updated_by, g.status FROM groups g %s ORDER BY g.created_at LIMIT :limit OFFSET :offset;`, query)
+		g.metadata, g.labels, g.created_at, g.updated_at, g.updated_by, g.status, g.version FROM groups g %s ORDER BY g.created_at LIMIT :limit OFFSET :offset;`, query)
 
 	dbPageMeta, err := toDBGroupPageMeta(pm)
 	if err != nil {
 		return groups.Page{}, errors.Wrap(repoerr.ErrFailedToRetrieveAllGroups, err)
 	}
+	_, dbPageMeta.IDs = postgres.CreateIDsQuery("", ids)
 	rows, err := repo.db.NamedQueryContext(ctx, q, dbPageMeta)
 	if err != nil {
 		return groups.Page{}, errors.Wrap(repoerr.ErrFailedToRetrieveAllGroups, err)
@@ -282,7 +364,7 @@ func (repo groupRepository) RetrieveByIDs(ctx context.Context, pm groups.PageMet
 	cq := fmt.Sprintf(`	SELECT COUNT(*) AS total_count
 						FROM (
 							SELECT DISTINCT g.id, g.domain_id, COALESCE(g.parent_id, '') AS parent_id, g.name, g.description,
-							g.metadata, g.created_at, g.updated_at, g.updated_by, g.status FROM groups g %s
+							g.metadata, g.labels, g.created_at, g.updated_at, g.updated_by, g.status, g.version FROM groups g %s
 						) AS subquery;
 						`, query)
 
@@ -310,6 +392,7 @@ func (repo groupRepository) RetrieveHierarchy(ctx context.Context, id string, hm
 			g.name,
 			g.description,
 			g.metadata,
+			g.labels,
 			g.created_at,
 			g.updated_at,
 			g.updated_by,
@@ -333,6 +416,7 @@ func (repo groupRepository) RetrieveHierarchy(ctx context.Context, id string, hm
 			g.name,
 			g.description,
 			g.metadata,
+			g.labels,
 			g.created_at,
 			g.updated_at,
 			g.updated_by,
@@ -575,7 +659,8 @@ func (repo groupRepository) RetrieveAllParentGroups(ctx context.Context, domainI
 
 	query := buildQuery(pm)
 
-	levelCondition := fmt.Sprintf("g.path @> '%s' ", cGroup.Path)
+	pm.Path = cGroup.Path
+	levelCondition := "g.path @> :path "
 
 	switch {
 	case query == "":
@@ -595,26 +680,26 @@ func (repo groupRepository) RetrieveChildrenGroups(ctx context.Context, domainID
 
 	query := buildQuery(pm)
 
-	levelCondition := ""
 	switch {
 	// Retrieve all children groups from parent group level
 	case startLevel == 0 && endLevel < 0:
-		levelCondition = fmt.Sprintf(" path ~ '%s.*'::::lquery ", pGroup.Path)
+		pm.Path = fmt.Sprintf("%s.*", pGroup.Path)
 
 	// Retrieve specific level of children groups from parent group level
 	case (startLevel > 0) && (startLevel == endLevel || endLevel == 0):
-		levelCondition = fmt.Sprintf(" path ~ '%s.*{%d}'::::lquery ", pGroup.Path, startLevel)
+		pm.Path = fmt.Sprintf("%s.*{%d}", pGroup.Path, startLevel)
 
 	// Retrieve all children groups from specific level from parent group level
 	case startLevel > 0 && endLevel < 0:
-		levelCondition = fmt.Sprintf(" path ~ '%s.*{%d,}'::::lquery ", pGroup.Path, startLevel)
+		pm.Path = fmt.Sprintf("%s.*{%d,}", pGroup.Path, startLevel)
 
 	// Retrieve children groups between specific level from parent group level
 	case startLevel > 0 && endLevel > 0 && startLevel < endLevel:
-		levelCondition = fmt.Sprintf(" path ~ '%s.*{%d,%d}'::::lquery ", pGroup.Path, startLevel, endLevel)
+		pm.Path = fmt.Sprintf("%s.*{%d,%d}", pGroup.Path, startLevel, endLevel)
 	default:
 		return groups.Page{}, errors.Wrap(repoerr.ErrViewEntity, fmt.Errorf("invalid level range: start level: %d end level: %d", startLevel, endLevel))
 	}
+	levelCondition := " path ~ (:path)::::lquery "
 
 	switch {
 	case query == "":
@@ -626,14 +711,39 @@ func (repo groupRepository) RetrieveChildrenGroups(ctx context.Context, domainID
 	return repo.retrieveGroups(ctx, domainID, userID, query, pm)
 }
 
-func (repo groupRepository) RetrieveUserGroups(ctx context.Context, domainID, userID string, pm groups.PageMeta) (groups.Page, error) {
-	query := buildQuery(pm)
+func (repo groupRepository) RetrieveUserGroups(ctx context.Context, domainID, userID string, pm groups.PageMeta, ids ...string) (groups.Page, error) {
+	query := buildQuery(pm, ids...)
 
 	return repo.retrieveGroups(ctx, domainID, userID, query, pm)
 }
 
+func (repo groupRepository) RetrieveMemberCounts(ctx context.Context, id string) (groups.MemberCounts, error) {
+	q := `SELECT
+			(SELECT COUNT(DISTINCT grm.member_id) FROM groups_role_members grm
+				JOIN groups_roles gr ON grm.role_id = gr.id WHERE gr.entity_id = :id) AS users,
+			(SELECT COUNT(*) FROM groups WHERE parent_id = :id) AS groups`
+
+	row, err := repo.db.NamedQueryContext(ctx, q, map[string]interface{}{"id": id})
+	if err != nil {
+		return groups.MemberCounts{}, errors.Wrap(repoerr.ErrViewEntity, err)
+	}
+	defer row.Close()
+
+	dbmc := dbMemberCounts{}
+	if ok := row.Next(); !ok {
+		return groups.MemberCounts{}, repoerr.ErrNotFound
+	}
+	if err := row.StructScan(&dbmc); err != nil {
+		return groups.MemberCounts{}, errors.Wrap(repoerr.ErrViewEntity, err)
+	}
+
+	return groups.MemberCounts{
+		Users:  dbmc.Users,
+		Groups: dbmc.Groups,
+	}, nil
+}
+
 func (repo groupRepository) retrieveGroups(ctx context.Context, domainID, userID, query string, pm groups.PageMeta) (groups.Page, error) {
-	baseQuery := repo.userGroupsBaseQuery(domainID, userID)
 	q := fmt.Sprintf(`%s
 					SELECT
 						g.id,
@@ -642,6 +752,7 @@ func (repo groupRepository) retrieveGroups(ctx context.Context, domainID, userID
 						COALESCE(g.parent_id, '') AS parent_id,
 						g.description,
 						g.metadata,
+						g.labels,
 						g.created_at,
 						g.updated_at,
 						g.updated_by,
@@ -663,11 +774,15 @@ func (repo groupRepository) retrieveGroups(ctx context.Context, domainID, userID
 					LIMIT :limit
 					OFFSET :offset;
 					`,
-		baseQuery, query)
+		userGroupsBaseQuery, query)
 	dbPageMeta, err := toDBGroupPageMeta(pm)
 	if err != nil {
 		return groups.Page{}, errors.Wrap(repoerr.ErrFailedToRetrieveAllGroups, err)
 	}
+	dbPageMeta.MemberID = userID
+	if domainID != "" {
+		dbPageMeta.DomainID = domainID
+	}
 	rows, err := repo.db.NamedQueryContext(ctx, q, dbPageMeta)
 	if err != nil {
 		return groups.Page{}, errors.Wrap(repoerr.ErrFailedToRetrieveAllGroups, err)
@@ -689,6 +804,7 @@ func (repo groupRepository) retrieveGroups(ctx context.Context, domainID, userID
 								COALESCE(g.parent_id, '') AS parent_id,
 								g.description,
 								g.metadata,
+								g.labels,
 								g.created_at,
 								g.updated_at,
 								g.updated_by,
@@ -706,7 +822,7 @@ func (repo groupRepository) retrieveGroups(ctx context.Context, domainID, userID
 								final_groups g
 							%s
 						) AS subquery;
-						`, baseQuery, query)
+						`, userGroupsBaseQuery, query)
 
 	total, err := postgres.Total(ctx, repo.db, cq, dbPageMeta)
 	if err != nil {
@@ -719,8 +835,9 @@ func (repo groupRepository) retrieveGroups(ctx context.Context, domainID, userID
 	return page, nil
 }
 
-func (repo groupRepository) userGroupsBaseQuery(domainID, userID string) string {
-	return fmt.Sprintf(`
+// userGroupsBaseQuery holds the CTEs resolving the groups a member can
+// access. The member and the domain are bound as named parameters.
+const userGroupsBaseQuery = `
 	WITH direct_groups AS (
 	SELECT
 		g.*,
@@ -738,8 +855,8 @@ func (repo groupRepository) userGroupsBaseQuery(domainID, userID string) string
 	JOIN
 		"groups" g ON g.id = gr.entity_id
 	WHERE
-		grm.member_id = '%s'
-		AND g.domain_id = '%s'
+		grm.member_id = :member_id
+		AND g.domain_id = :domain_id
 	GROUP BY
 		gr.entity_id, grm.member_id, gr.id, gr."name", g."path", g.id
 	),
@@ -750,7 +867,7 @@ func (repo groupRepository) userGroupsBaseQuery(domainID, userID string) string
 		WHERE EXISTS (
     		SELECT 1
     			FROM unnest(direct_groups.actions) AS action
-    		WHERE action LIKE 'subgroup_%%'
+    		WHERE action LIKE 'subgroup_%'
 		)
 	),
 	indirect_child_groups AS (
@@ -766,7 +883,7 @@ func (repo groupRepository) userGroupsBaseQuery(domainID, userID string) string
 		JOIN
 			groups indirect_child_groups ON indirect_child_groups.path <@ dgws.path  -- Finds all children of entity_id based on ltree path
 		WHERE
-			indirect_child_groups.domain_id = '%s'
+			indirect_child_groups.domain_id = :domain_id
 			AND
 			NOT EXISTS (  -- Ensures that the indirect_child_groups.id is not already in the direct_groups_with_subgroup table
 				SELECT 1
@@ -782,6 +899,7 @@ func (repo groupRepository) userGroupsBaseQuery(domainID, userID string) string
 			"name",
 			description,
 			metadata,
+			labels,
 			created_at,
 			updated_at,
 			updated_by,
@@ -805,6 +923,7 @@ func (repo groupRepository) userGroupsBaseQuery(domainID, userID string) string
 			"name",
 			description,
 			metadata,
+			labels,
 			created_at,
 			updated_at,
 			updated_by,
@@ -820,14 +939,13 @@ func (repo groupRepository) userGroupsBaseQuery(domainID, userID string) string
 			access_provider_role_actions
 		FROM
 			indirect_child_groups
-	)`, userID, domainID, domainID)
-}
+	)`
 
 func buildQuery(gm groups.PageMeta, ids ...string) string {
 	queries := []string{}
 
-	if len(ids) > 0 {
-		queries = append(queries, fmt.Sprintf(" id in ('%s') ", strings.Join(ids, "', '")))
+	if iq, _ := postgres.CreateIDsQuery("", ids); iq != "" {
+		queries = append(queries, iq)
 	}
 	if gm.Name != "" {
 		queries = append(queries, "g.name ILIKE '%' || :name || '%'")
@@ -856,6 +974,9 @@ func buildQuery(gm groups.PageMeta, ids ...string) string {
 	if len(gm.Metadata) > 0 {
 		queries = append(queries, "g.metadata @> :metadata")
 	}
+	if lq, _, _ := postgres.CreateLabelSelectorQuery("g.", gm.LabelSelector); lq != "" {
+		queries = append(queries, lq)
+	}
 	if len(queries) > 0 {
 		return fmt.Sprintf("WHERE %s", strings.Join(queries, " AND "))
 	}
@@ -863,19 +984,27 @@ func buildQuery(gm groups.PageMeta, ids ...string) string {
 	return ""
 }
 
+type dbMemberCounts struct {
+	Users  uint64 `db:"users"`
+	Groups uint64 `db:"groups"`
+}
+
 type dbGroup struct {
 	ID                        string         `db:"id"`
 	ParentID                  *string        `db:"parent_id,omitempty"`
 	DomainID                  string         `db:"domain_id,omitempty"`
+	MemberID                  string         `db:"member_id,omitempty"`
 	Name                      string         `db:"name"`
 	Description               string         `db:"description,omitempty"`
 	Level                     int            `db:"level"`
 	Path                      string         `db:"path,omitempty"`
 	Metadata                  []byte         `db:"metadata,omitempty"`
+	Labels                    []byte         `db:"labels,omitempty"`
 	CreatedAt                 time.Time      `db:"created_at"`
 	UpdatedAt                 sql.NullTime   `db:"updated_at,omitempty"`
 	UpdatedBy                 *string        `db:"updated_by,omitempty"`
 	Status                    groups.Status  `db:"status"`
+	Version                   uint64         `db:"version"`
 	RoleID                    string         `db:"role_id"`
 	RoleName                  string         `db:"role_name"`
 	Actions                   pq.StringArray `db:"actions"`
@@ -895,6 +1024,14 @@ func toDBGroup(g groups.Group) (dbGroup, error) {
 		}
 		data = b
 	}
+	lbls := []byte("{}")
+	if len(g.Labels) > 0 {
+		b, err := json.Marshal(g.Labels)
+		if err != nil {
+			return dbGroup{}, errors.Wrap(errors.ErrMalformedEntity, err)
+		}
+		lbls = b
+	}
 	var parentID *string
 	if g.Parent != "" {
 		parentID = &g.Parent
@@ -914,11 +1051,13 @@ func toDBGroup(g groups.Group) (dbGroup, error) {
 		DomainID:    g.Domain,
 		Description: g.Description,
 		Metadata:    data,
+		Labels:      lbls,
 		Path:        g.Path,
 		CreatedAt:   g.CreatedAt,
 		UpdatedAt:   updatedAt,
 		UpdatedBy:   updatedBy,
 		Status:      g.Status,
+		Version:     g.Version,
 	}, nil
 }
 
@@ -929,6 +1068,12 @@ func toGroup(g dbGroup) (groups.Group, error) {
 			return groups.Group{}, errors.Wrap(repoerr.ErrMalformedEntity, err)
 		}
 	}
+	var lbls labels.Labels
+	if len(g.Labels) > 0 {
+		if err := json.Unmarshal(g.Labels, &lbls); err != nil {
+			return groups.Group{}, errors.Wrap(repoerr.ErrMalformedEntity, err)
+		}
+	}
 	var parentID string
 	if g.ParentID != nil {
 		parentID = *g.ParentID
@@ -949,12 +1094,14 @@ func toGroup(g dbGroup) (groups.Group, error) {
 		Domain:                    g.DomainID,
 		Description:               g.Description,
 		Metadata:                  metadata,
+		Labels:                    lbls,
 		Level:                     g.Level,
 		Path:                      g.Path,
 		UpdatedAt:                 updatedAt,
 		UpdatedBy:                 updatedBy,
 		CreatedAt:                 g.CreatedAt,
 		Status:                    g.Status,
+		Version:                   g.Version,
 		RoleID:                    g.RoleID,
 		RoleName:                  g.RoleName,
 		Actions:                   g.Actions,
@@ -975,39 +1122,48 @@ func toDBGroupPageMeta(pm groups.PageMeta) (dbGroupPageMeta, error) {
 		}
 		data = b
 	}
+	_, sel, err := postgres.CreateLabelSelectorQuery("", pm.LabelSelector)
+	if err != nil {
+		return dbGroupPageMeta{}, errors.Wrap(errors.ErrMalformedEntity, err)
+	}
 	return dbGroupPageMeta{
-		ID:         pm.ID,
-		Name:       pm.Name,
-		Metadata:   data,
-		Total:      pm.Total,
-		Offset:     pm.Offset,
-		Limit:      pm.Limit,
-		DomainID:   pm.DomainID,
-		Status:     pm.Status,
-		RoleName:   pm.RoleName,
-		RoleID:     pm.RoleID,
-		Actions:    pm.Actions,
-		AccessType: pm.AccessType,
+		ID:            pm.ID,
+		LabelSelector: sel,
+		Name:          pm.Name,
+		Path:          pm.Path,
+		Metadata:      data,
+		Total:         pm.Total,
+		Offset:        pm.Offset,
+		Limit:         pm.Limit,
+		DomainID:      pm.DomainID,
+		Status:        pm.Status,
+		RoleName:      pm.RoleName,
+		RoleID:        pm.RoleID,
+		Actions:       pm.Actions,
+		AccessType:    pm.AccessType,
 	}, nil
 }
 
 type dbGroupPageMeta struct {
-	ID         string         `db:"id"`
-	Name       string         `db:"name"`
-	ParentID   string         `db:"parent_id"`
-	DomainID   string         `db:"domain_id"`
-	Metadata   []byte         `db:"metadata"`
-	Path       string         `db:"path"`
-	Level      uint64         `db:"level"`
-	Total      uint64         `db:"total"`
-	Limit      uint64         `db:"limit"`
-	Offset     uint64         `db:"offset"`
-	Subject    string         `db:"subject"`
-	RoleName   string         `db:"role_name"`
-	RoleID     string         `db:"role_id"`
-	Actions    pq.StringArray `db:"actions"`
-	AccessType string         `db:"access_type"`
-	Status     groups.Status  `db:"status"`
+	ID            string         `db:"id"`
+	IDs           pq.StringArray `db:"ids"`
+	Name          string         `db:"name"`
+	ParentID      string         `db:"parent_id"`
+	DomainID      string         `db:"domain_id"`
+	MemberID      string         `db:"member_id"`
+	Metadata      []byte         `db:"metadata"`
+	LabelSelector []byte         `db:"label_selector"`
+	Path          string         `db:"path"`
+	Level         uint64         `db:"level"`
+	Total         uint64         `db:"total"`
+	Limit         uint64         `db:"limit"`
+	Offset        uint64         `db:"offset"`
+	Subject       string         `db:"subject"`
+	RoleName      string         `db:"role_name"`
+	RoleID        string         `db:"role_id"`
+	Actions       pq.StringArray `db:"actions"`
+	AccessType    string         `db:"access_type"`
+	Status        groups.Status  `db:"status"`
 }
 
 func (repo groupRepository) processRows(rows *sqlx.Rows) ([]groups.Group, error) {
@@ -1026,23 +1182,23 @@ func (repo groupRepository) processRows(rows *sqlx.Rows) ([]groups.Group, error)
 	return items, nil
 }
 
-func (repo groupRepository) getInsertQuery(c context.Context, g groups.Group) (string, error) {
+func (repo groupRepository) getInsertQuery(c context.Context, g groups.Group) (string, string, error) {
 	switch {
 	case g.Parent != "":
 		parent, err := repo.RetrieveByID(c, g.Parent)
 		if err != nil {
-			return "", err
+			return "", "", err
 		}
 		path := parent.Path + "." + g.ID
 		if len(strings.Split(path, ".")) > groups.MaxPathLength {
-			return "", fmt.Errorf("reached max nested depth")
+			return "", "", fmt.Errorf("reached max nested depth")
 		}
-		return fmt.Sprintf(`INSERT INTO groups (name, description, id, domain_id, parent_id, metadata, created_at, status, path)
-		VALUES (:name, :description, :id, :domain_id, :parent_id, :metadata, :created_at, :status, '%s')
-		RETURNING id, name, description, domain_id, COALESCE(parent_id, '') AS parent_id, metadata, created_at, status, path, nlevel(path) as level;`, path), nil
+		return `INSERT INTO groups (name, description, id, domain_id, parent_id, metadata, labels, created_at, status, path)
+		VALUES (:name, :description, :id, :domain_id, :parent_id, :metadata, :labels, :created_at, :status, :path)
+		RETURNING id, name, description, domain_id, COALESCE(parent_id, '') AS parent_id, metadata, labels, created_at, status, path, nlevel(path) as level;`, path, nil
 	default:
-		return `INSERT INTO groups (name, description, id, domain_id, metadata, created_at, status, path)
-		VALUES (:name, :description, :id, :domain_id, :metadata, :created_at, :status, :id)
-		RETURNING id, name, description, domain_id, COALESCE(parent_id, '') AS parent_id, metadata, created_at, status, path, nlevel(path) as level;`, nil
+		return `INSERT INTO groups (name, description, id, domain_id, metadata, labels, created_at, status, path)
+		VALUES (:name, :description, :id, :domain_id, :metadata, :labels, :created_at, :status, :id)
+		RETURNING id, name, description, domain_id, COALESCE(parent_id, '') AS parent_id, metadata, labels, created_at, status, path, nlevel(path) as level;`, "", nil
 	}
 }
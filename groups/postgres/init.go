@@ -6,6 +6,7 @@ package postgres
 import (
 	"github.com/absmach/supermq/pkg/errors"
 	repoerr "github.com/absmach/supermq/pkg/errors/repository"
+	"github.com/absmach/supermq/pkg/postgres"
 	rolesPostgres "github.com/absmach/supermq/pkg/roles/repo/postgres"
 	_ "github.com/jackc/pgx/v5/stdlib" // required for SQL access
 	migrate "github.com/rubenv/sql-migrate"
@@ -54,6 +55,42 @@ func Migration() (*migrate.MemoryMigrationSource, error) {
 					`DROP EXTENSION IF EXISTS LTREE`,
 				},
 			},
+			{
+				Id: "groups_03",
+				Up: []string{
+					`ALTER TABLE groups ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1`,
+				},
+				Down: []string{
+					`ALTER TABLE groups DROP COLUMN IF EXISTS version`,
+				},
+			},
+			{
+				Id: "groups_04",
+				Up: []string{
+					`ALTER TABLE groups ADD COLUMN IF NOT EXISTS disabled_by_parent BOOLEAN NOT NULL DEFAULT FALSE`,
+				},
+				Down: []string{
+					`ALTER TABLE groups DROP COLUMN IF EXISTS disabled_by_parent`,
+				},
+			},
+			{
+				Id: "groups_05",
+				Up: []string{
+					`ALTER TABLE groups ADD COLUMN IF NOT EXISTS labels JSONB`,
+				},
+				Down: []string{
+					`ALTER TABLE groups DROP COLUMN IF EXISTS labels`,
+				},
+			},
+			{
+				Id: "groups_06",
+				Up: postgres.RLSPolicy("groups", "domain_id", postgres.DomainGUC),
+				Down: []string{
+					`DROP POLICY IF EXISTS groups_owner ON groups`,
+					`ALTER TABLE groups NO FORCE ROW LEVEL SECURITY`,
+					`ALTER TABLE groups DISABLE ROW LEVEL SECURITY`,
+				},
+			},
 		},
 	}
 
@@ -63,6 +63,8 @@ func NewOperationPerm() svcutil.OperationPerm {
 const (
 	DomainOpCreateGroup svcutil.ExternalOperation = iota
 	DomainOpListGroups
+	DomainOpListTrash
+	DomainOpRestoreGroup
 	UserOpListGroups
 	ClientsOpListGroups
 	ChannelsOpListGroups
@@ -71,6 +73,8 @@ const (
 var expectedExternalOperations = []svcutil.ExternalOperation{
 	DomainOpCreateGroup,
 	DomainOpListGroups,
+	DomainOpListTrash,
+	DomainOpRestoreGroup,
 	UserOpListGroups,
 	ClientsOpListGroups,
 	ChannelsOpListGroups,
@@ -79,6 +83,8 @@ var expectedExternalOperations = []svcutil.ExternalOperation{
 var externalOperationNames = []string{
 	"DomainOpCreateGroup",
 	"DomainOpListGroups",
+	"DomainOpListTrash",
+	"DomainOpRestoreGroup",
 	"UserOpListGroups",
 	"ClientsOpListGroups",
 	"ChannelsOpListGroups",
@@ -155,6 +161,8 @@ func NewExternalOperationPermissionMap() map[svcutil.ExternalOperation]svcutil.P
 	extOpPerm := map[svcutil.ExternalOperation]svcutil.Permission{
 		DomainOpCreateGroup:  domainCreateGroupPermission,
 		DomainOpListGroups:   domainListGroupPermission,
+		DomainOpListTrash:    domainListGroupPermission,
+		DomainOpRestoreGroup: domainCreateGroupPermission,
 		UserOpListGroups:     userListGroupsPermission,
 		ClientsOpListGroups:  clientListGroupPermission,
 		ChannelsOpListGroups: chanelListGroupPermission,
@@ -19,7 +19,10 @@ import (
 	"github.com/absmach/supermq/pkg/roles"
 )
 
-var ErrGroupIDs = errors.New("invalid group ids")
+var (
+	ErrGroupIDs   = errors.New("invalid group ids")
+	errNotInTrash = errors.New("group is not in the trash")
+)
 
 type service struct {
 	repo       Repository
@@ -94,9 +97,7 @@ func (svc service) CreateGroup(ctx context.Context, session smqauthn.Session, g
 			Object:      saved.ID,
 		})
 	}
-	newBuiltInRoleMembers := map[roles.BuiltInRoleName][]roles.Member{
-		BuiltInRoleAdmin: {roles.Member(session.UserID)},
-	}
+	newBuiltInRoleMembers := svc.DefaultRoleMembers(BuiltInRoleAdmin, []roles.Member{roles.Member(session.UserID)})
 	nrps, err := svc.AddNewEntitiesRoles(ctx, session.DomainID, session.UserID, []string{saved.ID}, oprs, newBuiltInRoleMembers)
 	if err != nil {
 		return Group{}, []roles.RoleProvision{}, errors.Wrap(svcerr.ErrAddPolicies, err)
@@ -105,12 +106,33 @@ func (svc service) CreateGroup(ctx context.Context, session smqauthn.Session, g
 	return saved, nrps, nil
 }
 
-func (svc service) ViewGroup(ctx context.Context, session smqauthn.Session, id string) (Group, error) {
+func (svc service) ViewGroup(ctx context.Context, session smqauthn.Session, id string, listPerms, listCounts bool) (Group, error) {
 	group, err := svc.repo.RetrieveByIDAndUser(ctx, session.DomainID, session.UserID, id)
 	if err != nil {
 		return Group{}, errors.Wrap(svcerr.ErrViewEntity, err)
 	}
 
+	if listPerms {
+		permissions, err := svc.policy.ListPermissions(ctx, policies.Policy{
+			SubjectType: policies.UserType,
+			Subject:     session.DomainUserID,
+			Object:      id,
+			ObjectType:  policies.GroupType,
+		}, []string{})
+		if err != nil {
+			return Group{}, errors.Wrap(svcerr.ErrAuthorization, err)
+		}
+		group.Permissions = permissions
+	}
+
+	if listCounts {
+		counts, err := svc.repo.RetrieveMemberCounts(ctx, id)
+		if err != nil {
+			return Group{}, errors.Wrap(svcerr.ErrViewEntity, err)
+		}
+		group.MemberCounts = &counts
+	}
+
 	return group, nil
 }
 
@@ -133,7 +155,18 @@ func (svc service) ListGroups(ctx context.Context, session smqauthn.Session, gm
 }
 
 func (svc service) ListUserGroups(ctx context.Context, session smqauthn.Session, userID string, pm PageMeta) (Page, error) {
-	page, err := svc.repo.RetrieveUserGroups(ctx, session.DomainID, userID, pm)
+	permission := pm.Permission
+	if permission == "" {
+		permission = "read_permission"
+	}
+	ids, err := svc.listAllGroupsOfUserID(ctx, session.DomainUserID, permission)
+	if err != nil {
+		return Page{}, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+	if len(ids) == 0 {
+		return Page{PageMeta: pm}, nil
+	}
+	page, err := svc.repo.RetrieveUserGroups(ctx, session.DomainID, userID, pm, ids...)
 	if err != nil {
 		return Page{}, errors.Wrap(svcerr.ErrViewEntity, err)
 	}
@@ -157,20 +190,22 @@ func (svc service) EnableGroup(ctx context.Context, session smqauthn.Session, id
 		Status:    EnabledStatus,
 		UpdatedAt: time.Now(),
 	}
-	group, err := svc.changeGroupStatus(ctx, session, group)
+	// Enabling always propagates, so that descendants disabled by a
+	// cascading disable are restored to their previous state.
+	group, err := svc.changeGroupStatus(ctx, session, group, true)
 	if err != nil {
 		return Group{}, err
 	}
 	return group, nil
 }
 
-func (svc service) DisableGroup(ctx context.Context, session smqauthn.Session, id string) (Group, error) {
+func (svc service) DisableGroup(ctx context.Context, session smqauthn.Session, id string, cascade bool) (Group, error) {
 	group := Group{
 		ID:        id,
 		Status:    DisabledStatus,
 		UpdatedAt: time.Now(),
 	}
-	group, err := svc.changeGroupStatus(ctx, session, group)
+	group, err := svc.changeGroupStatus(ctx, session, group, cascade)
 	if err != nil {
 		return Group{}, err
 	}
@@ -420,49 +455,44 @@ func (svc service) DeleteGroup(ctx context.Context, session smqauthn.Session, id
 		return errors.Wrap(svcerr.ErrRemoveEntity, err)
 	}
 
-	g, err := svc.repo.ChangeStatus(ctx, Group{ID: id, Status: DeletedStatus})
-	if err != nil {
+	if _, err := svc.repo.ChangeStatus(ctx, Group{ID: id, Status: DeletedStatus}); err != nil {
 		return errors.Wrap(svcerr.ErrRemoveEntity, err)
 	}
 
-	filterDeletePolicies := []policies.Policy{
-		{
-			SubjectType: policies.GroupType,
-			Subject:     id,
-		},
-		{
-			ObjectType: policies.GroupType,
-			Object:     id,
-		},
-	}
-	deletePolicies := []policies.Policy{
-		{
-			SubjectType: policies.DomainType,
-			Subject:     session.DomainID,
-			Relation:    policies.DomainRelation,
-			ObjectType:  policies.GroupType,
-			Object:      id,
-		},
+	return nil
+}
+
+func (svc service) ListTrash(ctx context.Context, session smqauthn.Session, pm PageMeta) (Page, error) {
+	pm.Status = DeletedStatus
+	pm.DomainID = session.DomainID
+
+	page, err := svc.repo.RetrieveAll(ctx, pm)
+	if err != nil {
+		return Page{}, errors.Wrap(svcerr.ErrViewEntity, err)
 	}
-	if g.Parent != "" {
-		deletePolicies = append(deletePolicies, policies.Policy{
-			Domain:      session.DomainID,
-			SubjectType: policies.GroupType,
-			Subject:     g.Parent,
-			Relation:    policies.ParentGroupRelation,
-			ObjectType:  policies.GroupType,
-			Object:      id,
-		})
+	return page, nil
+}
+
+func (svc service) Restore(ctx context.Context, session smqauthn.Session, id string) (Group, error) {
+	dbGroup, err := svc.repo.RetrieveByID(ctx, id)
+	if err != nil {
+		return Group{}, errors.Wrap(svcerr.ErrViewEntity, err)
 	}
-	if err := svc.RemoveEntitiesRoles(ctx, session.DomainID, session.DomainUserID, []string{id}, filterDeletePolicies, deletePolicies); err != nil {
-		return errors.Wrap(svcerr.ErrDeletePolicies, err)
+	if dbGroup.Status != DeletedStatus {
+		return Group{}, errors.Wrap(svcerr.ErrUpdateEntity, errNotInTrash)
 	}
 
-	if err := svc.repo.Delete(ctx, id); err != nil {
-		return err
+	group := Group{
+		ID:        id,
+		Status:    EnabledStatus,
+		UpdatedAt: time.Now(),
+		UpdatedBy: session.UserID,
 	}
-
-	return nil
+	group, err = svc.repo.ChangeStatus(ctx, group)
+	if err != nil {
+		return Group{}, errors.Wrap(svcerr.ErrUpdateEntity, err)
+	}
+	return group, nil
 }
 
 func (svc service) filterAllowedGroupIDsOfUserID(ctx context.Context, userID, permission string, groupIDs []string) ([]string, error) {
@@ -495,7 +525,7 @@ func (svc service) listAllGroupsOfUserID(ctx context.Context, userID, permission
 	return allowedIDs.Policies, nil
 }
 
-func (svc service) changeGroupStatus(ctx context.Context, session smqauthn.Session, group Group) (Group, error) {
+func (svc service) changeGroupStatus(ctx context.Context, session smqauthn.Session, group Group, cascade bool) (Group, error) {
 	dbGroup, err := svc.repo.RetrieveByID(ctx, group.ID)
 	if err != nil {
 		return Group{}, errors.Wrap(svcerr.ErrViewEntity, err)
@@ -505,5 +535,8 @@ func (svc service) changeGroupStatus(ctx context.Context, session smqauthn.Sessi
 	}
 
 	group.UpdatedBy = session.UserID
+	if cascade {
+		return svc.repo.ChangeStatusWithDescendants(ctx, group)
+	}
 	return svc.repo.ChangeStatus(ctx, group)
 }
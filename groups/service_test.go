@@ -225,19 +225,69 @@ func TestCreateGroup(t *testing.T) {
 func TestViewGroup(t *testing.T) {
 	svc := newService(t)
 
+	validCounts := groups.MemberCounts{Users: 2, Groups: 1}
+	groupWithPerms := validGroup
+	groupWithPerms.Permissions = []string{"read_permission"}
+	groupWithCounts := validGroup
+	groupWithCounts.MemberCounts = &validCounts
+
 	cases := []struct {
-		desc     string
-		session  smqauthn.Session
-		id       string
-		repoResp groups.Group
-		repoErr  error
-		err      error
+		desc               string
+		session            smqauthn.Session
+		id                 string
+		listPerms          bool
+		listCounts         bool
+		repoResp           groups.Group
+		repoErr            error
+		listPermissionsRes policysvc.Permissions
+		listPermissionsErr error
+		memberCountsRes    groups.MemberCounts
+		memberCountsErr    error
+		resp               groups.Group
+		err                error
 	}{
 		{
 			desc:     "view group successfully",
 			id:       validGroup.ID,
 			session:  validSession,
 			repoResp: validGroup,
+			resp:     validGroup,
+		},
+		{
+			desc:               "view group with permissions",
+			id:                 validGroup.ID,
+			session:            validSession,
+			listPerms:          true,
+			repoResp:           validGroup,
+			listPermissionsRes: policysvc.Permissions{"read_permission"},
+			resp:               groupWithPerms,
+		},
+		{
+			desc:            "view group with member counts",
+			id:              validGroup.ID,
+			session:         validSession,
+			listCounts:      true,
+			repoResp:        validGroup,
+			memberCountsRes: validCounts,
+			resp:            groupWithCounts,
+		},
+		{
+			desc:               "view group with failed to list permissions",
+			id:                 validGroup.ID,
+			session:            validSession,
+			listPerms:          true,
+			repoResp:           validGroup,
+			listPermissionsErr: svcerr.ErrAuthorization,
+			err:                svcerr.ErrAuthorization,
+		},
+		{
+			desc:            "view group with failed to retrieve member counts",
+			id:              validGroup.ID,
+			session:         validSession,
+			listCounts:      true,
+			repoResp:        validGroup,
+			memberCountsErr: repoerr.ErrNotFound,
+			err:             svcerr.ErrViewEntity,
 		},
 		{
 			desc:    "view group with failed to retrieve",
@@ -251,14 +301,23 @@ func TestViewGroup(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.desc, func(t *testing.T) {
 			repoCall := repo.On("RetrieveByIDAndUser", context.Background(), tc.session.DomainID, tc.session.UserID, tc.id).Return(tc.repoResp, tc.repoErr)
-			got, err := svc.ViewGroup(context.Background(), validSession, tc.id)
+			policyCall := policies.On("ListPermissions", context.Background(), policysvc.Policy{
+				SubjectType: policysvc.UserType,
+				Subject:     tc.session.DomainUserID,
+				Object:      tc.id,
+				ObjectType:  policysvc.GroupType,
+			}, []string{}).Return(tc.listPermissionsRes, tc.listPermissionsErr)
+			repoCall1 := repo.On("RetrieveMemberCounts", context.Background(), tc.id).Return(tc.memberCountsRes, tc.memberCountsErr)
+			got, err := svc.ViewGroup(context.Background(), validSession, tc.id, tc.listPerms, tc.listCounts)
 			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("expected error %v to contain %v", err, tc.err))
 			if err == nil {
-				assert.Equal(t, tc.repoResp, got)
+				assert.Equal(t, tc.resp, got)
 				ok := repo.AssertCalled(t, "RetrieveByIDAndUser", context.Background(), tc.session.DomainID, tc.session.UserID, tc.id)
 				assert.True(t, ok, fmt.Sprintf("RetrieveByIDAndUser was not called on %s", tc.desc))
 			}
 			repoCall.Unset()
+			policyCall.Unset()
+			repoCall1.Unset()
 		})
 	}
 }
@@ -347,7 +406,7 @@ func TestEnableGroup(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.desc, func(t *testing.T) {
 			repoCall := repo.On("RetrieveByID", context.Background(), tc.id).Return(tc.retrieveResp, tc.retrieveErr)
-			repoCall1 := repo.On("ChangeStatus", context.Background(), mock.Anything).Return(tc.changeResp, tc.changeErr)
+			repoCall1 := repo.On("ChangeStatusWithDescendants", context.Background(), mock.Anything).Return(tc.changeResp, tc.changeErr)
 			got, err := svc.EnableGroup(context.Background(), validSession, tc.id)
 			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("expected error %v to contain %v", err, tc.err))
 			if err == nil {
@@ -402,7 +461,7 @@ func TestDisableGroup(t *testing.T) {
 		t.Run(tc.desc, func(t *testing.T) {
 			repoCall := repo.On("RetrieveByID", context.Background(), tc.id).Return(tc.retrieveResp, tc.retrieveErr)
 			repoCall1 := repo.On("ChangeStatus", context.Background(), mock.Anything).Return(tc.changeResp, tc.changeErr)
-			got, err := svc.DisableGroup(context.Background(), validSession, tc.id)
+			got, err := svc.DisableGroup(context.Background(), validSession, tc.id, false)
 			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("expected error %v to contain %v", err, tc.err))
 			if err == nil {
 				assert.Equal(t, tc.changeResp, got)
@@ -500,7 +559,7 @@ func TestListGroups(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.desc, func(t *testing.T) {
 			repoCall := repo.On("RetrieveAll", context.Background(), tc.pageMeta).Return(tc.retrieveAllRes, tc.retrieveAllErr)
-			repoCall1 := repo.On("RetrieveUserGroups", context.Background(), tc.session.DomainID, tc.session.UserID, tc.pageMeta).Return(tc.retrieveUserGroupRes, tc.retrieveUserGroupErr)
+			repoCall1 := repo.On("RetrieveUserGroups", context.Background(), tc.session.DomainID, tc.session.UserID, tc.pageMeta, []string(nil)).Return(tc.retrieveUserGroupRes, tc.retrieveUserGroupErr)
 			got, err := svc.ListGroups(context.Background(), tc.session, tc.pageMeta)
 			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("expected error %v to contain %v", err, tc.err))
 			assert.Equal(t, tc.resp, got)
@@ -518,6 +577,8 @@ func TestListUserGroups(t *testing.T) {
 		session              smqauthn.Session
 		userID               string
 		pageMeta             groups.PageMeta
+		listAllObjectsRes    policysvc.PolicyPage
+		listAllObjectsErr    error
 		retrieveUserGroupRes groups.Page
 		retrieveUserGroupErr error
 		resp                 groups.Page
@@ -531,6 +592,9 @@ func TestListUserGroups(t *testing.T) {
 				Limit:  10,
 				Offset: 0,
 			},
+			listAllObjectsRes: policysvc.PolicyPage{
+				Policies: []string{validGroup.ID},
+			},
 			retrieveUserGroupRes: groups.Page{
 				Groups: []groups.Group{validGroup},
 				PageMeta: groups.PageMeta{
@@ -545,6 +609,35 @@ func TestListUserGroups(t *testing.T) {
 			},
 			err: nil,
 		},
+		{
+			desc:    "list user groups without visible groups",
+			session: validSession,
+			userID:  validID,
+			pageMeta: groups.PageMeta{
+				Limit:  10,
+				Offset: 0,
+			},
+			listAllObjectsRes: policysvc.PolicyPage{},
+			resp: groups.Page{
+				PageMeta: groups.PageMeta{
+					Limit:  10,
+					Offset: 0,
+				},
+			},
+			err: nil,
+		},
+		{
+			desc:    "list user groups with failed to list all objects",
+			session: validSession,
+			userID:  validID,
+			pageMeta: groups.PageMeta{
+				Limit:  10,
+				Offset: 0,
+			},
+			listAllObjectsErr: svcerr.ErrAuthorization,
+			resp:              groups.Page{},
+			err:               svcerr.ErrViewEntity,
+		},
 		{
 			desc:    "list user groups with failed to retrieve",
 			session: validSession,
@@ -553,6 +646,9 @@ func TestListUserGroups(t *testing.T) {
 				Limit:  10,
 				Offset: 0,
 			},
+			listAllObjectsRes: policysvc.PolicyPage{
+				Policies: []string{validGroup.ID},
+			},
 			retrieveUserGroupErr: repoerr.ErrNotFound,
 			resp:                 groups.Page{},
 			err:                  svcerr.ErrViewEntity,
@@ -561,10 +657,17 @@ func TestListUserGroups(t *testing.T) {
 
 	for _, tc := range cases {
 		t.Run(tc.desc, func(t *testing.T) {
-			repoCall := repo.On("RetrieveUserGroups", context.Background(), tc.session.DomainID, tc.userID, tc.pageMeta).Return(tc.retrieveUserGroupRes, tc.retrieveUserGroupErr)
+			policyCall := policies.On("ListAllObjects", context.Background(), policysvc.Policy{
+				SubjectType: policysvc.UserType,
+				Subject:     tc.session.DomainUserID,
+				Permission:  "read_permission",
+				ObjectType:  policysvc.GroupType,
+			}).Return(tc.listAllObjectsRes, tc.listAllObjectsErr)
+			repoCall := repo.On("RetrieveUserGroups", context.Background(), tc.session.DomainID, tc.userID, tc.pageMeta, tc.listAllObjectsRes.Policies).Return(tc.retrieveUserGroupRes, tc.retrieveUserGroupErr)
 			got, err := svc.ListUserGroups(context.Background(), tc.session, tc.userID, tc.pageMeta)
 			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("expected error %v to contain %v", err, tc.err))
 			assert.Equal(t, tc.resp, got)
+			policyCall.Unset()
 			repoCall.Unset()
 		})
 	}
@@ -1205,8 +1308,6 @@ func TestDeleteGroup(t *testing.T) {
 		id                string
 		changeStatusRes   groups.Group
 		changeStatusErr   error
-		deletePoliciesErr error
-		deleteErr         error
 		unsetFromChannels error
 		unsetFromClients  error
 		err               error
@@ -1241,41 +1342,18 @@ func TestDeleteGroup(t *testing.T) {
 			changeStatusErr: repoerr.ErrNotFound,
 			err:             repoerr.ErrNotFound,
 		},
-		{
-			desc:            "delete group with failed to delete",
-			id:              validGroup.ID,
-			changeStatusRes: validGroup,
-			deleteErr:       repoerr.ErrNotFound,
-			err:             repoerr.ErrNotFound,
-		},
-		{
-			desc:              "delete group with failed to delete policies",
-			id:                validGroup.ID,
-			changeStatusRes:   validGroup,
-			deleteErr:         nil,
-			deletePoliciesErr: svcerr.ErrAuthorization,
-			err:               svcerr.ErrDeletePolicies,
-		},
 	}
 
 	for _, tc := range cases {
 		t.Run(tc.desc, func(t *testing.T) {
 			repoCall := repo.On("ChangeStatus", context.Background(), groups.Group{ID: tc.id, Status: groups.DeletedStatus}).Return(tc.changeStatusRes, tc.changeStatusErr)
-			repoCall1 := repo.On("Delete", context.Background(), tc.id).Return(tc.deleteErr)
 			svcCall := channels.On("UnsetParentGroupFromChannels", context.Background(), &grpcChannelsV1.UnsetParentGroupFromChannelsReq{ParentGroupId: tc.id}).Return(&grpcChannelsV1.UnsetParentGroupFromChannelsRes{}, tc.unsetFromChannels)
 			svcCall1 := clients.On("UnsetParentGroupFromClient", context.Background(), &grpcClientsV1.UnsetParentGroupFromClientReq{ParentGroupId: tc.id}).Return(&grpcClientsV1.UnsetParentGroupFromClientRes{}, tc.unsetFromClients)
-			repoCall2 := repo.On("RetrieveEntitiesRolesActionsMembers", context.Background(), []string{tc.id}).Return([]roles.EntityActionRole{}, []roles.EntityMemberRole{}, nil)
-			policyCall := policies.On("DeletePolicyFilter", context.Background(), mock.Anything).Return(tc.deletePoliciesErr)
-			policyCall1 := policies.On("DeletePolicies", context.Background(), mock.Anything).Return(nil)
 			err := svc.DeleteGroup(context.Background(), validSession, tc.id)
 			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("expected error %v to contain %v", err, tc.err))
-			policyCall.Unset()
 			repoCall.Unset()
-			repoCall1.Unset()
 			svcCall.Unset()
 			svcCall1.Unset()
-			repoCall2.Unset()
-			policyCall1.Unset()
 		})
 	}
 }
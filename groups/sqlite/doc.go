@@ -0,0 +1,5 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package sqlite contains the SQLite implementation of groups repository layer.
+package sqlite
@@ -0,0 +1,310 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlite_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/0x6flab/namegenerator"
+	"github.com/absmach/supermq/groups"
+	"github.com/absmach/supermq/groups/sqlite"
+	"github.com/absmach/supermq/internal/testsutil"
+	"github.com/absmach/supermq/pkg/errors"
+	repoerr "github.com/absmach/supermq/pkg/errors/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var namegen = namegenerator.NewGenerator()
+
+func saveGroup(t *testing.T, repo groups.Repository, domainID, parentID string) groups.Group {
+	group := groups.Group{
+		ID:        testsutil.GenerateUUID(t),
+		Domain:    domainID,
+		Parent:    parentID,
+		Name:      namegen.Generate(),
+		Metadata:  map[string]interface{}{"key": "value"},
+		CreatedAt: time.Now().UTC().Truncate(time.Millisecond),
+		Status:    groups.EnabledStatus,
+	}
+	saved, err := repo.Save(context.Background(), group)
+	require.Nil(t, err, fmt.Sprintf("save group unexpected error: %s", err))
+	return saved
+}
+
+func TestSave(t *testing.T) {
+	t.Cleanup(func() {
+		_, err := db.Exec("DELETE FROM groups")
+		require.Nil(t, err, fmt.Sprintf("clean groups unexpected error: %s", err))
+	})
+	repo := sqlite.New(database)
+
+	domainID := testsutil.GenerateUUID(t)
+	parent := saveGroup(t, repo, domainID, "")
+	assert.Equal(t, parent.ID, parent.Path)
+	assert.Equal(t, 1, parent.Level)
+
+	child := saveGroup(t, repo, domainID, parent.ID)
+	assert.Equal(t, parent.ID+"."+child.ID, child.Path)
+	assert.Equal(t, 2, child.Level)
+
+	cases := []struct {
+		desc  string
+		group groups.Group
+		err   error
+	}{
+		{
+			desc: "add group with duplicate ID",
+			group: groups.Group{
+				ID:        parent.ID,
+				Domain:    domainID,
+				Name:      namegen.Generate(),
+				CreatedAt: time.Now().UTC().Truncate(time.Millisecond),
+				Status:    groups.EnabledStatus,
+			},
+			err: repoerr.ErrConflict,
+		},
+		{
+			desc: "add group with duplicate name in the domain",
+			group: groups.Group{
+				ID:        testsutil.GenerateUUID(t),
+				Domain:    domainID,
+				Name:      parent.Name,
+				CreatedAt: time.Now().UTC().Truncate(time.Millisecond),
+				Status:    groups.EnabledStatus,
+			},
+			err: repoerr.ErrConflict,
+		},
+		{
+			desc: "add group with non-existing parent",
+			group: groups.Group{
+				ID:        testsutil.GenerateUUID(t),
+				Domain:    domainID,
+				Parent:    testsutil.GenerateUUID(t),
+				Name:      namegen.Generate(),
+				CreatedAt: time.Now().UTC().Truncate(time.Millisecond),
+				Status:    groups.EnabledStatus,
+			},
+			err: repoerr.ErrCreateEntity,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := repo.Save(context.Background(), tc.group)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("expected %s got %s\n", tc.err, err))
+		})
+	}
+}
+
+func TestRetrieveByID(t *testing.T) {
+	t.Cleanup(func() {
+		_, err := db.Exec("DELETE FROM groups")
+		require.Nil(t, err, fmt.Sprintf("clean groups unexpected error: %s", err))
+	})
+	repo := sqlite.New(database)
+
+	group := saveGroup(t, repo, testsutil.GenerateUUID(t), "")
+
+	cases := []struct {
+		desc string
+		id   string
+		err  error
+	}{
+		{
+			desc: "retrieve existing group",
+			id:   group.ID,
+			err:  nil,
+		},
+		{
+			desc: "retrieve non-existing group",
+			id:   testsutil.GenerateUUID(t),
+			err:  repoerr.ErrNotFound,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			rGroup, err := repo.RetrieveByID(context.Background(), tc.id)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("expected %s got %s\n", tc.err, err))
+			if err == nil {
+				assert.Equal(t, group.ID, rGroup.ID)
+				assert.Equal(t, group.Name, rGroup.Name)
+				assert.Equal(t, group.Metadata, rGroup.Metadata)
+				assert.Equal(t, group.Path, rGroup.Path)
+			}
+		})
+	}
+}
+
+func TestAssignParentGroup(t *testing.T) {
+	t.Cleanup(func() {
+		_, err := db.Exec("DELETE FROM groups")
+		require.Nil(t, err, fmt.Sprintf("clean groups unexpected error: %s", err))
+	})
+	repo := sqlite.New(database)
+
+	domainID := testsutil.GenerateUUID(t)
+	parent := saveGroup(t, repo, domainID, "")
+	child := saveGroup(t, repo, domainID, "")
+	grandchild := saveGroup(t, repo, domainID, child.ID)
+
+	err := repo.AssignParentGroup(context.Background(), parent.ID, child.ID)
+	require.Nil(t, err, fmt.Sprintf("assign parent unexpected error: %s", err))
+
+	rChild, err := repo.RetrieveByID(context.Background(), child.ID)
+	require.Nil(t, err, fmt.Sprintf("retrieve group unexpected error: %s", err))
+	assert.Equal(t, parent.ID, rChild.Parent)
+	assert.Equal(t, parent.ID+"."+child.ID, rChild.Path)
+
+	// The subtree of the assigned group is re-rooted as well.
+	rGrandchild, err := repo.RetrieveByID(context.Background(), grandchild.ID)
+	require.Nil(t, err, fmt.Sprintf("retrieve group unexpected error: %s", err))
+	assert.Equal(t, parent.ID+"."+child.ID+"."+grandchild.ID, rGrandchild.Path)
+
+	err = repo.UnassignParentGroup(context.Background(), parent.ID, child.ID)
+	require.Nil(t, err, fmt.Sprintf("unassign parent unexpected error: %s", err))
+
+	rChild, err = repo.RetrieveByID(context.Background(), child.ID)
+	require.Nil(t, err, fmt.Sprintf("retrieve group unexpected error: %s", err))
+	assert.Equal(t, "", rChild.Parent)
+	assert.Equal(t, child.ID, rChild.Path)
+
+	rGrandchild, err = repo.RetrieveByID(context.Background(), grandchild.ID)
+	require.Nil(t, err, fmt.Sprintf("retrieve group unexpected error: %s", err))
+	assert.Equal(t, child.ID+"."+grandchild.ID, rGrandchild.Path)
+}
+
+func TestRetrieveHierarchy(t *testing.T) {
+	t.Cleanup(func() {
+		_, err := db.Exec("DELETE FROM groups")
+		require.Nil(t, err, fmt.Sprintf("clean groups unexpected error: %s", err))
+	})
+	repo := sqlite.New(database)
+
+	domainID := testsutil.GenerateUUID(t)
+	root := saveGroup(t, repo, domainID, "")
+	middle := saveGroup(t, repo, domainID, root.ID)
+	leaf := saveGroup(t, repo, domainID, middle.ID)
+
+	cases := []struct {
+		desc string
+		id   string
+		hm   groups.HierarchyPageMeta
+		ids  []string
+	}{
+		{
+			desc: "retrieve descendants of the root",
+			id:   root.ID,
+			hm:   groups.HierarchyPageMeta{Direction: -1},
+			ids:  []string{root.ID, middle.ID, leaf.ID},
+		},
+		{
+			desc: "retrieve descendants of the middle group",
+			id:   middle.ID,
+			hm:   groups.HierarchyPageMeta{Direction: -1},
+			ids:  []string{middle.ID, leaf.ID},
+		},
+		{
+			desc: "retrieve ancestors of the leaf",
+			id:   leaf.ID,
+			hm:   groups.HierarchyPageMeta{Direction: 1},
+			ids:  []string{root.ID, middle.ID, leaf.ID},
+		},
+		{
+			desc: "retrieve ancestors of the root",
+			id:   root.ID,
+			hm:   groups.HierarchyPageMeta{Direction: 1},
+			ids:  []string{root.ID},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			page, err := repo.RetrieveHierarchy(context.Background(), tc.id, tc.hm)
+			require.Nil(t, err, fmt.Sprintf("retrieve hierarchy unexpected error: %s", err))
+			ids := []string{}
+			for _, g := range page.Groups {
+				ids = append(ids, g.ID)
+			}
+			assert.ElementsMatch(t, tc.ids, ids)
+		})
+	}
+
+	page, err := repo.RetrieveHierarchy(context.Background(), root.ID, groups.HierarchyPageMeta{Direction: -1})
+	require.Nil(t, err, fmt.Sprintf("retrieve hierarchy unexpected error: %s", err))
+	levels := map[string]int{}
+	for _, g := range page.Groups {
+		levels[g.ID] = g.Level
+	}
+	assert.Equal(t, map[string]int{root.ID: 1, middle.ID: 2, leaf.ID: 3}, levels)
+}
+
+func TestChangeStatusWithDescendants(t *testing.T) {
+	t.Cleanup(func() {
+		_, err := db.Exec("DELETE FROM groups")
+		require.Nil(t, err, fmt.Sprintf("clean groups unexpected error: %s", err))
+	})
+	repo := sqlite.New(database)
+
+	domainID := testsutil.GenerateUUID(t)
+	root := saveGroup(t, repo, domainID, "")
+	child := saveGroup(t, repo, domainID, root.ID)
+	disabledChild := saveGroup(t, repo, domainID, root.ID)
+
+	_, err := repo.ChangeStatus(context.Background(), groups.Group{ID: disabledChild.ID, Status: groups.DisabledStatus, UpdatedAt: time.Now().UTC()})
+	require.Nil(t, err, fmt.Sprintf("change status unexpected error: %s", err))
+
+	_, err = repo.ChangeStatusWithDescendants(context.Background(), groups.Group{ID: root.ID, Status: groups.DisabledStatus, UpdatedAt: time.Now().UTC()})
+	require.Nil(t, err, fmt.Sprintf("disable with descendants unexpected error: %s", err))
+
+	rChild, err := repo.RetrieveByID(context.Background(), child.ID)
+	require.Nil(t, err, fmt.Sprintf("retrieve group unexpected error: %s", err))
+	assert.Equal(t, groups.DisabledStatus, rChild.Status)
+
+	_, err = repo.ChangeStatusWithDescendants(context.Background(), groups.Group{ID: root.ID, Status: groups.EnabledStatus, UpdatedAt: time.Now().UTC()})
+	require.Nil(t, err, fmt.Sprintf("enable with descendants unexpected error: %s", err))
+
+	rChild, err = repo.RetrieveByID(context.Background(), child.ID)
+	require.Nil(t, err, fmt.Sprintf("retrieve group unexpected error: %s", err))
+	assert.Equal(t, groups.EnabledStatus, rChild.Status)
+
+	// A group disabled on its own is not re-enabled by the cascade.
+	rDisabled, err := repo.RetrieveByID(context.Background(), disabledChild.ID)
+	require.Nil(t, err, fmt.Sprintf("retrieve group unexpected error: %s", err))
+	assert.Equal(t, groups.DisabledStatus, rDisabled.Status)
+}
+
+func TestDelete(t *testing.T) {
+	t.Cleanup(func() {
+		_, err := db.Exec("DELETE FROM groups")
+		require.Nil(t, err, fmt.Sprintf("clean groups unexpected error: %s", err))
+	})
+	repo := sqlite.New(database)
+
+	group := saveGroup(t, repo, testsutil.GenerateUUID(t), "")
+
+	cases := []struct {
+		desc string
+		id   string
+		err  error
+	}{
+		{
+			desc: "delete existing group",
+			id:   group.ID,
+			err:  nil,
+		},
+		{
+			desc: "delete non-existing group",
+			id:   testsutil.GenerateUUID(t),
+			err:  repoerr.ErrNotFound,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := repo.Delete(context.Background(), tc.id)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("expected %s got %s\n", tc.err, err))
+		})
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlite
+
+import (
+	"github.com/absmach/supermq/pkg/errors"
+	repoerr "github.com/absmach/supermq/pkg/errors/repository"
+	rolesSQLite "github.com/absmach/supermq/pkg/roles/repo/sqlite"
+	migrate "github.com/rubenv/sql-migrate"
+	_ "modernc.org/sqlite" // required for SQL access
+)
+
+// Migration returns the SQLite migrations of the Groups service. The
+// backend starts from a fresh database, so a single migration captures the
+// schema the Postgres migrations converge to, with the ltree path kept as
+// a dotted TEXT column and metadata and labels as JSON text.
+func Migration() (*migrate.MemoryMigrationSource, error) {
+	rolesMigration, err := rolesSQLite.Migration(rolesTableNamePrefix, entityTableName, entityIDColumnName)
+	if err != nil {
+		return &migrate.MemoryMigrationSource{}, errors.Wrap(repoerr.ErrRoleMigration, err)
+	}
+
+	groupsMigration := &migrate.MemoryMigrationSource{
+		Migrations: []*migrate.Migration{
+			{
+				Id: "groups_sqlite_01",
+				Up: []string{
+					`CREATE TABLE IF NOT EXISTS groups (
+						id					VARCHAR(36) PRIMARY KEY,
+						parent_id			VARCHAR(36),
+						domain_id			VARCHAR(36) NOT NULL,
+						name				VARCHAR(1024) NOT NULL,
+						description			VARCHAR(1024),
+						metadata			TEXT,
+						labels				TEXT,
+						path				TEXT,
+						created_at			TIMESTAMP,
+						updated_at			TIMESTAMP,
+						updated_by			VARCHAR(254),
+						status				SMALLINT NOT NULL DEFAULT 0 CHECK (status >= 0),
+						version				BIGINT NOT NULL DEFAULT 1,
+						disabled_by_parent	BOOLEAN NOT NULL DEFAULT FALSE,
+						UNIQUE		(domain_id, name),
+						FOREIGN KEY (parent_id) REFERENCES groups (id) ON DELETE SET NULL,
+						CHECK (id != parent_id)
+					)`,
+					`CREATE INDEX IF NOT EXISTS groups_path_idx ON groups (path)`,
+				},
+				Down: []string{
+					`DROP INDEX IF EXISTS groups_path_idx`,
+					`DROP TABLE IF EXISTS groups`,
+				},
+			},
+		},
+	}
+
+	groupsMigration.Migrations = append(groupsMigration.Migrations, rolesMigration.Migrations...)
+
+	return groupsMigration, nil
+}
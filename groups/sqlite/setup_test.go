@@ -0,0 +1,55 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlite_test
+
+import (
+	"log"
+	"os"
+	"path/filepath"
+	"testing"
+
+	gsqlite "github.com/absmach/supermq/groups/sqlite"
+	pgclient "github.com/absmach/supermq/pkg/postgres"
+	sqliteclient "github.com/absmach/supermq/pkg/sqlite"
+	"github.com/jmoiron/sqlx"
+	"go.opentelemetry.io/otel"
+)
+
+var (
+	db       *sqlx.DB
+	database pgclient.Database
+	tracer   = otel.Tracer("repo_tests")
+)
+
+func TestMain(m *testing.M) {
+	dir, err := os.MkdirTemp("", "groups-sqlite")
+	if err != nil {
+		log.Fatalf("Could not create test directory: %s", err)
+	}
+
+	cfg := sqliteclient.Config{
+		File:        filepath.Join(dir, "groups.db"),
+		AutoMigrate: true,
+	}
+
+	mig, err := gsqlite.Migration()
+	if err != nil {
+		log.Fatalf("Could not get DB migrations: %s", err)
+	}
+	if db, err = sqliteclient.Setup(cfg, *mig); err != nil {
+		log.Fatalf("Could not setup test DB connection: %s", err)
+	}
+
+	database = sqliteclient.NewDatabase(db, cfg, tracer)
+
+	code := m.Run()
+
+	// Defers will not be run when using os.Exit
+	db.Close()
+	if err := os.RemoveAll(dir); err != nil {
+		log.Fatalf("Could not remove test directory: %s", err)
+	}
+
+	os.Exit(code)
+}
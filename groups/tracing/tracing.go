@@ -37,11 +37,15 @@ func (tm *tracingMiddleware) CreateGroup(ctx context.Context, session authn.Sess
 }
 
 // ViewGroup traces the "ViewGroup" operation of the wrapped groups.Service.
-func (tm *tracingMiddleware) ViewGroup(ctx context.Context, session authn.Session, id string) (groups.Group, error) {
-	ctx, span := tm.tracer.Start(ctx, "svc_view_group", trace.WithAttributes(attribute.String("id", id)))
+func (tm *tracingMiddleware) ViewGroup(ctx context.Context, session authn.Session, id string, listPerms, listCounts bool) (groups.Group, error) {
+	ctx, span := tm.tracer.Start(ctx, "svc_view_group", trace.WithAttributes(
+		attribute.String("id", id),
+		attribute.Bool("list_perms", listPerms),
+		attribute.Bool("list_counts", listCounts),
+	))
 	defer span.End()
 
-	return tm.svc.ViewGroup(ctx, session, id)
+	return tm.svc.ViewGroup(ctx, session, id, listPerms, listCounts)
 }
 
 // ListGroups traces the "ListGroups" operation of the wrapped groups.Service.
@@ -97,11 +101,28 @@ func (tm *tracingMiddleware) EnableGroup(ctx context.Context, session authn.Sess
 }
 
 // DisableGroup traces the "DisableGroup" operation of the wrapped groups.Service.
-func (tm *tracingMiddleware) DisableGroup(ctx context.Context, session authn.Session, id string) (groups.Group, error) {
-	ctx, span := tm.tracer.Start(ctx, "svc_disable_group", trace.WithAttributes(attribute.String("id", id)))
+func (tm *tracingMiddleware) DisableGroup(ctx context.Context, session authn.Session, id string, cascade bool) (groups.Group, error) {
+	ctx, span := tm.tracer.Start(ctx, "svc_disable_group", trace.WithAttributes(attribute.String("id", id), attribute.Bool("cascade", cascade)))
+	defer span.End()
+
+	return tm.svc.DisableGroup(ctx, session, id, cascade)
+}
+
+func (tm *tracingMiddleware) ListTrash(ctx context.Context, session authn.Session, pm groups.PageMeta) (groups.Page, error) {
+	ctx, span := tm.tracer.Start(ctx, "svc_list_group_trash", trace.WithAttributes(
+		attribute.Int64("offset", int64(pm.Offset)),
+		attribute.Int64("limit", int64(pm.Limit)),
+	))
+	defer span.End()
+
+	return tm.svc.ListTrash(ctx, session, pm)
+}
+
+func (tm *tracingMiddleware) Restore(ctx context.Context, session authn.Session, id string) (groups.Group, error) {
+	ctx, span := tm.tracer.Start(ctx, "svc_restore_group", trace.WithAttributes(attribute.String("id", id)))
 	defer span.End()
 
-	return tm.svc.DisableGroup(ctx, session, id)
+	return tm.svc.Restore(ctx, session, id)
 }
 
 func (tm *tracingMiddleware) RetrieveGroupHierarchy(ctx context.Context, session authn.Session, id string, hm groups.HierarchyPageMeta) (groups.HierarchyPage, error) {
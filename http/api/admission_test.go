@@ -0,0 +1,84 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package api_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/absmach/supermq/http/api"
+	smqlog "github.com/absmach/supermq/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdmission(t *testing.T) {
+	adm := api.NewAdmission(1, 0, 2*time.Second)
+	release := make(chan struct{})
+	serving := make(chan struct{})
+	handler := adm.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case serving <- struct{}{}:
+		default:
+		}
+		<-release
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	ts := httptest.NewServer(handler)
+	defer ts.Close()
+
+	first := make(chan error)
+	go func() {
+		res, err := http.Get(ts.URL)
+		if err == nil {
+			res.Body.Close()
+		}
+		first <- err
+	}()
+	<-serving
+
+	// The single slot is taken and the queue is full, so the request
+	// is shed with a retry hint.
+	res, err := http.Get(ts.URL)
+	require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusServiceUnavailable, res.StatusCode)
+	assert.Equal(t, "2", res.Header.Get("Retry-After"))
+
+	close(release)
+	require.Nil(t, <-first, "unexpected error on admitted request")
+
+	// With the slot free again, requests are admitted. The slot is
+	// released just after the first response is written, so poll.
+	assert.Eventually(t, func() bool {
+		res, err := http.Get(ts.URL)
+		if err != nil {
+			return false
+		}
+		defer res.Body.Close()
+		return res.StatusCode == http.StatusAccepted
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestAdmissionHealthBypass(t *testing.T) {
+	adm := api.NewAdmission(1, 0, time.Second)
+	ts := httptest.NewServer(api.MakeHandler(smqlog.NewMock(), instanceID, adm))
+	defer ts.Close()
+
+	// Fill the only slot so that message endpoints shed load.
+	adm.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		res, err := http.Get(ts.URL + "/health")
+		require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+		defer res.Body.Close()
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+
+		res, err = http.Post(ts.URL+"/channels/1/messages", "application/json", nil)
+		require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+		defer res.Body.Close()
+		assert.Equal(t, http.StatusServiceUnavailable, res.StatusCode)
+	})).ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+}
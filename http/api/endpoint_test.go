@@ -4,12 +4,14 @@
 package api_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/absmach/mgate"
 	proxy "github.com/absmach/mgate/pkg/http"
@@ -41,11 +43,12 @@ var clientID = testsutil.GenerateUUID(&testing.T{})
 
 func newService(authn smqauthn.Authentication, clients grpcClientsV1.ClientsServiceClient, channels grpcChannelsV1.ChannelsServiceClient) (session.Handler, *pubsub.PubSub) {
 	pub := new(pubsub.PubSub)
-	return server.NewHandler(pub, authn, clients, channels, smqlog.NewMock()), pub
+	return server.NewHandler(pub, authn, clients, channels, smqlog.NewMock(), 1024*1024), pub
 }
 
 func newTargetHTTPServer() *httptest.Server {
-	mux := api.MakeHandler(smqlog.NewMock(), instanceID)
+	adm := api.NewAdmission(1024, 1024, time.Second)
+	mux := api.MakeHandler(smqlog.NewMock(), instanceID, adm)
 	return httptest.NewServer(mux)
 }
 
@@ -242,3 +245,77 @@ func TestPublish(t *testing.T) {
 		})
 	}
 }
+
+func TestPublishBatch(t *testing.T) {
+	clients := new(climocks.ClientsServiceClient)
+	authn := new(authnMocks.Authentication)
+	channels := new(chmocks.ChannelsServiceClient)
+	chanID := "1"
+	clientKey := "client_key"
+	svc, pub := newService(authn, clients, channels)
+	target := newTargetHTTPServer()
+	defer target.Close()
+	ts, err := newProxyHTPPServer(svc, target)
+	assert.Nil(t, err, fmt.Sprintf("failed to create proxy server with err: %v", err))
+	defer ts.Close()
+
+	cases := []struct {
+		desc     string
+		msg      string
+		status   int
+		accepted int
+	}{
+		{
+			desc:     "publish batch successfully",
+			msg:      `[[{"n":"current","t":-1,"v":1.6}],[{"n":"voltage","t":-1,"v":230}]]`,
+			status:   http.StatusAccepted,
+			accepted: 2,
+		},
+		{
+			desc:   "publish batch with malformed payload",
+			msg:    `{"n":"current","v":1.6}`,
+			status: http.StatusBadRequest,
+		},
+		{
+			desc:   "publish empty batch",
+			msg:    `[]`,
+			status: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			clientsCall := clients.On("Authenticate", mock.Anything, &grpcClientsV1.AuthnReq{ClientSecret: clientKey}).Return(&grpcClientsV1.AuthnRes{Id: clientID, Authenticated: true}, nil)
+			channelsCall := channels.On("Authorize", mock.Anything, mock.Anything).Return(&grpcChannelsV1.AuthzRes{Authorized: true}, nil)
+			svcCall := pub.On("Publish", mock.Anything, chanID, mock.Anything).Return(nil)
+			req := testRequest{
+				client:      ts.Client(),
+				method:      http.MethodPost,
+				url:         fmt.Sprintf("%s/channels/%s/messages/batch", ts.URL, chanID),
+				contentType: "application/senml+json",
+				token:       clientKey,
+				body:        strings.NewReader(tc.msg),
+			}
+			res, err := req.make()
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+			assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+			if tc.status == http.StatusAccepted {
+				var body struct {
+					Accepted int `json:"accepted"`
+					Results  []struct {
+						Index  int    `json:"index"`
+						Status string `json:"status"`
+					} `json:"results"`
+				}
+				err := json.NewDecoder(res.Body).Decode(&body)
+				assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+				assert.Equal(t, tc.accepted, body.Accepted)
+				assert.Len(t, body.Results, tc.accepted)
+			}
+			res.Body.Close()
+			svcCall.Unset()
+			clientsCall.Unset()
+			channelsCall.Unset()
+		})
+	}
+}
@@ -4,6 +4,8 @@
 package api
 
 import (
+	"encoding/json"
+
 	apiutil "github.com/absmach/supermq/api/http/util"
 	"github.com/absmach/supermq/pkg/messaging"
 )
@@ -23,3 +25,24 @@ func (req publishReq) validate() error {
 
 	return nil
 }
+
+type batchPublishReq struct {
+	token string
+	items []json.RawMessage
+}
+
+func (req batchPublishReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerKey
+	}
+	if len(req.items) == 0 {
+		return apiutil.ErrEmptyMessage
+	}
+	for _, item := range req.items {
+		if len(item) == 0 {
+			return apiutil.ErrEmptyMessage
+		}
+	}
+
+	return nil
+}
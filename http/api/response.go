@@ -9,7 +9,10 @@ import (
 	"github.com/absmach/supermq"
 )
 
-var _ supermq.Response = (*publishMessageRes)(nil)
+var (
+	_ supermq.Response = (*publishMessageRes)(nil)
+	_ supermq.Response = (*batchPublishRes)(nil)
+)
 
 type publishMessageRes struct{}
 
@@ -24,3 +27,25 @@ func (res publishMessageRes) Headers() map[string]string {
 func (res publishMessageRes) Empty() bool {
 	return true
 }
+
+type batchItemRes struct {
+	Index  int    `json:"index"`
+	Status string `json:"status"`
+}
+
+type batchPublishRes struct {
+	Accepted int            `json:"accepted"`
+	Results  []batchItemRes `json:"results"`
+}
+
+func (res batchPublishRes) Code() int {
+	return http.StatusAccepted
+}
+
+func (res batchPublishRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res batchPublishRes) Empty() bool {
+	return false
+}
@@ -5,6 +5,7 @@ package api
 
 import (
 	"context"
+	"encoding/json"
 	"io"
 	"log/slog"
 	"net/http"
@@ -26,26 +27,40 @@ const (
 	contentType = "application/json"
 )
 
-// MakeHandler returns a HTTP handler for API endpoints.
-func MakeHandler(logger *slog.Logger, instanceID string) http.Handler {
+// MakeHandler returns a HTTP handler for API endpoints. Message
+// endpoints are served within the admission limits; health and
+// metrics stay outside them, so that probes and scrapes keep
+// answering while the adapter sheds load.
+func MakeHandler(logger *slog.Logger, instanceID string, adm *Admission) http.Handler {
 	opts := []kithttp.ServerOption{
 		kithttp.ServerErrorEncoder(apiutil.LoggingErrorEncoder(logger, api.EncodeError)),
 	}
 
 	r := chi.NewRouter()
-	r.Post("/channels/{chanID}/messages", otelhttp.NewHandler(kithttp.NewServer(
-		sendMessageEndpoint(),
-		decodeRequest,
-		api.EncodeResponse,
-		opts...,
-	), "publish").ServeHTTP)
-
-	r.Post("/channels/{chanID}/messages/*", otelhttp.NewHandler(kithttp.NewServer(
-		sendMessageEndpoint(),
-		decodeRequest,
-		api.EncodeResponse,
-		opts...,
-	), "publish").ServeHTTP)
+	r.Group(func(r chi.Router) {
+		r.Use(adm.Middleware)
+
+		r.Post("/channels/{chanID}/messages", otelhttp.NewHandler(kithttp.NewServer(
+			sendMessageEndpoint(),
+			decodeRequest,
+			api.EncodeResponse,
+			opts...,
+		), "publish").ServeHTTP)
+
+		r.Post("/channels/{chanID}/messages/batch", otelhttp.NewHandler(kithttp.NewServer(
+			sendBatchMessageEndpoint(),
+			decodeBatchRequest,
+			api.EncodeResponse,
+			opts...,
+		), "publish_batch").ServeHTTP)
+
+		r.Post("/channels/{chanID}/messages/*", otelhttp.NewHandler(kithttp.NewServer(
+			sendMessageEndpoint(),
+			decodeRequest,
+			api.EncodeResponse,
+			opts...,
+		), "publish").ServeHTTP)
+	})
 	r.Get("/health", supermq.Health("http", instanceID))
 	r.Handle("/metrics", promhttp.Handler())
 
@@ -77,3 +92,28 @@ func decodeRequest(_ context.Context, r *http.Request) (interface{}, error) {
 
 	return req, nil
 }
+
+// decodeBatchRequest decodes a batch publish request. The batch
+// envelope is a JSON array, so the CBOR content type is not accepted.
+func decodeBatchRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	ct := r.Header.Get("Content-Type")
+	if ct != ctSenmlJSON && ct != contentType {
+		return nil, errors.Wrap(apiutil.ErrValidation, apiutil.ErrUnsupportedContentType)
+	}
+
+	var req batchPublishReq
+	_, pass, ok := r.BasicAuth()
+	switch {
+	case ok:
+		req.token = pass
+	case !ok:
+		req.token = apiutil.ExtractClientSecret(r)
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req.items); err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, errors.ErrMalformedEntity)
+	}
+	defer r.Body.Close()
+
+	return req, nil
+}
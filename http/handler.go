@@ -5,6 +5,7 @@ package http
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log/slog"
 	"net/http"
@@ -24,6 +25,7 @@ import (
 	svcerr "github.com/absmach/supermq/pkg/errors/service"
 	"github.com/absmach/supermq/pkg/messaging"
 	"github.com/absmach/supermq/pkg/policies"
+	smqtopics "github.com/absmach/supermq/pkg/topics"
 )
 
 var _ session.Handler = (*handler)(nil)
@@ -53,27 +55,35 @@ var (
 	errMalformedTopic           = mgate.NewHTTPProxyError(http.StatusBadRequest, errors.New("malformed topic"))
 	errMissingTopicPub          = mgate.NewHTTPProxyError(http.StatusBadRequest, errors.New("failed to publish due to missing topic"))
 	errFailedParseSubtopic      = mgate.NewHTTPProxyError(http.StatusBadRequest, errors.New("failed to parse subtopic"))
+	errPayloadTooLarge          = mgate.NewHTTPProxyError(http.StatusRequestEntityTooLarge, errors.New("payload size exceeds the maximum allowed"))
+	errMalformedBatch           = mgate.NewHTTPProxyError(http.StatusBadRequest, errors.New("batch payload is not a JSON array"))
+	errEmptyBatch               = mgate.NewHTTPProxyError(http.StatusBadRequest, errors.New("batch contains no messages"))
 )
 
 var channelRegExp = regexp.MustCompile(`^\/?channels\/([\w\-]+)\/messages(\/[^?]*)?(\?.*)?$`)
 
 // Event implements events.Event interface.
 type handler struct {
-	publisher messaging.Publisher
-	clients   grpcClientsV1.ClientsServiceClient
-	channels  grpcChannelsV1.ChannelsServiceClient
-	authn     smqauthn.Authentication
-	logger    *slog.Logger
+	publisher  messaging.Publisher
+	clients    grpcClientsV1.ClientsServiceClient
+	channels   grpcChannelsV1.ChannelsServiceClient
+	authn      smqauthn.Authentication
+	logger     *slog.Logger
+	topics     *smqtopics.Cache
+	maxPayload uint64
 }
 
-// NewHandler creates new Handler entity.
-func NewHandler(publisher messaging.Publisher, authn smqauthn.Authentication, clients grpcClientsV1.ClientsServiceClient, channels grpcChannelsV1.ChannelsServiceClient, logger *slog.Logger) session.Handler {
+// NewHandler creates new Handler entity. A zero maxPayloadSize disables the
+// payload size limit.
+func NewHandler(publisher messaging.Publisher, authn smqauthn.Authentication, clients grpcClientsV1.ClientsServiceClient, channels grpcChannelsV1.ChannelsServiceClient, logger *slog.Logger, maxPayloadSize uint64) session.Handler {
 	return &handler{
-		publisher: publisher,
-		authn:     authn,
-		clients:   clients,
-		channels:  channels,
-		logger:    logger,
+		publisher:  publisher,
+		authn:      authn,
+		clients:    clients,
+		channels:   channels,
+		logger:     logger,
+		topics:     smqtopics.NewCache(),
+		maxPayload: maxPayloadSize,
 	}
 }
 
@@ -99,8 +109,13 @@ func (h *handler) AuthConnect(ctx context.Context) error {
 	return nil
 }
 
-// AuthPublish is not used in HTTP service.
+// AuthPublish is called on device publish,
+// prior forwarding to the message broker.
 func (h *handler) AuthPublish(ctx context.Context, topic *string, payload *[]byte) error {
+	if h.maxPayload > 0 && payload != nil && uint64(len(*payload)) > h.maxPayload {
+		return errPayloadTooLarge
+	}
+
 	return nil
 }
 
@@ -153,7 +168,14 @@ func (h *handler) Publish(ctx context.Context, topic *string, payload *[]byte) e
 		return mgate.NewHTTPProxyError(http.StatusUnauthorized, svcerr.ErrAuthentication)
 	}
 
-	chanID, subtopic, err := parseTopic(*topic)
+	// The reserved batch suffix carries an array of messages published
+	// one by one rather than a subtopic publish.
+	plain, isBatch := strings.CutSuffix(*topic, "/messages/batch")
+	if isBatch {
+		plain += "/messages"
+	}
+
+	chanID, subtopic, err := parseTopic(plain)
 	if err != nil {
 		return mgate.NewHTTPProxyError(http.StatusBadRequest, err)
 	}
@@ -180,11 +202,26 @@ func (h *handler) Publish(ctx context.Context, topic *string, payload *[]byte) e
 		return mgate.NewHTTPProxyError(http.StatusUnauthorized, svcerr.ErrAuthorization)
 	}
 
+	if patterns := res.GetSubtopicPatterns(); len(patterns) > 0 {
+		key := fmt.Sprintf("%s:%s:%d", string(s.Password), msg.Channel, connections.Publish)
+		matcher, err := h.topics.Matcher(key, patterns)
+		if err != nil {
+			return mgate.NewHTTPProxyError(http.StatusUnauthorized, errors.Wrap(svcerr.ErrAuthorization, err))
+		}
+		if !matcher.Match(msg.Subtopic) {
+			return mgate.NewHTTPProxyError(http.StatusUnauthorized, svcerr.ErrAuthorization)
+		}
+	}
+
 	if clientType == policies.ClientType {
 		msg.Publisher = clientID
 	}
 
-	if err := h.publisher.Publish(ctx, msg.Channel, &msg); err != nil {
+	if isBatch {
+		if err := h.publishBatch(ctx, &msg); err != nil {
+			return err
+		}
+	} else if err := h.publisher.Publish(ctx, msg.Channel, &msg); err != nil {
 		return errors.Wrap(errFailedPublishToMsgBroker, err)
 	}
 
@@ -193,6 +230,38 @@ func (h *handler) Publish(ctx context.Context, topic *string, payload *[]byte) e
 	return nil
 }
 
+// publishBatch publishes every element of the batch payload as its own
+// message. Publishing stops at the first rejected element, so that the
+// index carried by the error tells the client where to resume.
+func (h *handler) publishBatch(ctx context.Context, msg *messaging.Message) error {
+	var items []json.RawMessage
+	if err := json.Unmarshal(msg.GetPayload(), &items); err != nil {
+		return errMalformedBatch
+	}
+	if len(items) == 0 {
+		return errEmptyBatch
+	}
+
+	for i, item := range items {
+		if h.maxPayload > 0 && uint64(len(item)) > h.maxPayload {
+			return errPayloadTooLarge
+		}
+		m := &messaging.Message{
+			Protocol:  protocol,
+			Channel:   msg.GetChannel(),
+			Subtopic:  msg.GetSubtopic(),
+			Publisher: msg.GetPublisher(),
+			Payload:   item,
+			Created:   time.Now().UnixNano(),
+		}
+		if err := h.publisher.Publish(ctx, m.GetChannel(), m); err != nil {
+			return errors.Wrap(errFailedPublishToMsgBroker, fmt.Errorf("batch item %d: %w", i, err))
+		}
+	}
+
+	return nil
+}
+
 // Subscribe - not used for HTTP.
 func (h *handler) Subscribe(ctx context.Context, topics *[]string) error {
 	return nil
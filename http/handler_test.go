@@ -36,6 +36,7 @@ const (
 	invalidID             = "invalidID"
 	invalidValue          = "invalidValue"
 	invalidChannelIDTopic = "channels/**/messages"
+	maxPayloadSize        = 1024
 )
 
 var (
@@ -58,6 +59,9 @@ var (
 	errFailedParseSubtopic      = errors.New("failed to parse subtopic")
 	errMalformedSubtopic        = errors.New("malformed subtopic")
 	errFailedPublishToMsgBroker = errors.New("failed to publish to supermq message broker")
+	errPayloadTooLarge          = errors.New("payload size exceeds the maximum allowed")
+	errMalformedBatch           = errors.New("batch payload is not a JSON array")
+	errEmptyBatch               = errors.New("batch contains no messages")
 )
 
 var (
@@ -74,7 +78,7 @@ func newHandler() session.Handler {
 	channels = new(chmocks.ChannelsServiceClient)
 	publisher = new(mocks.PubSub)
 
-	return mhttp.NewHandler(publisher, authn, clients, channels, logger)
+	return mhttp.NewHandler(publisher, authn, clients, channels, logger, maxPayloadSize)
 }
 
 func TestAuthConnect(t *testing.T) {
@@ -131,6 +135,40 @@ func TestAuthConnect(t *testing.T) {
 	}
 }
 
+func TestAuthPublish(t *testing.T) {
+	handler := newHandler()
+
+	cases := []struct {
+		desc    string
+		payload []byte
+		status  int
+		err     error
+	}{
+		{
+			desc:    "publish with payload within the limit",
+			payload: payload,
+			err:     nil,
+		},
+		{
+			desc:    "publish with payload exceeding the limit",
+			payload: make([]byte, maxPayloadSize+1),
+			status:  http.StatusRequestEntityTooLarge,
+			err:     errPayloadTooLarge,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			ctx := session.NewContext(context.TODO(), &sessionClient)
+			err := handler.AuthPublish(ctx, &topic, &tc.payload)
+			hpe, ok := err.(mghttp.HTTPProxyError)
+			if ok {
+				assert.Equal(t, tc.status, hpe.StatusCode())
+			}
+			assert.True(t, errors.Contains(err, tc.err))
+		})
+	}
+}
+
 func TestPublish(t *testing.T) {
 	handler := newHandler()
 
@@ -349,3 +387,66 @@ func TestPublish(t *testing.T) {
 		})
 	}
 }
+
+func TestPublishBatch(t *testing.T) {
+	handler := newHandler()
+
+	batchTopic := topic + "/batch"
+	clientKeySession := session.Session{
+		Password: []byte("Client " + clientKey),
+	}
+
+	cases := []struct {
+		desc       string
+		payload    []byte
+		publishErr error
+		status     int
+		published  int
+		err        error
+	}{
+		{
+			desc:      "publish batch successfully",
+			payload:   []byte(`[{"n":"a","v":1},{"n":"b","v":2},{"n":"c","v":3}]`),
+			published: 3,
+			err:       nil,
+		},
+		{
+			desc:    "publish batch with malformed payload",
+			payload: []byte(`{"n":"a","v":1}`),
+			status:  http.StatusBadRequest,
+			err:     errMalformedBatch,
+		},
+		{
+			desc:    "publish empty batch",
+			payload: []byte(`[]`),
+			status:  http.StatusBadRequest,
+			err:     errEmptyBatch,
+		},
+		{
+			desc:       "publish batch with broker error",
+			payload:    []byte(`[{"n":"a","v":1}]`),
+			publishErr: errors.New("failed to publish"),
+			published:  1,
+			err:        errFailedPublishToMsgBroker,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			ctx := session.NewContext(context.TODO(), &clientKeySession)
+			clientsCall := clients.On("Authenticate", ctx, &grpcClientsV1.AuthnReq{ClientSecret: clientKey}).Return(&grpcClientsV1.AuthnRes{Id: clientID, Authenticated: true}, nil)
+			channelsCall := channels.On("Authorize", ctx, mock.Anything).Return(&grpcChannelsV1.AuthzRes{Authorized: true}, nil)
+			repoCall := publisher.On("Publish", ctx, chanID, mock.Anything).Return(tc.publishErr)
+			before := len(publisher.Calls)
+			err := handler.Publish(ctx, &batchTopic, &tc.payload)
+			hpe, ok := err.(mghttp.HTTPProxyError)
+			if ok {
+				assert.Equal(t, tc.status, hpe.StatusCode())
+			}
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("expected: %v, got: %v", tc.err, err))
+			assert.Equal(t, tc.published, len(publisher.Calls)-before)
+			repoCall.Unset()
+			clientsCall.Unset()
+			channelsCall.Unset()
+		})
+	}
+}
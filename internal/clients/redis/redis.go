@@ -3,14 +3,144 @@
 
 package redis
 
-import "github.com/redis/go-redis/v9"
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"net/url"
+	"strconv"
+	"strings"
 
-// Connect create new RedisDB client and connect to RedisDB server.
-func Connect(url string) (*redis.Client, error) {
-	opts, err := redis.ParseURL(url)
+	"github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const meterName = "github.com/absmach/supermq/internal/clients/redis"
+
+const (
+	schemeCluster     = "redis-cluster"
+	schemeClusterTLS  = "rediss-cluster"
+	schemeSentinel    = "redis-sentinel"
+	schemeSentinelTLS = "rediss-sentinel"
+)
+
+var errNoMasterName = errors.New("sentinel master name is missing from the URL path")
+
+// Connect creates a new Redis client and connects to the Redis server.
+// The deployment mode is selected by the URL scheme:
+//
+//   - redis:// and rediss:// connect to a single node,
+//   - redis-cluster:// and rediss-cluster:// connect to a cluster using
+//     the comma-separated hosts from the URL,
+//   - redis-sentinel:// and rediss-sentinel:// connect through sentinels
+//     listed as comma-separated hosts, with the master name as the first
+//     URL path element.
+//
+// The rediss-prefixed schemes enable TLS, and ACL credentials are taken
+// from the URL user info.
+func Connect(redisURL string) (redis.UniversalClient, error) {
+	scheme, _, _ := strings.Cut(redisURL, "://")
+
+	var client redis.UniversalClient
+	switch scheme {
+	case schemeCluster, schemeClusterTLS:
+		opts, err := parseClusterURL(redisURL, scheme == schemeClusterTLS)
+		if err != nil {
+			return nil, err
+		}
+		client = redis.NewClusterClient(opts)
+	case schemeSentinel, schemeSentinelTLS:
+		opts, err := parseSentinelURL(redisURL, scheme == schemeSentinelTLS)
+		if err != nil {
+			return nil, err
+		}
+		client = redis.NewFailoverClient(opts)
+	default:
+		opts, err := redis.ParseURL(redisURL)
+		if err != nil {
+			return nil, err
+		}
+		client = redis.NewClient(opts)
+	}
+
+	registerPoolMetrics(redisURL, client)
+
+	return client, nil
+}
+
+func parseClusterURL(redisURL string, secure bool) (*redis.ClusterOptions, error) {
+	u, err := url.Parse(redisURL)
 	if err != nil {
 		return nil, err
 	}
 
-	return redis.NewClient(opts), nil
+	opts := &redis.ClusterOptions{
+		Addrs:    strings.Split(u.Host, ","),
+		Username: u.User.Username(),
+	}
+	opts.Password, _ = u.User.Password()
+	if secure {
+		opts.TLSConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+	}
+
+	return opts, nil
+}
+
+func parseSentinelURL(redisURL string, secure bool) (*redis.FailoverOptions, error) {
+	u, err := url.Parse(redisURL)
+	if err != nil {
+		return nil, err
+	}
+
+	parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if len(parts) == 0 || parts[0] == "" {
+		return nil, errNoMasterName
+	}
+
+	opts := &redis.FailoverOptions{
+		MasterName:    parts[0],
+		SentinelAddrs: strings.Split(u.Host, ","),
+		Username:      u.User.Username(),
+	}
+	opts.Password, _ = u.User.Password()
+	if len(parts) > 1 {
+		db, err := strconv.Atoi(parts[1])
+		if err != nil {
+			return nil, err
+		}
+		opts.DB = db
+	}
+	if secure {
+		opts.TLSConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+	}
+
+	return opts, nil
+}
+
+// registerPoolMetrics exposes connection pool health metrics for the
+// given client through the global OpenTelemetry meter provider.
+func registerPoolMetrics(redisURL string, client redis.UniversalClient) {
+	meter := otel.Meter(meterName)
+	hits, _ := meter.Int64ObservableGauge("redis_pool_hits", metric.WithDescription("Number of times a free connection was found in the pool."))
+	misses, _ := meter.Int64ObservableGauge("redis_pool_misses", metric.WithDescription("Number of times a free connection was not found in the pool."))
+	timeouts, _ := meter.Int64ObservableGauge("redis_pool_timeouts", metric.WithDescription("Number of times a wait timeout occurred."))
+	total, _ := meter.Int64ObservableGauge("redis_pool_total_conns", metric.WithDescription("Number of total connections in the pool."))
+	idle, _ := meter.Int64ObservableGauge("redis_pool_idle_conns", metric.WithDescription("Number of idle connections in the pool."))
+
+	address := redisURL
+	if u, err := url.Parse(redisURL); err == nil {
+		address = u.Host
+	}
+	attrs := metric.WithAttributes(attribute.String("server.address", address))
+	_, _ = meter.RegisterCallback(func(_ context.Context, o metric.Observer) error {
+		stats := client.PoolStats()
+		o.ObserveInt64(hits, int64(stats.Hits), attrs)
+		o.ObserveInt64(misses, int64(stats.Misses), attrs)
+		o.ObserveInt64(timeouts, int64(stats.Timeouts), attrs)
+		o.ObserveInt64(total, int64(stats.TotalConns), attrs)
+		o.ObserveInt64(idle, int64(stats.IdleConns), attrs)
+		return nil
+	}, hits, misses, timeouts, total, idle)
 }
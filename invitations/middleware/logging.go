@@ -32,10 +32,10 @@ func (lm *logging) SendInvitation(ctx context.Context, session authn.Session, in
 		}
 		if err != nil {
 			args = append(args, slog.Any("error", err))
-			lm.logger.Warn("Send invitation failed", args...)
+			lm.logger.WarnContext(ctx, "Send invitation failed", args...)
 			return
 		}
-		lm.logger.Info("Send invitation completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Send invitation completed successfully", args...)
 	}(time.Now())
 	return lm.svc.SendInvitation(ctx, session, invitation)
 }
@@ -49,10 +49,10 @@ func (lm *logging) ViewInvitation(ctx context.Context, session authn.Session, us
 		}
 		if err != nil {
 			args = append(args, slog.Any("error", err))
-			lm.logger.Warn("View invitation failed", args...)
+			lm.logger.WarnContext(ctx, "View invitation failed", args...)
 			return
 		}
-		lm.logger.Info("View invitation completed successfully", args...)
+		lm.logger.InfoContext(ctx, "View invitation completed successfully", args...)
 	}(time.Now())
 	return lm.svc.ViewInvitation(ctx, session, userID, domainID)
 }
@@ -69,10 +69,10 @@ func (lm *logging) ListInvitations(ctx context.Context, session authn.Session, p
 		}
 		if err != nil {
 			args = append(args, slog.Any("error", err))
-			lm.logger.Warn("List invitations failed", args...)
+			lm.logger.WarnContext(ctx, "List invitations failed", args...)
 			return
 		}
-		lm.logger.Info("List invitations completed successfully", args...)
+		lm.logger.InfoContext(ctx, "List invitations completed successfully", args...)
 	}(time.Now())
 	return lm.svc.ListInvitations(ctx, session, page)
 }
@@ -85,10 +85,10 @@ func (lm *logging) AcceptInvitation(ctx context.Context, session authn.Session,
 		}
 		if err != nil {
 			args = append(args, slog.Any("error", err))
-			lm.logger.Warn("Accept invitation failed", args...)
+			lm.logger.WarnContext(ctx, "Accept invitation failed", args...)
 			return
 		}
-		lm.logger.Info("Accept invitation completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Accept invitation completed successfully", args...)
 	}(time.Now())
 	return lm.svc.AcceptInvitation(ctx, session, domainID)
 }
@@ -101,10 +101,10 @@ func (lm *logging) RejectInvitation(ctx context.Context, session authn.Session,
 		}
 		if err != nil {
 			args = append(args, slog.Any("error", err))
-			lm.logger.Warn("Reject invitation failed", args...)
+			lm.logger.WarnContext(ctx, "Reject invitation failed", args...)
 			return
 		}
-		lm.logger.Info("Reject invitation completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Reject invitation completed successfully", args...)
 	}(time.Now())
 	return lm.svc.RejectInvitation(ctx, session, domainID)
 }
@@ -118,10 +118,10 @@ func (lm *logging) DeleteInvitation(ctx context.Context, session authn.Session,
 		}
 		if err != nil {
 			args = append(args, slog.Any("error", err))
-			lm.logger.Warn("Delete invitation failed", args...)
+			lm.logger.WarnContext(ctx, "Delete invitation failed", args...)
 			return
 		}
-		lm.logger.Info("Delete invitation completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Delete invitation completed successfully", args...)
 	}(time.Now())
 	return lm.svc.DeleteInvitation(ctx, session, userID, domainID)
 }
@@ -9,6 +9,7 @@ import (
 
 	"github.com/absmach/supermq/invitations"
 	"github.com/absmach/supermq/pkg/authn"
+	"github.com/absmach/supermq/pkg/prometheus"
 	"github.com/go-kit/kit/metrics"
 )
 
@@ -30,7 +31,7 @@ func Metrics(counter metrics.Counter, latency metrics.Histogram, svc invitations
 
 func (mm *metricsmw) SendInvitation(ctx context.Context, session authn.Session, invitation invitations.Invitation) (err error) {
 	defer func(begin time.Time) {
-		mm.counter.With("method", "send_invitation").Add(1)
+		mm.counter.With("method", "send_invitation", "error", prometheus.ErrorClass(err)).Add(1)
 		mm.latency.With("method", "send_invitation").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return mm.svc.SendInvitation(ctx, session, invitation)
@@ -38,7 +39,7 @@ func (mm *metricsmw) SendInvitation(ctx context.Context, session authn.Session,
 
 func (mm *metricsmw) ViewInvitation(ctx context.Context, session authn.Session, userID, domainID string) (invitation invitations.Invitation, err error) {
 	defer func(begin time.Time) {
-		mm.counter.With("method", "view_invitation").Add(1)
+		mm.counter.With("method", "view_invitation", "error", prometheus.ErrorClass(err)).Add(1)
 		mm.latency.With("method", "view_invitation").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return mm.svc.ViewInvitation(ctx, session, userID, domainID)
@@ -46,7 +47,7 @@ func (mm *metricsmw) ViewInvitation(ctx context.Context, session authn.Session,
 
 func (mm *metricsmw) ListInvitations(ctx context.Context, session authn.Session, page invitations.Page) (invs invitations.InvitationPage, err error) {
 	defer func(begin time.Time) {
-		mm.counter.With("method", "list_invitations").Add(1)
+		mm.counter.With("method", "list_invitations", "error", prometheus.ErrorClass(err)).Add(1)
 		mm.latency.With("method", "list_invitations").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return mm.svc.ListInvitations(ctx, session, page)
@@ -54,7 +55,7 @@ func (mm *metricsmw) ListInvitations(ctx context.Context, session authn.Session,
 
 func (mm *metricsmw) AcceptInvitation(ctx context.Context, session authn.Session, domainID string) (err error) {
 	defer func(begin time.Time) {
-		mm.counter.With("method", "accept_invitation").Add(1)
+		mm.counter.With("method", "accept_invitation", "error", prometheus.ErrorClass(err)).Add(1)
 		mm.latency.With("method", "accept_invitation").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return mm.svc.AcceptInvitation(ctx, session, domainID)
@@ -62,7 +63,7 @@ func (mm *metricsmw) AcceptInvitation(ctx context.Context, session authn.Session
 
 func (mm *metricsmw) RejectInvitation(ctx context.Context, session authn.Session, domainID string) (err error) {
 	defer func(begin time.Time) {
-		mm.counter.With("method", "reject_invitation").Add(1)
+		mm.counter.With("method", "reject_invitation", "error", prometheus.ErrorClass(err)).Add(1)
 		mm.latency.With("method", "reject_invitation").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return mm.svc.RejectInvitation(ctx, session, domainID)
@@ -70,7 +71,7 @@ func (mm *metricsmw) RejectInvitation(ctx context.Context, session authn.Session
 
 func (mm *metricsmw) DeleteInvitation(ctx context.Context, session authn.Session, userID, domainID string) (err error) {
 	defer func(begin time.Time) {
-		mm.counter.With("method", "delete_invitation").Add(1)
+		mm.counter.With("method", "delete_invitation", "error", prometheus.ErrorClass(err)).Add(1)
 		mm.latency.With("method", "delete_invitation").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return mm.svc.DeleteInvitation(ctx, session, userID, domainID)
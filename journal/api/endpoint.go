@@ -37,3 +37,95 @@ func retrieveJournalsEndpoint(svc journal.Service) endpoint.Endpoint {
 		}, nil
 	}
 }
+
+func retrievePolicyRecordsEndpoint(svc journal.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(retrievePolicyRecordsReq)
+		if err := req.validate(); err != nil {
+			return nil, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(api.SessionKey).(authn.Session)
+		if !ok {
+			return nil, svcerr.ErrAuthorization
+		}
+
+		page, err := svc.RetrievePolicyRecords(ctx, session, req.page)
+		if err != nil {
+			return nil, err
+		}
+
+		return policyRecordsPageRes{
+			PolicyRecordsPage: page,
+		}, nil
+	}
+}
+
+func retrievePolicyStateEndpoint(svc journal.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(retrievePolicyStateReq)
+		if err := req.validate(); err != nil {
+			return nil, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(api.SessionKey).(authn.Session)
+		if !ok {
+			return nil, svcerr.ErrAuthorization
+		}
+
+		page, err := svc.RetrievePolicyState(ctx, session, req.at, req.page)
+		if err != nil {
+			return nil, err
+		}
+
+		return policyRecordsPageRes{
+			PolicyRecordsPage: page,
+		}, nil
+	}
+}
+
+func retrievePlatformStatsEndpoint(svc journal.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(retrievePlatformStatsReq)
+		if err := req.validate(); err != nil {
+			return nil, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(api.SessionKey).(authn.Session)
+		if !ok {
+			return nil, svcerr.ErrAuthorization
+		}
+
+		stats, err := svc.RetrievePlatformStats(ctx, session)
+		if err != nil {
+			return nil, err
+		}
+
+		return platformStatsRes{
+			PlatformStats: stats,
+		}, nil
+	}
+}
+
+func retrieveSessionRecordsEndpoint(svc journal.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(retrieveSessionRecordsReq)
+		if err := req.validate(); err != nil {
+			return nil, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(api.SessionKey).(authn.Session)
+		if !ok {
+			return nil, svcerr.ErrAuthorization
+		}
+
+		page, err := svc.RetrieveSessionRecords(ctx, session, req.page)
+		if err != nil {
+			return nil, err
+		}
+
+		return sessionRecordsPageRes{
+			SessionRecordsPage: page,
+		}, nil
+	}
+}
@@ -4,6 +4,8 @@
 package api
 
 import (
+	"time"
+
 	api "github.com/absmach/supermq/api/http"
 	apiutil "github.com/absmach/supermq/api/http/util"
 	"github.com/absmach/supermq/journal"
@@ -30,3 +32,70 @@ func (req retrieveJournalsReq) validate() error {
 
 	return nil
 }
+
+type retrievePolicyRecordsReq struct {
+	token string
+	page  journal.PolicyRecordPage
+}
+
+func (req retrievePolicyRecordsReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+	if req.page.Limit > api.DefLimit {
+		return apiutil.ErrLimitSize
+	}
+
+	return nil
+}
+
+type retrievePolicyStateReq struct {
+	token string
+	at    time.Time
+	page  journal.PolicyRecordPage
+}
+
+func (req retrievePolicyStateReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+	if req.at.IsZero() {
+		return apiutil.ErrInvalidTimeFormat
+	}
+	if req.page.Limit > api.DefLimit {
+		return apiutil.ErrLimitSize
+	}
+
+	return nil
+}
+
+type retrievePlatformStatsReq struct {
+	token string
+}
+
+func (req retrievePlatformStatsReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	return nil
+}
+
+type retrieveSessionRecordsReq struct {
+	token string
+	page  journal.SessionRecordPage
+}
+
+func (req retrieveSessionRecordsReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+	if req.page.Limit > api.DefLimit {
+		return apiutil.ErrLimitSize
+	}
+	if req.page.ClientID == "" {
+		return apiutil.ErrMissingID
+	}
+
+	return nil
+}
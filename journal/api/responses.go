@@ -10,7 +10,12 @@ import (
 	"github.com/absmach/supermq/journal"
 )
 
-var _ supermq.Response = (*pageRes)(nil)
+var (
+	_ supermq.Response = (*pageRes)(nil)
+	_ supermq.Response = (*policyRecordsPageRes)(nil)
+	_ supermq.Response = (*platformStatsRes)(nil)
+	_ supermq.Response = (*sessionRecordsPageRes)(nil)
+)
 
 type pageRes struct {
 	journal.JournalsPage `json:",inline"`
@@ -27,3 +32,51 @@ func (res pageRes) Code() int {
 func (res pageRes) Empty() bool {
 	return false
 }
+
+type policyRecordsPageRes struct {
+	journal.PolicyRecordsPage `json:",inline"`
+}
+
+func (res policyRecordsPageRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res policyRecordsPageRes) Code() int {
+	return http.StatusOK
+}
+
+func (res policyRecordsPageRes) Empty() bool {
+	return false
+}
+
+type platformStatsRes struct {
+	journal.PlatformStats `json:",inline"`
+}
+
+func (res platformStatsRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res platformStatsRes) Code() int {
+	return http.StatusOK
+}
+
+func (res platformStatsRes) Empty() bool {
+	return false
+}
+
+type sessionRecordsPageRes struct {
+	journal.SessionRecordsPage `json:",inline"`
+}
+
+func (res sessionRecordsPageRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res sessionRecordsPageRes) Code() int {
+	return http.StatusOK
+}
+
+func (res sessionRecordsPageRes) Empty() bool {
+	return false
+}
@@ -31,6 +31,12 @@ const (
 	metadataKey   = "with_metadata"
 	entityIDKey   = "id"
 	entityTypeKey = "entity_type"
+	domainKey     = "domain"
+	subjectKey    = "subject"
+	objectKey     = "object"
+	atKey         = "at"
+	adapterKey    = "adapter"
+	eventKey      = "event"
 )
 
 // MakeHandler returns a HTTP API handler with health check and metrics.
@@ -48,6 +54,27 @@ func MakeHandler(svc journal.Service, authn smqauthn.Authentication, logger *slo
 		opts...,
 	), "list_user_journals").ServeHTTP)
 
+	mux.With(api.AuthenticateMiddleware(authn, false)).Get("/journal/policies", otelhttp.NewHandler(kithttp.NewServer(
+		retrievePolicyRecordsEndpoint(svc),
+		decodeRetrievePolicyRecordsReq,
+		api.EncodeResponse,
+		opts...,
+	), "list_policy_records").ServeHTTP)
+
+	mux.With(api.AuthenticateMiddleware(authn, false)).Get("/journal/policies/state", otelhttp.NewHandler(kithttp.NewServer(
+		retrievePolicyStateEndpoint(svc),
+		decodeRetrievePolicyStateReq,
+		api.EncodeResponse,
+		opts...,
+	), "retrieve_policy_state").ServeHTTP)
+
+	mux.With(api.AuthenticateMiddleware(authn, false)).Get("/admin/stats", otelhttp.NewHandler(kithttp.NewServer(
+		retrievePlatformStatsEndpoint(svc),
+		decodeRetrievePlatformStatsReq,
+		api.EncodeResponse,
+		opts...,
+	), "retrieve_platform_stats").ServeHTTP)
+
 	mux.With(api.AuthenticateMiddleware(authn, true)).Get("/{domainID}/journal/{entityType}/{entityID}", otelhttp.NewHandler(kithttp.NewServer(
 		retrieveJournalsEndpoint(svc),
 		decodeRetrieveEntityJournalReq,
@@ -55,6 +82,13 @@ func MakeHandler(svc journal.Service, authn smqauthn.Authentication, logger *slo
 		opts...,
 	), "list__entity_journals").ServeHTTP)
 
+	mux.With(api.AuthenticateMiddleware(authn, true)).Get("/{domainID}/clients/{clientID}/sessions", otelhttp.NewHandler(kithttp.NewServer(
+		retrieveSessionRecordsEndpoint(svc),
+		decodeRetrieveSessionRecordsReq,
+		api.EncodeResponse,
+		opts...,
+	), "list_client_sessions").ServeHTTP)
+
 	mux.Get("/health", supermq.Health(svcName, instanceID))
 	mux.Handle("/metrics", promhttp.Handler())
 
@@ -101,6 +135,146 @@ func decodeRetrieveUserJournalReq(_ context.Context, r *http.Request) (interface
 	return req, nil
 }
 
+func decodeRetrievePolicyRecordsReq(_ context.Context, r *http.Request) (interface{}, error) {
+	page, err := decodePolicyPageQuery(r)
+	if err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	from, err := decodeTimeQuery(r, fromKey)
+	if err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	to, err := decodeTimeQuery(r, toKey)
+	if err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	page.From = from
+	page.To = to
+
+	req := retrievePolicyRecordsReq{
+		token: apiutil.ExtractBearerToken(r),
+		page:  page,
+	}
+
+	return req, nil
+}
+
+func decodeRetrievePolicyStateReq(_ context.Context, r *http.Request) (interface{}, error) {
+	page, err := decodePolicyPageQuery(r)
+	if err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	at, err := decodeTimeQuery(r, atKey)
+	if err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, err)
+	}
+
+	req := retrievePolicyStateReq{
+		token: apiutil.ExtractBearerToken(r),
+		at:    at,
+		page:  page,
+	}
+
+	return req, nil
+}
+
+func decodeRetrieveSessionRecordsReq(_ context.Context, r *http.Request) (interface{}, error) {
+	offset, err := apiutil.ReadNumQuery[uint64](r, api.OffsetKey, api.DefOffset)
+	if err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	limit, err := apiutil.ReadNumQuery[uint64](r, api.LimitKey, api.DefLimit)
+	if err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	adapter, err := apiutil.ReadStringQuery(r, adapterKey, "")
+	if err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	event, err := apiutil.ReadStringQuery(r, eventKey, "")
+	if err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	from, err := decodeTimeQuery(r, fromKey)
+	if err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	to, err := decodeTimeQuery(r, toKey)
+	if err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, err)
+	}
+
+	req := retrieveSessionRecordsReq{
+		token: apiutil.ExtractBearerToken(r),
+		page: journal.SessionRecordPage{
+			Offset:   offset,
+			Limit:    limit,
+			ClientID: chi.URLParam(r, "clientID"),
+			Adapter:  adapter,
+			Event:    event,
+			From:     from,
+			To:       to,
+		},
+	}
+
+	return req, nil
+}
+
+func decodeRetrievePlatformStatsReq(_ context.Context, r *http.Request) (interface{}, error) {
+	req := retrievePlatformStatsReq{
+		token: apiutil.ExtractBearerToken(r),
+	}
+
+	return req, nil
+}
+
+func decodePolicyPageQuery(r *http.Request) (journal.PolicyRecordPage, error) {
+	offset, err := apiutil.ReadNumQuery[uint64](r, api.OffsetKey, api.DefOffset)
+	if err != nil {
+		return journal.PolicyRecordPage{}, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	limit, err := apiutil.ReadNumQuery[uint64](r, api.LimitKey, api.DefLimit)
+	if err != nil {
+		return journal.PolicyRecordPage{}, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	domain, err := apiutil.ReadStringQuery(r, domainKey, "")
+	if err != nil {
+		return journal.PolicyRecordPage{}, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	subject, err := apiutil.ReadStringQuery(r, subjectKey, "")
+	if err != nil {
+		return journal.PolicyRecordPage{}, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	object, err := apiutil.ReadStringQuery(r, objectKey, "")
+	if err != nil {
+		return journal.PolicyRecordPage{}, errors.Wrap(apiutil.ErrValidation, err)
+	}
+
+	return journal.PolicyRecordPage{
+		Offset:  offset,
+		Limit:   limit,
+		Domain:  domain,
+		Subject: subject,
+		Object:  object,
+	}, nil
+}
+
+func decodeTimeQuery(r *http.Request, key string) (time.Time, error) {
+	ts, err := apiutil.ReadNumQuery[int64](r, key, 0)
+	if err != nil {
+		return time.Time{}, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	if ts > math.MaxInt32 {
+		return time.Time{}, errors.Wrap(apiutil.ErrValidation, apiutil.ErrInvalidTimeFormat)
+	}
+	var t time.Time
+	if ts != 0 {
+		t = time.Unix(ts, 0)
+	}
+
+	return t, nil
+}
+
 func decodePageQuery(r *http.Request) (journal.Page, error) {
 	offset, err := apiutil.ReadNumQuery[uint64](r, api.OffsetKey, api.DefOffset)
 	if err != nil {
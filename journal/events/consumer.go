@@ -6,6 +6,7 @@ package events
 import (
 	"context"
 	"errors"
+	"strings"
 	"time"
 
 	"github.com/absmach/supermq/journal"
@@ -13,6 +14,8 @@ import (
 	"github.com/absmach/supermq/pkg/events/store"
 )
 
+const policyPrefix = "policy."
+
 var ErrMissingOccurredAt = errors.New("missing occurred_at")
 
 // Start method starts consuming messages received from Event store.
@@ -53,6 +56,18 @@ func Handle(service journal.Service) handleFunc {
 			return ErrMissingOccurredAt
 		}
 
+		if strings.HasPrefix(operation, policyPrefix) {
+			return service.SavePolicyRecord(ctx, toPolicyRecord(operation, time.Unix(0, int64(occurredAt)), data))
+		}
+
+		if operation == journal.SessionConnect || operation == journal.SessionDisconnect {
+			record := toSessionRecord(operation, time.Unix(0, int64(occurredAt)), data)
+			if record.ClientID == "" {
+				return nil
+			}
+			return service.SaveSessionRecord(ctx, record)
+		}
+
 		metadata, ok := data["metadata"].(map[string]interface{})
 		if !ok {
 			metadata = make(map[string]interface{})
@@ -74,6 +89,47 @@ func Handle(service journal.Service) handleFunc {
 	}
 }
 
+// toPolicyRecord materializes a policy change event into a policy record.
+func toPolicyRecord(operation string, occurredAt time.Time, data map[string]interface{}) journal.PolicyRecord {
+	str := func(key string) string {
+		v, _ := data[key].(string)
+		return v
+	}
+
+	return journal.PolicyRecord{
+		Action:          strings.TrimPrefix(operation, policyPrefix),
+		OccurredAt:      occurredAt,
+		Domain:          str("domain"),
+		Subject:         str("subject"),
+		SubjectType:     str("subject_type"),
+		SubjectRelation: str("subject_relation"),
+		Object:          str("object"),
+		ObjectType:      str("object_type"),
+		Relation:        str("relation"),
+		Permission:      str("permission"),
+	}
+}
+
+// toSessionRecord materializes an adapter connect or disconnect event into a
+// session record.
+func toSessionRecord(operation string, occurredAt time.Time, data map[string]interface{}) journal.SessionRecord {
+	str := func(key string) string {
+		v, _ := data[key].(string)
+		return v
+	}
+	clean, _ := data["clean"].(bool)
+
+	return journal.SessionRecord{
+		ClientID:   str("client_id"),
+		Event:      operation,
+		Adapter:    str("adapter"),
+		Instance:   str("instance"),
+		RemoteAddr: str("remote_addr"),
+		Clean:      clean,
+		OccurredAt: occurredAt,
+	}
+}
+
 type handleFunc func(ctx context.Context, event events.Event) error
 
 func (h handleFunc) Handle(ctx context.Context, event events.Event) error {
@@ -136,6 +136,142 @@ func (page JournalsPage) MarshalJSON() ([]byte, error) {
 	return json.Marshal(a)
 }
 
+// Supported policy record actions.
+const (
+	PolicyAdd          = "add"
+	PolicyDelete       = "delete"
+	PolicyDeleteFilter = "delete_filter"
+)
+
+// PolicyRecord represents a single policy change consumed from the event
+// store. Records with the delete_filter action keep the filter fields that
+// were provided with the request; their empty fields match any value during
+// state reconstruction.
+type PolicyRecord struct {
+	ID              string    `json:"id,omitempty" db:"id"`
+	Action          string    `json:"action" db:"action"`
+	OccurredAt      time.Time `json:"occurred_at" db:"occurred_at"`
+	Domain          string    `json:"domain,omitempty" db:"domain"`
+	Subject         string    `json:"subject,omitempty" db:"subject"`
+	SubjectType     string    `json:"subject_type,omitempty" db:"subject_type"`
+	SubjectRelation string    `json:"subject_relation,omitempty" db:"subject_relation"`
+	Object          string    `json:"object,omitempty" db:"object"`
+	ObjectType      string    `json:"object_type,omitempty" db:"object_type"`
+	Relation        string    `json:"relation,omitempty" db:"relation"`
+	Permission      string    `json:"permission,omitempty" db:"permission"`
+}
+
+// PolicyRecordsPage represents a page of policy records.
+type PolicyRecordsPage struct {
+	Total   uint64         `json:"total"`
+	Offset  uint64         `json:"offset"`
+	Limit   uint64         `json:"limit"`
+	Records []PolicyRecord `json:"records"`
+}
+
+// PolicyRecordPage is used to filter policy records.
+type PolicyRecordPage struct {
+	Offset  uint64    `json:"offset" db:"offset"`
+	Limit   uint64    `json:"limit" db:"limit"`
+	Domain  string    `json:"domain,omitempty" db:"domain,omitempty"`
+	Subject string    `json:"subject,omitempty" db:"subject,omitempty"`
+	Object  string    `json:"object,omitempty" db:"object,omitempty"`
+	From    time.Time `json:"from,omitempty" db:"from,omitempty"`
+	To      time.Time `json:"to,omitempty" db:"to,omitempty"`
+}
+
+func (page PolicyRecordsPage) MarshalJSON() ([]byte, error) {
+	type Alias PolicyRecordsPage
+	a := struct {
+		Alias
+	}{
+		Alias: Alias(page),
+	}
+
+	if a.Records == nil {
+		a.Records = make([]PolicyRecord, 0)
+	}
+
+	return json.Marshal(a)
+}
+
+// Supported session record events.
+const (
+	SessionConnect    = "connect"
+	SessionDisconnect = "disconnect"
+)
+
+// SessionRecord represents a single connect or disconnect of a client on a
+// messaging adapter, consumed from the adapter event streams. Remote address
+// and the clean flag are recorded when the adapter supplies them.
+type SessionRecord struct {
+	ID         string    `json:"id,omitempty" db:"id"`
+	ClientID   string    `json:"client_id" db:"client_id"`
+	Event      string    `json:"event" db:"event"`
+	Adapter    string    `json:"adapter,omitempty" db:"adapter"`
+	Instance   string    `json:"instance,omitempty" db:"instance"`
+	RemoteAddr string    `json:"remote_addr,omitempty" db:"remote_addr"`
+	Clean      bool      `json:"clean" db:"clean"`
+	OccurredAt time.Time `json:"occurred_at" db:"occurred_at"`
+}
+
+// SessionRecordsPage represents a page of session records.
+type SessionRecordsPage struct {
+	Total    uint64          `json:"total"`
+	Offset   uint64          `json:"offset"`
+	Limit    uint64          `json:"limit"`
+	Sessions []SessionRecord `json:"sessions"`
+}
+
+// SessionRecordPage is used to filter session records.
+type SessionRecordPage struct {
+	Offset   uint64    `json:"offset" db:"offset"`
+	Limit    uint64    `json:"limit" db:"limit"`
+	ClientID string    `json:"client_id,omitempty" db:"client_id,omitempty"`
+	Adapter  string    `json:"adapter,omitempty" db:"adapter,omitempty"`
+	Event    string    `json:"event,omitempty" db:"event,omitempty"`
+	From     time.Time `json:"from,omitempty" db:"from,omitempty"`
+	To       time.Time `json:"to,omitempty" db:"to,omitempty"`
+}
+
+func (page SessionRecordsPage) MarshalJSON() ([]byte, error) {
+	type Alias SessionRecordsPage
+	a := struct {
+		Alias
+	}{
+		Alias: Alias(page),
+	}
+
+	if a.Sessions == nil {
+		a.Sessions = make([]SessionRecord, 0)
+	}
+
+	return json.Marshal(a)
+}
+
+// EntityStats holds the number of entities of one type per current status,
+// derived from the latest lifecycle operation recorded for each entity.
+type EntityStats struct {
+	Enabled  uint64 `json:"enabled" db:"enabled"`
+	Disabled uint64 `json:"disabled" db:"disabled"`
+	Deleted  uint64 `json:"deleted" db:"deleted"`
+}
+
+// ActivityStats holds the number of operations recorded in the journal over
+// recent time windows, as a proxy for platform throughput.
+type ActivityStats struct {
+	Total    uint64 `json:"total" db:"total"`
+	LastHour uint64 `json:"last_hour" db:"last_hour"`
+	LastDay  uint64 `json:"last_day" db:"last_day"`
+}
+
+// PlatformStats aggregates platform-wide counts for the admin dashboard.
+type PlatformStats struct {
+	Entities   map[string]EntityStats `json:"entities"`
+	Activity   ActivityStats          `json:"activity"`
+	ComputedAt time.Time              `json:"computed_at"`
+}
+
 // Service provides access to the journal log service.
 //
 //go:generate mockery --name Service --output=./mocks --filename service.go --quiet --note "Copyright (c) Abstract Machines"
@@ -145,6 +281,29 @@ type Service interface {
 
 	// RetrieveAll retrieves all journals from the database with the given page.
 	RetrieveAll(ctx context.Context, session smqauthn.Session, page Page) (JournalsPage, error)
+
+	// SavePolicyRecord saves the policy change record to the database.
+	SavePolicyRecord(ctx context.Context, record PolicyRecord) error
+
+	// RetrievePolicyRecords retrieves the history of policy changes matching
+	// the given page.
+	RetrievePolicyRecords(ctx context.Context, session smqauthn.Session, page PolicyRecordPage) (PolicyRecordsPage, error)
+
+	// RetrievePolicyState reconstructs the set of policies that were in effect
+	// at the given time by replaying the recorded changes.
+	RetrievePolicyState(ctx context.Context, session smqauthn.Session, at time.Time, page PolicyRecordPage) (PolicyRecordsPage, error)
+
+	// RetrievePlatformStats returns platform-wide entity and activity counts
+	// for the admin dashboard. Stats are recomputed at most once per caching
+	// interval.
+	RetrievePlatformStats(ctx context.Context, session smqauthn.Session) (PlatformStats, error)
+
+	// SaveSessionRecord saves the adapter session record to the database.
+	SaveSessionRecord(ctx context.Context, record SessionRecord) error
+
+	// RetrieveSessionRecords retrieves the connection history of a client
+	// matching the given page.
+	RetrieveSessionRecords(ctx context.Context, session smqauthn.Session, page SessionRecordPage) (SessionRecordsPage, error)
 }
 
 // Repository provides access to the journal log database.
@@ -156,4 +315,26 @@ type Repository interface {
 
 	// RetrieveAll retrieves all journals from the database with the given page.
 	RetrieveAll(ctx context.Context, page Page) (JournalsPage, error)
+
+	// SavePolicyRecord persists the policy change record to a database.
+	SavePolicyRecord(ctx context.Context, record PolicyRecord) error
+
+	// RetrievePolicyRecords retrieves policy records from the database with
+	// the given page, ordered by the time they occurred.
+	RetrievePolicyRecords(ctx context.Context, page PolicyRecordPage) (PolicyRecordsPage, error)
+
+	// RetrievePlatformStats computes platform-wide entity and activity counts
+	// from the recorded journal entries.
+	RetrievePlatformStats(ctx context.Context) (PlatformStats, error)
+
+	// SaveSessionRecord persists the adapter session record to a database.
+	SaveSessionRecord(ctx context.Context, record SessionRecord) error
+
+	// RetrieveSessionRecords retrieves session records from the database with
+	// the given page, most recent first.
+	RetrieveSessionRecords(ctx context.Context, page SessionRecordPage) (SessionRecordsPage, error)
+
+	// DeleteSessionRecords removes session records that occurred before the
+	// given time and returns the number of deleted records.
+	DeleteSessionRecords(ctx context.Context, before time.Time) (uint64, error)
 }
@@ -5,6 +5,7 @@ package middleware
 
 import (
 	"context"
+	"time"
 
 	"github.com/absmach/supermq/journal"
 	smqauthn "github.com/absmach/supermq/pkg/authn"
@@ -60,3 +61,67 @@ func (am *authorizationMiddleware) RetrieveAll(ctx context.Context, session smqa
 
 	return am.svc.RetrieveAll(ctx, session, page)
 }
+
+func (am *authorizationMiddleware) SavePolicyRecord(ctx context.Context, record journal.PolicyRecord) error {
+	return am.svc.SavePolicyRecord(ctx, record)
+}
+
+func (am *authorizationMiddleware) RetrievePolicyRecords(ctx context.Context, session smqauthn.Session, page journal.PolicyRecordPage) (journal.PolicyRecordsPage, error) {
+	if err := am.authorizeAdmin(ctx, session.UserID); err != nil {
+		return journal.PolicyRecordsPage{}, err
+	}
+
+	return am.svc.RetrievePolicyRecords(ctx, session, page)
+}
+
+func (am *authorizationMiddleware) RetrievePolicyState(ctx context.Context, session smqauthn.Session, at time.Time, page journal.PolicyRecordPage) (journal.PolicyRecordsPage, error) {
+	if err := am.authorizeAdmin(ctx, session.UserID); err != nil {
+		return journal.PolicyRecordsPage{}, err
+	}
+
+	return am.svc.RetrievePolicyState(ctx, session, at, page)
+}
+
+func (am *authorizationMiddleware) RetrievePlatformStats(ctx context.Context, session smqauthn.Session) (journal.PlatformStats, error) {
+	if err := am.authorizeAdmin(ctx, session.UserID); err != nil {
+		return journal.PlatformStats{}, err
+	}
+
+	return am.svc.RetrievePlatformStats(ctx, session)
+}
+
+// authorizeAdmin restricts access to the policy audit trail and platform
+// statistics to platform administrators.
+func (am *authorizationMiddleware) authorizeAdmin(ctx context.Context, userID string) error {
+	req := smqauthz.PolicyReq{
+		SubjectType: policies.UserType,
+		SubjectKind: policies.UsersKind,
+		Subject:     userID,
+		Permission:  policies.AdminPermission,
+		ObjectType:  policies.PlatformType,
+		Object:      policies.SuperMQObject,
+	}
+
+	return am.authz.Authorize(ctx, req)
+}
+
+func (am *authorizationMiddleware) SaveSessionRecord(ctx context.Context, record journal.SessionRecord) error {
+	return am.svc.SaveSessionRecord(ctx, record)
+}
+
+func (am *authorizationMiddleware) RetrieveSessionRecords(ctx context.Context, session smqauthn.Session, page journal.SessionRecordPage) (journal.SessionRecordsPage, error) {
+	req := smqauthz.PolicyReq{
+		Domain:      session.DomainID,
+		SubjectType: policies.UserType,
+		SubjectKind: policies.UsersKind,
+		Subject:     session.DomainUserID,
+		Permission:  policies.ViewPermission,
+		ObjectType:  policies.ClientType,
+		Object:      page.ClientID,
+	}
+	if err := am.authz.Authorize(ctx, req); err != nil {
+		return journal.SessionRecordsPage{}, err
+	}
+
+	return am.svc.RetrieveSessionRecords(ctx, session, page)
+}
@@ -38,10 +38,10 @@ func (lm *loggingMiddleware) Save(ctx context.Context, j journal.Journal) (err e
 		}
 		if err != nil {
 			args = append(args, slog.Any("error", err))
-			lm.logger.Warn("Save journal failed", args...)
+			lm.logger.WarnContext(ctx, "Save journal failed", args...)
 			return
 		}
-		lm.logger.Info("Save journal completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Save journal completed successfully", args...)
 	}(time.Now())
 
 	return lm.service.Save(ctx, j)
@@ -61,11 +61,133 @@ func (lm *loggingMiddleware) RetrieveAll(ctx context.Context, session smqauthn.S
 		}
 		if err != nil {
 			args = append(args, slog.Any("error", err))
-			lm.logger.Warn("Retrieve all journals failed", args...)
+			lm.logger.WarnContext(ctx, "Retrieve all journals failed", args...)
 			return
 		}
-		lm.logger.Info("Retrieve all journals completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Retrieve all journals completed successfully", args...)
 	}(time.Now())
 
 	return lm.service.RetrieveAll(ctx, session, page)
 }
+
+func (lm *loggingMiddleware) SavePolicyRecord(ctx context.Context, record journal.PolicyRecord) (err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.Group("record",
+				slog.String("occurred_at", record.OccurredAt.Format(time.RFC3339Nano)),
+				slog.String("action", record.Action),
+			),
+		}
+		if err != nil {
+			args = append(args, slog.Any("error", err))
+			lm.logger.WarnContext(ctx, "Save policy record failed", args...)
+			return
+		}
+		lm.logger.InfoContext(ctx, "Save policy record completed successfully", args...)
+	}(time.Now())
+
+	return lm.service.SavePolicyRecord(ctx, record)
+}
+
+func (lm *loggingMiddleware) RetrievePolicyRecords(ctx context.Context, session smqauthn.Session, page journal.PolicyRecordPage) (recordsPage journal.PolicyRecordsPage, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.Group("page",
+				slog.Uint64("offset", page.Offset),
+				slog.Uint64("limit", page.Limit),
+				slog.Uint64("total", recordsPage.Total),
+			),
+		}
+		if err != nil {
+			args = append(args, slog.Any("error", err))
+			lm.logger.WarnContext(ctx, "Retrieve policy records failed", args...)
+			return
+		}
+		lm.logger.InfoContext(ctx, "Retrieve policy records completed successfully", args...)
+	}(time.Now())
+
+	return lm.service.RetrievePolicyRecords(ctx, session, page)
+}
+
+func (lm *loggingMiddleware) RetrievePolicyState(ctx context.Context, session smqauthn.Session, at time.Time, page journal.PolicyRecordPage) (recordsPage journal.PolicyRecordsPage, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("at", at.Format(time.RFC3339Nano)),
+			slog.Group("page",
+				slog.Uint64("offset", page.Offset),
+				slog.Uint64("limit", page.Limit),
+				slog.Uint64("total", recordsPage.Total),
+			),
+		}
+		if err != nil {
+			args = append(args, slog.Any("error", err))
+			lm.logger.WarnContext(ctx, "Retrieve policy state failed", args...)
+			return
+		}
+		lm.logger.InfoContext(ctx, "Retrieve policy state completed successfully", args...)
+	}(time.Now())
+
+	return lm.service.RetrievePolicyState(ctx, session, at, page)
+}
+
+func (lm *loggingMiddleware) RetrievePlatformStats(ctx context.Context, session smqauthn.Session) (stats journal.PlatformStats, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+		}
+		if err != nil {
+			args = append(args, slog.Any("error", err))
+			lm.logger.WarnContext(ctx, "Retrieve platform stats failed", args...)
+			return
+		}
+		lm.logger.InfoContext(ctx, "Retrieve platform stats completed successfully", args...)
+	}(time.Now())
+
+	return lm.service.RetrievePlatformStats(ctx, session)
+}
+
+func (lm *loggingMiddleware) SaveSessionRecord(ctx context.Context, record journal.SessionRecord) (err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.Group("record",
+				slog.String("occurred_at", record.OccurredAt.Format(time.RFC3339Nano)),
+				slog.String("client_id", record.ClientID),
+				slog.String("event", record.Event),
+			),
+		}
+		if err != nil {
+			args = append(args, slog.Any("error", err))
+			lm.logger.WarnContext(ctx, "Save session record failed", args...)
+			return
+		}
+		lm.logger.InfoContext(ctx, "Save session record completed successfully", args...)
+	}(time.Now())
+
+	return lm.service.SaveSessionRecord(ctx, record)
+}
+
+func (lm *loggingMiddleware) RetrieveSessionRecords(ctx context.Context, session smqauthn.Session, page journal.SessionRecordPage) (recordsPage journal.SessionRecordsPage, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("client_id", page.ClientID),
+			slog.Group("page",
+				slog.Uint64("offset", page.Offset),
+				slog.Uint64("limit", page.Limit),
+				slog.Uint64("total", recordsPage.Total),
+			),
+		}
+		if err != nil {
+			args = append(args, slog.Any("error", err))
+			lm.logger.WarnContext(ctx, "Retrieve session records failed", args...)
+			return
+		}
+		lm.logger.InfoContext(ctx, "Retrieve session records completed successfully", args...)
+	}(time.Now())
+
+	return lm.service.RetrieveSessionRecords(ctx, session, page)
+}
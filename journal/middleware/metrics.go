@@ -9,6 +9,7 @@ import (
 
 	"github.com/absmach/supermq/journal"
 	smqauthn "github.com/absmach/supermq/pkg/authn"
+	"github.com/absmach/supermq/pkg/prometheus"
 	"github.com/go-kit/kit/metrics"
 )
 
@@ -30,20 +31,74 @@ func MetricsMiddleware(service journal.Service, counter metrics.Counter, latency
 	}
 }
 
-func (mm *metricsMiddleware) Save(ctx context.Context, j journal.Journal) error {
+func (mm *metricsMiddleware) Save(ctx context.Context, j journal.Journal) (err error) {
 	defer func(begin time.Time) {
-		mm.counter.With("method", "save").Add(1)
+		mm.counter.With("method", "save", "error", prometheus.ErrorClass(err)).Add(1)
 		mm.latency.With("method", "save").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 
 	return mm.service.Save(ctx, j)
 }
 
-func (mm *metricsMiddleware) RetrieveAll(ctx context.Context, session smqauthn.Session, page journal.Page) (journal.JournalsPage, error) {
+func (mm *metricsMiddleware) RetrieveAll(ctx context.Context, session smqauthn.Session, page journal.Page) (journalsPage journal.JournalsPage, err error) {
 	defer func(begin time.Time) {
-		mm.counter.With("method", "retrieve_all").Add(1)
+		mm.counter.With("method", "retrieve_all", "error", prometheus.ErrorClass(err)).Add(1)
 		mm.latency.With("method", "retrieve_all").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 
 	return mm.service.RetrieveAll(ctx, session, page)
 }
+
+func (mm *metricsMiddleware) SavePolicyRecord(ctx context.Context, record journal.PolicyRecord) (err error) {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "save_policy_record", "error", prometheus.ErrorClass(err)).Add(1)
+		mm.latency.With("method", "save_policy_record").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.service.SavePolicyRecord(ctx, record)
+}
+
+func (mm *metricsMiddleware) RetrievePolicyRecords(ctx context.Context, session smqauthn.Session, page journal.PolicyRecordPage) (recordsPage journal.PolicyRecordsPage, err error) {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "retrieve_policy_records", "error", prometheus.ErrorClass(err)).Add(1)
+		mm.latency.With("method", "retrieve_policy_records").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.service.RetrievePolicyRecords(ctx, session, page)
+}
+
+func (mm *metricsMiddleware) RetrievePolicyState(ctx context.Context, session smqauthn.Session, at time.Time, page journal.PolicyRecordPage) (recordsPage journal.PolicyRecordsPage, err error) {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "retrieve_policy_state", "error", prometheus.ErrorClass(err)).Add(1)
+		mm.latency.With("method", "retrieve_policy_state").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.service.RetrievePolicyState(ctx, session, at, page)
+}
+
+func (mm *metricsMiddleware) RetrievePlatformStats(ctx context.Context, session smqauthn.Session) (stats journal.PlatformStats, err error) {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "retrieve_platform_stats", "error", prometheus.ErrorClass(err)).Add(1)
+		mm.latency.With("method", "retrieve_platform_stats").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.service.RetrievePlatformStats(ctx, session)
+}
+
+func (mm *metricsMiddleware) SaveSessionRecord(ctx context.Context, record journal.SessionRecord) (err error) {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "save_session_record", "error", prometheus.ErrorClass(err)).Add(1)
+		mm.latency.With("method", "save_session_record").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.service.SaveSessionRecord(ctx, record)
+}
+
+func (mm *metricsMiddleware) RetrieveSessionRecords(ctx context.Context, session smqauthn.Session, page journal.SessionRecordPage) (recordsPage journal.SessionRecordsPage, err error) {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "retrieve_session_records", "error", prometheus.ErrorClass(err)).Add(1)
+		mm.latency.With("method", "retrieve_session_records").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.service.RetrieveSessionRecords(ctx, session, page)
+}
@@ -5,6 +5,7 @@ package middleware
 
 import (
 	"context"
+	"time"
 
 	"github.com/absmach/supermq/journal"
 	smqauthn "github.com/absmach/supermq/pkg/authn"
@@ -45,3 +46,65 @@ func (tm *tracing) RetrieveAll(ctx context.Context, session smqauthn.Session, pa
 
 	return tm.svc.RetrieveAll(ctx, session, page)
 }
+
+func (tm *tracing) SavePolicyRecord(ctx context.Context, record journal.PolicyRecord) error {
+	ctx, span := tm.tracer.Start(ctx, "save_policy_record", trace.WithAttributes(
+		attribute.String("occurred_at", record.OccurredAt.String()),
+		attribute.String("action", record.Action),
+	))
+	defer span.End()
+
+	return tm.svc.SavePolicyRecord(ctx, record)
+}
+
+func (tm *tracing) RetrievePolicyRecords(ctx context.Context, session smqauthn.Session, page journal.PolicyRecordPage) (resp journal.PolicyRecordsPage, err error) {
+	ctx, span := tm.tracer.Start(ctx, "retrieve_policy_records", trace.WithAttributes(
+		attribute.Int64("offset", int64(page.Offset)),
+		attribute.Int64("limit", int64(page.Limit)),
+		attribute.String("subject", page.Subject),
+		attribute.String("object", page.Object),
+	))
+	defer span.End()
+
+	return tm.svc.RetrievePolicyRecords(ctx, session, page)
+}
+
+func (tm *tracing) RetrievePolicyState(ctx context.Context, session smqauthn.Session, at time.Time, page journal.PolicyRecordPage) (resp journal.PolicyRecordsPage, err error) {
+	ctx, span := tm.tracer.Start(ctx, "retrieve_policy_state", trace.WithAttributes(
+		attribute.String("at", at.String()),
+		attribute.Int64("offset", int64(page.Offset)),
+		attribute.Int64("limit", int64(page.Limit)),
+	))
+	defer span.End()
+
+	return tm.svc.RetrievePolicyState(ctx, session, at, page)
+}
+
+func (tm *tracing) RetrievePlatformStats(ctx context.Context, session smqauthn.Session) (resp journal.PlatformStats, err error) {
+	ctx, span := tm.tracer.Start(ctx, "retrieve_platform_stats")
+	defer span.End()
+
+	return tm.svc.RetrievePlatformStats(ctx, session)
+}
+
+func (tm *tracing) SaveSessionRecord(ctx context.Context, record journal.SessionRecord) error {
+	ctx, span := tm.tracer.Start(ctx, "save_session_record", trace.WithAttributes(
+		attribute.String("occurred_at", record.OccurredAt.String()),
+		attribute.String("client_id", record.ClientID),
+		attribute.String("event", record.Event),
+	))
+	defer span.End()
+
+	return tm.svc.SaveSessionRecord(ctx, record)
+}
+
+func (tm *tracing) RetrieveSessionRecords(ctx context.Context, session smqauthn.Session, page journal.SessionRecordPage) (resp journal.SessionRecordsPage, err error) {
+	ctx, span := tm.tracer.Start(ctx, "retrieve_session_records", trace.WithAttributes(
+		attribute.String("client_id", page.ClientID),
+		attribute.Int64("offset", int64(page.Offset)),
+		attribute.Int64("limit", int64(page.Limit)),
+	))
+	defer span.End()
+
+	return tm.svc.RetrieveSessionRecords(ctx, session, page)
+}
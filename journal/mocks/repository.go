@@ -9,6 +9,8 @@ import (
 
 	journal "github.com/absmach/supermq/journal"
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // Repository is an autogenerated mock type for the Repository type
@@ -16,6 +18,34 @@ type Repository struct {
 	mock.Mock
 }
 
+// DeleteSessionRecords provides a mock function with given fields: ctx, before
+func (_m *Repository) DeleteSessionRecords(ctx context.Context, before time.Time) (uint64, error) {
+	ret := _m.Called(ctx, before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSessionRecords")
+	}
+
+	var r0 uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (uint64, error)); ok {
+		return rf(ctx, before)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) uint64); ok {
+		r0 = rf(ctx, before)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, before)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RetrieveAll provides a mock function with given fields: ctx, page
 func (_m *Repository) RetrieveAll(ctx context.Context, page journal.Page) (journal.JournalsPage, error) {
 	ret := _m.Called(ctx, page)
@@ -44,6 +74,90 @@ func (_m *Repository) RetrieveAll(ctx context.Context, page journal.Page) (journ
 	return r0, r1
 }
 
+// RetrievePlatformStats provides a mock function with given fields: ctx
+func (_m *Repository) RetrievePlatformStats(ctx context.Context) (journal.PlatformStats, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetrievePlatformStats")
+	}
+
+	var r0 journal.PlatformStats
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (journal.PlatformStats, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) journal.PlatformStats); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(journal.PlatformStats)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RetrievePolicyRecords provides a mock function with given fields: ctx, page
+func (_m *Repository) RetrievePolicyRecords(ctx context.Context, page journal.PolicyRecordPage) (journal.PolicyRecordsPage, error) {
+	ret := _m.Called(ctx, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetrievePolicyRecords")
+	}
+
+	var r0 journal.PolicyRecordsPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, journal.PolicyRecordPage) (journal.PolicyRecordsPage, error)); ok {
+		return rf(ctx, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, journal.PolicyRecordPage) journal.PolicyRecordsPage); ok {
+		r0 = rf(ctx, page)
+	} else {
+		r0 = ret.Get(0).(journal.PolicyRecordsPage)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, journal.PolicyRecordPage) error); ok {
+		r1 = rf(ctx, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RetrieveSessionRecords provides a mock function with given fields: ctx, page
+func (_m *Repository) RetrieveSessionRecords(ctx context.Context, page journal.SessionRecordPage) (journal.SessionRecordsPage, error) {
+	ret := _m.Called(ctx, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetrieveSessionRecords")
+	}
+
+	var r0 journal.SessionRecordsPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, journal.SessionRecordPage) (journal.SessionRecordsPage, error)); ok {
+		return rf(ctx, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, journal.SessionRecordPage) journal.SessionRecordsPage); ok {
+		r0 = rf(ctx, page)
+	} else {
+		r0 = ret.Get(0).(journal.SessionRecordsPage)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, journal.SessionRecordPage) error); ok {
+		r1 = rf(ctx, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Save provides a mock function with given fields: ctx, _a1
 func (_m *Repository) Save(ctx context.Context, _a1 journal.Journal) error {
 	ret := _m.Called(ctx, _a1)
@@ -62,6 +176,42 @@ func (_m *Repository) Save(ctx context.Context, _a1 journal.Journal) error {
 	return r0
 }
 
+// SavePolicyRecord provides a mock function with given fields: ctx, record
+func (_m *Repository) SavePolicyRecord(ctx context.Context, record journal.PolicyRecord) error {
+	ret := _m.Called(ctx, record)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SavePolicyRecord")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, journal.PolicyRecord) error); ok {
+		r0 = rf(ctx, record)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SaveSessionRecord provides a mock function with given fields: ctx, record
+func (_m *Repository) SaveSessionRecord(ctx context.Context, record journal.SessionRecord) error {
+	ret := _m.Called(ctx, record)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveSessionRecord")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, journal.SessionRecord) error); ok {
+		r0 = rf(ctx, record)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewRepository creates a new instance of Repository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewRepository(t interface {
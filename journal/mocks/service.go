@@ -6,6 +6,7 @@ package mocks
 
 import (
 	context "context"
+	time "time"
 
 	authn "github.com/absmach/supermq/pkg/authn"
 
@@ -47,6 +48,118 @@ func (_m *Service) RetrieveAll(ctx context.Context, session authn.Session, page
 	return r0, r1
 }
 
+// RetrievePlatformStats provides a mock function with given fields: ctx, session
+func (_m *Service) RetrievePlatformStats(ctx context.Context, session authn.Session) (journal.PlatformStats, error) {
+	ret := _m.Called(ctx, session)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetrievePlatformStats")
+	}
+
+	var r0 journal.PlatformStats
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session) (journal.PlatformStats, error)); ok {
+		return rf(ctx, session)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session) journal.PlatformStats); ok {
+		r0 = rf(ctx, session)
+	} else {
+		r0 = ret.Get(0).(journal.PlatformStats)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, authn.Session) error); ok {
+		r1 = rf(ctx, session)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RetrievePolicyRecords provides a mock function with given fields: ctx, session, page
+func (_m *Service) RetrievePolicyRecords(ctx context.Context, session authn.Session, page journal.PolicyRecordPage) (journal.PolicyRecordsPage, error) {
+	ret := _m.Called(ctx, session, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetrievePolicyRecords")
+	}
+
+	var r0 journal.PolicyRecordsPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, journal.PolicyRecordPage) (journal.PolicyRecordsPage, error)); ok {
+		return rf(ctx, session, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, journal.PolicyRecordPage) journal.PolicyRecordsPage); ok {
+		r0 = rf(ctx, session, page)
+	} else {
+		r0 = ret.Get(0).(journal.PolicyRecordsPage)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, authn.Session, journal.PolicyRecordPage) error); ok {
+		r1 = rf(ctx, session, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RetrievePolicyState provides a mock function with given fields: ctx, session, at, page
+func (_m *Service) RetrievePolicyState(ctx context.Context, session authn.Session, at time.Time, page journal.PolicyRecordPage) (journal.PolicyRecordsPage, error) {
+	ret := _m.Called(ctx, session, at, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetrievePolicyState")
+	}
+
+	var r0 journal.PolicyRecordsPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, time.Time, journal.PolicyRecordPage) (journal.PolicyRecordsPage, error)); ok {
+		return rf(ctx, session, at, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, time.Time, journal.PolicyRecordPage) journal.PolicyRecordsPage); ok {
+		r0 = rf(ctx, session, at, page)
+	} else {
+		r0 = ret.Get(0).(journal.PolicyRecordsPage)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, authn.Session, time.Time, journal.PolicyRecordPage) error); ok {
+		r1 = rf(ctx, session, at, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RetrieveSessionRecords provides a mock function with given fields: ctx, session, page
+func (_m *Service) RetrieveSessionRecords(ctx context.Context, session authn.Session, page journal.SessionRecordPage) (journal.SessionRecordsPage, error) {
+	ret := _m.Called(ctx, session, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetrieveSessionRecords")
+	}
+
+	var r0 journal.SessionRecordsPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, journal.SessionRecordPage) (journal.SessionRecordsPage, error)); ok {
+		return rf(ctx, session, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, journal.SessionRecordPage) journal.SessionRecordsPage); ok {
+		r0 = rf(ctx, session, page)
+	} else {
+		r0 = ret.Get(0).(journal.SessionRecordsPage)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, authn.Session, journal.SessionRecordPage) error); ok {
+		r1 = rf(ctx, session, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Save provides a mock function with given fields: ctx, _a1
 func (_m *Service) Save(ctx context.Context, _a1 journal.Journal) error {
 	ret := _m.Called(ctx, _a1)
@@ -65,6 +178,42 @@ func (_m *Service) Save(ctx context.Context, _a1 journal.Journal) error {
 	return r0
 }
 
+// SavePolicyRecord provides a mock function with given fields: ctx, record
+func (_m *Service) SavePolicyRecord(ctx context.Context, record journal.PolicyRecord) error {
+	ret := _m.Called(ctx, record)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SavePolicyRecord")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, journal.PolicyRecord) error); ok {
+		r0 = rf(ctx, record)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SaveSessionRecord provides a mock function with given fields: ctx, record
+func (_m *Service) SaveSessionRecord(ctx context.Context, record journal.SessionRecord) error {
+	ret := _m.Called(ctx, record)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveSessionRecord")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, journal.SessionRecord) error); ok {
+		r0 = rf(ctx, record)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewService creates a new instance of Service. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewService(t interface {
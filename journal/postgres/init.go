@@ -31,6 +31,50 @@ func Migration() *migrate.MemoryMigrationSource {
 					`DROP TABLE IF EXISTS journal`,
 				},
 			},
+			{
+				Id: "journal_02",
+				Up: []string{
+					`CREATE TABLE IF NOT EXISTS policy_records (
+						id					VARCHAR(36) PRIMARY KEY,
+						action				VARCHAR(36) NOT NULL,
+						occurred_at			TIMESTAMP NOT NULL,
+						domain				VARCHAR(254) NOT NULL DEFAULT '',
+						subject				VARCHAR(254) NOT NULL DEFAULT '',
+						subject_type		VARCHAR(254) NOT NULL DEFAULT '',
+						subject_relation	VARCHAR(254) NOT NULL DEFAULT '',
+						object				VARCHAR(254) NOT NULL DEFAULT '',
+						object_type			VARCHAR(254) NOT NULL DEFAULT '',
+						relation			VARCHAR(254) NOT NULL DEFAULT '',
+						permission			VARCHAR(254) NOT NULL DEFAULT ''
+					)`,
+					`CREATE INDEX idx_policy_records_occurred_at ON policy_records(occurred_at);`,
+					`CREATE INDEX idx_policy_records_subject ON policy_records(subject, occurred_at);`,
+					`CREATE INDEX idx_policy_records_object ON policy_records(object, occurred_at);`,
+				},
+				Down: []string{
+					`DROP TABLE IF EXISTS policy_records`,
+				},
+			},
+			{
+				Id: "journal_03",
+				Up: []string{
+					`CREATE TABLE IF NOT EXISTS session_records (
+						id			VARCHAR(36) PRIMARY KEY,
+						client_id	VARCHAR(254) NOT NULL,
+						event		VARCHAR(36) NOT NULL,
+						adapter		VARCHAR(36) NOT NULL DEFAULT '',
+						instance	VARCHAR(254) NOT NULL DEFAULT '',
+						remote_addr	VARCHAR(254) NOT NULL DEFAULT '',
+						clean		BOOLEAN NOT NULL DEFAULT false,
+						occurred_at	TIMESTAMP NOT NULL
+					)`,
+					`CREATE INDEX idx_session_records_client ON session_records(client_id, occurred_at DESC);`,
+					`CREATE INDEX idx_session_records_occurred_at ON session_records(occurred_at);`,
+				},
+				Down: []string{
+					`DROP TABLE IF EXISTS session_records`,
+				},
+			},
 		},
 	}
 }
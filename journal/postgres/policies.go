@@ -0,0 +1,89 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/absmach/supermq/journal"
+	repoerr "github.com/absmach/supermq/pkg/errors/repository"
+	"github.com/absmach/supermq/pkg/postgres"
+)
+
+func (repo *repository) SavePolicyRecord(ctx context.Context, record journal.PolicyRecord) error {
+	q := `INSERT INTO policy_records (id, action, occurred_at, domain, subject, subject_type, subject_relation, object, object_type, relation, permission)
+		VALUES (:id, :action, :occurred_at, :domain, :subject, :subject_type, :subject_relation, :object, :object_type, :relation, :permission);`
+
+	if _, err := repo.db.NamedExecContext(ctx, q, record); err != nil {
+		return postgres.HandleError(repoerr.ErrCreateEntity, err)
+	}
+
+	return nil
+}
+
+func (repo *repository) RetrievePolicyRecords(ctx context.Context, page journal.PolicyRecordPage) (journal.PolicyRecordsPage, error) {
+	query := policyPageQuery(page)
+
+	q := fmt.Sprintf(`SELECT id, action, occurred_at, domain, subject, subject_type, subject_relation, object, object_type, relation, permission
+		FROM policy_records %s ORDER BY occurred_at ASC, id ASC LIMIT :limit OFFSET :offset;`, query)
+
+	rows, err := repo.db.NamedQueryContext(ctx, q, page)
+	if err != nil {
+		return journal.PolicyRecordsPage{}, postgres.HandleError(repoerr.ErrViewEntity, err)
+	}
+	defer rows.Close()
+
+	var items []journal.PolicyRecord
+	for rows.Next() {
+		var item journal.PolicyRecord
+		if err = rows.StructScan(&item); err != nil {
+			return journal.PolicyRecordsPage{}, postgres.HandleError(repoerr.ErrViewEntity, err)
+		}
+		items = append(items, item)
+	}
+
+	tq := fmt.Sprintf(`SELECT COUNT(*) FROM policy_records %s;`, query)
+
+	total, err := postgres.Total(ctx, repo.db, tq, page)
+	if err != nil {
+		return journal.PolicyRecordsPage{}, postgres.HandleError(repoerr.ErrViewEntity, err)
+	}
+
+	recordsPage := journal.PolicyRecordsPage{
+		Total:   total,
+		Offset:  page.Offset,
+		Limit:   page.Limit,
+		Records: items,
+	}
+
+	return recordsPage, nil
+}
+
+func policyPageQuery(pm journal.PolicyRecordPage) string {
+	var query []string
+	var emq string
+	if pm.Domain != "" {
+		query = append(query, "domain = :domain")
+	}
+	if pm.Subject != "" {
+		query = append(query, "subject = :subject")
+	}
+	if pm.Object != "" {
+		query = append(query, "object = :object")
+	}
+	if !pm.From.IsZero() {
+		query = append(query, "occurred_at >= :from")
+	}
+	if !pm.To.IsZero() {
+		query = append(query, "occurred_at <= :to")
+	}
+
+	if len(query) > 0 {
+		emq = fmt.Sprintf("WHERE %s", strings.Join(query, " AND "))
+	}
+
+	return emq
+}
@@ -0,0 +1,105 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/absmach/supermq/journal"
+	repoerr "github.com/absmach/supermq/pkg/errors/repository"
+	"github.com/absmach/supermq/pkg/postgres"
+)
+
+func (repo *repository) SaveSessionRecord(ctx context.Context, record journal.SessionRecord) error {
+	q := `INSERT INTO session_records (id, client_id, event, adapter, instance, remote_addr, clean, occurred_at)
+		VALUES (:id, :client_id, :event, :adapter, :instance, :remote_addr, :clean, :occurred_at);`
+
+	if _, err := repo.db.NamedExecContext(ctx, q, record); err != nil {
+		return postgres.HandleError(repoerr.ErrCreateEntity, err)
+	}
+
+	return nil
+}
+
+func (repo *repository) RetrieveSessionRecords(ctx context.Context, page journal.SessionRecordPage) (journal.SessionRecordsPage, error) {
+	query := sessionPageQuery(page)
+
+	q := fmt.Sprintf(`SELECT id, client_id, event, adapter, instance, remote_addr, clean, occurred_at
+		FROM session_records %s ORDER BY occurred_at DESC, id DESC LIMIT :limit OFFSET :offset;`, query)
+
+	rows, err := repo.db.NamedQueryContext(ctx, q, page)
+	if err != nil {
+		return journal.SessionRecordsPage{}, postgres.HandleError(repoerr.ErrViewEntity, err)
+	}
+	defer rows.Close()
+
+	var items []journal.SessionRecord
+	for rows.Next() {
+		var item journal.SessionRecord
+		if err = rows.StructScan(&item); err != nil {
+			return journal.SessionRecordsPage{}, postgres.HandleError(repoerr.ErrViewEntity, err)
+		}
+		items = append(items, item)
+	}
+
+	tq := fmt.Sprintf(`SELECT COUNT(*) FROM session_records %s;`, query)
+
+	total, err := postgres.Total(ctx, repo.db, tq, page)
+	if err != nil {
+		return journal.SessionRecordsPage{}, postgres.HandleError(repoerr.ErrViewEntity, err)
+	}
+
+	recordsPage := journal.SessionRecordsPage{
+		Total:    total,
+		Offset:   page.Offset,
+		Limit:    page.Limit,
+		Sessions: items,
+	}
+
+	return recordsPage, nil
+}
+
+func (repo *repository) DeleteSessionRecords(ctx context.Context, before time.Time) (uint64, error) {
+	q := `DELETE FROM session_records WHERE occurred_at < :before;`
+
+	result, err := repo.db.NamedExecContext(ctx, q, map[string]interface{}{"before": before})
+	if err != nil {
+		return 0, postgres.HandleError(repoerr.ErrRemoveEntity, err)
+	}
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return 0, postgres.HandleError(repoerr.ErrRemoveEntity, err)
+	}
+
+	return uint64(deleted), nil
+}
+
+func sessionPageQuery(pm journal.SessionRecordPage) string {
+	var query []string
+	var emq string
+	if pm.ClientID != "" {
+		query = append(query, "client_id = :client_id")
+	}
+	if pm.Adapter != "" {
+		query = append(query, "adapter = :adapter")
+	}
+	if pm.Event != "" {
+		query = append(query, "event = :event")
+	}
+	if !pm.From.IsZero() {
+		query = append(query, "occurred_at >= :from")
+	}
+	if !pm.To.IsZero() {
+		query = append(query, "occurred_at <= :to")
+	}
+
+	if len(query) > 0 {
+		emq = fmt.Sprintf("WHERE %s", strings.Join(query, " AND "))
+	}
+
+	return emq
+}
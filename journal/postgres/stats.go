@@ -0,0 +1,94 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/absmach/supermq/journal"
+	repoerr "github.com/absmach/supermq/pkg/errors/repository"
+	"github.com/absmach/supermq/pkg/postgres"
+)
+
+// entityStatsQuery derives the current status of every journaled entity from
+// its latest lifecycle operation and counts entities per type and status.
+// User status changes are published as remove events carrying the new status,
+// while the other services publish dedicated change_status events.
+const entityStatsQuery = `SELECT entity, status, COUNT(*) AS total FROM (
+		SELECT DISTINCT ON (entity, entity_id) entity,
+			CASE
+				WHEN action = 'delete' THEN 'deleted'
+				WHEN action = 'remove' THEN COALESCE(attributes->>'status', 'deleted')
+				WHEN action IN ('change_status', 'disable') THEN COALESCE(attributes->>'status', 'disabled')
+				ELSE COALESCE(attributes->>'status', 'enabled')
+			END AS status
+		FROM (
+			SELECT split_part(operation, '.', 1) AS entity,
+				split_part(operation, '.', 2) AS action,
+				COALESCE(attributes->>'id', '') AS entity_id,
+				attributes, occurred_at
+			FROM journal
+			WHERE split_part(operation, '.', 1) IN ('user', 'group', 'client', 'channel')
+				AND split_part(operation, '.', 2) IN ('create', 'change_status', 'disable', 'remove', 'restore', 'delete')
+		) ops
+		ORDER BY entity, entity_id, occurred_at DESC
+	) latest GROUP BY entity, status;`
+
+const activityStatsQuery = `SELECT COUNT(*) AS total,
+		COUNT(*) FILTER (WHERE occurred_at >= :last_hour) AS last_hour,
+		COUNT(*) FILTER (WHERE occurred_at >= :last_day) AS last_day
+	FROM journal;`
+
+func (repo *repository) RetrievePlatformStats(ctx context.Context) (journal.PlatformStats, error) {
+	rows, err := repo.db.NamedQueryContext(ctx, entityStatsQuery, map[string]interface{}{})
+	if err != nil {
+		return journal.PlatformStats{}, postgres.HandleError(repoerr.ErrViewEntity, err)
+	}
+	defer rows.Close()
+
+	entities := make(map[string]journal.EntityStats)
+	for rows.Next() {
+		var item struct {
+			Entity string `db:"entity"`
+			Status string `db:"status"`
+			Total  uint64 `db:"total"`
+		}
+		if err = rows.StructScan(&item); err != nil {
+			return journal.PlatformStats{}, postgres.HandleError(repoerr.ErrViewEntity, err)
+		}
+		stats := entities[item.Entity]
+		switch item.Status {
+		case "deleted":
+			stats.Deleted += item.Total
+		case "disabled":
+			stats.Disabled += item.Total
+		default:
+			stats.Enabled += item.Total
+		}
+		entities[item.Entity] = stats
+	}
+
+	now := time.Now()
+	arows, err := repo.db.NamedQueryContext(ctx, activityStatsQuery, map[string]interface{}{
+		"last_hour": now.Add(-time.Hour),
+		"last_day":  now.Add(-24 * time.Hour),
+	})
+	if err != nil {
+		return journal.PlatformStats{}, postgres.HandleError(repoerr.ErrViewEntity, err)
+	}
+	defer arows.Close()
+
+	var activity journal.ActivityStats
+	if arows.Next() {
+		if err = arows.StructScan(&activity); err != nil {
+			return journal.PlatformStats{}, postgres.HandleError(repoerr.ErrViewEntity, err)
+		}
+	}
+
+	return journal.PlatformStats{
+		Entities: entities,
+		Activity: activity,
+	}, nil
+}
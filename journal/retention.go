@@ -0,0 +1,58 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package journal
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+// sweepInterval is the period between session record retention sweeps.
+const sweepInterval = time.Hour
+
+// SessionRetention periodically deletes session records older than the
+// configured retention period.
+type SessionRetention struct {
+	repository Repository
+	logger     *slog.Logger
+	retention  time.Duration
+}
+
+// NewSessionRetention returns a session record pruner that deletes records
+// older than retention on every sweep.
+func NewSessionRetention(repository Repository, logger *slog.Logger, retention time.Duration) *SessionRetention {
+	return &SessionRetention{
+		repository: repository,
+		logger:     logger,
+		retention:  retention,
+	}
+}
+
+// Run starts the retention loop and blocks until the context is canceled.
+func (sr *SessionRetention) Run(ctx context.Context) error {
+	ticker := time.NewTicker(sweepInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			sr.sweep(ctx)
+		}
+	}
+}
+
+func (sr *SessionRetention) sweep(ctx context.Context) {
+	deleted, err := sr.repository.DeleteSessionRecords(ctx, time.Now().Add(-sr.retention))
+	if err != nil {
+		sr.logger.Warn(fmt.Sprintf("failed to delete expired session records: %s", err))
+		return
+	}
+	if deleted > 0 {
+		sr.logger.Info(fmt.Sprintf("deleted %d expired session records", deleted))
+	}
+}
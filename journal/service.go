@@ -5,6 +5,10 @@ package journal
 
 import (
 	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/absmach/supermq"
 	smqauthn "github.com/absmach/supermq/pkg/authn"
@@ -12,9 +16,20 @@ import (
 	svcerr "github.com/absmach/supermq/pkg/errors/service"
 )
 
+// stateChunk is the number of policy records fetched per repository call
+// when replaying the policy change history.
+const stateChunk = uint64(1000)
+
+// statsTTL is the period for which computed platform stats are served from
+// the cache before the aggregation queries are run again.
+const statsTTL = time.Minute
+
 type service struct {
 	idProvider supermq.IDProvider
 	repository Repository
+
+	statsMu sync.Mutex
+	stats   PlatformStats
 }
 
 func NewService(idp supermq.IDProvider, repository Repository) Service {
@@ -42,3 +57,153 @@ func (svc *service) RetrieveAll(ctx context.Context, session smqauthn.Session, p
 
 	return journalPage, nil
 }
+
+func (svc *service) SavePolicyRecord(ctx context.Context, record PolicyRecord) error {
+	id, err := svc.idProvider.ID()
+	if err != nil {
+		return err
+	}
+	record.ID = id
+
+	return svc.repository.SavePolicyRecord(ctx, record)
+}
+
+func (svc *service) RetrievePolicyRecords(ctx context.Context, session smqauthn.Session, page PolicyRecordPage) (PolicyRecordsPage, error) {
+	recordsPage, err := svc.repository.RetrievePolicyRecords(ctx, page)
+	if err != nil {
+		return PolicyRecordsPage{}, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+
+	return recordsPage, nil
+}
+
+func (svc *service) SaveSessionRecord(ctx context.Context, record SessionRecord) error {
+	id, err := svc.idProvider.ID()
+	if err != nil {
+		return err
+	}
+	record.ID = id
+
+	return svc.repository.SaveSessionRecord(ctx, record)
+}
+
+func (svc *service) RetrieveSessionRecords(ctx context.Context, session smqauthn.Session, page SessionRecordPage) (SessionRecordsPage, error) {
+	recordsPage, err := svc.repository.RetrieveSessionRecords(ctx, page)
+	if err != nil {
+		return SessionRecordsPage{}, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+
+	return recordsPage, nil
+}
+
+func (svc *service) RetrievePolicyState(ctx context.Context, session smqauthn.Session, at time.Time, page PolicyRecordPage) (PolicyRecordsPage, error) {
+	query := PolicyRecordPage{
+		Limit:   stateChunk,
+		Domain:  page.Domain,
+		Subject: page.Subject,
+		Object:  page.Object,
+		To:      at,
+	}
+
+	state := make(map[string]PolicyRecord)
+	for {
+		chunk, err := svc.repository.RetrievePolicyRecords(ctx, query)
+		if err != nil {
+			return PolicyRecordsPage{}, errors.Wrap(svcerr.ErrViewEntity, err)
+		}
+		for _, record := range chunk.Records {
+			replay(state, record)
+		}
+		if uint64(len(chunk.Records)) < stateChunk {
+			break
+		}
+		query.Offset += stateChunk
+	}
+
+	records := make([]PolicyRecord, 0, len(state))
+	for _, record := range state {
+		records = append(records, record)
+	}
+	sort.Slice(records, func(i, j int) bool {
+		if records[i].OccurredAt.Equal(records[j].OccurredAt) {
+			return records[i].tuple() < records[j].tuple()
+		}
+		return records[i].OccurredAt.Before(records[j].OccurredAt)
+	})
+
+	total := uint64(len(records))
+	start := page.Offset
+	if start > total {
+		start = total
+	}
+	end := total
+	if page.Limit != 0 && start+page.Limit < total {
+		end = start + page.Limit
+	}
+
+	return PolicyRecordsPage{
+		Total:   total,
+		Offset:  page.Offset,
+		Limit:   page.Limit,
+		Records: records[start:end],
+	}, nil
+}
+
+func (svc *service) RetrievePlatformStats(ctx context.Context, session smqauthn.Session) (PlatformStats, error) {
+	svc.statsMu.Lock()
+	defer svc.statsMu.Unlock()
+
+	if !svc.stats.ComputedAt.IsZero() && time.Since(svc.stats.ComputedAt) < statsTTL {
+		return svc.stats, nil
+	}
+
+	stats, err := svc.repository.RetrievePlatformStats(ctx)
+	if err != nil {
+		return PlatformStats{}, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+	stats.ComputedAt = time.Now()
+	svc.stats = stats
+
+	return stats, nil
+}
+
+// replay applies a single policy change record to the reconstructed state.
+func replay(state map[string]PolicyRecord, record PolicyRecord) {
+	switch record.Action {
+	case PolicyAdd:
+		state[record.tuple()] = record
+	case PolicyDelete:
+		delete(state, record.tuple())
+	case PolicyDeleteFilter:
+		for key, pr := range state {
+			if pr.matches(record) {
+				delete(state, key)
+			}
+		}
+	}
+}
+
+// tuple returns the identity of the policy relationship the record refers to.
+func (pr PolicyRecord) tuple() string {
+	return strings.Join([]string{pr.Domain, pr.Subject, pr.SubjectType, pr.SubjectRelation, pr.Object, pr.ObjectType, pr.Relation}, "\x1f")
+}
+
+// matches reports whether the record matches the given delete filter. Empty
+// filter fields match any value.
+func (pr PolicyRecord) matches(filter PolicyRecord) bool {
+	for _, fields := range [][2]string{
+		{filter.Domain, pr.Domain},
+		{filter.Subject, pr.Subject},
+		{filter.SubjectType, pr.SubjectType},
+		{filter.SubjectRelation, pr.SubjectRelation},
+		{filter.Object, pr.Object},
+		{filter.ObjectType, pr.ObjectType},
+		{filter.Relation, pr.Relation},
+	} {
+		if fields[0] != "" && fields[0] != fields[1] {
+			return false
+		}
+	}
+
+	return true
+}
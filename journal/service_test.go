@@ -16,6 +16,7 @@ import (
 	smqauthn "github.com/absmach/supermq/pkg/authn"
 	"github.com/absmach/supermq/pkg/errors"
 	repoerr "github.com/absmach/supermq/pkg/errors/repository"
+	svcerr "github.com/absmach/supermq/pkg/errors/service"
 	"github.com/absmach/supermq/pkg/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -145,3 +146,279 @@ func TestReadAll(t *testing.T) {
 		})
 	}
 }
+
+func TestSavePolicyRecord(t *testing.T) {
+	repo := new(mocks.Repository)
+	svc := journal.NewService(idProvider, repo)
+
+	record := journal.PolicyRecord{
+		Action:      journal.PolicyAdd,
+		OccurredAt:  time.Now().Add(-time.Hour),
+		Subject:     testsutil.GenerateUUID(t),
+		SubjectType: "user",
+		Object:      testsutil.GenerateUUID(t),
+		ObjectType:  "group",
+		Relation:    "member",
+	}
+
+	cases := []struct {
+		desc    string
+		record  journal.PolicyRecord
+		repoErr error
+		err     error
+	}{
+		{
+			desc:    "successful",
+			record:  record,
+			repoErr: nil,
+			err:     nil,
+		},
+		{
+			desc:    "with repo error",
+			record:  record,
+			repoErr: repoerr.ErrCreateEntity,
+			err:     repoerr.ErrCreateEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			repoCall := repo.On("SavePolicyRecord", context.Background(), mock.Anything).Return(tc.repoErr)
+			err := svc.SavePolicyRecord(context.Background(), tc.record)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			repoCall.Unset()
+		})
+	}
+}
+
+func TestSaveSessionRecord(t *testing.T) {
+	repo := new(mocks.Repository)
+	svc := journal.NewService(idProvider, repo)
+
+	record := journal.SessionRecord{
+		ClientID:   testsutil.GenerateUUID(t),
+		Event:      journal.SessionConnect,
+		Adapter:    "mqtt",
+		OccurredAt: time.Now().Add(-time.Hour),
+	}
+
+	cases := []struct {
+		desc    string
+		record  journal.SessionRecord
+		repoErr error
+		err     error
+	}{
+		{
+			desc:    "successful",
+			record:  record,
+			repoErr: nil,
+			err:     nil,
+		},
+		{
+			desc:    "with repo error",
+			record:  record,
+			repoErr: repoerr.ErrCreateEntity,
+			err:     repoerr.ErrCreateEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			repoCall := repo.On("SaveSessionRecord", context.Background(), mock.Anything).Return(tc.repoErr)
+			err := svc.SaveSessionRecord(context.Background(), tc.record)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			repoCall.Unset()
+		})
+	}
+}
+
+func TestRetrieveSessionRecords(t *testing.T) {
+	repo := new(mocks.Repository)
+	svc := journal.NewService(idProvider, repo)
+
+	session := smqauthn.Session{DomainUserID: testsutil.GenerateUUID(t), UserID: testsutil.GenerateUUID(t), DomainID: testsutil.GenerateUUID(t)}
+	page := journal.SessionRecordPage{
+		Offset:   0,
+		Limit:    10,
+		ClientID: testsutil.GenerateUUID(t),
+	}
+
+	cases := []struct {
+		desc    string
+		page    journal.SessionRecordPage
+		resp    journal.SessionRecordsPage
+		repoErr error
+		err     error
+	}{
+		{
+			desc: "successful",
+			page: page,
+			resp: journal.SessionRecordsPage{
+				Total:  1,
+				Offset: 0,
+				Limit:  10,
+				Sessions: []journal.SessionRecord{
+					{
+						ClientID:   page.ClientID,
+						Event:      journal.SessionConnect,
+						Adapter:    "mqtt",
+						OccurredAt: time.Now().Add(-time.Hour),
+					},
+				},
+			},
+		},
+		{
+			desc:    "with repo error",
+			page:    page,
+			resp:    journal.SessionRecordsPage{},
+			repoErr: repoerr.ErrViewEntity,
+			err:     svcerr.ErrViewEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			repoCall := repo.On("RetrieveSessionRecords", context.Background(), tc.page).Return(tc.resp, tc.repoErr)
+			resp, err := svc.RetrieveSessionRecords(context.Background(), session, tc.page)
+			if tc.err == nil {
+				assert.Equal(t, tc.resp, resp, tc.desc)
+			}
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			repoCall.Unset()
+		})
+	}
+}
+
+func TestRetrievePolicyState(t *testing.T) {
+	session := smqauthn.Session{UserID: testsutil.GenerateUUID(t)}
+	now := time.Now()
+
+	subject := testsutil.GenerateUUID(t)
+	object := testsutil.GenerateUUID(t)
+
+	record := func(action string, age time.Duration, pr journal.PolicyRecord) journal.PolicyRecord {
+		pr.Action = action
+		pr.OccurredAt = now.Add(-age)
+		return pr
+	}
+
+	kept := record(journal.PolicyAdd, 3*time.Hour, journal.PolicyRecord{Subject: subject, SubjectType: "user", Object: object, ObjectType: "group", Relation: "member"})
+	removed := journal.PolicyRecord{Subject: subject, SubjectType: "user", Object: testsutil.GenerateUUID(t), ObjectType: "group", Relation: "member"}
+	filtered := journal.PolicyRecord{Subject: testsutil.GenerateUUID(t), SubjectType: "user", Object: object, ObjectType: "group", Relation: "administrator"}
+
+	cases := []struct {
+		desc    string
+		records []journal.PolicyRecord
+		resp    []journal.PolicyRecord
+		repoErr error
+		err     error
+	}{
+		{
+			desc:    "add only",
+			records: []journal.PolicyRecord{kept},
+			resp:    []journal.PolicyRecord{kept},
+		},
+		{
+			desc: "delete removes the matching tuple",
+			records: []journal.PolicyRecord{
+				kept,
+				record(journal.PolicyAdd, 2*time.Hour, removed),
+				record(journal.PolicyDelete, time.Hour, removed),
+			},
+			resp: []journal.PolicyRecord{kept},
+		},
+		{
+			desc: "delete filter removes matching tuples",
+			records: []journal.PolicyRecord{
+				kept,
+				record(journal.PolicyAdd, 2*time.Hour, filtered),
+				record(journal.PolicyDeleteFilter, time.Hour, journal.PolicyRecord{Object: object, Relation: "administrator"}),
+			},
+			resp: []journal.PolicyRecord{kept},
+		},
+		{
+			desc: "add after delete keeps the tuple",
+			records: []journal.PolicyRecord{
+				record(journal.PolicyAdd, 3*time.Hour, kept),
+				record(journal.PolicyDelete, 2*time.Hour, kept),
+				kept,
+			},
+			resp: []journal.PolicyRecord{kept},
+		},
+		{
+			desc:    "with repo error",
+			repoErr: repoerr.ErrViewEntity,
+			err:     repoerr.ErrViewEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			repo := new(mocks.Repository)
+			svc := journal.NewService(idProvider, repo)
+
+			page := journal.PolicyRecordsPage{
+				Total:   uint64(len(tc.records)),
+				Records: tc.records,
+			}
+			repoCall := repo.On("RetrievePolicyRecords", context.Background(), mock.Anything).Return(page, tc.repoErr)
+			resp, err := svc.RetrievePolicyState(context.Background(), session, now, journal.PolicyRecordPage{Limit: 10})
+			if tc.err == nil {
+				assert.Equal(t, tc.resp, resp.Records, tc.desc)
+				assert.Equal(t, uint64(len(tc.resp)), resp.Total, tc.desc)
+			}
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			repoCall.Unset()
+		})
+	}
+}
+
+func TestRetrievePlatformStats(t *testing.T) {
+	session := smqauthn.Session{UserID: testsutil.GenerateUUID(t)}
+
+	stats := journal.PlatformStats{
+		Entities: map[string]journal.EntityStats{
+			"user":   {Enabled: 10, Disabled: 2, Deleted: 1},
+			"client": {Enabled: 42},
+		},
+		Activity: journal.ActivityStats{Total: 100, LastHour: 5, LastDay: 30},
+	}
+
+	cases := []struct {
+		desc    string
+		stats   journal.PlatformStats
+		repoErr error
+		err     error
+	}{
+		{
+			desc:  "successful",
+			stats: stats,
+		},
+		{
+			desc:    "with repo error",
+			repoErr: repoerr.ErrViewEntity,
+			err:     svcerr.ErrViewEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			repo := new(mocks.Repository)
+			svc := journal.NewService(idProvider, repo)
+			repoCall := repo.On("RetrievePlatformStats", context.Background()).Return(tc.stats, tc.repoErr)
+			resp, err := svc.RetrievePlatformStats(context.Background(), session)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			if err == nil {
+				assert.Equal(t, tc.stats.Entities, resp.Entities)
+				assert.Equal(t, tc.stats.Activity, resp.Activity)
+				assert.False(t, resp.ComputedAt.IsZero())
+
+				cached, err := svc.RetrievePlatformStats(context.Background(), session)
+				assert.Nil(t, err)
+				assert.Equal(t, resp, cached)
+				repo.AssertNumberOfCalls(t, "RetrievePlatformStats", 1)
+			}
+			repoCall.Unset()
+		})
+	}
+}
@@ -17,9 +17,18 @@ func New(w io.Writer, levelText string) (*slog.Logger, error) {
 		return &slog.Logger{}, fmt.Errorf(`{"level":"error","message":"%s: %s","ts":"%s"}`, err, levelText, time.RFC3339Nano)
 	}
 
+	lv := new(slog.LevelVar)
+	lv.Set(level)
+
+	return NewWithLevel(w, lv), nil
+}
+
+// NewWithLevel returns a wrapped slog logger whose level can be changed
+// at runtime through the given level var.
+func NewWithLevel(w io.Writer, level *slog.LevelVar) *slog.Logger {
 	logHandler := slog.NewJSONHandler(w, &slog.HandlerOptions{
 		Level: level,
 	})
 
-	return slog.New(logHandler), nil
+	return slog.New(contextHandler{Handler: logHandler})
 }
@@ -0,0 +1,8 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package modbus contains the Modbus gateway service.
+// The service polls Modbus TCP/RTU devices according to per-client register
+// maps, scales and types raw register values, and publishes the readings to
+// SuperMQ channels. It also supports writing command values back to devices.
+package modbus
@@ -0,0 +1,251 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package modbus
+
+import (
+	"context"
+	"encoding/binary"
+	"math"
+	"time"
+
+	smqauthn "github.com/absmach/supermq/pkg/authn"
+	"github.com/absmach/supermq/pkg/errors"
+)
+
+// Protocol is the transport used to reach a Modbus device.
+type Protocol string
+
+const (
+	// ProtocolTCP polls devices over Modbus TCP.
+	ProtocolTCP Protocol = "tcp"
+	// ProtocolRTU polls devices over Modbus RTU (serial).
+	ProtocolRTU Protocol = "rtu"
+)
+
+// RegisterKind is the Modbus register table a register belongs to.
+type RegisterKind string
+
+const (
+	Coil            RegisterKind = "coil"
+	DiscreteInput   RegisterKind = "discrete_input"
+	HoldingRegister RegisterKind = "holding_register"
+	InputRegister   RegisterKind = "input_register"
+)
+
+// DataType determines how raw register words are decoded before scaling.
+type DataType string
+
+const (
+	Bool    DataType = "bool"
+	Uint16  DataType = "uint16"
+	Int16   DataType = "int16"
+	Uint32  DataType = "uint32"
+	Int32   DataType = "int32"
+	Float32 DataType = "float32"
+)
+
+var (
+	// ErrInvalidRegister indicates a malformed register definition.
+	ErrInvalidRegister = errors.New("invalid register definition")
+
+	// ErrReadOnly indicates a write attempt against a read-only register.
+	ErrReadOnly = errors.New("register is not writable")
+)
+
+// Register describes a single point polled from a device. The decoded value
+// is scaled as value*Scale + Offset before publishing.
+type Register struct {
+	Name     string       `json:"name"`
+	Kind     RegisterKind `json:"kind"`
+	Address  uint16       `json:"address"`
+	DataType DataType     `json:"data_type"`
+	Scale    float64      `json:"scale,omitempty"`
+	Offset   float64      `json:"offset,omitempty"`
+	Unit     string       `json:"unit,omitempty"`
+	Writable bool         `json:"writable,omitempty"`
+}
+
+// Quantity returns the number of 16-bit words the register occupies.
+func (r Register) Quantity() uint16 {
+	switch r.DataType {
+	case Uint32, Int32, Float32:
+		return 2
+	default:
+		return 1
+	}
+}
+
+// Decode converts raw register words into a typed, scaled value.
+func (r Register) Decode(words []uint16) (float64, error) {
+	if len(words) < int(r.Quantity()) {
+		return 0, ErrInvalidRegister
+	}
+	var v float64
+	switch r.DataType {
+	case Bool:
+		if words[0] != 0 {
+			v = 1
+		}
+	case Uint16:
+		v = float64(words[0])
+	case Int16:
+		v = float64(int16(words[0]))
+	case Uint32:
+		v = float64(uint32(words[0])<<16 | uint32(words[1]))
+	case Int32:
+		v = float64(int32(uint32(words[0])<<16 | uint32(words[1])))
+	case Float32:
+		b := make([]byte, 4)
+		binary.BigEndian.PutUint16(b, words[0])
+		binary.BigEndian.PutUint16(b[2:], words[1])
+		v = float64(math.Float32frombits(binary.BigEndian.Uint32(b)))
+	default:
+		return 0, ErrInvalidRegister
+	}
+	scale := r.Scale
+	if scale == 0 {
+		scale = 1
+	}
+	return v*scale + r.Offset, nil
+}
+
+// Encode converts a scaled value back into raw register words for write-back.
+func (r Register) Encode(value float64) ([]uint16, error) {
+	if !r.Writable {
+		return nil, ErrReadOnly
+	}
+	scale := r.Scale
+	if scale == 0 {
+		scale = 1
+	}
+	raw := (value - r.Offset) / scale
+	switch r.DataType {
+	case Bool:
+		if raw != 0 {
+			return []uint16{0xFF00}, nil
+		}
+		return []uint16{0}, nil
+	case Uint16, Int16:
+		return []uint16{uint16(int64(raw))}, nil
+	case Uint32, Int32:
+		u := uint32(int64(raw))
+		return []uint16{uint16(u >> 16), uint16(u)}, nil
+	case Float32:
+		u := math.Float32bits(float32(raw))
+		return []uint16{uint16(u >> 16), uint16(u)}, nil
+	default:
+		return nil, ErrInvalidRegister
+	}
+}
+
+// RegisterMap binds a set of registers on a single device to a client and a
+// channel. The gateway polls the device every Interval and publishes readings
+// to the channel on behalf of the client.
+type RegisterMap struct {
+	ID        string        `json:"id"`
+	DomainID  string        `json:"domain_id"`
+	ClientID  string        `json:"client_id"`
+	ChannelID string        `json:"channel_id"`
+	Protocol  Protocol      `json:"protocol"`
+	Address   string        `json:"address"`
+	UnitID    uint8         `json:"unit_id"`
+	Interval  time.Duration `json:"interval"`
+	Registers []Register    `json:"registers"`
+	CreatedAt time.Time     `json:"created_at"`
+	UpdatedAt time.Time     `json:"updated_at,omitempty"`
+}
+
+// Validate checks that the register map is complete enough to be polled.
+func (rm RegisterMap) Validate() error {
+	if rm.ClientID == "" || rm.ChannelID == "" || rm.Address == "" {
+		return ErrInvalidRegister
+	}
+	if rm.Protocol != ProtocolTCP && rm.Protocol != ProtocolRTU {
+		return ErrInvalidRegister
+	}
+	if rm.Interval <= 0 || len(rm.Registers) == 0 {
+		return ErrInvalidRegister
+	}
+	for _, reg := range rm.Registers {
+		if reg.Name == "" {
+			return ErrInvalidRegister
+		}
+		if _, err := reg.Decode(make([]uint16, reg.Quantity())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PageMetadata contains page metadata that helps navigation.
+type PageMetadata struct {
+	Total    uint64 `json:"total"`
+	Offset   uint64 `json:"offset"`
+	Limit    uint64 `json:"limit"`
+	ClientID string `json:"client_id,omitempty"`
+}
+
+// RegisterMapsPage contains a page of register maps.
+type RegisterMapsPage struct {
+	PageMetadata
+	RegisterMaps []RegisterMap `json:"register_maps"`
+}
+
+// Repository specifies register map persistence API.
+//
+//go:generate mockery --name Repository --output=./mocks --filename repository.go --quiet --note "Copyright (c) Abstract Machines"
+type Repository interface {
+	// Save persists the register map.
+	Save(ctx context.Context, rm RegisterMap) (RegisterMap, error)
+
+	// RetrieveByID retrieves the register map having the provided identifier.
+	RetrieveByID(ctx context.Context, id string) (RegisterMap, error)
+
+	// RetrieveAll retrieves a page of register maps.
+	RetrieveAll(ctx context.Context, pm PageMetadata) (RegisterMapsPage, error)
+
+	// Update updates the register map.
+	Update(ctx context.Context, rm RegisterMap) (RegisterMap, error)
+
+	// Remove removes the register map having the provided identifier.
+	Remove(ctx context.Context, id string) error
+}
+
+// Reader reads raw register words from a Modbus device.
+type Reader interface {
+	// Read reads quantity words of the given kind starting at address.
+	Read(ctx context.Context, unitID uint8, kind RegisterKind, address, quantity uint16) ([]uint16, error)
+
+	// Write writes raw words of the given kind starting at address.
+	Write(ctx context.Context, unitID uint8, kind RegisterKind, address uint16, words []uint16) error
+
+	// Close closes the connection to the device.
+	Close() error
+}
+
+// Dialer opens a Reader for the given protocol and device address.
+type Dialer func(protocol Protocol, address string) (Reader, error)
+
+// Service specifies the Modbus gateway API.
+//
+//go:generate mockery --name Service --output=./mocks --filename service.go --quiet --note "Copyright (c) Abstract Machines"
+type Service interface {
+	// CreateRegisterMap creates a register map and starts polling it.
+	CreateRegisterMap(ctx context.Context, session smqauthn.Session, rm RegisterMap) (RegisterMap, error)
+
+	// ViewRegisterMap retrieves the register map having the provided identifier.
+	ViewRegisterMap(ctx context.Context, session smqauthn.Session, id string) (RegisterMap, error)
+
+	// ListRegisterMaps retrieves a page of register maps.
+	ListRegisterMaps(ctx context.Context, session smqauthn.Session, pm PageMetadata) (RegisterMapsPage, error)
+
+	// UpdateRegisterMap updates the register map and restarts its poller.
+	UpdateRegisterMap(ctx context.Context, session smqauthn.Session, rm RegisterMap) (RegisterMap, error)
+
+	// RemoveRegisterMap removes the register map and stops its poller.
+	RemoveRegisterMap(ctx context.Context, session smqauthn.Session, id string) error
+
+	// WriteRegister writes a command value to a writable register.
+	WriteRegister(ctx context.Context, session smqauthn.Session, id, register string, value float64) error
+}
@@ -0,0 +1,95 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package modbus_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/absmach/supermq/modbus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegisterDecode(t *testing.T) {
+	tests := []struct {
+		name     string
+		register modbus.Register
+		words    []uint16
+		expected float64
+		err      error
+	}{
+		{"bool set", modbus.Register{DataType: modbus.Bool}, []uint16{1}, 1, nil},
+		{"uint16", modbus.Register{DataType: modbus.Uint16}, []uint16{1000}, 1000, nil},
+		{"int16 negative", modbus.Register{DataType: modbus.Int16}, []uint16{0xFFFF}, -1, nil},
+		{"uint32", modbus.Register{DataType: modbus.Uint32}, []uint16{1, 0}, 65536, nil},
+		{"int32 negative", modbus.Register{DataType: modbus.Int32}, []uint16{0xFFFF, 0xFFFF}, -1, nil},
+		{"float32", modbus.Register{DataType: modbus.Float32}, []uint16{0x3F80, 0}, 1, nil},
+		{"scaled", modbus.Register{DataType: modbus.Uint16, Scale: 0.1, Offset: -40}, []uint16{650}, 25, nil},
+		{"short words", modbus.Register{DataType: modbus.Uint32}, []uint16{1}, 0, modbus.ErrInvalidRegister},
+		{"unknown type", modbus.Register{DataType: "unknown"}, []uint16{1}, 0, modbus.ErrInvalidRegister},
+	}
+
+	for _, tt := range tests {
+		got, err := tt.register.Decode(tt.words)
+		assert.Equal(t, tt.err, err, "%s: Decode() error = %v, expected %v", tt.name, err, tt.err)
+		assert.InDelta(t, tt.expected, got, 1e-9, "%s: Decode() = %v, expected %v", tt.name, got, tt.expected)
+	}
+}
+
+func TestRegisterEncode(t *testing.T) {
+	tests := []struct {
+		name     string
+		register modbus.Register
+		value    float64
+		expected []uint16
+		err      error
+	}{
+		{"bool on", modbus.Register{DataType: modbus.Bool, Writable: true}, 1, []uint16{0xFF00}, nil},
+		{"uint16", modbus.Register{DataType: modbus.Uint16, Writable: true}, 1000, []uint16{1000}, nil},
+		{"uint32", modbus.Register{DataType: modbus.Uint32, Writable: true}, 65536, []uint16{1, 0}, nil},
+		{"float32", modbus.Register{DataType: modbus.Float32, Writable: true}, 1, []uint16{0x3F80, 0}, nil},
+		{"scaled", modbus.Register{DataType: modbus.Uint16, Scale: 0.1, Offset: -40, Writable: true}, 25, []uint16{650}, nil},
+		{"read-only", modbus.Register{DataType: modbus.Uint16}, 1, nil, modbus.ErrReadOnly},
+		{"unknown type", modbus.Register{DataType: "unknown", Writable: true}, 1, nil, modbus.ErrInvalidRegister},
+	}
+
+	for _, tt := range tests {
+		got, err := tt.register.Encode(tt.value)
+		assert.Equal(t, tt.err, err, "%s: Encode() error = %v, expected %v", tt.name, err, tt.err)
+		assert.Equal(t, tt.expected, got, "%s: Encode() = %v, expected %v", tt.name, got, tt.expected)
+	}
+}
+
+func TestRegisterMapValidate(t *testing.T) {
+	valid := modbus.RegisterMap{
+		ClientID:  "client",
+		ChannelID: "channel",
+		Protocol:  modbus.ProtocolTCP,
+		Address:   "localhost:502",
+		Interval:  time.Second,
+		Registers: []modbus.Register{{Name: "temperature", Kind: modbus.HoldingRegister, DataType: modbus.Uint16}},
+	}
+
+	tests := []struct {
+		name   string
+		modify func(rm *modbus.RegisterMap)
+		err    error
+	}{
+		{"valid", func(rm *modbus.RegisterMap) {}, nil},
+		{"missing client", func(rm *modbus.RegisterMap) { rm.ClientID = "" }, modbus.ErrInvalidRegister},
+		{"missing channel", func(rm *modbus.RegisterMap) { rm.ChannelID = "" }, modbus.ErrInvalidRegister},
+		{"invalid protocol", func(rm *modbus.RegisterMap) { rm.Protocol = "serial" }, modbus.ErrInvalidRegister},
+		{"no interval", func(rm *modbus.RegisterMap) { rm.Interval = 0 }, modbus.ErrInvalidRegister},
+		{"no registers", func(rm *modbus.RegisterMap) { rm.Registers = nil }, modbus.ErrInvalidRegister},
+		{"unnamed register", func(rm *modbus.RegisterMap) { rm.Registers[0].Name = "" }, modbus.ErrInvalidRegister},
+	}
+
+	for _, tt := range tests {
+		rm := valid
+		rm.Registers = append([]modbus.Register{}, valid.Registers...)
+		tt.modify(&rm)
+		err := rm.Validate()
+		assert.Equal(t, tt.err, err, "%s: Validate() error = %v, expected %v", tt.name, err, tt.err)
+	}
+}
@@ -0,0 +1,212 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package modbus
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/absmach/supermq"
+	smqauthn "github.com/absmach/supermq/pkg/authn"
+	"github.com/absmach/supermq/pkg/errors"
+	svcerr "github.com/absmach/supermq/pkg/errors/service"
+	"github.com/absmach/supermq/pkg/messaging"
+)
+
+const protocol = "modbus"
+
+var (
+	errPoll  = errors.New("failed to poll device")
+	errWrite = errors.New("failed to write to device")
+)
+
+type reading struct {
+	Name  string  `json:"n"`
+	Value float64 `json:"v"`
+	Unit  string  `json:"u,omitempty"`
+	Time  int64   `json:"t"`
+}
+
+type service struct {
+	idProvider supermq.IDProvider
+	repo       Repository
+	publisher  messaging.Publisher
+	dial       Dialer
+	pollers    map[string]context.CancelFunc
+	mu         sync.Mutex
+}
+
+// NewService returns a new Modbus gateway service instance.
+func NewService(idp supermq.IDProvider, repo Repository, publisher messaging.Publisher, dial Dialer) Service {
+	return &service{
+		idProvider: idp,
+		repo:       repo,
+		publisher:  publisher,
+		dial:       dial,
+		pollers:    map[string]context.CancelFunc{},
+	}
+}
+
+func (svc *service) CreateRegisterMap(ctx context.Context, session smqauthn.Session, rm RegisterMap) (RegisterMap, error) {
+	if err := rm.Validate(); err != nil {
+		return RegisterMap{}, errors.Wrap(svcerr.ErrMalformedEntity, err)
+	}
+	id, err := svc.idProvider.ID()
+	if err != nil {
+		return RegisterMap{}, err
+	}
+	rm.ID = id
+	rm.DomainID = session.DomainID
+	rm.CreatedAt = time.Now()
+
+	saved, err := svc.repo.Save(ctx, rm)
+	if err != nil {
+		return RegisterMap{}, errors.Wrap(svcerr.ErrCreateEntity, err)
+	}
+	svc.startPoller(saved)
+
+	return saved, nil
+}
+
+func (svc *service) ViewRegisterMap(ctx context.Context, session smqauthn.Session, id string) (RegisterMap, error) {
+	rm, err := svc.repo.RetrieveByID(ctx, id)
+	if err != nil {
+		return RegisterMap{}, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+	return rm, nil
+}
+
+func (svc *service) ListRegisterMaps(ctx context.Context, session smqauthn.Session, pm PageMetadata) (RegisterMapsPage, error) {
+	page, err := svc.repo.RetrieveAll(ctx, pm)
+	if err != nil {
+		return RegisterMapsPage{}, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+	return page, nil
+}
+
+func (svc *service) UpdateRegisterMap(ctx context.Context, session smqauthn.Session, rm RegisterMap) (RegisterMap, error) {
+	if err := rm.Validate(); err != nil {
+		return RegisterMap{}, errors.Wrap(svcerr.ErrMalformedEntity, err)
+	}
+	rm.UpdatedAt = time.Now()
+
+	updated, err := svc.repo.Update(ctx, rm)
+	if err != nil {
+		return RegisterMap{}, errors.Wrap(svcerr.ErrUpdateEntity, err)
+	}
+	svc.stopPoller(updated.ID)
+	svc.startPoller(updated)
+
+	return updated, nil
+}
+
+func (svc *service) RemoveRegisterMap(ctx context.Context, session smqauthn.Session, id string) error {
+	if err := svc.repo.Remove(ctx, id); err != nil {
+		return errors.Wrap(svcerr.ErrRemoveEntity, err)
+	}
+	svc.stopPoller(id)
+
+	return nil
+}
+
+func (svc *service) WriteRegister(ctx context.Context, session smqauthn.Session, id, register string, value float64) error {
+	rm, err := svc.repo.RetrieveByID(ctx, id)
+	if err != nil {
+		return errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+	for _, reg := range rm.Registers {
+		if reg.Name != register {
+			continue
+		}
+		words, err := reg.Encode(value)
+		if err != nil {
+			return errors.Wrap(svcerr.ErrMalformedEntity, err)
+		}
+		conn, err := svc.dial(rm.Protocol, rm.Address)
+		if err != nil {
+			return errors.Wrap(errWrite, err)
+		}
+		defer conn.Close()
+		if err := conn.Write(ctx, rm.UnitID, reg.Kind, reg.Address, words); err != nil {
+			return errors.Wrap(errWrite, err)
+		}
+		return nil
+	}
+
+	return errors.Wrap(svcerr.ErrNotFound, ErrInvalidRegister)
+}
+
+func (svc *service) startPoller(rm RegisterMap) {
+	ctx, cancel := context.WithCancel(context.Background())
+	svc.mu.Lock()
+	svc.pollers[rm.ID] = cancel
+	svc.mu.Unlock()
+
+	go func() {
+		ticker := time.NewTicker(rm.Interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				// Polling failures are transient by nature, so the poller
+				// keeps running and retries on the next tick.
+				_ = svc.poll(ctx, rm)
+			}
+		}
+	}()
+}
+
+func (svc *service) stopPoller(id string) {
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+	if cancel, ok := svc.pollers[id]; ok {
+		cancel()
+		delete(svc.pollers, id)
+	}
+}
+
+func (svc *service) poll(ctx context.Context, rm RegisterMap) error {
+	conn, err := svc.dial(rm.Protocol, rm.Address)
+	if err != nil {
+		return errors.Wrap(errPoll, err)
+	}
+	defer conn.Close()
+
+	readings := make([]reading, 0, len(rm.Registers))
+	now := time.Now()
+	for _, reg := range rm.Registers {
+		words, err := conn.Read(ctx, rm.UnitID, reg.Kind, reg.Address, reg.Quantity())
+		if err != nil {
+			return errors.Wrap(errPoll, err)
+		}
+		value, err := reg.Decode(words)
+		if err != nil {
+			return errors.Wrap(errPoll, err)
+		}
+		readings = append(readings, reading{
+			Name:  reg.Name,
+			Value: value,
+			Unit:  reg.Unit,
+			Time:  now.Unix(),
+		})
+	}
+
+	payload, err := json.Marshal(readings)
+	if err != nil {
+		return errors.Wrap(errPoll, err)
+	}
+	msg := messaging.Message{
+		Protocol:  protocol,
+		Channel:   rm.ChannelID,
+		Publisher: rm.ClientID,
+		Payload:   payload,
+		Created:   now.UnixNano(),
+	}
+
+	return svc.publisher.Publish(ctx, msg.Channel, &msg)
+}
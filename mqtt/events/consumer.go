@@ -0,0 +1,64 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package events
+
+import (
+	"context"
+
+	"github.com/absmach/supermq/pkg/events"
+	"github.com/absmach/supermq/pkg/events/store"
+)
+
+const (
+	clientDisable = "client.disable"
+	clientRemove  = "client.remove"
+)
+
+// Disconnector terminates the live sessions of a client.
+type Disconnector interface {
+	DisconnectClient(ctx context.Context, clientID string) error
+}
+
+// Start method starts consuming messages received from Event store.
+func Start(ctx context.Context, consumer string, sub events.Subscriber, disconnector Disconnector) error {
+	subCfg := events.SubscriberConfig{
+		Consumer: consumer,
+		Stream:   store.StreamAllEvents,
+		Handler:  Handle(disconnector),
+	}
+
+	return sub.Subscribe(ctx, subCfg)
+}
+
+// Handle returns an event handler terminating live sessions of disabled
+// and removed clients. Events of other operations are ignored.
+func Handle(disconnector Disconnector) handleFunc {
+	return func(ctx context.Context, event events.Event) error {
+		data, err := event.Encode()
+		if err != nil {
+			return err
+		}
+
+		operation, _ := data["operation"].(string)
+		if operation != clientDisable && operation != clientRemove {
+			return nil
+		}
+		id, _ := data["id"].(string)
+		if id == "" {
+			return nil
+		}
+
+		return disconnector.DisconnectClient(ctx, id)
+	}
+}
+
+type handleFunc func(ctx context.Context, event events.Event) error
+
+func (h handleFunc) Handle(ctx context.Context, event events.Event) error {
+	return h(ctx, event)
+}
+
+func (h handleFunc) Cancel() error {
+	return nil
+}
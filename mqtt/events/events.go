@@ -17,6 +17,7 @@ func (me mqttEvent) Encode() (map[string]interface{}, error) {
 	return map[string]interface{}{
 		"client_id": me.clientID,
 		"operation": me.operation,
+		"adapter":   "mqtt",
 		"instance":  me.instance,
 	}, nil
 }
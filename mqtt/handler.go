@@ -10,6 +10,7 @@ import (
 	"net/url"
 	"regexp"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/absmach/mgate/pkg/session"
@@ -21,9 +22,21 @@ import (
 	svcerr "github.com/absmach/supermq/pkg/errors/service"
 	"github.com/absmach/supermq/pkg/messaging"
 	"github.com/absmach/supermq/pkg/policies"
+	"github.com/absmach/supermq/pkg/topics"
 )
 
-var _ session.Handler = (*handler)(nil)
+// Handler is the session handler of the MQTT adapter. Besides the
+// proxy hooks it can terminate the live sessions of a client.
+type Handler interface {
+	session.Handler
+
+	// DisconnectClient revokes the live sessions of the given client.
+	// Revoked sessions are terminated on their next packet; reconnects
+	// re-authenticate against the clients service.
+	DisconnectClient(ctx context.Context, clientID string) error
+}
+
+var _ Handler = (*handler)(nil)
 
 const protocol = "mqtt"
 
@@ -53,6 +66,8 @@ var (
 	ErrFailedParseSubtopic          = errors.New("failed to parse subtopic")
 	ErrFailedPublishConnectEvent    = errors.New("failed to publish connect event")
 	ErrFailedPublishToMsgBroker     = errors.New("failed to publish to supermq message broker")
+	ErrPayloadTooLarge              = errors.New("payload size exceeds the maximum allowed")
+	ErrSessionRevoked               = errors.New("client session has been revoked")
 )
 
 var (
@@ -62,24 +77,59 @@ var (
 
 // Event implements events.Event interface.
 type handler struct {
-	publisher messaging.Publisher
-	clients   grpcClientsV1.ClientsServiceClient
-	channels  grpcChannelsV1.ChannelsServiceClient
-	logger    *slog.Logger
-	es        events.EventStore
+	publisher  messaging.Publisher
+	clients    grpcClientsV1.ClientsServiceClient
+	channels   grpcChannelsV1.ChannelsServiceClient
+	logger     *slog.Logger
+	es         events.EventStore
+	topics     *topics.Cache
+	maxPayload uint64
+
+	// revoked holds the IDs of clients whose live sessions have been
+	// revoked, e.g. because the client was disabled.
+	mu      sync.Mutex
+	revoked map[string]struct{}
 }
 
-// NewHandler creates new Handler entity.
-func NewHandler(publisher messaging.Publisher, es events.EventStore, logger *slog.Logger, clients grpcClientsV1.ClientsServiceClient, channels grpcChannelsV1.ChannelsServiceClient) session.Handler {
+// NewHandler creates new Handler entity. A zero maxPayloadSize disables the
+// payload size limit.
+func NewHandler(publisher messaging.Publisher, es events.EventStore, logger *slog.Logger, clients grpcClientsV1.ClientsServiceClient, channels grpcChannelsV1.ChannelsServiceClient, maxPayloadSize uint64) Handler {
 	return &handler{
-		es:        es,
-		logger:    logger,
-		publisher: publisher,
-		clients:   clients,
-		channels:  channels,
+		es:         es,
+		logger:     logger,
+		publisher:  publisher,
+		clients:    clients,
+		channels:   channels,
+		topics:     topics.NewCache(),
+		maxPayload: maxPayloadSize,
+		revoked:    make(map[string]struct{}),
 	}
 }
 
+// DisconnectClient revokes the live sessions of the given client.
+func (h *handler) DisconnectClient(ctx context.Context, clientID string) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.revoked[clientID] = struct{}{}
+	return nil
+}
+
+// isRevoked reports whether the client's sessions have been revoked.
+func (h *handler) isRevoked(clientID string) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	_, ok := h.revoked[clientID]
+	return ok
+}
+
+// clearRevoked forgets the revocation, e.g. once the session ends or
+// the client re-authenticates after being re-enabled.
+func (h *handler) clearRevoked(clientID string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.revoked, clientID)
+}
+
 // AuthConnect is called on device connection,
 // prior forwarding to the MQTT broker.
 func (h *handler) AuthConnect(ctx context.Context) error {
@@ -105,8 +155,15 @@ func (h *handler) AuthConnect(ctx context.Context) error {
 	if s.Username != "" && res.GetId() != s.Username {
 		return errInvalidUserId
 	}
+	// Keep the resolved client ID on the session so the disconnect event can
+	// be attributed to the client without re-authenticating.
+	s.Username = res.GetId()
+
+	// A successful authentication means the client is enabled again, so
+	// any previous revocation no longer applies.
+	h.clearRevoked(res.GetId())
 
-	if err := h.es.Connect(ctx, pwd); err != nil {
+	if err := h.es.Connect(ctx, res.GetId()); err != nil {
 		h.logger.Error(errors.Wrap(ErrFailedPublishConnectEvent, err).Error())
 	}
 
@@ -119,12 +176,18 @@ func (h *handler) AuthPublish(ctx context.Context, topic *string, payload *[]byt
 	if topic == nil {
 		return ErrMissingTopicPub
 	}
+	if h.maxPayload > 0 && payload != nil && uint64(len(*payload)) > h.maxPayload {
+		return ErrPayloadTooLarge
+	}
 	s, ok := session.FromContext(ctx)
 	if !ok {
 		return ErrClientNotInitialized
 	}
+	if h.isRevoked(s.Username) {
+		return ErrSessionRevoked
+	}
 
-	return h.authAccess(ctx, string(s.Username), *topic, connections.Publish)
+	return h.authAccess(ctx, string(s.Username), string(s.Password), *topic, connections.Publish)
 }
 
 // AuthSubscribe is called on device subscribe,
@@ -137,9 +200,12 @@ func (h *handler) AuthSubscribe(ctx context.Context, topics *[]string) error {
 	if topics == nil || *topics == nil {
 		return ErrMissingTopicSub
 	}
+	if h.isRevoked(s.Username) {
+		return ErrSessionRevoked
+	}
 
 	for _, topic := range *topics {
-		if err := h.authAccess(ctx, string(s.Username), topic, connections.Subscribe); err != nil {
+		if err := h.authAccess(ctx, string(s.Username), string(s.Password), topic, connections.Subscribe); err != nil {
 			return err
 		}
 	}
@@ -222,14 +288,14 @@ func (h *handler) Disconnect(ctx context.Context) error {
 	if !ok {
 		return errors.Wrap(ErrFailedDisconnect, ErrClientNotInitialized)
 	}
-	h.logger.Error(fmt.Sprintf(LogInfoDisconnected, s.ID, s.Password))
-	if err := h.es.Disconnect(ctx, string(s.Password)); err != nil {
+	h.logger.Error(fmt.Sprintf(LogInfoDisconnected, s.ID, s.Username))
+	if err := h.es.Disconnect(ctx, s.Username); err != nil {
 		return errors.Wrap(ErrFailedPublishDisconnectEvent, err)
 	}
 	return nil
 }
 
-func (h *handler) authAccess(ctx context.Context, clientID, topic string, msgType connections.ConnType) error {
+func (h *handler) authAccess(ctx context.Context, clientID, clientKey, topic string, msgType connections.ConnType) error {
 	// Topics are in the format:
 	// channels/<channel_id>/messages/<subtopic>/.../ct/<content_type>
 	if !channelRegExp.MatchString(topic) {
@@ -242,6 +308,15 @@ func (h *handler) authAccess(ctx context.Context, clientID, topic string, msgTyp
 	}
 
 	chanID := channelParts[1]
+	subtopic := ""
+	if len(channelParts) > 2 {
+		subtopic = channelParts[2]
+	}
+
+	subtopic, err := parseSubtopic(subtopic)
+	if err != nil {
+		return errors.Wrap(ErrFailedParseSubtopic, err)
+	}
 
 	ar := &grpcChannelsV1.AuthzReq{
 		Type:       uint32(msgType),
@@ -257,6 +332,17 @@ func (h *handler) authAccess(ctx context.Context, clientID, topic string, msgTyp
 		return svcerr.ErrAuthorization
 	}
 
+	if patterns := res.GetSubtopicPatterns(); len(patterns) > 0 {
+		key := fmt.Sprintf("%s:%s:%d", clientKey, chanID, msgType)
+		matcher, err := h.topics.Matcher(key, patterns)
+		if err != nil {
+			return errors.Wrap(svcerr.ErrAuthorization, err)
+		}
+		if !matcher.Match(subtopic) {
+			return svcerr.ErrAuthorization
+		}
+	}
+
 	return nil
 }
 
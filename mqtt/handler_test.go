@@ -37,6 +37,7 @@ const (
 	clientID1             = "clientID1"
 	subtopic              = "testSubtopic"
 	invalidChannelIDTopic = "channels/**/messages"
+	maxPayloadSize        = 1024
 )
 
 var (
@@ -147,7 +148,7 @@ func TestAuthConnect(t *testing.T) {
 				password = string(tc.session.Password)
 			}
 			clientsCall := clients.On("Authenticate", mock.Anything, &grpcClientsV1.AuthnReq{ClientSecret: password}).Return(tc.authNRes, tc.authNErr)
-			svcCall := eventStore.On("Connect", mock.Anything, password).Return(tc.err)
+			svcCall := eventStore.On("Connect", mock.Anything, tc.authNRes.GetId()).Return(tc.err)
 			err := handler.AuthConnect(ctx)
 			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
 			svcCall.Unset()
@@ -206,6 +207,13 @@ func TestAuthPublish(t *testing.T) {
 			authZRes: &grpcChannelsV1.AuthzRes{Authorized: false},
 			authZErr: svcerr.ErrAuthorization,
 		},
+		{
+			desc:    "publish with payload exceeding the limit",
+			session: &sessionClient,
+			err:     mqtt.ErrPayloadTooLarge,
+			topic:   &topic,
+			payload: make([]byte, maxPayloadSize+1),
+		},
 	}
 
 	for _, tc := range cases {
@@ -514,12 +522,12 @@ func TestDisconnect(t *testing.T) {
 
 	for _, tc := range cases {
 		ctx := context.TODO()
-		password := ""
+		username := ""
 		if tc.session != nil {
 			ctx = session.NewContext(ctx, tc.session)
-			password = string(tc.session.Password)
+			username = tc.session.Username
 		}
-		svcCall := eventStore.On("Disconnect", mock.Anything, password).Return(tc.err)
+		svcCall := eventStore.On("Disconnect", mock.Anything, username).Return(tc.err)
 		err := handler.Disconnect(ctx)
 		assert.Contains(t, logBuffer.String(), tc.logMsg)
 		assert.Equal(t, tc.err, err)
@@ -527,7 +535,39 @@ func TestDisconnect(t *testing.T) {
 	}
 }
 
-func newHandler() session.Handler {
+func TestDisconnectClient(t *testing.T) {
+	handler := newHandler()
+	ctx := session.NewContext(context.TODO(), &sessionClient)
+
+	err := handler.DisconnectClient(context.TODO(), clientID)
+	assert.Nil(t, err, fmt.Sprintf("unexpected error %s\n", err))
+
+	// Revoked sessions are rejected on publish and subscribe.
+	err = handler.AuthPublish(ctx, &topic, &payload)
+	assert.True(t, errors.Contains(err, mqtt.ErrSessionRevoked), fmt.Sprintf("expected %s got %s\n", mqtt.ErrSessionRevoked, err))
+	err = handler.AuthSubscribe(ctx, &topics)
+	assert.True(t, errors.Contains(err, mqtt.ErrSessionRevoked), fmt.Sprintf("expected %s got %s\n", mqtt.ErrSessionRevoked, err))
+
+	// A successful re-authentication clears the revocation.
+	clientsCall := clients.On("Authenticate", mock.Anything, &grpcClientsV1.AuthnReq{ClientSecret: password}).Return(&grpcClientsV1.AuthnRes{Authenticated: true, Id: clientID}, nil)
+	svcCall := eventStore.On("Connect", mock.Anything, clientID).Return(nil)
+	err = handler.AuthConnect(ctx)
+	assert.Nil(t, err, fmt.Sprintf("unexpected error %s\n", err))
+	clientsCall.Unset()
+	svcCall.Unset()
+
+	channelsCall := channels.On("Authorize", mock.Anything, &grpcChannelsV1.AuthzReq{
+		ChannelId:  chanID,
+		ClientId:   clientID,
+		ClientType: policies.ClientType,
+		Type:       uint32(connections.Publish),
+	}).Return(&grpcChannelsV1.AuthzRes{Authorized: true}, nil)
+	err = handler.AuthPublish(ctx, &topic, &payload)
+	assert.Nil(t, err, fmt.Sprintf("unexpected error %s\n", err))
+	channelsCall.Unset()
+}
+
+func newHandler() mqtt.Handler {
 	logger, err := smqlog.New(&logBuffer, "debug")
 	if err != nil {
 		log.Fatalf("failed to create logger: %s", err)
@@ -535,5 +575,5 @@ func newHandler() session.Handler {
 	clients = new(climocks.ClientsServiceClient)
 	channels = new(chmocks.ChannelsServiceClient)
 	eventStore = new(mocks.EventStore)
-	return mqtt.NewHandler(mocks.NewPublisher(), eventStore, logger, clients, channels)
+	return mqtt.NewHandler(mocks.NewPublisher(), eventStore, logger, clients, channels, maxPayloadSize)
 }
@@ -12,18 +12,30 @@ import (
 	"github.com/absmach/supermq/pkg/authn"
 	"github.com/absmach/supermq/pkg/errors"
 	"github.com/absmach/supermq/pkg/grpcclient"
+	"github.com/go-kit/kit/metrics"
 	grpchealth "google.golang.org/grpc/health/grpc_health_v1"
 )
 
 const patPrefix = "pat_"
 
+// Option is an authentication client option.
+type Option func(grpcAuthV1.AuthServiceClient) grpcAuthV1.AuthServiceClient
+
+// WithMetrics instruments every RPC issued to the auth service with the given
+// request counter and latency metrics.
+func WithMetrics(counter metrics.Counter, latency metrics.Histogram) Option {
+	return func(client grpcAuthV1.AuthServiceClient) grpcAuthV1.AuthServiceClient {
+		return auth.NewMetricsClient(client, counter, latency)
+	}
+}
+
 type authentication struct {
 	authSvcClient grpcAuthV1.AuthServiceClient
 }
 
 var _ authn.Authentication = (*authentication)(nil)
 
-func NewAuthentication(ctx context.Context, cfg grpcclient.Config) (authn.Authentication, grpcclient.Handler, error) {
+func NewAuthentication(ctx context.Context, cfg grpcclient.Config, opts ...Option) (authn.Authentication, grpcclient.Handler, error) {
 	client, err := grpcclient.NewHandler(cfg)
 	if err != nil {
 		return nil, nil, err
@@ -37,6 +49,9 @@ func NewAuthentication(ctx context.Context, cfg grpcclient.Config) (authn.Authen
 		return nil, nil, grpcclient.ErrSvcNotServing
 	}
 	authSvcClient := auth.NewAuthClient(client.Connection(), cfg.Timeout)
+	for _, opt := range opts {
+		authSvcClient = opt(authSvcClient)
+	}
 	return authentication{authSvcClient}, client, nil
 }
 
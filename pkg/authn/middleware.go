@@ -0,0 +1,109 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package authn
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	apiutil "github.com/absmach/supermq/api/http/util"
+)
+
+type sessionKeyType string
+
+// SessionKey is the default context key under which Middleware stores the
+// authenticated session.
+const SessionKey = sessionKeyType("session")
+
+// ErrorEncoder writes an authentication error to the response.
+type ErrorEncoder func(ctx context.Context, err error, w http.ResponseWriter)
+
+type middlewareConfig struct {
+	contextKey     any
+	domainResolver func(*http.Request) string
+	encodeError    ErrorEncoder
+}
+
+// MiddlewareOption configures the authentication middleware.
+type MiddlewareOption func(*middlewareConfig)
+
+// WithContextKey stores the session under the given context key instead of
+// the default SessionKey.
+func WithContextKey(key any) MiddlewareOption {
+	return func(cfg *middlewareConfig) {
+		cfg.contextKey = key
+	}
+}
+
+// WithDomainResolver scopes the session to the domain returned by the
+// resolver, failing the request when the resolver returns an empty domain.
+func WithDomainResolver(resolver func(*http.Request) string) MiddlewareOption {
+	return func(cfg *middlewareConfig) {
+		cfg.domainResolver = resolver
+	}
+}
+
+// WithErrorEncoder sets the encoder used to write authentication errors.
+func WithErrorEncoder(enc ErrorEncoder) MiddlewareOption {
+	return func(cfg *middlewareConfig) {
+		cfg.encodeError = enc
+	}
+}
+
+// Middleware validates the bearer token once per request, builds a Session
+// and injects it into the request context, so that handlers and services can
+// read the session instead of identifying the token themselves.
+func Middleware(authn Authentication, opts ...MiddlewareOption) func(http.Handler) http.Handler {
+	cfg := middlewareConfig{
+		contextKey:  SessionKey,
+		encodeError: defaultErrorEncoder,
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token := apiutil.ExtractBearerToken(r)
+			if token == "" {
+				cfg.encodeError(r.Context(), apiutil.ErrBearerToken, w)
+				return
+			}
+
+			resp, err := authn.Authenticate(r.Context(), token)
+			if err != nil {
+				cfg.encodeError(r.Context(), err, w)
+				return
+			}
+
+			if cfg.domainResolver != nil {
+				domain := cfg.domainResolver(r)
+				if domain == "" {
+					cfg.encodeError(r.Context(), apiutil.ErrMissingDomainID, w)
+					return
+				}
+				resp.DomainID = domain
+				resp.DomainUserID = domain + "_" + resp.UserID
+			}
+
+			ctx := context.WithValue(r.Context(), cfg.contextKey, resp)
+
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// FromContext returns the session stored by Middleware under the default
+// context key.
+func FromContext(ctx context.Context) (Session, bool) {
+	session, ok := ctx.Value(SessionKey).(Session)
+	return session, ok
+}
+
+func defaultErrorEncoder(_ context.Context, err error, w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusUnauthorized)
+	_ = json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+}
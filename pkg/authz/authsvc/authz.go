@@ -15,9 +15,21 @@ import (
 	svcerr "github.com/absmach/supermq/pkg/errors/service"
 	"github.com/absmach/supermq/pkg/grpcclient"
 	"github.com/absmach/supermq/pkg/policies"
+	"github.com/go-kit/kit/metrics"
 	grpchealth "google.golang.org/grpc/health/grpc_health_v1"
 )
 
+// Option is an authorization client option.
+type Option func(grpcAuthV1.AuthServiceClient) grpcAuthV1.AuthServiceClient
+
+// WithMetrics instruments every RPC issued to the auth service with the given
+// request counter and latency metrics.
+func WithMetrics(counter metrics.Counter, latency metrics.Histogram) Option {
+	return func(client grpcAuthV1.AuthServiceClient) grpcAuthV1.AuthServiceClient {
+		return auth.NewMetricsClient(client, counter, latency)
+	}
+}
+
 type authorization struct {
 	authSvcClient grpcAuthV1.AuthServiceClient
 	domains       pkgDomians.Authorization
@@ -25,7 +37,7 @@ type authorization struct {
 
 var _ authz.Authorization = (*authorization)(nil)
 
-func NewAuthorization(ctx context.Context, cfg grpcclient.Config, domainsAuthz pkgDomians.Authorization) (authz.Authorization, grpcclient.Handler, error) {
+func NewAuthorization(ctx context.Context, cfg grpcclient.Config, domainsAuthz pkgDomians.Authorization, opts ...Option) (authz.Authorization, grpcclient.Handler, error) {
 	client, err := grpcclient.NewHandler(cfg)
 	if err != nil {
 		return nil, nil, err
@@ -39,6 +51,9 @@ func NewAuthorization(ctx context.Context, cfg grpcclient.Config, domainsAuthz p
 		return nil, nil, grpcclient.ErrSvcNotServing
 	}
 	authSvcClient := auth.NewAuthClient(client.Connection(), cfg.Timeout)
+	for _, opt := range opts {
+		authSvcClient = opt(authSvcClient)
+	}
 	return authorization{
 		authSvcClient: authSvcClient,
 		domains:       domainsAuthz,
@@ -0,0 +1,144 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package errors
+
+import (
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+)
+
+// Code is a stable machine-readable error code. Codes are part of the API
+// contract: transports include them in error bodies so that SDK and CLI
+// clients can branch on them without parsing error messages.
+type Code string
+
+const (
+	// CodeAuthentication indicates missing or invalid credentials.
+	CodeAuthentication Code = "authentication"
+
+	// CodeAuthorization indicates insufficient permissions.
+	CodeAuthorization Code = "authorization"
+
+	// CodeInvalidArgument indicates a malformed or invalid request.
+	CodeInvalidArgument Code = "invalid_argument"
+
+	// CodeNotFound indicates a non-existent entity.
+	CodeNotFound Code = "not_found"
+
+	// CodeConflict indicates a conflict with the current entity state.
+	CodeConflict Code = "conflict"
+
+	// CodeUnprocessableEntity indicates a well-formed request that could
+	// not be processed.
+	CodeUnprocessableEntity Code = "unprocessable_entity"
+
+	// CodeUnsupportedContentType indicates an unsupported request content type.
+	CodeUnsupportedContentType Code = "unsupported_content_type"
+
+	// CodePreconditionFailed indicates a failed request precondition,
+	// such as an entity version mismatch.
+	CodePreconditionFailed Code = "precondition_failed"
+
+	// CodeInternal indicates an unexpected internal error.
+	CodeInternal Code = "internal"
+)
+
+// coder is implemented by errors that carry a stable code.
+type coder interface {
+	Code() Code
+}
+
+// CodeOf returns the first code found in the error chain, or CodeInternal
+// if no error in the chain carries one.
+func CodeOf(err error) Code {
+	if code := findCode(err); code != "" {
+		return code
+	}
+	return CodeInternal
+}
+
+// findCode walks the error chain and returns the first code it finds, or
+// an empty code if no error in the chain carries one.
+func findCode(err error) Code {
+	for err != nil {
+		if c, ok := err.(coder); ok && c.Code() != "" {
+			return c.Code()
+		}
+		ce, ok := err.(Error)
+		if !ok || ce.Err() == nil {
+			break
+		}
+		err = ce.Err()
+	}
+	return ""
+}
+
+// HTTPStatus returns the HTTP status code corresponding to the code.
+func (c Code) HTTPStatus() int {
+	switch c {
+	case CodeAuthentication:
+		return http.StatusUnauthorized
+	case CodeAuthorization:
+		return http.StatusForbidden
+	case CodeInvalidArgument:
+		return http.StatusBadRequest
+	case CodeNotFound:
+		return http.StatusNotFound
+	case CodeConflict:
+		return http.StatusConflict
+	case CodeUnprocessableEntity:
+		return http.StatusUnprocessableEntity
+	case CodeUnsupportedContentType:
+		return http.StatusUnsupportedMediaType
+	case CodePreconditionFailed:
+		return http.StatusPreconditionFailed
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// GRPCStatus returns the gRPC status code corresponding to the code.
+func (c Code) GRPCStatus() codes.Code {
+	switch c {
+	case CodeAuthentication:
+		return codes.Unauthenticated
+	case CodeAuthorization:
+		return codes.PermissionDenied
+	case CodeInvalidArgument, CodeUnsupportedContentType:
+		return codes.InvalidArgument
+	case CodeNotFound:
+		return codes.NotFound
+	case CodeConflict:
+		return codes.AlreadyExists
+	case CodeUnprocessableEntity, CodePreconditionFailed:
+		return codes.FailedPrecondition
+	default:
+		return codes.Internal
+	}
+}
+
+// CodeFromHTTPStatus returns the code corresponding to the HTTP status.
+func CodeFromHTTPStatus(status int) Code {
+	switch status {
+	case http.StatusUnauthorized:
+		return CodeAuthentication
+	case http.StatusForbidden:
+		return CodeAuthorization
+	case http.StatusBadRequest:
+		return CodeInvalidArgument
+	case http.StatusNotFound:
+		return CodeNotFound
+	case http.StatusConflict:
+		return CodeConflict
+	case http.StatusUnprocessableEntity:
+		return CodeUnprocessableEntity
+	case http.StatusUnsupportedMediaType:
+		return CodeUnsupportedContentType
+	case http.StatusPreconditionFailed:
+		return CodePreconditionFailed
+	default:
+		return CodeInternal
+	}
+}
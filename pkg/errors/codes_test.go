@@ -0,0 +1,78 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package errors_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/absmach/supermq/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func TestCodeOf(t *testing.T) {
+	cases := []struct {
+		desc string
+		err  error
+		code errors.Code
+	}{
+		{
+			desc: "coded error",
+			err:  errors.NewWithCode(errors.CodeNotFound, "entity not found"),
+			code: errors.CodeNotFound,
+		},
+		{
+			desc: "wrapped coded error",
+			err:  errors.Wrap(errors.NewWithCode(errors.CodeConflict, "entity already exists"), errors.New("db error")),
+			code: errors.CodeConflict,
+		},
+		{
+			desc: "coded error in the middle of the chain",
+			err:  errors.Wrap(errors.New("request failed"), errors.NewWithCode(errors.CodeAuthorization, "forbidden")),
+			code: errors.CodeAuthorization,
+		},
+		{
+			desc: "error without code",
+			err:  errors.New("plain error"),
+			code: errors.CodeInternal,
+		},
+		{
+			desc: "nil error",
+			err:  nil,
+			code: errors.CodeInternal,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			assert.Equal(t, tc.code, errors.CodeOf(tc.err))
+		})
+	}
+}
+
+func TestCodeMapping(t *testing.T) {
+	cases := []struct {
+		code errors.Code
+		http int
+		grpc codes.Code
+	}{
+		{errors.CodeAuthentication, http.StatusUnauthorized, codes.Unauthenticated},
+		{errors.CodeAuthorization, http.StatusForbidden, codes.PermissionDenied},
+		{errors.CodeInvalidArgument, http.StatusBadRequest, codes.InvalidArgument},
+		{errors.CodeNotFound, http.StatusNotFound, codes.NotFound},
+		{errors.CodeConflict, http.StatusConflict, codes.AlreadyExists},
+		{errors.CodeUnprocessableEntity, http.StatusUnprocessableEntity, codes.FailedPrecondition},
+		{errors.CodeUnsupportedContentType, http.StatusUnsupportedMediaType, codes.InvalidArgument},
+		{errors.CodeInternal, http.StatusInternalServerError, codes.Internal},
+	}
+
+	for _, tc := range cases {
+		t.Run(string(tc.code), func(t *testing.T) {
+			assert.Equal(t, tc.http, tc.code.HTTPStatus())
+			assert.Equal(t, tc.code, errors.CodeFromHTTPStatus(tc.code.HTTPStatus()))
+			assert.Equal(t, tc.grpc, tc.code.GRPCStatus())
+		})
+	}
+}
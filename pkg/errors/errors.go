@@ -26,8 +26,9 @@ var _ Error = (*customError)(nil)
 
 // customError represents a SuperMQ error.
 type customError struct {
-	msg string
-	err Error
+	msg  string
+	code Code
+	err  Error
 }
 
 // New returns an Error that formats as the given text.
@@ -38,6 +39,30 @@ func New(text string) Error {
 	}
 }
 
+// NewWithCode returns an Error that formats as the given text and carries
+// a stable machine-readable code.
+func NewWithCode(code Code, text string) Error {
+	return &customError{
+		msg:  text,
+		code: code,
+		err:  nil,
+	}
+}
+
+// WithCode returns an Error carrying the given code while preserving the
+// message and the wrapped error chain of err.
+func WithCode(code Code, err error) Error {
+	ce := cast(err)
+	if ce == nil {
+		return nil
+	}
+	return &customError{
+		msg:  ce.Msg(),
+		code: code,
+		err:  ce.Err(),
+	}
+}
+
 func (ce *customError) Error() string {
 	if ce == nil {
 		return ""
@@ -56,17 +81,29 @@ func (ce *customError) Err() Error {
 	return ce.err
 }
 
+// Code returns the stable machine-readable code of the error.
+func (ce *customError) Code() Code {
+	return ce.code
+}
+
 func (ce *customError) MarshalJSON() ([]byte, error) {
-	var val string
+	var val, details string
 	if e := ce.Err(); e != nil {
 		val = e.Msg()
+		if e.Err() != nil {
+			details = e.Err().Error()
+		}
 	}
 	return json.Marshal(&struct {
-		Err string `json:"error"`
-		Msg string `json:"message"`
+		Code    string `json:"code,omitempty"`
+		Err     string `json:"error"`
+		Msg     string `json:"message"`
+		Details string `json:"details,omitempty"`
 	}{
-		Err: val,
-		Msg: ce.Msg(),
+		Code:    string(ce.code),
+		Err:     val,
+		Msg:     ce.Msg(),
+		Details: details,
 	})
 }
 
@@ -91,10 +128,14 @@ func Wrap(wrapper, err error) error {
 		return wrapper
 	}
 	if w, ok := wrapper.(Error); ok {
-		return &customError{
+		ce := &customError{
 			msg: w.Msg(),
 			err: cast(err),
 		}
+		if c, ok := wrapper.(coder); ok {
+			ce.code = c.Code()
+		}
+		return ce
 	}
 	return &customError{
 		msg: wrapper.Error(),
@@ -48,14 +48,14 @@ func TestError(t *testing.T) {
 			desc:     "level 2 wrapped error",
 			err:      wrap(2),
 			msg:      message(2),
-			bytes:    []byte(`{"error":"1","message":"2"}`),
+			bytes:    []byte(`{"error":"1","message":"2","details":"0"}`),
 			bytesErr: nil,
 		},
 		{
 			desc:     fmt.Sprintf("level %d wrapped error", level),
 			err:      wrap(level),
 			msg:      message(level),
-			bytes:    []byte(`{"error":"9","message":"` + strconv.Itoa(level) + `"}`),
+			bytes:    []byte(`{"error":"9","message":"` + strconv.Itoa(level) + `","details":"8 : 7 : 6 : 5 : 4 : 3 : 2 : 1 : 0"}`),
 			bytesErr: nil,
 		},
 		{
@@ -16,6 +16,9 @@ var (
 	// ErrConflict indicates that entity already exists.
 	ErrConflict = errors.New("entity already exists")
 
+	// ErrVersionConflict indicates that the entity was modified concurrently.
+	ErrVersionConflict = errors.NewWithCode(errors.CodePreconditionFailed, "entity version mismatch")
+
 	// ErrCreateEntity indicates error in creating entity or entities.
 	ErrCreateEntity = errors.New("failed to create entity in the db")
 
@@ -11,8 +11,10 @@ import (
 )
 
 type errorRes struct {
-	Err string `json:"error"`
-	Msg string `json:"message"`
+	Code    string `json:"code"`
+	Err     string `json:"error"`
+	Msg     string `json:"message"`
+	Details string `json:"details"`
 }
 
 // Failed to read response body.
@@ -22,6 +24,10 @@ var errRespBody = New("failed to read response body")
 type SDKError interface {
 	Error
 	StatusCode() int
+
+	// Code returns the stable machine-readable error code reported
+	// by the service, or an empty code if none was reported.
+	Code() Code
 }
 
 var _ SDKError = (*sdkError)(nil)
@@ -55,8 +61,9 @@ func NewSDKError(err error) SDKError {
 		return &sdkError{
 			statusCode: 0,
 			customError: &customError{
-				msg: e.Msg(),
-				err: cast(e.Err()),
+				msg:  e.Msg(),
+				code: findCode(e),
+				err:  stripCodes(e.Err()),
 			},
 		}
 	}
@@ -69,30 +76,52 @@ func NewSDKError(err error) SDKError {
 	}
 }
 
-// NewSDKErrorWithStatus returns an SDK Error setting the status code.
+// NewSDKErrorWithStatus returns an SDK Error setting the status code. If
+// the error chain carries no code, the code is derived from the status.
 func NewSDKErrorWithStatus(err error, statusCode int) SDKError {
 	if err == nil {
 		return nil
 	}
 
+	code := findCode(err)
+	if code == "" && statusCode >= http.StatusBadRequest {
+		code = CodeFromHTTPStatus(statusCode)
+	}
+
 	if e, ok := err.(Error); ok {
 		return &sdkError{
 			statusCode: statusCode,
 			customError: &customError{
-				msg: e.Msg(),
-				err: cast(e.Err()),
+				msg:  e.Msg(),
+				code: code,
+				err:  stripCodes(e.Err()),
 			},
 		}
 	}
 	return &sdkError{
 		statusCode: statusCode,
 		customError: &customError{
-			msg: err.Error(),
-			err: nil,
+			msg:  err.Error(),
+			code: code,
+			err:  nil,
 		},
 	}
 }
 
+// stripCodes rebuilds an error chain without codes. The code of an SDK
+// error is kept on the top level only, since the codes of the wrapped
+// errors are not part of the transported error body.
+func stripCodes(err error) Error {
+	ce := cast(err)
+	if ce == nil {
+		return nil
+	}
+	return &customError{
+		msg: ce.Msg(),
+		err: stripCodes(ce.Err()),
+	}
+}
+
 // CheckError will check the HTTP response status code and matches it with the given status codes.
 // Since multiple status codes can be valid, we can pass multiple status codes to the function.
 // The function then checks for errors in the HTTP response.
@@ -115,9 +144,16 @@ func CheckError(resp *http.Response, expectedStatusCodes ...int) SDKError {
 	if err := json.Unmarshal(body, &content); err != nil {
 		return NewSDKErrorWithStatus(err, resp.StatusCode)
 	}
+
+	var ce error
 	if content.Err == "" {
-		return NewSDKErrorWithStatus(New(content.Msg), resp.StatusCode)
+		ce = New(content.Msg)
+	} else {
+		ce = Wrap(New(content.Msg), New(content.Err))
+	}
+	if content.Code != "" {
+		ce = WithCode(Code(content.Code), ce)
 	}
 
-	return NewSDKErrorWithStatus(Wrap(New(content.Msg), New(content.Err)), resp.StatusCode)
+	return NewSDKErrorWithStatus(ce, resp.StatusCode)
 }
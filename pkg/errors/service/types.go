@@ -8,49 +8,49 @@ import "github.com/absmach/supermq/pkg/errors"
 // Wrapper for Service errors.
 var (
 	// ErrAuthentication indicates failure occurred while authenticating the entity.
-	ErrAuthentication = errors.New("failed to perform authentication over the entity")
+	ErrAuthentication = errors.NewWithCode(errors.CodeAuthentication, "failed to perform authentication over the entity")
 
 	// ErrAuthorization indicates failure occurred while authorizing the entity.
-	ErrAuthorization = errors.New("failed to perform authorization over the entity")
+	ErrAuthorization = errors.NewWithCode(errors.CodeAuthorization, "failed to perform authorization over the entity")
 
 	// ErrDomainAuthorization indicates failure occurred while authorizing the domain.
-	ErrDomainAuthorization = errors.New("failed to perform authorization over the domain")
+	ErrDomainAuthorization = errors.NewWithCode(errors.CodeAuthorization, "failed to perform authorization over the domain")
 
 	// ErrLogin indicates wrong login credentials.
-	ErrLogin = errors.New("invalid user id or secret")
+	ErrLogin = errors.NewWithCode(errors.CodeAuthentication, "invalid user id or secret")
 
 	// ErrMalformedEntity indicates a malformed entity specification.
-	ErrMalformedEntity = errors.New("malformed entity specification")
+	ErrMalformedEntity = errors.NewWithCode(errors.CodeInvalidArgument, "malformed entity specification")
 
 	// ErrNotFound indicates a non-existent entity request.
-	ErrNotFound = errors.New("entity not found")
+	ErrNotFound = errors.NewWithCode(errors.CodeNotFound, "entity not found")
 
 	// ErrConflict indicates that entity already exists.
-	ErrConflict = errors.New("entity already exists")
+	ErrConflict = errors.NewWithCode(errors.CodeConflict, "entity already exists")
 
 	// ErrCreateEntity indicates error in creating entity or entities.
-	ErrCreateEntity = errors.New("failed to create entity")
+	ErrCreateEntity = errors.NewWithCode(errors.CodeUnprocessableEntity, "failed to create entity")
 
 	// ErrRemoveEntity indicates error in removing entity.
-	ErrRemoveEntity = errors.New("failed to remove entity")
+	ErrRemoveEntity = errors.NewWithCode(errors.CodeUnprocessableEntity, "failed to remove entity")
 
 	// ErrViewEntity indicates error in viewing entity or entities.
-	ErrViewEntity = errors.New("view entity failed")
+	ErrViewEntity = errors.NewWithCode(errors.CodeInvalidArgument, "view entity failed")
 
 	// ErrUpdateEntity indicates error in updating entity or entities.
-	ErrUpdateEntity = errors.New("update entity failed")
+	ErrUpdateEntity = errors.NewWithCode(errors.CodeUnprocessableEntity, "update entity failed")
 
 	// ErrInvalidStatus indicates an invalid status.
-	ErrInvalidStatus = errors.New("invalid status")
+	ErrInvalidStatus = errors.NewWithCode(errors.CodeInvalidArgument, "invalid status")
 
 	// ErrInvalidRole indicates that an invalid role.
-	ErrInvalidRole = errors.New("invalid client role")
+	ErrInvalidRole = errors.NewWithCode(errors.CodeInvalidArgument, "invalid client role")
 
 	// ErrInvalidPolicy indicates that an invalid policy.
-	ErrInvalidPolicy = errors.New("invalid policy")
+	ErrInvalidPolicy = errors.NewWithCode(errors.CodeInvalidArgument, "invalid policy")
 
 	// ErrEnableClient indicates error in enabling client.
-	ErrEnableClient = errors.New("failed to enable client")
+	ErrEnableClient = errors.NewWithCode(errors.CodeUnprocessableEntity, "failed to enable client")
 
 	// ErrDisableClient indicates error in disabling client.
 	ErrDisableClient = errors.New("failed to disable client")
@@ -62,13 +62,13 @@ var (
 	ErrDeletePolicies = errors.New("failed to remove policies")
 
 	// ErrSearch indicates error in searching clients.
-	ErrSearch = errors.New("failed to search clients")
+	ErrSearch = errors.NewWithCode(errors.CodeInvalidArgument, "failed to search clients")
 
 	// ErrInvitationAlreadyRejected indicates that the invitation is already rejected.
-	ErrInvitationAlreadyRejected = errors.New("invitation already rejected")
+	ErrInvitationAlreadyRejected = errors.NewWithCode(errors.CodeConflict, "invitation already rejected")
 
 	// ErrInvitationAlreadyAccepted indicates that the invitation is already accepted.
-	ErrInvitationAlreadyAccepted = errors.New("invitation already accepted")
+	ErrInvitationAlreadyAccepted = errors.NewWithCode(errors.CodeConflict, "invitation already accepted")
 
 	// ErrParentGroupAuthorization indicates failure occurred while authorizing the parent group.
 	ErrParentGroupAuthorization = errors.New("failed to authorize parent group")
@@ -77,14 +77,17 @@ var (
 	ErrMissingUsername = errors.New("missing usernames")
 
 	// ErrEnableUser indicates error in enabling user.
-	ErrEnableUser = errors.New("failed to enable user")
+	ErrEnableUser = errors.NewWithCode(errors.CodeUnprocessableEntity, "failed to enable user")
 
 	// ErrDisableUser indicates error in disabling user.
-	ErrDisableUser = errors.New("failed to disable user")
+	ErrDisableUser = errors.NewWithCode(errors.CodeUnprocessableEntity, "failed to disable user")
 
 	// ErrRollbackRepo indicates a failure to rollback repository.
 	ErrRollbackRepo = errors.New("failed to rollback repo")
 
 	// ErrUnauthorizedPAT indicates failure occurred while authorizing PAT.
-	ErrUnauthorizedPAT = errors.New("failed to authorize PAT")
+	ErrUnauthorizedPAT = errors.NewWithCode(errors.CodeAuthorization, "failed to authorize PAT")
+
+	// ErrQuotaExceeded indicates that the domain usage quota is exhausted.
+	ErrQuotaExceeded = errors.NewWithCode(errors.CodeUnprocessableEntity, "quota exceeded")
 )
@@ -0,0 +1,9 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package embedded runs a NATS server inside the service process so the
+// event store can use JetStream without an external broker. It is selected
+// with the "nats+embedded" URL scheme in the event store configuration and
+// complements the inproc store with at-least-once delivery and events that
+// survive restarts when a store directory is configured.
+package embedded
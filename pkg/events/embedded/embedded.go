@@ -0,0 +1,93 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package embedded
+
+import (
+	"errors"
+	"net/url"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/nats-io/nats-server/v2/server"
+)
+
+// Scheme is the URL scheme that selects the embedded NATS event store.
+const Scheme = "nats+embedded"
+
+const readyTimeout = 10 * time.Second
+
+// ErrServerStart is returned when the embedded server does not become ready
+// to accept connections in time.
+var ErrServerStart = errors.New("embedded NATS server failed to start")
+
+var (
+	serversMu sync.Mutex
+	servers   = make(map[string]string)
+)
+
+// ServerURL starts the embedded NATS server identified by the given URL on
+// first use and returns the client URL to connect to. Callers with the same
+// URL share one server. The URL host and port select the listen address,
+// with a random free port on localhost by default, and the URL path, when
+// present, is used as the JetStream store directory so events survive
+// restarts; without it events are stored under a temporary directory.
+//
+// For example:
+//
+//	nats+embedded://
+//	nats+embedded://127.0.0.1:4222/var/lib/supermq/events
+func ServerURL(rawURL string) (string, error) {
+	serversMu.Lock()
+	defer serversMu.Unlock()
+	if curl, ok := servers[rawURL]; ok {
+		return curl, nil
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", err
+	}
+
+	host := u.Hostname()
+	if host == "" {
+		host = "127.0.0.1"
+	}
+	port := server.RANDOM_PORT
+	if p := u.Port(); p != "" {
+		if port, err = strconv.Atoi(p); err != nil {
+			return "", err
+		}
+	}
+	storeDir := u.Path
+	if storeDir == "" || storeDir == "/" {
+		if storeDir, err = os.MkdirTemp("", "supermq-events"); err != nil {
+			return "", err
+		}
+	}
+
+	srv, err := server.NewServer(&server.Options{
+		ServerName: "supermq-embedded",
+		Host:       host,
+		Port:       port,
+		JetStream:  true,
+		StoreDir:   storeDir,
+		NoSigs:     true,
+		NoLog:      true,
+	})
+	if err != nil {
+		return "", err
+	}
+	srv.Start()
+	if !srv.ReadyForConnections(readyTimeout) {
+		srv.Shutdown()
+		return "", ErrServerStart
+	}
+
+	curl := srv.ClientURL()
+	servers[rawURL] = curl
+
+	return curl, nil
+}
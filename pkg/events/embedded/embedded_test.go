@@ -0,0 +1,87 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package embedded_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	smqlog "github.com/absmach/supermq/logger"
+	"github.com/absmach/supermq/pkg/events"
+	"github.com/absmach/supermq/pkg/events/embedded"
+	"github.com/absmach/supermq/pkg/events/nats"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	streamURL = "nats+embedded://"
+	stream    = "supermq.test"
+)
+
+type testEvent struct {
+	Data map[string]interface{}
+}
+
+func (te testEvent) Encode() (map[string]interface{}, error) {
+	return te.Data, nil
+}
+
+type handler struct {
+	events chan map[string]interface{}
+}
+
+func (h handler) Handle(_ context.Context, event events.Event) error {
+	data, err := event.Encode()
+	if err != nil {
+		return err
+	}
+	h.events <- data
+
+	return nil
+}
+
+func TestServerURL(t *testing.T) {
+	curl, err := embedded.ServerURL(streamURL)
+	require.Nil(t, err, "starting embedded server must not fail")
+	assert.NotEmpty(t, curl)
+
+	again, err := embedded.ServerURL(streamURL)
+	require.Nil(t, err, "reusing embedded server must not fail")
+	assert.Equal(t, curl, again, "same URL must resolve to the same server")
+}
+
+func TestPubsub(t *testing.T) {
+	curl, err := embedded.ServerURL(streamURL)
+	require.Nil(t, err, "starting embedded server must not fail")
+
+	publisher, err := nats.NewPublisher(context.Background(), curl, stream)
+	require.Nil(t, err, "creating publisher must not fail")
+
+	subscriber, err := nats.NewSubscriber(context.Background(), curl, smqlog.NewMock())
+	require.Nil(t, err, "creating subscriber must not fail")
+
+	h := handler{events: make(chan map[string]interface{}, 1)}
+	err = subscriber.Subscribe(context.Background(), events.SubscriberConfig{
+		Consumer: "test-consumer",
+		Stream:   "events.>",
+		Handler:  h,
+	})
+	require.Nil(t, err, "subscribing must not fail")
+
+	err = publisher.Publish(context.Background(), testEvent{Data: map[string]interface{}{"operation": "test.create"}})
+	require.Nil(t, err, "publishing must not fail")
+
+	select {
+	case data := <-h.events:
+		assert.Equal(t, "test.create", data["operation"])
+		assert.NotEmpty(t, data["occurred_at"])
+	case <-time.After(5 * time.Second):
+		t.Error("did not receive published event")
+	}
+
+	require.Nil(t, subscriber.Close())
+	require.Nil(t, publisher.Close())
+}
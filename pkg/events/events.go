@@ -6,6 +6,8 @@ package events
 import (
 	"context"
 	"time"
+
+	"github.com/absmach/supermq/pkg/messaging"
 )
 
 const (
@@ -43,6 +45,12 @@ type SubscriberConfig struct {
 	Consumer string
 	Stream   string
 	Handler  EventHandler
+
+	// DeliveryPolicy controls where a new consumer starts in the
+	// stream. The default delivers only events published after the
+	// consumer is created; DeliverAllPolicy replays the retained event
+	// history first, for consumers rebuilding in-memory state.
+	DeliveryPolicy messaging.DeliveryPolicy
 }
 
 // Subscriber specifies event subscription API.
@@ -0,0 +1,8 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package inproc provides an embedded, process-local event store. Publishers
+// and subscribers created with the same URL share one in-memory bus, so
+// services compiled into a single binary can exchange events without an
+// external broker. Events are not persisted and do not survive restarts.
+package inproc
@@ -0,0 +1,201 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package inproc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/absmach/supermq/pkg/events"
+)
+
+// Scheme is the URL scheme that selects the embedded event store.
+const Scheme = "inproc"
+
+const eventsPrefix = "events"
+
+var (
+	// ErrEmptyStream is returned when stream name is empty.
+	ErrEmptyStream = errors.New("stream name cannot be empty")
+
+	// ErrEmptyConsumer is returned when consumer name is empty.
+	ErrEmptyConsumer = errors.New("consumer name cannot be empty")
+
+	busesMu sync.Mutex
+	buses   = make(map[string]*bus)
+)
+
+// bus is a process-local event bus shared by all publishers and subscribers
+// created with the same URL.
+type bus struct {
+	mu   sync.RWMutex
+	subs []*subscription
+}
+
+type subscription struct {
+	consumer string
+	pattern  string
+	handler  events.EventHandler
+	logger   *slog.Logger
+}
+
+func getBus(url string) *bus {
+	busesMu.Lock()
+	defer busesMu.Unlock()
+	b, ok := buses[url]
+	if !ok {
+		b = &bus{}
+		buses[url] = b
+	}
+
+	return b
+}
+
+func (b *bus) publish(subject string, data map[string]interface{}) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	for _, sub := range b.subs {
+		if !matchSubject(sub.pattern, subject) {
+			continue
+		}
+		go func(sub *subscription) {
+			if err := sub.handler.Handle(context.Background(), event{Data: data}); err != nil {
+				sub.logger.Warn(fmt.Sprintf("failed to consume %s event: %s", subject, err))
+			}
+		}(sub)
+	}
+}
+
+func (b *bus) remove(consumer string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	kept := b.subs[:0]
+	for _, sub := range b.subs {
+		if sub.consumer != consumer {
+			kept = append(kept, sub)
+		}
+	}
+	b.subs = kept
+}
+
+type event struct {
+	Data map[string]interface{}
+}
+
+func (e event) Encode() (map[string]interface{}, error) {
+	return e.Data, nil
+}
+
+var _ events.Publisher = (*publisher)(nil)
+
+type publisher struct {
+	bus    *bus
+	stream string
+}
+
+// NewPublisher returns an event publisher backed by the process-local bus
+// identified by the given URL.
+func NewPublisher(_ context.Context, url, stream string) (events.Publisher, error) {
+	return &publisher{
+		bus:    getBus(url),
+		stream: stream,
+	}, nil
+}
+
+func (p *publisher) Publish(_ context.Context, ev events.Event) error {
+	values, err := ev.Encode()
+	if err != nil {
+		return err
+	}
+	values["occurred_at"] = time.Now().UnixNano()
+
+	p.bus.publish(fmt.Sprintf("%s.%s", eventsPrefix, p.stream), values)
+
+	return nil
+}
+
+func (p *publisher) Close() error {
+	return nil
+}
+
+var _ events.Subscriber = (*subscriber)(nil)
+
+type subscriber struct {
+	bus    *bus
+	logger *slog.Logger
+
+	mu        sync.Mutex
+	consumers []string
+}
+
+// NewSubscriber returns an event subscriber backed by the process-local bus
+// identified by the given URL.
+func NewSubscriber(_ context.Context, url string, logger *slog.Logger) (events.Subscriber, error) {
+	return &subscriber{
+		bus:    getBus(url),
+		logger: logger,
+	}, nil
+}
+
+func (s *subscriber) Subscribe(_ context.Context, cfg events.SubscriberConfig) error {
+	if cfg.Stream == "" {
+		return ErrEmptyStream
+	}
+	if cfg.Consumer == "" {
+		return ErrEmptyConsumer
+	}
+
+	s.bus.mu.Lock()
+	s.bus.subs = append(s.bus.subs, &subscription{
+		consumer: cfg.Consumer,
+		pattern:  cfg.Stream,
+		handler:  cfg.Handler,
+		logger:   s.logger,
+	})
+	s.bus.mu.Unlock()
+
+	s.mu.Lock()
+	s.consumers = append(s.consumers, cfg.Consumer)
+	s.mu.Unlock()
+
+	return nil
+}
+
+func (s *subscriber) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, consumer := range s.consumers {
+		s.bus.remove(consumer)
+	}
+	s.consumers = nil
+
+	return nil
+}
+
+// matchSubject reports whether the subject matches the NATS-style pattern,
+// where "*" matches a single token and ">" (or the RabbitMQ-style "#")
+// matches the rest of the subject.
+func matchSubject(pattern, subject string) bool {
+	pt := strings.Split(pattern, ".")
+	st := strings.Split(subject, ".")
+
+	for i, token := range pt {
+		if token == ">" || token == "#" {
+			return true
+		}
+		if i >= len(st) {
+			return false
+		}
+		if token != "*" && token != st[i] {
+			return false
+		}
+	}
+
+	return len(pt) == len(st)
+}
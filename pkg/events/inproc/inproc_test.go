@@ -0,0 +1,117 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package inproc_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	smqlog "github.com/absmach/supermq/logger"
+	"github.com/absmach/supermq/pkg/events"
+	"github.com/absmach/supermq/pkg/events/inproc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	streamURL = "inproc://test"
+	stream    = "supermq.test"
+)
+
+type testEvent struct {
+	Data map[string]interface{}
+}
+
+func (te testEvent) Encode() (map[string]interface{}, error) {
+	return te.Data, nil
+}
+
+type handler struct {
+	events chan map[string]interface{}
+}
+
+func (h handler) Handle(_ context.Context, event events.Event) error {
+	data, err := event.Encode()
+	if err != nil {
+		return err
+	}
+	h.events <- data
+
+	return nil
+}
+
+func TestPubsub(t *testing.T) {
+	publisher, err := inproc.NewPublisher(context.Background(), streamURL, stream)
+	require.Nil(t, err, "creating publisher must not fail")
+
+	subscriber, err := inproc.NewSubscriber(context.Background(), streamURL, smqlog.NewMock())
+	require.Nil(t, err, "creating subscriber must not fail")
+
+	h := handler{events: make(chan map[string]interface{}, 1)}
+	err = subscriber.Subscribe(context.Background(), events.SubscriberConfig{
+		Consumer: "test-consumer",
+		Stream:   "events.>",
+		Handler:  h,
+	})
+	require.Nil(t, err, "subscribing must not fail")
+
+	err = publisher.Publish(context.Background(), testEvent{Data: map[string]interface{}{"operation": "test.create"}})
+	require.Nil(t, err, "publishing must not fail")
+
+	select {
+	case data := <-h.events:
+		assert.Equal(t, "test.create", data["operation"])
+		assert.NotEmpty(t, data["occurred_at"])
+	case <-time.After(time.Second):
+		t.Error("did not receive published event")
+	}
+
+	require.Nil(t, subscriber.Close())
+	require.Nil(t, publisher.Close())
+}
+
+func TestSubscribeValidation(t *testing.T) {
+	subscriber, err := inproc.NewSubscriber(context.Background(), streamURL, smqlog.NewMock())
+	require.Nil(t, err, "creating subscriber must not fail")
+
+	cases := []struct {
+		desc     string
+		stream   string
+		consumer string
+		err      error
+	}{
+		{
+			desc:     "empty stream",
+			stream:   "",
+			consumer: "consumer",
+			err:      inproc.ErrEmptyStream,
+		},
+		{
+			desc:     "empty consumer",
+			stream:   "events.>",
+			consumer: "",
+			err:      inproc.ErrEmptyConsumer,
+		},
+		{
+			desc:     "valid subscription",
+			stream:   "events.>",
+			consumer: "consumer",
+			err:      nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := subscriber.Subscribe(context.Background(), events.SubscriberConfig{
+				Consumer: tc.consumer,
+				Stream:   tc.stream,
+				Handler:  handler{events: make(chan map[string]interface{}, 1)},
+			})
+			assert.Equal(t, tc.err, err)
+		})
+	}
+
+	require.Nil(t, subscriber.Close())
+}
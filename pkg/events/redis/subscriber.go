@@ -11,6 +11,7 @@ import (
 	"log/slog"
 
 	"github.com/absmach/supermq/pkg/events"
+	"github.com/absmach/supermq/pkg/messaging"
 	"github.com/redis/go-redis/v9"
 )
 
@@ -56,7 +57,17 @@ func (es *subEventStore) Subscribe(ctx context.Context, cfg events.SubscriberCon
 		return ErrEmptyConsumer
 	}
 
-	err := es.client.XGroupCreateMkStream(ctx, cfg.Stream, group, "$").Err()
+	start := "$"
+	consumerGroup := group
+	if cfg.DeliveryPolicy == messaging.DeliverAllPolicy {
+		// A replaying consumer gets a group of its own, so it reads the
+		// retained history from the beginning without moving the shared
+		// group.
+		consumerGroup = group + "." + cfg.Consumer
+		start = "0"
+	}
+
+	err := es.client.XGroupCreateMkStream(ctx, cfg.Stream, consumerGroup, start).Err()
 	if err != nil && err.Error() != exists {
 		return err
 	}
@@ -64,7 +75,7 @@ func (es *subEventStore) Subscribe(ctx context.Context, cfg events.SubscriberCon
 	go func() {
 		for {
 			msgs, err := es.client.XReadGroup(ctx, &redis.XReadGroupArgs{
-				Group:    group,
+				Group:    consumerGroup,
 				Consumer: cfg.Consumer,
 				Streams:  []string{cfg.Stream, ">"},
 				Count:    eventCount,
@@ -78,7 +89,7 @@ func (es *subEventStore) Subscribe(ctx context.Context, cfg events.SubscriberCon
 				continue
 			}
 
-			es.handle(ctx, cfg.Stream, msgs[0].Messages, cfg.Handler)
+			es.handle(ctx, cfg.Stream, consumerGroup, msgs[0].Messages, cfg.Handler)
 		}
 	}()
 
@@ -97,7 +108,7 @@ func (re redisEvent) Encode() (map[string]interface{}, error) {
 	return re.Data, nil
 }
 
-func (es *subEventStore) handle(ctx context.Context, stream string, msgs []redis.XMessage, h events.EventHandler) {
+func (es *subEventStore) handle(ctx context.Context, stream, group string, msgs []redis.XMessage, h events.EventHandler) {
 	for _, msg := range msgs {
 		var data map[string]interface{}
 		if err := json.Unmarshal([]byte(msg.Values["data"].(string)), &data); err != nil {
@@ -10,8 +10,11 @@ import (
 	"context"
 	"log"
 	"log/slog"
+	"strings"
 
 	"github.com/absmach/supermq/pkg/events"
+	"github.com/absmach/supermq/pkg/events/embedded"
+	"github.com/absmach/supermq/pkg/events/inproc"
 	"github.com/absmach/supermq/pkg/events/nats"
 )
 
@@ -23,6 +26,16 @@ func init() {
 }
 
 func NewPublisher(ctx context.Context, url, stream string) (events.Publisher, error) {
+	if strings.HasPrefix(url, inproc.Scheme+"://") {
+		return inproc.NewPublisher(ctx, url, stream)
+	}
+	if strings.HasPrefix(url, embedded.Scheme+"://") {
+		curl, err := embedded.ServerURL(url)
+		if err != nil {
+			return nil, err
+		}
+		return nats.NewPublisher(ctx, curl, stream)
+	}
 	pb, err := nats.NewPublisher(ctx, url, stream)
 	if err != nil {
 		return nil, err
@@ -32,6 +45,16 @@ func NewPublisher(ctx context.Context, url, stream string) (events.Publisher, er
 }
 
 func NewSubscriber(ctx context.Context, url string, logger *slog.Logger) (events.Subscriber, error) {
+	if strings.HasPrefix(url, inproc.Scheme+"://") {
+		return inproc.NewSubscriber(ctx, url, logger)
+	}
+	if strings.HasPrefix(url, embedded.Scheme+"://") {
+		curl, err := embedded.ServerURL(url)
+		if err != nil {
+			return nil, err
+		}
+		return nats.NewSubscriber(ctx, curl, logger)
+	}
 	pb, err := nats.NewSubscriber(ctx, url, logger)
 	if err != nil {
 		return nil, err
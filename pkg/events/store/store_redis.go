@@ -10,8 +10,12 @@ import (
 	"context"
 	"log"
 	"log/slog"
+	"strings"
 
 	"github.com/absmach/supermq/pkg/events"
+	"github.com/absmach/supermq/pkg/events/embedded"
+	"github.com/absmach/supermq/pkg/events/inproc"
+	"github.com/absmach/supermq/pkg/events/nats"
 	"github.com/absmach/supermq/pkg/events/redis"
 )
 
@@ -23,6 +27,16 @@ func init() {
 }
 
 func NewPublisher(ctx context.Context, url, stream string) (events.Publisher, error) {
+	if strings.HasPrefix(url, inproc.Scheme+"://") {
+		return inproc.NewPublisher(ctx, url, stream)
+	}
+	if strings.HasPrefix(url, embedded.Scheme+"://") {
+		curl, err := embedded.ServerURL(url)
+		if err != nil {
+			return nil, err
+		}
+		return nats.NewPublisher(ctx, curl, stream)
+	}
 	pb, err := redis.NewPublisher(ctx, url, stream, events.UnpublishedEventsCheckInterval)
 	if err != nil {
 		return nil, err
@@ -31,7 +45,17 @@ func NewPublisher(ctx context.Context, url, stream string) (events.Publisher, er
 	return pb, nil
 }
 
-func NewSubscriber(_ context.Context, url string, logger *slog.Logger) (events.Subscriber, error) {
+func NewSubscriber(ctx context.Context, url string, logger *slog.Logger) (events.Subscriber, error) {
+	if strings.HasPrefix(url, inproc.Scheme+"://") {
+		return inproc.NewSubscriber(ctx, url, logger)
+	}
+	if strings.HasPrefix(url, embedded.Scheme+"://") {
+		curl, err := embedded.ServerURL(url)
+		if err != nil {
+			return nil, err
+		}
+		return nats.NewSubscriber(ctx, curl, logger)
+	}
 	pb, err := redis.NewSubscriber(url, logger)
 	if err != nil {
 		return nil, err
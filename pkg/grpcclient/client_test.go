@@ -15,10 +15,12 @@ import (
 	tokengrpcapi "github.com/absmach/supermq/auth/api/grpc/token"
 	"github.com/absmach/supermq/auth/mocks"
 	clientsgrpcapi "github.com/absmach/supermq/clients/api/grpc"
+	cmocks "github.com/absmach/supermq/clients/mocks"
 	climocks "github.com/absmach/supermq/clients/private/mocks"
 	domainsgrpcapi "github.com/absmach/supermq/domains/api/grpc"
 	domainsMocks "github.com/absmach/supermq/domains/private/mocks"
 	smqlog "github.com/absmach/supermq/logger"
+	authnmocks "github.com/absmach/supermq/pkg/authn/mocks"
 	"github.com/absmach/supermq/pkg/errors"
 	"github.com/absmach/supermq/pkg/grpcclient"
 	"github.com/absmach/supermq/pkg/server"
@@ -83,7 +85,7 @@ func TestSetupClientsClient(t *testing.T) {
 	defer cancel()
 
 	registerClientsServiceServer := func(srv *grpc.Server) {
-		grpcClientsV1.RegisterClientsServiceServer(srv, clientsgrpcapi.NewServer(new(climocks.Service)))
+		grpcClientsV1.RegisterClientsServiceServer(srv, clientsgrpcapi.NewServer(new(climocks.Service), new(cmocks.Service), new(authnmocks.Authentication)))
 	}
 	gs := grpcserver.NewServer(ctx, cancel, "clients", server.Config{Port: "12345"}, registerClientsServiceServer, smqlog.NewMock())
 	go func() {
@@ -4,6 +4,7 @@
 package grpcclient
 
 import (
+	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"fmt"
@@ -11,10 +12,13 @@ import (
 	"time"
 
 	"github.com/absmach/supermq/pkg/errors"
+	"github.com/absmach/supermq/pkg/requestid"
+	"github.com/absmach/supermq/pkg/server"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
 )
 
 type security int
@@ -38,6 +42,11 @@ type Config struct {
 	ClientCert        string        `env:"CLIENT_CERT"      envDefault:""`
 	ClientKey         string        `env:"CLIENT_KEY"       envDefault:""`
 	ServerCAFile      string        `env:"SERVER_CA_CERTS"  envDefault:""`
+	RequireTLS        bool          `env:"REQUIRE_TLS"      envDefault:"false"`
+	Retries           int           `env:"RETRIES"          envDefault:"0"`
+	RetryBackoff      time.Duration `env:"RETRY_BACKOFF"    envDefault:"100ms"`
+	BreakerThreshold  int           `env:"BREAKER_THRESHOLD" envDefault:"0"`
+	BreakerTimeout    time.Duration `env:"BREAKER_TIMEOUT"  envDefault:"10s"`
 	BypassHealthCheck bool
 }
 
@@ -102,8 +111,16 @@ func (c *client) Secure() string {
 
 // connect creates new gRPC client and connect to gRPC server.
 func connect(cfg Config) (*grpc.ClientConn, security, error) {
+	interceptors := []grpc.UnaryClientInterceptor{requestIDInterceptor}
+	if cfg.BreakerThreshold > 0 {
+		interceptors = append(interceptors, breakerInterceptor(newBreaker(cfg.URL, cfg.BreakerThreshold, cfg.BreakerTimeout)))
+	}
+	if cfg.Retries > 0 {
+		interceptors = append(interceptors, retryInterceptor(cfg.Retries, cfg.RetryBackoff))
+	}
 	opts := []grpc.DialOption{
 		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+		grpc.WithChainUnaryInterceptor(interceptors...),
 	}
 	secure := withoutTLS
 	tc := insecure.NewCredentials()
@@ -127,17 +144,21 @@ func connect(cfg Config) (*grpc.ClientConn, security, error) {
 
 		// Loading mtls certificates file
 		if cfg.ClientCert != "" || cfg.ClientKey != "" {
-			certificate, err := tls.LoadX509KeyPair(cfg.ClientCert, cfg.ClientKey)
+			reloader, err := server.NewCertReloader(cfg.ClientCert, cfg.ClientKey)
 			if err != nil {
 				return nil, secure, fmt.Errorf("failed to client certificate and key %w", err)
 			}
-			tlsConfig.Certificates = []tls.Certificate{certificate}
+			tlsConfig.GetClientCertificate = reloader.GetClientCertificate
 			secure = withmTLS
 		}
 
 		tc = credentials.NewTLS(tlsConfig)
 	}
 
+	if cfg.RequireTLS && secure == withoutTLS {
+		return nil, secure, fmt.Errorf("connection to %s requires TLS, but no server CA is configured", cfg.URL)
+	}
+
 	opts = append(
 		opts, grpc.WithTransportCredentials(tc),
 		grpc.WithReadBufferSize(buffSize),
@@ -152,3 +173,12 @@ func connect(cfg Config) (*grpc.ClientConn, security, error) {
 
 	return conn, secure, nil
 }
+
+// requestIDInterceptor propagates the request correlation ID from the
+// context to the outgoing gRPC metadata.
+func requestIDInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if id := requestid.Get(ctx); id != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, requestid.MetadataKey, id)
+	}
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
@@ -0,0 +1,166 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package grpcclient
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/absmach/supermq/pkg/errors"
+	kitprometheus "github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	breakerClosed   = 0
+	breakerOpen     = 1
+	breakerHalfOpen = 2
+)
+
+// ErrBreakerOpen is returned when the circuit breaker rejects a call
+// without reaching the server.
+var ErrBreakerOpen = errors.New("grpc client circuit breaker is open")
+
+// breakerState exposes the current circuit breaker state per target, so
+// that dashboards can observe tripped internal connections.
+var breakerState = kitprometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+	Namespace: "grpc_client",
+	Subsystem: "breaker",
+	Name:      "state",
+	Help:      "Circuit breaker state per gRPC target: 0 closed, 1 open, 2 half-open.",
+}, []string{"url"})
+
+// idempotentPrefixes lists the RPC name prefixes that are safe to retry.
+var idempotentPrefixes = []string{
+	"Authenticate",
+	"Authorize",
+	"Retrieve",
+	"List",
+	"Verify",
+}
+
+// retryable reports whether a failed RPC may be retried: only idempotent
+// RPCs that failed because the server was unreachable.
+func retryable(method string, err error) bool {
+	if status.Code(err) != codes.Unavailable {
+		return false
+	}
+	name := method
+	if i := strings.LastIndex(method, "/"); i != -1 {
+		name = method[i+1:]
+	}
+	for _, prefix := range idempotentPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// retryInterceptor retries idempotent RPCs that failed with Unavailable up
+// to the given number of times, backing off linearly between attempts.
+func retryInterceptor(retries int, backoff time.Duration) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		for attempt := 1; attempt <= retries && retryable(method, err); attempt++ {
+			select {
+			case <-ctx.Done():
+				return err
+			case <-time.After(time.Duration(attempt) * backoff):
+			}
+			err = invoker(ctx, method, req, reply, cc, opts...)
+		}
+		return err
+	}
+}
+
+// breaker is a consecutive-failure circuit breaker. After threshold
+// consecutive failures it rejects calls for the configured timeout, then
+// lets a single probe call through and closes again when the probe
+// succeeds.
+type breaker struct {
+	url       string
+	threshold int
+	timeout   time.Duration
+
+	mu       sync.Mutex
+	state    int
+	failures int
+	openedAt time.Time
+}
+
+func newBreaker(url string, threshold int, timeout time.Duration) *breaker {
+	b := &breaker{
+		url:       url,
+		threshold: threshold,
+		timeout:   timeout,
+	}
+	b.setState(breakerClosed)
+	return b
+}
+
+func (b *breaker) setState(state int) {
+	b.state = state
+	breakerState.With("url", b.url).Set(float64(state))
+}
+
+// allow reports whether a call may proceed, moving the breaker to
+// half-open when the open timeout has elapsed so the call probes the
+// server health.
+func (b *breaker) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	switch b.state {
+	case breakerOpen:
+		if time.Since(b.openedAt) < b.timeout {
+			return false
+		}
+		b.setState(breakerHalfOpen)
+		return true
+	case breakerHalfOpen:
+		return false
+	default:
+		return true
+	}
+}
+
+func (b *breaker) record(err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	switch {
+	case err == nil:
+		b.failures = 0
+		b.setState(breakerClosed)
+	case status.Code(err) == codes.Unavailable || status.Code(err) == codes.DeadlineExceeded:
+		b.failures++
+		if b.state == breakerHalfOpen || b.failures >= b.threshold {
+			b.openedAt = time.Now()
+			b.setState(breakerOpen)
+		}
+	default:
+		// Application errors mean the server is healthy.
+		b.failures = 0
+		if b.state != breakerClosed {
+			b.setState(breakerClosed)
+		}
+	}
+}
+
+// breakerInterceptor rejects calls while the breaker is open, so that a
+// slow or unreachable server degrades gracefully instead of cascading.
+func breakerInterceptor(b *breaker) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if !b.allow() {
+			return ErrBreakerOpen
+		}
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		b.record(err)
+		return err
+	}
+}
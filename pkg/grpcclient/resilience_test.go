@@ -0,0 +1,133 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package grpcclient
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRetryInterceptor(t *testing.T) {
+	cases := []struct {
+		desc     string
+		method   string
+		errs     []error
+		retries  int
+		calls    int
+		expected error
+	}{
+		{
+			desc:     "idempotent RPC retried until success",
+			method:   "/auth.v1.AuthService/Authenticate",
+			errs:     []error{status.Error(codes.Unavailable, "down"), nil},
+			retries:  3,
+			calls:    2,
+			expected: nil,
+		},
+		{
+			desc:     "idempotent RPC exhausts retries",
+			method:   "/auth.v1.AuthService/Authorize",
+			errs:     []error{status.Error(codes.Unavailable, "down"), status.Error(codes.Unavailable, "down"), status.Error(codes.Unavailable, "down")},
+			retries:  2,
+			calls:    3,
+			expected: status.Error(codes.Unavailable, "down"),
+		},
+		{
+			desc:     "non-idempotent RPC is not retried",
+			method:   "/channels.v1.ChannelsService/RemoveClientConnections",
+			errs:     []error{status.Error(codes.Unavailable, "down")},
+			retries:  3,
+			calls:    1,
+			expected: status.Error(codes.Unavailable, "down"),
+		},
+		{
+			desc:     "application errors are not retried",
+			method:   "/auth.v1.AuthService/Authenticate",
+			errs:     []error{status.Error(codes.Unauthenticated, "bad token")},
+			retries:  3,
+			calls:    1,
+			expected: status.Error(codes.Unauthenticated, "bad token"),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			calls := 0
+			invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+				err := tc.errs[calls]
+				calls++
+				return err
+			}
+
+			err := retryInterceptor(tc.retries, time.Millisecond)(context.Background(), tc.method, nil, nil, nil, invoker)
+			assert.Equal(t, tc.expected, err)
+			assert.Equal(t, tc.calls, calls)
+		})
+	}
+}
+
+func TestBreaker(t *testing.T) {
+	b := newBreaker("localhost:7001", 2, 50*time.Millisecond)
+	unavailable := status.Error(codes.Unavailable, "down")
+
+	invoked := 0
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		invoked++
+		return unavailable
+	}
+	intercept := breakerInterceptor(b)
+
+	// Two consecutive failures trip the breaker.
+	for i := 0; i < 2; i++ {
+		err := intercept(context.Background(), "/auth.v1.AuthService/Authenticate", nil, nil, nil, invoker)
+		assert.Equal(t, unavailable, err)
+	}
+	assert.Equal(t, 2, invoked)
+
+	// The open breaker rejects calls without reaching the server.
+	err := intercept(context.Background(), "/auth.v1.AuthService/Authenticate", nil, nil, nil, invoker)
+	assert.Equal(t, ErrBreakerOpen, err)
+	assert.Equal(t, 2, invoked)
+
+	// After the timeout a single probe goes through and closes the breaker
+	// on success.
+	time.Sleep(60 * time.Millisecond)
+	ok := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		invoked++
+		return nil
+	}
+	err = intercept(context.Background(), "/auth.v1.AuthService/Authenticate", nil, nil, nil, ok)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, invoked)
+
+	err = intercept(context.Background(), "/auth.v1.AuthService/Authenticate", nil, nil, nil, ok)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, invoked)
+}
+
+func TestBreakerFailedProbe(t *testing.T) {
+	b := newBreaker("localhost:7002", 1, 20*time.Millisecond)
+	unavailable := status.Error(codes.Unavailable, "down")
+	failing := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		return unavailable
+	}
+	intercept := breakerInterceptor(b)
+
+	err := intercept(context.Background(), "/auth.v1.AuthService/Authenticate", nil, nil, nil, failing)
+	assert.Equal(t, unavailable, err)
+
+	// A failed probe reopens the breaker.
+	time.Sleep(30 * time.Millisecond)
+	err = intercept(context.Background(), "/auth.v1.AuthService/Authenticate", nil, nil, nil, failing)
+	assert.Equal(t, unavailable, err)
+
+	err = intercept(context.Background(), "/auth.v1.AuthService/Authenticate", nil, nil, nil, failing)
+	assert.Equal(t, ErrBreakerOpen, err)
+}
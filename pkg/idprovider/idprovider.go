@@ -0,0 +1,51 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package idprovider selects an identity provider strategy from service
+// configuration. UUID is random, ULID sorts by creation time and Snowflake
+// additionally encodes the generating node. An optional prefix makes the
+// entity type readable from the ID.
+package idprovider
+
+import (
+	"github.com/absmach/supermq"
+	"github.com/absmach/supermq/pkg/errors"
+	"github.com/absmach/supermq/pkg/prefixed"
+	"github.com/absmach/supermq/pkg/snowflake"
+	"github.com/absmach/supermq/pkg/ulid"
+	"github.com/absmach/supermq/pkg/uuid"
+)
+
+// ErrUnknownStrategy indicates an unsupported ID provider strategy.
+var ErrUnknownStrategy = errors.New("unknown id provider strategy")
+
+// Config holds the ID provider configuration of a service.
+type Config struct {
+	Strategy string `env:"STRATEGY" envDefault:"uuid"`
+	Prefix   string `env:"PREFIX"   envDefault:""`
+	Node     uint64 `env:"NODE"     envDefault:"0"`
+}
+
+// New instantiates the ID provider selected by the configuration.
+func New(cfg Config) (supermq.IDProvider, error) {
+	var idp supermq.IDProvider
+	switch cfg.Strategy {
+	case "uuid":
+		idp = uuid.New()
+	case "ulid":
+		idp = ulid.New()
+	case "snowflake":
+		var err error
+		if idp, err = snowflake.New(cfg.Node); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, ErrUnknownStrategy
+	}
+
+	if cfg.Prefix != "" {
+		idp = prefixed.New(cfg.Prefix, idp)
+	}
+
+	return idp, nil
+}
@@ -6,11 +6,15 @@ package jaeger
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/url"
 
+	"github.com/absmach/supermq"
+	"github.com/caarlos0/env/v11"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
 	"go.opentelemetry.io/otel/propagation"
 	"go.opentelemetry.io/otel/sdk/resource"
@@ -18,13 +22,28 @@ import (
 	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
 )
 
+const (
+	samplerRatio            = "traceidratio"
+	samplerParentBasedRatio = "parentbased_traceidratio"
+	samplerAlways           = "always_on"
+	samplerNever            = "always_off"
+)
+
 var (
 	errNoURL                     = errors.New("URL is empty")
 	errNoSvcName                 = errors.New("service Name is empty")
 	errUnsupportedTraceURLScheme = errors.New("unsupported tracing url scheme")
 )
 
-// NewProvider initializes Jaeger TraceProvider.
+type samplingConfig struct {
+	Sampler       string `env:"SMQ_JAEGER_SAMPLER" envDefault:"parentbased_traceidratio"`
+	DeploymentEnv string `env:"SMQ_DEPLOYMENT_ENV" envDefault:""`
+}
+
+// NewProvider initializes Jaeger TraceProvider. The exporter is selected
+// by the URL scheme: http and https select the OTLP/HTTP exporter, while
+// grpc and grpcs select the OTLP/gRPC one. The sampling strategy is
+// configured via the SMQ_JAEGER_SAMPLER environment variable.
 //
 //	tp, err := jaeger.NewProvider(ctx, "demo-service", "http://localhost:14268/api/traces", "2cb32911-6833-469c-9cad-4d3e93c528d8", "1.0")
 func NewProvider(ctx context.Context, svcName string, jaegerUrl url.URL, instanceID string, fraction float64) (*trace.TracerProvider, error) {
@@ -36,12 +55,21 @@ func NewProvider(ctx context.Context, svcName string, jaegerUrl url.URL, instanc
 		return nil, errNoSvcName
 	}
 
+	cfg := samplingConfig{}
+	if err := env.Parse(&cfg); err != nil {
+		return nil, err
+	}
+
 	var client otlptrace.Client
 	switch jaegerUrl.Scheme {
 	case "http":
 		client = otlptracehttp.NewClient(otlptracehttp.WithEndpoint(jaegerUrl.Host), otlptracehttp.WithURLPath(jaegerUrl.Path), otlptracehttp.WithInsecure())
 	case "https":
 		client = otlptracehttp.NewClient(otlptracehttp.WithEndpoint(jaegerUrl.Host), otlptracehttp.WithURLPath(jaegerUrl.Path))
+	case "grpc":
+		client = otlptracegrpc.NewClient(otlptracegrpc.WithEndpoint(jaegerUrl.Host), otlptracegrpc.WithInsecure())
+	case "grpcs":
+		client = otlptracegrpc.NewClient(otlptracegrpc.WithEndpoint(jaegerUrl.Host))
 	default:
 		return nil, errUnsupportedTraceURLScheme
 	}
@@ -51,10 +79,19 @@ func NewProvider(ctx context.Context, svcName string, jaegerUrl url.URL, instanc
 		return nil, err
 	}
 
+	sampler, err := newSampler(cfg.Sampler, fraction)
+	if err != nil {
+		return nil, err
+	}
+
 	attributes := []attribute.KeyValue{
 		semconv.ServiceNameKey.String(svcName),
+		semconv.ServiceVersionKey.String(supermq.Version),
 		attribute.String("host.id", instanceID),
 	}
+	if cfg.DeploymentEnv != "" {
+		attributes = append(attributes, semconv.DeploymentEnvironmentKey.String(cfg.DeploymentEnv))
+	}
 
 	hostAttr, err := resource.New(ctx, resource.WithHost(), resource.WithOSDescription(), resource.WithContainer())
 	if err != nil {
@@ -63,7 +100,7 @@ func NewProvider(ctx context.Context, svcName string, jaegerUrl url.URL, instanc
 	attributes = append(attributes, hostAttr.Attributes()...)
 
 	tp := trace.NewTracerProvider(
-		trace.WithSampler(trace.TraceIDRatioBased(fraction)),
+		trace.WithSampler(sampler),
 		trace.WithBatcher(exporter),
 		trace.WithResource(resource.NewWithAttributes(
 			semconv.SchemaURL,
@@ -75,3 +112,20 @@ func NewProvider(ctx context.Context, svcName string, jaegerUrl url.URL, instanc
 
 	return tp, nil
 }
+
+// newSampler returns the sampler selected by name, using the given
+// fraction for the ratio-based strategies.
+func newSampler(name string, fraction float64) (trace.Sampler, error) {
+	switch name {
+	case samplerRatio:
+		return trace.TraceIDRatioBased(fraction), nil
+	case samplerParentBasedRatio:
+		return trace.ParentBased(trace.TraceIDRatioBased(fraction)), nil
+	case samplerAlways:
+		return trace.AlwaysSample(), nil
+	case samplerNever:
+		return trace.NeverSample(), nil
+	default:
+		return nil, fmt.Errorf("unsupported sampler: %s", name)
+	}
+}
@@ -0,0 +1,191 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package labels provides Kubernetes-style entity labels together with the
+// selector syntax used to filter listings by them. Unlike metadata, which
+// holds arbitrary JSON, labels are flat string pairs with a restricted
+// syntax, which makes them queryable.
+package labels
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/absmach/supermq/pkg/errors"
+)
+
+const (
+	// maxKeyLen is the maximum length of a label key.
+	maxKeyLen = 63
+	// maxValueLen is the maximum length of a label value.
+	maxValueLen = 63
+)
+
+var (
+	// ErrInvalidKey indicates a label key that does not match the label syntax.
+	ErrInvalidKey = errors.New("invalid label key")
+	// ErrInvalidValue indicates a label value that does not match the label syntax.
+	ErrInvalidValue = errors.New("invalid label value")
+	// ErrMalformedSelector indicates a label selector that could not be parsed.
+	ErrMalformedSelector = errors.New("malformed label selector")
+
+	keyRegexp   = regexp.MustCompile(`^[A-Za-z0-9]([A-Za-z0-9._-]*[A-Za-z0-9])?$`)
+	valueRegexp = regexp.MustCompile(`^([A-Za-z0-9]([A-Za-z0-9._-]*[A-Za-z0-9])?)?$`)
+)
+
+// Labels is a set of key-value pairs attached to an entity.
+type Labels map[string]string
+
+// Validate checks that every key and value matches the label syntax:
+// alphanumerics optionally separated by dashes, underscores and dots, at
+// most 63 characters each. Values may be empty.
+func (l Labels) Validate() error {
+	for k, v := range l {
+		if len(k) > maxKeyLen || !keyRegexp.MatchString(k) {
+			return errors.Wrap(ErrInvalidKey, fmt.Errorf("%q", k))
+		}
+		if len(v) > maxValueLen || !valueRegexp.MatchString(v) {
+			return errors.Wrap(ErrInvalidValue, fmt.Errorf("%q", v))
+		}
+	}
+	return nil
+}
+
+// Operator is a comparison supported by label selector requirements.
+type Operator string
+
+const (
+	// OpEqual requires the label to exist with the given value.
+	OpEqual Operator = "="
+	// OpNotEqual requires the label to be absent or hold a different value.
+	OpNotEqual Operator = "!="
+	// OpIn requires the label to exist with one of the given values.
+	OpIn Operator = "in"
+	// OpNotIn requires the label to be absent or hold none of the given values.
+	OpNotIn Operator = "notin"
+)
+
+// Requirement is a single comparison of a label selector.
+type Requirement struct {
+	Key    string   `json:"key"`
+	Op     Operator `json:"op"`
+	Values []string `json:"values"`
+}
+
+// Selector is a conjunction of requirements. An entity matches the selector
+// if it matches every requirement.
+type Selector []Requirement
+
+// ParseSelector parses a comma-separated list of requirements, e.g.
+//
+//	env=prod,tier!=cache,region in (eu,us)
+//
+// Supported operators are =, ==, != and the set operators in and notin.
+func ParseSelector(s string) (Selector, error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, nil
+	}
+
+	var sel Selector
+	for _, part := range splitRequirements(s) {
+		req, err := parseRequirement(strings.TrimSpace(part))
+		if err != nil {
+			return nil, err
+		}
+		sel = append(sel, req)
+	}
+	return sel, nil
+}
+
+// Matches reports whether the given labels satisfy every requirement of the
+// selector. An empty selector matches everything.
+func (s Selector) Matches(l Labels) bool {
+	for _, req := range s {
+		if !req.matches(l) {
+			return false
+		}
+	}
+	return true
+}
+
+func (r Requirement) matches(l Labels) bool {
+	v, ok := l[r.Key]
+	switch r.Op {
+	case OpEqual, OpIn:
+		return ok && contains(r.Values, v)
+	case OpNotEqual, OpNotIn:
+		return !ok || !contains(r.Values, v)
+	default:
+		return false
+	}
+}
+
+// splitRequirements splits a selector on commas that are not enclosed in
+// parentheses, so that set values such as "in (eu,us)" stay together.
+func splitRequirements(s string) []string {
+	var parts []string
+	depth, start := 0, 0
+	for i, c := range s {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				parts = append(parts, s[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(parts, s[start:])
+}
+
+var (
+	setRegexp = regexp.MustCompile(`^(\S+)\s+(in|notin)\s+\(([^()]*)\)$`)
+	cmpRegexp = regexp.MustCompile(`^([^=!\s]+)\s*(==|=|!=)\s*(\S*)$`)
+)
+
+func parseRequirement(s string) (Requirement, error) {
+	if m := setRegexp.FindStringSubmatch(s); m != nil {
+		req := Requirement{Key: m[1], Op: Operator(m[2])}
+		for _, v := range strings.Split(m[3], ",") {
+			req.Values = append(req.Values, strings.TrimSpace(v))
+		}
+		return req, req.validate()
+	}
+	if m := cmpRegexp.FindStringSubmatch(s); m != nil {
+		op := Operator(m[2])
+		if op == "==" {
+			op = OpEqual
+		}
+		req := Requirement{Key: m[1], Op: op, Values: []string{m[3]}}
+		return req, req.validate()
+	}
+	return Requirement{}, errors.Wrap(ErrMalformedSelector, fmt.Errorf("%q", s))
+}
+
+func (r Requirement) validate() error {
+	if len(r.Key) > maxKeyLen || !keyRegexp.MatchString(r.Key) {
+		return errors.Wrap(ErrInvalidKey, fmt.Errorf("%q", r.Key))
+	}
+	if len(r.Values) == 0 {
+		return errors.Wrap(ErrMalformedSelector, fmt.Errorf("%q has no values", r.Key))
+	}
+	for _, v := range r.Values {
+		if len(v) > maxValueLen || !valueRegexp.MatchString(v) {
+			return errors.Wrap(ErrInvalidValue, fmt.Errorf("%q", v))
+		}
+	}
+	return nil
+}
+
+func contains(vals []string, v string) bool {
+	for _, val := range vals {
+		if val == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,206 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package labels_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/absmach/supermq/pkg/errors"
+	"github.com/absmach/supermq/pkg/labels"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+	cases := []struct {
+		desc   string
+		labels labels.Labels
+		err    error
+	}{
+		{
+			desc:   "valid labels",
+			labels: labels.Labels{"env": "prod", "app.kubernetes.io-name": "adapter_1"},
+			err:    nil,
+		},
+		{
+			desc:   "empty value",
+			labels: labels.Labels{"env": ""},
+			err:    nil,
+		},
+		{
+			desc:   "empty labels",
+			labels: labels.Labels{},
+			err:    nil,
+		},
+		{
+			desc:   "invalid key",
+			labels: labels.Labels{"env prod": "a"},
+			err:    labels.ErrInvalidKey,
+		},
+		{
+			desc:   "key with leading dash",
+			labels: labels.Labels{"-env": "a"},
+			err:    labels.ErrInvalidKey,
+		},
+		{
+			desc:   "invalid value",
+			labels: labels.Labels{"env": "pr od"},
+			err:    labels.ErrInvalidValue,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.labels.Validate()
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("expected error %v, got %v", tc.err, err))
+		})
+	}
+}
+
+func TestParseSelector(t *testing.T) {
+	cases := []struct {
+		desc     string
+		selector string
+		expected labels.Selector
+		err      error
+	}{
+		{
+			desc:     "empty selector",
+			selector: "",
+			expected: nil,
+			err:      nil,
+		},
+		{
+			desc:     "equality",
+			selector: "env=prod",
+			expected: labels.Selector{{Key: "env", Op: labels.OpEqual, Values: []string{"prod"}}},
+			err:      nil,
+		},
+		{
+			desc:     "double equality",
+			selector: "env==prod",
+			expected: labels.Selector{{Key: "env", Op: labels.OpEqual, Values: []string{"prod"}}},
+			err:      nil,
+		},
+		{
+			desc:     "inequality",
+			selector: "tier!=cache",
+			expected: labels.Selector{{Key: "tier", Op: labels.OpNotEqual, Values: []string{"cache"}}},
+			err:      nil,
+		},
+		{
+			desc:     "set membership",
+			selector: "region in (eu, us)",
+			expected: labels.Selector{{Key: "region", Op: labels.OpIn, Values: []string{"eu", "us"}}},
+			err:      nil,
+		},
+		{
+			desc:     "combined requirements",
+			selector: "env=prod,region in (eu,us),tier notin (cache)",
+			expected: labels.Selector{
+				{Key: "env", Op: labels.OpEqual, Values: []string{"prod"}},
+				{Key: "region", Op: labels.OpIn, Values: []string{"eu", "us"}},
+				{Key: "tier", Op: labels.OpNotIn, Values: []string{"cache"}},
+			},
+			err: nil,
+		},
+		{
+			desc:     "malformed requirement",
+			selector: "env>prod",
+			expected: nil,
+			err:      labels.ErrMalformedSelector,
+		},
+		{
+			desc:     "invalid key",
+			selector: "en v=prod",
+			expected: nil,
+			err:      labels.ErrMalformedSelector,
+		},
+		{
+			desc:     "invalid value",
+			selector: "region in (e u)",
+			expected: nil,
+			err:      labels.ErrInvalidValue,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			sel, err := labels.ParseSelector(tc.selector)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("expected error %v, got %v", tc.err, err))
+			if tc.err == nil {
+				assert.Equal(t, tc.expected, sel)
+			}
+		})
+	}
+}
+
+func TestSelectorMatches(t *testing.T) {
+	l := labels.Labels{"env": "prod", "region": "eu"}
+
+	cases := []struct {
+		desc     string
+		selector string
+		matches  bool
+	}{
+		{
+			desc:     "empty selector matches",
+			selector: "",
+			matches:  true,
+		},
+		{
+			desc:     "equality match",
+			selector: "env=prod",
+			matches:  true,
+		},
+		{
+			desc:     "equality mismatch",
+			selector: "env=staging",
+			matches:  false,
+		},
+		{
+			desc:     "equality on missing key",
+			selector: "tier=cache",
+			matches:  false,
+		},
+		{
+			desc:     "inequality on missing key",
+			selector: "tier!=cache",
+			matches:  true,
+		},
+		{
+			desc:     "set membership match",
+			selector: "region in (eu,us)",
+			matches:  true,
+		},
+		{
+			desc:     "set membership mismatch",
+			selector: "region in (us,ap)",
+			matches:  false,
+		},
+		{
+			desc:     "set exclusion on missing key",
+			selector: "tier notin (cache)",
+			matches:  true,
+		},
+		{
+			desc:     "combined requirements",
+			selector: "env=prod,region in (eu,us)",
+			matches:  true,
+		},
+		{
+			desc:     "combined requirements with mismatch",
+			selector: "env=prod,region in (us)",
+			matches:  false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			sel, err := labels.ParseSelector(tc.selector)
+			assert.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+			assert.Equal(t, tc.matches, sel.Matches(l))
+		})
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package events provides the channel ordering-mode consumer for the
+// messaging adapters.
+package events
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/absmach/supermq/pkg/events"
+	"github.com/absmach/supermq/pkg/events/store"
+	"github.com/absmach/supermq/pkg/messaging"
+)
+
+const (
+	channelCreate = "channels.create"
+	channelUpdate = "channels.update"
+	channelRemove = "channels.remove"
+)
+
+// OrderingResolver tracks the ordering mode of every channel from
+// channel events, so adapters can enforce the mode on the publish path
+// without querying the channels service per message.
+type OrderingResolver struct {
+	mu    sync.RWMutex
+	modes map[string]messaging.OrderingMode
+}
+
+// NewOrderingResolver returns an empty ordering resolver. Until events
+// are consumed, every channel resolves to OrderingUnordered, so the
+// consumer has to replay the retained channel event history to restore
+// the modes configured before the adapter started.
+func NewOrderingResolver() *OrderingResolver {
+	return &OrderingResolver{
+		modes: make(map[string]messaging.OrderingMode),
+	}
+}
+
+// Resolve returns the ordering mode of the given channel.
+func (r *OrderingResolver) Resolve(channelID string) messaging.OrderingMode {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	mode, ok := r.modes[channelID]
+	if !ok {
+		return messaging.OrderingUnordered
+	}
+
+	return mode
+}
+
+func (r *OrderingResolver) set(channelID string, mode messaging.OrderingMode) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if mode == messaging.OrderingUnordered {
+		delete(r.modes, channelID)
+		return
+	}
+	r.modes[channelID] = mode
+}
+
+func (r *OrderingResolver) remove(channelID string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.modes, channelID)
+}
+
+// Start method starts consuming messages received from Event store. The
+// retained event history is replayed first, rebuilding the ordering
+// modes configured before this instance started. The consumer name must
+// be unique per instance, so every boot replays from the beginning
+// instead of resuming an older consumer past the events it needs.
+func Start(ctx context.Context, consumer string, sub events.Subscriber, resolver *OrderingResolver) error {
+	subCfg := events.SubscriberConfig{
+		Consumer:       consumer,
+		Stream:         store.StreamAllEvents,
+		Handler:        Handle(resolver),
+		DeliveryPolicy: messaging.DeliverAllPolicy,
+	}
+
+	return sub.Subscribe(ctx, subCfg)
+}
+
+// Handle returns an event handler updating the resolver from channel
+// create, update and remove events. Events of other operations are
+// ignored, as are update events carrying no metadata.
+func Handle(resolver *OrderingResolver) handleFunc {
+	return func(ctx context.Context, event events.Event) error {
+		data, err := event.Encode()
+		if err != nil {
+			return err
+		}
+
+		operation, _ := data["operation"].(string)
+		id, _ := data["id"].(string)
+		if id == "" {
+			return nil
+		}
+
+		switch {
+		case operation == channelCreate, operation == channelUpdate, strings.HasPrefix(operation, channelUpdate+"_"):
+			if metadata, ok := data["metadata"].(map[string]interface{}); ok {
+				resolver.set(id, messaging.OrderingFromMetadata(metadata))
+			}
+		case operation == channelRemove:
+			resolver.remove(id)
+		}
+
+		return nil
+	}
+}
+
+type handleFunc func(ctx context.Context, event events.Event) error
+
+func (h handleFunc) Handle(ctx context.Context, event events.Event) error {
+	return h(ctx, event)
+}
+
+func (h handleFunc) Cancel() error {
+	return nil
+}
@@ -0,0 +1,195 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package events
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/absmach/supermq/pkg/errors"
+	"github.com/absmach/supermq/pkg/events"
+	"github.com/absmach/supermq/pkg/events/store"
+	"github.com/absmach/supermq/pkg/messaging"
+	"github.com/xeipuuv/gojsonschema"
+)
+
+const (
+	schemaCreate  = "schemas.create"
+	channelBind   = "schemas.bind"
+	channelUnbind = "schemas.unbind"
+
+	jsonSchemaFormat = "json"
+)
+
+var errSchemaValidation = errors.New("payload does not conform to the channel schema")
+
+// SchemaRegistry tracks registered payload schemas and channel bindings
+// from schema registry events, so adapters can validate published
+// messages without querying the registry per message.
+type SchemaRegistry struct {
+	mu       sync.RWMutex
+	schemas  map[string]*gojsonschema.Schema
+	bindings map[string]string
+}
+
+// NewSchemaRegistry returns an empty schema registry. Until events are
+// consumed, every channel passes validation.
+func NewSchemaRegistry() *SchemaRegistry {
+	return &SchemaRegistry{
+		schemas:  make(map[string]*gojsonschema.Schema),
+		bindings: make(map[string]string),
+	}
+}
+
+// Validate validates the payload against the schema the channel is
+// bound to. Unbound channels and non-JSON schema formats pass.
+func (r *SchemaRegistry) Validate(channelID string, payload []byte) error {
+	r.mu.RLock()
+	schema := r.schemas[r.bindings[channelID]]
+	r.mu.RUnlock()
+	if schema == nil {
+		return nil
+	}
+
+	result, err := schema.Validate(gojsonschema.NewBytesLoader(payload))
+	if err != nil {
+		return errors.Wrap(errSchemaValidation, err)
+	}
+	if result.Valid() {
+		return nil
+	}
+	reasons := make([]string, 0, len(result.Errors()))
+	for _, desc := range result.Errors() {
+		reasons = append(reasons, desc.String())
+	}
+	return errors.Wrap(errSchemaValidation, errors.New(strings.Join(reasons, "; ")))
+}
+
+func (r *SchemaRegistry) set(id, format, definition string) {
+	// Protobuf and Avro schemas are served for consumer-side decoding
+	// only, so only JSON Schema definitions are compiled.
+	if format != jsonSchemaFormat {
+		return
+	}
+	schema, err := gojsonschema.NewSchema(gojsonschema.NewStringLoader(definition))
+	if err != nil {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.schemas[id] = schema
+}
+
+func (r *SchemaRegistry) bind(channelID, schemaID string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.bindings[channelID] = schemaID
+}
+
+func (r *SchemaRegistry) unbind(channelID string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.bindings, channelID)
+}
+
+// StartSchemas method starts consuming messages received from Event
+// store.
+func StartSchemas(ctx context.Context, consumer string, sub events.Subscriber, registry *SchemaRegistry) error {
+	subCfg := events.SubscriberConfig{
+		Consumer: consumer,
+		Stream:   store.StreamAllEvents,
+		Handler:  HandleSchemas(registry),
+	}
+
+	return sub.Subscribe(ctx, subCfg)
+}
+
+// HandleSchemas returns an event handler updating the registry from
+// schema create, bind and unbind events. Events of other operations
+// are ignored.
+func HandleSchemas(registry *SchemaRegistry) handleFunc {
+	return func(ctx context.Context, event events.Event) error {
+		data, err := event.Encode()
+		if err != nil {
+			return err
+		}
+
+		operation, _ := data["operation"].(string)
+		switch operation {
+		case schemaCreate:
+			id, _ := data["id"].(string)
+			format, _ := data["format"].(string)
+			definition, _ := data["definition"].(string)
+			if id != "" {
+				registry.set(id, format, definition)
+			}
+		case channelBind:
+			channelID, _ := data["channel_id"].(string)
+			schemaID, _ := data["schema_id"].(string)
+			if channelID != "" && schemaID != "" {
+				registry.bind(channelID, schemaID)
+			}
+		case channelUnbind:
+			channelID, _ := data["channel_id"].(string)
+			if channelID != "" {
+				registry.unbind(channelID)
+			}
+		}
+
+		return nil
+	}
+}
+
+var _ messaging.Publisher = (*validatingPublisher)(nil)
+
+type validatingPublisher struct {
+	pub      messaging.Publisher
+	registry *SchemaRegistry
+}
+
+// NewValidatingPublisher returns a publisher rejecting messages whose
+// payload does not conform to the schema their channel is bound to.
+func NewValidatingPublisher(pub messaging.Publisher, registry *SchemaRegistry) messaging.Publisher {
+	return &validatingPublisher{
+		pub:      pub,
+		registry: registry,
+	}
+}
+
+func (p *validatingPublisher) Publish(ctx context.Context, topic string, msg *messaging.Message) error {
+	channel := msg.GetChannel()
+	if channel == "" {
+		channel = topic
+	}
+	if err := p.registry.Validate(channel, msg.GetPayload()); err != nil {
+		return err
+	}
+	return p.pub.Publish(ctx, topic, msg)
+}
+
+func (p *validatingPublisher) Close() error {
+	return p.pub.Close()
+}
+
+var _ messaging.PubSub = (*validatingPubSub)(nil)
+
+type validatingPubSub struct {
+	messaging.Subscriber
+	messaging.Publisher
+}
+
+// NewValidatingPubSub wraps the publishing side of a PubSub with
+// NewValidatingPublisher, leaving subscriptions untouched.
+func NewValidatingPubSub(pubsub messaging.PubSub, registry *SchemaRegistry) messaging.PubSub {
+	return &validatingPubSub{
+		Subscriber: pubsub,
+		Publisher:  NewValidatingPublisher(pubsub, registry),
+	}
+}
+
+func (ps *validatingPubSub) Close() error {
+	return ps.Publisher.Close()
+}
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/absmach/mgate/pkg/session"
+	"github.com/absmach/supermq/pkg/prometheus"
 	"github.com/go-kit/kit/metrics"
 )
 
@@ -31,9 +32,9 @@ func MetricsMiddleware(svc session.Handler, counter metrics.Counter, latency met
 }
 
 // AuthConnect implements session.Handler.
-func (mm *metricsMiddleware) AuthConnect(ctx context.Context) error {
+func (mm *metricsMiddleware) AuthConnect(ctx context.Context) (err error) {
 	defer func(begin time.Time) {
-		mm.counter.With("method", "publish").Add(1)
+		mm.counter.With("method", "publish", "error", prometheus.ErrorClass(err)).Add(1)
 		mm.latency.With("method", "publish").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 
@@ -41,9 +42,9 @@ func (mm *metricsMiddleware) AuthConnect(ctx context.Context) error {
 }
 
 // AuthPublish implements session.Handler.
-func (mm *metricsMiddleware) AuthPublish(ctx context.Context, topic *string, payload *[]byte) error {
+func (mm *metricsMiddleware) AuthPublish(ctx context.Context, topic *string, payload *[]byte) (err error) {
 	defer func(begin time.Time) {
-		mm.counter.With("method", "publish").Add(1)
+		mm.counter.With("method", "publish", "error", prometheus.ErrorClass(err)).Add(1)
 		mm.latency.With("method", "publish").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 
@@ -66,9 +67,9 @@ func (*metricsMiddleware) Disconnect(ctx context.Context) error {
 }
 
 // Publish instruments Publish method with metrics.
-func (mm *metricsMiddleware) Publish(ctx context.Context, topic *string, payload *[]byte) error {
+func (mm *metricsMiddleware) Publish(ctx context.Context, topic *string, payload *[]byte) (err error) {
 	defer func(begin time.Time) {
-		mm.counter.With("method", "publish").Add(1)
+		mm.counter.With("method", "publish", "error", prometheus.ErrorClass(err)).Add(1)
 		mm.latency.With("method", "publish").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 
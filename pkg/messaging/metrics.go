@@ -0,0 +1,39 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package messaging
+
+import (
+	kitprometheus "github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+// redeliveredMessages counts messages returned for redelivery after a
+// handler failure, per subscriber.
+var redeliveredMessages = kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{
+	Namespace: "messaging",
+	Subsystem: "pubsub",
+	Name:      "redeliveries_total",
+	Help:      "Number of messages returned for redelivery after a handler failure.",
+}, []string{"subscriber"})
+
+// deadLetteredMessages counts messages moved to the dead-letter topic
+// after exhausting their redeliveries, per subscriber.
+var deadLetteredMessages = kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{
+	Namespace: "messaging",
+	Subsystem: "pubsub",
+	Name:      "dead_letters_total",
+	Help:      "Number of messages dead-lettered after exhausting redeliveries.",
+}, []string{"subscriber"})
+
+// IncRedelivered records a redelivery for the given subscriber. It is
+// exported for use by the broker implementations.
+func IncRedelivered(subscriber string) {
+	redeliveredMessages.With("subscriber", subscriber).Add(1)
+}
+
+// IncDeadLettered records a dead-lettered message for the given
+// subscriber. It is exported for use by the broker implementations.
+func IncDeadLettered(subscriber string) {
+	deadLetteredMessages.With("subscriber", subscriber).Add(1)
+}
@@ -94,7 +94,7 @@ func (ps *pubsub) Subscribe(ctx context.Context, cfg messaging.SubscriberConfig)
 		return ErrEmptyTopic
 	}
 
-	nh := ps.natsHandler(cfg.Handler)
+	nh := ps.natsHandler(cfg)
 
 	consumerConfig := jetstream.ConsumerConfig{
 		Name:          formatConsumerName(cfg.Topic, cfg.ID),
@@ -140,7 +140,7 @@ func (ps *pubsub) Unsubscribe(ctx context.Context, id, topic string) error {
 	}
 }
 
-func (ps *pubsub) natsHandler(h messaging.MessageHandler) func(m jetstream.Msg) {
+func (ps *pubsub) natsHandler(cfg messaging.SubscriberConfig) func(m jetstream.Msg) {
 	return func(m jetstream.Msg) {
 		var msg messaging.Message
 		if err := proto.Unmarshal(m.Data(), &msg); err != nil {
@@ -149,8 +149,13 @@ func (ps *pubsub) natsHandler(h messaging.MessageHandler) func(m jetstream.Msg)
 			return
 		}
 
-		if err := h.Handle(&msg); err != nil {
+		if err := cfg.Handler.Handle(&msg); err != nil {
 			ps.logger.Warn(fmt.Sprintf("Failed to handle SuperMQ message: %s", err))
+			if cfg.AckPolicy == messaging.AckExplicitPolicy {
+				ps.redeliver(m, cfg)
+
+				return
+			}
 		}
 		if err := m.Ack(); err != nil {
 			ps.logger.Warn(fmt.Sprintf("Failed to ack message: %s", err))
@@ -158,6 +163,35 @@ func (ps *pubsub) natsHandler(h messaging.MessageHandler) func(m jetstream.Msg)
 	}
 }
 
+// redeliver nacks a failed message so that JetStream delivers it again,
+// and dead-letters it once its redeliveries are exhausted.
+func (ps *pubsub) redeliver(m jetstream.Msg, cfg messaging.SubscriberConfig) {
+	meta, err := m.Metadata()
+	if err == nil && meta.NumDelivered > cfg.MaxRedeliveries {
+		if cfg.DLQTopic != "" {
+			if _, err := ps.js.Publish(context.Background(), cfg.DLQTopic, m.Data()); err != nil {
+				ps.logger.Warn(fmt.Sprintf("Failed to dead-letter message: %s", err))
+				if err := m.Nak(); err != nil {
+					ps.logger.Warn(fmt.Sprintf("Failed to nack message: %s", err))
+				}
+
+				return
+			}
+			messaging.IncDeadLettered(cfg.ID)
+		}
+		if err := m.Term(); err != nil {
+			ps.logger.Warn(fmt.Sprintf("Failed to terminate message: %s", err))
+		}
+
+		return
+	}
+
+	messaging.IncRedelivered(cfg.ID)
+	if err := m.Nak(); err != nil {
+		ps.logger.Warn(fmt.Sprintf("Failed to nack message: %s", err))
+	}
+}
+
 func formatConsumerName(topic, id string) string {
 	// A durable name cannot contain whitespace, ., *, >, path separators (forward or backwards slash), and non-printable characters.
 	chars := []string{
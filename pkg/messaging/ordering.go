@@ -0,0 +1,120 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package messaging
+
+import (
+	"context"
+	"sync"
+)
+
+// OrderingMode is the message ordering guarantee of a channel.
+type OrderingMode string
+
+const (
+	// OrderingUnordered gives no ordering guarantee. This is the default mode.
+	OrderingUnordered OrderingMode = "unordered"
+
+	// OrderingPerPublisher serializes messages of one publisher on a channel.
+	OrderingPerPublisher OrderingMode = "per_publisher"
+
+	// OrderingStrict serializes all messages on a channel, so that they reach
+	// the broker in publish order regardless of the publisher.
+	OrderingStrict OrderingMode = "strict"
+)
+
+// OrderingMetadataKey is the channel metadata key holding the ordering mode.
+const OrderingMetadataKey = "ordering"
+
+// OrderingFromMetadata reads the ordering mode from channel metadata.
+// Missing or unknown modes fall back to OrderingUnordered.
+func OrderingFromMetadata(metadata map[string]interface{}) OrderingMode {
+	mode, ok := metadata[OrderingMetadataKey].(string)
+	if !ok {
+		return OrderingUnordered
+	}
+	switch OrderingMode(mode) {
+	case OrderingPerPublisher, OrderingStrict:
+		return OrderingMode(mode)
+	default:
+		return OrderingUnordered
+	}
+}
+
+// ModeResolver resolves the ordering mode of a channel.
+type ModeResolver func(channelID string) OrderingMode
+
+var _ Publisher = (*orderingPublisher)(nil)
+
+type orderingPublisher struct {
+	pub      Publisher
+	resolver ModeResolver
+
+	mu    sync.Mutex
+	locks map[string]*sync.Mutex
+}
+
+// NewOrderingPublisher returns a publisher that enforces the ordering
+// mode of each channel by serializing concurrent publishes. Channels in
+// OrderingStrict mode share one lock per channel, channels in
+// OrderingPerPublisher mode share one lock per channel and publisher,
+// and unordered channels publish without locking.
+func NewOrderingPublisher(pub Publisher, resolver ModeResolver) Publisher {
+	return &orderingPublisher{
+		pub:      pub,
+		resolver: resolver,
+		locks:    make(map[string]*sync.Mutex),
+	}
+}
+
+func (p *orderingPublisher) Publish(ctx context.Context, topic string, msg *Message) error {
+	channel := msg.GetChannel()
+	if channel == "" {
+		channel = topic
+	}
+	switch p.resolver(channel) {
+	case OrderingStrict:
+		defer p.lock(channel)()
+	case OrderingPerPublisher:
+		defer p.lock(channel + "." + msg.GetPublisher())()
+	}
+	return p.pub.Publish(ctx, topic, msg)
+}
+
+func (p *orderingPublisher) Close() error {
+	return p.pub.Close()
+}
+
+var _ PubSub = (*orderingPubSub)(nil)
+
+type orderingPubSub struct {
+	Subscriber
+	Publisher
+}
+
+// NewOrderingPubSub wraps the publishing side of a PubSub with
+// NewOrderingPublisher, leaving subscriptions untouched.
+func NewOrderingPubSub(pubsub PubSub, resolver ModeResolver) PubSub {
+	return &orderingPubSub{
+		Subscriber: pubsub,
+		Publisher:  NewOrderingPublisher(pubsub, resolver),
+	}
+}
+
+func (ps *orderingPubSub) Close() error {
+	return ps.Publisher.Close()
+}
+
+// lock acquires the lock with the given key and returns its unlock.
+func (p *orderingPublisher) lock(key string) func() {
+	p.mu.Lock()
+	l, ok := p.locks[key]
+	if !ok {
+		l = &sync.Mutex{}
+		p.locks[key] = l
+	}
+	p.mu.Unlock()
+
+	l.Lock()
+	return l.Unlock
+}
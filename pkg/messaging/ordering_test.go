@@ -0,0 +1,118 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package messaging_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/absmach/supermq/pkg/messaging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrderingFromMetadata(t *testing.T) {
+	cases := []struct {
+		desc     string
+		metadata map[string]interface{}
+		mode     messaging.OrderingMode
+	}{
+		{
+			desc:     "strict mode",
+			metadata: map[string]interface{}{messaging.OrderingMetadataKey: "strict"},
+			mode:     messaging.OrderingStrict,
+		},
+		{
+			desc:     "per-publisher mode",
+			metadata: map[string]interface{}{messaging.OrderingMetadataKey: "per_publisher"},
+			mode:     messaging.OrderingPerPublisher,
+		},
+		{
+			desc:     "unknown mode",
+			metadata: map[string]interface{}{messaging.OrderingMetadataKey: "fifo"},
+			mode:     messaging.OrderingUnordered,
+		},
+		{
+			desc:     "missing mode",
+			metadata: map[string]interface{}{},
+			mode:     messaging.OrderingUnordered,
+		},
+		{
+			desc: "missing metadata",
+			mode: messaging.OrderingUnordered,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			assert.Equal(t, tc.mode, messaging.OrderingFromMetadata(tc.metadata))
+		})
+	}
+}
+
+type concurrencyPublisher struct {
+	current int32
+	max     int32
+}
+
+func (p *concurrencyPublisher) Publish(ctx context.Context, topic string, msg *messaging.Message) error {
+	current := atomic.AddInt32(&p.current, 1)
+	for {
+		max := atomic.LoadInt32(&p.max)
+		if current <= max || atomic.CompareAndSwapInt32(&p.max, max, current) {
+			break
+		}
+	}
+	time.Sleep(time.Millisecond)
+	atomic.AddInt32(&p.current, -1)
+	return nil
+}
+
+func (p *concurrencyPublisher) Close() error {
+	return nil
+}
+
+func TestOrderingPublisher(t *testing.T) {
+	inner := &concurrencyPublisher{}
+	pub := messaging.NewOrderingPublisher(inner, func(channelID string) messaging.OrderingMode {
+		return messaging.OrderingStrict
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			msg := &messaging.Message{Channel: "control"}
+			err := pub.Publish(context.Background(), "control", msg)
+			require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&inner.max), "strict channel must serialize publishes")
+	assert.Nil(t, pub.Close())
+}
+
+func TestOrderingPublisherUnordered(t *testing.T) {
+	inner := &concurrencyPublisher{}
+	pub := messaging.NewOrderingPublisher(inner, func(channelID string) messaging.OrderingMode {
+		return messaging.OrderingUnordered
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			msg := &messaging.Message{Channel: "telemetry"}
+			err := pub.Publish(context.Background(), "telemetry", msg)
+			require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+		}()
+	}
+	wg.Wait()
+}
@@ -16,6 +16,19 @@ const (
 	DeliverAllPolicy
 )
 
+type AckPolicy uint8
+
+const (
+	// AckAutoPolicy acknowledges messages as soon as they are passed to the handler,
+	// regardless of the handler outcome. This is the default policy.
+	AckAutoPolicy AckPolicy = iota
+
+	// AckExplicitPolicy acknowledges a message only after the handler returns without
+	// an error. A failed message is redelivered until MaxRedeliveries is exhausted,
+	// after which it is published to DLQTopic, if one is set, and acknowledged.
+	AckExplicitPolicy
+)
+
 // Publisher specifies message publishing API.
 type Publisher interface {
 	// Publishes message to the stream.
@@ -39,6 +52,15 @@ type SubscriberConfig struct {
 	Topic          string
 	Handler        MessageHandler
 	DeliveryPolicy DeliveryPolicy
+	AckPolicy      AckPolicy
+
+	// MaxRedeliveries is the number of redeliveries of a failed message
+	// before it is dead-lettered. It is only used with AckExplicitPolicy.
+	MaxRedeliveries uint64
+
+	// DLQTopic is the topic failed messages are published to once
+	// MaxRedeliveries is exhausted. If empty, such messages are dropped.
+	DLQTopic string
 }
 
 // Subscriber specifies message subscription API.
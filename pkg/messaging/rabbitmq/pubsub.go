@@ -21,6 +21,10 @@ const (
 
 	exchangeName = "messages"
 	chansPrefix  = "channels"
+
+	// redeliveryHeader carries the number of redeliveries of a message,
+	// since AMQP deliveries only expose a redelivered flag.
+	redeliveryHeader = "x-redeliveries"
 )
 
 var (
@@ -122,11 +126,12 @@ func (ps *pubsub) Subscribe(ctx context.Context, cfg messaging.SubscriberConfig)
 		return err
 	}
 
-	msgs, err := ps.channel.Consume(queue.Name, clientID, true, false, false, false, nil)
+	autoAck := cfg.AckPolicy == messaging.AckAutoPolicy
+	msgs, err := ps.channel.Consume(queue.Name, clientID, autoAck, false, false, false, nil)
 	if err != nil {
 		return err
 	}
-	go ps.handle(msgs, cfg.Handler)
+	go ps.handle(msgs, cfg)
 	s[cfg.ID] = subscription{
 		cancel: func() error {
 			if err := ps.channel.Cancel(clientID, false); err != nil {
@@ -176,16 +181,80 @@ func (ps *pubsub) Unsubscribe(ctx context.Context, id, topic string) error {
 	return nil
 }
 
-func (ps *pubsub) handle(deliveries <-chan amqp.Delivery, h messaging.MessageHandler) {
+func (ps *pubsub) handle(deliveries <-chan amqp.Delivery, cfg messaging.SubscriberConfig) {
 	for d := range deliveries {
 		var msg messaging.Message
 		if err := proto.Unmarshal(d.Body, &msg); err != nil {
 			ps.logger.Warn(fmt.Sprintf("Failed to unmarshal received message: %s", err))
+			if cfg.AckPolicy == messaging.AckExplicitPolicy {
+				ps.redeliver(d, cfg)
+				continue
+			}
 			return
 		}
-		if err := h.Handle(&msg); err != nil {
+		if err := cfg.Handler.Handle(&msg); err != nil {
 			ps.logger.Warn(fmt.Sprintf("Failed to handle SuperMQ message: %s", err))
+			if cfg.AckPolicy == messaging.AckExplicitPolicy {
+				ps.redeliver(d, cfg)
+				continue
+			}
+			return
+		}
+		if cfg.AckPolicy == messaging.AckExplicitPolicy {
+			if err := d.Ack(false); err != nil {
+				ps.logger.Warn(fmt.Sprintf("Failed to ack message: %s", err))
+			}
+		}
+	}
+}
+
+// redeliver republishes a failed message with an incremented redelivery
+// counter, since AMQP deliveries only carry a redelivered flag. Once the
+// counter exceeds the configured redeliveries the message is published
+// to the dead-letter topic instead, or dropped if none is set.
+func (ps *pubsub) redeliver(d amqp.Delivery, cfg messaging.SubscriberConfig) {
+	count := redeliveryCount(d)
+	target := d.RoutingKey
+	deadLetter := count >= cfg.MaxRedeliveries
+	if deadLetter {
+		if cfg.DLQTopic == "" {
+			if err := d.Ack(false); err != nil {
+				ps.logger.Warn(fmt.Sprintf("Failed to ack message: %s", err))
+			}
 			return
 		}
+		target = formatTopic(cfg.DLQTopic)
+	}
+
+	pub := amqp.Publishing{
+		Headers:     amqp.Table{redeliveryHeader: int64(count + 1)},
+		ContentType: "application/octet-stream",
+		AppId:       "supermq-publisher",
+		Body:        d.Body,
+	}
+	if err := ps.channel.PublishWithContext(context.Background(), ps.exchange, target, false, false, pub); err != nil {
+		ps.logger.Warn(fmt.Sprintf("Failed to redeliver message: %s", err))
+		if err := d.Nack(false, true); err != nil {
+			ps.logger.Warn(fmt.Sprintf("Failed to nack message: %s", err))
+		}
+		return
+	}
+
+	switch deadLetter {
+	case true:
+		messaging.IncDeadLettered(cfg.ID)
+	default:
+		messaging.IncRedelivered(cfg.ID)
+	}
+	if err := d.Ack(false); err != nil {
+		ps.logger.Warn(fmt.Sprintf("Failed to ack message: %s", err))
+	}
+}
+
+func redeliveryCount(d amqp.Delivery) uint64 {
+	count, ok := d.Headers[redeliveryHeader].(int64)
+	if !ok || count < 0 {
+		return 0
 	}
+	return uint64(count)
 }
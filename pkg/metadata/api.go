@@ -0,0 +1,101 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package metadata
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+
+	api "github.com/absmach/supermq/api/http"
+	smqauthn "github.com/absmach/supermq/pkg/authn"
+	smqauthz "github.com/absmach/supermq/pkg/authz"
+	"github.com/absmach/supermq/pkg/errors"
+	svcerr "github.com/absmach/supermq/pkg/errors/service"
+	"github.com/absmach/supermq/pkg/policies"
+	"github.com/go-chi/chi/v5"
+)
+
+// Handler returns an HTTP handler for managing per-owner metadata
+// schemas. The schema definition is the request body. All routes
+// require an authenticated user; registering or removing a schema is
+// restricted to the owner itself or a platform administrator.
+func Handler(registry Registry, authn smqauthn.Authentication, authz smqauthz.Authorization) http.Handler {
+	mux := chi.NewRouter()
+
+	mux.Group(func(router chi.Router) {
+		router.Use(api.AuthenticateMiddleware(authn, false))
+
+		router.Put("/{ownerID}", func(w http.ResponseWriter, r *http.Request) {
+			ownerID := chi.URLParam(r, "ownerID")
+			if err := authorizeOwner(r, authz, ownerID); err != nil {
+				api.EncodeError(r.Context(), err, w)
+				return
+			}
+			definition, err := io.ReadAll(r.Body)
+			if err != nil {
+				api.EncodeError(r.Context(), errors.Wrap(svcerr.ErrMalformedEntity, err), w)
+				return
+			}
+			if err := registry.Register(r.Context(), ownerID, string(definition)); err != nil {
+				api.EncodeError(r.Context(), errors.Wrap(svcerr.ErrMalformedEntity, err), w)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		})
+
+		router.Get("/{ownerID}", func(w http.ResponseWriter, r *http.Request) {
+			definition, err := registry.View(r.Context(), chi.URLParam(r, "ownerID"))
+			if err != nil {
+				api.EncodeError(r.Context(), errors.Wrap(svcerr.ErrViewEntity, err), w)
+				return
+			}
+			w.Header().Set("Content-Type", api.ContentType)
+			w.WriteHeader(http.StatusOK)
+			if err := json.NewEncoder(w).Encode(json.RawMessage(definition)); err != nil {
+				w.WriteHeader(http.StatusInternalServerError)
+			}
+		})
+
+		router.Delete("/{ownerID}", func(w http.ResponseWriter, r *http.Request) {
+			ownerID := chi.URLParam(r, "ownerID")
+			if err := authorizeOwner(r, authz, ownerID); err != nil {
+				api.EncodeError(r.Context(), err, w)
+				return
+			}
+			if err := registry.Remove(r.Context(), ownerID); err != nil {
+				api.EncodeError(r.Context(), errors.Wrap(svcerr.ErrRemoveEntity, err), w)
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		})
+	})
+
+	return mux
+}
+
+// authorizeOwner allows a schema write when the session user or domain
+// is the owner itself, falling back to a platform-admin check for every
+// other caller.
+func authorizeOwner(r *http.Request, authz smqauthz.Authorization, ownerID string) error {
+	session, ok := r.Context().Value(api.SessionKey).(smqauthn.Session)
+	if !ok {
+		return svcerr.ErrAuthentication
+	}
+	if session.UserID == ownerID || session.DomainID == ownerID {
+		return nil
+	}
+	req := smqauthz.PolicyReq{
+		SubjectType: policies.UserType,
+		SubjectKind: policies.UsersKind,
+		Subject:     session.UserID,
+		Permission:  policies.AdminPermission,
+		ObjectType:  policies.PlatformType,
+		Object:      policies.SuperMQObject,
+	}
+	if err := authz.Authorize(r.Context(), req); err != nil {
+		return svcerr.ErrAuthorization
+	}
+	return nil
+}
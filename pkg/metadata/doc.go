@@ -0,0 +1,6 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package metadata provides per-owner JSON Schema validation of entity
+// metadata, keeping fleet metadata consistent for downstream automation.
+package metadata
@@ -0,0 +1,185 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package metadata
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+
+	"github.com/absmach/supermq/pkg/errors"
+	repoerr "github.com/absmach/supermq/pkg/errors/repository"
+)
+
+var (
+	errMissingDefinition = errors.New("missing schema definition")
+	errInvalidDefinition = errors.New("invalid schema definition")
+)
+
+// ValidationError describes a single metadata field that failed
+// validation. An empty field refers to the metadata document itself.
+type ValidationError struct {
+	Field  string `json:"field,omitempty"`
+	Reason string `json:"reason"`
+}
+
+func (e ValidationError) Error() string {
+	if e.Field == "" {
+		return e.Reason
+	}
+	return e.Field + ": " + e.Reason
+}
+
+// ValidationErrors aggregates the validation errors of a metadata
+// document, so callers can return them in a structured response.
+type ValidationErrors []ValidationError
+
+func (e ValidationErrors) Error() string {
+	reasons := make([]string, 0, len(e))
+	for _, ve := range e {
+		reasons = append(reasons, ve.Error())
+	}
+	return strings.Join(reasons, "; ")
+}
+
+// Registry stores per-owner JSON Schemas and validates entity metadata
+// against them. Owners without a registered schema fall back to the
+// schema registered for the empty owner, when present.
+type Registry interface {
+	// Register stores the owner's metadata schema, replacing any
+	// previous one.
+	Register(ctx context.Context, ownerID, definition string) error
+
+	// View returns the owner's metadata schema.
+	View(ctx context.Context, ownerID string) (string, error)
+
+	// Remove drops the owner's metadata schema.
+	Remove(ctx context.Context, ownerID string) error
+
+	// Validate validates metadata against the owner's schema. Metadata
+	// of owners without a schema passes.
+	Validate(ctx context.Context, ownerID string, metadata map[string]interface{}) error
+}
+
+// schema holds the supported JSON Schema keywords of a definition.
+type schema struct {
+	definition string
+
+	Required   []string `json:"required"`
+	Properties map[string]struct {
+		Type string `json:"type"`
+	} `json:"properties"`
+}
+
+var _ Registry = (*registry)(nil)
+
+type registry struct {
+	mu      sync.RWMutex
+	schemas map[string]schema
+}
+
+// NewRegistry returns an in-memory metadata schema registry.
+func NewRegistry() Registry {
+	return &registry{
+		schemas: make(map[string]schema),
+	}
+}
+
+func (r *registry) Register(ctx context.Context, ownerID, definition string) error {
+	if definition == "" {
+		return errors.Wrap(repoerr.ErrCreateEntity, errMissingDefinition)
+	}
+	s := schema{definition: definition}
+	if err := json.Unmarshal([]byte(definition), &s); err != nil {
+		return errors.Wrap(errInvalidDefinition, err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.schemas[ownerID] = s
+
+	return nil
+}
+
+func (r *registry) View(ctx context.Context, ownerID string) (string, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	s, ok := r.schemas[ownerID]
+	if !ok {
+		return "", repoerr.ErrNotFound
+	}
+	return s.definition, nil
+}
+
+func (r *registry) Remove(ctx context.Context, ownerID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.schemas[ownerID]; !ok {
+		return repoerr.ErrNotFound
+	}
+	delete(r.schemas, ownerID)
+	return nil
+}
+
+func (r *registry) Validate(ctx context.Context, ownerID string, metadata map[string]interface{}) error {
+	r.mu.RLock()
+	s, ok := r.schemas[ownerID]
+	if !ok {
+		s, ok = r.schemas[""]
+	}
+	r.mu.RUnlock()
+	if !ok {
+		return nil
+	}
+
+	var ves ValidationErrors
+	for _, field := range s.Required {
+		if _, ok := metadata[field]; !ok {
+			ves = append(ves, ValidationError{Field: field, Reason: "missing required field"})
+		}
+	}
+	for field, property := range s.Properties {
+		value, ok := metadata[field]
+		if !ok || property.Type == "" {
+			continue
+		}
+		if !hasType(value, property.Type) {
+			ves = append(ves, ValidationError{Field: field, Reason: "not of type " + property.Type})
+		}
+	}
+
+	if len(ves) > 0 {
+		return ves
+	}
+	return nil
+}
+
+// hasType reports whether the value has the given JSON type.
+func hasType(value interface{}, jsonType string) bool {
+	switch jsonType {
+	case "object":
+		_, ok := value.(map[string]interface{})
+		return ok
+	case "array":
+		_, ok := value.([]interface{})
+		return ok
+	case "string":
+		_, ok := value.(string)
+		return ok
+	case "number":
+		_, ok := value.(float64)
+		return ok
+	case "integer":
+		number, ok := value.(float64)
+		return ok && number == float64(int64(number))
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "null":
+		return value == nil
+	default:
+		return false
+	}
+}
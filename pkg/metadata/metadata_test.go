@@ -0,0 +1,155 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package metadata_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/absmach/supermq/pkg/errors"
+	repoerr "github.com/absmach/supermq/pkg/errors/repository"
+	"github.com/absmach/supermq/pkg/metadata"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const schema = `{
+	"type": "object",
+	"required": ["region"],
+	"properties": {
+		"region": {"type": "string"},
+		"floor": {"type": "integer"},
+		"active": {"type": "boolean"}
+	}
+}`
+
+func TestRegister(t *testing.T) {
+	registry := metadata.NewRegistry()
+
+	cases := []struct {
+		desc       string
+		ownerID    string
+		definition string
+		err        error
+	}{
+		{
+			desc:       "register valid schema",
+			ownerID:    "owner",
+			definition: schema,
+			err:        nil,
+		},
+		{
+			desc:       "register empty schema",
+			ownerID:    "owner",
+			definition: "",
+			err:        repoerr.ErrCreateEntity,
+		},
+		{
+			desc:       "register malformed schema",
+			ownerID:    "owner",
+			definition: "{",
+			err:        errors.New("invalid schema definition"),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := registry.Register(context.Background(), tc.ownerID, tc.definition)
+			if tc.err == nil {
+				assert.Nil(t, err)
+				return
+			}
+			assert.NotNil(t, err)
+		})
+	}
+}
+
+func TestViewRemove(t *testing.T) {
+	registry := metadata.NewRegistry()
+	ctx := context.Background()
+
+	err := registry.Register(ctx, "owner", schema)
+	require.Nil(t, err)
+
+	definition, err := registry.View(ctx, "owner")
+	assert.Nil(t, err)
+	assert.Equal(t, schema, definition)
+
+	_, err = registry.View(ctx, "unknown")
+	assert.True(t, errors.Contains(err, repoerr.ErrNotFound))
+
+	err = registry.Remove(ctx, "owner")
+	assert.Nil(t, err)
+
+	err = registry.Remove(ctx, "owner")
+	assert.True(t, errors.Contains(err, repoerr.ErrNotFound))
+}
+
+func TestValidate(t *testing.T) {
+	registry := metadata.NewRegistry()
+	ctx := context.Background()
+
+	err := registry.Register(ctx, "owner", schema)
+	require.Nil(t, err)
+
+	cases := []struct {
+		desc     string
+		ownerID  string
+		metadata map[string]interface{}
+		fields   []string
+	}{
+		{
+			desc:     "valid metadata",
+			ownerID:  "owner",
+			metadata: map[string]interface{}{"region": "eu", "floor": float64(2), "active": true},
+		},
+		{
+			desc:     "missing required field",
+			ownerID:  "owner",
+			metadata: map[string]interface{}{"floor": float64(2)},
+			fields:   []string{"region"},
+		},
+		{
+			desc:     "wrong field types",
+			ownerID:  "owner",
+			metadata: map[string]interface{}{"region": float64(1), "floor": "second", "active": true},
+			fields:   []string{"region", "floor"},
+		},
+		{
+			desc:     "owner without schema",
+			ownerID:  "unknown",
+			metadata: map[string]interface{}{"region": float64(1)},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := registry.Validate(ctx, tc.ownerID, tc.metadata)
+			if len(tc.fields) == 0 {
+				assert.Nil(t, err)
+				return
+			}
+			var ves metadata.ValidationErrors
+			require.ErrorAs(t, err, &ves)
+			got := make([]string, 0, len(ves))
+			for _, ve := range ves {
+				got = append(got, ve.Field)
+			}
+			assert.ElementsMatch(t, tc.fields, got)
+		})
+	}
+}
+
+func TestValidateFallback(t *testing.T) {
+	registry := metadata.NewRegistry()
+	ctx := context.Background()
+
+	err := registry.Register(ctx, "", schema)
+	require.Nil(t, err)
+
+	err = registry.Validate(ctx, "owner", map[string]interface{}{})
+	var ves metadata.ValidationErrors
+	require.ErrorAs(t, err, &ves)
+	assert.Equal(t, "region", ves[0].Field)
+}
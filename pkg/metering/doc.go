@@ -0,0 +1,7 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package metering aggregates per-domain usage counts from repositories and
+// the event stream, and enforces configurable quotas at creation and
+// ingestion time.
+package metering
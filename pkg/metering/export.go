@@ -0,0 +1,78 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package metering
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	kitprometheus "github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+// DomainLister returns the IDs of the domains to export usage for.
+type DomainLister func(ctx context.Context) ([]string, error)
+
+// PrometheusExporter periodically publishes per-domain usage counts as
+// prometheus gauges labeled by domain and resource.
+type PrometheusExporter struct {
+	meter   *Meter
+	domains DomainLister
+	gauge   *kitprometheus.Gauge
+}
+
+// NewPrometheusExporter creates an exporter publishing usage of the domains
+// returned by the lister under the given namespace.
+func NewPrometheusExporter(namespace string, meter *Meter, domains DomainLister) *PrometheusExporter {
+	gauge := kitprometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "usage",
+		Name:      "resource_count",
+		Help:      "Current per-domain count of metered resources.",
+	}, []string{"domain_id", "resource"})
+
+	return &PrometheusExporter{
+		meter:   meter,
+		domains: domains,
+		gauge:   gauge,
+	}
+}
+
+// Export publishes the current usage of all listed domains.
+func (e *PrometheusExporter) Export(ctx context.Context) error {
+	domains, err := e.domains(ctx)
+	if err != nil {
+		return err
+	}
+	for _, domainID := range domains {
+		u, err := e.meter.Usage(ctx, domainID)
+		if err != nil {
+			return err
+		}
+		for resource, count := range u.Resources {
+			e.gauge.With("domain_id", domainID, "resource", string(resource)).Set(float64(count))
+		}
+	}
+	return nil
+}
+
+// Start exports usage on the given interval until the context is canceled.
+func (e *PrometheusExporter) Start(ctx context.Context, interval time.Duration, logger *slog.Logger) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := e.Export(ctx); err != nil {
+					logger.Warn(fmt.Sprintf("failed to export usage metrics : %s", err))
+				}
+			}
+		}
+	}()
+}
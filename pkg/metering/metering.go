@@ -0,0 +1,259 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package metering
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	api "github.com/absmach/supermq/api/http"
+	apiutil "github.com/absmach/supermq/api/http/util"
+	"github.com/absmach/supermq/auth"
+	smqauthn "github.com/absmach/supermq/pkg/authn"
+	smqauthz "github.com/absmach/supermq/pkg/authz"
+	"github.com/absmach/supermq/pkg/errors"
+	svcerr "github.com/absmach/supermq/pkg/errors/service"
+	"github.com/absmach/supermq/pkg/events"
+	"github.com/absmach/supermq/pkg/policies"
+)
+
+// Resource identifies a metered resource kind.
+type Resource string
+
+const (
+	ResourceUsers    Resource = "users"
+	ResourceClients  Resource = "clients"
+	ResourceChannels Resource = "channels"
+	ResourceMessages Resource = "messages_per_day"
+)
+
+var errInvalidQuota = errors.New("invalid quota configuration")
+
+// Quota holds per-domain limits for metered resources. A zero or missing
+// limit means the resource is unlimited.
+type Quota map[Resource]uint64
+
+// ParseQuota parses quota limits from a JSON object mapping resource names
+// to limits, e.g. {"clients": 100, "messages_per_day": 10000}. An empty
+// string yields an empty quota with no limits.
+func ParseQuota(s string) (Quota, error) {
+	q := Quota{}
+	if s == "" {
+		return q, nil
+	}
+	if err := json.Unmarshal([]byte(s), &q); err != nil {
+		return Quota{}, errors.Wrap(errInvalidQuota, err)
+	}
+	return q, nil
+}
+
+// Usage holds the current per-domain counts for metered resources.
+type Usage struct {
+	DomainID  string              `json:"domain_id"`
+	Resources map[Resource]uint64 `json:"resources"`
+}
+
+// CountFunc returns the current count of a resource owned by the domain.
+type CountFunc func(ctx context.Context, domainID string) (uint64, error)
+
+// Meter aggregates per-domain usage and enforces quotas. Repository-backed
+// resources are counted through registered CountFuncs, while messages are
+// counted in memory per day from ingestion hooks or the event stream.
+type Meter struct {
+	mu       sync.Mutex
+	counters map[Resource]CountFunc
+	quota    Quota
+	messages map[string]uint64
+	day      time.Time
+}
+
+// New returns a meter enforcing the given quota.
+func New(quota Quota) *Meter {
+	return &Meter{
+		counters: map[Resource]CountFunc{},
+		quota:    quota,
+		messages: map[string]uint64{},
+		day:      startOfDay(time.Now()),
+	}
+}
+
+// RegisterCounter registers the repository-backed counter for a resource.
+func (m *Meter) RegisterCounter(resource Resource, count CountFunc) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.counters[resource] = count
+}
+
+// RecordMessage counts one ingested message for the domain, rolling the
+// in-memory counters over at the start of each day.
+func (m *Meter) RecordMessage(domainID string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.rollover()
+	m.messages[domainID]++
+}
+
+// Usage returns the current counts of all metered resources for the domain.
+func (m *Meter) Usage(ctx context.Context, domainID string) (Usage, error) {
+	u := Usage{
+		DomainID:  domainID,
+		Resources: map[Resource]uint64{},
+	}
+
+	m.mu.Lock()
+	counters := make(map[Resource]CountFunc, len(m.counters))
+	for resource, count := range m.counters {
+		counters[resource] = count
+	}
+	m.rollover()
+	u.Resources[ResourceMessages] = m.messages[domainID]
+	m.mu.Unlock()
+
+	for resource, count := range counters {
+		total, err := count(ctx, domainID)
+		if err != nil {
+			return Usage{}, errors.Wrap(svcerr.ErrViewEntity, err)
+		}
+		u.Resources[resource] = total
+	}
+
+	return u, nil
+}
+
+// CheckQuota returns a quota-exceeded error when the domain has reached the
+// configured limit for the resource. Resources without a limit always pass.
+func (m *Meter) CheckQuota(ctx context.Context, domainID string, resource Resource) error {
+	limit := m.quota[resource]
+	if limit == 0 {
+		return nil
+	}
+
+	var current uint64
+	switch resource {
+	case ResourceMessages:
+		m.mu.Lock()
+		m.rollover()
+		current = m.messages[domainID]
+		m.mu.Unlock()
+	default:
+		m.mu.Lock()
+		count, ok := m.counters[resource]
+		m.mu.Unlock()
+		if !ok {
+			return nil
+		}
+		total, err := count(ctx, domainID)
+		if err != nil {
+			return errors.Wrap(svcerr.ErrViewEntity, err)
+		}
+		current = total
+	}
+
+	if current >= limit {
+		return errors.Wrap(svcerr.ErrQuotaExceeded, fmt.Errorf("%s quota of %d reached", resource, limit))
+	}
+	return nil
+}
+
+// MessageHandler returns an event handler counting message events from the
+// event stream, keyed by the domain_id event field.
+func (m *Meter) MessageHandler() events.EventHandler {
+	return messageHandler{meter: m}
+}
+
+type messageHandler struct {
+	meter *Meter
+}
+
+func (h messageHandler) Handle(ctx context.Context, event events.Event) error {
+	val, err := event.Encode()
+	if err != nil {
+		return err
+	}
+	if domainID := events.Read(val, "domain_id", ""); domainID != "" {
+		h.meter.RecordMessage(domainID)
+	}
+	return nil
+}
+
+// Handler returns an HTTP handler exposing current usage. The domain is
+// taken from the domain_id query parameter. The caller must be an
+// authenticated member of that domain; platform administrators may read
+// the usage of any domain.
+func (m *Meter) Handler(authn smqauthn.Authentication, authz smqauthz.Authorization) http.Handler {
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		domainID, err := apiutil.ReadStringQuery(r, "domain_id", "")
+		if err != nil || domainID == "" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if err := authorizeUsage(r, authz, domainID); err != nil {
+			api.EncodeError(r.Context(), err, w)
+			return
+		}
+		u, err := m.Usage(r.Context(), domainID)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(u); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+	return api.AuthenticateMiddleware(authn, false)(h)
+}
+
+// authorizeUsage allows domain members to read the usage of their own
+// domain, falling back to a platform-admin check for everyone else.
+func authorizeUsage(r *http.Request, authz smqauthz.Authorization, domainID string) error {
+	session, ok := r.Context().Value(api.SessionKey).(smqauthn.Session)
+	if !ok {
+		return svcerr.ErrAuthentication
+	}
+	member := smqauthz.PolicyReq{
+		Domain:      domainID,
+		SubjectType: policies.UserType,
+		SubjectKind: policies.UsersKind,
+		Subject:     auth.EncodeDomainUserID(domainID, session.UserID),
+		Permission:  policies.MembershipPermission,
+		ObjectType:  policies.DomainType,
+		Object:      domainID,
+	}
+	if err := authz.Authorize(r.Context(), member); err == nil {
+		return nil
+	}
+	admin := smqauthz.PolicyReq{
+		SubjectType: policies.UserType,
+		SubjectKind: policies.UsersKind,
+		Subject:     session.UserID,
+		Permission:  policies.AdminPermission,
+		ObjectType:  policies.PlatformType,
+		Object:      policies.SuperMQObject,
+	}
+	if err := authz.Authorize(r.Context(), admin); err != nil {
+		return svcerr.ErrAuthorization
+	}
+	return nil
+}
+
+func (m *Meter) rollover() {
+	if today := startOfDay(time.Now()); today.After(m.day) {
+		m.messages = map[string]uint64{}
+		m.day = today
+	}
+}
+
+func startOfDay(t time.Time) time.Time {
+	y, mo, d := t.UTC().Date()
+	return time.Date(y, mo, d, 0, 0, 0, 0, time.UTC)
+}
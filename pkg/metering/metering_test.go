@@ -0,0 +1,181 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package metering_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+
+	smqauthn "github.com/absmach/supermq/pkg/authn"
+	authnmocks "github.com/absmach/supermq/pkg/authn/mocks"
+	smqauthz "github.com/absmach/supermq/pkg/authz"
+	authzmocks "github.com/absmach/supermq/pkg/authz/mocks"
+	"github.com/absmach/supermq/pkg/errors"
+	svcerr "github.com/absmach/supermq/pkg/errors/service"
+	"github.com/absmach/supermq/pkg/metering"
+	"github.com/absmach/supermq/pkg/policies"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	domainID   = "b8dd2b4d-0c36-4b7b-87fa-76f58c8acbb2"
+	userID     = "d4e4d9b1-6be6-4ae1-8a63-9bfd2c9b6b45"
+	validToken = "valid"
+)
+
+func TestParseQuota(t *testing.T) {
+	cases := []struct {
+		desc  string
+		input string
+		quota metering.Quota
+		err   bool
+	}{
+		{
+			desc:  "empty configuration",
+			input: "",
+			quota: metering.Quota{},
+		},
+		{
+			desc:  "valid configuration",
+			input: `{"clients": 10, "messages_per_day": 100}`,
+			quota: metering.Quota{metering.ResourceClients: 10, metering.ResourceMessages: 100},
+		},
+		{
+			desc:  "invalid configuration",
+			input: `{"clients":`,
+			err:   true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			quota, err := metering.ParseQuota(tc.input)
+			if tc.err {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.quota, quota)
+		})
+	}
+}
+
+func TestCheckQuota(t *testing.T) {
+	meter := metering.New(metering.Quota{
+		metering.ResourceClients:  2,
+		metering.ResourceMessages: 2,
+	})
+	count := uint64(0)
+	meter.RegisterCounter(metering.ResourceClients, func(ctx context.Context, domainID string) (uint64, error) {
+		return count, nil
+	})
+
+	err := meter.CheckQuota(context.Background(), domainID, metering.ResourceClients)
+	assert.NoError(t, err)
+
+	count = 2
+	err = meter.CheckQuota(context.Background(), domainID, metering.ResourceClients)
+	assert.True(t, errors.Contains(err, svcerr.ErrQuotaExceeded))
+
+	// Resources without a configured limit are unlimited.
+	err = meter.CheckQuota(context.Background(), domainID, metering.ResourceChannels)
+	assert.NoError(t, err)
+
+	meter.RecordMessage(domainID)
+	err = meter.CheckQuota(context.Background(), domainID, metering.ResourceMessages)
+	assert.NoError(t, err)
+
+	meter.RecordMessage(domainID)
+	err = meter.CheckQuota(context.Background(), domainID, metering.ResourceMessages)
+	assert.True(t, errors.Contains(err, svcerr.ErrQuotaExceeded))
+
+	// Other domains keep their own message counts.
+	err = meter.CheckQuota(context.Background(), "other", metering.ResourceMessages)
+	assert.NoError(t, err)
+}
+
+func TestUsage(t *testing.T) {
+	meter := metering.New(metering.Quota{})
+	meter.RegisterCounter(metering.ResourceClients, func(ctx context.Context, domainID string) (uint64, error) {
+		return 5, nil
+	})
+	meter.RecordMessage(domainID)
+	meter.RecordMessage(domainID)
+
+	u, err := meter.Usage(context.Background(), domainID)
+	require.NoError(t, err)
+	assert.Equal(t, domainID, u.DomainID)
+	assert.Equal(t, uint64(5), u.Resources[metering.ResourceClients])
+	assert.Equal(t, uint64(2), u.Resources[metering.ResourceMessages])
+}
+
+func TestHandler(t *testing.T) {
+	meter := metering.New(metering.Quota{})
+	meter.RecordMessage(domainID)
+
+	cases := []struct {
+		desc   string
+		target string
+		token  string
+		member bool
+		status int
+	}{
+		{
+			desc:   "domain member",
+			target: "/usage?domain_id=" + domainID,
+			token:  validToken,
+			member: true,
+			status: 200,
+		},
+		{
+			desc:   "missing domain",
+			target: "/usage",
+			token:  validToken,
+			status: 400,
+		},
+		{
+			desc:   "missing token",
+			target: "/usage?domain_id=" + domainID,
+			status: 401,
+		},
+		{
+			desc:   "neither member nor admin",
+			target: "/usage?domain_id=" + domainID,
+			token:  validToken,
+			status: 403,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			authn := new(authnmocks.Authentication)
+			authn.On("Authenticate", mock.Anything, validToken).Return(smqauthn.Session{UserID: userID}, nil)
+			authz := new(authzmocks.Authorization)
+			if tc.member {
+				authz.On("Authorize", mock.Anything, mock.MatchedBy(func(req smqauthz.PolicyReq) bool {
+					return req.Permission == policies.MembershipPermission && req.Object == domainID
+				})).Return(nil)
+			}
+			authz.On("Authorize", mock.Anything, mock.Anything).Return(svcerr.ErrAuthorization)
+
+			req := httptest.NewRequest("GET", tc.target, nil)
+			if tc.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tc.token)
+			}
+			w := httptest.NewRecorder()
+			meter.Handler(authn, authz).ServeHTTP(w, req)
+			assert.Equal(t, tc.status, w.Code)
+			if tc.status == 200 {
+				var u metering.Usage
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&u))
+				assert.Equal(t, domainID, u.DomainID)
+				assert.Equal(t, uint64(1), u.Resources[metering.ResourceMessages])
+			}
+		})
+	}
+}
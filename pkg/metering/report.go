@@ -0,0 +1,201 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package metering
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/absmach/supermq/internal/email"
+	"github.com/absmach/supermq/pkg/errors"
+)
+
+var errDeliverReport = errors.New("failed to deliver usage report")
+
+// Report holds the usage of all domains for a billing period.
+type Report struct {
+	Period      string    `json:"period"` // YYYY-MM
+	GeneratedAt time.Time `json:"generated_at"`
+	Usage       []Usage   `json:"usage"`
+}
+
+// Report builds a usage report covering the month of the given time for the
+// domains returned by the lister.
+func (m *Meter) Report(ctx context.Context, at time.Time, domains DomainLister) (Report, error) {
+	r := Report{
+		Period:      at.UTC().Format("2006-01"),
+		GeneratedAt: time.Now().UTC(),
+	}
+
+	ids, err := domains(ctx)
+	if err != nil {
+		return Report{}, err
+	}
+	sort.Strings(ids)
+	for _, domainID := range ids {
+		u, err := m.Usage(ctx, domainID)
+		if err != nil {
+			return Report{}, err
+		}
+		r.Usage = append(r.Usage, u)
+	}
+
+	return r, nil
+}
+
+// JSON renders the report as JSON.
+func (r Report) JSON() ([]byte, error) {
+	return json.Marshal(r)
+}
+
+// CSV renders the report as CSV with one row per domain and resource.
+func (r Report) CSV() ([]byte, error) {
+	buf := new(bytes.Buffer)
+	w := csv.NewWriter(buf)
+	if err := w.Write([]string{"period", "domain_id", "resource", "count"}); err != nil {
+		return nil, err
+	}
+	for _, u := range r.Usage {
+		resources := make([]string, 0, len(u.Resources))
+		for resource := range u.Resources {
+			resources = append(resources, string(resource))
+		}
+		sort.Strings(resources)
+		for _, resource := range resources {
+			row := []string{r.Period, u.DomainID, resource, fmt.Sprintf("%d", u.Resources[Resource(resource)])}
+			if err := w.Write(row); err != nil {
+				return nil, err
+			}
+		}
+	}
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
+
+// Deliverer delivers a generated usage report to its recipients.
+type Deliverer interface {
+	Deliver(ctx context.Context, report Report) error
+}
+
+type webhookDeliverer struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhookDeliverer returns a deliverer posting reports as JSON to the
+// given URL.
+func NewWebhookDeliverer(url string) Deliverer {
+	return &webhookDeliverer{
+		url:    url,
+		client: http.DefaultClient,
+	}
+}
+
+func (d *webhookDeliverer) Deliver(ctx context.Context, report Report) error {
+	data, err := report.JSON()
+	if err != nil {
+		return errors.Wrap(errDeliverReport, err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.url, bytes.NewReader(data))
+	if err != nil {
+		return errors.Wrap(errDeliverReport, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return errors.Wrap(errDeliverReport, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return errors.Wrap(errDeliverReport, fmt.Errorf("unexpected response code %d", resp.StatusCode))
+	}
+	return nil
+}
+
+type emailDeliverer struct {
+	agent *email.Agent
+	to    []string
+}
+
+// NewEmailDeliverer returns a deliverer mailing reports as CSV to the given
+// recipients.
+func NewEmailDeliverer(c *email.Config, to []string) (Deliverer, error) {
+	agent, err := email.New(c)
+	if err != nil {
+		return nil, err
+	}
+	return &emailDeliverer{
+		agent: agent,
+		to:    to,
+	}, nil
+}
+
+func (d *emailDeliverer) Deliver(_ context.Context, report Report) error {
+	data, err := report.CSV()
+	if err != nil {
+		return errors.Wrap(errDeliverReport, err)
+	}
+	subject := fmt.Sprintf("Usage report %s", report.Period)
+	if err := d.agent.Send(d.to, "", subject, "", "", string(data), ""); err != nil {
+		return errors.Wrap(errDeliverReport, err)
+	}
+	return nil
+}
+
+// Reporter generates a usage report at the end of every month and delivers
+// it to the configured deliverers.
+type Reporter struct {
+	meter      *Meter
+	domains    DomainLister
+	deliverers []Deliverer
+	logger     *slog.Logger
+}
+
+// NewReporter creates a monthly usage reporter.
+func NewReporter(meter *Meter, domains DomainLister, logger *slog.Logger, deliverers ...Deliverer) *Reporter {
+	return &Reporter{
+		meter:      meter,
+		domains:    domains,
+		deliverers: deliverers,
+		logger:     logger,
+	}
+}
+
+// Start schedules report generation at the start of every month until the
+// context is canceled. Each report covers the month that just ended.
+func (r *Reporter) Start(ctx context.Context) {
+	go func() {
+		for {
+			now := time.Now().UTC()
+			next := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC).AddDate(0, 1, 0)
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(next.Sub(now)):
+				r.run(ctx, next.AddDate(0, 0, -1))
+			}
+		}
+	}()
+}
+
+func (r *Reporter) run(ctx context.Context, period time.Time) {
+	report, err := r.meter.Report(ctx, period, r.domains)
+	if err != nil {
+		r.logger.Warn(fmt.Sprintf("failed to generate usage report : %s", err))
+		return
+	}
+	for _, d := range r.deliverers {
+		if err := d.Deliver(ctx, report); err != nil {
+			r.logger.Warn(fmt.Sprintf("failed to deliver usage report : %s", err))
+		}
+	}
+}
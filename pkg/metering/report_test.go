@@ -0,0 +1,72 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package metering_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/absmach/supermq/pkg/metering"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReport(t *testing.T) {
+	meter := metering.New(metering.Quota{})
+	meter.RegisterCounter(metering.ResourceClients, func(ctx context.Context, domainID string) (uint64, error) {
+		return 3, nil
+	})
+	meter.RecordMessage(domainID)
+
+	report, err := meter.Report(context.Background(), time.Now(), func(ctx context.Context) ([]string, error) {
+		return []string{domainID}, nil
+	})
+	require.NoError(t, err)
+	require.Len(t, report.Usage, 1)
+	assert.Equal(t, domainID, report.Usage[0].DomainID)
+	assert.Equal(t, uint64(3), report.Usage[0].Resources[metering.ResourceClients])
+	assert.Equal(t, uint64(1), report.Usage[0].Resources[metering.ResourceMessages])
+
+	data, err := report.CSV()
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "period,domain_id,resource,count\n")
+	assert.Contains(t, string(data), report.Period+","+domainID+",clients,3\n")
+	assert.Contains(t, string(data), report.Period+","+domainID+",messages_per_day,1\n")
+
+	data, err = report.JSON()
+	require.NoError(t, err)
+	var decoded metering.Report
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, report.Period, decoded.Period)
+}
+
+func TestWebhookDeliverer(t *testing.T) {
+	var received metering.Report
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+	}))
+	defer srv.Close()
+
+	report := metering.Report{
+		Period: "2024-01",
+		Usage:  []metering.Usage{{DomainID: domainID, Resources: map[metering.Resource]uint64{metering.ResourceClients: 1}}},
+	}
+
+	err := metering.NewWebhookDeliverer(srv.URL).Deliver(context.Background(), report)
+	require.NoError(t, err)
+	assert.Equal(t, report.Period, received.Period)
+
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer failing.Close()
+
+	err = metering.NewWebhookDeliverer(failing.URL).Deliver(context.Background(), report)
+	assert.Error(t, err)
+}
@@ -0,0 +1,6 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package metrics contains OpenTelemetry metrics provider setup and
+// RED (rate, errors, duration) endpoint instrumentation.
+package metrics
@@ -0,0 +1,113 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"time"
+
+	"github.com/go-kit/kit/endpoint"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
+	otelprom "go.opentelemetry.io/otel/exporters/prometheus"
+	"go.opentelemetry.io/otel/metric"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/resource"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+)
+
+const meterName = "github.com/absmach/supermq/pkg/metrics"
+
+var (
+	errNoSvcName                   = errors.New("service Name is empty")
+	errUnsupportedMetricsURLScheme = errors.New("unsupported metrics url scheme")
+)
+
+// NewProvider initializes an OpenTelemetry MeterProvider. An empty URL
+// selects the Prometheus exporter that serves metrics from the default
+// registry, while http and https URLs select the OTLP/HTTP exporter,
+// mirroring the Jaeger tracing setup.
+//
+//	mp, err := metrics.NewProvider(ctx, "demo-service", metricsURL, "2cb32911-6833-469c-9cad-4d3e93c528d8")
+func NewProvider(ctx context.Context, svcName string, metricsURL url.URL, instanceID string) (*sdkmetric.MeterProvider, error) {
+	if svcName == "" {
+		return nil, errNoSvcName
+	}
+
+	var reader sdkmetric.Reader
+	switch metricsURL.Scheme {
+	case "":
+		exporter, err := otelprom.New()
+		if err != nil {
+			return nil, err
+		}
+		reader = exporter
+	case "http":
+		exporter, err := otlpmetrichttp.New(ctx, otlpmetrichttp.WithEndpoint(metricsURL.Host), otlpmetrichttp.WithURLPath(metricsURL.Path), otlpmetrichttp.WithInsecure())
+		if err != nil {
+			return nil, err
+		}
+		reader = sdkmetric.NewPeriodicReader(exporter)
+	case "https":
+		exporter, err := otlpmetrichttp.New(ctx, otlpmetrichttp.WithEndpoint(metricsURL.Host), otlpmetrichttp.WithURLPath(metricsURL.Path))
+		if err != nil {
+			return nil, err
+		}
+		reader = sdkmetric.NewPeriodicReader(exporter)
+	default:
+		return nil, errUnsupportedMetricsURLScheme
+	}
+
+	attributes := []attribute.KeyValue{
+		semconv.ServiceNameKey.String(svcName),
+		attribute.String("host.id", instanceID),
+	}
+
+	hostAttr, err := resource.New(ctx, resource.WithHost(), resource.WithOSDescription(), resource.WithContainer())
+	if err != nil {
+		return nil, err
+	}
+	attributes = append(attributes, hostAttr.Attributes()...)
+
+	mp := sdkmetric.NewMeterProvider(
+		sdkmetric.WithReader(reader),
+		sdkmetric.WithResource(resource.NewWithAttributes(
+			semconv.SchemaURL,
+			attributes...,
+		)),
+	)
+	otel.SetMeterProvider(mp)
+
+	return mp, nil
+}
+
+// Endpoint returns a go-kit endpoint middleware that records RED
+// metrics (request rate, errors and duration) for the named endpoint.
+func Endpoint(svcName, name string) endpoint.Middleware {
+	meter := otel.Meter(meterName)
+	requests, _ := meter.Int64Counter("requests_total", metric.WithDescription("Number of requests received."))
+	failures, _ := meter.Int64Counter("errors_total", metric.WithDescription("Number of requests that failed."))
+	duration, _ := meter.Float64Histogram("request_duration_seconds", metric.WithDescription("Duration of requests in seconds."), metric.WithUnit("s"))
+
+	attrs := metric.WithAttributes(
+		attribute.String("service", svcName),
+		attribute.String("endpoint", name),
+	)
+
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		return func(ctx context.Context, request interface{}) (interface{}, error) {
+			start := time.Now()
+			response, err := next(ctx, request)
+			requests.Add(ctx, 1, attrs)
+			if err != nil {
+				failures.Add(ctx, 1, attrs)
+			}
+			duration.Record(ctx, time.Since(start).Seconds(), attrs)
+			return response, err
+		}
+	}
+}
@@ -0,0 +1,9 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package outbox provides a coordinator for keeping service databases
+// and the policy store eventually consistent. Entity creation records a
+// pending policy operation in an outbox; the coordinator retries the
+// policy write and, when retries are exhausted, compensates by deleting
+// the orphaned entity.
+package outbox
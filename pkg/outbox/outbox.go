@@ -0,0 +1,287 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	smq "github.com/absmach/supermq"
+	apiutil "github.com/absmach/supermq/api/http/util"
+	"github.com/absmach/supermq/pkg/errors"
+	"github.com/absmach/supermq/pkg/policies"
+)
+
+// Operation statuses. An operation starts as pending, becomes completed
+// when the recorded policies are written, and becomes compensated when
+// the entity is deleted instead because the policies could not be
+// written.
+const (
+	StatusPending     = "pending"
+	StatusCompleted   = "completed"
+	StatusCompensated = "compensated"
+)
+
+const (
+	defLimit  = 10
+	batchSize = 100
+)
+
+var (
+	// ErrNoCompensator indicates that no compensator is registered for
+	// the entity type of an operation that has to be compensated.
+	ErrNoCompensator = errors.New("no compensator registered for entity type")
+
+	errRecordOp = errors.New("failed to record outbox operation")
+	errUpdateOp = errors.New("failed to update outbox operation")
+)
+
+// Operation is a pending policy write recorded alongside an entity
+// creation. It carries everything needed to retry the write or to
+// compensate by deleting the entity.
+type Operation struct {
+	ID         string            `json:"id"`
+	EntityType string            `json:"entity_type"`
+	EntityID   string            `json:"entity_id"`
+	DomainID   string            `json:"domain_id"`
+	Policies   []policies.Policy `json:"policies"`
+	Status     string            `json:"status"`
+	Attempts   uint64            `json:"attempts"`
+	LastError  string            `json:"last_error,omitempty"`
+	CreatedAt  time.Time         `json:"created_at"`
+	UpdatedAt  time.Time         `json:"updated_at"`
+}
+
+// OperationsPage contains a page of operations.
+type OperationsPage struct {
+	Total      uint64      `json:"total"`
+	Offset     uint64      `json:"offset"`
+	Limit      uint64      `json:"limit"`
+	Operations []Operation `json:"operations"`
+}
+
+// Repository specifies persistence for outbox operations.
+type Repository interface {
+	// Save persists a new operation.
+	Save(ctx context.Context, op Operation) error
+
+	// Update updates the status, attempts and last error of an
+	// existing operation.
+	Update(ctx context.Context, op Operation) error
+
+	// RetrievePending retrieves pending operations last updated before
+	// the given time, oldest first.
+	RetrievePending(ctx context.Context, updatedBefore time.Time, limit uint64) ([]Operation, error)
+
+	// RetrieveAll retrieves a page of operations with the given status.
+	// An empty status matches all operations.
+	RetrieveAll(ctx context.Context, status string, offset, limit uint64) (OperationsPage, error)
+}
+
+// Compensator deletes the entity of an operation whose policies could
+// not be written, restoring consistency between the service database
+// and the policy store.
+type Compensator func(ctx context.Context, op Operation) error
+
+// Coordinator records pending policy operations and drives them to
+// consistency: it retries the policy write up to a configured number of
+// times and then compensates by deleting the orphaned entity.
+type Coordinator struct {
+	repo        Repository
+	policy      policies.Service
+	idProvider  smq.IDProvider
+	maxAttempts uint64
+
+	mu           sync.RWMutex
+	compensators map[string]Compensator
+}
+
+// NewCoordinator returns a new outbox coordinator. Operations are
+// retried up to maxAttempts times before being compensated.
+func NewCoordinator(repo Repository, policy policies.Service, idProvider smq.IDProvider, maxAttempts uint64) *Coordinator {
+	return &Coordinator{
+		repo:         repo,
+		policy:       policy,
+		idProvider:   idProvider,
+		maxAttempts:  maxAttempts,
+		compensators: make(map[string]Compensator),
+	}
+}
+
+// RegisterCompensator registers the compensator used to delete entities
+// of the given type.
+func (c *Coordinator) RegisterCompensator(entityType string, comp Compensator) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.compensators[entityType] = comp
+}
+
+// Record persists a pending operation for the given entity. It is
+// called after the entity is saved and before its policies are written,
+// so that a crash in between leaves a pending operation behind.
+func (c *Coordinator) Record(ctx context.Context, entityType, entityID, domainID string, prs []policies.Policy) (Operation, error) {
+	id, err := c.idProvider.ID()
+	if err != nil {
+		return Operation{}, errors.Wrap(errRecordOp, err)
+	}
+	op := Operation{
+		ID:         id,
+		EntityType: entityType,
+		EntityID:   entityID,
+		DomainID:   domainID,
+		Policies:   prs,
+		Status:     StatusPending,
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
+	}
+	if err := c.repo.Save(ctx, op); err != nil {
+		return Operation{}, errors.Wrap(errRecordOp, err)
+	}
+	return op, nil
+}
+
+// Complete marks an operation as completed after its policies have been
+// written.
+func (c *Coordinator) Complete(ctx context.Context, op Operation) error {
+	op.Status = StatusCompleted
+	op.LastError = ""
+	op.UpdatedAt = time.Now()
+	if err := c.repo.Update(ctx, op); err != nil {
+		return errors.Wrap(errUpdateOp, err)
+	}
+	return nil
+}
+
+// Cancel marks an operation as compensated after the entity has been
+// rolled back inline by the caller.
+func (c *Coordinator) Cancel(ctx context.Context, op Operation) error {
+	op.Status = StatusCompensated
+	op.UpdatedAt = time.Now()
+	if err := c.repo.Update(ctx, op); err != nil {
+		return errors.Wrap(errUpdateOp, err)
+	}
+	return nil
+}
+
+// Sync processes pending operations last updated before the given grace
+// period. Operations within the grace period are skipped, since their
+// policy writes may still be in flight.
+func (c *Coordinator) Sync(ctx context.Context, grace time.Duration) error {
+	ops, err := c.repo.RetrievePending(ctx, time.Now().Add(-grace), batchSize)
+	if err != nil {
+		return err
+	}
+	for _, op := range ops {
+		if err := c.process(ctx, op); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// process retries the policy write of a pending operation, or
+// compensates when the retries are exhausted. Failures are recorded on
+// the operation so that the admin view shows why it is stuck.
+func (c *Coordinator) process(ctx context.Context, op Operation) error {
+	op.Attempts++
+	op.UpdatedAt = time.Now()
+	switch {
+	case op.Attempts <= c.maxAttempts:
+		if err := c.policy.AddPolicies(ctx, op.Policies); err != nil {
+			op.LastError = err.Error()
+			break
+		}
+		op.Status = StatusCompleted
+		op.LastError = ""
+	default:
+		if err := c.compensate(ctx, op); err != nil {
+			op.LastError = err.Error()
+			break
+		}
+		op.Status = StatusCompensated
+		op.LastError = ""
+	}
+	if err := c.repo.Update(ctx, op); err != nil {
+		return errors.Wrap(errUpdateOp, err)
+	}
+	return nil
+}
+
+// compensate removes any policies that may have been written and
+// deletes the entity through the registered compensator.
+func (c *Coordinator) compensate(ctx context.Context, op Operation) error {
+	c.mu.RLock()
+	comp, ok := c.compensators[op.EntityType]
+	c.mu.RUnlock()
+	if !ok {
+		return ErrNoCompensator
+	}
+	if err := c.policy.DeletePolicies(ctx, op.Policies); err != nil {
+		return err
+	}
+	return comp(ctx, op)
+}
+
+// Start runs the coordinator in the background, syncing pending
+// operations on every interval until the context is canceled. The
+// interval doubles as the grace period for in-flight operations.
+func (c *Coordinator) Start(ctx context.Context, interval time.Duration, logger *slog.Logger) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := c.Sync(ctx, interval); err != nil {
+					logger.Warn(fmt.Sprintf("failed to sync policy outbox : %s", err))
+				}
+			}
+		}
+	}()
+}
+
+// Handler returns an HTTP handler exposing recorded operations, so that
+// administrators can inspect stuck ones. Operations are filtered with
+// the status query parameter, which defaults to pending. The recorded
+// policy tuples are sensitive, so the handler must be mounted behind
+// platform-admin authorization.
+func (c *Coordinator) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		status, err := apiutil.ReadStringQuery(r, "status", StatusPending)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		offset, err := apiutil.ReadNumQuery[uint64](r, "offset", 0)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		limit, err := apiutil.ReadNumQuery[uint64](r, "limit", defLimit)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		page, err := c.repo.RetrieveAll(r.Context(), status, offset, limit)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(page); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
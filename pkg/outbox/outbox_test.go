@@ -0,0 +1,219 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package outbox_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/absmach/supermq/pkg/errors"
+	"github.com/absmach/supermq/pkg/outbox"
+	"github.com/absmach/supermq/pkg/policies"
+	policymocks "github.com/absmach/supermq/pkg/policies/mocks"
+	"github.com/absmach/supermq/pkg/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testPolicies = []policies.Policy{
+	{
+		Domain:      "domain",
+		SubjectType: policies.DomainType,
+		Subject:     "domain",
+		Relation:    policies.DomainRelation,
+		ObjectType:  policies.ClientType,
+		Object:      "client",
+	},
+}
+
+// memRepo is an in-memory outbox repository used to exercise the
+// coordinator without a database.
+type memRepo struct {
+	mu  sync.Mutex
+	ops map[string]outbox.Operation
+}
+
+func newMemRepo() *memRepo {
+	return &memRepo{ops: make(map[string]outbox.Operation)}
+}
+
+func (r *memRepo) Save(ctx context.Context, op outbox.Operation) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.ops[op.ID] = op
+	return nil
+}
+
+func (r *memRepo) Update(ctx context.Context, op outbox.Operation) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.ops[op.ID]; !ok {
+		return errors.New("not found")
+	}
+	r.ops[op.ID] = op
+	return nil
+}
+
+func (r *memRepo) RetrievePending(ctx context.Context, updatedBefore time.Time, limit uint64) ([]outbox.Operation, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var ops []outbox.Operation
+	for _, op := range r.ops {
+		if op.Status == outbox.StatusPending && op.UpdatedAt.Before(updatedBefore) {
+			ops = append(ops, op)
+		}
+	}
+	return ops, nil
+}
+
+func (r *memRepo) RetrieveAll(ctx context.Context, status string, offset, limit uint64) (outbox.OperationsPage, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var ops []outbox.Operation
+	for _, op := range r.ops {
+		if status == "" || op.Status == status {
+			ops = append(ops, op)
+		}
+	}
+	return outbox.OperationsPage{
+		Total:      uint64(len(ops)),
+		Offset:     offset,
+		Limit:      limit,
+		Operations: ops,
+	}, nil
+}
+
+func (r *memRepo) get(id string) outbox.Operation {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.ops[id]
+}
+
+func TestSyncRetriesUntilCompleted(t *testing.T) {
+	repo := newMemRepo()
+	policy := new(policymocks.Service)
+	c := outbox.NewCoordinator(repo, policy, uuid.NewMock(), 3)
+
+	op, err := c.Record(context.Background(), policies.ClientType, "client", "domain", testPolicies)
+	require.NoError(t, err)
+
+	policy.On("AddPolicies", context.Background(), testPolicies).Return(errors.New("unavailable")).Once()
+	policy.On("AddPolicies", context.Background(), testPolicies).Return(nil).Once()
+
+	err = c.Sync(context.Background(), 0)
+	require.NoError(t, err)
+	assert.Equal(t, outbox.StatusPending, repo.get(op.ID).Status)
+	assert.NotEmpty(t, repo.get(op.ID).LastError)
+
+	err = c.Sync(context.Background(), 0)
+	require.NoError(t, err)
+	assert.Equal(t, outbox.StatusCompleted, repo.get(op.ID).Status)
+	assert.Empty(t, repo.get(op.ID).LastError)
+}
+
+func TestSyncCompensatesAfterRetriesExhausted(t *testing.T) {
+	repo := newMemRepo()
+	policy := new(policymocks.Service)
+	c := outbox.NewCoordinator(repo, policy, uuid.NewMock(), 1)
+
+	deleted := []string{}
+	c.RegisterCompensator(policies.ClientType, func(ctx context.Context, op outbox.Operation) error {
+		deleted = append(deleted, op.EntityID)
+		return nil
+	})
+
+	op, err := c.Record(context.Background(), policies.ClientType, "client", "domain", testPolicies)
+	require.NoError(t, err)
+
+	policy.On("AddPolicies", context.Background(), testPolicies).Return(errors.New("unavailable")).Once()
+	policy.On("DeletePolicies", context.Background(), testPolicies).Return(nil).Once()
+
+	err = c.Sync(context.Background(), 0)
+	require.NoError(t, err)
+	assert.Equal(t, outbox.StatusPending, repo.get(op.ID).Status)
+
+	err = c.Sync(context.Background(), 0)
+	require.NoError(t, err)
+	assert.Equal(t, outbox.StatusCompensated, repo.get(op.ID).Status)
+	assert.Equal(t, []string{"client"}, deleted)
+}
+
+func TestSyncWithoutCompensator(t *testing.T) {
+	repo := newMemRepo()
+	policy := new(policymocks.Service)
+	c := outbox.NewCoordinator(repo, policy, uuid.NewMock(), 0)
+
+	op, err := c.Record(context.Background(), policies.ClientType, "client", "domain", testPolicies)
+	require.NoError(t, err)
+
+	err = c.Sync(context.Background(), 0)
+	require.NoError(t, err)
+	assert.Equal(t, outbox.StatusPending, repo.get(op.ID).Status)
+	assert.Equal(t, outbox.ErrNoCompensator.Error(), repo.get(op.ID).LastError)
+}
+
+func TestSyncSkipsRecentOperations(t *testing.T) {
+	repo := newMemRepo()
+	policy := new(policymocks.Service)
+	c := outbox.NewCoordinator(repo, policy, uuid.NewMock(), 3)
+
+	op, err := c.Record(context.Background(), policies.ClientType, "client", "domain", testPolicies)
+	require.NoError(t, err)
+
+	err = c.Sync(context.Background(), time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), repo.get(op.ID).Attempts)
+}
+
+func TestCompleteAndCancel(t *testing.T) {
+	repo := newMemRepo()
+	policy := new(policymocks.Service)
+	c := outbox.NewCoordinator(repo, policy, uuid.NewMock(), 3)
+
+	op, err := c.Record(context.Background(), policies.ClientType, "client", "domain", testPolicies)
+	require.NoError(t, err)
+
+	err = c.Complete(context.Background(), op)
+	require.NoError(t, err)
+	assert.Equal(t, outbox.StatusCompleted, repo.get(op.ID).Status)
+
+	op, err = c.Record(context.Background(), policies.ClientType, "client", "domain", testPolicies)
+	require.NoError(t, err)
+
+	err = c.Cancel(context.Background(), op)
+	require.NoError(t, err)
+	assert.Equal(t, outbox.StatusCompensated, repo.get(op.ID).Status)
+}
+
+func TestHandler(t *testing.T) {
+	repo := newMemRepo()
+	policy := new(policymocks.Service)
+	c := outbox.NewCoordinator(repo, policy, uuid.NewMock(), 3)
+
+	_, err := c.Record(context.Background(), policies.ClientType, "client", "domain", testPolicies)
+	require.NoError(t, err)
+
+	srv := httptest.NewServer(c.Handler())
+	defer srv.Close()
+
+	res, err := http.Get(srv.URL + "?status=pending")
+	require.NoError(t, err)
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	var page outbox.OperationsPage
+	err = json.NewDecoder(res.Body).Decode(&page)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), page.Total)
+
+	res, err = http.Post(srv.URL, "application/json", nil)
+	require.NoError(t, err)
+	res.Body.Close()
+	assert.Equal(t, http.StatusMethodNotAllowed, res.StatusCode)
+}
@@ -0,0 +1,37 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	_ "github.com/jackc/pgx/v5/stdlib" // required for SQL access
+	migrate "github.com/rubenv/sql-migrate"
+)
+
+func Migration() *migrate.MemoryMigrationSource {
+	return &migrate.MemoryMigrationSource{
+		Migrations: []*migrate.Migration{
+			{
+				Id: "policy_outbox_01",
+				Up: []string{
+					`CREATE TABLE IF NOT EXISTS policy_outbox (
+						id          VARCHAR(36) PRIMARY KEY,
+						entity_type VARCHAR(254) NOT NULL,
+						entity_id   VARCHAR(36) NOT NULL,
+						domain_id   VARCHAR(36),
+						policies    JSONB NOT NULL,
+						status      VARCHAR(36) NOT NULL DEFAULT 'pending',
+						attempts    BIGINT NOT NULL DEFAULT 0,
+						last_error  TEXT,
+						created_at  TIMESTAMP,
+						updated_at  TIMESTAMP
+					)`,
+					`CREATE INDEX idx_policy_outbox_status ON policy_outbox(status, updated_at)`,
+				},
+				Down: []string{
+					`DROP TABLE IF EXISTS policy_outbox`,
+				},
+			},
+		},
+	}
+}
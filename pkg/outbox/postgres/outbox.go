@@ -0,0 +1,198 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/absmach/supermq/pkg/errors"
+	repoerr "github.com/absmach/supermq/pkg/errors/repository"
+	"github.com/absmach/supermq/pkg/outbox"
+	"github.com/absmach/supermq/pkg/policies"
+	"github.com/absmach/supermq/pkg/postgres"
+)
+
+type repository struct {
+	db postgres.Database
+}
+
+// NewRepository returns a PostgreSQL implementation of the outbox
+// repository.
+func NewRepository(db postgres.Database) outbox.Repository {
+	return &repository{db: db}
+}
+
+func (repo *repository) Save(ctx context.Context, op outbox.Operation) error {
+	q := `INSERT INTO policy_outbox (id, entity_type, entity_id, domain_id, policies, status, attempts, last_error, created_at, updated_at)
+		VALUES (:id, :entity_type, :entity_id, :domain_id, :policies, :status, :attempts, :last_error, :created_at, :updated_at);`
+
+	dbOp, err := toDBOperation(op)
+	if err != nil {
+		return errors.Wrap(repoerr.ErrCreateEntity, err)
+	}
+
+	if _, err := repo.db.NamedExecContext(ctx, q, dbOp); err != nil {
+		return postgres.HandleError(repoerr.ErrCreateEntity, err)
+	}
+
+	return nil
+}
+
+func (repo *repository) Update(ctx context.Context, op outbox.Operation) error {
+	q := `UPDATE policy_outbox SET status = :status, attempts = :attempts, last_error = :last_error, updated_at = :updated_at
+		WHERE id = :id;`
+
+	dbOp, err := toDBOperation(op)
+	if err != nil {
+		return errors.Wrap(repoerr.ErrUpdateEntity, err)
+	}
+
+	res, err := repo.db.NamedExecContext(ctx, q, dbOp)
+	if err != nil {
+		return postgres.HandleError(repoerr.ErrUpdateEntity, err)
+	}
+	count, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(repoerr.ErrUpdateEntity, err)
+	}
+	if count == 0 {
+		return repoerr.ErrNotFound
+	}
+
+	return nil
+}
+
+func (repo *repository) RetrievePending(ctx context.Context, updatedBefore time.Time, limit uint64) ([]outbox.Operation, error) {
+	q := `SELECT id, entity_type, entity_id, domain_id, policies, status, attempts, last_error, created_at, updated_at
+		FROM policy_outbox WHERE status = :status AND updated_at < :updated_at ORDER BY updated_at ASC LIMIT :limit;`
+
+	params := dbOperation{
+		Status:    outbox.StatusPending,
+		UpdatedAt: updatedBefore,
+		Limit:     limit,
+	}
+	rows, err := repo.db.NamedQueryContext(ctx, q, params)
+	if err != nil {
+		return nil, postgres.HandleError(repoerr.ErrViewEntity, err)
+	}
+	defer rows.Close()
+
+	var ops []outbox.Operation
+	for rows.Next() {
+		var dbOp dbOperation
+		if err := rows.StructScan(&dbOp); err != nil {
+			return nil, postgres.HandleError(repoerr.ErrViewEntity, err)
+		}
+		op, err := toOperation(dbOp)
+		if err != nil {
+			return nil, errors.Wrap(repoerr.ErrViewEntity, err)
+		}
+		ops = append(ops, op)
+	}
+
+	return ops, nil
+}
+
+func (repo *repository) RetrieveAll(ctx context.Context, status string, offset, limit uint64) (outbox.OperationsPage, error) {
+	filter := ""
+	if status != "" {
+		filter = "WHERE status = :status"
+	}
+	q := `SELECT id, entity_type, entity_id, domain_id, policies, status, attempts, last_error, created_at, updated_at
+		FROM policy_outbox ` + filter + ` ORDER BY created_at ASC LIMIT :limit OFFSET :offset;`
+
+	params := dbOperation{
+		Status: status,
+		Limit:  limit,
+		Offset: offset,
+	}
+	rows, err := repo.db.NamedQueryContext(ctx, q, params)
+	if err != nil {
+		return outbox.OperationsPage{}, postgres.HandleError(repoerr.ErrViewEntity, err)
+	}
+	defer rows.Close()
+
+	var ops []outbox.Operation
+	for rows.Next() {
+		var dbOp dbOperation
+		if err := rows.StructScan(&dbOp); err != nil {
+			return outbox.OperationsPage{}, postgres.HandleError(repoerr.ErrViewEntity, err)
+		}
+		op, err := toOperation(dbOp)
+		if err != nil {
+			return outbox.OperationsPage{}, errors.Wrap(repoerr.ErrViewEntity, err)
+		}
+		ops = append(ops, op)
+	}
+
+	cq := `SELECT COUNT(*) FROM policy_outbox ` + filter + `;`
+	total, err := postgres.Total(ctx, repo.db, cq, params)
+	if err != nil {
+		return outbox.OperationsPage{}, errors.Wrap(repoerr.ErrViewEntity, err)
+	}
+
+	return outbox.OperationsPage{
+		Total:      total,
+		Offset:     offset,
+		Limit:      limit,
+		Operations: ops,
+	}, nil
+}
+
+type dbOperation struct {
+	ID         string    `db:"id"`
+	EntityType string    `db:"entity_type"`
+	EntityID   string    `db:"entity_id"`
+	DomainID   string    `db:"domain_id"`
+	Policies   []byte    `db:"policies"`
+	Status     string    `db:"status"`
+	Attempts   uint64    `db:"attempts"`
+	LastError  string    `db:"last_error"`
+	CreatedAt  time.Time `db:"created_at"`
+	UpdatedAt  time.Time `db:"updated_at"`
+	Limit      uint64    `db:"limit"`
+	Offset     uint64    `db:"offset"`
+}
+
+func toDBOperation(op outbox.Operation) (dbOperation, error) {
+	prs, err := json.Marshal(op.Policies)
+	if err != nil {
+		return dbOperation{}, errors.Wrap(errors.ErrMalformedEntity, err)
+	}
+
+	return dbOperation{
+		ID:         op.ID,
+		EntityType: op.EntityType,
+		EntityID:   op.EntityID,
+		DomainID:   op.DomainID,
+		Policies:   prs,
+		Status:     op.Status,
+		Attempts:   op.Attempts,
+		LastError:  op.LastError,
+		CreatedAt:  op.CreatedAt,
+		UpdatedAt:  op.UpdatedAt,
+	}, nil
+}
+
+func toOperation(dbOp dbOperation) (outbox.Operation, error) {
+	var prs []policies.Policy
+	if err := json.Unmarshal(dbOp.Policies, &prs); err != nil {
+		return outbox.Operation{}, errors.Wrap(errors.ErrMalformedEntity, err)
+	}
+
+	return outbox.Operation{
+		ID:         dbOp.ID,
+		EntityType: dbOp.EntityType,
+		EntityID:   dbOp.EntityID,
+		DomainID:   dbOp.DomainID,
+		Policies:   prs,
+		Status:     dbOp.Status,
+		Attempts:   dbOp.Attempts,
+		LastError:  dbOp.LastError,
+		CreatedAt:  dbOp.CreatedAt,
+		UpdatedAt:  dbOp.UpdatedAt,
+	}, nil
+}
@@ -0,0 +1,148 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package cache
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/absmach/supermq/pkg/policies"
+)
+
+var _ policies.Service = (*policyCache)(nil)
+
+type entry struct {
+	page      policies.PolicyPage
+	expiresAt time.Time
+}
+
+// policyCache keeps recently listed allowed-object sets in memory for a short
+// period, so that bursts of list requests from the same user skip the policy
+// engine round trip. Every policy change made through this service drops the
+// entries of the affected subject.
+type policyCache struct {
+	svc policies.Service
+	ttl time.Duration
+
+	mu      sync.Mutex
+	entries map[string]map[string]entry
+}
+
+// NewMiddleware returns a policy service that caches ListAllObjects results
+// per subject for the given duration.
+func NewMiddleware(svc policies.Service, ttl time.Duration) policies.Service {
+	return &policyCache{
+		svc:     svc,
+		ttl:     ttl,
+		entries: make(map[string]map[string]entry),
+	}
+}
+
+func (pc *policyCache) AddPolicy(ctx context.Context, pr policies.Policy) error {
+	if err := pc.svc.AddPolicy(ctx, pr); err != nil {
+		return err
+	}
+	pc.invalidate(pr.Subject)
+
+	return nil
+}
+
+func (pc *policyCache) AddPolicies(ctx context.Context, prs []policies.Policy) error {
+	if err := pc.svc.AddPolicies(ctx, prs); err != nil {
+		return err
+	}
+	for _, pr := range prs {
+		pc.invalidate(pr.Subject)
+	}
+
+	return nil
+}
+
+func (pc *policyCache) DeletePolicyFilter(ctx context.Context, pr policies.Policy) error {
+	if err := pc.svc.DeletePolicyFilter(ctx, pr); err != nil {
+		return err
+	}
+	pc.invalidate(pr.Subject)
+
+	return nil
+}
+
+func (pc *policyCache) DeletePolicies(ctx context.Context, prs []policies.Policy) error {
+	if err := pc.svc.DeletePolicies(ctx, prs); err != nil {
+		return err
+	}
+	for _, pr := range prs {
+		pc.invalidate(pr.Subject)
+	}
+
+	return nil
+}
+
+func (pc *policyCache) ListObjects(ctx context.Context, pr policies.Policy, nextPageToken string, limit uint64) (policies.PolicyPage, error) {
+	return pc.svc.ListObjects(ctx, pr, nextPageToken, limit)
+}
+
+func (pc *policyCache) ListAllObjects(ctx context.Context, pr policies.Policy) (policies.PolicyPage, error) {
+	key := strings.Join([]string{pr.Domain, pr.SubjectType, pr.SubjectRelation, pr.Permission, pr.ObjectType, pr.Object}, "\x1f")
+
+	pc.mu.Lock()
+	if e, ok := pc.entries[pr.Subject][key]; ok && time.Now().Before(e.expiresAt) {
+		pc.mu.Unlock()
+		return e.page, nil
+	}
+	pc.mu.Unlock()
+
+	page, err := pc.svc.ListAllObjects(ctx, pr)
+	if err != nil {
+		return policies.PolicyPage{}, err
+	}
+
+	pc.mu.Lock()
+	if _, ok := pc.entries[pr.Subject]; !ok {
+		pc.entries[pr.Subject] = make(map[string]entry)
+	}
+	pc.entries[pr.Subject][key] = entry{
+		page:      page,
+		expiresAt: time.Now().Add(pc.ttl),
+	}
+	pc.mu.Unlock()
+
+	return page, nil
+}
+
+func (pc *policyCache) CountObjects(ctx context.Context, pr policies.Policy) (uint64, error) {
+	return pc.svc.CountObjects(ctx, pr)
+}
+
+func (pc *policyCache) ListSubjects(ctx context.Context, pr policies.Policy, nextPageToken string, limit uint64) (policies.PolicyPage, error) {
+	return pc.svc.ListSubjects(ctx, pr, nextPageToken, limit)
+}
+
+func (pc *policyCache) ListAllSubjects(ctx context.Context, pr policies.Policy) (policies.PolicyPage, error) {
+	return pc.svc.ListAllSubjects(ctx, pr)
+}
+
+func (pc *policyCache) CountSubjects(ctx context.Context, pr policies.Policy) (uint64, error) {
+	return pc.svc.CountSubjects(ctx, pr)
+}
+
+func (pc *policyCache) ListPermissions(ctx context.Context, pr policies.Policy, permissionsFilter []string) (policies.Permissions, error) {
+	return pc.svc.ListPermissions(ctx, pr, permissionsFilter)
+}
+
+// invalidate drops the cached listings of the given subject. Changes without
+// a subject, such as deletes filtered by object, may affect any subject, so
+// the whole cache is dropped.
+func (pc *policyCache) invalidate(subject string) {
+	pc.mu.Lock()
+	defer pc.mu.Unlock()
+
+	if subject == "" {
+		pc.entries = make(map[string]map[string]entry)
+		return
+	}
+	delete(pc.entries, subject)
+}
@@ -0,0 +1,48 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package events
+
+import (
+	"github.com/absmach/supermq/pkg/events"
+	"github.com/absmach/supermq/pkg/policies"
+)
+
+const (
+	policyPrefix       = "policy."
+	policyAdd          = policyPrefix + "add"
+	policyDelete       = policyPrefix + "delete"
+	policyDeleteFilter = policyPrefix + "delete_filter"
+)
+
+var _ events.Event = (*policyEvent)(nil)
+
+type policyEvent struct {
+	policies.Policy
+	operation string
+}
+
+func (pe policyEvent) Encode() (map[string]interface{}, error) {
+	val := map[string]interface{}{
+		"operation":    pe.operation,
+		"subject":      pe.Subject,
+		"subject_type": pe.SubjectType,
+		"object":       pe.Object,
+		"object_type":  pe.ObjectType,
+	}
+
+	if pe.Domain != "" {
+		val["domain"] = pe.Domain
+	}
+	if pe.SubjectRelation != "" {
+		val["subject_relation"] = pe.SubjectRelation
+	}
+	if pe.Relation != "" {
+		val["relation"] = pe.Relation
+	}
+	if pe.Permission != "" {
+		val["permission"] = pe.Permission
+	}
+
+	return val, nil
+}
@@ -0,0 +1,107 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package events
+
+import (
+	"context"
+
+	"github.com/absmach/supermq/pkg/events"
+	"github.com/absmach/supermq/pkg/events/store"
+	"github.com/absmach/supermq/pkg/policies"
+)
+
+const streamID = "supermq.policies"
+
+var _ policies.Service = (*eventStore)(nil)
+
+type eventStore struct {
+	events.Publisher
+	svc policies.Service
+}
+
+// NewEventStoreMiddleware returns wrapper around policy service that sends
+// policy changes to event store.
+func NewEventStoreMiddleware(ctx context.Context, svc policies.Service, url string) (policies.Service, error) {
+	publisher, err := store.NewPublisher(ctx, url, streamID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &eventStore{
+		svc:       svc,
+		Publisher: publisher,
+	}, nil
+}
+
+func (es *eventStore) AddPolicy(ctx context.Context, pr policies.Policy) error {
+	if err := es.svc.AddPolicy(ctx, pr); err != nil {
+		return err
+	}
+
+	return es.Publish(ctx, policyEvent{Policy: pr, operation: policyAdd})
+}
+
+func (es *eventStore) AddPolicies(ctx context.Context, prs []policies.Policy) error {
+	if err := es.svc.AddPolicies(ctx, prs); err != nil {
+		return err
+	}
+
+	for _, pr := range prs {
+		if err := es.Publish(ctx, policyEvent{Policy: pr, operation: policyAdd}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (es *eventStore) DeletePolicyFilter(ctx context.Context, pr policies.Policy) error {
+	if err := es.svc.DeletePolicyFilter(ctx, pr); err != nil {
+		return err
+	}
+
+	return es.Publish(ctx, policyEvent{Policy: pr, operation: policyDeleteFilter})
+}
+
+func (es *eventStore) DeletePolicies(ctx context.Context, prs []policies.Policy) error {
+	if err := es.svc.DeletePolicies(ctx, prs); err != nil {
+		return err
+	}
+
+	for _, pr := range prs {
+		if err := es.Publish(ctx, policyEvent{Policy: pr, operation: policyDelete}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (es *eventStore) ListObjects(ctx context.Context, pr policies.Policy, nextPageToken string, limit uint64) (policies.PolicyPage, error) {
+	return es.svc.ListObjects(ctx, pr, nextPageToken, limit)
+}
+
+func (es *eventStore) ListAllObjects(ctx context.Context, pr policies.Policy) (policies.PolicyPage, error) {
+	return es.svc.ListAllObjects(ctx, pr)
+}
+
+func (es *eventStore) CountObjects(ctx context.Context, pr policies.Policy) (uint64, error) {
+	return es.svc.CountObjects(ctx, pr)
+}
+
+func (es *eventStore) ListSubjects(ctx context.Context, pr policies.Policy, nextPageToken string, limit uint64) (policies.PolicyPage, error) {
+	return es.svc.ListSubjects(ctx, pr, nextPageToken, limit)
+}
+
+func (es *eventStore) ListAllSubjects(ctx context.Context, pr policies.Policy) (policies.PolicyPage, error) {
+	return es.svc.ListAllSubjects(ctx, pr)
+}
+
+func (es *eventStore) CountSubjects(ctx context.Context, pr policies.Policy) (uint64, error) {
+	return es.svc.CountSubjects(ctx, pr)
+}
+
+func (es *eventStore) ListPermissions(ctx context.Context, pr policies.Policy, permissionsFilter []string) (policies.Permissions, error) {
+	return es.svc.ListPermissions(ctx, pr, permissionsFilter)
+}
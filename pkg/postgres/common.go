@@ -7,6 +7,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+
+	"github.com/absmach/supermq/pkg/labels"
+	"github.com/lib/pq"
 )
 
 // CreateMetadataQuery creates a query to filter by metadata.
@@ -30,6 +33,52 @@ func CreateMetadataQuery(entity string, um map[string]interface{}) (string, []by
 	return query, param, nil
 }
 
+// CreateLabelSelectorQuery creates a query to filter by a label selector.
+// The selector is bound as a single JSONB parameter and evaluated row by
+// row in SQL, so no part of it is interpolated into the query. An entity
+// matches when none of the selector requirements fail.
+//
+// For example:
+//
+//	query, param, err := CreateLabelSelectorQuery("", sel)
+func CreateLabelSelectorQuery(entity string, sel labels.Selector) (string, []byte, error) {
+	if len(sel) == 0 {
+		return "", nil, nil
+	}
+
+	param, err := json.Marshal(sel)
+	if err != nil {
+		return "", nil, err
+	}
+	lbl := entity + "labels"
+	query := fmt.Sprintf(`NOT EXISTS (
+		SELECT 1 FROM jsonb_array_elements(:label_selector) AS req
+		WHERE NOT COALESCE(
+			CASE req->>'op'
+				WHEN '=' THEN %s->>(req->>'key') = req->'values'->>0
+				WHEN '!=' THEN %s->>(req->>'key') IS DISTINCT FROM req->'values'->>0
+				WHEN 'in' THEN %s->>(req->>'key') IN (SELECT jsonb_array_elements_text(req->'values'))
+				WHEN 'notin' THEN COALESCE(%s->>(req->>'key') NOT IN (SELECT jsonb_array_elements_text(req->'values')), TRUE)
+			END, FALSE))`, lbl, lbl, lbl, lbl)
+
+	return query, param, nil
+}
+
+// CreateIDsQuery creates a query to filter by a list of IDs. The IDs are
+// bound as the ids parameter instead of being interpolated into the query,
+// so they are safe to take from user input.
+//
+// For example:
+//
+//	query, param := CreateIDsQuery("", []string{"id1", "id2"})
+func CreateIDsQuery(entity string, ids []string) (string, pq.StringArray) {
+	if len(ids) == 0 {
+		return "", nil
+	}
+
+	return fmt.Sprintf("%sid = ANY(:ids)", entity), pq.StringArray(ids)
+}
+
 // Total returns the total number of rows.
 //
 // For example:
@@ -0,0 +1,60 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package events provides the tenant provisioning consumer for
+// schema-per-domain storage.
+package events
+
+import (
+	"context"
+
+	"github.com/absmach/supermq/pkg/events"
+	"github.com/absmach/supermq/pkg/events/store"
+	pgclient "github.com/absmach/supermq/pkg/postgres"
+	migrate "github.com/rubenv/sql-migrate"
+)
+
+const domainCreate = "domain.create"
+
+// Start method starts consuming messages received from Event store.
+func Start(ctx context.Context, consumer string, sub events.Subscriber, db pgclient.TenantDatabase, migrations migrate.MemoryMigrationSource) error {
+	subCfg := events.SubscriberConfig{
+		Consumer: consumer,
+		Stream:   store.StreamAllEvents,
+		Handler:  Handle(db, migrations),
+	}
+
+	return sub.Subscribe(ctx, subCfg)
+}
+
+// Handle returns an event handler provisioning the schema of every
+// created domain. Events of other operations are ignored.
+func Handle(db pgclient.TenantDatabase, migrations migrate.MemoryMigrationSource) handleFunc {
+	return func(ctx context.Context, event events.Event) error {
+		data, err := event.Encode()
+		if err != nil {
+			return err
+		}
+
+		operation, _ := data["operation"].(string)
+		if operation != domainCreate {
+			return nil
+		}
+		id, _ := data["id"].(string)
+		if id == "" {
+			return nil
+		}
+
+		return db.Provision(ctx, id, migrations)
+	}
+}
+
+type handleFunc func(ctx context.Context, event events.Event) error
+
+func (h handleFunc) Handle(ctx context.Context, event events.Event) error {
+	return h(ctx, event)
+}
+
+func (h handleFunc) Cancel() error {
+	return nil
+}
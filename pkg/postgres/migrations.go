@@ -0,0 +1,158 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/absmach/supermq/pkg/errors"
+	"github.com/jmoiron/sqlx"
+	migrate "github.com/rubenv/sql-migrate"
+)
+
+var (
+	errDirtyMigrations  = errors.New("database contains applied migrations unknown to this binary")
+	errUnknownDirection = errors.New("unknown migration direction")
+)
+
+// MigrationStatus describes a single migration and whether it has
+// been applied to the database.
+type MigrationStatus struct {
+	ID        string
+	Applied   bool
+	AppliedAt time.Time
+}
+
+// Migrate applies all unapplied up migrations and returns the number
+// of migrations applied.
+func Migrate(cfg Config, migrations migrate.MemoryMigrationSource) (int, error) {
+	db, err := Connect(cfg)
+	if err != nil {
+		return 0, err
+	}
+	defer db.Close()
+
+	if err := checkDirty(db, migrations); err != nil {
+		return 0, err
+	}
+
+	n, err := migrate.Exec(db.DB, "postgres", migrations, migrate.Up)
+	if err != nil {
+		return 0, errors.Wrap(errMigration, err)
+	}
+
+	return n, nil
+}
+
+// MigrateDown reverts the most recently applied migration and returns
+// the number of migrations reverted.
+func MigrateDown(cfg Config, migrations migrate.MemoryMigrationSource) (int, error) {
+	db, err := Connect(cfg)
+	if err != nil {
+		return 0, err
+	}
+	defer db.Close()
+
+	if err := checkDirty(db, migrations); err != nil {
+		return 0, err
+	}
+
+	n, err := migrate.ExecMax(db.DB, "postgres", migrations, migrate.Down, 1)
+	if err != nil {
+		return 0, errors.Wrap(errMigration, err)
+	}
+
+	return n, nil
+}
+
+// Status returns the state of every migration known to the source, as
+// well as applied migrations the source does not know about.
+func Status(cfg Config, migrations migrate.MemoryMigrationSource) ([]MigrationStatus, error) {
+	db, err := Connect(cfg)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	records, err := migrate.GetMigrationRecords(db.DB, "postgres")
+	if err != nil {
+		return nil, errors.Wrap(errMigration, err)
+	}
+	applied := make(map[string]time.Time, len(records))
+	for _, r := range records {
+		applied[r.Id] = r.AppliedAt
+	}
+
+	statuses := make([]MigrationStatus, 0, len(migrations.Migrations))
+	known := make(map[string]struct{}, len(migrations.Migrations))
+	for _, m := range migrations.Migrations {
+		known[m.Id] = struct{}{}
+		at, ok := applied[m.Id]
+		statuses = append(statuses, MigrationStatus{ID: m.Id, Applied: ok, AppliedAt: at})
+	}
+	for _, r := range records {
+		if _, ok := known[r.Id]; !ok {
+			statuses = append(statuses, MigrationStatus{ID: r.Id + " (unknown)", Applied: true, AppliedAt: r.AppliedAt})
+		}
+	}
+
+	return statuses, nil
+}
+
+// RunMigration executes the given migrate subcommand (up, down or
+// status) and returns a human-readable summary.
+func RunMigration(direction string, cfg Config, migrations migrate.MemoryMigrationSource) (string, error) {
+	switch direction {
+	case "up":
+		n, err := Migrate(cfg, migrations)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("applied %d migrations", n), nil
+	case "down":
+		n, err := MigrateDown(cfg, migrations)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("reverted %d migrations", n), nil
+	case "status":
+		statuses, err := Status(cfg, migrations)
+		if err != nil {
+			return "", err
+		}
+		summary := ""
+		for _, s := range statuses {
+			state := "pending"
+			if s.Applied {
+				state = fmt.Sprintf("applied at %s", s.AppliedAt.Format(time.RFC3339))
+			}
+			summary += fmt.Sprintf("%s: %s\n", s.ID, state)
+		}
+		return summary, nil
+	default:
+		return "", errUnknownDirection
+	}
+}
+
+// checkDirty reports an error if the database contains applied
+// migrations the given source does not know about, which indicates the
+// schema was modified by a different binary version.
+func checkDirty(db *sqlx.DB, migrations migrate.MemoryMigrationSource) error {
+	records, err := migrate.GetMigrationRecords(db.DB, "postgres")
+	if err != nil {
+		return errors.Wrap(errMigration, err)
+	}
+	known := make(map[string]struct{}, len(migrations.Migrations))
+	for _, m := range migrations.Migrations {
+		known[m.Id] = struct{}{}
+	}
+	for _, r := range records {
+		if _, ok := known[r.Id]; !ok {
+			return errors.Wrap(errDirtyMigrations, errors.New(r.Id))
+		}
+	}
+
+	return nil
+}
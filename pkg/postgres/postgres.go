@@ -5,6 +5,7 @@ package postgres
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/absmach/supermq/pkg/errors"
 	_ "github.com/jackc/pgx/v5/stdlib" // required for SQL access
@@ -27,6 +28,30 @@ type Config struct {
 	SSLCert     string `env:"SSL_CERT"       envDefault:""`
 	SSLKey      string `env:"SSL_KEY"        envDefault:""`
 	SSLRootCert string `env:"SSL_ROOT_CERT"  envDefault:""`
+
+	MaxOpenConns    int           `env:"MAX_OPEN_CONNS"     envDefault:"0"`
+	MaxIdleConns    int           `env:"MAX_IDLE_CONNS"     envDefault:"2"`
+	ConnMaxLifetime time.Duration `env:"CONN_MAX_LIFETIME"  envDefault:"0"`
+	ConnMaxIdleTime time.Duration `env:"CONN_MAX_IDLE_TIME" envDefault:"0"`
+
+	// ReplicaHosts contains optional read-replica hosts. When set,
+	// repositories route read-only queries to the replicas while
+	// writes keep going to the primary.
+	ReplicaHosts []string `env:"REPLICA_HOSTS" envDefault:""`
+
+	// RowLevelSecurity enables propagation of the requesting user to
+	// the row-level security policies through NewRLSDatabase.
+	RowLevelSecurity bool `env:"ROW_LEVEL_SECURITY" envDefault:"false"`
+
+	// TenantSchemas enables schema-per-domain storage. Requests
+	// carrying a tenant in their context are routed to the domain's
+	// own Postgres schema through NewTenantDatabase.
+	TenantSchemas bool `env:"TENANT_SCHEMAS" envDefault:"false"`
+
+	// AutoMigrate controls whether unapplied migrations run at
+	// startup. Disable it in production to run them explicitly via
+	// the migrate subcommand.
+	AutoMigrate bool `env:"AUTO_MIGRATE" envDefault:"true"`
 }
 
 // Setup creates a connection to the PostgreSQL instance and applies any
@@ -41,6 +66,14 @@ func Setup(cfg Config, migrations migrate.MemoryMigrationSource) (*sqlx.DB, erro
 		return nil, err
 	}
 
+	if !cfg.AutoMigrate {
+		return db, nil
+	}
+
+	if err := checkDirty(db, migrations); err != nil {
+		return nil, err
+	}
+
 	if _, err = migrate.Exec(db.DB, "postgres", migrations, migrate.Up); err != nil {
 		return nil, errors.Wrap(errMigration, err)
 	}
@@ -54,12 +87,26 @@ func Setup(cfg Config, migrations migrate.MemoryMigrationSource) (*sqlx.DB, erro
 //
 //	db, err := postgres.Connect(postgres.Config{})
 func Connect(cfg Config) (*sqlx.DB, error) {
+	return connect(cfg, "")
+}
+
+// connect opens the connection pool. A non-empty searchPath pins every
+// connection of the pool to that schema.
+func connect(cfg Config, searchPath string) (*sqlx.DB, error) {
 	url := fmt.Sprintf("host=%s port=%s user=%s dbname=%s password=%s sslmode=%s sslcert=%s sslkey=%s sslrootcert=%s", cfg.Host, cfg.Port, cfg.User, cfg.Name, cfg.Pass, cfg.SSLMode, cfg.SSLCert, cfg.SSLKey, cfg.SSLRootCert)
+	if searchPath != "" {
+		url = fmt.Sprintf("%s options='-c search_path=%s'", url, searchPath)
+	}
 
 	db, err := sqlx.Open("pgx", url)
 	if err != nil {
 		return nil, errors.Wrap(errConnect, err)
 	}
 
+	db.SetMaxOpenConns(cfg.MaxOpenConns)
+	db.SetMaxIdleConns(cfg.MaxIdleConns)
+	db.SetConnMaxLifetime(cfg.ConnMaxLifetime)
+	db.SetConnMaxIdleTime(cfg.ConnMaxIdleTime)
+
 	return db, nil
 }
@@ -0,0 +1,242 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/absmach/supermq/pkg/errors"
+	"github.com/jmoiron/sqlx"
+)
+
+// The Postgres settings carrying the requesting session for row-level
+// security policies.
+const (
+	UserGUC   = "supermq.user_id"
+	DomainGUC = "supermq.domain_id"
+)
+
+var errSetUser = errors.New("failed to set user for row-level security")
+
+type (
+	userKey   struct{}
+	domainKey struct{}
+)
+
+// WithUser returns a context carrying the requesting user. Repositories
+// running behind an RLS database propagate it to the row-level security
+// policies.
+func WithUser(ctx context.Context, userID string) context.Context {
+	return context.WithValue(ctx, userKey{}, userID)
+}
+
+// UserFromContext returns the requesting user carried by the context.
+func UserFromContext(ctx context.Context) (string, bool) {
+	userID, ok := ctx.Value(userKey{}).(string)
+	return userID, ok
+}
+
+// WithDomain returns a context carrying the requesting domain, for the
+// row-level security policies of domain-scoped tables.
+func WithDomain(ctx context.Context, domainID string) context.Context {
+	return context.WithValue(ctx, domainKey{}, domainID)
+}
+
+// DomainFromContext returns the requesting domain carried by the context.
+func DomainFromContext(ctx context.Context) (string, bool) {
+	domainID, ok := ctx.Value(domainKey{}).(string)
+	return domainID, ok
+}
+
+// hasSession reports whether the context carries a requesting session
+// the row-level security policies apply to.
+func hasSession(ctx context.Context) bool {
+	if _, ok := UserFromContext(ctx); ok {
+		return true
+	}
+	_, ok := DomainFromContext(ctx)
+	return ok
+}
+
+// RLSPolicy returns the migration statements that enable row-level
+// security on a table and restrict statements to rows whose owner
+// column matches the given session setting. Security is forced so the
+// policies also apply to the table owner the services connect as.
+// Requests that do not declare a session are not restricted, so the
+// policies act as defense-in-depth for requests that do, without
+// breaking legacy paths.
+func RLSPolicy(table, ownerColumn, setting string) []string {
+	guc := "current_setting('" + setting + "', true)"
+	return []string{
+		fmt.Sprintf(`ALTER TABLE %s ENABLE ROW LEVEL SECURITY;`, table),
+		fmt.Sprintf(`ALTER TABLE %s FORCE ROW LEVEL SECURITY;`, table),
+		fmt.Sprintf(`CREATE POLICY %s_owner ON %s
+            USING (%s IS NULL
+                OR %s = ''
+                OR %s = %s);`,
+			table, table, guc, guc, ownerColumn, guc),
+	}
+}
+
+var _ Database = (*rlsDatabase)(nil)
+
+type rlsDatabase struct {
+	base Database
+}
+
+// NewRLSDatabase returns a database that propagates the requesting
+// session of the context to the row-level security policies. The
+// session is set with SET LOCAL, so it is scoped to a transaction:
+// transactions opened with BeginTxx carry it for their whole lifetime,
+// non-transactional statements are wrapped in one, and row-returning
+// queries run in a read transaction scoped to the request context.
+func NewRLSDatabase(base Database) Database {
+	return &rlsDatabase{base: base}
+}
+
+// setUser sets the requesting session on the transaction.
+func setUser(ctx context.Context, tx *sqlx.Tx) error {
+	if userID, ok := UserFromContext(ctx); ok {
+		if _, err := tx.ExecContext(ctx, `SELECT set_config($1, $2, true)`, UserGUC, userID); err != nil {
+			return errors.Wrap(errSetUser, err)
+		}
+	}
+	if domainID, ok := DomainFromContext(ctx); ok {
+		if _, err := tx.ExecContext(ctx, `SELECT set_config($1, $2, true)`, DomainGUC, domainID); err != nil {
+			return errors.Wrap(errSetUser, err)
+		}
+	}
+	return nil
+}
+
+// inTx runs the statement in its own transaction carrying the
+// requesting user.
+func (d *rlsDatabase) inTx(ctx context.Context, exec func(tx *sqlx.Tx) (sql.Result, error)) (sql.Result, error) {
+	tx, err := d.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := exec(tx)
+	if err != nil {
+		if txErr := tx.Rollback(); txErr != nil {
+			return nil, errors.Wrap(err, txErr)
+		}
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (d *rlsDatabase) BeginTxx(ctx context.Context, opts *sql.TxOptions) (*sqlx.Tx, error) {
+	tx, err := d.base.BeginTxx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	if err := setUser(ctx, tx); err != nil {
+		if txErr := tx.Rollback(); txErr != nil {
+			return nil, errors.Wrap(err, txErr)
+		}
+		return nil, err
+	}
+	return tx, nil
+}
+
+func (d *rlsDatabase) NamedExecContext(ctx context.Context, query string, args interface{}) (sql.Result, error) {
+	if !hasSession(ctx) {
+		return d.base.NamedExecContext(ctx, query, args)
+	}
+	return d.inTx(ctx, func(tx *sqlx.Tx) (sql.Result, error) {
+		return tx.NamedExecContext(ctx, query, args)
+	})
+}
+
+func (d *rlsDatabase) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	if !hasSession(ctx) {
+		return d.base.ExecContext(ctx, query, args...)
+	}
+	return d.inTx(ctx, func(tx *sqlx.Tx) (sql.Result, error) {
+		return tx.ExecContext(ctx, query, args...)
+	})
+}
+
+// readTxTTL caps how long a read transaction stays open for contexts
+// that are never cancelled.
+const readTxTTL = time.Minute
+
+// readTx begins a transaction carrying the requesting session for a
+// row-returning query. The rows of database/sql cannot be intercepted
+// on close, so the transaction is ended when the request context is,
+// with the TTL as a backstop; rows stay readable for the lifetime of
+// the request that asked for them.
+func (d *rlsDatabase) readTx(ctx context.Context) (*sqlx.Tx, error) {
+	tx, err := d.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	timer := time.NewTimer(readTxTTL)
+	go func() {
+		defer timer.Stop()
+		select {
+		case <-ctx.Done():
+		case <-timer.C:
+		}
+		// The transaction only read, so rolling it back releases the
+		// connection without losing anything.
+		_ = tx.Rollback()
+	}()
+
+	return tx, nil
+}
+
+func (d *rlsDatabase) NamedQueryContext(ctx context.Context, query string, args interface{}) (*sqlx.Rows, error) {
+	if !hasSession(ctx) {
+		return d.base.NamedQueryContext(ctx, query, args)
+	}
+	tx, err := d.readTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return sqlx.NamedQueryContext(ctx, tx, query, args)
+}
+
+func (d *rlsDatabase) QueryRowxContext(ctx context.Context, query string, args ...interface{}) *sqlx.Row {
+	if !hasSession(ctx) {
+		return d.base.QueryRowxContext(ctx, query, args...)
+	}
+	tx, err := d.readTx(ctx)
+	if err != nil {
+		// A Row carrying the error cannot be constructed, so the query
+		// runs unrestricted and fails the same way on the base database.
+		return d.base.QueryRowxContext(ctx, query, args...)
+	}
+	return tx.QueryRowxContext(ctx, query, args...)
+}
+
+func (d *rlsDatabase) QueryxContext(ctx context.Context, query string, args ...interface{}) (*sqlx.Rows, error) {
+	if !hasSession(ctx) {
+		return d.base.QueryxContext(ctx, query, args...)
+	}
+	tx, err := d.readTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return tx.QueryxContext(ctx, query, args...)
+}
+
+func (d *rlsDatabase) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	if !hasSession(ctx) {
+		return d.base.QueryContext(ctx, query, args...)
+	}
+	tx, err := d.readTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return tx.QueryContext(ctx, query, args...)
+}
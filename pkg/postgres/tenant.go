@@ -0,0 +1,222 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/absmach/supermq/pkg/errors"
+	"github.com/jmoiron/sqlx"
+	migrate "github.com/rubenv/sql-migrate"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tenantSchemaPrefix prefixes the Postgres schema of every tenant.
+const tenantSchemaPrefix = "tenant_"
+
+var (
+	errInvalidTenant = errors.New("invalid tenant domain ID")
+	errCreateSchema  = errors.New("failed to create tenant schema")
+	errTenantMigrate = errors.New("failed to migrate tenant schema")
+	errTenantConnect = errors.New("failed to connect to tenant schema")
+)
+
+type tenantKey struct{}
+
+// WithTenant returns a context carrying the domain whose schema
+// subsequent queries are routed to.
+func WithTenant(ctx context.Context, domainID string) context.Context {
+	return context.WithValue(ctx, tenantKey{}, domainID)
+}
+
+// TenantFromContext returns the domain carried by the context.
+func TenantFromContext(ctx context.Context) (string, bool) {
+	domainID, ok := ctx.Value(tenantKey{}).(string)
+	return domainID, ok
+}
+
+// TenantSchema returns the Postgres schema of a domain.
+func TenantSchema(domainID string) (string, error) {
+	schema := strings.ToLower(strings.ReplaceAll(domainID, "-", "_"))
+	if schema == "" {
+		return "", errInvalidTenant
+	}
+	for _, r := range schema {
+		if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '_' {
+			return "", errInvalidTenant
+		}
+	}
+	return tenantSchemaPrefix + schema, nil
+}
+
+// TenantDatabase is a Database that additionally provisions per-domain
+// schemas.
+type TenantDatabase interface {
+	Database
+
+	// Provision creates the domain's schema, applies the migrations to
+	// it, and opens its connection pool.
+	Provision(ctx context.Context, domainID string, migrations migrate.MemoryMigrationSource) error
+}
+
+var _ TenantDatabase = (*tenantDatabase)(nil)
+
+type tenantDatabase struct {
+	base   Database
+	cfg    Config
+	tracer trace.Tracer
+
+	// closed answers queries whose tenant cannot be routed. Such
+	// queries must fail rather than fall through to the shared schema.
+	closed Database
+
+	mu    sync.RWMutex
+	pools map[string]Database
+}
+
+// NewTenantDatabase returns a database that routes queries of requests
+// carrying a tenant in their context to the tenant's own schema, for
+// deployments with hard data-isolation requirements. Requests without a
+// tenant keep using the shared schema through the base database. Tenant
+// pools connect to the primary only; read replicas are not used for
+// tenant queries.
+func NewTenantDatabase(base Database, cfg Config, tracer trace.Tracer) TenantDatabase {
+	closed, _ := sqlx.Open("pgx", "")
+	_ = closed.Close()
+
+	cfg.ReplicaHosts = nil
+
+	return &tenantDatabase{
+		base:   base,
+		cfg:    cfg,
+		tracer: tracer,
+		closed: NewDatabase(closed, cfg, tracer),
+		pools:  make(map[string]Database),
+	}
+}
+
+func (d *tenantDatabase) Provision(ctx context.Context, domainID string, migrations migrate.MemoryMigrationSource) error {
+	schema, err := TenantSchema(domainID)
+	if err != nil {
+		return err
+	}
+	if _, err := d.base.ExecContext(ctx, fmt.Sprintf("CREATE SCHEMA IF NOT EXISTS %s", schema)); err != nil {
+		return errors.Wrap(errCreateSchema, err)
+	}
+
+	pool, err := connect(d.cfg, schema)
+	if err != nil {
+		return errors.Wrap(errTenantConnect, err)
+	}
+	ms := migrate.MigrationSet{SchemaName: schema}
+	if _, err := ms.Exec(pool.DB, "postgres", migrations, migrate.Up); err != nil {
+		pool.Close()
+		return errors.Wrap(errTenantMigrate, err)
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if _, ok := d.pools[schema]; !ok {
+		d.pools[schema] = NewDatabase(pool, d.cfg, d.tracer)
+		return nil
+	}
+	return pool.Close()
+}
+
+// ProvisionTenant creates the schema of a domain and applies the
+// migrations to it. It is intended for out-of-band tenant provisioning
+// tooling; running services provision through TenantDatabase instead.
+func ProvisionTenant(cfg Config, domainID string, migrations migrate.MemoryMigrationSource) error {
+	schema, err := TenantSchema(domainID)
+	if err != nil {
+		return err
+	}
+
+	admin, err := Connect(cfg)
+	if err != nil {
+		return err
+	}
+	defer admin.Close()
+	if _, err := admin.Exec(fmt.Sprintf("CREATE SCHEMA IF NOT EXISTS %s", schema)); err != nil {
+		return errors.Wrap(errCreateSchema, err)
+	}
+
+	pool, err := connect(cfg, schema)
+	if err != nil {
+		return errors.Wrap(errTenantConnect, err)
+	}
+	defer pool.Close()
+	ms := migrate.MigrationSet{SchemaName: schema}
+	if _, err := ms.Exec(pool.DB, "postgres", migrations, migrate.Up); err != nil {
+		return errors.Wrap(errTenantMigrate, err)
+	}
+
+	return nil
+}
+
+// route returns the database serving the tenant of the request. Schemas
+// provisioned by another instance are connected to lazily.
+func (d *tenantDatabase) route(ctx context.Context) Database {
+	domainID, ok := TenantFromContext(ctx)
+	if !ok {
+		return d.base
+	}
+	schema, err := TenantSchema(domainID)
+	if err != nil {
+		return d.closed
+	}
+
+	d.mu.RLock()
+	pool, ok := d.pools[schema]
+	d.mu.RUnlock()
+	if ok {
+		return pool
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if pool, ok := d.pools[schema]; ok {
+		return pool
+	}
+	db, err := connect(d.cfg, schema)
+	if err != nil {
+		return d.closed
+	}
+	pool = NewDatabase(db, d.cfg, d.tracer)
+	d.pools[schema] = pool
+
+	return pool
+}
+
+func (d *tenantDatabase) NamedQueryContext(ctx context.Context, query string, args interface{}) (*sqlx.Rows, error) {
+	return d.route(ctx).NamedQueryContext(ctx, query, args)
+}
+
+func (d *tenantDatabase) NamedExecContext(ctx context.Context, query string, args interface{}) (sql.Result, error) {
+	return d.route(ctx).NamedExecContext(ctx, query, args)
+}
+
+func (d *tenantDatabase) QueryRowxContext(ctx context.Context, query string, args ...interface{}) *sqlx.Row {
+	return d.route(ctx).QueryRowxContext(ctx, query, args...)
+}
+
+func (d *tenantDatabase) QueryxContext(ctx context.Context, query string, args ...interface{}) (*sqlx.Rows, error) {
+	return d.route(ctx).QueryxContext(ctx, query, args...)
+}
+
+func (d *tenantDatabase) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	return d.route(ctx).QueryContext(ctx, query, args...)
+}
+
+func (d *tenantDatabase) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	return d.route(ctx).ExecContext(ctx, query, args...)
+}
+
+func (d *tenantDatabase) BeginTxx(ctx context.Context, opts *sql.TxOptions) (*sqlx.Tx, error) {
+	return d.route(ctx).BeginTxx(ctx, opts)
+}
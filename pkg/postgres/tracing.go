@@ -7,7 +7,10 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"log/slog"
+	"net"
 	"strings"
+	"sync/atomic"
 
 	"github.com/jmoiron/sqlx"
 	"go.opentelemetry.io/otel/attribute"
@@ -18,8 +21,10 @@ var _ Database = (*database)(nil)
 
 type database struct {
 	Config
-	db     *sqlx.DB
-	tracer trace.Tracer
+	db       *sqlx.DB
+	replicas []*sqlx.DB
+	next     atomic.Uint64
+	tracer   trace.Tracer
 }
 
 // Database provides a database interface.
@@ -46,7 +51,9 @@ type Database interface {
 	BeginTxx(ctx context.Context, opts *sql.TxOptions) (*sqlx.Tx, error)
 }
 
-// NewDatabase creates a Clients'Database instance.
+// NewDatabase creates a Clients'Database instance. When the configuration
+// lists read-replica hosts, read-only queries are routed to the replicas
+// in round-robin fashion while writes keep going to the primary.
 func NewDatabase(db *sqlx.DB, config Config, tracer trace.Tracer) Database {
 	database := &database{
 		Config: config,
@@ -54,14 +61,50 @@ func NewDatabase(db *sqlx.DB, config Config, tracer trace.Tracer) Database {
 		tracer: tracer,
 	}
 
+	for _, replica := range config.ReplicaHosts {
+		rcfg := config
+		rcfg.ReplicaHosts = nil
+		rcfg.Host = replica
+		if host, port, err := net.SplitHostPort(replica); err == nil {
+			rcfg.Host = host
+			rcfg.Port = port
+		}
+		rdb, err := Connect(rcfg)
+		if err != nil {
+			// A skipped replica only reduces read capacity, but it must
+			// not be skipped silently.
+			slog.Warn(fmt.Sprintf("failed to connect to read replica %s : %s", replica, err))
+			continue
+		}
+		database.replicas = append(database.replicas, rdb)
+	}
+
 	return database
 }
 
+// reader returns the connection used for read-only queries.
+func (d *database) reader() *sqlx.DB {
+	if len(d.replicas) == 0 {
+		return d.db
+	}
+	return d.replicas[d.next.Add(1)%uint64(len(d.replicas))]
+}
+
+// route returns the connection the query runs on. Only plain SELECT
+// queries go to the replicas: repositories also return rows from
+// INSERT/UPDATE ... RETURNING queries, which must run on the primary.
+func (d *database) route(query string) *sqlx.DB {
+	if strings.EqualFold(strings.Split(strings.TrimSpace(query), " ")[0], "SELECT") {
+		return d.reader()
+	}
+	return d.db
+}
+
 func (d *database) NamedQueryContext(ctx context.Context, query string, args interface{}) (*sqlx.Rows, error) {
 	ctx, span := d.addSpanTags(ctx, query)
 	defer span.End()
 
-	return d.db.NamedQueryContext(ctx, query, args)
+	return d.route(query).NamedQueryContext(ctx, query, args)
 }
 
 func (d *database) NamedExecContext(ctx context.Context, query string, args interface{}) (sql.Result, error) {
@@ -82,23 +125,23 @@ func (d *database) QueryRowxContext(ctx context.Context, query string, args ...i
 	ctx, span := d.addSpanTags(ctx, query)
 	defer span.End()
 
-	return d.db.QueryRowxContext(ctx, query, args...)
+	return d.route(query).QueryRowxContext(ctx, query, args...)
 }
 
 func (d *database) QueryxContext(ctx context.Context, query string, args ...interface{}) (*sqlx.Rows, error) {
 	ctx, span := d.addSpanTags(ctx, query)
 	defer span.End()
 
-	return d.db.QueryxContext(ctx, query, args...)
+	return d.route(query).QueryxContext(ctx, query, args...)
 }
 
-func (d database) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+func (d *database) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
 	ctx, span := d.addSpanTags(ctx, query)
 	defer span.End()
-	return d.db.QueryContext(ctx, query, args...)
+	return d.route(query).QueryContext(ctx, query, args...)
 }
 
-func (d database) BeginTxx(ctx context.Context, opts *sql.TxOptions) (*sqlx.Tx, error) {
+func (d *database) BeginTxx(ctx context.Context, opts *sql.TxOptions) (*sqlx.Tx, error) {
 	ctx, span := d.addSpanTags(ctx, "BeginTxx")
 	defer span.End()
 
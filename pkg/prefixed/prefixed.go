@@ -0,0 +1,35 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package prefixed provides an identity provider that prepends a fixed
+// prefix, such as usr_ or grp_, to IDs of an underlying provider, so the
+// entity type is readable from the ID itself.
+package prefixed
+
+import (
+	"github.com/absmach/supermq"
+)
+
+var _ supermq.IDProvider = (*prefixedProvider)(nil)
+
+type prefixedProvider struct {
+	prefix   string
+	provider supermq.IDProvider
+}
+
+// New instantiates a prefixed ID provider wrapping the given provider.
+func New(prefix string, provider supermq.IDProvider) supermq.IDProvider {
+	return &prefixedProvider{
+		prefix:   prefix,
+		provider: provider,
+	}
+}
+
+func (pp *prefixedProvider) ID() (string, error) {
+	id, err := pp.provider.ID()
+	if err != nil {
+		return "", err
+	}
+
+	return pp.prefix + id, nil
+}
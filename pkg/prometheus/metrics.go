@@ -4,12 +4,15 @@
 package prometheus
 
 import (
+	"github.com/absmach/supermq/pkg/errors"
 	kitprometheus "github.com/go-kit/kit/metrics/prometheus"
 	stdprometheus "github.com/prometheus/client_golang/prometheus"
 )
 
 // MakeMetrics returns an instance of Prometheus implementations for metrics.
-// It returns a request counter and a request latency summary.
+// It returns a request counter and a request latency summary. The counter
+// carries an error label holding the class of the error the request failed
+// with, so that dashboards can alert on error classes separately.
 //
 //	counter, latency := metrics.MakeMetrics("demo-service", "api")
 func MakeMetrics(namespace, subsystem string) (*kitprometheus.Counter, *kitprometheus.Summary) {
@@ -18,7 +21,7 @@ func MakeMetrics(namespace, subsystem string) (*kitprometheus.Counter, *kitprome
 		Subsystem: subsystem,
 		Name:      "request_count",
 		Help:      "Number of requests received.",
-	}, []string{"method"})
+	}, []string{"method", "error"})
 	latency := kitprometheus.NewSummaryFrom(stdprometheus.SummaryOpts{
 		Namespace:  namespace,
 		Subsystem:  subsystem,
@@ -29,3 +32,22 @@ func MakeMetrics(namespace, subsystem string) (*kitprometheus.Counter, *kitprome
 
 	return counter, latency
 }
+
+// ErrorClass returns the value of the error label for the given request
+// error, derived from the stable error code carried by the error chain.
+func ErrorClass(err error) string {
+	if err == nil {
+		return "none"
+	}
+	switch errors.CodeOf(err) {
+	case errors.CodeAuthentication, errors.CodeAuthorization:
+		return "authz"
+	case errors.CodeNotFound:
+		return "not_found"
+	case errors.CodeInvalidArgument, errors.CodeConflict, errors.CodeUnprocessableEntity,
+		errors.CodeUnsupportedContentType, errors.CodePreconditionFailed:
+		return "validation"
+	default:
+		return "internal"
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package prometheus_test
+
+import (
+	"testing"
+
+	"github.com/absmach/supermq/pkg/errors"
+	svcerr "github.com/absmach/supermq/pkg/errors/service"
+	"github.com/absmach/supermq/pkg/prometheus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrorClass(t *testing.T) {
+	cases := []struct {
+		desc  string
+		err   error
+		class string
+	}{
+		{
+			desc:  "no error",
+			err:   nil,
+			class: "none",
+		},
+		{
+			desc:  "authentication error",
+			err:   svcerr.ErrAuthentication,
+			class: "authz",
+		},
+		{
+			desc:  "authorization error",
+			err:   svcerr.ErrAuthorization,
+			class: "authz",
+		},
+		{
+			desc:  "not found error",
+			err:   svcerr.ErrNotFound,
+			class: "not_found",
+		},
+		{
+			desc:  "validation error",
+			err:   svcerr.ErrMalformedEntity,
+			class: "validation",
+		},
+		{
+			desc:  "wrapped error keeps the class of the wrapper",
+			err:   errors.Wrap(svcerr.ErrAuthorization, errors.New("spicedb unreachable")),
+			class: "authz",
+		},
+		{
+			desc:  "error without code",
+			err:   errors.New("unexpected"),
+			class: "internal",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			assert.Equal(t, tc.class, prometheus.ErrorClass(tc.err))
+		})
+	}
+}
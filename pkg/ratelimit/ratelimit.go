@@ -0,0 +1,99 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package ratelimit provides a per-key token bucket rate limiter, used
+// to throttle anonymous access to public resources.
+package ratelimit
+
+import (
+	"sync"
+	"time"
+)
+
+// sweepInterval is how often stale buckets are evicted, amortized over
+// Allow calls.
+const sweepInterval = time.Minute
+
+type bucket struct {
+	tokens   float64
+	lastSeen time.Time
+}
+
+// Limiter is a token bucket rate limiter keyed by an arbitrary string,
+// e.g. a remote address or a channel ID. Buckets idle long enough to
+// have refilled completely are evicted, so the limiter does not grow
+// without bound when keyed by untrusted values such as remote
+// addresses.
+type Limiter struct {
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	rate      float64
+	burst     float64
+	ttl       time.Duration
+	lastSweep time.Time
+}
+
+// New returns a Limiter allowing rate requests per second per key with
+// the given burst size.
+func New(rate float64, burst uint64) *Limiter {
+	// A bucket idle long enough to have refilled to the full burst is
+	// indistinguishable from a fresh one and can be dropped.
+	ttl := sweepInterval
+	if rate > 0 {
+		if refill := time.Duration(float64(burst) / rate * float64(time.Second)); refill > ttl {
+			ttl = refill
+		}
+	}
+
+	return &Limiter{
+		buckets:   make(map[string]*bucket),
+		rate:      rate,
+		burst:     float64(burst),
+		ttl:       ttl,
+		lastSweep: time.Now(),
+	}
+}
+
+// Allow reports whether a request for the given key is within the rate
+// limit, consuming a token if it is.
+func (l *Limiter) Allow(key string) bool {
+	now := time.Now()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.sweep(now)
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: l.burst, lastSeen: now}
+		l.buckets[key] = b
+	}
+
+	b.tokens += now.Sub(b.lastSeen).Seconds() * l.rate
+	if b.tokens > l.burst {
+		b.tokens = l.burst
+	}
+	b.lastSeen = now
+
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// sweep evicts buckets not seen within the TTL. The caller must hold
+// the lock.
+func (l *Limiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < sweepInterval {
+		return
+	}
+	l.lastSweep = now
+
+	for key, b := range l.buckets {
+		if now.Sub(b.lastSeen) > l.ttl {
+			delete(l.buckets, key)
+		}
+	}
+}
@@ -0,0 +1,35 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package ratelimit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/absmach/supermq/pkg/ratelimit"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testTimeout  = time.Second
+	testInterval = time.Millisecond
+)
+
+func TestAllow(t *testing.T) {
+	l := ratelimit.New(0, 2)
+
+	assert.True(t, l.Allow("key"), "first request within burst should be allowed")
+	assert.True(t, l.Allow("key"), "second request within burst should be allowed")
+	assert.False(t, l.Allow("key"), "request over burst should be denied")
+	assert.True(t, l.Allow("other"), "different key should have its own bucket")
+}
+
+func TestAllowRefill(t *testing.T) {
+	l := ratelimit.New(1000, 1)
+
+	assert.True(t, l.Allow("key"), "first request within burst should be allowed")
+	assert.Eventually(t, func() bool {
+		return l.Allow("key")
+	}, testTimeout, testInterval, "bucket should refill over time")
+}
@@ -0,0 +1,7 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package reconciler cross-checks service repositories against the
+// policy store, reporting entities without domain tuples and tuples
+// referencing deleted entities, with optional automatic repair.
+package reconciler
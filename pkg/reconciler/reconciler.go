@@ -0,0 +1,298 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package reconciler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/absmach/supermq/pkg/errors"
+	"github.com/absmach/supermq/pkg/policies"
+	kitprometheus "github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+// Drift kinds. A missing policy is an entity stored in the repository
+// without its domain tuple in the policy store; an orphaned policy is a
+// domain tuple referencing an entity that no longer exists.
+const (
+	DriftMissingPolicy  = "missing_policy"
+	DriftOrphanedPolicy = "orphaned_policy"
+)
+
+// Repair actions recorded on detected drift.
+const (
+	ActionNone         = "none"
+	ActionWouldRepair  = "would_repair"
+	ActionRepaired     = "repaired"
+	ActionRepairFailed = "repair_failed"
+)
+
+// ErrNoEntities indicates that no entity listers are registered.
+var ErrNoEntities = errors.New("no entity listers registered")
+
+// driftCount exposes the number of drifted records found by the last
+// reconciliation run, per entity type and drift kind.
+var driftCount = kitprometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+	Namespace: "reconciler",
+	Subsystem: "drift",
+	Name:      "count",
+	Help:      "Number of drifted records found by the last reconciliation run.",
+}, []string{"entity_type", "kind"})
+
+// Entity is a repository-side record checked against the policy store.
+type Entity struct {
+	ID     string
+	Domain string
+}
+
+// Lister retrieves all entities of one type from the repository.
+type Lister func(ctx context.Context) ([]Entity, error)
+
+// Drift is a single detected inconsistency between a repository and the
+// policy store.
+type Drift struct {
+	EntityType string `json:"entity_type"`
+	EntityID   string `json:"entity_id"`
+	DomainID   string `json:"domain_id"`
+	Kind       string `json:"kind"`
+	Action     string `json:"action"`
+	Error      string `json:"error,omitempty"`
+}
+
+// Report is the outcome of a reconciliation run.
+type Report struct {
+	StartedAt  time.Time `json:"started_at"`
+	FinishedAt time.Time `json:"finished_at"`
+	DryRun     bool      `json:"dry_run"`
+	Checked    uint64    `json:"checked"`
+	Drifts     []Drift   `json:"drifts"`
+}
+
+// Option is a functional option for the reconciler.
+type Option func(*Reconciler)
+
+// WithRepair enables automatic repair of detected drift: missing
+// policies are re-added and orphaned policies are deleted. With dryRun
+// set, repairs are only reported, not executed.
+func WithRepair(dryRun bool) Option {
+	return func(r *Reconciler) {
+		r.repair = true
+		r.dryRun = dryRun
+	}
+}
+
+// Reconciler periodically cross-checks service repositories against the
+// policy store and reports the drift it finds.
+type Reconciler struct {
+	policy policies.Service
+	repair bool
+	dryRun bool
+
+	mu      sync.RWMutex
+	listers map[string]Lister
+	last    Report
+}
+
+// New returns a new reconciler checking the given policy store.
+func New(policy policies.Service, opts ...Option) *Reconciler {
+	r := &Reconciler{
+		policy:  policy,
+		listers: make(map[string]Lister),
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// RegisterEntity registers the lister used to enumerate entities of the
+// given type.
+func (r *Reconciler) RegisterEntity(entityType string, lister Lister) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.listers[entityType] = lister
+}
+
+// Run performs a single reconciliation pass over all registered entity
+// types and returns its report. The report is also retained for the
+// HTTP handler.
+func (r *Reconciler) Run(ctx context.Context) (Report, error) {
+	r.mu.RLock()
+	listers := make(map[string]Lister, len(r.listers))
+	for entityType, lister := range r.listers {
+		listers[entityType] = lister
+	}
+	r.mu.RUnlock()
+	if len(listers) == 0 {
+		return Report{}, ErrNoEntities
+	}
+
+	rep := Report{
+		StartedAt: time.Now(),
+		DryRun:    r.dryRun,
+		Drifts:    []Drift{},
+	}
+	counts := map[string]map[string]uint64{}
+	for entityType, lister := range listers {
+		counts[entityType] = map[string]uint64{DriftMissingPolicy: 0, DriftOrphanedPolicy: 0}
+		if err := r.reconcile(ctx, entityType, lister, &rep, counts[entityType]); err != nil {
+			return Report{}, err
+		}
+	}
+	rep.FinishedAt = time.Now()
+
+	for entityType, kinds := range counts {
+		for kind, count := range kinds {
+			driftCount.With("entity_type", entityType, "kind", kind).Set(float64(count))
+		}
+	}
+
+	r.mu.Lock()
+	r.last = rep
+	r.mu.Unlock()
+
+	return rep, nil
+}
+
+// reconcile checks one entity type: entities are grouped per domain and
+// compared with the objects the policy store links to that domain.
+func (r *Reconciler) reconcile(ctx context.Context, entityType string, lister Lister, rep *Report, counts map[string]uint64) error {
+	entities, err := lister(ctx)
+	if err != nil {
+		return err
+	}
+
+	byDomain := map[string]map[string]bool{}
+	for _, e := range entities {
+		if byDomain[e.Domain] == nil {
+			byDomain[e.Domain] = map[string]bool{}
+		}
+		byDomain[e.Domain][e.ID] = true
+		rep.Checked++
+	}
+
+	for domainID, ids := range byDomain {
+		page, err := r.policy.ListAllObjects(ctx, policies.Policy{
+			SubjectType: policies.DomainType,
+			Subject:     domainID,
+			Permission:  policies.DomainRelation,
+			ObjectType:  entityType,
+		})
+		if err != nil {
+			return err
+		}
+		linked := map[string]bool{}
+		for _, id := range page.Policies {
+			linked[id] = true
+		}
+
+		for id := range ids {
+			if !linked[id] {
+				counts[DriftMissingPolicy]++
+				rep.Drifts = append(rep.Drifts, r.repairDrift(ctx, Drift{
+					EntityType: entityType,
+					EntityID:   id,
+					DomainID:   domainID,
+					Kind:       DriftMissingPolicy,
+				}))
+			}
+		}
+		for id := range linked {
+			if !ids[id] {
+				counts[DriftOrphanedPolicy]++
+				rep.Drifts = append(rep.Drifts, r.repairDrift(ctx, Drift{
+					EntityType: entityType,
+					EntityID:   id,
+					DomainID:   domainID,
+					Kind:       DriftOrphanedPolicy,
+				}))
+			}
+		}
+	}
+
+	return nil
+}
+
+// repairDrift applies the configured repair mode to a detected drift
+// and records the taken action on it.
+func (r *Reconciler) repairDrift(ctx context.Context, d Drift) Drift {
+	d.Action = ActionNone
+	if !r.repair {
+		return d
+	}
+	if r.dryRun {
+		d.Action = ActionWouldRepair
+		return d
+	}
+
+	var err error
+	switch d.Kind {
+	case DriftMissingPolicy:
+		err = r.policy.AddPolicy(ctx, policies.Policy{
+			Domain:      d.DomainID,
+			SubjectType: policies.DomainType,
+			Subject:     d.DomainID,
+			Relation:    policies.DomainRelation,
+			ObjectType:  d.EntityType,
+			Object:      d.EntityID,
+		})
+	case DriftOrphanedPolicy:
+		err = r.policy.DeletePolicyFilter(ctx, policies.Policy{
+			ObjectType: d.EntityType,
+			Object:     d.EntityID,
+		})
+	}
+	if err != nil {
+		d.Action = ActionRepairFailed
+		d.Error = err.Error()
+		return d
+	}
+	d.Action = ActionRepaired
+	return d
+}
+
+// Start runs the reconciler in the background on every interval until
+// the context is canceled.
+func (r *Reconciler) Start(ctx context.Context, interval time.Duration, logger *slog.Logger) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if _, err := r.Run(ctx); err != nil {
+					logger.Warn(fmt.Sprintf("failed to reconcile policies : %s", err))
+				}
+			}
+		}
+	}()
+}
+
+// Handler returns an HTTP handler exposing the report of the last
+// reconciliation run. The report lists entity IDs and orphaned
+// relations across domains, so the handler must be mounted behind
+// platform-admin authorization.
+func (r *Reconciler) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		r.mu.RLock()
+		rep := r.last
+		r.mu.RUnlock()
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(rep); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
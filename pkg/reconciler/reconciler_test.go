@@ -0,0 +1,116 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package reconciler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/absmach/supermq/pkg/policies"
+	policymocks "github.com/absmach/supermq/pkg/policies/mocks"
+	"github.com/absmach/supermq/pkg/reconciler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func lister(entities ...reconciler.Entity) reconciler.Lister {
+	return func(ctx context.Context) ([]reconciler.Entity, error) {
+		return entities, nil
+	}
+}
+
+func TestRunDetectsDrift(t *testing.T) {
+	policy := new(policymocks.Service)
+	policy.On("ListAllObjects", mock.Anything, mock.Anything).Return(policies.PolicyPage{Policies: []string{"linked", "orphaned"}}, nil)
+
+	r := reconciler.New(policy)
+	r.RegisterEntity(policies.ClientType, lister(
+		reconciler.Entity{ID: "linked", Domain: "domain"},
+		reconciler.Entity{ID: "unlinked", Domain: "domain"},
+	))
+
+	rep, err := r.Run(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), rep.Checked)
+	require.Len(t, rep.Drifts, 2)
+
+	kinds := map[string]reconciler.Drift{}
+	for _, d := range rep.Drifts {
+		kinds[d.Kind] = d
+	}
+	assert.Equal(t, "unlinked", kinds[reconciler.DriftMissingPolicy].EntityID)
+	assert.Equal(t, "orphaned", kinds[reconciler.DriftOrphanedPolicy].EntityID)
+	assert.Equal(t, reconciler.ActionNone, kinds[reconciler.DriftMissingPolicy].Action)
+}
+
+func TestRunWithoutListers(t *testing.T) {
+	r := reconciler.New(new(policymocks.Service))
+	_, err := r.Run(context.Background())
+	assert.Equal(t, reconciler.ErrNoEntities, err)
+}
+
+func TestRunDryRun(t *testing.T) {
+	policy := new(policymocks.Service)
+	policy.On("ListAllObjects", mock.Anything, mock.Anything).Return(policies.PolicyPage{}, nil)
+
+	r := reconciler.New(policy, reconciler.WithRepair(true))
+	r.RegisterEntity(policies.ClientType, lister(reconciler.Entity{ID: "unlinked", Domain: "domain"}))
+
+	rep, err := r.Run(context.Background())
+	require.NoError(t, err)
+	require.Len(t, rep.Drifts, 1)
+	assert.True(t, rep.DryRun)
+	assert.Equal(t, reconciler.ActionWouldRepair, rep.Drifts[0].Action)
+	policy.AssertNotCalled(t, "AddPolicy")
+}
+
+func TestRunRepairs(t *testing.T) {
+	policy := new(policymocks.Service)
+	policy.On("ListAllObjects", mock.Anything, mock.Anything).Return(policies.PolicyPage{Policies: []string{"orphaned"}}, nil)
+	policy.On("AddPolicy", mock.Anything, mock.MatchedBy(func(pr policies.Policy) bool {
+		return pr.Object == "unlinked"
+	})).Return(nil)
+	policy.On("DeletePolicyFilter", mock.Anything, mock.MatchedBy(func(pr policies.Policy) bool {
+		return pr.Object == "orphaned"
+	})).Return(nil)
+
+	r := reconciler.New(policy, reconciler.WithRepair(false))
+	r.RegisterEntity(policies.ClientType, lister(reconciler.Entity{ID: "unlinked", Domain: "domain"}))
+
+	rep, err := r.Run(context.Background())
+	require.NoError(t, err)
+	require.Len(t, rep.Drifts, 2)
+	for _, d := range rep.Drifts {
+		assert.Equal(t, reconciler.ActionRepaired, d.Action)
+	}
+	policy.AssertExpectations(t)
+}
+
+func TestHandler(t *testing.T) {
+	policy := new(policymocks.Service)
+	policy.On("ListAllObjects", mock.Anything, mock.Anything).Return(policies.PolicyPage{}, nil)
+
+	r := reconciler.New(policy)
+	r.RegisterEntity(policies.ClientType, lister(reconciler.Entity{ID: "client", Domain: "domain"}))
+	_, err := r.Run(context.Background())
+	require.NoError(t, err)
+
+	srv := httptest.NewServer(r.Handler())
+	defer srv.Close()
+
+	res, err := http.Get(srv.URL)
+	require.NoError(t, err)
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	var rep reconciler.Report
+	err = json.NewDecoder(res.Body).Decode(&rep)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), rep.Checked)
+	require.Len(t, rep.Drifts, 1)
+}
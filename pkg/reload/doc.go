@@ -0,0 +1,6 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package reload provides runtime reconfiguration of selected settings
+// without restarting the service, triggered by SIGHUP or an HTTP endpoint.
+package reload
@@ -0,0 +1,178 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package reload
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+
+	"github.com/absmach/supermq/pkg/errors"
+)
+
+var (
+	errUnknownSetting   = errors.New("unknown runtime setting")
+	errDuplicateSetting = errors.New("runtime setting already registered")
+	errInvalidSetting   = errors.New("invalid runtime setting value")
+)
+
+// Setting describes a single runtime-reloadable configuration value.
+type Setting struct {
+	// Name identifies the setting in the config endpoint and logs.
+	Name string
+
+	// EnvKey is the environment variable re-read on SIGHUP. Settings
+	// without an EnvKey can only be changed through the endpoint.
+	EnvKey string
+
+	// Value returns the current value of the setting.
+	Value func() string
+
+	// Validate checks a candidate value without applying it. Optional.
+	Validate func(value string) error
+
+	// Apply installs a new value.
+	Apply func(value string) error
+}
+
+// Watcher holds registered runtime settings and applies changes to them,
+// logging an event for every accepted change.
+type Watcher struct {
+	mu       sync.Mutex
+	settings map[string]Setting
+	names    []string
+	logger   *slog.Logger
+}
+
+// NewWatcher returns an empty settings watcher.
+func NewWatcher(logger *slog.Logger) *Watcher {
+	return &Watcher{
+		settings: make(map[string]Setting),
+		logger:   logger,
+	}
+}
+
+// Register adds a setting to the watcher.
+func (w *Watcher) Register(s Setting) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if _, ok := w.settings[s.Name]; ok {
+		return errors.Wrap(errDuplicateSetting, errors.New(s.Name))
+	}
+	w.settings[s.Name] = s
+	w.names = append(w.names, s.Name)
+	return nil
+}
+
+// Set validates and applies a new value for the named setting. Unchanged
+// values are accepted without triggering a change event.
+func (w *Watcher) Set(ctx context.Context, name, value string) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.set(ctx, name, value)
+}
+
+// Values returns the current value of every registered setting.
+func (w *Watcher) Values() map[string]string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	values := make(map[string]string, len(w.settings))
+	for name, s := range w.settings {
+		values[name] = s.Value()
+	}
+	return values
+}
+
+// Listen blocks until ctx is cancelled, re-reading setting environment
+// variables whenever the process receives SIGHUP.
+func (w *Watcher) Listen(ctx context.Context) error {
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, syscall.SIGHUP)
+	defer signal.Stop(sig)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-sig:
+			w.reload(ctx)
+		}
+	}
+}
+
+// Handler returns an HTTP handler exposing the registered settings: GET
+// returns current values, PATCH applies the values from a JSON object.
+// Settings control service-wide behavior, so the handler must be
+// mounted behind platform-admin authorization.
+func (w *Watcher) Handler() http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+		case http.MethodPatch:
+			var updates map[string]string
+			if err := json.NewDecoder(r.Body).Decode(&updates); err != nil {
+				http.Error(rw, err.Error(), http.StatusBadRequest)
+				return
+			}
+			for name, value := range updates {
+				if err := w.Set(r.Context(), name, value); err != nil {
+					http.Error(rw, err.Error(), http.StatusBadRequest)
+					return
+				}
+			}
+		default:
+			http.Error(rw, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+
+		rw.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(rw).Encode(w.Values()); err != nil {
+			rw.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
+
+func (w *Watcher) reload(ctx context.Context) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for _, name := range w.names {
+		s := w.settings[name]
+		if s.EnvKey == "" {
+			continue
+		}
+		value, ok := os.LookupEnv(s.EnvKey)
+		if !ok {
+			continue
+		}
+		if err := w.set(ctx, name, value); err != nil {
+			w.logger.WarnContext(ctx, "failed to reload runtime setting", slog.String("setting", name), slog.Any("error", err))
+		}
+	}
+}
+
+func (w *Watcher) set(ctx context.Context, name, value string) error {
+	s, ok := w.settings[name]
+	if !ok {
+		return errors.Wrap(errUnknownSetting, errors.New(name))
+	}
+	previous := s.Value()
+	if previous == value {
+		return nil
+	}
+	if s.Validate != nil {
+		if err := s.Validate(value); err != nil {
+			return errors.Wrap(errInvalidSetting, err)
+		}
+	}
+	if err := s.Apply(value); err != nil {
+		return errors.Wrap(errInvalidSetting, err)
+	}
+	w.logger.InfoContext(ctx, "runtime setting changed", slog.String("setting", name), slog.String("previous", previous), slog.String("current", value))
+	return nil
+}
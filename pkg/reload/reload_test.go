@@ -0,0 +1,110 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package reload_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	smqlog "github.com/absmach/supermq/logger"
+	"github.com/absmach/supermq/pkg/reload"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newWatcher(t *testing.T) (*reload.Watcher, *string) {
+	watcher := reload.NewWatcher(smqlog.NewMock())
+	value := "initial"
+	err := watcher.Register(reload.Setting{
+		Name:  "greeting",
+		Value: func() string { return value },
+		Validate: func(v string) error {
+			if v == "" {
+				return fmt.Errorf("empty value")
+			}
+			return nil
+		},
+		Apply: func(v string) error {
+			value = v
+			return nil
+		},
+	})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	return watcher, &value
+}
+
+func TestSet(t *testing.T) {
+	cases := []struct {
+		desc  string
+		name  string
+		value string
+		res   string
+		err   bool
+	}{
+		{
+			desc:  "set valid value",
+			name:  "greeting",
+			value: "updated",
+			res:   "updated",
+		},
+		{
+			desc:  "set invalid value",
+			name:  "greeting",
+			value: "",
+			res:   "initial",
+			err:   true,
+		},
+		{
+			desc:  "set unknown setting",
+			name:  "unknown",
+			value: "updated",
+			res:   "initial",
+			err:   true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			watcher, value := newWatcher(t)
+			err := watcher.Set(context.Background(), tc.name, tc.value)
+			if tc.err {
+				assert.NotNil(t, err, "expected error")
+			} else {
+				assert.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+			}
+			assert.Equal(t, tc.res, *value)
+		})
+	}
+}
+
+func TestHandler(t *testing.T) {
+	watcher, value := newWatcher(t)
+	srv := httptest.NewServer(watcher.Handler())
+	defer srv.Close()
+
+	res, err := http.Get(srv.URL)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	req, err := http.NewRequest(http.MethodPatch, srv.URL, strings.NewReader(`{"greeting":"patched"}`))
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	res, err = http.DefaultClient.Do(req)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, "patched", *value)
+
+	req, err = http.NewRequest(http.MethodPatch, srv.URL, strings.NewReader(`{"greeting":""}`))
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	res, err = http.DefaultClient.Do(req)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+	assert.Equal(t, "patched", *value)
+}
@@ -0,0 +1,30 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package requestid provides context propagation of per-request
+// correlation IDs across HTTP handlers, log lines and gRPC calls.
+package requestid
+
+import "context"
+
+// Header is the HTTP header used to propagate the correlation ID.
+const Header = "X-Request-ID"
+
+// MetadataKey is the gRPC metadata key used to propagate the correlation ID.
+const MetadataKey = "x-request-id"
+
+type requestIDKeyType struct{}
+
+var requestIDKey requestIDKeyType
+
+// Put returns a copy of ctx carrying the given correlation ID.
+func Put(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey, id)
+}
+
+// Get returns the correlation ID carried by ctx, or an empty
+// string if none is set.
+func Get(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey).(string)
+	return id
+}
@@ -47,6 +47,36 @@ func (_m *RoleManager) AddRole(ctx context.Context, session authn.Session, entit
 	return r0, r1
 }
 
+// AddRoles provides a mock function with given fields: ctx, session, entityID, rps
+func (_m *RoleManager) AddRoles(ctx context.Context, session authn.Session, entityID string, rps []roles.RoleProvision) ([]roles.RoleProvision, error) {
+	ret := _m.Called(ctx, session, entityID, rps)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddRoles")
+	}
+
+	var r0 []roles.RoleProvision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, string, []roles.RoleProvision) ([]roles.RoleProvision, error)); ok {
+		return rf(ctx, session, entityID, rps)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, string, []roles.RoleProvision) []roles.RoleProvision); ok {
+		r0 = rf(ctx, session, entityID, rps)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]roles.RoleProvision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, authn.Session, string, []roles.RoleProvision) error); ok {
+		r1 = rf(ctx, session, entityID, rps)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListAvailableActions provides a mock function with given fields: ctx, session
 func (_m *RoleManager) ListAvailableActions(ctx context.Context, session authn.Session) ([]string, error) {
 	ret := _m.Called(ctx, session)
@@ -77,6 +107,92 @@ func (_m *RoleManager) ListAvailableActions(ctx context.Context, session authn.S
 	return r0, r1
 }
 
+// ListEntityMembers provides a mock function with given fields: ctx, session, entityID, limit, offset
+func (_m *RoleManager) ListEntityMembers(ctx context.Context, session authn.Session, entityID string, limit uint64, offset uint64) (roles.EntityMembersPage, error) {
+	ret := _m.Called(ctx, session, entityID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEntityMembers")
+	}
+
+	var r0 roles.EntityMembersPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, string, uint64, uint64) (roles.EntityMembersPage, error)); ok {
+		return rf(ctx, session, entityID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, string, uint64, uint64) roles.EntityMembersPage); ok {
+		r0 = rf(ctx, session, entityID, limit, offset)
+	} else {
+		r0 = ret.Get(0).(roles.EntityMembersPage)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, authn.Session, string, uint64, uint64) error); ok {
+		r1 = rf(ctx, session, entityID, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListMemberEntities provides a mock function with given fields: ctx, session, memberID, limit, offset
+func (_m *RoleManager) ListMemberEntities(ctx context.Context, session authn.Session, memberID string, limit uint64, offset uint64) (roles.MemberEntitiesPage, error) {
+	ret := _m.Called(ctx, session, memberID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListMemberEntities")
+	}
+
+	var r0 roles.MemberEntitiesPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, string, uint64, uint64) (roles.MemberEntitiesPage, error)); ok {
+		return rf(ctx, session, memberID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, string, uint64, uint64) roles.MemberEntitiesPage); ok {
+		r0 = rf(ctx, session, memberID, limit, offset)
+	} else {
+		r0 = ret.Get(0).(roles.MemberEntitiesPage)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, authn.Session, string, uint64, uint64) error); ok {
+		r1 = rf(ctx, session, memberID, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRoleTemplates provides a mock function with given fields: ctx, session
+func (_m *RoleManager) ListRoleTemplates(ctx context.Context, session authn.Session) (map[string][]string, error) {
+	ret := _m.Called(ctx, session)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRoleTemplates")
+	}
+
+	var r0 map[string][]string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session) (map[string][]string, error)); ok {
+		return rf(ctx, session)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session) map[string][]string); ok {
+		r0 = rf(ctx, session)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, authn.Session) error); ok {
+		r1 = rf(ctx, session)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RemoveMemberFromAllRoles provides a mock function with given fields: ctx, session, memberID
 func (_m *RoleManager) RemoveMemberFromAllRoles(ctx context.Context, session authn.Session, memberID string) error {
 	ret := _m.Called(ctx, session, memberID)
@@ -415,6 +531,34 @@ func (_m *RoleManager) RoleRemoveMembers(ctx context.Context, session authn.Sess
 	return r0
 }
 
+// SetRoleInheritance provides a mock function with given fields: ctx, session, entityID, roleID, inheritsFrom
+func (_m *RoleManager) SetRoleInheritance(ctx context.Context, session authn.Session, entityID string, roleID string, inheritsFrom string) (roles.Role, error) {
+	ret := _m.Called(ctx, session, entityID, roleID, inheritsFrom)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetRoleInheritance")
+	}
+
+	var r0 roles.Role
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, string, string, string) (roles.Role, error)); ok {
+		return rf(ctx, session, entityID, roleID, inheritsFrom)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, string, string, string) roles.Role); ok {
+		r0 = rf(ctx, session, entityID, roleID, inheritsFrom)
+	} else {
+		r0 = ret.Get(0).(roles.Role)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, authn.Session, string, string, string) error); ok {
+		r1 = rf(ctx, session, entityID, roleID, inheritsFrom)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateRoleName provides a mock function with given fields: ctx, session, entityID, roleID, newRoleName
 func (_m *RoleManager) UpdateRoleName(ctx context.Context, session authn.Session, entityID string, roleID string, newRoleName string) (roles.Role, error) {
 	ret := _m.Called(ctx, session, entityID, roleID, newRoleName)
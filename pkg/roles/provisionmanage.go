@@ -19,6 +19,7 @@ var (
 	errRemoveOptionalDeletePolicies       = errors.New("failed to delete the additional requested policies")
 	errRemoveOptionalFilterDeletePolicies = errors.New("failed to filter delete the additional requested policies")
 	errRollbackRoles                      = errors.New("failed to rollback roles")
+	errRoleInheritanceCycle               = errors.New("role inheritance cycle")
 )
 
 type roleProvisionerManger interface {
@@ -292,6 +293,78 @@ func (r ProvisionManageService) AddRole(ctx context.Context, session authn.Sessi
 	return nrps[0], nil
 }
 
+func (r ProvisionManageService) AddRoles(ctx context.Context, session authn.Session, entityID string, rps []RoleProvision) (retRolesProvision []RoleProvision, retErr error) {
+	newRolesProvision := []RoleProvision{}
+	prs := []policies.Policy{}
+
+	for _, rp := range rps {
+		sid, err := r.sidProvider.ID()
+		if err != nil {
+			return []RoleProvision{}, errors.Wrap(svcerr.ErrCreateEntity, err)
+		}
+
+		id := r.entityType + "_" + sid
+
+		if err := r.validateActions(toRolesActions(rp.OptionalActions)); err != nil {
+			return []RoleProvision{}, errors.Wrap(svcerr.ErrMalformedEntity, err)
+		}
+
+		newRolesProvision = append(newRolesProvision, RoleProvision{
+			Role: Role{
+				ID:        id,
+				Name:      rp.Name,
+				EntityID:  entityID,
+				CreatedAt: time.Now(),
+				CreatedBy: session.UserID,
+			},
+			OptionalActions: rp.OptionalActions,
+			OptionalMembers: rp.OptionalMembers,
+		})
+
+		for _, cap := range rp.OptionalActions {
+			prs = append(prs, policies.Policy{
+				SubjectType:     policies.RoleType,
+				SubjectRelation: policies.MemberRelation,
+				Subject:         id,
+				Relation:        cap,
+				Object:          entityID,
+				ObjectType:      r.entityType,
+			})
+		}
+
+		for _, member := range rp.OptionalMembers {
+			prs = append(prs, policies.Policy{
+				SubjectType: policies.UserType,
+				Subject:     policies.EncodeDomainUserID(session.DomainID, member),
+				Relation:    policies.MemberRelation,
+				Object:      id,
+				ObjectType:  policies.RoleType,
+			})
+		}
+	}
+
+	if len(prs) > 0 {
+		if err := r.policy.AddPolicies(ctx, prs); err != nil {
+			return []RoleProvision{}, errors.Wrap(svcerr.ErrCreateEntity, err)
+		}
+
+		defer func() {
+			if retErr != nil {
+				if errRollBack := r.policy.DeletePolicies(ctx, prs); errRollBack != nil {
+					retErr = errors.Wrap(retErr, errors.Wrap(errRollbackRoles, errRollBack))
+				}
+			}
+		}()
+	}
+
+	nrps, err := r.repo.AddRoles(ctx, newRolesProvision)
+	if err != nil {
+		return []RoleProvision{}, errors.Wrap(svcerr.ErrCreateEntity, err)
+	}
+
+	return nrps, nil
+}
+
 func (r ProvisionManageService) RemoveRole(ctx context.Context, session authn.Session, entityID, roleID string) error {
 	ro, err := r.repo.RetrieveEntityRole(ctx, entityID, roleID)
 	if err != nil {
@@ -329,6 +402,151 @@ func (r ProvisionManageService) UpdateRoleName(ctx context.Context, session auth
 	return ro, nil
 }
 
+// effectiveActions returns the actions granted to the role directly or
+// through its inheritance chain, in chain order without duplicates.
+func (r ProvisionManageService) effectiveActions(ctx context.Context, roleID string) ([]string, error) {
+	seen := map[string]struct{}{}
+	have := map[string]struct{}{}
+	acts := []string{}
+	for id := roleID; id != ""; {
+		if _, ok := seen[id]; ok {
+			break
+		}
+		seen[id] = struct{}{}
+
+		roActs, err := r.repo.RoleListActions(ctx, id)
+		if err != nil {
+			return []string{}, err
+		}
+		for _, act := range roActs {
+			if _, ok := have[act]; !ok {
+				have[act] = struct{}{}
+				acts = append(acts, act)
+			}
+		}
+
+		ro, err := r.repo.RetrieveRole(ctx, id)
+		if err != nil {
+			return []string{}, err
+		}
+		id = ro.InheritsFrom
+	}
+	return acts, nil
+}
+
+func (r ProvisionManageService) SetRoleInheritance(ctx context.Context, session authn.Session, entityID, roleID, inheritsFrom string) (Role, error) {
+	ro, err := r.repo.RetrieveEntityRole(ctx, entityID, roleID)
+	if err != nil {
+		return Role{}, errors.Wrap(svcerr.ErrUpdateEntity, err)
+	}
+
+	if inheritsFrom != "" {
+		if _, err := r.repo.RetrieveEntityRole(ctx, entityID, inheritsFrom); err != nil {
+			return Role{}, errors.Wrap(svcerr.ErrUpdateEntity, err)
+		}
+
+		seen := map[string]struct{}{ro.ID: {}}
+		for id := inheritsFrom; id != ""; {
+			if _, ok := seen[id]; ok {
+				return Role{}, errors.Wrap(svcerr.ErrMalformedEntity, errRoleInheritanceCycle)
+			}
+			seen[id] = struct{}{}
+
+			parent, err := r.repo.RetrieveRole(ctx, id)
+			if err != nil {
+				return Role{}, errors.Wrap(svcerr.ErrUpdateEntity, err)
+			}
+			id = parent.InheritsFrom
+		}
+	}
+
+	direct, err := r.repo.RoleListActions(ctx, ro.ID)
+	if err != nil {
+		return Role{}, errors.Wrap(svcerr.ErrUpdateEntity, err)
+	}
+	keep := map[string]struct{}{}
+	for _, act := range direct {
+		keep[act] = struct{}{}
+	}
+
+	oldInherited := []string{}
+	if ro.InheritsFrom != "" {
+		if oldInherited, err = r.effectiveActions(ctx, ro.InheritsFrom); err != nil {
+			return Role{}, errors.Wrap(svcerr.ErrUpdateEntity, err)
+		}
+	}
+	newInherited := []string{}
+	if inheritsFrom != "" {
+		if newInherited, err = r.effectiveActions(ctx, inheritsFrom); err != nil {
+			return Role{}, errors.Wrap(svcerr.ErrUpdateEntity, err)
+		}
+	}
+	newSet := map[string]struct{}{}
+	for _, act := range newInherited {
+		newSet[act] = struct{}{}
+	}
+
+	removePrs := []policies.Policy{}
+	for _, act := range oldInherited {
+		if _, ok := keep[act]; ok {
+			continue
+		}
+		if _, ok := newSet[act]; ok {
+			continue
+		}
+		removePrs = append(removePrs, r.roleActionPolicy(ro.ID, entityID, act))
+	}
+
+	oldSet := map[string]struct{}{}
+	for _, act := range oldInherited {
+		oldSet[act] = struct{}{}
+	}
+	addPrs := []policies.Policy{}
+	for _, act := range newInherited {
+		if _, ok := keep[act]; ok {
+			continue
+		}
+		if _, ok := oldSet[act]; ok {
+			continue
+		}
+		addPrs = append(addPrs, r.roleActionPolicy(ro.ID, entityID, act))
+	}
+
+	if len(removePrs) > 0 {
+		if err := r.policy.DeletePolicies(ctx, removePrs); err != nil {
+			return Role{}, errors.Wrap(svcerr.ErrDeletePolicies, err)
+		}
+	}
+	if len(addPrs) > 0 {
+		if err := r.policy.AddPolicies(ctx, addPrs); err != nil {
+			return Role{}, errors.Wrap(svcerr.ErrAddPolicies, err)
+		}
+	}
+
+	ro, err = r.repo.SetRoleInheritance(ctx, Role{
+		ID:           ro.ID,
+		EntityID:     entityID,
+		InheritsFrom: inheritsFrom,
+		UpdatedBy:    session.UserID,
+		UpdatedAt:    time.Now(),
+	})
+	if err != nil {
+		return Role{}, errors.Wrap(svcerr.ErrUpdateEntity, err)
+	}
+	return ro, nil
+}
+
+func (r ProvisionManageService) roleActionPolicy(roleID, entityID, action string) policies.Policy {
+	return policies.Policy{
+		SubjectType:     policies.RoleType,
+		SubjectRelation: policies.MemberRelation,
+		Subject:         roleID,
+		Relation:        action,
+		Object:          entityID,
+		ObjectType:      r.entityType,
+	}
+}
+
 func (r ProvisionManageService) RetrieveRole(ctx context.Context, session authn.Session, entityID, roleID string) (Role, error) {
 	ro, err := r.repo.RetrieveEntityRole(ctx, entityID, roleID)
 	if err != nil {
@@ -353,6 +571,32 @@ func (r ProvisionManageService) ListAvailableActions(ctx context.Context, sessio
 	return acts, nil
 }
 
+// ListRoleTemplates returns the built-in role templates configured for the
+// entity type, mapping role names to the actions they grant.
+func (r ProvisionManageService) ListRoleTemplates(ctx context.Context, session authn.Session) (map[string][]string, error) {
+	templates := make(map[string][]string, len(r.builtInRoles))
+	for name, actions := range r.builtInRoles {
+		templates[name.String()] = roleActionsToString(actions)
+	}
+	return templates, nil
+}
+
+// DefaultRoleMembers returns membership for every built-in role template so
+// that newly created entities receive the full standard role set. The given
+// members are assigned to the admin role, while the remaining roles are
+// created without members.
+func (r ProvisionManageService) DefaultRoleMembers(admin BuiltInRoleName, members []Member) map[BuiltInRoleName][]Member {
+	mems := make(map[BuiltInRoleName][]Member, len(r.builtInRoles))
+	for name := range r.builtInRoles {
+		if name == admin {
+			mems[name] = members
+			continue
+		}
+		mems[name] = []Member{}
+	}
+	return mems
+}
+
 func (r ProvisionManageService) RoleAddActions(ctx context.Context, session authn.Session, entityID, roleID string, actions []string) (retActs []string, retErr error) {
 	ro, err := r.repo.RetrieveEntityRole(ctx, entityID, roleID)
 	if err != nil {
@@ -420,11 +664,20 @@ func (r ProvisionManageService) RoleCheckActionsExists(ctx context.Context, sess
 		return false, errors.Wrap(svcerr.ErrViewEntity, err)
 	}
 
-	result, err := r.repo.RoleCheckActionsExists(ctx, ro.ID, actions)
+	effective, err := r.effectiveActions(ctx, ro.ID)
 	if err != nil {
 		return true, errors.Wrap(svcerr.ErrViewEntity, err)
 	}
-	return result, nil
+	have := map[string]struct{}{}
+	for _, act := range effective {
+		have[act] = struct{}{}
+	}
+	for _, act := range actions {
+		if _, ok := have[act]; !ok {
+			return false, nil
+		}
+	}
+	return true, nil
 }
 
 func (r ProvisionManageService) RoleRemoveActions(ctx context.Context, session authn.Session, entityID, roleID string, actions []string) (err error) {
@@ -540,6 +793,22 @@ func (r ProvisionManageService) RoleListMembers(ctx context.Context, session aut
 	return mp, nil
 }
 
+func (r ProvisionManageService) ListEntityMembers(ctx context.Context, session authn.Session, entityID string, limit, offset uint64) (EntityMembersPage, error) {
+	emp, err := r.repo.ListEntityMembers(ctx, entityID, limit, offset)
+	if err != nil {
+		return EntityMembersPage{}, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+	return emp, nil
+}
+
+func (r ProvisionManageService) ListMemberEntities(ctx context.Context, session authn.Session, memberID string, limit, offset uint64) (MemberEntitiesPage, error) {
+	mep, err := r.repo.ListMemberEntities(ctx, memberID, limit, offset)
+	if err != nil {
+		return MemberEntitiesPage{}, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+	return mep, nil
+}
+
 func (r ProvisionManageService) RoleCheckMembersExists(ctx context.Context, session authn.Session, entityID, roleID string, members []string) (bool, error) {
 	ro, err := r.repo.RetrieveEntityRole(ctx, entityID, roleID)
 	if err != nil {
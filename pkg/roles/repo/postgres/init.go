@@ -54,6 +54,17 @@ func Migration(rolesTableNamePrefix, entityTableName, entityIDColumnName string)
 					fmt.Sprintf(`DROP TABLE IF EXISTS %s_roles_members`, rolesTableNamePrefix),
 				},
 			},
+			{
+				Id: fmt.Sprintf("%s_roles_2", rolesTableNamePrefix),
+				Up: []string{
+					fmt.Sprintf(`ALTER TABLE %s_roles ADD COLUMN IF NOT EXISTS inherits_from VARCHAR(254)
+						CONSTRAINT fk_%s_roles_inherits_from REFERENCES %s_roles(id) ON DELETE SET NULL;`,
+						rolesTableNamePrefix, rolesTableNamePrefix, rolesTableNamePrefix),
+				},
+				Down: []string{
+					fmt.Sprintf(`ALTER TABLE %s_roles DROP COLUMN IF EXISTS inherits_from;`, rolesTableNamePrefix),
+				},
+			},
 		},
 	}, nil
 }
@@ -42,17 +42,19 @@ type dbPage struct {
 	Name     string `db:"name"`
 	EntityID string `db:"entity_id"`
 	RoleID   string `db:"role_id"`
+	MemberID string `db:"member_id"`
 	Limit    uint64 `db:"limit"`
 	Offset   uint64 `db:"offset"`
 }
 type dbRole struct {
-	ID        string       `db:"id"`
-	Name      string       `db:"name"`
-	EntityID  string       `db:"entity_id"`
-	CreatedBy *string      `db:"created_by"`
-	CreatedAt sql.NullTime `db:"created_at"`
-	UpdatedBy *string      `db:"updated_by"`
-	UpdatedAt sql.NullTime `db:"updated_at"`
+	ID           string       `db:"id"`
+	Name         string       `db:"name"`
+	EntityID     string       `db:"entity_id"`
+	InheritsFrom *string      `db:"inherits_from"`
+	CreatedBy    *string      `db:"created_by"`
+	CreatedAt    sql.NullTime `db:"created_at"`
+	UpdatedBy    *string      `db:"updated_by"`
+	UpdatedAt    sql.NullTime `db:"updated_at"`
 }
 
 type dbEntityActionRole struct {
@@ -100,6 +102,18 @@ type dbRoleMember struct {
 	MemberID string `db:"member_id"`
 }
 
+type dbEntityMember struct {
+	MemberID string `db:"member_id"`
+	RoleID   string `db:"role_id"`
+	RoleName string `db:"role_name"`
+}
+
+type dbMemberEntity struct {
+	EntityID string `db:"entity_id"`
+	RoleID   string `db:"role_id"`
+	RoleName string `db:"role_name"`
+}
+
 func toDBRoles(role roles.Role) dbRole {
 	var createdBy *string
 	if role.CreatedBy != "" {
@@ -119,14 +133,20 @@ func toDBRoles(role roles.Role) dbRole {
 		updatedAt = sql.NullTime{Time: role.UpdatedAt, Valid: true}
 	}
 
+	var inheritsFrom *string
+	if role.InheritsFrom != "" {
+		inheritsFrom = &role.InheritsFrom
+	}
+
 	return dbRole{
-		ID:        role.ID,
-		Name:      role.Name,
-		EntityID:  role.EntityID,
-		CreatedBy: createdBy,
-		CreatedAt: createdAt,
-		UpdatedBy: updatedBy,
-		UpdatedAt: updatedAt,
+		ID:           role.ID,
+		Name:         role.Name,
+		EntityID:     role.EntityID,
+		InheritsFrom: inheritsFrom,
+		CreatedBy:    createdBy,
+		CreatedAt:    createdAt,
+		UpdatedBy:    updatedBy,
+		UpdatedAt:    updatedAt,
 	}
 }
 
@@ -149,14 +169,20 @@ func toRole(r dbRole) roles.Role {
 		updatedAt = r.UpdatedAt.Time
 	}
 
+	var inheritsFrom string
+	if r.InheritsFrom != nil {
+		inheritsFrom = *r.InheritsFrom
+	}
+
 	return roles.Role{
-		ID:        r.ID,
-		Name:      r.Name,
-		EntityID:  r.EntityID,
-		CreatedBy: createdBy,
-		CreatedAt: createdAt,
-		UpdatedBy: updatedBy,
-		UpdatedAt: updatedAt,
+		ID:           r.ID,
+		Name:         r.Name,
+		EntityID:     r.EntityID,
+		InheritsFrom: inheritsFrom,
+		CreatedBy:    createdBy,
+		CreatedAt:    createdAt,
+		UpdatedBy:    updatedBy,
+		UpdatedAt:    updatedAt,
 	}
 }
 
@@ -174,8 +200,8 @@ func (repo *Repository) AddRoles(ctx context.Context, rps []roles.RoleProvision)
 	}()
 
 	for _, rp := range rps {
-		q := fmt.Sprintf(`INSERT INTO %s_roles (id, name, entity_id, created_by, created_at, updated_by, updated_at)
-        VALUES (:id, :name, :entity_id, :created_by, :created_at, :updated_by, :updated_at);`, repo.tableNamePrefix)
+		q := fmt.Sprintf(`INSERT INTO %s_roles (id, name, entity_id, inherits_from, created_by, created_at, updated_by, updated_at)
+        VALUES (:id, :name, :entity_id, :inherits_from, :created_by, :created_at, :updated_by, :updated_at);`, repo.tableNamePrefix)
 
 		if _, err := tx.NamedExec(q, toDBRoles(rp.Role)); err != nil {
 			return []roles.RoleProvision{}, postgres.HandleError(repoerr.ErrCreateEntity, err)
@@ -254,7 +280,7 @@ func (repo *Repository) UpdateRole(ctx context.Context, role roles.Role) (roles.
 
 	q := fmt.Sprintf(`UPDATE %s_roles SET %s updated_at = :updated_at, updated_by = :updated_by
         WHERE id = :id
-        RETURNING id, name, entity_id, created_by, created_at, updated_by, updated_at`,
+        RETURNING id, name, entity_id, inherits_from, created_by, created_at, updated_by, updated_at`,
 		repo.tableNamePrefix, upq)
 
 	row, err := repo.db.NamedQueryContext(ctx, q, toDBRoles(role))
@@ -274,8 +300,31 @@ func (repo *Repository) UpdateRole(ctx context.Context, role roles.Role) (roles.
 	return roles.Role{}, repoerr.ErrNotFound
 }
 
+func (repo *Repository) SetRoleInheritance(ctx context.Context, role roles.Role) (roles.Role, error) {
+	q := fmt.Sprintf(`UPDATE %s_roles SET inherits_from = :inherits_from, updated_at = :updated_at, updated_by = :updated_by
+        WHERE id = :id
+        RETURNING id, name, entity_id, inherits_from, created_by, created_at, updated_by, updated_at`,
+		repo.tableNamePrefix)
+
+	row, err := repo.db.NamedQueryContext(ctx, q, toDBRoles(role))
+	if err != nil {
+		return roles.Role{}, postgres.HandleError(repoerr.ErrUpdateEntity, err)
+	}
+	defer row.Close()
+
+	dbr := dbRole{}
+	if row.Next() {
+		if err := row.StructScan(&dbr); err != nil {
+			return roles.Role{}, errors.Wrap(repoerr.ErrUpdateEntity, err)
+		}
+		return toRole(dbr), nil
+	}
+
+	return roles.Role{}, repoerr.ErrNotFound
+}
+
 func (repo *Repository) RetrieveRole(ctx context.Context, roleID string) (roles.Role, error) {
-	q := fmt.Sprintf(`SELECT id, name, entity_id, created_by, created_at, updated_by, updated_at
+	q := fmt.Sprintf(`SELECT id, name, entity_id, inherits_from, created_by, created_at, updated_by, updated_at
         FROM %s_roles WHERE id = :id`, repo.tableNamePrefix)
 
 	dbr := dbRole{
@@ -301,7 +350,7 @@ func (repo *Repository) RetrieveRole(ctx context.Context, roleID string) (roles.
 }
 
 func (repo *Repository) RetrieveEntityRole(ctx context.Context, entityID, roleID string) (roles.Role, error) {
-	q := fmt.Sprintf(`SELECT id, name, entity_id, created_by, created_at, updated_by, updated_at
+	q := fmt.Sprintf(`SELECT id, name, entity_id, inherits_from, created_by, created_at, updated_by, updated_at
         FROM %s_roles WHERE entity_id = :entity_id and id = :id`, repo.tableNamePrefix)
 
 	dbr := dbRole{
@@ -328,7 +377,7 @@ func (repo *Repository) RetrieveEntityRole(ctx context.Context, entityID, roleID
 }
 
 func (repo *Repository) RetrieveAllRoles(ctx context.Context, entityID string, limit, offset uint64) (roles.RolePage, error) {
-	q := fmt.Sprintf(`SELECT id, name, entity_id, created_by, created_at, updated_by, updated_at
+	q := fmt.Sprintf(`SELECT id, name, entity_id, inherits_from, created_by, created_at, updated_by, updated_at
     	FROM %s_roles WHERE entity_id = :entity_id ORDER BY created_at LIMIT :limit OFFSET :offset;`, repo.tableNamePrefix)
 
 	dbp := dbPage{
@@ -612,6 +661,100 @@ func (repo *Repository) RoleListMembers(ctx context.Context, roleID string, limi
 	}, nil
 }
 
+func (repo *Repository) ListEntityMembers(ctx context.Context, entityID string, limit, offset uint64) (roles.EntityMembersPage, error) {
+	q := fmt.Sprintf(`SELECT rm.member_id, rm.role_id, r.name AS role_name FROM %s_role_members rm
+		JOIN %s_roles r ON rm.role_id = r.id
+		WHERE r.entity_id = :entity_id ORDER BY rm.member_id LIMIT :limit OFFSET :offset;`, repo.tableNamePrefix, repo.tableNamePrefix)
+
+	dbp := dbPage{
+		EntityID: entityID,
+		Limit:    limit,
+		Offset:   offset,
+	}
+
+	rows, err := repo.db.NamedQueryContext(ctx, q, dbp)
+	if err != nil {
+		return roles.EntityMembersPage{}, errors.Wrap(repoerr.ErrViewEntity, err)
+	}
+	defer rows.Close()
+
+	items := []roles.EntityMember{}
+	for rows.Next() {
+		dbem := dbEntityMember{}
+		if err := rows.StructScan(&dbem); err != nil {
+			return roles.EntityMembersPage{}, errors.Wrap(repoerr.ErrViewEntity, err)
+		}
+
+		items = append(items, roles.EntityMember{
+			MemberID: dbem.MemberID,
+			RoleID:   dbem.RoleID,
+			RoleName: dbem.RoleName,
+		})
+	}
+
+	cq := fmt.Sprintf(`SELECT COUNT(*) FROM %s_role_members rm
+		JOIN %s_roles r ON rm.role_id = r.id
+		WHERE r.entity_id = :entity_id`, repo.tableNamePrefix, repo.tableNamePrefix)
+
+	total, err := postgres.Total(ctx, repo.db, cq, dbp)
+	if err != nil {
+		return roles.EntityMembersPage{}, errors.Wrap(repoerr.ErrViewEntity, err)
+	}
+
+	return roles.EntityMembersPage{
+		Members: items,
+		Total:   total,
+		Offset:  offset,
+		Limit:   limit,
+	}, nil
+}
+
+func (repo *Repository) ListMemberEntities(ctx context.Context, memberID string, limit, offset uint64) (roles.MemberEntitiesPage, error) {
+	q := fmt.Sprintf(`SELECT r.entity_id, rm.role_id, r.name AS role_name FROM %s_role_members rm
+		JOIN %s_roles r ON rm.role_id = r.id
+		WHERE rm.member_id = :member_id ORDER BY r.entity_id LIMIT :limit OFFSET :offset;`, repo.tableNamePrefix, repo.tableNamePrefix)
+
+	dbp := dbPage{
+		MemberID: memberID,
+		Limit:    limit,
+		Offset:   offset,
+	}
+
+	rows, err := repo.db.NamedQueryContext(ctx, q, dbp)
+	if err != nil {
+		return roles.MemberEntitiesPage{}, errors.Wrap(repoerr.ErrViewEntity, err)
+	}
+	defer rows.Close()
+
+	items := []roles.MemberEntity{}
+	for rows.Next() {
+		dbme := dbMemberEntity{}
+		if err := rows.StructScan(&dbme); err != nil {
+			return roles.MemberEntitiesPage{}, errors.Wrap(repoerr.ErrViewEntity, err)
+		}
+
+		items = append(items, roles.MemberEntity{
+			EntityID: dbme.EntityID,
+			RoleID:   dbme.RoleID,
+			RoleName: dbme.RoleName,
+		})
+	}
+
+	cq := fmt.Sprintf(`SELECT COUNT(*) FROM %s_role_members WHERE member_id = :member_id`, repo.tableNamePrefix)
+
+	total, err := postgres.Total(ctx, repo.db, cq, dbp)
+	if err != nil {
+		return roles.MemberEntitiesPage{}, errors.Wrap(repoerr.ErrViewEntity, err)
+	}
+
+	return roles.MemberEntitiesPage{
+		Entities: items,
+		Total:    total,
+		Offset:   offset,
+		Limit:    limit,
+	}, nil
+}
+
 func (repo *Repository) RoleCheckMembersExists(ctx context.Context, roleID string, members []string) (bool, error) {
 	q := fmt.Sprintf(`SELECT COUNT(*) FROM %s_role_members WHERE role_id = :role_id AND action IN (:members)`, repo.tableNamePrefix)
 
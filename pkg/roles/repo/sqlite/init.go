@@ -0,0 +1,63 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlite
+
+import (
+	"fmt"
+
+	migrate "github.com/rubenv/sql-migrate"
+	_ "modernc.org/sqlite" // required for SQL access
+)
+
+// Migration returns the roles migrations for the given entity. The SQLite
+// backend starts from a fresh database, so a single migration captures the
+// schema the Postgres migrations converge to.
+func Migration(rolesTableNamePrefix, entityTableName, entityIDColumnName string) (*migrate.MemoryMigrationSource, error) {
+	if entityTableName == "" || entityIDColumnName == "" {
+		return nil, fmt.Errorf("invalid entity Table Name or column name")
+	}
+
+	return &migrate.MemoryMigrationSource{
+		Migrations: []*migrate.Migration{
+			{
+				Id: fmt.Sprintf("%s_roles_sqlite_1", rolesTableNamePrefix),
+				Up: []string{
+					fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s_roles (
+                        id            VARCHAR(254) NOT NULL PRIMARY KEY,
+                        name          VARCHAR(200) NOT NULL,
+                        entity_id     VARCHAR(36)  NOT NULL,
+                        inherits_from VARCHAR(254),
+                        created_at    TIMESTAMP,
+                        updated_at    TIMESTAMP,
+                        updated_by    VARCHAR(254),
+                        created_by    VARCHAR(254),
+                        CONSTRAINT    unique_role_name_entity_id_constraint UNIQUE ( name, entity_id),
+                        CONSTRAINT    fk_entity_id FOREIGN KEY(entity_id) REFERENCES %s(%s) ON DELETE CASCADE,
+                        CONSTRAINT    fk_%s_roles_inherits_from FOREIGN KEY(inherits_from) REFERENCES %s_roles(id) ON DELETE SET NULL
+                    );`, rolesTableNamePrefix, entityTableName, entityIDColumnName, rolesTableNamePrefix, rolesTableNamePrefix),
+
+					fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s_role_actions (
+                        role_id     VARCHAR(254) NOT NULL,
+                        action      VARCHAR(254) NOT NULL,
+                        CONSTRAINT  unique_domain_role_action_constraint UNIQUE ( role_id, action),
+                        CONSTRAINT  fk_%s_roles_id FOREIGN KEY(role_id) REFERENCES %s_roles(id) ON DELETE CASCADE
+
+                    );`, rolesTableNamePrefix, rolesTableNamePrefix, rolesTableNamePrefix),
+
+					fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s_role_members (
+                        role_id     VARCHAR(254) NOT NULL,
+                        member_id   VARCHAR(254) NOT NULL,
+                        CONSTRAINT  unique_role_member_constraint UNIQUE (role_id, member_id),
+                        CONSTRAINT  fk_%s_roles_id FOREIGN KEY(role_id) REFERENCES %s_roles(id) ON DELETE CASCADE
+                    );`, rolesTableNamePrefix, rolesTableNamePrefix, rolesTableNamePrefix),
+				},
+				Down: []string{
+					fmt.Sprintf(`DROP TABLE IF EXISTS %s_role_members`, rolesTableNamePrefix),
+					fmt.Sprintf(`DROP TABLE IF EXISTS %s_role_actions`, rolesTableNamePrefix),
+					fmt.Sprintf(`DROP TABLE IF EXISTS %s_roles`, rolesTableNamePrefix),
+				},
+			},
+		},
+	}, nil
+}
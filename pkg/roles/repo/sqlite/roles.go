@@ -0,0 +1,931 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	apiutil "github.com/absmach/supermq/api/http/util"
+	"github.com/absmach/supermq/pkg/errors"
+	repoerr "github.com/absmach/supermq/pkg/errors/repository"
+	"github.com/absmach/supermq/pkg/postgres"
+	"github.com/absmach/supermq/pkg/roles"
+	"github.com/absmach/supermq/pkg/sqlite"
+)
+
+var _ roles.Repository = (*Repository)(nil)
+
+type Repository struct {
+	db                 postgres.Database
+	tableNamePrefix    string
+	entityTableName    string
+	entityIDColumnName string
+}
+
+// NewRepository instantiates a SQLite
+// implementation of Roles repository.
+func NewRepository(db postgres.Database, tableNamePrefix, entityTableName, entityIDColumnName string) Repository {
+	return Repository{
+		db:                 db,
+		tableNamePrefix:    tableNamePrefix,
+		entityTableName:    entityTableName,
+		entityIDColumnName: entityIDColumnName,
+	}
+}
+
+type dbPage struct {
+	ID       string `db:"id"`
+	Name     string `db:"name"`
+	EntityID string `db:"entity_id"`
+	RoleID   string `db:"role_id"`
+	MemberID string `db:"member_id"`
+	Limit    uint64 `db:"limit"`
+	Offset   uint64 `db:"offset"`
+}
+type dbRole struct {
+	ID           string       `db:"id"`
+	Name         string       `db:"name"`
+	EntityID     string       `db:"entity_id"`
+	InheritsFrom *string      `db:"inherits_from"`
+	CreatedBy    *string      `db:"created_by"`
+	CreatedAt    sql.NullTime `db:"created_at"`
+	UpdatedBy    *string      `db:"updated_by"`
+	UpdatedAt    sql.NullTime `db:"updated_at"`
+}
+
+type dbEntityActionRole struct {
+	EntityID string `db:"entity_id"`
+	Action   string `db:"action"`
+	RoleID   string `db:"role_id"`
+}
+type dbEntityMemberRole struct {
+	EntityID string `db:"entity_id"`
+	MemberID string `db:"member_id"`
+	RoleID   string `db:"role_id"`
+}
+
+func dbToEntityActionRole(dbs []dbEntityActionRole) []roles.EntityActionRole {
+	var r []roles.EntityActionRole
+	for _, d := range dbs {
+		r = append(r, roles.EntityActionRole{
+			EntityID: d.EntityID,
+			Action:   d.Action,
+			RoleID:   d.RoleID,
+		})
+	}
+	return r
+}
+
+func dbToEntityMemberRole(dbs []dbEntityMemberRole) []roles.EntityMemberRole {
+	var r []roles.EntityMemberRole
+	for _, d := range dbs {
+		r = append(r, roles.EntityMemberRole{
+			EntityID: d.EntityID,
+			MemberID: d.MemberID,
+			RoleID:   d.RoleID,
+		})
+	}
+	return r
+}
+
+type dbRoleAction struct {
+	RoleID string `db:"role_id"`
+	Action string `db:"action"`
+}
+
+type dbRoleMember struct {
+	RoleID   string `db:"role_id"`
+	MemberID string `db:"member_id"`
+}
+
+type dbEntityMember struct {
+	MemberID string `db:"member_id"`
+	RoleID   string `db:"role_id"`
+	RoleName string `db:"role_name"`
+}
+
+type dbMemberEntity struct {
+	EntityID string `db:"entity_id"`
+	RoleID   string `db:"role_id"`
+	RoleName string `db:"role_name"`
+}
+
+func toDBRoles(role roles.Role) dbRole {
+	var createdBy *string
+	if role.CreatedBy != "" {
+		createdBy = &role.UpdatedBy
+	}
+	var createdAt sql.NullTime
+	if role.CreatedAt != (time.Time{}) && !role.CreatedAt.IsZero() {
+		createdAt = sql.NullTime{Time: role.CreatedAt, Valid: true}
+	}
+
+	var updatedBy *string
+	if role.UpdatedBy != "" {
+		updatedBy = &role.UpdatedBy
+	}
+	var updatedAt sql.NullTime
+	if role.UpdatedAt != (time.Time{}) && !role.UpdatedAt.IsZero() {
+		updatedAt = sql.NullTime{Time: role.UpdatedAt, Valid: true}
+	}
+
+	var inheritsFrom *string
+	if role.InheritsFrom != "" {
+		inheritsFrom = &role.InheritsFrom
+	}
+
+	return dbRole{
+		ID:           role.ID,
+		Name:         role.Name,
+		EntityID:     role.EntityID,
+		InheritsFrom: inheritsFrom,
+		CreatedBy:    createdBy,
+		CreatedAt:    createdAt,
+		UpdatedBy:    updatedBy,
+		UpdatedAt:    updatedAt,
+	}
+}
+
+func toRole(r dbRole) roles.Role {
+	var createdBy string
+	if r.CreatedBy != nil {
+		createdBy = *r.CreatedBy
+	}
+	var createdAt time.Time
+	if r.CreatedAt.Valid {
+		createdAt = r.CreatedAt.Time
+	}
+
+	var updatedBy string
+	if r.UpdatedBy != nil {
+		updatedBy = *r.UpdatedBy
+	}
+	var updatedAt time.Time
+	if r.UpdatedAt.Valid {
+		updatedAt = r.UpdatedAt.Time
+	}
+
+	var inheritsFrom string
+	if r.InheritsFrom != nil {
+		inheritsFrom = *r.InheritsFrom
+	}
+
+	return roles.Role{
+		ID:           r.ID,
+		Name:         r.Name,
+		EntityID:     r.EntityID,
+		InheritsFrom: inheritsFrom,
+		CreatedBy:    createdBy,
+		CreatedAt:    createdAt,
+		UpdatedBy:    updatedBy,
+		UpdatedAt:    updatedAt,
+	}
+}
+
+func (repo *Repository) AddRoles(ctx context.Context, rps []roles.RoleProvision) ([]roles.RoleProvision, error) {
+	tx, err := repo.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return []roles.RoleProvision{}, errors.Wrap(repoerr.ErrCreateEntity, err)
+	}
+	defer func() {
+		if err != nil {
+			if errRollback := tx.Rollback(); errRollback != nil {
+				err = errors.Wrap(errors.Wrap(apiutil.ErrRollbackTx, errRollback), err)
+			}
+		}
+	}()
+
+	for _, rp := range rps {
+		q := fmt.Sprintf(`INSERT INTO %s_roles (id, name, entity_id, inherits_from, created_by, created_at, updated_by, updated_at)
+        VALUES (:id, :name, :entity_id, :inherits_from, :created_by, :created_at, :updated_by, :updated_at);`, repo.tableNamePrefix)
+
+		if _, err := tx.NamedExec(q, toDBRoles(rp.Role)); err != nil {
+			return []roles.RoleProvision{}, sqlite.HandleError(repoerr.ErrCreateEntity, err)
+		}
+
+		if len(rp.OptionalActions) > 0 {
+			capq := fmt.Sprintf(`INSERT INTO %s_role_actions (role_id, action)
+        				VALUES (:role_id, :action)
+        				RETURNING role_id, action`, repo.tableNamePrefix)
+
+			rCaps := []dbRoleAction{}
+			for _, cap := range rp.OptionalActions {
+				rCaps = append(rCaps, dbRoleAction{
+					RoleID: rp.ID,
+					Action: string(cap),
+				})
+			}
+			if _, err := tx.NamedExec(capq, rCaps); err != nil {
+				return []roles.RoleProvision{}, sqlite.HandleError(repoerr.ErrCreateEntity, err)
+			}
+		}
+
+		if len(rp.OptionalMembers) > 0 {
+			mq := fmt.Sprintf(`INSERT INTO %s_role_members (role_id, member_id)
+					VALUES (:role_id, :member_id)
+					RETURNING role_id, member_id`, repo.tableNamePrefix)
+
+			rMems := []dbRoleMember{}
+			for _, m := range rp.OptionalMembers {
+				rMems = append(rMems, dbRoleMember{
+					RoleID:   rp.ID,
+					MemberID: m,
+				})
+			}
+			if _, err := tx.NamedExec(mq, rMems); err != nil {
+				return []roles.RoleProvision{}, sqlite.HandleError(repoerr.ErrCreateEntity, err)
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return []roles.RoleProvision{}, sqlite.HandleError(repoerr.ErrCreateEntity, err)
+	}
+
+	return rps, nil
+}
+
+func (repo *Repository) RemoveRoles(ctx context.Context, roleIDs []string) error {
+	q := fmt.Sprintf("DELETE FROM %s_roles  WHERE id IN (SELECT value FROM json_each(:role_ids)) ;", repo.tableNamePrefix)
+
+	ids, err := json.Marshal(roleIDs)
+	if err != nil {
+		return errors.Wrap(repoerr.ErrRemoveEntity, err)
+	}
+	params := map[string]interface{}{
+		"role_ids": string(ids),
+	}
+	result, err := repo.db.NamedExecContext(ctx, q, params)
+	if err != nil {
+		return sqlite.HandleError(repoerr.ErrRemoveEntity, err)
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return repoerr.ErrNotFound
+	}
+
+	return nil
+}
+
+// Update only role name, don't update ID.
+func (repo *Repository) UpdateRole(ctx context.Context, role roles.Role) (roles.Role, error) {
+	var query []string
+	var upq string
+	if role.Name != "" {
+		query = append(query, "name = :name,")
+	}
+
+	if len(query) > 0 {
+		upq = strings.Join(query, " ")
+	}
+
+	q := fmt.Sprintf(`UPDATE %s_roles SET %s updated_at = :updated_at, updated_by = :updated_by
+        WHERE id = :id
+        RETURNING id, name, entity_id, inherits_from, created_by, created_at, updated_by, updated_at`,
+		repo.tableNamePrefix, upq)
+
+	row, err := repo.db.NamedQueryContext(ctx, q, toDBRoles(role))
+	if err != nil {
+		return roles.Role{}, sqlite.HandleError(repoerr.ErrUpdateEntity, err)
+	}
+	defer row.Close()
+
+	dbr := dbRole{}
+	if row.Next() {
+		if err := row.StructScan(&dbr); err != nil {
+			return roles.Role{}, errors.Wrap(repoerr.ErrUpdateEntity, err)
+		}
+		return toRole(dbr), nil
+	}
+
+	return roles.Role{}, repoerr.ErrNotFound
+}
+
+func (repo *Repository) SetRoleInheritance(ctx context.Context, role roles.Role) (roles.Role, error) {
+	q := fmt.Sprintf(`UPDATE %s_roles SET inherits_from = :inherits_from, updated_at = :updated_at, updated_by = :updated_by
+        WHERE id = :id
+        RETURNING id, name, entity_id, inherits_from, created_by, created_at, updated_by, updated_at`,
+		repo.tableNamePrefix)
+
+	row, err := repo.db.NamedQueryContext(ctx, q, toDBRoles(role))
+	if err != nil {
+		return roles.Role{}, sqlite.HandleError(repoerr.ErrUpdateEntity, err)
+	}
+	defer row.Close()
+
+	dbr := dbRole{}
+	if row.Next() {
+		if err := row.StructScan(&dbr); err != nil {
+			return roles.Role{}, errors.Wrap(repoerr.ErrUpdateEntity, err)
+		}
+		return toRole(dbr), nil
+	}
+
+	return roles.Role{}, repoerr.ErrNotFound
+}
+
+func (repo *Repository) RetrieveRole(ctx context.Context, roleID string) (roles.Role, error) {
+	q := fmt.Sprintf(`SELECT id, name, entity_id, inherits_from, created_by, created_at, updated_by, updated_at
+        FROM %s_roles WHERE id = :id`, repo.tableNamePrefix)
+
+	dbr := dbRole{
+		ID: roleID,
+	}
+
+	rows, err := repo.db.NamedQueryContext(ctx, q, dbr)
+	if err != nil {
+		return roles.Role{}, sqlite.HandleError(repoerr.ErrViewEntity, err)
+	}
+	defer rows.Close()
+
+	dbr = dbRole{}
+	if rows.Next() {
+		if err = rows.StructScan(&dbr); err != nil {
+			return roles.Role{}, sqlite.HandleError(repoerr.ErrViewEntity, err)
+		}
+
+		return toRole(dbr), nil
+	}
+
+	return roles.Role{}, repoerr.ErrNotFound
+}
+
+func (repo *Repository) RetrieveEntityRole(ctx context.Context, entityID, roleID string) (roles.Role, error) {
+	q := fmt.Sprintf(`SELECT id, name, entity_id, inherits_from, created_by, created_at, updated_by, updated_at
+        FROM %s_roles WHERE entity_id = :entity_id and id = :id`, repo.tableNamePrefix)
+
+	dbr := dbRole{
+		EntityID: entityID,
+		ID:       roleID,
+	}
+
+	rows, err := repo.db.NamedQueryContext(ctx, q, dbr)
+	if err != nil {
+		return roles.Role{}, sqlite.HandleError(repoerr.ErrViewEntity, err)
+	}
+	defer rows.Close()
+
+	dbr = dbRole{}
+	if rows.Next() {
+		if err = rows.StructScan(&dbr); err != nil {
+			return roles.Role{}, sqlite.HandleError(repoerr.ErrViewEntity, err)
+		}
+
+		return toRole(dbr), nil
+	}
+
+	return roles.Role{}, repoerr.ErrNotFound
+}
+
+func (repo *Repository) RetrieveAllRoles(ctx context.Context, entityID string, limit, offset uint64) (roles.RolePage, error) {
+	q := fmt.Sprintf(`SELECT id, name, entity_id, inherits_from, created_by, created_at, updated_by, updated_at
+    	FROM %s_roles WHERE entity_id = :entity_id ORDER BY created_at LIMIT :limit OFFSET :offset;`, repo.tableNamePrefix)
+
+	dbp := dbPage{
+		EntityID: entityID,
+		Limit:    limit,
+		Offset:   offset,
+	}
+
+	rows, err := repo.db.NamedQueryContext(ctx, q, dbp)
+	if err != nil {
+		return roles.RolePage{}, errors.Wrap(repoerr.ErrViewEntity, err)
+	}
+	defer rows.Close()
+
+	items := []roles.Role{}
+	for rows.Next() {
+		dbr := dbRole{}
+		if err := rows.StructScan(&dbr); err != nil {
+			return roles.RolePage{}, errors.Wrap(repoerr.ErrViewEntity, err)
+		}
+
+		items = append(items, toRole(dbr))
+	}
+	cq := fmt.Sprintf(`SELECT COUNT(*) FROM %s_roles WHERE entity_id = :entity_id`, repo.tableNamePrefix)
+
+	total, err := postgres.Total(ctx, repo.db, cq, dbp)
+	if err != nil {
+		return roles.RolePage{}, errors.Wrap(repoerr.ErrViewEntity, err)
+	}
+
+	page := roles.RolePage{
+		Roles:  items,
+		Total:  total,
+		Offset: offset,
+		Limit:  limit,
+	}
+
+	return page, nil
+}
+
+func (repo *Repository) RoleAddActions(ctx context.Context, role roles.Role, actions []string) (caps []string, err error) {
+	tx, err := repo.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return []string{}, errors.Wrap(repoerr.ErrCreateEntity, err)
+	}
+	defer func() {
+		if err != nil {
+			if errRollback := tx.Rollback(); errRollback != nil {
+				err = errors.Wrap(errors.Wrap(apiutil.ErrRollbackTx, errRollback), err)
+			}
+		}
+	}()
+
+	capq := fmt.Sprintf(`INSERT INTO %s_role_actions (role_id, action)
+	VALUES (:role_id, :action)
+	RETURNING role_id, action`, repo.tableNamePrefix)
+
+	rCaps := []dbRoleAction{}
+	for _, cap := range actions {
+		rCaps = append(rCaps, dbRoleAction{
+			RoleID: role.ID,
+			Action: string(cap),
+		})
+	}
+	if _, err := tx.NamedExecContext(ctx, capq, rCaps); err != nil {
+		return []string{}, sqlite.HandleError(repoerr.ErrCreateEntity, err)
+	}
+
+	upq := fmt.Sprintf(`UPDATE %s_roles SET updated_at = :updated_at, updated_by = :updated_by WHERE id = :id;`, repo.tableNamePrefix)
+	if _, err := tx.NamedExecContext(ctx, upq, toDBRoles(role)); err != nil {
+		return []string{}, sqlite.HandleError(repoerr.ErrCreateEntity, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return []string{}, sqlite.HandleError(repoerr.ErrCreateEntity, err)
+	}
+
+	return repo.RoleListActions(ctx, role.ID)
+}
+
+func (repo *Repository) RoleListActions(ctx context.Context, roleID string) ([]string, error) {
+	q := fmt.Sprintf(`SELECT role_id, action FROM %s_role_actions WHERE role_id = :role_id ;`, repo.tableNamePrefix)
+
+	dbrcap := dbRoleAction{
+		RoleID: roleID,
+	}
+
+	rows, err := repo.db.NamedQueryContext(ctx, q, dbrcap)
+	if err != nil {
+		return []string{}, errors.Wrap(repoerr.ErrViewEntity, err)
+	}
+	defer rows.Close()
+
+	items := []string{}
+	for rows.Next() {
+		dbrcap = dbRoleAction{}
+		if err := rows.StructScan(&dbrcap); err != nil {
+			return []string{}, errors.Wrap(repoerr.ErrViewEntity, err)
+		}
+
+		items = append(items, dbrcap.Action)
+	}
+	return items, nil
+}
+
+func (repo *Repository) RoleCheckActionsExists(ctx context.Context, roleID string, actions []string) (bool, error) {
+	q := fmt.Sprintf(`SELECT COUNT(*) FROM %s_role_actions WHERE role_id = :role_id AND action IN (SELECT value FROM json_each(:actions))`, repo.tableNamePrefix)
+
+	acts, err := json.Marshal(actions)
+	if err != nil {
+		return false, errors.Wrap(repoerr.ErrViewEntity, err)
+	}
+	params := map[string]interface{}{
+		"role_id": roleID,
+		"actions": string(acts),
+	}
+	var count int
+	query, err := repo.db.NamedQueryContext(ctx, q, params)
+	if err != nil {
+		return false, errors.Wrap(repoerr.ErrViewEntity, err)
+	}
+
+	defer query.Close()
+
+	if query.Next() {
+		if err := query.Scan(&count); err != nil {
+			return false, errors.Wrap(repoerr.ErrViewEntity, err)
+		}
+	}
+
+	// Check if the count matches the number of actions provided
+	if count != len(actions) {
+		return false, nil
+	}
+
+	return true, nil
+}
+
+func (repo *Repository) RoleRemoveActions(ctx context.Context, role roles.Role, actions []string) (err error) {
+	tx, err := repo.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(repoerr.ErrRemoveEntity, err)
+	}
+	defer func() {
+		if err != nil {
+			if errRollback := tx.Rollback(); errRollback != nil {
+				err = errors.Wrap(errors.Wrap(apiutil.ErrRollbackTx, errRollback), err)
+			}
+		}
+	}()
+
+	q := fmt.Sprintf(`DELETE FROM %s_role_actions WHERE role_id = :role_id AND action IN (SELECT value FROM json_each(:actions))`, repo.tableNamePrefix)
+
+	acts, err := json.Marshal(actions)
+	if err != nil {
+		return errors.Wrap(repoerr.ErrRemoveEntity, err)
+	}
+	params := map[string]interface{}{
+		"role_id": role.ID,
+		"actions": string(acts),
+	}
+
+	if _, err := tx.NamedExec(q, params); err != nil {
+		return errors.Wrap(repoerr.ErrRemoveEntity, err)
+	}
+
+	upq := fmt.Sprintf(`UPDATE %s_roles SET updated_at = :updated_at, updated_by = :updated_by WHERE id = :id;`, repo.tableNamePrefix)
+	if _, err := tx.NamedExec(upq, toDBRoles(role)); err != nil {
+		return sqlite.HandleError(repoerr.ErrRemoveEntity, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.Wrap(repoerr.ErrRemoveEntity, err)
+	}
+
+	return nil
+}
+
+func (repo *Repository) RoleRemoveAllActions(ctx context.Context, role roles.Role) error {
+	tx, err := repo.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(repoerr.ErrRemoveEntity, err)
+	}
+	defer func() {
+		if err != nil {
+			if errRollback := tx.Rollback(); errRollback != nil {
+				err = errors.Wrap(errors.Wrap(apiutil.ErrRollbackTx, errRollback), err)
+			}
+		}
+	}()
+
+	q := fmt.Sprintf(`DELETE FROM %s_role_actions WHERE role_id = :role_id `, repo.tableNamePrefix)
+
+	dbrcap := dbRoleAction{RoleID: role.ID}
+
+	if _, err := tx.NamedExec(q, dbrcap); err != nil {
+		return errors.Wrap(repoerr.ErrRemoveEntity, err)
+	}
+
+	upq := fmt.Sprintf(`UPDATE %s_roles SET updated_at = :updated_at, updated_by = :updated_by WHERE id = :id;`, repo.tableNamePrefix)
+	if _, err := tx.NamedExec(upq, toDBRoles(role)); err != nil {
+		return sqlite.HandleError(repoerr.ErrRemoveEntity, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.Wrap(repoerr.ErrRemoveEntity, err)
+	}
+
+	return nil
+}
+
+func (repo *Repository) RoleAddMembers(ctx context.Context, role roles.Role, members []string) ([]string, error) {
+	mq := fmt.Sprintf(`INSERT INTO %s_role_members (role_id, member_id)
+        VALUES (:role_id, :member_id)
+        RETURNING role_id, member_id`, repo.tableNamePrefix)
+
+	tx, err := repo.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return []string{}, errors.Wrap(repoerr.ErrCreateEntity, err)
+	}
+	defer func() {
+		if err != nil {
+			if errRollback := tx.Rollback(); errRollback != nil {
+				err = errors.Wrap(errors.Wrap(apiutil.ErrRollbackTx, errRollback), err)
+			}
+		}
+	}()
+
+	rMems := []dbRoleMember{}
+	for _, m := range members {
+		rMems = append(rMems, dbRoleMember{
+			RoleID:   role.ID,
+			MemberID: m,
+		})
+	}
+	if _, err := tx.NamedExec(mq, rMems); err != nil {
+		return []string{}, sqlite.HandleError(repoerr.ErrCreateEntity, err)
+	}
+
+	upq := fmt.Sprintf(`UPDATE %s_roles SET updated_at = :updated_at, updated_by = :updated_by WHERE id = :id;`, repo.tableNamePrefix)
+	if _, err := tx.NamedExec(upq, toDBRoles(role)); err != nil {
+		return []string{}, sqlite.HandleError(repoerr.ErrCreateEntity, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return []string{}, sqlite.HandleError(repoerr.ErrCreateEntity, err)
+	}
+
+	return members, nil
+}
+
+func (repo *Repository) RoleListMembers(ctx context.Context, roleID string, limit, offset uint64) (roles.MembersPage, error) {
+	q := fmt.Sprintf(`SELECT role_id, member_id FROM %s_role_members WHERE role_id = :role_id LIMIT :limit OFFSET :offset;`, repo.tableNamePrefix)
+
+	dbp := dbPage{
+		RoleID: roleID,
+		Limit:  limit,
+		Offset: offset,
+	}
+
+	rows, err := repo.db.NamedQueryContext(ctx, q, dbp)
+	if err != nil {
+		return roles.MembersPage{}, errors.Wrap(repoerr.ErrViewEntity, err)
+	}
+	defer rows.Close()
+
+	items := []string{}
+	for rows.Next() {
+		dbrmems := dbRoleMember{}
+		if err := rows.StructScan(&dbrmems); err != nil {
+			return roles.MembersPage{}, errors.Wrap(repoerr.ErrViewEntity, err)
+		}
+
+		items = append(items, dbrmems.MemberID)
+	}
+
+	cq := fmt.Sprintf(`SELECT COUNT(*) FROM %s_role_members WHERE role_id = :role_id`, repo.tableNamePrefix)
+
+	total, err := postgres.Total(ctx, repo.db, cq, dbp)
+	if err != nil {
+		return roles.MembersPage{}, errors.Wrap(repoerr.ErrViewEntity, err)
+	}
+
+	return roles.MembersPage{
+		Members: items,
+		Total:   total,
+		Offset:  offset,
+		Limit:   limit,
+	}, nil
+}
+
+func (repo *Repository) ListEntityMembers(ctx context.Context, entityID string, limit, offset uint64) (roles.EntityMembersPage, error) {
+	q := fmt.Sprintf(`SELECT rm.member_id, rm.role_id, r.name AS role_name FROM %s_role_members rm
+		JOIN %s_roles r ON rm.role_id = r.id
+		WHERE r.entity_id = :entity_id ORDER BY rm.member_id LIMIT :limit OFFSET :offset;`, repo.tableNamePrefix, repo.tableNamePrefix)
+
+	dbp := dbPage{
+		EntityID: entityID,
+		Limit:    limit,
+		Offset:   offset,
+	}
+
+	rows, err := repo.db.NamedQueryContext(ctx, q, dbp)
+	if err != nil {
+		return roles.EntityMembersPage{}, errors.Wrap(repoerr.ErrViewEntity, err)
+	}
+	defer rows.Close()
+
+	items := []roles.EntityMember{}
+	for rows.Next() {
+		dbem := dbEntityMember{}
+		if err := rows.StructScan(&dbem); err != nil {
+			return roles.EntityMembersPage{}, errors.Wrap(repoerr.ErrViewEntity, err)
+		}
+
+		items = append(items, roles.EntityMember{
+			MemberID: dbem.MemberID,
+			RoleID:   dbem.RoleID,
+			RoleName: dbem.RoleName,
+		})
+	}
+
+	cq := fmt.Sprintf(`SELECT COUNT(*) FROM %s_role_members rm
+		JOIN %s_roles r ON rm.role_id = r.id
+		WHERE r.entity_id = :entity_id`, repo.tableNamePrefix, repo.tableNamePrefix)
+
+	total, err := postgres.Total(ctx, repo.db, cq, dbp)
+	if err != nil {
+		return roles.EntityMembersPage{}, errors.Wrap(repoerr.ErrViewEntity, err)
+	}
+
+	return roles.EntityMembersPage{
+		Members: items,
+		Total:   total,
+		Offset:  offset,
+		Limit:   limit,
+	}, nil
+}
+
+func (repo *Repository) ListMemberEntities(ctx context.Context, memberID string, limit, offset uint64) (roles.MemberEntitiesPage, error) {
+	q := fmt.Sprintf(`SELECT r.entity_id, rm.role_id, r.name AS role_name FROM %s_role_members rm
+		JOIN %s_roles r ON rm.role_id = r.id
+		WHERE rm.member_id = :member_id ORDER BY r.entity_id LIMIT :limit OFFSET :offset;`, repo.tableNamePrefix, repo.tableNamePrefix)
+
+	dbp := dbPage{
+		MemberID: memberID,
+		Limit:    limit,
+		Offset:   offset,
+	}
+
+	rows, err := repo.db.NamedQueryContext(ctx, q, dbp)
+	if err != nil {
+		return roles.MemberEntitiesPage{}, errors.Wrap(repoerr.ErrViewEntity, err)
+	}
+	defer rows.Close()
+
+	items := []roles.MemberEntity{}
+	for rows.Next() {
+		dbme := dbMemberEntity{}
+		if err := rows.StructScan(&dbme); err != nil {
+			return roles.MemberEntitiesPage{}, errors.Wrap(repoerr.ErrViewEntity, err)
+		}
+
+		items = append(items, roles.MemberEntity{
+			EntityID: dbme.EntityID,
+			RoleID:   dbme.RoleID,
+			RoleName: dbme.RoleName,
+		})
+	}
+
+	cq := fmt.Sprintf(`SELECT COUNT(*) FROM %s_role_members WHERE member_id = :member_id`, repo.tableNamePrefix)
+
+	total, err := postgres.Total(ctx, repo.db, cq, dbp)
+	if err != nil {
+		return roles.MemberEntitiesPage{}, errors.Wrap(repoerr.ErrViewEntity, err)
+	}
+
+	return roles.MemberEntitiesPage{
+		Entities: items,
+		Total:    total,
+		Offset:   offset,
+		Limit:    limit,
+	}, nil
+}
+
+func (repo *Repository) RoleCheckMembersExists(ctx context.Context, roleID string, members []string) (bool, error) {
+	q := fmt.Sprintf(`SELECT COUNT(*) FROM %s_role_members WHERE role_id = :role_id AND member_id IN (SELECT value FROM json_each(:members))`, repo.tableNamePrefix)
+
+	mems, err := json.Marshal(members)
+	if err != nil {
+		return false, errors.Wrap(repoerr.ErrViewEntity, err)
+	}
+	params := map[string]interface{}{
+		"role_id": roleID,
+		"members": string(mems),
+	}
+	var count int
+	query, err := repo.db.NamedQueryContext(ctx, q, params)
+	if err != nil {
+		return false, errors.Wrap(repoerr.ErrViewEntity, err)
+	}
+
+	defer query.Close()
+
+	if query.Next() {
+		if err := query.Scan(&count); err != nil {
+			return false, errors.Wrap(repoerr.ErrViewEntity, err)
+		}
+	}
+
+	if count != len(members) {
+		return false, nil
+	}
+
+	return true, nil
+}
+
+func (repo *Repository) RoleRemoveMembers(ctx context.Context, role roles.Role, members []string) (err error) {
+	tx, err := repo.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(repoerr.ErrRemoveEntity, err)
+	}
+	defer func() {
+		if err != nil {
+			if errRollback := tx.Rollback(); errRollback != nil {
+				err = errors.Wrap(errors.Wrap(apiutil.ErrRollbackTx, errRollback), err)
+			}
+		}
+	}()
+
+	q := fmt.Sprintf(`DELETE FROM %s_role_members WHERE role_id = :role_id AND member_id IN (SELECT value FROM json_each(:member_ids))`, repo.tableNamePrefix)
+
+	mems, err := json.Marshal(members)
+	if err != nil {
+		return errors.Wrap(repoerr.ErrRemoveEntity, err)
+	}
+	params := map[string]interface{}{
+		"role_id":    role.ID,
+		"member_ids": string(mems),
+	}
+
+	if _, err := tx.NamedExec(q, params); err != nil {
+		return errors.Wrap(repoerr.ErrRemoveEntity, err)
+	}
+
+	upq := fmt.Sprintf(`UPDATE %s_roles SET updated_at = :updated_at, updated_by = :updated_by WHERE id = :id;`, repo.tableNamePrefix)
+	if _, err := tx.NamedExec(upq, toDBRoles(role)); err != nil {
+		return sqlite.HandleError(repoerr.ErrRemoveEntity, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.Wrap(repoerr.ErrRemoveEntity, err)
+	}
+	return nil
+}
+
+func (repo *Repository) RoleRemoveAllMembers(ctx context.Context, role roles.Role) (err error) {
+	tx, err := repo.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(repoerr.ErrRemoveEntity, err)
+	}
+	defer func() {
+		if err != nil {
+			if errRollback := tx.Rollback(); errRollback != nil {
+				err = errors.Wrap(errors.Wrap(apiutil.ErrRollbackTx, errRollback), err)
+			}
+		}
+	}()
+	q := fmt.Sprintf(`DELETE FROM %s_role_members WHERE role_id = :role_id `, repo.tableNamePrefix)
+
+	dbrcap := dbRoleAction{RoleID: role.ID}
+
+	if _, err := repo.db.NamedExecContext(ctx, q, dbrcap); err != nil {
+		return errors.Wrap(repoerr.ErrRemoveEntity, err)
+	}
+
+	upq := fmt.Sprintf(`UPDATE %s_roles SET updated_at = :updated_at, updated_by = :updated_by WHERE id = :id;`, repo.tableNamePrefix)
+	if _, err := tx.NamedExec(upq, toDBRoles(role)); err != nil {
+		return sqlite.HandleError(repoerr.ErrRemoveEntity, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.Wrap(repoerr.ErrRemoveEntity, err)
+	}
+	return nil
+}
+
+func (repo *Repository) RetrieveEntitiesRolesActionsMembers(ctx context.Context, entityIDs []string) ([]roles.EntityActionRole, []roles.EntityMemberRole, error) {
+	ids, err := json.Marshal(entityIDs)
+	if err != nil {
+		return []roles.EntityActionRole{}, []roles.EntityMemberRole{}, errors.Wrap(repoerr.ErrViewEntity, err)
+	}
+	params := map[string]interface{}{
+		"entity_ids": string(ids),
+	}
+
+	clientsActionsRolesQuery := fmt.Sprintf(`SELECT e.%s AS entity_id , era."action" AS "action", er.id AS role_id
+								FROM %s e
+								JOIN %s_roles er ON er.entity_id  = e.%s
+								JOIN %s_role_actions era  ON era.role_id  = er.id
+								WHERE e.%s IN (SELECT value FROM json_each(:entity_ids));
+							`, repo.entityIDColumnName, repo.entityTableName, repo.tableNamePrefix, repo.entityIDColumnName, repo.tableNamePrefix, repo.entityIDColumnName)
+	rows, err := repo.db.NamedQueryContext(ctx, clientsActionsRolesQuery, params)
+	if err != nil {
+		return []roles.EntityActionRole{}, []roles.EntityMemberRole{}, sqlite.HandleError(repoerr.ErrViewEntity, err)
+	}
+
+	defer rows.Close()
+	dbears := []dbEntityActionRole{}
+	for rows.Next() {
+		dbear := dbEntityActionRole{}
+		if err = rows.StructScan(&dbear); err != nil {
+			return []roles.EntityActionRole{}, []roles.EntityMemberRole{}, sqlite.HandleError(repoerr.ErrViewEntity, err)
+		}
+
+		dbears = append(dbears, dbear)
+	}
+	clientsMembersRolesQuery := fmt.Sprintf(`SELECT e.%s AS entity_id , erm.member_id AS member_id, er.id AS role_id
+								FROM %s e
+								JOIN %s_roles er ON er.entity_id  = e.%s
+								JOIN %s_role_members erm ON erm.role_id = er.id
+								WHERE e.%s IN (SELECT value FROM json_each(:entity_ids));
+								`, repo.entityIDColumnName, repo.entityTableName, repo.tableNamePrefix, repo.entityIDColumnName, repo.tableNamePrefix, repo.entityIDColumnName)
+
+	rows, err = repo.db.NamedQueryContext(ctx, clientsMembersRolesQuery, params)
+	if err != nil {
+		return []roles.EntityActionRole{}, []roles.EntityMemberRole{}, sqlite.HandleError(repoerr.ErrViewEntity, err)
+	}
+
+	defer rows.Close()
+	dbemrs := []dbEntityMemberRole{}
+	for rows.Next() {
+		dbemr := dbEntityMemberRole{}
+		if err = rows.StructScan(&dbemr); err != nil {
+			return []roles.EntityActionRole{}, []roles.EntityMemberRole{}, sqlite.HandleError(repoerr.ErrViewEntity, err)
+		}
+
+		dbemrs = append(dbemrs, dbemr)
+	}
+	return dbToEntityActionRole(dbears), dbToEntityMemberRole(dbemrs), nil
+}
+
+func (repo *Repository) RemoveMemberFromAllRoles(ctx context.Context, memberID string) (err error) {
+	return nil
+}
@@ -95,6 +95,13 @@ func (d Decoder) DecodeListAvailableActions(_ context.Context, r *http.Request)
 	return req, nil
 }
 
+func (d Decoder) DecodeListRoleTemplates(_ context.Context, r *http.Request) (interface{}, error) {
+	req := listRoleTemplatesReq{
+		token: apiutil.ExtractBearerToken(r),
+	}
+	return req, nil
+}
+
 func (d Decoder) DecodeAddRoleActions(_ context.Context, r *http.Request) (interface{}, error) {
 	if !strings.Contains(r.Header.Get("Content-Type"), api.ContentType) {
 		return nil, errors.Wrap(apiutil.ErrValidation, apiutil.ErrUnsupportedContentType)
@@ -27,7 +27,20 @@ func CreateRoleEndpoint(svc roles.RoleManager) endpoint.Endpoint {
 			return nil, svcerr.ErrAuthentication
 		}
 
-		rp, err := svc.AddRole(ctx, session, req.entityID, req.RoleName, req.OptionalActions, req.OptionalMembers)
+		actions := req.OptionalActions
+		if req.Template != "" {
+			templates, err := svc.ListRoleTemplates(ctx, session)
+			if err != nil {
+				return nil, err
+			}
+			tmplActions, ok := templates[req.Template]
+			if !ok {
+				return nil, errors.Wrap(apiutil.ErrValidation, apiutil.ErrUnknownRoleTemplate)
+			}
+			actions = mergeActions(tmplActions, actions)
+		}
+
+		rp, err := svc.AddRole(ctx, session, req.entityID, req.RoleName, actions, req.OptionalMembers)
 		if err != nil {
 			return nil, err
 		}
@@ -134,6 +147,26 @@ func ListAvailableActionsEndpoint(svc roles.RoleManager) endpoint.Endpoint {
 	}
 }
 
+func ListRoleTemplatesEndpoint(svc roles.RoleManager) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(listRoleTemplatesReq)
+		if err := req.validate(); err != nil {
+			return nil, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(api.SessionKey).(authn.Session)
+		if !ok {
+			return nil, svcerr.ErrAuthentication
+		}
+
+		templates, err := svc.ListRoleTemplates(ctx, session)
+		if err != nil {
+			return nil, err
+		}
+		return listRoleTemplatesRes{templates}, nil
+	}
+}
+
 func AddRoleActionsEndpoint(svc roles.RoleManager) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(addRoleActionsReq)
@@ -289,3 +322,19 @@ func DeleteAllRoleMembersEndpoint(svc roles.RoleManager) endpoint.Endpoint {
 		return deleteAllRoleMemberRes{}, nil
 	}
 }
+
+// mergeActions combines template actions with explicitly requested ones,
+// dropping duplicates so the role is not provisioned with the same action
+// twice.
+func mergeActions(tmplActions, actions []string) []string {
+	merged := make([]string, 0, len(tmplActions)+len(actions))
+	seen := make(map[string]struct{}, len(tmplActions)+len(actions))
+	for _, action := range append(tmplActions, actions...) {
+		if _, ok := seen[action]; ok {
+			continue
+		}
+		seen[action] = struct{}{}
+		merged = append(merged, action)
+	}
+	return merged
+}
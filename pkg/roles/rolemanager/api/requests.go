@@ -12,6 +12,7 @@ type createRoleReq struct {
 	token           string
 	entityID        string
 	RoleName        string   `json:"role_name"`
+	Template        string   `json:"template,omitempty"`
 	OptionalActions []string `json:"optional_actions"`
 	OptionalMembers []string `json:"optional_members"`
 }
@@ -125,6 +126,17 @@ func (req listAvailableActionsReq) validate() error {
 	return nil
 }
 
+type listRoleTemplatesReq struct {
+	token string
+}
+
+func (req listRoleTemplatesReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+	return nil
+}
+
 type addRoleActionsReq struct {
 	token    string
 	entityID string
@@ -17,6 +17,7 @@ var (
 	_ supermq.Response = (*updateRoleRes)(nil)
 	_ supermq.Response = (*deleteRoleRes)(nil)
 	_ supermq.Response = (*listAvailableActionsRes)(nil)
+	_ supermq.Response = (*listRoleTemplatesRes)(nil)
 	_ supermq.Response = (*addRoleActionsRes)(nil)
 	_ supermq.Response = (*listRoleActionsRes)(nil)
 	_ supermq.Response = (*deleteRoleActionsRes)(nil)
@@ -121,6 +122,22 @@ func (res listAvailableActionsRes) Empty() bool {
 	return false
 }
 
+type listRoleTemplatesRes struct {
+	RoleTemplates map[string][]string `json:"role_templates"`
+}
+
+func (res listRoleTemplatesRes) Code() int {
+	return http.StatusOK
+}
+
+func (res listRoleTemplatesRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res listRoleTemplatesRes) Empty() bool {
+	return false
+}
+
 type addRoleActionsRes struct {
 	Actions []string `json:"actions"`
 }
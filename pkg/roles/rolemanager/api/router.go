@@ -122,5 +122,12 @@ func EntityAvailableActionsRouter(svc roles.RoleManager, d Decoder, r chi.Router
 		opts...,
 	), "list_available_actions").ServeHTTP)
 
+	r.Get("/roles/templates", otelhttp.NewHandler(kithttp.NewServer(
+		ListRoleTemplatesEndpoint(svc),
+		d.DecodeListRoleTemplates,
+		api.EncodeResponse,
+		opts...,
+	), "list_role_templates").ServeHTTP)
+
 	return r
 }
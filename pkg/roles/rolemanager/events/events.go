@@ -4,17 +4,23 @@
 package events
 
 import (
+	"time"
+
+	"github.com/absmach/supermq/pkg/authn"
 	"github.com/absmach/supermq/pkg/events"
 	"github.com/absmach/supermq/pkg/roles"
 )
 
 const (
 	addRole                  = "role.add"
+	addRoles                 = "role.add_bulk"
 	removeRole               = "role.remove"
 	updateRole               = "role.update"
+	setRoleInheritance       = "role.inheritance.set"
 	viewRole                 = "role.view"
 	viewAllRole              = "role.view_all"
 	listAvailableActions     = "role.list_available_actions"
+	listRoleTemplates        = "role.list_templates"
 	addRoleActions           = "role.actions.add"
 	listRoleActions          = "role.actions.ist"
 	checkRoleActions         = "role.actions.check"
@@ -22,6 +28,8 @@ const (
 	removeAllRoleActions     = "role.actions.remove_all"
 	addRoleMembers           = "role.members.add"
 	listRoleMembers          = "role.members.list"
+	listEntityMembers        = "role.members.list_by_entity"
+	listMemberEntities       = "role.entities.list_by_member"
 	checkRoleMembers         = "role.members.check"
 	removeRoleMembers        = "role.members.remove"
 	removeRoleAllMembers     = "role.members.remove_all"
@@ -29,12 +37,16 @@ const (
 )
 
 var (
+	_ events.Event = (*roleEvent)(nil)
 	_ events.Event = (*addRoleEvent)(nil)
+	_ events.Event = (*addRolesEvent)(nil)
 	_ events.Event = (*removeRoleEvent)(nil)
 	_ events.Event = (*updateRoleEvent)(nil)
+	_ events.Event = (*setRoleInheritanceEvent)(nil)
 	_ events.Event = (*retrieveRoleEvent)(nil)
 	_ events.Event = (*retrieveAllRolesEvent)(nil)
 	_ events.Event = (*listAvailableActionsEvent)(nil)
+	_ events.Event = (*listRoleTemplatesEvent)(nil)
 	_ events.Event = (*roleAddActionsEvent)(nil)
 	_ events.Event = (*roleListActionsEvent)(nil)
 	_ events.Event = (*roleCheckActionsExistsEvent)(nil)
@@ -42,12 +54,35 @@ var (
 	_ events.Event = (*roleRemoveAllActionsEvent)(nil)
 	_ events.Event = (*roleAddMembersEvent)(nil)
 	_ events.Event = (*roleListMembersEvent)(nil)
+	_ events.Event = (*listEntityMembersEvent)(nil)
+	_ events.Event = (*listMemberEntitiesEvent)(nil)
 	_ events.Event = (*roleCheckMembersExistsEvent)(nil)
 	_ events.Event = (*roleRemoveMembersEvent)(nil)
 	_ events.Event = (*roleRemoveAllMembersEvent)(nil)
 	_ events.Event = (*removeMemberFromAllRolesEvent)(nil)
 )
 
+// roleEvent decorates operation events with fields shared by all role
+// operations: the acting user, the entity type and the time of occurrence.
+type roleEvent struct {
+	events.Event
+	entityType string
+	session    authn.Session
+	occurredAt time.Time
+}
+
+func (re roleEvent) Encode() (map[string]interface{}, error) {
+	val, err := re.Event.Encode()
+	if err != nil {
+		return val, err
+	}
+	val["entity_type"] = re.entityType
+	val["actor_id"] = re.session.UserID
+	val["domain_id"] = re.session.DomainID
+	val["occurred_at"] = re.occurredAt
+	return val, nil
+}
+
 type addRoleEvent struct {
 	operationPrefix string
 	roles.RoleProvision
@@ -69,6 +104,21 @@ func (are addRoleEvent) Encode() (map[string]interface{}, error) {
 	return val, nil
 }
 
+type addRolesEvent struct {
+	operationPrefix string
+	entityID        string
+	rps             []roles.RoleProvision
+}
+
+func (are addRolesEvent) Encode() (map[string]interface{}, error) {
+	val := map[string]interface{}{
+		"operation": are.operationPrefix + addRoles,
+		"entity_id": are.entityID,
+		"roles":     are.rps,
+	}
+	return val, nil
+}
+
 type removeRoleEvent struct {
 	operationPrefix string
 	entityID        string
@@ -103,6 +153,24 @@ func (ure updateRoleEvent) Encode() (map[string]interface{}, error) {
 	return val, nil
 }
 
+type setRoleInheritanceEvent struct {
+	operationPrefix string
+	roles.Role
+}
+
+func (srie setRoleInheritanceEvent) Encode() (map[string]interface{}, error) {
+	val := map[string]interface{}{
+		"operation":     srie.operationPrefix + setRoleInheritance,
+		"id":            srie.ID,
+		"name":          srie.Name,
+		"entity_id":     srie.EntityID,
+		"inherits_from": srie.InheritsFrom,
+		"updated_by":    srie.UpdatedBy,
+		"updated_at":    srie.UpdatedAt,
+	}
+	return val, nil
+}
+
 type retrieveRoleEvent struct {
 	operationPrefix string
 	roles.Role
@@ -150,6 +218,17 @@ func (laae listAvailableActionsEvent) Encode() (map[string]interface{}, error) {
 	return val, nil
 }
 
+type listRoleTemplatesEvent struct {
+	operationPrefix string
+}
+
+func (lrte listRoleTemplatesEvent) Encode() (map[string]interface{}, error) {
+	val := map[string]interface{}{
+		"operation": lrte.operationPrefix + listRoleTemplates,
+	}
+	return val, nil
+}
+
 type roleAddActionsEvent struct {
 	operationPrefix string
 	entityID        string
@@ -269,6 +348,40 @@ func (rlme roleListMembersEvent) Encode() (map[string]interface{}, error) {
 	return val, nil
 }
 
+type listEntityMembersEvent struct {
+	operationPrefix string
+	entityID        string
+	limit           uint64
+	offset          uint64
+}
+
+func (leme listEntityMembersEvent) Encode() (map[string]interface{}, error) {
+	val := map[string]interface{}{
+		"operation": leme.operationPrefix + listEntityMembers,
+		"entity_id": leme.entityID,
+		"limit":     leme.limit,
+		"offset":    leme.offset,
+	}
+	return val, nil
+}
+
+type listMemberEntitiesEvent struct {
+	operationPrefix string
+	memberID        string
+	limit           uint64
+	offset          uint64
+}
+
+func (lmee listMemberEntitiesEvent) Encode() (map[string]interface{}, error) {
+	val := map[string]interface{}{
+		"operation": lmee.operationPrefix + listMemberEntities,
+		"member_id": lmee.memberID,
+		"limit":     lmee.limit,
+		"offset":    lmee.offset,
+	}
+	return val, nil
+}
+
 type roleCheckMembersExistsEvent struct {
 	operationPrefix string
 	entityID        string
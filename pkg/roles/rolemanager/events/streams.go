@@ -5,6 +5,8 @@ package events
 
 import (
 	"context"
+	"log/slog"
+	"time"
 
 	"github.com/absmach/supermq/pkg/authn"
 	"github.com/absmach/supermq/pkg/events"
@@ -13,21 +15,100 @@ import (
 
 var _ roles.RoleManager = (*RoleManagerEventStore)(nil)
 
+// FailurePolicy defines how the event store treats event publishing errors.
+type FailurePolicy uint8
+
+const (
+	// FailTheCall returns publishing errors to the caller, failing the
+	// operation even though it has already been applied.
+	FailTheCall FailurePolicy = iota
+
+	// BestEffort logs publishing errors and reports the operation as
+	// successful.
+	BestEffort
+)
+
+type eventJob struct {
+	ctx   context.Context
+	event events.Event
+}
+
 type RoleManagerEventStore struct {
 	events.Publisher
 	svc             roles.RoleManager
 	operationPrefix string
 	svcName         string
+	policy          FailurePolicy
+	logger          *slog.Logger
+	jobs            chan eventJob
+}
+
+// Option configures the role manager event store.
+type Option func(*RoleManagerEventStore)
+
+// WithFailurePolicy sets how publishing errors are reported. The logger is
+// used to record errors dropped by the BestEffort policy.
+func WithFailurePolicy(policy FailurePolicy, logger *slog.Logger) Option {
+	return func(rmes *RoleManagerEventStore) {
+		rmes.policy = policy
+		rmes.logger = logger
+	}
+}
+
+// WithAsyncPublish buffers events in a channel of the given size and
+// publishes them from a background worker. When the buffer is full,
+// publishing falls back to the synchronous path.
+func WithAsyncPublish(size int) Option {
+	return func(rmes *RoleManagerEventStore) {
+		rmes.jobs = make(chan eventJob, size)
+		go func() {
+			for job := range rmes.jobs {
+				if err := rmes.Publisher.Publish(job.ctx, job.event); err != nil && rmes.logger != nil {
+					rmes.logger.Warn("failed to publish role event", slog.String("error", err.Error()))
+				}
+			}
+		}()
+	}
 }
 
 // NewEventStoreMiddleware returns wrapper around auth service that sends
 // events to event store.
-func NewRoleManagerEventStore(svcName, operationPrefix string, svc roles.RoleManager, publisher events.Publisher) RoleManagerEventStore {
-	return RoleManagerEventStore{
+func NewRoleManagerEventStore(svcName, operationPrefix string, svc roles.RoleManager, publisher events.Publisher, opts ...Option) RoleManagerEventStore {
+	rmes := RoleManagerEventStore{
 		svcName:   svcName,
 		svc:       svc,
 		Publisher: publisher,
 	}
+	for _, opt := range opts {
+		opt(&rmes)
+	}
+	return rmes
+}
+
+func (rmes *RoleManagerEventStore) publish(ctx context.Context, session authn.Session, event events.Event) error {
+	e := roleEvent{
+		Event:      event,
+		entityType: rmes.svcName,
+		session:    session,
+		occurredAt: time.Now(),
+	}
+	if rmes.jobs != nil {
+		select {
+		case rmes.jobs <- eventJob{ctx: context.WithoutCancel(ctx), event: e}:
+			return nil
+		default:
+		}
+	}
+	if err := rmes.publish(ctx, session, e); err != nil {
+		if rmes.policy == BestEffort {
+			if rmes.logger != nil {
+				rmes.logger.WarnContext(ctx, "failed to publish role event", slog.String("error", err.Error()))
+			}
+			return nil
+		}
+		return err
+	}
+	return nil
 }
 
 func (rmes *RoleManagerEventStore) AddRole(ctx context.Context, session authn.Session, entityID, roleName string, optionalActions []string, optionalMembers []string) (roles.RoleProvision, error) {
@@ -40,12 +121,29 @@ func (rmes *RoleManagerEventStore) AddRole(ctx context.Context, session authn.Se
 		operationPrefix: rmes.operationPrefix,
 		RoleProvision:   nrp,
 	}
-	if err := rmes.Publish(ctx, e); err != nil {
+	if err := rmes.publish(ctx, session, e); err != nil {
 		return nrp, err
 	}
 	return nrp, nil
 }
 
+func (rmes *RoleManagerEventStore) AddRoles(ctx context.Context, session authn.Session, entityID string, rps []roles.RoleProvision) ([]roles.RoleProvision, error) {
+	nrps, err := rmes.svc.AddRoles(ctx, session, entityID, rps)
+	if err != nil {
+		return nrps, err
+	}
+
+	e := addRolesEvent{
+		operationPrefix: rmes.operationPrefix,
+		entityID:        entityID,
+		rps:             nrps,
+	}
+	if err := rmes.publish(ctx, session, e); err != nil {
+		return nrps, err
+	}
+	return nrps, nil
+}
+
 func (rmes *RoleManagerEventStore) RemoveRole(ctx context.Context, session authn.Session, entityID, roleID string) error {
 	if err := rmes.svc.RemoveRole(ctx, session, entityID, roleID); err != nil {
 		return err
@@ -55,7 +153,7 @@ func (rmes *RoleManagerEventStore) RemoveRole(ctx context.Context, session authn
 		roleID:          roleID,
 		entityID:        entityID,
 	}
-	if err := rmes.Publish(ctx, e); err != nil {
+	if err := rmes.publish(ctx, session, e); err != nil {
 		return err
 	}
 	return nil
@@ -71,7 +169,23 @@ func (rmes *RoleManagerEventStore) UpdateRoleName(ctx context.Context, session a
 		operationPrefix: rmes.operationPrefix,
 		Role:            ro,
 	}
-	if err := rmes.Publish(ctx, e); err != nil {
+	if err := rmes.publish(ctx, session, e); err != nil {
+		return ro, err
+	}
+	return ro, nil
+}
+
+func (rmes *RoleManagerEventStore) SetRoleInheritance(ctx context.Context, session authn.Session, entityID, roleID, inheritsFrom string) (roles.Role, error) {
+	ro, err := rmes.svc.SetRoleInheritance(ctx, session, entityID, roleID, inheritsFrom)
+	if err != nil {
+		return ro, err
+	}
+
+	e := setRoleInheritanceEvent{
+		operationPrefix: rmes.operationPrefix,
+		Role:            ro,
+	}
+	if err := rmes.publish(ctx, session, e); err != nil {
 		return ro, err
 	}
 	return ro, nil
@@ -86,7 +200,7 @@ func (rmes *RoleManagerEventStore) RetrieveRole(ctx context.Context, session aut
 		operationPrefix: rmes.operationPrefix,
 		Role:            ro,
 	}
-	if err := rmes.Publish(ctx, e); err != nil {
+	if err := rmes.publish(ctx, session, e); err != nil {
 		return ro, err
 	}
 	return ro, nil
@@ -104,7 +218,7 @@ func (rmes *RoleManagerEventStore) RetrieveAllRoles(ctx context.Context, session
 		limit:           limit,
 		offset:          offset,
 	}
-	if err := rmes.Publish(ctx, e); err != nil {
+	if err := rmes.publish(ctx, session, e); err != nil {
 		return rp, err
 	}
 	return rp, nil
@@ -118,12 +232,26 @@ func (rmes *RoleManagerEventStore) ListAvailableActions(ctx context.Context, ses
 	e := listAvailableActionsEvent{
 		operationPrefix: rmes.operationPrefix,
 	}
-	if err := rmes.Publish(ctx, e); err != nil {
+	if err := rmes.publish(ctx, session, e); err != nil {
 		return actions, err
 	}
 	return actions, nil
 }
 
+func (rmes *RoleManagerEventStore) ListRoleTemplates(ctx context.Context, session authn.Session) (map[string][]string, error) {
+	templates, err := rmes.svc.ListRoleTemplates(ctx, session)
+	if err != nil {
+		return templates, err
+	}
+	e := listRoleTemplatesEvent{
+		operationPrefix: rmes.operationPrefix,
+	}
+	if err := rmes.publish(ctx, session, e); err != nil {
+		return templates, err
+	}
+	return templates, nil
+}
+
 func (rmes *RoleManagerEventStore) RoleAddActions(ctx context.Context, session authn.Session, entityID, roleID string, actions []string) ([]string, error) {
 	actions, err := rmes.svc.RoleAddActions(ctx, session, entityID, roleID, actions)
 	if err != nil {
@@ -135,7 +263,7 @@ func (rmes *RoleManagerEventStore) RoleAddActions(ctx context.Context, session a
 		roleID:          roleID,
 		actions:         actions,
 	}
-	if err := rmes.Publish(ctx, e); err != nil {
+	if err := rmes.publish(ctx, session, e); err != nil {
 		return actions, err
 	}
 	return actions, nil
@@ -152,7 +280,7 @@ func (rmes *RoleManagerEventStore) RoleListActions(ctx context.Context, session
 		entityID:        entityID,
 		roleID:          roleID,
 	}
-	if err := rmes.Publish(ctx, e); err != nil {
+	if err := rmes.publish(ctx, session, e); err != nil {
 		return actions, err
 	}
 	return actions, nil
@@ -171,7 +299,7 @@ func (rmes *RoleManagerEventStore) RoleCheckActionsExists(ctx context.Context, s
 		actions:         actions,
 		isAllExists:     isAllExists,
 	}
-	if err := rmes.Publish(ctx, e); err != nil {
+	if err := rmes.publish(ctx, session, e); err != nil {
 		return isAllExists, err
 	}
 	return isAllExists, nil
@@ -188,7 +316,7 @@ func (rmes *RoleManagerEventStore) RoleRemoveActions(ctx context.Context, sessio
 		roleID:          roleID,
 		actions:         actions,
 	}
-	if err := rmes.Publish(ctx, e); err != nil {
+	if err := rmes.publish(ctx, session, e); err != nil {
 		return err
 	}
 	return nil
@@ -204,7 +332,7 @@ func (rmes *RoleManagerEventStore) RoleRemoveAllActions(ctx context.Context, ses
 		entityID:        entityID,
 		roleID:          roleID,
 	}
-	if err := rmes.Publish(ctx, e); err != nil {
+	if err := rmes.publish(ctx, session, e); err != nil {
 		return err
 	}
 	return nil
@@ -222,7 +350,7 @@ func (rmes *RoleManagerEventStore) RoleAddMembers(ctx context.Context, session a
 		roleID:          roleID,
 		members:         members,
 	}
-	if err := rmes.Publish(ctx, e); err != nil {
+	if err := rmes.publish(ctx, session, e); err != nil {
 		return mems, err
 	}
 	return mems, nil
@@ -241,12 +369,48 @@ func (rmes *RoleManagerEventStore) RoleListMembers(ctx context.Context, session
 		limit:           limit,
 		offset:          offset,
 	}
-	if err := rmes.Publish(ctx, e); err != nil {
+	if err := rmes.publish(ctx, session, e); err != nil {
 		return mp, err
 	}
 	return mp, nil
 }
 
+func (rmes *RoleManagerEventStore) ListEntityMembers(ctx context.Context, session authn.Session, entityID string, limit, offset uint64) (roles.EntityMembersPage, error) {
+	emp, err := rmes.svc.ListEntityMembers(ctx, session, entityID, limit, offset)
+	if err != nil {
+		return emp, err
+	}
+
+	e := listEntityMembersEvent{
+		operationPrefix: rmes.operationPrefix,
+		entityID:        entityID,
+		limit:           limit,
+		offset:          offset,
+	}
+	if err := rmes.publish(ctx, session, e); err != nil {
+		return emp, err
+	}
+	return emp, nil
+}
+
+func (rmes *RoleManagerEventStore) ListMemberEntities(ctx context.Context, session authn.Session, memberID string, limit, offset uint64) (roles.MemberEntitiesPage, error) {
+	mep, err := rmes.svc.ListMemberEntities(ctx, session, memberID, limit, offset)
+	if err != nil {
+		return mep, err
+	}
+
+	e := listMemberEntitiesEvent{
+		operationPrefix: rmes.operationPrefix,
+		memberID:        memberID,
+		limit:           limit,
+		offset:          offset,
+	}
+	if err := rmes.publish(ctx, session, e); err != nil {
+		return mep, err
+	}
+	return mep, nil
+}
+
 func (rmes *RoleManagerEventStore) RoleCheckMembersExists(ctx context.Context, session authn.Session, entityID, roleID string, members []string) (bool, error) {
 	isAllExists, err := rmes.svc.RoleCheckMembersExists(ctx, session, entityID, roleID, members)
 	if err != nil {
@@ -259,7 +423,7 @@ func (rmes *RoleManagerEventStore) RoleCheckMembersExists(ctx context.Context, s
 		roleID:          roleID,
 		members:         members,
 	}
-	if err := rmes.Publish(ctx, e); err != nil {
+	if err := rmes.publish(ctx, session, e); err != nil {
 		return isAllExists, err
 	}
 	return isAllExists, nil
@@ -276,7 +440,7 @@ func (rmes *RoleManagerEventStore) RoleRemoveMembers(ctx context.Context, sessio
 		roleID:          roleID,
 		members:         members,
 	}
-	if err := rmes.Publish(ctx, e); err != nil {
+	if err := rmes.publish(ctx, session, e); err != nil {
 		return err
 	}
 	return nil
@@ -292,7 +456,7 @@ func (rmes *RoleManagerEventStore) RoleRemoveAllMembers(ctx context.Context, ses
 		entityID:        entityID,
 		roleID:          roleID,
 	}
-	if err := rmes.Publish(ctx, e); err != nil {
+	if err := rmes.publish(ctx, session, e); err != nil {
 		return err
 	}
 	return nil
@@ -307,7 +471,7 @@ func (rmes *RoleManagerEventStore) RemoveMemberFromAllRoles(ctx context.Context,
 		operationPrefix: rmes.operationPrefix,
 		memberID:        memberID,
 	}
-	if err := rmes.Publish(ctx, e); err != nil {
+	if err := rmes.publish(ctx, session, e); err != nil {
 		return err
 	}
 	return nil
@@ -66,6 +66,20 @@ func (ram RoleManagerAuthorizationMiddleware) AddRole(ctx context.Context, sessi
 	return ram.svc.AddRole(ctx, session, entityID, roleName, optionalActions, optionalMembers)
 }
 
+func (ram RoleManagerAuthorizationMiddleware) AddRoles(ctx context.Context, session authn.Session, entityID string, rps []roles.RoleProvision) ([]roles.RoleProvision, error) {
+	if err := ram.authorize(ctx, roles.OpAddRole, smqauthz.PolicyReq{
+		Domain:      session.DomainID,
+		Subject:     session.DomainUserID,
+		SubjectType: policies.UserType,
+		SubjectKind: policies.UsersKind,
+		Object:      entityID,
+		ObjectType:  ram.entityType,
+	}); err != nil {
+		return []roles.RoleProvision{}, err
+	}
+	return ram.svc.AddRoles(ctx, session, entityID, rps)
+}
+
 func (ram RoleManagerAuthorizationMiddleware) RemoveRole(ctx context.Context, session authn.Session, entityID, roleID string) error {
 	if err := ram.authorize(ctx, roles.OpRemoveRole, smqauthz.PolicyReq{
 		Domain:      session.DomainID,
@@ -94,6 +108,20 @@ func (ram RoleManagerAuthorizationMiddleware) UpdateRoleName(ctx context.Context
 	return ram.svc.UpdateRoleName(ctx, session, entityID, roleID, newRoleName)
 }
 
+func (ram RoleManagerAuthorizationMiddleware) SetRoleInheritance(ctx context.Context, session authn.Session, entityID, roleID, inheritsFrom string) (roles.Role, error) {
+	if err := ram.authorize(ctx, roles.OpUpdateRoleName, smqauthz.PolicyReq{
+		Domain:      session.DomainID,
+		Subject:     session.DomainUserID,
+		SubjectType: policies.UserType,
+		SubjectKind: policies.UsersKind,
+		Object:      entityID,
+		ObjectType:  ram.entityType,
+	}); err != nil {
+		return roles.Role{}, err
+	}
+	return ram.svc.SetRoleInheritance(ctx, session, entityID, roleID, inheritsFrom)
+}
+
 func (ram RoleManagerAuthorizationMiddleware) RetrieveRole(ctx context.Context, session authn.Session, entityID, roleID string) (roles.Role, error) {
 	if err := ram.authorize(ctx, roles.OpRetrieveRole, smqauthz.PolicyReq{
 		Domain:      session.DomainID,
@@ -126,6 +154,10 @@ func (ram RoleManagerAuthorizationMiddleware) ListAvailableActions(ctx context.C
 	return ram.svc.ListAvailableActions(ctx, session)
 }
 
+func (ram RoleManagerAuthorizationMiddleware) ListRoleTemplates(ctx context.Context, session authn.Session) (map[string][]string, error) {
+	return ram.svc.ListRoleTemplates(ctx, session)
+}
+
 func (ram RoleManagerAuthorizationMiddleware) RoleAddActions(ctx context.Context, session authn.Session, entityID, roleID string, actions []string) (ops []string, err error) {
 	if err := ram.authorize(ctx, roles.OpRoleAddActions, smqauthz.PolicyReq{
 		Domain:      session.DomainID,
@@ -226,6 +258,24 @@ func (ram RoleManagerAuthorizationMiddleware) RoleListMembers(ctx context.Contex
 	return ram.svc.RoleListMembers(ctx, session, entityID, roleID, limit, offset)
 }
 
+func (ram RoleManagerAuthorizationMiddleware) ListEntityMembers(ctx context.Context, session authn.Session, entityID string, limit, offset uint64) (roles.EntityMembersPage, error) {
+	if err := ram.authorize(ctx, roles.OpRoleListMembers, smqauthz.PolicyReq{
+		Domain:      session.DomainID,
+		Subject:     session.DomainUserID,
+		SubjectType: policies.UserType,
+		SubjectKind: policies.UsersKind,
+		Object:      entityID,
+		ObjectType:  ram.entityType,
+	}); err != nil {
+		return roles.EntityMembersPage{}, err
+	}
+	return ram.svc.ListEntityMembers(ctx, session, entityID, limit, offset)
+}
+
+func (ram RoleManagerAuthorizationMiddleware) ListMemberEntities(ctx context.Context, session authn.Session, memberID string, limit, offset uint64) (roles.MemberEntitiesPage, error) {
+	return ram.svc.ListMemberEntities(ctx, session, memberID, limit, offset)
+}
+
 func (ram RoleManagerAuthorizationMiddleware) RoleCheckMembersExists(ctx context.Context, session authn.Session, entityID, roleID string, members []string) (bool, error) {
 	if err := ram.authorize(ctx, roles.OpRoleCheckMembersExists, smqauthz.PolicyReq{
 		Domain:      session.DomainID,
@@ -45,14 +45,34 @@ func (lm *RoleManagerLoggingMiddleware) AddRole(ctx context.Context, session aut
 		}
 		if err != nil {
 			args = append(args, slog.String("error", err.Error()))
-			lm.logger.Warn(prefix+" failed", args...)
+			lm.logger.WarnContext(ctx, prefix+" failed", args...)
 			return
 		}
-		lm.logger.Info(prefix+" completed successfully", args...)
+		lm.logger.InfoContext(ctx, prefix+" completed successfully", args...)
 	}(time.Now())
 	return lm.svc.AddRole(ctx, session, entityID, roleName, optionalActions, optionalMembers)
 }
 
+func (lm *RoleManagerLoggingMiddleware) AddRoles(ctx context.Context, session authn.Session, entityID string, rps []roles.RoleProvision) (nrps []roles.RoleProvision, err error) {
+	prefix := fmt.Sprintf("Add %s bulk roles", lm.svcName)
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.Group(lm.svcName+"_add_roles",
+				slog.String("entity_id", entityID),
+				slog.Int("roles", len(rps)),
+			),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.WarnContext(ctx, prefix+" failed", args...)
+			return
+		}
+		lm.logger.InfoContext(ctx, prefix+" completed successfully", args...)
+	}(time.Now())
+	return lm.svc.AddRoles(ctx, session, entityID, rps)
+}
+
 func (lm *RoleManagerLoggingMiddleware) RemoveRole(ctx context.Context, session authn.Session, entityID, roleID string) (err error) {
 	prefix := fmt.Sprintf("Delete %s role", lm.svcName)
 	defer func(begin time.Time) {
@@ -65,10 +85,10 @@ func (lm *RoleManagerLoggingMiddleware) RemoveRole(ctx context.Context, session
 		}
 		if err != nil {
 			args = append(args, slog.String("error", err.Error()))
-			lm.logger.Warn(prefix+" failed", args...)
+			lm.logger.WarnContext(ctx, prefix+" failed", args...)
 			return
 		}
-		lm.logger.Info(prefix+" completed successfully", args...)
+		lm.logger.InfoContext(ctx, prefix+" completed successfully", args...)
 	}(time.Now())
 	return lm.svc.RemoveRole(ctx, session, entityID, roleID)
 }
@@ -86,14 +106,35 @@ func (lm *RoleManagerLoggingMiddleware) UpdateRoleName(ctx context.Context, sess
 		}
 		if err != nil {
 			args = append(args, slog.String("error", err.Error()))
-			lm.logger.Warn(prefix+" failed", args...)
+			lm.logger.WarnContext(ctx, prefix+" failed", args...)
 			return
 		}
-		lm.logger.Info(prefix+" completed successfully", args...)
+		lm.logger.InfoContext(ctx, prefix+" completed successfully", args...)
 	}(time.Now())
 	return lm.svc.UpdateRoleName(ctx, session, entityID, roleID, newRoleName)
 }
 
+func (lm *RoleManagerLoggingMiddleware) SetRoleInheritance(ctx context.Context, session authn.Session, entityID, roleID, inheritsFrom string) (ro roles.Role, err error) {
+	prefix := fmt.Sprintf("Set %s role inheritance", lm.svcName)
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.Group(lm.svcName+"_set_role_inheritance",
+				slog.String("entity_id", entityID),
+				slog.String("role_id", roleID),
+				slog.String("inherits_from", inheritsFrom),
+			),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.WarnContext(ctx, prefix+" failed", args...)
+			return
+		}
+		lm.logger.InfoContext(ctx, prefix+" completed successfully", args...)
+	}(time.Now())
+	return lm.svc.SetRoleInheritance(ctx, session, entityID, roleID, inheritsFrom)
+}
+
 func (lm *RoleManagerLoggingMiddleware) RetrieveRole(ctx context.Context, session authn.Session, entityID, roleID string) (ro roles.Role, err error) {
 	prefix := fmt.Sprintf("Retrieve %s role", lm.svcName)
 	defer func(begin time.Time) {
@@ -106,10 +147,10 @@ func (lm *RoleManagerLoggingMiddleware) RetrieveRole(ctx context.Context, sessio
 		}
 		if err != nil {
 			args = append(args, slog.String("error", err.Error()))
-			lm.logger.Warn(prefix+" failed", args...)
+			lm.logger.WarnContext(ctx, prefix+" failed", args...)
 			return
 		}
-		lm.logger.Info(prefix+" completed successfully", args...)
+		lm.logger.InfoContext(ctx, prefix+" completed successfully", args...)
 	}(time.Now())
 	return lm.svc.RetrieveRole(ctx, session, entityID, roleID)
 }
@@ -127,10 +168,10 @@ func (lm *RoleManagerLoggingMiddleware) RetrieveAllRoles(ctx context.Context, se
 		}
 		if err != nil {
 			args = append(args, slog.String("error", err.Error()))
-			lm.logger.Warn(prefix+" failed", args...)
+			lm.logger.WarnContext(ctx, prefix+" failed", args...)
 			return
 		}
-		lm.logger.Info(prefix+" completed successfully", args...)
+		lm.logger.InfoContext(ctx, prefix+" completed successfully", args...)
 	}(time.Now())
 	return lm.svc.RetrieveAllRoles(ctx, session, entityID, limit, offset)
 }
@@ -144,14 +185,31 @@ func (lm *RoleManagerLoggingMiddleware) ListAvailableActions(ctx context.Context
 		}
 		if err != nil {
 			args = append(args, slog.String("error", err.Error()))
-			lm.logger.Warn(prefix+" failed", args...)
+			lm.logger.WarnContext(ctx, prefix+" failed", args...)
 			return
 		}
-		lm.logger.Info(prefix+" completed successfully", args...)
+		lm.logger.InfoContext(ctx, prefix+" completed successfully", args...)
 	}(time.Now())
 	return lm.svc.ListAvailableActions(ctx, session)
 }
 
+func (lm *RoleManagerLoggingMiddleware) ListRoleTemplates(ctx context.Context, session authn.Session) (templates map[string][]string, err error) {
+	prefix := fmt.Sprintf("List %s role templates", lm.svcName)
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.Group(lm.svcName + "_list_role_templates"),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.WarnContext(ctx, prefix+" failed", args...)
+			return
+		}
+		lm.logger.InfoContext(ctx, prefix+" completed successfully", args...)
+	}(time.Now())
+	return lm.svc.ListRoleTemplates(ctx, session)
+}
+
 func (lm *RoleManagerLoggingMiddleware) RoleAddActions(ctx context.Context, session authn.Session, entityID, roleID string, actions []string) (caps []string, err error) {
 	prefix := fmt.Sprintf("%s role add actions", lm.svcName)
 	defer func(begin time.Time) {
@@ -165,10 +223,10 @@ func (lm *RoleManagerLoggingMiddleware) RoleAddActions(ctx context.Context, sess
 		}
 		if err != nil {
 			args = append(args, slog.String("error", err.Error()))
-			lm.logger.Warn(prefix+" failed", args...)
+			lm.logger.WarnContext(ctx, prefix+" failed", args...)
 			return
 		}
-		lm.logger.Info(prefix+" completed successfully", args...)
+		lm.logger.InfoContext(ctx, prefix+" completed successfully", args...)
 	}(time.Now())
 	return lm.svc.RoleAddActions(ctx, session, entityID, roleID, actions)
 }
@@ -185,10 +243,10 @@ func (lm *RoleManagerLoggingMiddleware) RoleListActions(ctx context.Context, ses
 		}
 		if err != nil {
 			args = append(args, slog.String("error", err.Error()))
-			lm.logger.Warn(prefix+" failed", args...)
+			lm.logger.WarnContext(ctx, prefix+" failed", args...)
 			return
 		}
-		lm.logger.Info(prefix+" completed successfully", args...)
+		lm.logger.InfoContext(ctx, prefix+" completed successfully", args...)
 	}(time.Now())
 	return lm.svc.RoleListActions(ctx, session, entityID, roleID)
 }
@@ -210,10 +268,10 @@ func (lm *RoleManagerLoggingMiddleware) RoleRemoveActions(ctx context.Context, s
 		}
 		if err != nil {
 			args = append(args, slog.String("error", err.Error()))
-			lm.logger.Warn(prefix+" failed", args...)
+			lm.logger.WarnContext(ctx, prefix+" failed", args...)
 			return
 		}
-		lm.logger.Info(prefix+" completed successfully", args...)
+		lm.logger.InfoContext(ctx, prefix+" completed successfully", args...)
 	}(time.Now())
 	return lm.svc.RoleRemoveActions(ctx, session, entityID, roleID, actions)
 }
@@ -230,10 +288,10 @@ func (lm *RoleManagerLoggingMiddleware) RoleRemoveAllActions(ctx context.Context
 		}
 		if err != nil {
 			args = append(args, slog.String("error", err.Error()))
-			lm.logger.Warn(prefix+" failed", args...)
+			lm.logger.WarnContext(ctx, prefix+" failed", args...)
 			return
 		}
-		lm.logger.Info(prefix+" completed successfully", args...)
+		lm.logger.InfoContext(ctx, prefix+" completed successfully", args...)
 	}(time.Now())
 	return lm.svc.RoleRemoveAllActions(ctx, session, entityID, roleID)
 }
@@ -251,10 +309,10 @@ func (lm *RoleManagerLoggingMiddleware) RoleAddMembers(ctx context.Context, sess
 		}
 		if err != nil {
 			args = append(args, slog.String("error", err.Error()))
-			lm.logger.Warn(prefix+" failed", args...)
+			lm.logger.WarnContext(ctx, prefix+" failed", args...)
 			return
 		}
-		lm.logger.Info(prefix+" completed successfully", args...)
+		lm.logger.InfoContext(ctx, prefix+" completed successfully", args...)
 	}(time.Now())
 	return lm.svc.RoleAddMembers(ctx, session, entityID, roleID, members)
 }
@@ -273,14 +331,56 @@ func (lm *RoleManagerLoggingMiddleware) RoleListMembers(ctx context.Context, ses
 		}
 		if err != nil {
 			args = append(args, slog.String("error", err.Error()))
-			lm.logger.Warn(prefix+" failed", args...)
+			lm.logger.WarnContext(ctx, prefix+" failed", args...)
 			return
 		}
-		lm.logger.Info(prefix+" completed successfully", args...)
+		lm.logger.InfoContext(ctx, prefix+" completed successfully", args...)
 	}(time.Now())
 	return lm.svc.RoleListMembers(ctx, session, entityID, roleID, limit, offset)
 }
 
+func (lm *RoleManagerLoggingMiddleware) ListEntityMembers(ctx context.Context, session authn.Session, entityID string, limit, offset uint64) (emp roles.EntityMembersPage, err error) {
+	prefix := fmt.Sprintf("List %s entity members", lm.svcName)
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.Group(lm.svcName+"_list_entity_members",
+				slog.String("entity_id", entityID),
+				slog.Uint64("limit", limit),
+				slog.Uint64("offset", offset),
+			),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.WarnContext(ctx, prefix+" failed", args...)
+			return
+		}
+		lm.logger.InfoContext(ctx, prefix+" completed successfully", args...)
+	}(time.Now())
+	return lm.svc.ListEntityMembers(ctx, session, entityID, limit, offset)
+}
+
+func (lm *RoleManagerLoggingMiddleware) ListMemberEntities(ctx context.Context, session authn.Session, memberID string, limit, offset uint64) (mep roles.MemberEntitiesPage, err error) {
+	prefix := fmt.Sprintf("List %s member entities", lm.svcName)
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.Group(lm.svcName+"_list_member_entities",
+				slog.String("member_id", memberID),
+				slog.Uint64("limit", limit),
+				slog.Uint64("offset", offset),
+			),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.WarnContext(ctx, prefix+" failed", args...)
+			return
+		}
+		lm.logger.InfoContext(ctx, prefix+" completed successfully", args...)
+	}(time.Now())
+	return lm.svc.ListMemberEntities(ctx, session, memberID, limit, offset)
+}
+
 func (lm *RoleManagerLoggingMiddleware) RoleCheckMembersExists(ctx context.Context, session authn.Session, entityID, roleID string, members []string) (bool, error) {
 	return lm.svc.RoleCheckMembersExists(ctx, session, entityID, roleID, members)
 }
@@ -298,10 +398,10 @@ func (lm *RoleManagerLoggingMiddleware) RoleRemoveMembers(ctx context.Context, s
 		}
 		if err != nil {
 			args = append(args, slog.String("error", err.Error()))
-			lm.logger.Warn(prefix+" failed", args...)
+			lm.logger.WarnContext(ctx, prefix+" failed", args...)
 			return
 		}
-		lm.logger.Info(prefix+" completed successfully", args...)
+		lm.logger.InfoContext(ctx, prefix+" completed successfully", args...)
 	}(time.Now())
 	return lm.svc.RoleRemoveMembers(ctx, session, entityID, roleID, members)
 }
@@ -318,10 +418,10 @@ func (lm *RoleManagerLoggingMiddleware) RoleRemoveAllMembers(ctx context.Context
 		}
 		if err != nil {
 			args = append(args, slog.String("error", err.Error()))
-			lm.logger.Warn(prefix+" failed", args...)
+			lm.logger.WarnContext(ctx, prefix+" failed", args...)
 			return
 		}
-		lm.logger.Info(prefix+" completed successfully", args...)
+		lm.logger.InfoContext(ctx, prefix+" completed successfully", args...)
 	}(time.Now())
 	return lm.svc.RoleRemoveAllMembers(ctx, session, entityID, roleID)
 }
@@ -337,10 +437,10 @@ func (lm *RoleManagerLoggingMiddleware) RemoveMemberFromAllRoles(ctx context.Con
 		}
 		if err != nil {
 			args = append(args, slog.String("error", err.Error()))
-			lm.logger.Warn(prefix+" failed", args...)
+			lm.logger.WarnContext(ctx, prefix+" failed", args...)
 			return
 		}
-		lm.logger.Info(prefix+" completed successfully", args...)
+		lm.logger.InfoContext(ctx, prefix+" completed successfully", args...)
 	}(time.Now())
 	return lm.svc.RemoveMemberFromAllRoles(ctx, session, memberID)
 }
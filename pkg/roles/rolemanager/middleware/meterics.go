@@ -35,6 +35,10 @@ func (rmm *RoleManagerMetricsMiddleware) AddRole(ctx context.Context, session au
 	return rmm.svc.AddRole(ctx, session, entityID, roleName, optionalActions, optionalMembers)
 }
 
+func (rmm *RoleManagerMetricsMiddleware) AddRoles(ctx context.Context, session authn.Session, entityID string, rps []roles.RoleProvision) ([]roles.RoleProvision, error) {
+	return rmm.svc.AddRoles(ctx, session, entityID, rps)
+}
+
 func (rmm *RoleManagerMetricsMiddleware) RemoveRole(ctx context.Context, session authn.Session, entityID, roleID string) error {
 	return rmm.svc.RemoveRole(ctx, session, entityID, roleID)
 }
@@ -43,6 +47,10 @@ func (rmm *RoleManagerMetricsMiddleware) UpdateRoleName(ctx context.Context, ses
 	return rmm.svc.UpdateRoleName(ctx, session, entityID, roleID, newRoleName)
 }
 
+func (rmm *RoleManagerMetricsMiddleware) SetRoleInheritance(ctx context.Context, session authn.Session, entityID, roleID, inheritsFrom string) (roles.Role, error) {
+	return rmm.svc.SetRoleInheritance(ctx, session, entityID, roleID, inheritsFrom)
+}
+
 func (rmm *RoleManagerMetricsMiddleware) RetrieveRole(ctx context.Context, session authn.Session, entityID, roleID string) (roles.Role, error) {
 	return rmm.svc.RetrieveRole(ctx, session, entityID, roleID)
 }
@@ -55,6 +63,10 @@ func (rmm *RoleManagerMetricsMiddleware) ListAvailableActions(ctx context.Contex
 	return rmm.svc.ListAvailableActions(ctx, session)
 }
 
+func (rmm *RoleManagerMetricsMiddleware) ListRoleTemplates(ctx context.Context, session authn.Session) (map[string][]string, error) {
+	return rmm.svc.ListRoleTemplates(ctx, session)
+}
+
 func (rmm *RoleManagerMetricsMiddleware) RoleAddActions(ctx context.Context, session authn.Session, entityID, roleID string, actions []string) (caps []string, err error) {
 	return rmm.svc.RoleAddActions(ctx, session, entityID, roleID, actions)
 }
@@ -83,6 +95,14 @@ func (rmm *RoleManagerMetricsMiddleware) RoleListMembers(ctx context.Context, se
 	return rmm.svc.RoleListMembers(ctx, session, entityID, roleID, limit, offset)
 }
 
+func (rmm *RoleManagerMetricsMiddleware) ListEntityMembers(ctx context.Context, session authn.Session, entityID string, limit, offset uint64) (roles.EntityMembersPage, error) {
+	return rmm.svc.ListEntityMembers(ctx, session, entityID, limit, offset)
+}
+
+func (rmm *RoleManagerMetricsMiddleware) ListMemberEntities(ctx context.Context, session authn.Session, memberID string, limit, offset uint64) (roles.MemberEntitiesPage, error) {
+	return rmm.svc.ListMemberEntities(ctx, session, memberID, limit, offset)
+}
+
 func (rmm *RoleManagerMetricsMiddleware) RoleCheckMembersExists(ctx context.Context, session authn.Session, entityID, roleID string, members []string) (bool, error) {
 	return rmm.svc.RoleCheckMembersExists(ctx, session, entityID, roleID, members)
 }
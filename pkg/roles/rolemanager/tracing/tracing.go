@@ -27,6 +27,10 @@ func (rtm *RoleManagerTracing) AddRole(ctx context.Context, session authn.Sessio
 	return rtm.roles.AddRole(ctx, session, entityID, roleName, optionalActions, optionalMembers)
 }
 
+func (rtm *RoleManagerTracing) AddRoles(ctx context.Context, session authn.Session, entityID string, rps []roles.RoleProvision) ([]roles.RoleProvision, error) {
+	return rtm.roles.AddRoles(ctx, session, entityID, rps)
+}
+
 func (rtm *RoleManagerTracing) RemoveRole(ctx context.Context, session authn.Session, entityID, roleID string) error {
 	return rtm.roles.RemoveRole(ctx, session, entityID, roleID)
 }
@@ -35,6 +39,10 @@ func (rtm *RoleManagerTracing) UpdateRoleName(ctx context.Context, session authn
 	return rtm.roles.UpdateRoleName(ctx, session, entityID, roleID, newRoleName)
 }
 
+func (rtm *RoleManagerTracing) SetRoleInheritance(ctx context.Context, session authn.Session, entityID, roleID, inheritsFrom string) (roles.Role, error) {
+	return rtm.roles.SetRoleInheritance(ctx, session, entityID, roleID, inheritsFrom)
+}
+
 func (rtm *RoleManagerTracing) RetrieveRole(ctx context.Context, session authn.Session, entityID, roleID string) (roles.Role, error) {
 	return rtm.roles.RetrieveRole(ctx, session, entityID, roleID)
 }
@@ -47,6 +55,10 @@ func (rtm *RoleManagerTracing) ListAvailableActions(ctx context.Context, session
 	return rtm.roles.ListAvailableActions(ctx, session)
 }
 
+func (rtm *RoleManagerTracing) ListRoleTemplates(ctx context.Context, session authn.Session) (map[string][]string, error) {
+	return rtm.roles.ListRoleTemplates(ctx, session)
+}
+
 func (rtm *RoleManagerTracing) RoleAddActions(ctx context.Context, session authn.Session, entityID, roleID string, actions []string) (ops []string, err error) {
 	return rtm.roles.RoleAddActions(ctx, session, entityID, roleID, actions)
 }
@@ -75,6 +87,14 @@ func (rtm *RoleManagerTracing) RoleListMembers(ctx context.Context, session auth
 	return rtm.roles.RoleListMembers(ctx, session, entityID, roleID, limit, offset)
 }
 
+func (rtm *RoleManagerTracing) ListEntityMembers(ctx context.Context, session authn.Session, entityID string, limit, offset uint64) (roles.EntityMembersPage, error) {
+	return rtm.roles.ListEntityMembers(ctx, session, entityID, limit, offset)
+}
+
+func (rtm *RoleManagerTracing) ListMemberEntities(ctx context.Context, session authn.Session, memberID string, limit, offset uint64) (roles.MemberEntitiesPage, error) {
+	return rtm.roles.ListMemberEntities(ctx, session, memberID, limit, offset)
+}
+
 func (rtm *RoleManagerTracing) RoleCheckMembersExists(ctx context.Context, session authn.Session, entityID, roleID string, members []string) (bool, error) {
 	return rtm.roles.RoleCheckMembersExists(ctx, session, entityID, roleID, members)
 }
@@ -41,13 +41,14 @@ func (b BuiltInRoleName) String() string {
 }
 
 type Role struct {
-	ID        string    `json:"id"`
-	Name      string    `json:"name"`
-	EntityID  string    `json:"entity_id"`
-	CreatedBy string    `json:"created_by"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedBy string    `json:"updated_by"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID           string    `json:"id"`
+	Name         string    `json:"name"`
+	EntityID     string    `json:"entity_id"`
+	InheritsFrom string    `json:"inherits_from,omitempty"`
+	CreatedBy    string    `json:"created_by"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedBy    string    `json:"updated_by"`
+	UpdatedAt    time.Time `json:"updated_at"`
 }
 
 type RoleProvision struct {
@@ -70,6 +71,32 @@ type MembersPage struct {
 	Members []string `json:"members"`
 }
 
+type EntityMember struct {
+	MemberID string `json:"member_id"`
+	RoleID   string `json:"role_id"`
+	RoleName string `json:"role_name"`
+}
+
+type EntityMembersPage struct {
+	Total   uint64         `json:"total"`
+	Offset  uint64         `json:"offset"`
+	Limit   uint64         `json:"limit"`
+	Members []EntityMember `json:"members"`
+}
+
+type MemberEntity struct {
+	EntityID string `json:"entity_id"`
+	RoleID   string `json:"role_id"`
+	RoleName string `json:"role_name"`
+}
+
+type MemberEntitiesPage struct {
+	Total    uint64         `json:"total"`
+	Offset   uint64         `json:"offset"`
+	Limit    uint64         `json:"limit"`
+	Entities []MemberEntity `json:"entities"`
+}
+
 type EntityActionRole struct {
 	EntityID string `json:"entity_id"`
 	Action   string `json:"action"`
@@ -92,18 +119,29 @@ type RoleManager interface {
 	// Add New role to entity
 	AddRole(ctx context.Context, session authn.Session, entityID, roleName string, optionalActions []string, optionalMembers []string) (RoleProvision, error)
 
+	// AddRoles adds multiple new roles to entity in a single call.
+	AddRoles(ctx context.Context, session authn.Session, entityID string, rps []RoleProvision) ([]RoleProvision, error)
+
 	// Remove removes the roles of entity.
 	RemoveRole(ctx context.Context, session authn.Session, entityID, roleID string) error
 
 	// UpdateName update the name of the entity role.
 	UpdateRoleName(ctx context.Context, session authn.Session, entityID, roleID, newRoleName string) (Role, error)
 
+	// SetRoleInheritance makes the role inherit the actions of another role
+	// of the same entity. An empty inheritsFrom clears the inheritance.
+	SetRoleInheritance(ctx context.Context, session authn.Session, entityID, roleID, inheritsFrom string) (Role, error)
+
 	RetrieveRole(ctx context.Context, session authn.Session, entityID, roleID string) (Role, error)
 
 	RetrieveAllRoles(ctx context.Context, session authn.Session, entityID string, limit, offset uint64) (RolePage, error)
 
 	ListAvailableActions(ctx context.Context, session authn.Session) ([]string, error)
 
+	// ListRoleTemplates lists the built-in role templates configured for the
+	// entity type, mapping role names to the actions they grant.
+	ListRoleTemplates(ctx context.Context, session authn.Session) (map[string][]string, error)
+
 	RoleAddActions(ctx context.Context, session authn.Session, entityID, roleID string, actions []string) (ops []string, err error)
 
 	RoleListActions(ctx context.Context, session authn.Session, entityID, roleID string) ([]string, error)
@@ -118,6 +156,13 @@ type RoleManager interface {
 
 	RoleListMembers(ctx context.Context, session authn.Session, entityID, roleID string, limit, offset uint64) (MembersPage, error)
 
+	// ListEntityMembers lists members holding any role on the entity together
+	// with the role they hold.
+	ListEntityMembers(ctx context.Context, session authn.Session, entityID string, limit, offset uint64) (EntityMembersPage, error)
+
+	// ListMemberEntities lists entities on which the member holds any role.
+	ListMemberEntities(ctx context.Context, session authn.Session, memberID string, limit, offset uint64) (MemberEntitiesPage, error)
+
 	RoleCheckMembersExists(ctx context.Context, session authn.Session, entityID, roleID string, members []string) (bool, error)
 
 	RoleRemoveMembers(ctx context.Context, session authn.Session, entityID, roleID string, members []string) (err error)
@@ -132,6 +177,7 @@ type Repository interface {
 	AddRoles(ctx context.Context, rps []RoleProvision) ([]RoleProvision, error)
 	RemoveRoles(ctx context.Context, roleIDs []string) error
 	UpdateRole(ctx context.Context, ro Role) (Role, error)
+	SetRoleInheritance(ctx context.Context, ro Role) (Role, error)
 	RetrieveRole(ctx context.Context, roleID string) (Role, error)
 	RetrieveEntityRole(ctx context.Context, entityID, roleID string) (Role, error)
 	RetrieveAllRoles(ctx context.Context, entityID string, limit, offset uint64) (RolePage, error)
@@ -142,6 +188,8 @@ type Repository interface {
 	RoleRemoveAllActions(ctx context.Context, role Role) error
 	RoleAddMembers(ctx context.Context, role Role, members []string) ([]string, error)
 	RoleListMembers(ctx context.Context, roleID string, limit, offset uint64) (MembersPage, error)
+	ListEntityMembers(ctx context.Context, entityID string, limit, offset uint64) (EntityMembersPage, error)
+	ListMemberEntities(ctx context.Context, memberID string, limit, offset uint64) (MemberEntitiesPage, error)
 	RoleCheckMembersExists(ctx context.Context, roleID string, members []string) (bool, error)
 	RoleRemoveMembers(ctx context.Context, role Role, members []string) (err error)
 	RoleRemoveAllMembers(ctx context.Context, role Role) (err error)
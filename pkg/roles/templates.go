@@ -0,0 +1,39 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package roles
+
+import (
+	"encoding/json"
+
+	"github.com/absmach/supermq/pkg/errors"
+)
+
+var errInvalidRoleTemplates = errors.New("invalid role templates")
+
+// OverrideBuiltInRoles applies deployment-specific overrides to the default
+// built-in role templates. Overrides are given as a JSON object mapping role
+// names to action lists: a role with a non-empty action list is added or
+// replaced, while a role with an empty list is removed from the templates.
+func OverrideBuiltInRoles(defaults map[BuiltInRoleName][]Action, overridesJSON string) (map[BuiltInRoleName][]Action, error) {
+	templates := make(map[BuiltInRoleName][]Action, len(defaults))
+	for name, actions := range defaults {
+		templates[name] = actions
+	}
+	if overridesJSON == "" {
+		return templates, nil
+	}
+
+	overrides := map[string][]string{}
+	if err := json.Unmarshal([]byte(overridesJSON), &overrides); err != nil {
+		return nil, errors.Wrap(errInvalidRoleTemplates, err)
+	}
+	for name, actions := range overrides {
+		if len(actions) == 0 {
+			delete(templates, BuiltInRoleName(name))
+			continue
+		}
+		templates[BuiltInRoleName(name)] = toRolesActions(actions)
+	}
+	return templates, nil
+}
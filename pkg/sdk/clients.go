@@ -29,6 +29,7 @@ type Client struct {
 	Name        string                 `json:"name,omitempty"`
 	Tags        []string               `json:"tags,omitempty"`
 	DomainID    string                 `json:"domain_id,omitempty"`
+	ExternalID  string                 `json:"external_id,omitempty"`
 	ParentGroup string                 `json:"parent_group_id,omitempty"`
 	Credentials ClientCredentials      `json:"credentials"`
 	Metadata    map[string]interface{} `json:"metadata,omitempty"`
@@ -0,0 +1,39 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package sdk
+
+import (
+	"net/http"
+
+	"github.com/absmach/supermq/pkg/errors"
+)
+
+// IsNotFound returns true if the error reports that the requested entity
+// does not exist.
+func IsNotFound(err error) bool {
+	return hasStatus(err, http.StatusNotFound)
+}
+
+// IsUnauthorized returns true if the error reports failed authentication.
+func IsUnauthorized(err error) bool {
+	return hasStatus(err, http.StatusUnauthorized)
+}
+
+// IsForbidden returns true if the error reports failed authorization.
+func IsForbidden(err error) bool {
+	return hasStatus(err, http.StatusForbidden)
+}
+
+// IsConflict returns true if the error reports that the entity already exists.
+func IsConflict(err error) bool {
+	return hasStatus(err, http.StatusConflict)
+}
+
+func hasStatus(err error, code int) bool {
+	sdkErr, ok := err.(errors.SDKError)
+	if !ok {
+		return false
+	}
+	return sdkErr.StatusCode() == code
+}
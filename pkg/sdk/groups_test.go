@@ -607,12 +607,12 @@ func TestViewGroup(t *testing.T) {
 				tc.session = smqauthn.Session{DomainUserID: domainID + "_" + validID, UserID: validID, DomainID: domainID}
 			}
 			authCall := auth.On("Authenticate", mock.Anything, tc.token).Return(tc.session, tc.authenticateErr)
-			svcCall := gsvc.On("ViewGroup", mock.Anything, tc.session, tc.groupID).Return(tc.svcRes, tc.svcErr)
+			svcCall := gsvc.On("ViewGroup", mock.Anything, tc.session, tc.groupID, false, false).Return(tc.svcRes, tc.svcErr)
 			resp, err := mgsdk.Group(tc.groupID, tc.domainID, tc.token)
 			assert.Equal(t, tc.err, err)
 			assert.Equal(t, tc.response, resp)
 			if tc.err == nil {
-				ok := svcCall.Parent.AssertCalled(t, "ViewGroup", mock.Anything, tc.session, tc.groupID)
+				ok := svcCall.Parent.AssertCalled(t, "ViewGroup", mock.Anything, tc.session, tc.groupID, false, false)
 				assert.True(t, ok)
 			}
 			svcCall.Unset()
@@ -1018,12 +1018,12 @@ func TestDisableGroup(t *testing.T) {
 				tc.session = smqauthn.Session{DomainUserID: domainID + "_" + validID, UserID: validID, DomainID: domainID}
 			}
 			authCall := auth.On("Authenticate", mock.Anything, tc.token).Return(tc.session, tc.authenticateErr)
-			svcCall := gsvc.On("DisableGroup", mock.Anything, tc.session, tc.groupID).Return(tc.svcRes, tc.svcErr)
+			svcCall := gsvc.On("DisableGroup", mock.Anything, tc.session, tc.groupID, false).Return(tc.svcRes, tc.svcErr)
 			resp, err := mgsdk.DisableGroup(tc.groupID, tc.domainID, tc.token)
 			assert.Equal(t, tc.err, err)
 			assert.Equal(t, tc.response, resp)
 			if tc.err == nil {
-				ok := svcCall.Parent.AssertCalled(t, "DisableGroup", mock.Anything, tc.session, tc.groupID)
+				ok := svcCall.Parent.AssertCalled(t, "DisableGroup", mock.Anything, tc.session, tc.groupID, false)
 				assert.True(t, ok)
 			}
 			svcCall.Unset()
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/absmach/mgate"
 	proxy "github.com/absmach/mgate/pkg/http"
@@ -38,9 +39,9 @@ func setupMessages() (*httptest.Server, *pubsub.PubSub) {
 	channelsGRPCClient = new(chmocks.ChannelsServiceClient)
 	pub := new(pubsub.PubSub)
 	authn := new(authnmocks.Authentication)
-	handler := adapter.NewHandler(pub, authn, clientsGRPCClient, channelsGRPCClient, smqlog.NewMock())
+	handler := adapter.NewHandler(pub, authn, clientsGRPCClient, channelsGRPCClient, smqlog.NewMock(), 0)
 
-	mux := api.MakeHandler(smqlog.NewMock(), "")
+	mux := api.MakeHandler(smqlog.NewMock(), "", api.NewAdmission(1024, 1024, time.Second))
 	target := httptest.NewServer(mux)
 
 	config := mgate.Config{
@@ -6003,6 +6003,69 @@ func (_c *SDK_SetGroupParent_Call) RunAndReturn(run func(string, string, string,
 	return _c
 }
 
+// Subscribe provides a mock function with given fields: channelID, subtopic, key, handler
+func (_m *SDK) Subscribe(channelID string, subtopic string, key string, handler sdk.MessagesHandler) (*sdk.Subscription, errors.SDKError) {
+	ret := _m.Called(channelID, subtopic, key, handler)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Subscribe")
+	}
+
+	var r0 *sdk.Subscription
+	var r1 errors.SDKError
+	if rf, ok := ret.Get(0).(func(string, string, string, sdk.MessagesHandler) (*sdk.Subscription, errors.SDKError)); ok {
+		return rf(channelID, subtopic, key, handler)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, sdk.MessagesHandler) *sdk.Subscription); ok {
+		r0 = rf(channelID, subtopic, key, handler)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sdk.Subscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, sdk.MessagesHandler) errors.SDKError); ok {
+		r1 = rf(channelID, subtopic, key, handler)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.SDKError)
+		}
+	}
+
+	return r0, r1
+}
+
+// SDK_Subscribe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Subscribe'
+type SDK_Subscribe_Call struct {
+	*mock.Call
+}
+
+// Subscribe is a helper method to define mock.On call
+//   - channelID string
+//   - subtopic string
+//   - key string
+//   - handler sdk.MessagesHandler
+func (_e *SDK_Expecter) Subscribe(channelID interface{}, subtopic interface{}, key interface{}, handler interface{}) *SDK_Subscribe_Call {
+	return &SDK_Subscribe_Call{Call: _e.mock.On("Subscribe", channelID, subtopic, key, handler)}
+}
+
+func (_c *SDK_Subscribe_Call) Run(run func(channelID string, subtopic string, key string, handler sdk.MessagesHandler)) *SDK_Subscribe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(string), args[3].(sdk.MessagesHandler))
+	})
+	return _c
+}
+
+func (_c *SDK_Subscribe_Call) Return(_a0 *sdk.Subscription, _a1 errors.SDKError) *SDK_Subscribe_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SDK_Subscribe_Call) RunAndReturn(run func(string, string, string, sdk.MessagesHandler) (*sdk.Subscription, errors.SDKError)) *SDK_Subscribe_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateChannel provides a mock function with given fields: channel, domainID, token
 func (_m *SDK) UpdateChannel(channel sdk.Channel, domainID string, token string) (sdk.Channel, errors.SDKError) {
 	ret := _m.Called(channel, domainID, token)
@@ -55,6 +55,7 @@ type UserGroupsRequest struct {
 
 type RoleReq struct {
 	RoleName        string   `json:"role_name"`
+	Template        string   `json:"template,omitempty"`
 	OptionalActions []string `json:"optional_actions"`
 	OptionalMembers []string `json:"optional_members"`
 }
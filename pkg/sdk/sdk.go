@@ -1322,11 +1322,23 @@ type SDK interface {
 	//  journals, _ := sdk.Journal("client", "clientID","domainID", PageMetadata{Offset: 0, Limit: 10, Operation: "thing.create"}, "token")
 	//  fmt.Println(journals)
 	Journal(entityType, entityID, domainID string, pm PageMetadata, token string) (journal JournalsPage, err error)
+
+	// Subscribe opens a WebSocket subscription to the channel and invokes the
+	// handler for every received SenML record. The connection is redialed
+	// with exponential backoff when it drops.
+	//
+	// For example:
+	//  sub, _ := sdk.Subscribe("channelID", "subtopic", "clientSecret", func(record senml.Record) {
+	//  	fmt.Println(record)
+	//  })
+	//  defer sub.Close()
+	Subscribe(channelID, subtopic, key string, handler MessagesHandler) (*Subscription, errors.SDKError)
 }
 
 type mgSDK struct {
 	certsURL       string
 	httpAdapterURL string
+	wsAdapterURL   string
 	clientsURL     string
 	usersURL       string
 	groupsURL      string
@@ -1345,6 +1357,7 @@ type mgSDK struct {
 type Config struct {
 	CertsURL       string
 	HTTPAdapterURL string
+	WSAdapterURL   string
 	ClientsURL     string
 	UsersURL       string
 	GroupsURL      string
@@ -1364,6 +1377,7 @@ func NewSDK(conf Config) SDK {
 	return &mgSDK{
 		certsURL:       conf.CertsURL,
 		httpAdapterURL: conf.HTTPAdapterURL,
+		wsAdapterURL:   conf.WSAdapterURL,
 		clientsURL:     conf.ClientsURL,
 		usersURL:       conf.UsersURL,
 		groupsURL:      conf.GroupsURL,
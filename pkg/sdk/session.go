@@ -0,0 +1,101 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package sdk
+
+import (
+	"sync"
+	"time"
+
+	"github.com/absmach/supermq/pkg/errors"
+)
+
+const (
+	defRefreshRetries = 3
+	defRetryDelay     = 500 * time.Millisecond
+)
+
+// Session holds a token pair and transparently refreshes the access token
+// when an SDK call fails with an authentication error.
+type Session struct {
+	sdk     SDK
+	token   Token
+	retries uint
+	delay   time.Duration
+	mu      sync.Mutex
+}
+
+// SessionOption configures a Session.
+type SessionOption func(s *Session)
+
+// WithRefreshRetries sets the number of token refresh attempts and the delay
+// between them.
+func WithRefreshRetries(retries uint, delay time.Duration) SessionOption {
+	return func(s *Session) {
+		s.retries = retries
+		s.delay = delay
+	}
+}
+
+// NewSession logs the user in and returns a session that keeps the token
+// pair fresh.
+func NewSession(sdk SDK, login Login, opts ...SessionOption) (*Session, errors.SDKError) {
+	token, err := sdk.CreateToken(login)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Session{
+		sdk:     sdk,
+		token:   token,
+		retries: defRefreshRetries,
+		delay:   defRetryDelay,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s, nil
+}
+
+// Token returns the current token pair.
+func (s *Session) Token() Token {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.token
+}
+
+// Do invokes fn with the current access token. If the call fails with an
+// authentication error, the access token is refreshed using the stored
+// refresh token and the call is retried.
+func (s *Session) Do(fn func(accessToken string) errors.SDKError) errors.SDKError {
+	err := fn(s.Token().AccessToken)
+	if err == nil || !IsUnauthorized(err) {
+		return err
+	}
+
+	if err := s.refresh(); err != nil {
+		return err
+	}
+
+	return fn(s.Token().AccessToken)
+}
+
+func (s *Session) refresh() errors.SDKError {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var err errors.SDKError
+	for i := uint(0); i < s.retries; i++ {
+		if i > 0 {
+			time.Sleep(s.delay)
+		}
+		var token Token
+		if token, err = s.sdk.RefreshToken(s.token.RefreshToken); err == nil {
+			s.token = token
+			return nil
+		}
+	}
+
+	return err
+}
@@ -0,0 +1,107 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package sdk_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/absmach/supermq/pkg/errors"
+	sdk "github.com/absmach/supermq/pkg/sdk"
+	"github.com/absmach/supermq/pkg/sdk/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSessionDo(t *testing.T) {
+	login := sdk.Login{Username: "user", Password: "pass"}
+	token := sdk.Token{AccessToken: "access", RefreshToken: "refresh"}
+	refreshed := sdk.Token{AccessToken: "new-access", RefreshToken: "new-refresh"}
+	unauthorized := errors.NewSDKErrorWithStatus(errors.New("unauthorized"), http.StatusUnauthorized)
+
+	cases := []struct {
+		desc       string
+		callErrs   []errors.SDKError
+		refreshErr errors.SDKError
+		err        errors.SDKError
+		token      string
+	}{
+		{
+			desc:     "successful call without refresh",
+			callErrs: []errors.SDKError{nil},
+			token:    token.AccessToken,
+		},
+		{
+			desc:     "expired token refreshed and call retried",
+			callErrs: []errors.SDKError{unauthorized, nil},
+			token:    refreshed.AccessToken,
+		},
+		{
+			desc:       "refresh failure",
+			callErrs:   []errors.SDKError{unauthorized},
+			refreshErr: unauthorized,
+			err:        unauthorized,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			mgsdk := new(mocks.SDK)
+			mgsdk.On("CreateToken", login).Return(token, nil)
+			if tc.refreshErr != nil {
+				mgsdk.On("RefreshToken", token.RefreshToken).Return(sdk.Token{}, tc.refreshErr)
+			} else {
+				mgsdk.On("RefreshToken", token.RefreshToken).Return(refreshed, nil)
+			}
+
+			session, err := sdk.NewSession(mgsdk, login, sdk.WithRefreshRetries(1, time.Millisecond))
+			assert.Nil(t, err, "NewSession() error = %v", err)
+
+			calls := 0
+			var lastToken string
+			callErr := session.Do(func(accessToken string) errors.SDKError {
+				lastToken = accessToken
+				defer func() { calls++ }()
+				return tc.callErrs[calls]
+			})
+			assert.Equal(t, tc.err, callErr, "Do() error = %v, expected %v", callErr, tc.err)
+			if tc.err == nil {
+				assert.Equal(t, tc.token, lastToken, "Do() used token %s, expected %s", lastToken, tc.token)
+			}
+		})
+	}
+}
+
+func TestSessionLoginFailure(t *testing.T) {
+	login := sdk.Login{Username: "user", Password: "wrong"}
+	loginErr := errors.NewSDKErrorWithStatus(errors.New("invalid credentials"), http.StatusUnauthorized)
+
+	mgsdk := new(mocks.SDK)
+	mgsdk.On("CreateToken", login).Return(sdk.Token{}, loginErr)
+
+	_, err := sdk.NewSession(mgsdk, login)
+	assert.Equal(t, loginErr, err, "NewSession() error = %v, expected %v", err, loginErr)
+}
+
+func TestTypedErrors(t *testing.T) {
+	cases := []struct {
+		desc  string
+		err   error
+		check func(error) bool
+		match bool
+	}{
+		{"not found", errors.NewSDKErrorWithStatus(errors.New("no entity"), http.StatusNotFound), sdk.IsNotFound, true},
+		{"unauthorized", errors.NewSDKErrorWithStatus(errors.New("bad token"), http.StatusUnauthorized), sdk.IsUnauthorized, true},
+		{"forbidden", errors.NewSDKErrorWithStatus(errors.New("no access"), http.StatusForbidden), sdk.IsForbidden, true},
+		{"conflict", errors.NewSDKErrorWithStatus(errors.New("duplicate"), http.StatusConflict), sdk.IsConflict, true},
+		{"status mismatch", errors.NewSDKErrorWithStatus(errors.New("no entity"), http.StatusNotFound), sdk.IsConflict, false},
+		{"plain error", errors.New("plain"), sdk.IsNotFound, false},
+		{"nil error", nil, sdk.IsNotFound, false},
+	}
+
+	for _, tc := range cases {
+		got := tc.check(tc.err)
+		assert.Equal(t, tc.match, got, "%s: check returned %v, expected %v", tc.desc, got, tc.match)
+	}
+}
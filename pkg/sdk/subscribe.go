@@ -0,0 +1,108 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package sdk
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/absmach/senml"
+	"github.com/absmach/supermq/pkg/errors"
+	"github.com/gorilla/websocket"
+)
+
+const (
+	minReconnectDelay = time.Second
+	maxReconnectDelay = time.Minute
+)
+
+// MessagesHandler is invoked for every SenML record received on a
+// subscription.
+type MessagesHandler func(record senml.Record)
+
+// Subscription is a live WebSocket subscription to a channel. Closing it
+// stops message delivery and the reconnection loop.
+type Subscription struct {
+	cancel chan struct{}
+	once   sync.Once
+}
+
+// Close terminates the subscription.
+func (s *Subscription) Close() {
+	s.once.Do(func() {
+		close(s.cancel)
+	})
+}
+
+func (sdk mgSDK) Subscribe(channelID, subtopic, key string, handler MessagesHandler) (*Subscription, errors.SDKError) {
+	url := fmt.Sprintf("%s/channels/%s/messages", sdk.wsAdapterURL, channelID)
+	if subtopic != "" {
+		url = fmt.Sprintf("%s/%s", url, strings.Trim(subtopic, "/"))
+	}
+	url = fmt.Sprintf("%s?authorization=%s", url, key)
+
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	if err != nil {
+		return nil, errors.NewSDKError(err)
+	}
+
+	sub := &Subscription{cancel: make(chan struct{})}
+	go sdk.consume(url, conn, sub, handler)
+
+	return sub, nil
+}
+
+// consume reads messages until the subscription is closed, redialing with
+// exponential backoff whenever the connection drops.
+func (sdk mgSDK) consume(url string, conn *websocket.Conn, sub *Subscription, handler MessagesHandler) {
+	delay := minReconnectDelay
+	for {
+		select {
+		case <-sub.cancel:
+			if conn != nil {
+				conn.Close()
+			}
+			return
+		default:
+		}
+
+		if conn == nil {
+			select {
+			case <-sub.cancel:
+				return
+			case <-time.After(delay):
+			}
+			if delay *= 2; delay > maxReconnectDelay {
+				delay = maxReconnectDelay
+			}
+			c, _, err := websocket.DefaultDialer.Dial(url, nil)
+			if err != nil {
+				continue
+			}
+			conn = c
+			delay = minReconnectDelay
+		}
+
+		_, payload, err := conn.ReadMessage()
+		if err != nil {
+			conn.Close()
+			conn = nil
+			continue
+		}
+
+		pack, err := senml.Decode(payload, senml.JSON)
+		if err != nil {
+			continue
+		}
+		normalized, err := senml.Normalize(pack)
+		if err != nil {
+			continue
+		}
+		for _, record := range normalized.Records {
+			handler(record)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package secrets
+
+import (
+	"context"
+
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+)
+
+type awsProvider struct {
+	client *secretsmanager.Client
+}
+
+// NewAWSProvider returns a provider that reads secrets from AWS Secrets
+// Manager using the default credential chain.
+func NewAWSProvider(ctx context.Context) (Provider, error) {
+	cfg, err := awsconfig.LoadDefaultConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return awsProvider{client: secretsmanager.NewFromConfig(cfg)}, nil
+}
+
+func (p awsProvider) Name() string {
+	return "aws"
+}
+
+func (p awsProvider) Read(ctx context.Context, path string) (string, error) {
+	out, err := p.client.GetSecretValue(ctx, &secretsmanager.GetSecretValueInput{SecretId: &path})
+	if err != nil {
+		return "", err
+	}
+	if out.SecretString != nil {
+		return *out.SecretString, nil
+	}
+	return string(out.SecretBinary), nil
+}
@@ -0,0 +1,6 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package secrets provides resolution of secret:// references in
+// configuration values from external secret stores.
+package secrets
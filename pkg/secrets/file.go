@@ -0,0 +1,39 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package secrets
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// DefSecretsDir is the directory where Docker mounts container secrets.
+const DefSecretsDir = "/run/secrets"
+
+type fileProvider struct {
+	dir string
+}
+
+// NewFileProvider returns a provider that reads secrets from files in
+// the given directory, such as Docker secrets mounted under /run/secrets.
+func NewFileProvider(dir string) Provider {
+	if dir == "" {
+		dir = DefSecretsDir
+	}
+	return fileProvider{dir: dir}
+}
+
+func (p fileProvider) Name() string {
+	return "file"
+}
+
+func (p fileProvider) Read(_ context.Context, path string) (string, error) {
+	data, err := os.ReadFile(filepath.Join(p.dir, filepath.Clean(path)))
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(string(data), "\r\n"), nil
+}
@@ -0,0 +1,175 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/absmach/supermq/pkg/errors"
+)
+
+// Scheme prefixes configuration values that reference a secret, e.g.
+// secret://file/db_pass or secret://vault/database/creds#password.
+const Scheme = "secret://"
+
+var (
+	errUnknownProvider = errors.New("unknown secrets provider")
+	errMalformedRef    = errors.New("malformed secret reference")
+)
+
+// Provider reads secrets from a single secret store.
+type Provider interface {
+	// Name returns the provider name used as the secret URI host.
+	Name() string
+
+	// Read returns the secret stored under the given path.
+	Read(ctx context.Context, path string) (string, error)
+}
+
+// RotationHook is called when a cached secret changes during Refresh.
+type RotationHook func(ref string)
+
+type cachedSecret struct {
+	value   string
+	fetched time.Time
+}
+
+// Resolver resolves secret:// references against registered providers,
+// caching resolved values for the configured TTL.
+type Resolver struct {
+	providers map[string]Provider
+	cacheTTL  time.Duration
+	mu        sync.Mutex
+	cache     map[string]cachedSecret
+	hooks     []RotationHook
+}
+
+// NewResolver returns a resolver backed by the given providers.
+func NewResolver(cacheTTL time.Duration, providers ...Provider) *Resolver {
+	r := &Resolver{
+		providers: make(map[string]Provider, len(providers)),
+		cacheTTL:  cacheTTL,
+		cache:     make(map[string]cachedSecret),
+	}
+	for _, p := range providers {
+		r.providers[p.Name()] = p
+	}
+	return r
+}
+
+// OnRotation registers a hook invoked for every secret whose value
+// changed during Refresh.
+func (r *Resolver) OnRotation(hook RotationHook) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.hooks = append(r.hooks, hook)
+}
+
+// Resolve returns the value behind a secret:// reference, or the value
+// unchanged if it is not a reference.
+func (r *Resolver) Resolve(ctx context.Context, value string) (string, error) {
+	if !strings.HasPrefix(value, Scheme) {
+		return value, nil
+	}
+
+	r.mu.Lock()
+	if c, ok := r.cache[value]; ok && time.Since(c.fetched) < r.cacheTTL {
+		r.mu.Unlock()
+		return c.value, nil
+	}
+	r.mu.Unlock()
+
+	secret, err := r.read(ctx, value)
+	if err != nil {
+		return "", err
+	}
+
+	r.mu.Lock()
+	r.cache[value] = cachedSecret{value: secret, fetched: time.Now()}
+	r.mu.Unlock()
+
+	return secret, nil
+}
+
+// ResolveStruct resolves every secret:// reference found in string
+// fields of the struct pointed to by dst, including nested structs.
+func (r *Resolver) ResolveStruct(ctx context.Context, dst interface{}) error {
+	v := reflect.ValueOf(dst)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("expected pointer to struct, got %T", dst)
+	}
+	return r.resolveValue(ctx, v.Elem())
+}
+
+// Refresh re-reads all cached secrets and fires rotation hooks for the
+// ones whose values changed.
+func (r *Resolver) Refresh(ctx context.Context) error {
+	r.mu.Lock()
+	refs := make([]string, 0, len(r.cache))
+	for ref := range r.cache {
+		refs = append(refs, ref)
+	}
+	r.mu.Unlock()
+
+	for _, ref := range refs {
+		secret, err := r.read(ctx, ref)
+		if err != nil {
+			return err
+		}
+		r.mu.Lock()
+		rotated := r.cache[ref].value != secret
+		r.cache[ref] = cachedSecret{value: secret, fetched: time.Now()}
+		hooks := r.hooks
+		r.mu.Unlock()
+		if rotated {
+			for _, hook := range hooks {
+				hook(ref)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (r *Resolver) read(ctx context.Context, ref string) (string, error) {
+	provider, path, found := strings.Cut(strings.TrimPrefix(ref, Scheme), "/")
+	if !found || provider == "" || path == "" {
+		return "", errors.Wrap(errMalformedRef, errors.New(ref))
+	}
+	p, ok := r.providers[provider]
+	if !ok {
+		return "", errors.Wrap(errUnknownProvider, errors.New(provider))
+	}
+	return p.Read(ctx, path)
+}
+
+func (r *Resolver) resolveValue(ctx context.Context, v reflect.Value) error {
+	switch v.Kind() {
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if !v.Field(i).CanSet() {
+				continue
+			}
+			if err := r.resolveValue(ctx, v.Field(i)); err != nil {
+				return err
+			}
+		}
+	case reflect.String:
+		resolved, err := r.Resolve(ctx, v.String())
+		if err != nil {
+			return err
+		}
+		v.SetString(resolved)
+	case reflect.Ptr:
+		if !v.IsNil() {
+			return r.resolveValue(ctx, v.Elem())
+		}
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package secrets_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/absmach/supermq/pkg/secrets"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolve(t *testing.T) {
+	dir := t.TempDir()
+	err := os.WriteFile(filepath.Join(dir, "db_pass"), []byte("supersecret\n"), 0o600)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	resolver := secrets.NewResolver(time.Minute, secrets.NewFileProvider(dir))
+
+	cases := []struct {
+		desc  string
+		value string
+		res   string
+		err   bool
+	}{
+		{
+			desc:  "resolve plain value",
+			value: "plaintext",
+			res:   "plaintext",
+		},
+		{
+			desc:  "resolve file secret",
+			value: "secret://file/db_pass",
+			res:   "supersecret",
+		},
+		{
+			desc:  "resolve missing secret",
+			value: "secret://file/missing",
+			err:   true,
+		},
+		{
+			desc:  "resolve unknown provider",
+			value: "secret://vault/db_pass",
+			err:   true,
+		},
+		{
+			desc:  "resolve malformed reference",
+			value: "secret://file",
+			err:   true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			res, err := resolver.Resolve(context.Background(), tc.value)
+			if tc.err {
+				assert.NotNil(t, err, "expected error")
+				return
+			}
+			assert.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+			assert.Equal(t, tc.res, res)
+		})
+	}
+}
+
+func TestResolveStruct(t *testing.T) {
+	dir := t.TempDir()
+	err := os.WriteFile(filepath.Join(dir, "smtp_pass"), []byte("smtpsecret"), 0o600)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	resolver := secrets.NewResolver(time.Minute, secrets.NewFileProvider(dir))
+
+	cfg := struct {
+		Host string
+		Pass string
+	}{
+		Host: "localhost",
+		Pass: "secret://file/smtp_pass",
+	}
+
+	err = resolver.ResolveStruct(context.Background(), &cfg)
+	assert.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Equal(t, "localhost", cfg.Host)
+	assert.Equal(t, "smtpsecret", cfg.Pass)
+}
+
+func TestRefresh(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "token")
+	err := os.WriteFile(path, []byte("first"), 0o600)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	resolver := secrets.NewResolver(time.Minute, secrets.NewFileProvider(dir))
+
+	res, err := resolver.Resolve(context.Background(), "secret://file/token")
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Equal(t, "first", res)
+
+	rotated := []string{}
+	resolver.OnRotation(func(ref string) {
+		rotated = append(rotated, ref)
+	})
+
+	err = os.WriteFile(path, []byte("second"), 0o600)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	err = resolver.Refresh(context.Background())
+	assert.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Equal(t, []string{"secret://file/token"}, rotated)
+
+	res, err = resolver.Resolve(context.Background(), "secret://file/token")
+	assert.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Equal(t, "second", res)
+}
@@ -13,6 +13,7 @@ import (
 	"os"
 	"time"
 
+	"github.com/absmach/supermq/pkg/requestid"
 	"github.com/absmach/supermq/pkg/server"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
@@ -20,8 +21,20 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/health"
 	grpchealth "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
 )
 
+// requestIDInterceptor extracts the request correlation ID from the
+// incoming gRPC metadata and injects it into the handler context.
+func requestIDInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if ids := md.Get(requestid.MetadataKey); len(ids) > 0 && ids[0] != "" {
+			ctx = requestid.Put(ctx, ids[0])
+		}
+	}
+	return handler(ctx, req)
+}
+
 type serviceRegister func(srv *grpc.Server)
 
 type grpcServer struct {
@@ -46,6 +59,7 @@ func (s *grpcServer) Start() error {
 	errCh := make(chan error)
 	grpcServerOptions := []grpc.ServerOption{
 		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.UnaryInterceptor(requestIDInterceptor),
 	}
 
 	listener, err := net.Listen("tcp", s.Address)
@@ -56,13 +70,13 @@ func (s *grpcServer) Start() error {
 
 	switch {
 	case s.Config.CertFile != "" || s.Config.KeyFile != "":
-		certificate, err := tls.LoadX509KeyPair(s.Config.CertFile, s.Config.KeyFile)
+		reloader, err := server.NewCertReloader(s.Config.CertFile, s.Config.KeyFile)
 		if err != nil {
 			return fmt.Errorf("failed to load auth gRPC client certificates: %w", err)
 		}
 		tlsConfig := &tls.Config{
-			ClientAuth:   tls.NoClientCert,
-			Certificates: []tls.Certificate{certificate},
+			ClientAuth:     tls.NoClientCert,
+			GetCertificate: reloader.GetCertificate,
 		}
 
 		var mtlsCA string
@@ -104,6 +118,8 @@ func (s *grpcServer) Start() error {
 		default:
 			s.Logger.Info(fmt.Sprintf("%s service gRPC server listening at %s with TLS cert %s and key %s", s.Name, s.Address, s.Config.CertFile, s.Config.KeyFile))
 		}
+	case s.Config.RequireTLS:
+		return fmt.Errorf("%s service gRPC server at %s requires TLS, but no server certificate is configured", s.Name, s.Address)
 	default:
 		s.Logger.Info(fmt.Sprintf("%s service gRPC server listening at %s without TLS", s.Name, s.Address))
 	}
@@ -0,0 +1,28 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import "net/http"
+
+// maxBodyMiddleware rejects requests whose body exceeds the configured limit
+// with 413 Request Entity Too Large, so that oversized payloads never reach
+// the JSON decoders. Requests without a declared length are capped while they
+// are read.
+func maxBodyMiddleware(limit int64, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.ContentLength > limit {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusRequestEntityTooLarge)
+			if _, err := w.Write([]byte(`{"message":"request body too large"}`)); err != nil {
+				return
+			}
+			return
+		}
+		if r.Body != nil {
+			r.Body = http.MaxBytesReader(w, r.Body, limit)
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,130 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"compress/flate"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"strings"
+)
+
+const (
+	gzipEncoding    = "gzip"
+	deflateEncoding = "deflate"
+)
+
+// compressionMiddleware compresses response bodies with gzip or deflate when
+// the client advertises support in the Accept-Encoding header, so that large
+// listings cost less bandwidth for polling dashboards. Responses without a
+// body and responses that are already encoded are passed through unchanged.
+func compressionMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		encoding := acceptedEncoding(r.Header.Get("Accept-Encoding"))
+		if encoding == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		cw := &compressWriter{ResponseWriter: w, encoding: encoding}
+		defer cw.close()
+
+		next.ServeHTTP(cw, r)
+	})
+}
+
+// acceptedEncoding picks the response encoding from the Accept-Encoding
+// header, preferring gzip over deflate and skipping encodings the client
+// refused with a zero quality value.
+func acceptedEncoding(header string) string {
+	deflate := false
+	for _, part := range strings.Split(header, ",") {
+		fields := strings.Split(part, ";")
+		name := strings.ToLower(strings.TrimSpace(fields[0]))
+		refused := false
+		for _, p := range fields[1:] {
+			if strings.TrimSpace(p) == "q=0" {
+				refused = true
+			}
+		}
+		if refused {
+			continue
+		}
+		switch name {
+		case gzipEncoding:
+			return gzipEncoding
+		case deflateEncoding:
+			deflate = true
+		}
+	}
+	if deflate {
+		return deflateEncoding
+	}
+	return ""
+}
+
+type flushWriter interface {
+	io.WriteCloser
+	Flush() error
+}
+
+type compressWriter struct {
+	http.ResponseWriter
+	encoding string
+	cw       flushWriter
+	decided  bool
+}
+
+func (w *compressWriter) WriteHeader(status int) {
+	if !w.decided {
+		w.decided = true
+		h := w.Header()
+		h.Add("Vary", "Accept-Encoding")
+		if bodyAllowed(status) && h.Get("Content-Encoding") == "" {
+			h.Set("Content-Encoding", w.encoding)
+			h.Del("Content-Length")
+			switch w.encoding {
+			case gzipEncoding:
+				w.cw = gzip.NewWriter(w.ResponseWriter)
+			case deflateEncoding:
+				w.cw, _ = flate.NewWriter(w.ResponseWriter, flate.DefaultCompression)
+			}
+		}
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *compressWriter) Write(b []byte) (int, error) {
+	if !w.decided {
+		w.WriteHeader(http.StatusOK)
+	}
+	if w.cw != nil {
+		return w.cw.Write(b)
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+// Flush forwards flushes to the underlying writer through the compressor, so
+// that streamed responses such as NDJSON listings keep flowing item by item.
+func (w *compressWriter) Flush() {
+	if w.cw != nil {
+		if err := w.cw.Flush(); err != nil {
+			return
+		}
+	}
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+func (w *compressWriter) close() {
+	if w.cw != nil {
+		w.cw.Close()
+	}
+}
+
+func bodyAllowed(status int) bool {
+	return status != http.StatusNoContent && status != http.StatusNotModified && (status < 100 || status > 199)
+}
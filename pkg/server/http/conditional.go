@@ -0,0 +1,68 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"net/http"
+	"time"
+)
+
+// conditionalMiddleware turns successful GET responses into 304 Not Modified
+// when the client sent an If-Modified-Since header and the handler reports,
+// through the Last-Modified header, that nothing changed since then. Handlers
+// that do not set Last-Modified are unaffected.
+func conditionalMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			next.ServeHTTP(w, r)
+			return
+		}
+		since, err := http.ParseTime(r.Header.Get("If-Modified-Since"))
+		if err != nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		next.ServeHTTP(&conditionalWriter{ResponseWriter: w, since: since}, r)
+	})
+}
+
+type conditionalWriter struct {
+	http.ResponseWriter
+	since       time.Time
+	notModified bool
+	decided     bool
+}
+
+func (w *conditionalWriter) WriteHeader(status int) {
+	if !w.decided {
+		w.decided = true
+		if status == http.StatusOK {
+			if modified, err := http.ParseTime(w.Header().Get("Last-Modified")); err == nil && !modified.Truncate(time.Second).After(w.since) {
+				w.notModified = true
+				w.Header().Del("Content-Type")
+				w.Header().Del("Content-Length")
+				w.ResponseWriter.WriteHeader(http.StatusNotModified)
+				return
+			}
+		}
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *conditionalWriter) Write(b []byte) (int, error) {
+	if !w.decided {
+		w.WriteHeader(http.StatusOK)
+	}
+	if w.notModified {
+		return len(b), nil
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *conditionalWriter) Flush() {
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
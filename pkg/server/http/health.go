@@ -0,0 +1,181 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/absmach/supermq"
+	"github.com/jmoiron/sqlx"
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+)
+
+const (
+	contentType     = "Content-Type"
+	contentTypeJSON = "application/health+json"
+	statusPass      = "pass"
+	statusFail      = "fail"
+	checkTimeout    = 5 * time.Second
+	dialTimeout     = 5 * time.Second
+)
+
+// Check verifies the availability of a single service dependency.
+type Check struct {
+	// Name identifies the dependency in the readiness response.
+	Name string
+
+	// Check reports an error if the dependency is not reachable.
+	Check func(ctx context.Context) error
+}
+
+// DBCheck returns a readiness check that pings the given database.
+func DBCheck(db *sqlx.DB) Check {
+	return Check{
+		Name: "postgres",
+		Check: func(ctx context.Context) error {
+			return db.PingContext(ctx)
+		},
+	}
+}
+
+// CacheCheck returns a readiness check that pings the given Redis client.
+func CacheCheck(client redis.UniversalClient) Check {
+	return Check{
+		Name: "redis",
+		Check: func(ctx context.Context) error {
+			return client.Ping(ctx).Err()
+		},
+	}
+}
+
+// GRPCCheck returns a readiness check that verifies the given gRPC
+// connection is not shut down or failing.
+func GRPCCheck(name string, conn *grpc.ClientConn) Check {
+	return Check{
+		Name: name,
+		Check: func(ctx context.Context) error {
+			state := conn.GetState()
+			if state == connectivity.Shutdown || state == connectivity.TransientFailure {
+				return errConnState(name, state)
+			}
+			return nil
+		},
+	}
+}
+
+// BrokerCheck returns a readiness check that dials the message broker
+// listening at the given URL.
+func BrokerCheck(brokerURL string) Check {
+	return Check{
+		Name: "broker",
+		Check: func(ctx context.Context) error {
+			u, err := url.Parse(brokerURL)
+			if err != nil {
+				return err
+			}
+			var d net.Dialer
+			d.Timeout = dialTimeout
+			conn, err := d.DialContext(ctx, "tcp", u.Host)
+			if err != nil {
+				return err
+			}
+			return conn.Close()
+		},
+	}
+}
+
+type connStateError struct {
+	name  string
+	state connectivity.State
+}
+
+func (e connStateError) Error() string {
+	return e.name + " gRPC connection is " + e.state.String()
+}
+
+func errConnState(name string, state connectivity.State) error {
+	return connStateError{name: name, state: state}
+}
+
+type checkResult struct {
+	// Status contains the dependency status.
+	Status string `json:"status"`
+
+	// Latency contains the duration of the dependency check.
+	Latency string `json:"latency"`
+
+	// Error contains the failure reason when the check did not pass.
+	Error string `json:"error,omitempty"`
+}
+
+type readinessInfo struct {
+	// Status contains the aggregated service status.
+	Status string `json:"status"`
+
+	// Version contains current service version.
+	Version string `json:"version"`
+
+	// Description contains service description.
+	Description string `json:"description"`
+
+	// InstanceID contains the ID of the current service instance.
+	InstanceID string `json:"instance_id"`
+
+	// Checks contains per-dependency check results.
+	Checks map[string]checkResult `json:"checks,omitempty"`
+}
+
+// Ready exposes an HTTP handler for retrieving service readiness. The
+// response carries per-dependency status and check latency, and the
+// handler responds with 503 Service Unavailable if any check fails.
+func Ready(service, instanceID string, checks ...Check) http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add(contentType, contentTypeJSON)
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		res := readinessInfo{
+			Status:      statusPass,
+			Version:     supermq.Version,
+			Description: service + " service",
+			InstanceID:  instanceID,
+			Checks:      make(map[string]checkResult, len(checks)),
+		}
+		for _, check := range checks {
+			ctx, cancel := context.WithTimeout(r.Context(), checkTimeout)
+			start := time.Now()
+			err := check.Check(ctx)
+			cancel()
+			result := checkResult{
+				Status:  statusPass,
+				Latency: time.Since(start).String(),
+			}
+			if err != nil {
+				result.Status = statusFail
+				result.Error = err.Error()
+				res.Status = statusFail
+			}
+			res.Checks[check.Name] = result
+		}
+
+		if res.Status != statusPass {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		} else {
+			w.WriteHeader(http.StatusOK)
+		}
+
+		if err := json.NewEncoder(w).Encode(res); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
@@ -5,9 +5,12 @@ package http
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"log/slog"
 	"net/http"
+	"os"
 
 	"github.com/absmach/supermq/pkg/server"
 )
@@ -26,7 +29,14 @@ var _ server.Server = (*httpServer)(nil)
 
 func NewServer(ctx context.Context, cancel context.CancelFunc, name string, config server.Config, handler http.Handler, logger *slog.Logger) server.Server {
 	baseServer := server.NewBaseServer(ctx, cancel, name, config, logger)
-	hserver := &http.Server{Addr: baseServer.Address, Handler: handler}
+	h := conditionalMiddleware(securityMiddleware(config, handler))
+	if config.MaxBodySize > 0 {
+		h = maxBodyMiddleware(config.MaxBodySize, h)
+	}
+	if config.Compression {
+		h = compressionMiddleware(h)
+	}
+	hserver := &http.Server{Addr: baseServer.Address, Handler: requestIDMiddleware(redMetricsMiddleware(name, h))}
 
 	return &httpServer{
 		BaseServer: baseServer,
@@ -40,10 +50,36 @@ func (s *httpServer) Start() error {
 	switch {
 	case s.Config.CertFile != "" || s.Config.KeyFile != "":
 		s.Protocol = httpsProtocol
-		s.Logger.Info(fmt.Sprintf("%s service %s server listening at %s with TLS cert %s and key %s", s.Name, s.Protocol, s.Address, s.Config.CertFile, s.Config.KeyFile))
+
+		reloader, err := server.NewCertReloader(s.Config.CertFile, s.Config.KeyFile)
+		if err != nil {
+			return err
+		}
+		tlsConfig := &tls.Config{
+			GetCertificate: reloader.GetCertificate,
+		}
+
+		clientCA, err := loadCertFile(s.Config.ClientCAFile)
+		if err != nil {
+			return fmt.Errorf("failed to load client ca file: %w", err)
+		}
+		mtls := ""
+		if len(clientCA) > 0 {
+			tlsConfig.ClientCAs = x509.NewCertPool()
+			if !tlsConfig.ClientCAs.AppendCertsFromPEM(clientCA) {
+				return fmt.Errorf("failed to append client ca to tls.Config")
+			}
+			tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+			mtls = fmt.Sprintf(" and client ca %s", s.Config.ClientCAFile)
+		}
+		s.server.TLSConfig = tlsConfig
+
+		s.Logger.Info(fmt.Sprintf("%s service %s server listening at %s with TLS cert %s and key %s%s", s.Name, s.Protocol, s.Address, s.Config.CertFile, s.Config.KeyFile, mtls))
 		go func() {
-			errCh <- s.server.ListenAndServeTLS(s.Config.CertFile, s.Config.KeyFile)
+			errCh <- s.server.ListenAndServeTLS("", "")
 		}()
+	case s.Config.RequireTLS:
+		return fmt.Errorf("%s service %s server at %s requires TLS, but no server certificate is configured", s.Name, s.Protocol, s.Address)
 	default:
 		s.Logger.Info(fmt.Sprintf("%s service %s server listening at %s without TLS", s.Name, s.Protocol, s.Address))
 		go func() {
@@ -58,6 +94,13 @@ func (s *httpServer) Start() error {
 	}
 }
 
+func loadCertFile(certFile string) ([]byte, error) {
+	if certFile != "" {
+		return os.ReadFile(certFile)
+	}
+	return []byte{}, nil
+}
+
 func (s *httpServer) Stop() error {
 	defer s.Cancel()
 	ctx, cancel := context.WithTimeout(context.Background(), server.StopWaitTime)
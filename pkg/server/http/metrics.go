@@ -0,0 +1,58 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"net/http"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const meterName = "github.com/absmach/supermq/pkg/server/http"
+
+// redMetricsMiddleware records RED metrics (request rate, errors and
+// duration) for every endpoint served, labelled with the service name,
+// HTTP method, request path and status code class.
+func redMetricsMiddleware(svcName string, next http.Handler) http.Handler {
+	meter := otel.Meter(meterName)
+	requests, _ := meter.Int64Counter("http_requests_total", metric.WithDescription("Number of HTTP requests received."))
+	failures, _ := meter.Int64Counter("http_errors_total", metric.WithDescription("Number of HTTP requests that resulted in a server error."))
+	duration, _ := meter.Float64Histogram("http_request_duration_seconds", metric.WithDescription("Duration of HTTP requests in seconds."), metric.WithUnit("s"))
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		sw := &statusWriter{ResponseWriter: w, status: http.StatusOK}
+
+		next.ServeHTTP(sw, r)
+
+		attrs := metric.WithAttributes(
+			attribute.String("service", svcName),
+			attribute.String("method", r.Method),
+			attribute.String("path", r.URL.Path),
+			attribute.Int("status", sw.status),
+		)
+		requests.Add(r.Context(), 1, attrs)
+		if sw.status >= http.StatusInternalServerError {
+			failures.Add(r.Context(), 1, attrs)
+		}
+		duration.Record(r.Context(), time.Since(start).Seconds(), attrs)
+	})
+}
+
+type statusWriter struct {
+	http.ResponseWriter
+	status  int
+	written bool
+}
+
+func (w *statusWriter) WriteHeader(status int) {
+	if !w.written {
+		w.status = status
+		w.written = true
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
@@ -0,0 +1,35 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"net/http"
+
+	"github.com/absmach/supermq/pkg/requestid"
+	"github.com/absmach/supermq/pkg/uuid"
+)
+
+// requestIDMiddleware generates a correlation ID for every request that
+// does not carry one in the X-Request-ID header, injects it into the
+// request context and echoes it back in the response.
+func requestIDMiddleware(next http.Handler) http.Handler {
+	idp := uuid.New()
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get(requestid.Header)
+		if id == "" {
+			generated, err := idp.ID()
+			if err != nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+			id = generated
+		}
+
+		w.Header().Set(requestid.Header, id)
+		ctx := requestid.Put(r.Context(), id)
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
@@ -0,0 +1,78 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/absmach/supermq/pkg/server"
+)
+
+// securityMiddleware emits standard security headers on every response and,
+// when allowed origins are configured, handles CORS including preflight
+// requests, so that browser clients can talk to the service directly.
+func securityMiddleware(config server.Config, next http.Handler) http.Handler {
+	sec := config.Security
+	tls := config.CertFile != "" || config.KeyFile != ""
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		h := w.Header()
+		h.Set("X-Content-Type-Options", "nosniff")
+		h.Set("X-Frame-Options", "DENY")
+		if tls && sec.HSTSMaxAge > 0 {
+			h.Set("Strict-Transport-Security", "max-age="+strconv.Itoa(sec.HSTSMaxAge)+"; includeSubDomains")
+		}
+		if sec.CSP != "" {
+			h.Set("Content-Security-Policy", sec.CSP)
+		}
+
+		origin := r.Header.Get("Origin")
+		if len(sec.CORSAllowedOrigins) == 0 || origin == "" || !originAllowed(sec.CORSAllowedOrigins, origin) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		h.Add("Vary", "Origin")
+		if sec.CORSAllowCredentials {
+			h.Set("Access-Control-Allow-Origin", origin)
+			h.Set("Access-Control-Allow-Credentials", "true")
+		} else {
+			h.Set("Access-Control-Allow-Origin", allowedOrigin(sec.CORSAllowedOrigins, origin))
+		}
+
+		if r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != "" {
+			h.Set("Access-Control-Allow-Methods", strings.Join(sec.CORSAllowedMethods, ", "))
+			h.Set("Access-Control-Allow-Headers", strings.Join(sec.CORSAllowedHeaders, ", "))
+			if sec.CORSMaxAge > 0 {
+				h.Set("Access-Control-Max-Age", strconv.Itoa(sec.CORSMaxAge))
+			}
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func originAllowed(allowed []string, origin string) bool {
+	for _, o := range allowed {
+		if o == "*" || strings.EqualFold(o, origin) {
+			return true
+		}
+	}
+	return false
+}
+
+// allowedOrigin echoes the request origin unless the wildcard is configured,
+// in which case any origin may share the cached response.
+func allowedOrigin(allowed []string, origin string) string {
+	for _, o := range allowed {
+		if o == "*" {
+			return "*"
+		}
+	}
+	return origin
+}
@@ -30,6 +30,23 @@ type Config struct {
 	KeyFile      string `env:"SERVER_KEY"      envDefault:""`
 	ServerCAFile string `env:"SERVER_CA_CERTS" envDefault:""`
 	ClientCAFile string `env:"CLIENT_CA_CERTS" envDefault:""`
+	RequireTLS   bool   `env:"REQUIRE_TLS"     envDefault:"false"`
+	Compression  bool   `env:"COMPRESSION"     envDefault:"true"`
+	MaxBodySize  int64  `env:"MAX_BODY_SIZE"   envDefault:"1048576"`
+	Security     SecurityConfig
+}
+
+// SecurityConfig holds the CORS and security header configuration of an HTTP
+// server, so that browser clients can be served without an external proxy.
+// CORS headers are only emitted when allowed origins are configured.
+type SecurityConfig struct {
+	CORSAllowedOrigins   []string `env:"CORS_ALLOWED_ORIGINS"     envSeparator:"," envDefault:""`
+	CORSAllowedMethods   []string `env:"CORS_ALLOWED_METHODS"     envSeparator:"," envDefault:"GET,POST,PUT,PATCH,DELETE,OPTIONS"`
+	CORSAllowedHeaders   []string `env:"CORS_ALLOWED_HEADERS"     envSeparator:"," envDefault:"Authorization,Content-Type,If-Match,X-Request-ID"`
+	CORSAllowCredentials bool     `env:"CORS_ALLOW_CREDENTIALS"   envDefault:"false"`
+	CORSMaxAge           int      `env:"CORS_MAX_AGE"             envDefault:"300"`
+	HSTSMaxAge           int      `env:"HSTS_MAX_AGE"             envDefault:"31536000"`
+	CSP                  string   `env:"CONTENT_SECURITY_POLICY"  envDefault:""`
 }
 
 type BaseServer struct {
@@ -0,0 +1,86 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlite
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/absmach/supermq/pkg/labels"
+)
+
+// CreateMetadataQuery creates a query to filter by metadata. The filter is
+// bound as a single JSON text parameter and containment is checked with
+// json_patch: patching an entity's metadata with the filter is a no-op
+// exactly when the filter is contained in it.
+//
+// For example:
+//
+//	query, param, err := CreateMetadataQuery("", map[string]interface{}{
+//		"key": "value",
+//	})
+func CreateMetadataQuery(entity string, um map[string]interface{}) (string, string, error) {
+	if len(um) == 0 {
+		return "", "", nil
+	}
+
+	param, err := json.Marshal(um)
+	if err != nil {
+		return "", "", err
+	}
+	query := fmt.Sprintf("json_patch(%smetadata, :metadata) = json(%smetadata)", entity, entity)
+
+	return query, string(param), nil
+}
+
+// CreateLabelSelectorQuery creates a query to filter by a label selector.
+// The selector is bound as a single JSON text parameter and evaluated row
+// by row in SQL, so no part of it is interpolated into the query. An entity
+// matches when none of the selector requirements fail.
+//
+// For example:
+//
+//	query, param, err := CreateLabelSelectorQuery("", sel)
+func CreateLabelSelectorQuery(entity string, sel labels.Selector) (string, string, error) {
+	if len(sel) == 0 {
+		return "", "", nil
+	}
+
+	param, err := json.Marshal(sel)
+	if err != nil {
+		return "", "", err
+	}
+	lbl := fmt.Sprintf("(SELECT je.value FROM json_each(%slabels) AS je WHERE je.key = json_extract(req.value, '$.key'))", entity)
+	query := fmt.Sprintf(`NOT EXISTS (
+		SELECT 1 FROM json_each(:label_selector) AS req
+		WHERE NOT COALESCE(
+			CASE json_extract(req.value, '$.op')
+				WHEN '=' THEN %s = json_extract(req.value, '$.values[0]')
+				WHEN '!=' THEN %s IS NOT json_extract(req.value, '$.values[0]')
+				WHEN 'in' THEN %s IN (SELECT value FROM json_each(req.value, '$.values'))
+				WHEN 'notin' THEN COALESCE(%s NOT IN (SELECT value FROM json_each(req.value, '$.values')), TRUE)
+			END, FALSE))`, lbl, lbl, lbl, lbl)
+
+	return query, string(param), nil
+}
+
+// CreateIDsQuery creates a query to filter by a list of IDs. The IDs are
+// bound as a single JSON text parameter instead of being interpolated into
+// the query, so they are safe to take from user input.
+//
+// For example:
+//
+//	query, param, err := CreateIDsQuery("", []string{"id1", "id2"})
+func CreateIDsQuery(entity string, ids []string) (string, string, error) {
+	if len(ids) == 0 {
+		return "", "", nil
+	}
+
+	param, err := json.Marshal(ids)
+	if err != nil {
+		return "", "", err
+	}
+
+	return fmt.Sprintf("%sid IN (SELECT value FROM json_each(:ids))", entity), string(param), nil
+}
@@ -0,0 +1,103 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/absmach/supermq/pkg/postgres"
+	"github.com/jmoiron/sqlx"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var _ postgres.Database = (*database)(nil)
+
+type database struct {
+	db     *sqlx.DB
+	name   string
+	tracer trace.Tracer
+}
+
+// NewDatabase creates a database middleware satisfying the shared
+// postgres.Database interface, so that repositories written against it
+// run on SQLite unchanged.
+func NewDatabase(db *sqlx.DB, cfg Config, tracer trace.Tracer) postgres.Database {
+	return &database{
+		db:     db,
+		name:   cfg.File,
+		tracer: tracer,
+	}
+}
+
+func (d *database) NamedQueryContext(ctx context.Context, query string, args interface{}) (*sqlx.Rows, error) {
+	ctx, span := d.addSpanTags(ctx, query)
+	defer span.End()
+
+	return d.db.NamedQueryContext(ctx, query, args)
+}
+
+func (d *database) NamedExecContext(ctx context.Context, query string, args interface{}) (sql.Result, error) {
+	ctx, span := d.addSpanTags(ctx, query)
+	defer span.End()
+
+	return d.db.NamedExecContext(ctx, query, args)
+}
+
+func (d *database) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	ctx, span := d.addSpanTags(ctx, query)
+	defer span.End()
+
+	return d.db.ExecContext(ctx, query, args...)
+}
+
+func (d *database) QueryRowxContext(ctx context.Context, query string, args ...interface{}) *sqlx.Row {
+	ctx, span := d.addSpanTags(ctx, query)
+	defer span.End()
+
+	return d.db.QueryRowxContext(ctx, query, args...)
+}
+
+func (d *database) QueryxContext(ctx context.Context, query string, args ...interface{}) (*sqlx.Rows, error) {
+	ctx, span := d.addSpanTags(ctx, query)
+	defer span.End()
+
+	return d.db.QueryxContext(ctx, query, args...)
+}
+
+func (d *database) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	ctx, span := d.addSpanTags(ctx, query)
+	defer span.End()
+
+	return d.db.QueryContext(ctx, query, args...)
+}
+
+func (d *database) BeginTxx(ctx context.Context, opts *sql.TxOptions) (*sqlx.Tx, error) {
+	ctx, span := d.addSpanTags(ctx, "BeginTxx")
+	defer span.End()
+
+	return d.db.BeginTxx(ctx, opts)
+}
+
+func (d *database) addSpanTags(ctx context.Context, query string) (context.Context, trace.Span) {
+	operation := strings.Replace(strings.Split(query, " ")[0], "(", "", 1)
+
+	ctx, span := d.tracer.Start(ctx,
+		fmt.Sprintf("%s %s", operation, d.name),
+		trace.WithAttributes(
+			// Related to the database instance (informational)
+			attribute.String("db.system", "sqlite"),
+			attribute.String("db.name", d.name),
+			attribute.String("db.statement", query),
+
+			// General Span tags
+			attribute.String("span.kind", "client"),
+		),
+	)
+
+	return ctx, span
+}
@@ -0,0 +1,7 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package sqlite provides a SQLite client and query helpers for services
+// that keep their data in an embedded database file instead of PostgreSQL,
+// so single-node and edge deployments can run without an external database.
+package sqlite
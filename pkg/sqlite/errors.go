@@ -0,0 +1,29 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlite
+
+import (
+	"github.com/absmach/supermq/pkg/errors"
+	repoerr "github.com/absmach/supermq/pkg/errors/repository"
+	sqlite "modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+)
+
+// HandleError handles the error and returns a wrapped error.
+// It checks the error code and returns a specific error.
+func HandleError(wrapper, err error) error {
+	sqliteErr, ok := err.(*sqlite.Error)
+	if ok {
+		switch sqliteErr.Code() {
+		case sqlite3.SQLITE_CONSTRAINT_UNIQUE, sqlite3.SQLITE_CONSTRAINT_PRIMARYKEY:
+			return errors.Wrap(repoerr.ErrConflict, err)
+		case sqlite3.SQLITE_CONSTRAINT_CHECK, sqlite3.SQLITE_CONSTRAINT_NOTNULL, sqlite3.SQLITE_MISMATCH:
+			return errors.Wrap(repoerr.ErrMalformedEntity, err)
+		case sqlite3.SQLITE_CONSTRAINT_FOREIGNKEY:
+			return errors.Wrap(repoerr.ErrCreateEntity, err)
+		}
+	}
+
+	return errors.Wrap(wrapper, err)
+}
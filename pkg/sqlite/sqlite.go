@@ -0,0 +1,77 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlite
+
+import (
+	"fmt"
+
+	"github.com/absmach/supermq/pkg/errors"
+	"github.com/jmoiron/sqlx"
+	migrate "github.com/rubenv/sql-migrate"
+	_ "modernc.org/sqlite" // required for SQL access
+)
+
+var (
+	errConnect   = errors.New("failed to open sqlite database")
+	errMigration = errors.New("failed to apply migrations")
+)
+
+func init() {
+	// sqlx does not know the driver registered by modernc.org/sqlite, so
+	// named queries need its bindvar type registered explicitly.
+	sqlx.BindDriver("sqlite", sqlx.QUESTION)
+}
+
+// Config holds the configuration of the SQLite database file.
+type Config struct {
+	File string `env:"FILE" envDefault:"supermq.db"`
+
+	// AutoMigrate controls whether unapplied migrations run at
+	// startup.
+	AutoMigrate bool `env:"AUTO_MIGRATE" envDefault:"true"`
+}
+
+// Setup opens the SQLite database file and applies any unapplied
+// database migrations. A non-nil error is returned to indicate failure.
+//
+// For example:
+//
+//	db, err := sqlite.Setup(sqlite.Config{}, migrate.MemoryMigrationSource{})
+func Setup(cfg Config, migrations migrate.MemoryMigrationSource) (*sqlx.DB, error) {
+	db, err := Connect(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if !cfg.AutoMigrate {
+		return db, nil
+	}
+
+	if _, err = migrate.Exec(db.DB, "sqlite3", migrations, migrate.Up); err != nil {
+		return nil, errors.Wrap(errMigration, err)
+	}
+
+	return db, nil
+}
+
+// Connect opens the SQLite database file, creating it if it does not exist.
+//
+// For example:
+//
+//	db, err := sqlite.Connect(sqlite.Config{})
+func Connect(cfg Config) (*sqlx.DB, error) {
+	dsn := fmt.Sprintf("file:%s?_time_format=sqlite&_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)&_pragma=foreign_keys(1)", cfg.File)
+
+	db, err := sqlx.Open("sqlite", dsn)
+	if err != nil {
+		return nil, errors.Wrap(errConnect, err)
+	}
+
+	// SQLite allows a single writer at a time, so the pool is kept at
+	// one connection to serialize access instead of failing with
+	// SQLITE_BUSY under concurrent writes.
+	db.SetMaxOpenConns(1)
+
+	return db, nil
+}
@@ -0,0 +1,97 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package testutil
+
+import (
+	"context"
+	"sync"
+
+	grpcAuthV1 "github.com/absmach/supermq/api/grpc/auth/v1"
+	smqauthn "github.com/absmach/supermq/pkg/authn"
+	svcerr "github.com/absmach/supermq/pkg/errors/service"
+	"google.golang.org/grpc"
+)
+
+var _ grpcAuthV1.AuthServiceClient = (*AuthService)(nil)
+
+// AuthService is an in-memory grpcAuthV1.AuthServiceClient. Tokens added
+// with AddToken authenticate successfully and every authorization request
+// is allowed unless DenyAll is set, so tests can exercise both paths
+// without an auth server.
+type AuthService struct {
+	mu      sync.Mutex
+	tokens  map[string]*grpcAuthV1.AuthNRes
+	DenyAll bool
+}
+
+// NewAuthService returns an auth service client fake without any known tokens.
+func NewAuthService() *AuthService {
+	return &AuthService{
+		tokens: make(map[string]*grpcAuthV1.AuthNRes),
+	}
+}
+
+// AddToken registers a token that authenticates as the given user.
+func (a *AuthService) AddToken(token, userID, domainID string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.tokens[token] = &grpcAuthV1.AuthNRes{
+		Id:       userID,
+		UserId:   userID,
+		DomainId: domainID,
+	}
+}
+
+func (a *AuthService) Authorize(ctx context.Context, in *grpcAuthV1.AuthZReq, opts ...grpc.CallOption) (*grpcAuthV1.AuthZRes, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.DenyAll {
+		return &grpcAuthV1.AuthZRes{Authorized: false}, svcerr.ErrAuthorization
+	}
+
+	return &grpcAuthV1.AuthZRes{Authorized: true}, nil
+}
+
+func (a *AuthService) AuthorizePAT(ctx context.Context, in *grpcAuthV1.AuthZPatReq, opts ...grpc.CallOption) (*grpcAuthV1.AuthZRes, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.DenyAll {
+		return &grpcAuthV1.AuthZRes{Authorized: false}, svcerr.ErrAuthorization
+	}
+
+	return &grpcAuthV1.AuthZRes{Authorized: true}, nil
+}
+
+func (a *AuthService) Authenticate(ctx context.Context, in *grpcAuthV1.AuthNReq, opts ...grpc.CallOption) (*grpcAuthV1.AuthNRes, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if res, ok := a.tokens[in.GetToken()]; ok {
+		return res, nil
+	}
+
+	return &grpcAuthV1.AuthNRes{}, svcerr.ErrAuthentication
+}
+
+func (a *AuthService) AuthenticatePAT(ctx context.Context, in *grpcAuthV1.AuthNReq, opts ...grpc.CallOption) (*grpcAuthV1.AuthNRes, error) {
+	return a.Authenticate(ctx, in, opts...)
+}
+
+// Authn returns an authn.Authentication backed by this fake, suitable for
+// passing to HTTP handler constructors.
+func (a *AuthService) Authn() smqauthn.Authentication {
+	return authnFake{svc: a}
+}
+
+type authnFake struct {
+	svc *AuthService
+}
+
+func (a authnFake) Authenticate(ctx context.Context, token string) (smqauthn.Session, error) {
+	res, err := a.svc.Authenticate(ctx, &grpcAuthV1.AuthNReq{Token: token})
+	if err != nil {
+		return smqauthn.Session{}, err
+	}
+
+	return smqauthn.Session{Type: smqauthn.AccessToken, DomainUserID: res.GetId(), UserID: res.GetUserId(), DomainID: res.GetDomainId()}, nil
+}
@@ -0,0 +1,469 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package testutil
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/absmach/supermq/clients"
+	repoerr "github.com/absmach/supermq/pkg/errors/repository"
+)
+
+var _ clients.Repository = (*ClientsRepository)(nil)
+
+// ClientsRepository is an in-memory clients.Repository backed by maps. It
+// supports the filters the service layer relies on (domain, status, name,
+// tag and ID lists) and shares the roles store with other entity fakes.
+type ClientsRepository struct {
+	*RolesRepository
+
+	mu          sync.Mutex
+	clients     map[string]clients.Client
+	connections map[string][]clients.Connection
+	relations   []clients.ClientRelation
+}
+
+// NewClientsRepository returns an empty in-memory clients repository.
+func NewClientsRepository() *ClientsRepository {
+	return &ClientsRepository{
+		RolesRepository: NewRolesRepository(),
+		clients:         make(map[string]clients.Client),
+		connections:     make(map[string][]clients.Connection),
+	}
+}
+
+func (r *ClientsRepository) Save(ctx context.Context, cs ...clients.Client) ([]clients.Client, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, c := range cs {
+		if _, ok := r.clients[c.ID]; ok {
+			return nil, repoerr.ErrConflict
+		}
+		r.clients[c.ID] = c
+	}
+
+	return cs, nil
+}
+
+func (r *ClientsRepository) RetrieveByID(ctx context.Context, id string) (clients.Client, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	c, ok := r.clients[id]
+	if !ok {
+		return clients.Client{}, repoerr.ErrNotFound
+	}
+
+	return c, nil
+}
+
+func (r *ClientsRepository) RetrieveByExternalID(ctx context.Context, domainID, externalID string) (clients.Client, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, c := range r.clients {
+		if c.Domain == domainID && c.ExternalID == externalID {
+			return c, nil
+		}
+	}
+
+	return clients.Client{}, repoerr.ErrNotFound
+}
+
+func (r *ClientsRepository) Claim(ctx context.Context, domainID, claimCode string) (clients.Client, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for id, c := range r.clients {
+		if c.Domain == domainID && c.ClaimCode != "" && c.ClaimCode == claimCode {
+			c.ClaimCode = ""
+			r.clients[id] = c
+			return c, nil
+		}
+	}
+
+	return clients.Client{}, repoerr.ErrNotFound
+}
+
+func (r *ClientsRepository) RetrieveBySecret(ctx context.Context, key string) (clients.Client, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, c := range r.clients {
+		if c.Credentials.Secret == key {
+			return c, nil
+		}
+	}
+
+	return clients.Client{}, repoerr.ErrNotFound
+}
+
+func (r *ClientsRepository) RetrieveAll(ctx context.Context, pm clients.Page) (clients.ClientsPage, error) {
+	return r.retrieve(pm)
+}
+
+func (r *ClientsRepository) SearchClients(ctx context.Context, pm clients.Page) (clients.ClientsPage, error) {
+	return r.retrieve(pm)
+}
+
+func (r *ClientsRepository) RetrieveAllByIDs(ctx context.Context, pm clients.Page) (clients.ClientsPage, error) {
+	return r.retrieve(pm)
+}
+
+func (r *ClientsRepository) RetrieveByIds(ctx context.Context, ids []string) (clients.ClientsPage, error) {
+	return r.retrieve(clients.Page{IDs: ids, Status: clients.AllStatus})
+}
+
+func (r *ClientsRepository) Update(ctx context.Context, client clients.Client) (clients.Client, error) {
+	return r.update(client, func(c *clients.Client) {
+		if client.Name != "" {
+			c.Name = client.Name
+		}
+		if client.Metadata != nil {
+			c.Metadata = client.Metadata
+		}
+	})
+}
+
+func (r *ClientsRepository) UpdateTags(ctx context.Context, client clients.Client) (clients.Client, error) {
+	return r.update(client, func(c *clients.Client) {
+		c.Tags = client.Tags
+	})
+}
+
+func (r *ClientsRepository) UpdateIdentity(ctx context.Context, client clients.Client) (clients.Client, error) {
+	return r.update(client, func(c *clients.Client) {
+		c.Credentials.Identity = client.Credentials.Identity
+	})
+}
+
+func (r *ClientsRepository) UpdateSecret(ctx context.Context, client clients.Client) (clients.Client, error) {
+	return r.update(client, func(c *clients.Client) {
+		c.Credentials.Secret = client.Credentials.Secret
+	})
+}
+
+func (r *ClientsRepository) ChangeStatus(ctx context.Context, client clients.Client) (clients.Client, error) {
+	return r.update(client, func(c *clients.Client) {
+		c.Status = client.Status
+	})
+}
+
+func (r *ClientsRepository) Delete(ctx context.Context, clientIDs ...string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, id := range clientIDs {
+		if _, ok := r.clients[id]; !ok {
+			return repoerr.ErrNotFound
+		}
+		delete(r.clients, id)
+		delete(r.connections, id)
+	}
+
+	return nil
+}
+
+func (r *ClientsRepository) RetrieveAllTags(ctx context.Context, domainID string, offset, limit uint64) (clients.TagsPage, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	counts := map[string]uint64{}
+	for _, c := range r.clients {
+		if c.Domain != domainID || c.Status == clients.DeletedStatus {
+			continue
+		}
+		for _, tag := range c.Tags {
+			counts[tag]++
+		}
+	}
+	tags := make([]clients.Tag, 0, len(counts))
+	for name, count := range counts {
+		tags = append(tags, clients.Tag{Name: name, Count: count})
+	}
+	sort.Slice(tags, func(i, j int) bool { return tags[i].Name < tags[j].Name })
+	total := uint64(len(tags))
+	if offset >= total {
+		tags = []clients.Tag{}
+	} else {
+		tags = tags[offset:]
+		if limit != 0 && limit < uint64(len(tags)) {
+			tags = tags[:limit]
+		}
+	}
+
+	return clients.TagsPage{
+		Total:  total,
+		Offset: offset,
+		Limit:  limit,
+		Tags:   tags,
+	}, nil
+}
+
+func (r *ClientsRepository) RenameTag(ctx context.Context, domainID, oldTag, newTag string) (uint64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var affected uint64
+	for id, c := range r.clients {
+		if c.Domain != domainID || !containsString(c.Tags, oldTag) {
+			continue
+		}
+		tags := removeAll(c.Tags, []string{oldTag})
+		if !containsString(tags, newTag) {
+			tags = append(tags, newTag)
+		}
+		c.Tags = tags
+		c.UpdatedAt = time.Now()
+		r.clients[id] = c
+		affected++
+	}
+
+	return affected, nil
+}
+
+func (r *ClientsRepository) AddConnections(ctx context.Context, conns []clients.Connection) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, conn := range conns {
+		r.connections[conn.ClientID] = append(r.connections[conn.ClientID], conn)
+	}
+
+	return nil
+}
+
+func (r *ClientsRepository) RemoveConnections(ctx context.Context, conns []clients.Connection) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, conn := range conns {
+		kept := r.connections[conn.ClientID][:0]
+		for _, existing := range r.connections[conn.ClientID] {
+			if existing.ChannelID != conn.ChannelID || existing.DomainID != conn.DomainID {
+				kept = append(kept, existing)
+			}
+		}
+		r.connections[conn.ClientID] = kept
+	}
+
+	return nil
+}
+
+func (r *ClientsRepository) ClientConnectionsCount(ctx context.Context, id string) (uint64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return uint64(len(r.connections[id])), nil
+}
+
+func (r *ClientsRepository) DoesClientHaveConnections(ctx context.Context, id string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return len(r.connections[id]) > 0, nil
+}
+
+func (r *ClientsRepository) RemoveChannelConnections(ctx context.Context, channelID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for id, conns := range r.connections {
+		kept := conns[:0]
+		for _, conn := range conns {
+			if conn.ChannelID != channelID {
+				kept = append(kept, conn)
+			}
+		}
+		r.connections[id] = kept
+	}
+
+	return nil
+}
+
+func (r *ClientsRepository) RemoveClientConnections(ctx context.Context, clientID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.connections, clientID)
+
+	return nil
+}
+
+func (r *ClientsRepository) SetParentGroup(ctx context.Context, cli clients.Client) error {
+	_, err := r.update(cli, func(c *clients.Client) {
+		c.ParentGroup = cli.ParentGroup
+	})
+
+	return err
+}
+
+func (r *ClientsRepository) RemoveParentGroup(ctx context.Context, cli clients.Client) error {
+	_, err := r.update(cli, func(c *clients.Client) {
+		c.ParentGroup = ""
+	})
+
+	return err
+}
+
+func (r *ClientsRepository) RetrieveParentGroupClients(ctx context.Context, parentGroupID string) ([]clients.Client, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var cs []clients.Client
+	for _, c := range r.clients {
+		if c.ParentGroup == parentGroupID {
+			cs = append(cs, c)
+		}
+	}
+	sort.Slice(cs, func(i, j int) bool { return cs[i].ID < cs[j].ID })
+
+	return cs, nil
+}
+
+func (r *ClientsRepository) UnsetParentGroupFromClient(ctx context.Context, parentGroupID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for id, c := range r.clients {
+		if c.ParentGroup == parentGroupID {
+			c.ParentGroup = ""
+			r.clients[id] = c
+		}
+	}
+
+	return nil
+}
+
+func (r *ClientsRepository) AddRelation(ctx context.Context, rel clients.ClientRelation) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, existing := range r.relations {
+		if existing.DomainID == rel.DomainID && existing.SubjectID == rel.SubjectID && existing.Relation == rel.Relation && existing.ObjectID == rel.ObjectID {
+			return repoerr.ErrConflict
+		}
+	}
+	r.relations = append(r.relations, rel)
+
+	return nil
+}
+
+func (r *ClientsRepository) RemoveRelation(ctx context.Context, rel clients.ClientRelation) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, existing := range r.relations {
+		if existing.DomainID == rel.DomainID && existing.SubjectID == rel.SubjectID && existing.Relation == rel.Relation && existing.ObjectID == rel.ObjectID {
+			r.relations = append(r.relations[:i], r.relations[i+1:]...)
+			return nil
+		}
+	}
+
+	return repoerr.ErrNotFound
+}
+
+func (r *ClientsRepository) RetrieveRelated(ctx context.Context, domainID, clientID, relation string, depth uint64) ([]clients.RelatedClient, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	visited := map[string]bool{clientID: true}
+	frontier := []string{clientID}
+	var related []clients.RelatedClient
+	for d := uint64(1); d <= depth && len(frontier) > 0; d++ {
+		var next []string
+		for _, subject := range frontier {
+			for _, rel := range r.relations {
+				if rel.DomainID != domainID || rel.SubjectID != subject {
+					continue
+				}
+				if relation != "" && rel.Relation != relation {
+					continue
+				}
+				if visited[rel.ObjectID] {
+					continue
+				}
+				visited[rel.ObjectID] = true
+				if c, ok := r.clients[rel.ObjectID]; ok {
+					related = append(related, clients.RelatedClient{Client: c, Relation: rel.Relation, Depth: d})
+				}
+				next = append(next, rel.ObjectID)
+			}
+		}
+		frontier = next
+	}
+	sort.Slice(related, func(i, j int) bool {
+		if related[i].Depth != related[j].Depth {
+			return related[i].Depth < related[j].Depth
+		}
+		return related[i].ID < related[j].ID
+	})
+
+	return related, nil
+}
+
+func (r *ClientsRepository) update(client clients.Client, apply func(*clients.Client)) (clients.Client, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	c, ok := r.clients[client.ID]
+	if !ok {
+		return clients.Client{}, repoerr.ErrNotFound
+	}
+	apply(&c)
+	c.UpdatedAt = time.Now()
+	c.UpdatedBy = client.UpdatedBy
+	r.clients[client.ID] = c
+
+	return c, nil
+}
+
+func (r *ClientsRepository) retrieve(pm clients.Page) (clients.ClientsPage, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var matched []clients.Client
+	for _, c := range r.clients {
+		if matchClient(c, pm) {
+			matched = append(matched, c)
+		}
+	}
+	sort.Slice(matched, func(i, j int) bool { return matched[i].ID < matched[j].ID })
+	total := uint64(len(matched))
+	if pm.Offset >= total {
+		matched = []clients.Client{}
+	} else {
+		matched = matched[pm.Offset:]
+		if pm.Limit != 0 && pm.Limit < uint64(len(matched)) {
+			matched = matched[:pm.Limit]
+		}
+	}
+
+	return clients.ClientsPage{
+		Page: clients.Page{
+			Total:  total,
+			Offset: pm.Offset,
+			Limit:  pm.Limit,
+		},
+		Clients: matched,
+	}, nil
+}
+
+func matchClient(c clients.Client, pm clients.Page) bool {
+	if pm.Domain != "" && c.Domain != pm.Domain {
+		return false
+	}
+	if pm.Status != clients.AllStatus && c.Status != pm.Status {
+		return false
+	}
+	if pm.Name != "" && c.Name != pm.Name {
+		return false
+	}
+	if pm.Tag != "" && !containsString(c.Tags, pm.Tag) {
+		return false
+	}
+	if pm.Identity != "" && c.Credentials.Identity != pm.Identity {
+		return false
+	}
+	if len(pm.IDs) > 0 && !containsString(pm.IDs, c.ID) {
+		return false
+	}
+
+	return true
+}
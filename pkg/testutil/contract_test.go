@@ -0,0 +1,194 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package testutil_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	apiutil "github.com/absmach/supermq/api/http/util"
+	chmocks "github.com/absmach/supermq/channels/mocks"
+	"github.com/absmach/supermq/clients"
+	clientsapi "github.com/absmach/supermq/clients/api/http"
+	climocks "github.com/absmach/supermq/clients/mocks"
+	"github.com/absmach/supermq/groups"
+	groupsapi "github.com/absmach/supermq/groups/api/http"
+	gpmocks "github.com/absmach/supermq/groups/mocks"
+	smqlog "github.com/absmach/supermq/logger"
+	policysvc "github.com/absmach/supermq/pkg/policies"
+	policymocks "github.com/absmach/supermq/pkg/policies/mocks"
+	"github.com/absmach/supermq/pkg/roles"
+	"github.com/absmach/supermq/pkg/testutil"
+	"github.com/absmach/supermq/pkg/uuid"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	validToken = "token"
+	userID     = "7b4a1c2e-6c5d-4f3a-9b8e-1d2c3b4a5f60"
+	domainID   = "a6b9c8d7-1e2f-4a3b-8c9d-0e1f2a3b4c5d"
+	clientID   = "5c1e0d9a-2b3c-4d5e-8f90-a1b2c3d4e5f6"
+	client2ID  = "9f8e7d6c-5b4a-4c3d-8e2f-1a0b9c8d7e6f"
+	groupID    = "0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d"
+	childID    = "3d2c1b0a-9f8e-4d7c-8b6a-5f4e3d2c1b0a"
+)
+
+var createdAt = time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+func newClientsServer(t *testing.T) *httptest.Server {
+	repo := testutil.NewClientsRepository()
+	_, err := repo.Save(context.Background(),
+		clients.Client{
+			ID:          clientID,
+			Name:        "thermostat",
+			Tags:        []string{"temperature"},
+			Domain:      domainID,
+			Credentials: clients.Credentials{Identity: "thermostat@example.com"},
+			Metadata:    clients.Metadata{"location": "hall"},
+			CreatedAt:   createdAt,
+			Status:      clients.EnabledStatus,
+		},
+		clients.Client{
+			ID:        client2ID,
+			Name:      "valve",
+			Domain:    domainID,
+			CreatedAt: createdAt,
+			Status:    clients.EnabledStatus,
+		},
+	)
+	require.Nil(t, err)
+
+	policy := new(policymocks.Service)
+	policy.On("ListAllObjects", mock.Anything, mock.Anything).Return(policysvc.PolicyPage{Policies: []string{clientID, client2ID}}, nil)
+
+	svc, err := clients.NewService(repo, policy, new(climocks.Cache), new(chmocks.ChannelsServiceClient), new(gpmocks.GroupsServiceClient), uuid.NewMock(), uuid.NewMock())
+	require.Nil(t, err)
+
+	auth := testutil.NewAuthService()
+	auth.AddToken(validToken, userID, domainID)
+
+	mux := chi.NewRouter()
+	clientsapi.MakeHandler(svc, auth.Authn(), mux, smqlog.NewMock(), "")
+
+	return httptest.NewServer(mux)
+}
+
+func newGroupsServer(t *testing.T) *httptest.Server {
+	repo := testutil.NewGroupsRepository()
+	_, err := repo.Save(context.Background(), groups.Group{
+		ID:        groupID,
+		Domain:    domainID,
+		Name:      "building",
+		Metadata:  groups.Metadata{"floors": float64(3)},
+		CreatedAt: createdAt,
+		Status:    groups.EnabledStatus,
+	})
+	require.Nil(t, err)
+	_, err = repo.Save(context.Background(), groups.Group{
+		ID:        childID,
+		Domain:    domainID,
+		Parent:    groupID,
+		Name:      "first-floor",
+		CreatedAt: createdAt,
+		Status:    groups.EnabledStatus,
+	})
+	require.Nil(t, err)
+
+	svc, err := groups.NewService(repo, new(policymocks.Service), uuid.NewMock(), new(chmocks.ChannelsServiceClient), new(climocks.ClientsServiceClient), uuid.NewMock(), []roles.Action{}, map[roles.BuiltInRoleName][]roles.Action{groups.BuiltInRoleAdmin: {}})
+	require.Nil(t, err)
+
+	auth := testutil.NewAuthService()
+	auth.AddToken(validToken, userID, domainID)
+
+	mux := groupsapi.MakeHandler(svc, auth.Authn(), chi.NewRouter(), smqlog.NewMock(), "")
+
+	return httptest.NewServer(mux)
+}
+
+func assertGoldenResponse(t *testing.T, ts *httptest.Server, path, golden string) {
+	req, err := http.NewRequest(http.MethodGet, ts.URL+path, nil)
+	require.Nil(t, err)
+	req.Header.Set("Authorization", apiutil.BearerPrefix+validToken)
+
+	res, err := ts.Client().Do(req)
+	require.Nil(t, err)
+	defer res.Body.Close()
+
+	assert.Equal(t, http.StatusOK, res.StatusCode, fmt.Sprintf("expected status %d got %d", http.StatusOK, res.StatusCode))
+
+	body, err := io.ReadAll(res.Body)
+	require.Nil(t, err)
+
+	var pretty bytes.Buffer
+	require.Nil(t, json.Indent(&pretty, body, "", "  "))
+	pretty.WriteByte('\n')
+
+	testutil.AssertGolden(t, golden, pretty.Bytes())
+}
+
+func TestClientsContract(t *testing.T) {
+	ts := newClientsServer(t)
+	defer ts.Close()
+
+	cases := []struct {
+		desc   string
+		path   string
+		golden string
+	}{
+		{
+			desc:   "view client",
+			path:   "/" + domainID + "/clients/" + clientID,
+			golden: "client_view.json",
+		},
+		{
+			desc:   "list clients",
+			path:   "/" + domainID + "/clients?limit=10",
+			golden: "clients_list.json",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			assertGoldenResponse(t, ts, tc.path, tc.golden)
+		})
+	}
+}
+
+func TestGroupsContract(t *testing.T) {
+	ts := newGroupsServer(t)
+	defer ts.Close()
+
+	cases := []struct {
+		desc   string
+		path   string
+		golden string
+	}{
+		{
+			desc:   "view group",
+			path:   "/" + domainID + "/groups/" + groupID,
+			golden: "group_view.json",
+		},
+		{
+			desc:   "list groups",
+			path:   "/" + domainID + "/groups?limit=10",
+			golden: "groups_list.json",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			assertGoldenResponse(t, ts, tc.path, tc.golden)
+		})
+	}
+}
@@ -0,0 +1,8 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package testutil provides in-memory fakes of the persistence and
+// infrastructure dependencies of SuperMQ services, so that internal services
+// and external integrators can run tests against stable, deterministic
+// implementations without a database, broker or auth server.
+package testutil
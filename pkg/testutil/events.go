@@ -0,0 +1,66 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package testutil
+
+import (
+	"context"
+	"sync"
+
+	"github.com/absmach/supermq/pkg/events"
+)
+
+var _ events.Publisher = (*EventsPublisher)(nil)
+
+// EventsPublisher is an in-memory events.Publisher that records every
+// published event, so that tests can assert on the emitted stream.
+type EventsPublisher struct {
+	mu     sync.Mutex
+	events []map[string]interface{}
+	closed bool
+}
+
+// NewEventsPublisher returns an empty in-memory event publisher.
+func NewEventsPublisher() *EventsPublisher {
+	return &EventsPublisher{}
+}
+
+func (p *EventsPublisher) Publish(ctx context.Context, event events.Event) error {
+	data, err := event.Encode()
+	if err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.events = append(p.events, data)
+
+	return nil
+}
+
+func (p *EventsPublisher) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.closed = true
+
+	return nil
+}
+
+// Events returns a copy of the encoded events published so far.
+func (p *EventsPublisher) Events() []map[string]interface{} {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	evs := make([]map[string]interface{}, len(p.events))
+	copy(evs, p.events)
+
+	return evs
+}
+
+// Closed reports whether the publisher has been closed.
+func (p *EventsPublisher) Closed() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.closed
+}
@@ -0,0 +1,351 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package testutil
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	repoerr "github.com/absmach/supermq/pkg/errors/repository"
+
+	"github.com/absmach/supermq/groups"
+)
+
+var _ groups.Repository = (*GroupsRepository)(nil)
+
+// GroupsRepository is an in-memory groups.Repository backed by maps. The
+// hierarchy is kept as plain parent references, which is enough for the
+// parent assignment and children listing paths exercised in tests.
+type GroupsRepository struct {
+	*RolesRepository
+
+	mu     sync.Mutex
+	groups map[string]groups.Group
+}
+
+// NewGroupsRepository returns an empty in-memory groups repository.
+func NewGroupsRepository() *GroupsRepository {
+	return &GroupsRepository{
+		RolesRepository: NewRolesRepository(),
+		groups:          make(map[string]groups.Group),
+	}
+}
+
+func (r *GroupsRepository) Save(ctx context.Context, g groups.Group) (groups.Group, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.groups[g.ID]; ok {
+		return groups.Group{}, repoerr.ErrConflict
+	}
+	r.groups[g.ID] = g
+
+	return g, nil
+}
+
+func (r *GroupsRepository) Update(ctx context.Context, g groups.Group) (groups.Group, error) {
+	return r.update(g, func(existing *groups.Group) {
+		if g.Name != "" {
+			existing.Name = g.Name
+		}
+		if g.Description != "" {
+			existing.Description = g.Description
+		}
+		if g.Metadata != nil {
+			existing.Metadata = g.Metadata
+		}
+	})
+}
+
+func (r *GroupsRepository) RetrieveByID(ctx context.Context, id string) (groups.Group, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	g, ok := r.groups[id]
+	if !ok {
+		return groups.Group{}, repoerr.ErrNotFound
+	}
+
+	return g, nil
+}
+
+func (r *GroupsRepository) RetrieveByIDAndUser(ctx context.Context, domainID, userID, groupID string) (groups.Group, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	g, ok := r.groups[groupID]
+	if !ok || g.Domain != domainID {
+		return groups.Group{}, repoerr.ErrNotFound
+	}
+
+	return g, nil
+}
+
+func (r *GroupsRepository) RetrieveAll(ctx context.Context, pm groups.PageMeta) (groups.Page, error) {
+	return r.retrieve(pm, nil)
+}
+
+func (r *GroupsRepository) RetrieveByIDs(ctx context.Context, pm groups.PageMeta, ids ...string) (groups.Page, error) {
+	return r.retrieve(pm, ids)
+}
+
+func (r *GroupsRepository) RetrieveHierarchy(ctx context.Context, id string, hm groups.HierarchyPageMeta) (groups.HierarchyPage, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	g, ok := r.groups[id]
+	if !ok {
+		return groups.HierarchyPage{}, repoerr.ErrNotFound
+	}
+
+	gs := []groups.Group{g}
+	switch {
+	case hm.Direction >= 0:
+		for g.Parent != "" {
+			parent, ok := r.groups[g.Parent]
+			if !ok {
+				break
+			}
+			gs = append(gs, parent)
+			g = parent
+		}
+	default:
+		gs = append(gs, r.descendants(id)...)
+	}
+
+	return groups.HierarchyPage{
+		HierarchyPageMeta: hm,
+		Groups:            gs,
+	}, nil
+}
+
+func (r *GroupsRepository) ChangeStatus(ctx context.Context, group groups.Group) (groups.Group, error) {
+	return r.update(group, func(existing *groups.Group) {
+		existing.Status = group.Status
+	})
+}
+
+func (r *GroupsRepository) ChangeStatusWithDescendants(ctx context.Context, group groups.Group) (groups.Group, error) {
+	g, err := r.update(group, func(existing *groups.Group) {
+		existing.Status = group.Status
+	})
+	if err != nil {
+		return groups.Group{}, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, child := range r.descendants(group.ID) {
+		child.Status = group.Status
+		child.UpdatedAt = time.Now()
+		r.groups[child.ID] = child
+	}
+
+	return g, nil
+}
+
+func (r *GroupsRepository) AssignParentGroup(ctx context.Context, parentGroupID string, groupIDs ...string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.groups[parentGroupID]; !ok {
+		return repoerr.ErrNotFound
+	}
+	for _, id := range groupIDs {
+		g, ok := r.groups[id]
+		if !ok {
+			return repoerr.ErrNotFound
+		}
+		g.Parent = parentGroupID
+		r.groups[id] = g
+	}
+
+	return nil
+}
+
+func (r *GroupsRepository) UnassignParentGroup(ctx context.Context, parentGroupID string, groupIDs ...string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, id := range groupIDs {
+		g, ok := r.groups[id]
+		if !ok {
+			return repoerr.ErrNotFound
+		}
+		if g.Parent == parentGroupID {
+			g.Parent = ""
+			r.groups[id] = g
+		}
+	}
+
+	return nil
+}
+
+func (r *GroupsRepository) UnassignAllChildrenGroups(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for gid, g := range r.groups {
+		if g.Parent == id {
+			g.Parent = ""
+			r.groups[gid] = g
+		}
+	}
+
+	return nil
+}
+
+func (r *GroupsRepository) RetrieveUserGroups(ctx context.Context, domainID, userID string, pm groups.PageMeta, ids ...string) (groups.Page, error) {
+	pm.DomainID = domainID
+
+	return r.retrieve(pm, ids)
+}
+
+func (r *GroupsRepository) RetrieveMemberCounts(ctx context.Context, id string) (groups.MemberCounts, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.groups[id]; !ok {
+		return groups.MemberCounts{}, repoerr.ErrNotFound
+	}
+	var childCount uint64
+	for _, g := range r.groups {
+		if g.Parent == id {
+			childCount++
+		}
+	}
+
+	return groups.MemberCounts{Groups: childCount}, nil
+}
+
+func (r *GroupsRepository) RetrieveChildrenGroups(ctx context.Context, domainID, userID, groupID string, startLevel, endLevel int64, pm groups.PageMeta) (groups.Page, error) {
+	r.mu.Lock()
+	children := r.descendants(groupID)
+	r.mu.Unlock()
+
+	ids := make([]string, 0, len(children))
+	for _, child := range children {
+		ids = append(ids, child.ID)
+	}
+	if len(ids) == 0 {
+		return groups.Page{PageMeta: groups.PageMeta{Offset: pm.Offset, Limit: pm.Limit}}, nil
+	}
+	pm.DomainID = domainID
+
+	return r.retrieve(pm, ids)
+}
+
+func (r *GroupsRepository) RetrieveAllParentGroups(ctx context.Context, domainID, userID, groupID string, pm groups.PageMeta) (groups.Page, error) {
+	r.mu.Lock()
+	var ids []string
+	g, ok := r.groups[groupID]
+	for ok && g.Parent != "" {
+		ids = append(ids, g.Parent)
+		g, ok = r.groups[g.Parent]
+	}
+	r.mu.Unlock()
+
+	if len(ids) == 0 {
+		return groups.Page{PageMeta: groups.PageMeta{Offset: pm.Offset, Limit: pm.Limit}}, nil
+	}
+	pm.DomainID = domainID
+
+	return r.retrieve(pm, ids)
+}
+
+func (r *GroupsRepository) Delete(ctx context.Context, groupID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.groups[groupID]; !ok {
+		return repoerr.ErrNotFound
+	}
+	delete(r.groups, groupID)
+	for id, g := range r.groups {
+		if g.Parent == groupID {
+			g.Parent = ""
+			r.groups[id] = g
+		}
+	}
+
+	return nil
+}
+
+// descendants must be called with the mutex held.
+func (r *GroupsRepository) descendants(id string) []groups.Group {
+	var ds []groups.Group
+	for _, g := range r.groups {
+		if g.Parent == id {
+			ds = append(ds, g)
+			ds = append(ds, r.descendants(g.ID)...)
+		}
+	}
+	sort.Slice(ds, func(i, j int) bool { return ds[i].ID < ds[j].ID })
+
+	return ds
+}
+
+func (r *GroupsRepository) update(g groups.Group, apply func(*groups.Group)) (groups.Group, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	existing, ok := r.groups[g.ID]
+	if !ok {
+		return groups.Group{}, repoerr.ErrNotFound
+	}
+	apply(&existing)
+	existing.UpdatedAt = time.Now()
+	existing.UpdatedBy = g.UpdatedBy
+	r.groups[g.ID] = existing
+
+	return existing, nil
+}
+
+func (r *GroupsRepository) retrieve(pm groups.PageMeta, ids []string) (groups.Page, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var matched []groups.Group
+	for _, g := range r.groups {
+		if matchGroup(g, pm, ids) {
+			matched = append(matched, g)
+		}
+	}
+	sort.Slice(matched, func(i, j int) bool { return matched[i].ID < matched[j].ID })
+	total := uint64(len(matched))
+	if pm.Offset >= total {
+		matched = []groups.Group{}
+	} else {
+		matched = matched[pm.Offset:]
+		if pm.Limit != 0 && pm.Limit < uint64(len(matched)) {
+			matched = matched[:pm.Limit]
+		}
+	}
+
+	return groups.Page{
+		PageMeta: groups.PageMeta{
+			Total:  total,
+			Offset: pm.Offset,
+			Limit:  pm.Limit,
+		},
+		Groups: matched,
+	}, nil
+}
+
+func matchGroup(g groups.Group, pm groups.PageMeta, ids []string) bool {
+	if pm.DomainID != "" && g.Domain != pm.DomainID {
+		return false
+	}
+	if pm.Status != groups.AllStatus && g.Status != pm.Status {
+		return false
+	}
+	if pm.Name != "" && g.Name != pm.Name {
+		return false
+	}
+	if pm.ID != "" && g.ID != pm.ID {
+		return false
+	}
+	if len(ids) > 0 && !containsString(ids, g.ID) {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,368 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package testutil
+
+import (
+	"context"
+	"sync"
+
+	repoerr "github.com/absmach/supermq/pkg/errors/repository"
+	"github.com/absmach/supermq/pkg/roles"
+)
+
+var _ roles.Repository = (*RolesRepository)(nil)
+
+// RolesRepository is an in-memory roles.Repository shared by the entity
+// repository fakes, since clients and groups embed the same roles
+// persistence API.
+type RolesRepository struct {
+	mu      sync.Mutex
+	roles   map[string]roles.Role
+	actions map[string][]string
+	members map[string][]string
+}
+
+// NewRolesRepository returns an empty in-memory roles repository.
+func NewRolesRepository() *RolesRepository {
+	return &RolesRepository{
+		roles:   make(map[string]roles.Role),
+		actions: make(map[string][]string),
+		members: make(map[string][]string),
+	}
+}
+
+func (r *RolesRepository) AddRoles(ctx context.Context, rps []roles.RoleProvision) ([]roles.RoleProvision, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, rp := range rps {
+		r.roles[rp.ID] = rp.Role
+		r.actions[rp.ID] = append([]string{}, rp.OptionalActions...)
+		r.members[rp.ID] = append([]string{}, rp.OptionalMembers...)
+	}
+
+	return rps, nil
+}
+
+func (r *RolesRepository) RemoveRoles(ctx context.Context, roleIDs []string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, id := range roleIDs {
+		delete(r.roles, id)
+		delete(r.actions, id)
+		delete(r.members, id)
+	}
+
+	return nil
+}
+
+func (r *RolesRepository) UpdateRole(ctx context.Context, ro roles.Role) (roles.Role, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.roles[ro.ID]; !ok {
+		return roles.Role{}, repoerr.ErrNotFound
+	}
+	r.roles[ro.ID] = ro
+
+	return ro, nil
+}
+
+func (r *RolesRepository) SetRoleInheritance(ctx context.Context, ro roles.Role) (roles.Role, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	existing, ok := r.roles[ro.ID]
+	if !ok {
+		return roles.Role{}, repoerr.ErrNotFound
+	}
+	existing.InheritsFrom = ro.InheritsFrom
+	r.roles[ro.ID] = existing
+
+	return existing, nil
+}
+
+func (r *RolesRepository) RetrieveRole(ctx context.Context, roleID string) (roles.Role, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	ro, ok := r.roles[roleID]
+	if !ok {
+		return roles.Role{}, repoerr.ErrNotFound
+	}
+
+	return ro, nil
+}
+
+func (r *RolesRepository) RetrieveEntityRole(ctx context.Context, entityID, roleID string) (roles.Role, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	ro, ok := r.roles[roleID]
+	if !ok || ro.EntityID != entityID {
+		return roles.Role{}, repoerr.ErrNotFound
+	}
+
+	return ro, nil
+}
+
+func (r *RolesRepository) RetrieveAllRoles(ctx context.Context, entityID string, limit, offset uint64) (roles.RolePage, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var items []roles.Role
+	for _, ro := range r.roles {
+		if ro.EntityID == entityID {
+			items = append(items, ro)
+		}
+	}
+	sortRoles(items)
+	total := uint64(len(items))
+	items = paginateRoles(items, offset, limit)
+
+	return roles.RolePage{
+		Total:  total,
+		Offset: offset,
+		Limit:  limit,
+		Roles:  items,
+	}, nil
+}
+
+func (r *RolesRepository) RoleAddActions(ctx context.Context, role roles.Role, actions []string) ([]string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.roles[role.ID]; !ok {
+		return nil, repoerr.ErrNotFound
+	}
+	r.actions[role.ID] = appendMissing(r.actions[role.ID], actions)
+
+	return actions, nil
+}
+
+func (r *RolesRepository) RoleListActions(ctx context.Context, roleID string) ([]string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]string{}, r.actions[roleID]...), nil
+}
+
+func (r *RolesRepository) RoleCheckActionsExists(ctx context.Context, roleID string, actions []string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return containsAll(r.actions[roleID], actions), nil
+}
+
+func (r *RolesRepository) RoleRemoveActions(ctx context.Context, role roles.Role, actions []string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.actions[role.ID] = removeAll(r.actions[role.ID], actions)
+
+	return nil
+}
+
+func (r *RolesRepository) RoleRemoveAllActions(ctx context.Context, role roles.Role) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.actions, role.ID)
+
+	return nil
+}
+
+func (r *RolesRepository) RoleAddMembers(ctx context.Context, role roles.Role, members []string) ([]string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.roles[role.ID]; !ok {
+		return nil, repoerr.ErrNotFound
+	}
+	r.members[role.ID] = appendMissing(r.members[role.ID], members)
+
+	return members, nil
+}
+
+func (r *RolesRepository) RoleListMembers(ctx context.Context, roleID string, limit, offset uint64) (roles.MembersPage, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	members := append([]string{}, r.members[roleID]...)
+	total := uint64(len(members))
+	members = paginateStrings(members, offset, limit)
+
+	return roles.MembersPage{
+		Total:   total,
+		Offset:  offset,
+		Limit:   limit,
+		Members: members,
+	}, nil
+}
+
+func (r *RolesRepository) ListEntityMembers(ctx context.Context, entityID string, limit, offset uint64) (roles.EntityMembersPage, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var items []roles.EntityMember
+	for id, ro := range r.roles {
+		if ro.EntityID != entityID {
+			continue
+		}
+		for _, member := range r.members[id] {
+			items = append(items, roles.EntityMember{
+				MemberID: member,
+				RoleID:   ro.ID,
+				RoleName: ro.Name,
+			})
+		}
+	}
+	total := uint64(len(items))
+
+	return roles.EntityMembersPage{
+		Total:   total,
+		Offset:  offset,
+		Limit:   limit,
+		Members: items,
+	}, nil
+}
+
+func (r *RolesRepository) ListMemberEntities(ctx context.Context, memberID string, limit, offset uint64) (roles.MemberEntitiesPage, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var items []roles.MemberEntity
+	for id, ro := range r.roles {
+		for _, member := range r.members[id] {
+			if member == memberID {
+				items = append(items, roles.MemberEntity{
+					EntityID: ro.EntityID,
+					RoleID:   ro.ID,
+					RoleName: ro.Name,
+				})
+			}
+		}
+	}
+	total := uint64(len(items))
+
+	return roles.MemberEntitiesPage{
+		Total:    total,
+		Offset:   offset,
+		Limit:    limit,
+		Entities: items,
+	}, nil
+}
+
+func (r *RolesRepository) RoleCheckMembersExists(ctx context.Context, roleID string, members []string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return containsAll(r.members[roleID], members), nil
+}
+
+func (r *RolesRepository) RoleRemoveMembers(ctx context.Context, role roles.Role, members []string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.members[role.ID] = removeAll(r.members[role.ID], members)
+
+	return nil
+}
+
+func (r *RolesRepository) RoleRemoveAllMembers(ctx context.Context, role roles.Role) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.members, role.ID)
+
+	return nil
+}
+
+func (r *RolesRepository) RetrieveEntitiesRolesActionsMembers(ctx context.Context, entityIDs []string) ([]roles.EntityActionRole, []roles.EntityMemberRole, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var ars []roles.EntityActionRole
+	var mrs []roles.EntityMemberRole
+	for id, ro := range r.roles {
+		if !containsString(entityIDs, ro.EntityID) {
+			continue
+		}
+		for _, action := range r.actions[id] {
+			ars = append(ars, roles.EntityActionRole{EntityID: ro.EntityID, Action: action, RoleID: ro.ID})
+		}
+		for _, member := range r.members[id] {
+			mrs = append(mrs, roles.EntityMemberRole{EntityID: ro.EntityID, MemberID: member, RoleID: ro.ID})
+		}
+	}
+
+	return ars, mrs, nil
+}
+
+func (r *RolesRepository) RemoveMemberFromAllRoles(ctx context.Context, memberID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for id := range r.members {
+		r.members[id] = removeAll(r.members[id], []string{memberID})
+	}
+
+	return nil
+}
+
+func appendMissing(existing, items []string) []string {
+	for _, item := range items {
+		if !containsString(existing, item) {
+			existing = append(existing, item)
+		}
+	}
+
+	return existing
+}
+
+func removeAll(existing, items []string) []string {
+	kept := existing[:0]
+	for _, e := range existing {
+		if !containsString(items, e) {
+			kept = append(kept, e)
+		}
+	}
+
+	return kept
+}
+
+func containsAll(existing, items []string) bool {
+	for _, item := range items {
+		if !containsString(existing, item) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func containsString(items []string, item string) bool {
+	for _, i := range items {
+		if i == item {
+			return true
+		}
+	}
+
+	return false
+}
+
+func sortRoles(items []roles.Role) {
+	for i := 1; i < len(items); i++ {
+		for j := i; j > 0 && items[j].ID < items[j-1].ID; j-- {
+			items[j], items[j-1] = items[j-1], items[j]
+		}
+	}
+}
+
+func paginateRoles(items []roles.Role, offset, limit uint64) []roles.Role {
+	if offset >= uint64(len(items)) {
+		return []roles.Role{}
+	}
+	items = items[offset:]
+	if limit != 0 && limit < uint64(len(items)) {
+		items = items[:limit]
+	}
+
+	return items
+}
+
+func paginateStrings(items []string, offset, limit uint64) []string {
+	if offset >= uint64(len(items)) {
+		return []string{}
+	}
+	items = items[offset:]
+	if limit != 0 && limit < uint64(len(items)) {
+		items = items[:limit]
+	}
+
+	return items
+}
@@ -0,0 +1,6 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package topics provides matching of message subtopics against
+// subtopic patterns configured per channel-client connection.
+package topics
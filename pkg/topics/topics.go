@@ -0,0 +1,170 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package topics provides matching of message subtopics against
+// MQTT-style subtopic patterns, used to scope publish and subscribe
+// operations of a connection to configured subtopics.
+package topics
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/absmach/supermq/pkg/connections"
+	"github.com/absmach/supermq/pkg/errors"
+)
+
+// Connection metadata keys holding subtopic patterns per operation.
+const (
+	PublishPatternsKey   = "publish_topics"
+	SubscribePatternsKey = "subscribe_topics"
+)
+
+// ClientIDWildcard is replaced with the ID of the connected client
+// when patterns are expanded.
+const ClientIDWildcard = "{clientID}"
+
+// ErrMalformedPattern indicates an invalid subtopic pattern, e.g. a
+// multi-level wildcard that is not the last pattern level.
+var ErrMalformedPattern = errors.New("malformed subtopic pattern")
+
+const (
+	singleWildcard = "+"
+	multiWildcard  = "#"
+)
+
+// Matcher reports whether subtopics are covered by a compiled set of
+// subtopic patterns. Patterns use `/` or `.` separated levels, where
+// `+` matches exactly one level and a trailing `#` matches any number
+// of trailing levels.
+type Matcher struct {
+	patterns [][]string
+}
+
+// Compile parses the given patterns into a Matcher.
+func Compile(patterns []string) (*Matcher, error) {
+	m := &Matcher{}
+	for _, pattern := range patterns {
+		levels := split(pattern)
+		if len(levels) == 0 {
+			return nil, errors.Wrap(ErrMalformedPattern, errors.New("empty pattern"))
+		}
+		for i, level := range levels {
+			if level == multiWildcard && i != len(levels)-1 {
+				return nil, errors.Wrap(ErrMalformedPattern, errors.New(pattern))
+			}
+		}
+		m.patterns = append(m.patterns, levels)
+	}
+	return m, nil
+}
+
+// Match reports whether the given subtopic is covered by any of the
+// compiled patterns. An empty subtopic matches only the multi-level
+// wildcard pattern.
+func (m *Matcher) Match(subtopic string) bool {
+	levels := split(subtopic)
+	for _, pattern := range m.patterns {
+		if match(pattern, levels) {
+			return true
+		}
+	}
+	return false
+}
+
+func match(pattern, levels []string) bool {
+	for i, p := range pattern {
+		if p == multiWildcard {
+			return true
+		}
+		if i >= len(levels) {
+			return false
+		}
+		if p != singleWildcard && p != levels[i] {
+			return false
+		}
+	}
+	return len(pattern) == len(levels)
+}
+
+func split(topic string) []string {
+	topic = strings.ReplaceAll(topic, "/", ".")
+	var levels []string
+	for _, level := range strings.Split(topic, ".") {
+		if level != "" {
+			levels = append(levels, level)
+		}
+	}
+	return levels
+}
+
+// Expand replaces the client ID wildcard in the given patterns with
+// the ID of the connected client.
+func Expand(patterns []string, clientID string) []string {
+	expanded := make([]string, 0, len(patterns))
+	for _, pattern := range patterns {
+		expanded = append(expanded, strings.ReplaceAll(pattern, ClientIDWildcard, clientID))
+	}
+	return expanded
+}
+
+// FromMetadata extracts the subtopic patterns configured for the given
+// connection type from connection metadata.
+func FromMetadata(metadata map[string]interface{}, connType connections.ConnType) []string {
+	key := PublishPatternsKey
+	if connType == connections.Subscribe {
+		key = SubscribePatternsKey
+	}
+	raw, ok := metadata[key].([]interface{})
+	if !ok {
+		return nil
+	}
+	var patterns []string
+	for _, p := range raw {
+		if pattern, ok := p.(string); ok {
+			patterns = append(patterns, pattern)
+		}
+	}
+	return patterns
+}
+
+type entry struct {
+	sig     string
+	matcher *Matcher
+}
+
+// Cache keeps compiled matchers keyed by client key, recompiling only
+// when the patterns for a key change.
+type Cache struct {
+	mu      sync.RWMutex
+	entries map[string]entry
+}
+
+// NewCache returns an empty matcher cache.
+func NewCache() *Cache {
+	return &Cache{entries: make(map[string]entry)}
+}
+
+// Matcher returns the compiled matcher for the given key and patterns,
+// reusing the cached one if the patterns are unchanged.
+func (c *Cache) Matcher(key string, patterns []string) (*Matcher, error) {
+	sig := strings.Join(patterns, "\n")
+
+	c.mu.RLock()
+	e, ok := c.entries[key]
+	c.mu.RUnlock()
+	if ok && e.sig == sig {
+		return e.matcher, nil
+	}
+
+	m, err := Compile(patterns)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	c.entries[key] = entry{sig: sig, matcher: m}
+	c.mu.Unlock()
+
+	return m, nil
+}
@@ -0,0 +1,135 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package topics_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/absmach/supermq/pkg/connections"
+	"github.com/absmach/supermq/pkg/errors"
+	"github.com/absmach/supermq/pkg/topics"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompile(t *testing.T) {
+	cases := []struct {
+		desc     string
+		patterns []string
+		err      error
+	}{
+		{
+			desc:     "compile valid patterns",
+			patterns: []string{"telemetry/#", "cmd/+/status", "alerts"},
+			err:      nil,
+		},
+		{
+			desc:     "compile dot separated pattern",
+			patterns: []string{"telemetry.sensors.#"},
+			err:      nil,
+		},
+		{
+			desc:     "compile pattern with non-trailing multi-level wildcard",
+			patterns: []string{"telemetry/#/temp"},
+			err:      topics.ErrMalformedPattern,
+		},
+		{
+			desc:     "compile empty pattern",
+			patterns: []string{""},
+			err:      topics.ErrMalformedPattern,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := topics.Compile(tc.patterns)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		})
+	}
+}
+
+func TestMatch(t *testing.T) {
+	matcher, err := topics.Compile([]string{"telemetry/#", "cmd/+/status"})
+	require.Nil(t, err, fmt.Sprintf("compile unexpected error: %s", err))
+
+	cases := []struct {
+		desc     string
+		subtopic string
+		matched  bool
+	}{
+		{
+			desc:     "match multi-level wildcard",
+			subtopic: "telemetry.sensors.temp",
+			matched:  true,
+		},
+		{
+			desc:     "match multi-level wildcard root",
+			subtopic: "telemetry",
+			matched:  true,
+		},
+		{
+			desc:     "match single-level wildcard",
+			subtopic: "cmd.dev1.status",
+			matched:  true,
+		},
+		{
+			desc:     "mismatch single-level wildcard depth",
+			subtopic: "cmd.dev1.sub.status",
+			matched:  false,
+		},
+		{
+			desc:     "mismatch unrelated subtopic",
+			subtopic: "alerts.fire",
+			matched:  false,
+		},
+		{
+			desc:     "mismatch empty subtopic",
+			subtopic: "",
+			matched:  false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			matched := matcher.Match(tc.subtopic)
+			assert.Equal(t, tc.matched, matched, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.matched, matched))
+		})
+	}
+}
+
+func TestExpand(t *testing.T) {
+	patterns := topics.Expand([]string{"cmd/{clientID}/+", "telemetry/#"}, "dev1")
+	assert.Equal(t, []string{"cmd/dev1/+", "telemetry/#"}, patterns)
+}
+
+func TestFromMetadata(t *testing.T) {
+	metadata := map[string]interface{}{
+		topics.PublishPatternsKey:   []interface{}{"telemetry/#"},
+		topics.SubscribePatternsKey: []interface{}{"cmd/+", 1},
+	}
+
+	assert.Equal(t, []string{"telemetry/#"}, topics.FromMetadata(metadata, connections.Publish))
+	assert.Equal(t, []string{"cmd/+"}, topics.FromMetadata(metadata, connections.Subscribe))
+	assert.Nil(t, topics.FromMetadata(map[string]interface{}{}, connections.Publish))
+}
+
+func TestCache(t *testing.T) {
+	cache := topics.NewCache()
+
+	first, err := cache.Matcher("key", []string{"telemetry/#"})
+	require.Nil(t, err, fmt.Sprintf("matcher unexpected error: %s", err))
+
+	second, err := cache.Matcher("key", []string{"telemetry/#"})
+	require.Nil(t, err, fmt.Sprintf("matcher unexpected error: %s", err))
+	assert.Same(t, first, second, "expected cached matcher to be reused")
+
+	third, err := cache.Matcher("key", []string{"cmd/+"})
+	require.Nil(t, err, fmt.Sprintf("matcher unexpected error: %s", err))
+	assert.NotSame(t, first, third, "expected matcher to be recompiled on pattern change")
+	assert.True(t, third.Match("cmd.dev1"))
+
+	_, err = cache.Matcher("key", []string{"bad/#/pattern"})
+	assert.True(t, errors.Contains(err, topics.ErrMalformedPattern), fmt.Sprintf("expected %s got %s\n", topics.ErrMalformedPattern, err))
+}
@@ -0,0 +1,7 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package pipeline provides per-channel payload transformation chains
+// applied between adapters and the message broker. Chains are built
+// from registered steps, configured via API and hot-reloaded.
+package pipeline
@@ -0,0 +1,177 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package pipeline
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+
+	grpcChannelsV1 "github.com/absmach/supermq/api/grpc/channels/v1"
+	api "github.com/absmach/supermq/api/http"
+	apiutil "github.com/absmach/supermq/api/http/util"
+	smqauthn "github.com/absmach/supermq/pkg/authn"
+	"github.com/absmach/supermq/pkg/connections"
+	"github.com/absmach/supermq/pkg/errors"
+	svcerr "github.com/absmach/supermq/pkg/errors/service"
+	"github.com/absmach/supermq/pkg/messaging"
+	"github.com/absmach/supermq/pkg/policies"
+	"github.com/go-chi/chi/v5"
+)
+
+// Manager holds the per-channel transformation chains. Chain updates
+// take effect immediately.
+type Manager struct {
+	mu      sync.RWMutex
+	chains  map[string][]Step
+	configs map[string][]StepConfig
+}
+
+// NewManager returns a manager with no configured chains.
+func NewManager() *Manager {
+	return &Manager{
+		chains:  make(map[string][]Step),
+		configs: make(map[string][]StepConfig),
+	}
+}
+
+// SetChain builds and installs the transformation chain of the given
+// channel, replacing any existing one.
+func (m *Manager) SetChain(channelID string, configs []StepConfig) error {
+	steps, err := build(configs)
+	if err != nil {
+		return errors.Wrap(svcerr.ErrMalformedEntity, err)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.chains[channelID] = steps
+	m.configs[channelID] = configs
+
+	return nil
+}
+
+// RemoveChain removes the transformation chain of the given channel.
+func (m *Manager) RemoveChain(channelID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.chains[channelID]; !ok {
+		return svcerr.ErrNotFound
+	}
+	delete(m.chains, channelID)
+	delete(m.configs, channelID)
+	return nil
+}
+
+// Chains returns the chain configuration of every channel.
+func (m *Manager) Chains() map[string][]StepConfig {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	configs := make(map[string][]StepConfig, len(m.configs))
+	for channelID, cfg := range m.configs {
+		configs[channelID] = cfg
+	}
+	return configs
+}
+
+// Apply runs the message through the chain of its channel, if any.
+func (m *Manager) Apply(msg *messaging.Message) (*messaging.Message, error) {
+	m.mu.RLock()
+	steps := m.chains[msg.GetChannel()]
+	m.mu.RUnlock()
+
+	var err error
+	for _, step := range steps {
+		if msg, err = step.Apply(msg); err != nil {
+			return nil, err
+		}
+	}
+	return msg, nil
+}
+
+// Handler returns a HTTP handler managing the chains: GET / lists
+// them, PUT /{chanID} installs one and DELETE /{chanID} removes one.
+// All routes require an authenticated user; installing or removing a
+// chain additionally requires publish authorization on the channel,
+// since a chain rewrites every message published to it.
+func (m *Manager) Handler(authn smqauthn.Authentication, channels grpcChannelsV1.ChannelsServiceClient) http.Handler {
+	mux := chi.NewRouter()
+
+	mux.Group(func(router chi.Router) {
+		router.Use(api.AuthenticateMiddleware(authn, false))
+
+		router.Get("/", func(w http.ResponseWriter, r *http.Request) {
+			encodeJSON(w, http.StatusOK, map[string]interface{}{"pipelines": m.Chains()})
+		})
+
+		router.Put("/{chanID}", func(w http.ResponseWriter, r *http.Request) {
+			var req struct {
+				Steps []StepConfig `json:"steps"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				api.EncodeError(r.Context(), errors.Wrap(apiutil.ErrValidation, err), w)
+				return
+			}
+			chanID := chi.URLParam(r, "chanID")
+			if err := authorizeChain(r, channels, chanID); err != nil {
+				api.EncodeError(r.Context(), err, w)
+				return
+			}
+			if err := m.SetChain(chanID, req.Steps); err != nil {
+				api.EncodeError(r.Context(), err, w)
+				return
+			}
+			encodeJSON(w, http.StatusOK, map[string]interface{}{"channel_id": chanID, "steps": req.Steps})
+		})
+
+		router.Delete("/{chanID}", func(w http.ResponseWriter, r *http.Request) {
+			chanID := chi.URLParam(r, "chanID")
+			if err := authorizeChain(r, channels, chanID); err != nil {
+				api.EncodeError(r.Context(), err, w)
+				return
+			}
+			if err := m.RemoveChain(chanID); err != nil {
+				api.EncodeError(r.Context(), err, w)
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		})
+	})
+
+	return mux
+}
+
+// authorizeChain checks that the session user may publish on the
+// channel whose chain is being changed.
+func authorizeChain(r *http.Request, channels grpcChannelsV1.ChannelsServiceClient, chanID string) error {
+	session, ok := r.Context().Value(api.SessionKey).(smqauthn.Session)
+	if !ok {
+		return svcerr.ErrAuthentication
+	}
+	userID := session.DomainUserID
+	if userID == "" {
+		userID = session.UserID
+	}
+	res, err := channels.Authorize(r.Context(), &grpcChannelsV1.AuthzReq{
+		ChannelId:  chanID,
+		ClientId:   userID,
+		ClientType: policies.UserType,
+		Type:       uint32(connections.Publish),
+	})
+	if err != nil {
+		return errors.Wrap(svcerr.ErrAuthorization, err)
+	}
+	if !res.GetAuthorized() {
+		return svcerr.ErrAuthorization
+	}
+	return nil
+}
+
+func encodeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", api.ContentType)
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package pipeline
+
+import (
+	"sync"
+
+	"github.com/absmach/supermq/pkg/errors"
+	"github.com/absmach/supermq/pkg/messaging"
+)
+
+var (
+	errUnknownStep = errors.New("unknown pipeline step")
+
+	factoriesMu sync.RWMutex
+	factories   = map[string]Factory{}
+)
+
+// Step transforms a message payload. Steps are chained per channel.
+type Step interface {
+	// Apply transforms the message and returns the transformed copy.
+	Apply(msg *messaging.Message) (*messaging.Message, error)
+}
+
+// Factory builds a step from its configuration.
+type Factory func(config map[string]interface{}) (Step, error)
+
+// StepConfig is the configuration of one step in a chain.
+type StepConfig struct {
+	Type   string                 `json:"type"`
+	Config map[string]interface{} `json:"config,omitempty"`
+}
+
+// Register registers a step factory under the given type name,
+// overriding any existing registration. It allows deployments to plug
+// in custom steps, e.g. script interpreters or decoders.
+func Register(name string, factory Factory) {
+	factoriesMu.Lock()
+	defer factoriesMu.Unlock()
+	factories[name] = factory
+}
+
+func build(configs []StepConfig) ([]Step, error) {
+	factoriesMu.RLock()
+	defer factoriesMu.RUnlock()
+
+	steps := make([]Step, 0, len(configs))
+	for _, cfg := range configs {
+		factory, ok := factories[cfg.Type]
+		if !ok {
+			return nil, errors.Wrap(errUnknownStep, errors.New(cfg.Type))
+		}
+		step, err := factory(cfg.Config)
+		if err != nil {
+			return nil, err
+		}
+		steps = append(steps, step)
+	}
+	return steps, nil
+}
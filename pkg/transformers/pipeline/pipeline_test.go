@@ -0,0 +1,117 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package pipeline_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/absmach/supermq/pkg/messaging"
+	"github.com/absmach/supermq/pkg/messaging/mocks"
+	"github.com/absmach/supermq/pkg/transformers/pipeline"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const chanID = "channel"
+
+func message(payload string) *messaging.Message {
+	return &messaging.Message{
+		Channel: chanID,
+		Payload: []byte(payload),
+	}
+}
+
+func apply(t *testing.T, m *pipeline.Manager, payload string) string {
+	msg, err := m.Apply(message(payload))
+	require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+	return string(msg.GetPayload())
+}
+
+func TestSetChain(t *testing.T) {
+	cases := []struct {
+		desc  string
+		steps []pipeline.StepConfig
+		err   bool
+	}{
+		{
+			desc:  "valid chain",
+			steps: []pipeline.StepConfig{{Type: pipeline.StepFlatten}},
+		},
+		{
+			desc:  "empty chain",
+			steps: []pipeline.StepConfig{},
+		},
+		{
+			desc:  "unknown step",
+			steps: []pipeline.StepConfig{{Type: "lua"}},
+			err:   true,
+		},
+		{
+			desc:  "convert step without field",
+			steps: []pipeline.StepConfig{{Type: pipeline.StepConvert}},
+			err:   true,
+		},
+		{
+			desc:  "fields step without configuration",
+			steps: []pipeline.StepConfig{{Type: pipeline.StepFields}},
+			err:   true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := pipeline.NewManager().SetChain(chanID, tc.steps)
+			assert.Equal(t, tc.err, err != nil, fmt.Sprintf("unexpected error %v", err))
+		})
+	}
+}
+
+func TestApply(t *testing.T) {
+	m := pipeline.NewManager()
+
+	// Messages of channels without a chain pass through unchanged.
+	assert.Equal(t, `{"a": 1}`, apply(t, m, `{"a": 1}`))
+
+	err := m.SetChain(chanID, []pipeline.StepConfig{{Type: pipeline.StepFlatten}})
+	require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+	assert.JSONEq(t, `{"outer/inner": 1}`, apply(t, m, `{"outer": {"inner": 1}}`))
+
+	err = m.SetChain(chanID, []pipeline.StepConfig{
+		{Type: pipeline.StepConvert, Config: map[string]interface{}{"field": "celsius", "factor": 1.8, "offset": 32.0, "rename": "fahrenheit"}},
+		{Type: pipeline.StepFields, Config: map[string]interface{}{"keep": []interface{}{"fahrenheit"}}},
+	})
+	require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+	assert.JSONEq(t, `{"fahrenheit": 212}`, apply(t, m, `{"celsius": 100, "humidity": 13}`))
+
+	_, err = m.Apply(message("not json"))
+	assert.NotNil(t, err, "expected error for non-JSON payload")
+
+	require.Nil(t, m.RemoveChain(chanID))
+	assert.Equal(t, "not json", apply(t, m, "not json"))
+
+	err = m.RemoveChain(chanID)
+	assert.NotNil(t, err, "expected error removing absent chain")
+}
+
+func TestPublisherMiddleware(t *testing.T) {
+	m := pipeline.NewManager()
+	err := m.SetChain(chanID, []pipeline.StepConfig{{Type: pipeline.StepFlatten}})
+	require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+
+	pubsub := new(mocks.PubSub)
+	pubsub.On("Publish", mock.Anything, chanID, mock.MatchedBy(func(msg *messaging.Message) bool {
+		return string(msg.GetPayload()) == `{"a/b":1}`
+	})).Return(nil)
+
+	pub := pipeline.NewPublisherMiddleware(pubsub, m)
+	err = pub.Publish(context.Background(), chanID, message(`{"a": {"b": 1}}`))
+	assert.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+	pubsub.AssertNumberOfCalls(t, "Publish", 1)
+
+	err = pub.Publish(context.Background(), chanID, message("not json"))
+	assert.NotNil(t, err, "expected error for non-JSON payload")
+	pubsub.AssertNumberOfCalls(t, "Publish", 1)
+}
@@ -0,0 +1,38 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package pipeline
+
+import (
+	"context"
+
+	"github.com/absmach/supermq/pkg/messaging"
+)
+
+var _ messaging.Publisher = (*publisherMiddleware)(nil)
+
+type publisherMiddleware struct {
+	pub     messaging.Publisher
+	manager *Manager
+}
+
+// NewPublisherMiddleware returns a publisher applying the channel
+// transformation chain to every message before publishing it.
+func NewPublisherMiddleware(pub messaging.Publisher, manager *Manager) messaging.Publisher {
+	return &publisherMiddleware{
+		pub:     pub,
+		manager: manager,
+	}
+}
+
+func (pm *publisherMiddleware) Publish(ctx context.Context, topic string, msg *messaging.Message) error {
+	msg, err := pm.manager.Apply(msg)
+	if err != nil {
+		return err
+	}
+	return pm.pub.Publish(ctx, topic, msg)
+}
+
+func (pm *publisherMiddleware) Close() error {
+	return pm.pub.Close()
+}
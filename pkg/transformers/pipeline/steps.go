@@ -0,0 +1,128 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package pipeline
+
+import (
+	"encoding/json"
+
+	"github.com/absmach/supermq/pkg/errors"
+	"github.com/absmach/supermq/pkg/messaging"
+	smqjson "github.com/absmach/supermq/pkg/transformers/json"
+	"google.golang.org/protobuf/proto"
+)
+
+// Built-in step types.
+const (
+	StepFlatten = "flatten"
+	StepConvert = "convert"
+	StepFields  = "fields"
+)
+
+var (
+	errPayloadNotJSON = errors.New("payload is not a JSON object")
+	errMissingField   = errors.New("missing step field")
+	errFieldsConfig   = errors.New("fields step requires either keep or drop")
+)
+
+func init() {
+	Register(StepFlatten, newFlattenStep)
+	Register(StepConvert, newConvertStep)
+	Register(StepFields, newFieldsStep)
+}
+
+// jsonStep lifts a function over decoded JSON payloads into a step.
+type jsonStep func(values map[string]interface{}) (map[string]interface{}, error)
+
+func (s jsonStep) Apply(msg *messaging.Message) (*messaging.Message, error) {
+	values := map[string]interface{}{}
+	if err := json.Unmarshal(msg.GetPayload(), &values); err != nil {
+		return nil, errors.Wrap(errPayloadNotJSON, err)
+	}
+
+	values, err := s(values)
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := json.Marshal(values)
+	if err != nil {
+		return nil, err
+	}
+
+	transformed := proto.Clone(msg).(*messaging.Message)
+	transformed.Payload = payload
+	return transformed, nil
+}
+
+// newFlattenStep returns a step flattening nested JSON objects using
+// composite keys.
+func newFlattenStep(_ map[string]interface{}) (Step, error) {
+	return jsonStep(smqjson.Flatten), nil
+}
+
+// newConvertStep returns a step converting a numeric payload field
+// with value*factor+offset, optionally renaming it. Configuration:
+// field, factor, offset, rename.
+func newConvertStep(config map[string]interface{}) (Step, error) {
+	field, _ := config["field"].(string)
+	if field == "" {
+		return nil, errMissingField
+	}
+	factor, ok := config["factor"].(float64)
+	if !ok {
+		factor = 1
+	}
+	offset, _ := config["offset"].(float64)
+	rename, _ := config["rename"].(string)
+
+	return jsonStep(func(values map[string]interface{}) (map[string]interface{}, error) {
+		value, ok := values[field].(float64)
+		if !ok {
+			return values, nil
+		}
+		name := field
+		if rename != "" {
+			name = rename
+			delete(values, field)
+		}
+		values[name] = value*factor + offset
+		return values, nil
+	}), nil
+}
+
+// newFieldsStep returns a step keeping or dropping payload fields.
+// Configuration: keep or drop, a list of field names.
+func newFieldsStep(config map[string]interface{}) (Step, error) {
+	keep := fieldSet(config["keep"])
+	drop := fieldSet(config["drop"])
+	if (len(keep) == 0) == (len(drop) == 0) {
+		return nil, errFieldsConfig
+	}
+
+	return jsonStep(func(values map[string]interface{}) (map[string]interface{}, error) {
+		for field := range values {
+			switch {
+			case len(keep) > 0 && !keep[field]:
+				delete(values, field)
+			case drop[field]:
+				delete(values, field)
+			}
+		}
+		return values, nil
+	}), nil
+}
+
+func fieldSet(value interface{}) map[string]bool {
+	fields, ok := value.([]interface{})
+	if !ok {
+		return nil
+	}
+	set := map[string]bool{}
+	for _, field := range fields {
+		if name, ok := field.(string); ok {
+			set[name] = true
+		}
+	}
+	return set
+}
@@ -0,0 +1,89 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+
+	grpcChannelsV1 "github.com/absmach/supermq/api/grpc/channels/v1"
+	api "github.com/absmach/supermq/api/http"
+	apiutil "github.com/absmach/supermq/api/http/util"
+	smqauthn "github.com/absmach/supermq/pkg/authn"
+	"github.com/absmach/supermq/pkg/errors"
+	svcerr "github.com/absmach/supermq/pkg/errors/service"
+	"github.com/absmach/supermq/readers"
+	"github.com/go-chi/chi/v5"
+)
+
+// startExportHandler starts a Parquet export job for the requested
+// channels and time range. The requester must be authorized on every
+// requested channel, the same way as for multi-entity reads.
+func startExportHandler(exporter *readers.Exporter, channels grpcChannelsV1.ChannelsServiceClient) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		session, ok := r.Context().Value(api.SessionKey).(smqauthn.Session)
+		if !ok {
+			api.EncodeError(r.Context(), svcerr.ErrAuthentication, w)
+			return
+		}
+		var req readers.ExportRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			api.EncodeError(r.Context(), errors.Wrap(apiutil.ErrValidation, err), w)
+			return
+		}
+		if err := authorizeChannels(r, channels, session, req.Channels); err != nil {
+			api.EncodeError(r.Context(), err, w)
+			return
+		}
+
+		job, err := exporter.Start(r.Context(), sessionUserID(session), req)
+		if err != nil {
+			api.EncodeError(r.Context(), err, w)
+			return
+		}
+		encodeJSON(w, http.StatusAccepted, job)
+	}
+}
+
+func exportJobHandler(exporter *readers.Exporter) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		session, ok := r.Context().Value(api.SessionKey).(smqauthn.Session)
+		if !ok {
+			api.EncodeError(r.Context(), svcerr.ErrAuthentication, w)
+			return
+		}
+		job, err := exporter.Job(chi.URLParam(r, "jobID"), sessionUserID(session))
+		if err != nil {
+			api.EncodeError(r.Context(), err, w)
+			return
+		}
+		encodeJSON(w, http.StatusOK, job)
+	}
+}
+
+func exportDownloadHandler(exporter *readers.Exporter) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		session, ok := r.Context().Value(api.SessionKey).(smqauthn.Session)
+		if !ok {
+			api.EncodeError(r.Context(), svcerr.ErrAuthentication, w)
+			return
+		}
+		path, err := exporter.File(chi.URLParam(r, "jobID"), sessionUserID(session))
+		if err != nil {
+			api.EncodeError(r.Context(), err, w)
+			return
+		}
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Header().Set("Content-Disposition", `attachment; filename="`+chi.URLParam(r, "jobID")+`.parquet"`)
+		http.ServeFile(w, r, path)
+	}
+}
+
+func encodeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", api.ContentType)
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+
+	grpcChannelsV1 "github.com/absmach/supermq/api/grpc/channels/v1"
+	api "github.com/absmach/supermq/api/http"
+	apiutil "github.com/absmach/supermq/api/http/util"
+	smqauthn "github.com/absmach/supermq/pkg/authn"
+	"github.com/absmach/supermq/pkg/errors"
+	svcerr "github.com/absmach/supermq/pkg/errors/service"
+	"github.com/absmach/supermq/readers"
+	"github.com/go-chi/chi/v5"
+)
+
+// updateRetentionHandler sets the retention policy of a channel. The
+// requester must be authorized on the channel, the same way as for
+// reads.
+func updateRetentionHandler(retention readers.RetentionRepository, channels grpcChannelsV1.ChannelsServiceClient) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		session, ok := r.Context().Value(api.SessionKey).(smqauthn.Session)
+		if !ok {
+			api.EncodeError(r.Context(), svcerr.ErrAuthentication, w)
+			return
+		}
+		var policy readers.RetentionPolicy
+		if err := json.NewDecoder(r.Body).Decode(&policy); err != nil {
+			api.EncodeError(r.Context(), errors.Wrap(apiutil.ErrValidation, err), w)
+			return
+		}
+		policy.ChannelID = chi.URLParam(r, "chanID")
+		if err := policy.Validate(); err != nil {
+			api.EncodeError(r.Context(), errors.Wrap(apiutil.ErrValidation, err), w)
+			return
+		}
+		if err := authorizeChannels(r, channels, session, []string{policy.ChannelID}); err != nil {
+			api.EncodeError(r.Context(), err, w)
+			return
+		}
+
+		if err := retention.SaveRetention(r.Context(), policy); err != nil {
+			api.EncodeError(r.Context(), err, w)
+			return
+		}
+		encodeJSON(w, http.StatusOK, policy)
+	}
+}
+
+func viewRetentionHandler(retention readers.RetentionRepository, channels grpcChannelsV1.ChannelsServiceClient) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		session, ok := r.Context().Value(api.SessionKey).(smqauthn.Session)
+		if !ok {
+			api.EncodeError(r.Context(), svcerr.ErrAuthentication, w)
+			return
+		}
+		chanID := chi.URLParam(r, "chanID")
+		if err := authorizeChannels(r, channels, session, []string{chanID}); err != nil {
+			api.EncodeError(r.Context(), err, w)
+			return
+		}
+
+		policy, err := retention.RetrieveRetention(r.Context(), chanID)
+		if err != nil {
+			api.EncodeError(r.Context(), err, w)
+			return
+		}
+		encodeJSON(w, http.StatusOK, policy)
+	}
+}
+
+func removeRetentionHandler(retention readers.RetentionRepository, channels grpcChannelsV1.ChannelsServiceClient) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		session, ok := r.Context().Value(api.SessionKey).(smqauthn.Session)
+		if !ok {
+			api.EncodeError(r.Context(), svcerr.ErrAuthentication, w)
+			return
+		}
+		chanID := chi.URLParam(r, "chanID")
+		if err := authorizeChannels(r, channels, session, []string{chanID}); err != nil {
+			api.EncodeError(r.Context(), err, w)
+			return
+		}
+
+		if err := retention.RemoveRetention(r.Context(), chanID); err != nil {
+			api.EncodeError(r.Context(), err, w)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
@@ -0,0 +1,341 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"encoding/json"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/absmach/supermq"
+	grpcChannelsV1 "github.com/absmach/supermq/api/grpc/channels/v1"
+	api "github.com/absmach/supermq/api/http"
+	apiutil "github.com/absmach/supermq/api/http/util"
+	smqauthn "github.com/absmach/supermq/pkg/authn"
+	"github.com/absmach/supermq/pkg/connections"
+	"github.com/absmach/supermq/pkg/errors"
+	svcerr "github.com/absmach/supermq/pkg/errors/service"
+	"github.com/absmach/supermq/pkg/policies"
+	"github.com/absmach/supermq/pkg/ratelimit"
+	"github.com/absmach/supermq/readers"
+	"github.com/go-chi/chi/v5"
+)
+
+const (
+	defOffset = 0
+	defLimit  = 10
+
+	// Rate limit for anonymous reads, per remote address.
+	anonRateLimit = 10
+	anonRateBurst = 20
+
+	csvContentType = "text/csv"
+)
+
+var errRateLimitExceeded = errors.New("anonymous access rate limit exceeded")
+
+// MakeHandler returns a HTTP API handler with health check.
+func MakeHandler(repo readers.MessageRepository, exporter *readers.Exporter, retention readers.RetentionRepository, replayer *readers.Replayer, authn smqauthn.Authentication, channels grpcChannelsV1.ChannelsServiceClient, svcName, instanceID string) http.Handler {
+	mux := chi.NewRouter()
+
+	mux.Mount("/replay", readers.MakeReplayHandler(replayer, authn, channels))
+
+	messages := listMessagesHandler(repo)
+	authenticated := api.AuthenticateMiddleware(authn, false)(messages)
+	mux.Get("/channels/{chanID}/messages", anonymousHandler(channels, messages, authenticated))
+
+	multi := listMultiMessagesHandler(repo, channels)
+	mux.Get("/messages", api.AuthenticateMiddleware(authn, false)(multi).ServeHTTP)
+
+	mux.Group(func(r chi.Router) {
+		r.Use(api.AuthenticateMiddleware(authn, false))
+		r.Post("/export", startExportHandler(exporter, channels))
+		r.Get("/export/{jobID}", exportJobHandler(exporter))
+		r.Get("/export/{jobID}/download", exportDownloadHandler(exporter))
+		r.Put("/channels/{chanID}/retention", updateRetentionHandler(retention, channels))
+		r.Get("/channels/{chanID}/retention", viewRetentionHandler(retention, channels))
+		r.Delete("/channels/{chanID}/retention", removeRetentionHandler(retention, channels))
+	})
+
+	mux.Get("/health", supermq.Health(svcName, instanceID))
+
+	return mux
+}
+
+// anonymousHandler serves requests without credentials through the anonymous
+// handler, provided the requested channel is public, and routes the rest to
+// the authenticated one. Anonymous reads are rate limited per remote address.
+func anonymousHandler(channels grpcChannelsV1.ChannelsServiceClient, anonymous, authenticated http.Handler) http.HandlerFunc {
+	limiter := ratelimit.New(anonRateLimit, anonRateBurst)
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "" {
+			authenticated.ServeHTTP(w, r)
+			return
+		}
+
+		host, _, err := net.SplitHostPort(r.RemoteAddr)
+		if err != nil {
+			host = r.RemoteAddr
+		}
+		if !limiter.Allow(host) {
+			api.EncodeError(r.Context(), errors.Wrap(svcerr.ErrAuthorization, errRateLimitExceeded), w)
+			return
+		}
+
+		res, err := channels.Authorize(r.Context(), &grpcChannelsV1.AuthzReq{
+			ChannelId: chi.URLParam(r, "chanID"),
+			Type:      uint32(connections.Subscribe),
+		})
+		if err != nil {
+			api.EncodeError(r.Context(), errors.Wrap(svcerr.ErrAuthorization, err), w)
+			return
+		}
+		if !res.GetAuthorized() {
+			api.EncodeError(r.Context(), svcerr.ErrAuthorization, w)
+			return
+		}
+
+		anonymous.ServeHTTP(w, r)
+	}
+}
+
+func listMessagesHandler(repo readers.MessageRepository) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		pm, err := decodePageMetadata(r)
+		if err != nil {
+			api.EncodeError(r.Context(), errors.Wrap(apiutil.ErrValidation, err), w)
+			return
+		}
+
+		page, err := repo.ReadAll(chi.URLParam(r, "chanID"), pm)
+		if err != nil {
+			api.EncodeError(r.Context(), errors.Wrap(svcerr.ErrViewEntity, err), w)
+			return
+		}
+
+		encodePage(w, r, page)
+	}
+}
+
+// listMultiMessagesHandler reads messages across several channels at
+// once, interleaved in time order. The requester must be authorized on
+// every requested channel, and every requested publisher must be
+// connected to at least one of them, so that a multi-entity query
+// cannot widen access beyond the per-channel endpoint.
+func listMultiMessagesHandler(repo readers.MessageRepository, channels grpcChannelsV1.ChannelsServiceClient) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		session, ok := r.Context().Value(api.SessionKey).(smqauthn.Session)
+		if !ok {
+			api.EncodeError(r.Context(), svcerr.ErrAuthentication, w)
+			return
+		}
+		pm, err := decodePageMetadata(r)
+		if err != nil {
+			api.EncodeError(r.Context(), errors.Wrap(apiutil.ErrValidation, err), w)
+			return
+		}
+		if pm.Channels, err = readIDList(r, "channels"); err != nil {
+			api.EncodeError(r.Context(), errors.Wrap(apiutil.ErrValidation, err), w)
+			return
+		}
+		if pm.Publishers, err = readIDList(r, "publishers"); err != nil {
+			api.EncodeError(r.Context(), errors.Wrap(apiutil.ErrValidation, err), w)
+			return
+		}
+		if len(pm.Channels) == 0 {
+			api.EncodeError(r.Context(), errors.Wrap(apiutil.ErrValidation, apiutil.ErrMissingID), w)
+			return
+		}
+
+		if err := authorizeChannels(r, channels, session, pm.Channels); err != nil {
+			api.EncodeError(r.Context(), err, w)
+			return
+		}
+		for _, pubID := range pm.Publishers {
+			if !publisherConnected(r, channels, pubID, pm.Channels) {
+				api.EncodeError(r.Context(), svcerr.ErrAuthorization, w)
+				return
+			}
+		}
+
+		page, err := repo.ReadAll("", pm)
+		if err != nil {
+			api.EncodeError(r.Context(), errors.Wrap(svcerr.ErrViewEntity, err), w)
+			return
+		}
+
+		encodePage(w, r, page)
+	}
+}
+
+// sessionUserID returns the ID the session user acts under.
+func sessionUserID(session smqauthn.Session) string {
+	if session.DomainUserID != "" {
+		return session.DomainUserID
+	}
+	return session.UserID
+}
+
+// authorizeChannels checks that the session user is authorized to read
+// every one of the channels.
+func authorizeChannels(r *http.Request, channels grpcChannelsV1.ChannelsServiceClient, session smqauthn.Session, chanIDs []string) error {
+	userID := sessionUserID(session)
+	for _, chanID := range chanIDs {
+		res, err := channels.Authorize(r.Context(), &grpcChannelsV1.AuthzReq{
+			ChannelId:  chanID,
+			ClientId:   userID,
+			ClientType: policies.UserType,
+			Type:       uint32(connections.Subscribe),
+		})
+		if err != nil {
+			return errors.Wrap(svcerr.ErrAuthorization, err)
+		}
+		if !res.GetAuthorized() {
+			return svcerr.ErrAuthorization
+		}
+	}
+	return nil
+}
+
+// encodePage encodes the page as JSON, or as CSV when the request asks
+// for it through the Accept header.
+func encodePage(w http.ResponseWriter, r *http.Request, page readers.MessagesPage) {
+	if strings.HasPrefix(r.Header.Get("Accept"), csvContentType) {
+		w.Header().Set("Content-Type", csvContentType)
+		w.WriteHeader(http.StatusOK)
+		if err := readers.WriteCSV(w, page.Messages); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", api.ContentType)
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(page); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
+// publisherConnected reports whether the publisher is connected to at
+// least one of the channels.
+func publisherConnected(r *http.Request, channels grpcChannelsV1.ChannelsServiceClient, pubID string, chanIDs []string) bool {
+	for _, chanID := range chanIDs {
+		res, err := channels.Authorize(r.Context(), &grpcChannelsV1.AuthzReq{
+			ChannelId:  chanID,
+			ClientId:   pubID,
+			ClientType: policies.ClientType,
+			Type:       uint32(connections.Publish),
+		})
+		if err == nil && res.GetAuthorized() {
+			return true
+		}
+	}
+	return false
+}
+
+// readIDList reads a comma-separated list of IDs from the named query
+// parameter, dropping empty elements.
+func readIDList(r *http.Request, key string) ([]string, error) {
+	raw, err := apiutil.ReadStringQuery(r, key, "")
+	if err != nil {
+		return nil, err
+	}
+	ids := []string{}
+	for _, id := range strings.Split(raw, ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+func decodePageMetadata(r *http.Request) (readers.PageMetadata, error) {
+	offset, err := apiutil.ReadNumQuery[uint64](r, "offset", defOffset)
+	if err != nil {
+		return readers.PageMetadata{}, err
+	}
+	limit, err := apiutil.ReadNumQuery[uint64](r, "limit", defLimit)
+	if err != nil {
+		return readers.PageMetadata{}, err
+	}
+	subtopic, err := apiutil.ReadStringQuery(r, "subtopic", "")
+	if err != nil {
+		return readers.PageMetadata{}, err
+	}
+	publisher, err := apiutil.ReadStringQuery(r, "publisher", "")
+	if err != nil {
+		return readers.PageMetadata{}, err
+	}
+	protocol, err := apiutil.ReadStringQuery(r, "protocol", "")
+	if err != nil {
+		return readers.PageMetadata{}, err
+	}
+	name, err := apiutil.ReadStringQuery(r, "name", "")
+	if err != nil {
+		return readers.PageMetadata{}, err
+	}
+	from, err := apiutil.ReadNumQuery[float64](r, "from", 0)
+	if err != nil {
+		return readers.PageMetadata{}, err
+	}
+	to, err := apiutil.ReadNumQuery[float64](r, "to", 0)
+	if err != nil {
+		return readers.PageMetadata{}, err
+	}
+	vGT, err := apiutil.ReadNumQuery[float64](r, "v_gt", 0)
+	if err != nil {
+		return readers.PageMetadata{}, err
+	}
+	vLT, err := apiutil.ReadNumQuery[float64](r, "v_lt", 0)
+	if err != nil {
+		return readers.PageMetadata{}, err
+	}
+	stringValue, err := apiutil.ReadStringQuery(r, "string_value", "")
+	if err != nil {
+		return readers.PageMetadata{}, err
+	}
+	boolValue, err := apiutil.ReadBoolQuery(r, "bool_value", false)
+	if err != nil {
+		return readers.PageMetadata{}, err
+	}
+	cursor, err := apiutil.ReadNumQuery[float64](r, "cursor", 0)
+	if err != nil {
+		return readers.PageMetadata{}, err
+	}
+	aggregation, err := apiutil.ReadStringQuery(r, "aggregation", "")
+	if err != nil {
+		return readers.PageMetadata{}, err
+	}
+	// The resolution selects the cheapest rollup that satisfies it; the
+	// interval parameter is its backward-compatible alias.
+	resolution, err := apiutil.ReadStringQuery(r, "resolution", "")
+	if err != nil {
+		return readers.PageMetadata{}, err
+	}
+	if resolution == "" {
+		if resolution, err = apiutil.ReadStringQuery(r, "interval", ""); err != nil {
+			return readers.PageMetadata{}, err
+		}
+	}
+
+	return readers.PageMetadata{
+		Offset:      offset,
+		Limit:       limit,
+		Subtopic:    subtopic,
+		Publisher:   publisher,
+		Protocol:    protocol,
+		Name:        name,
+		From:        from,
+		To:          to,
+		ValueGT:     vGT,
+		ValueLT:     vLT,
+		StringValue: stringValue,
+		BoolValue:   boolValue,
+		Cursor:      cursor,
+		Aggregation: aggregation,
+		Interval:    resolution,
+	}, nil
+}
@@ -0,0 +1,340 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package readers
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	smq "github.com/absmach/supermq"
+	"github.com/absmach/supermq/pkg/errors"
+	svcerr "github.com/absmach/supermq/pkg/errors/service"
+	"github.com/absmach/supermq/pkg/transformers/senml"
+	"github.com/parquet-go/parquet-go"
+)
+
+// Export job statuses.
+const (
+	ExportRunning   = "running"
+	ExportCompleted = "completed"
+	ExportFailed    = "failed"
+)
+
+const exportPageLimit = uint64(1000)
+
+var (
+	errMissingExportChannel = errors.New("missing export channel")
+	errExportNotReady       = errors.NewWithCode(errors.CodeConflict, "export is not completed")
+)
+
+// ExportRequest designates the channels and time range of stored
+// messages written out as a Parquet file.
+type ExportRequest struct {
+	// Channels are the channels the exported messages were stored from.
+	Channels []string `json:"channels"`
+
+	// Subtopic optionally narrows the exported messages to one
+	// subtopic.
+	Subtopic string `json:"subtopic,omitempty"`
+
+	// From and To bound the exported time range, in seconds.
+	From float64 `json:"from,omitempty"`
+	To   float64 `json:"to,omitempty"`
+}
+
+// ExportJob is the state of one export run.
+type ExportJob struct {
+	ID       string `json:"id"`
+	Status   string `json:"status"`
+	Exported uint64 `json:"exported"`
+	Error    string `json:"error,omitempty"`
+
+	// UserID is the user that started the job. Only that user may
+	// inspect or download it.
+	UserID string `json:"user_id,omitempty"`
+
+	// Download is the path the finished file is served from. It is set
+	// once the job completes.
+	Download string `json:"download,omitempty"`
+
+	StartedAt time.Time     `json:"started_at"`
+	Request   ExportRequest `json:"request"`
+
+	file string
+}
+
+// Exporter writes stored messages out as Parquet files downloaded once
+// their jobs complete.
+type Exporter struct {
+	repo       MessageRepository
+	idProvider smq.IDProvider
+	dir        string
+
+	mu   sync.RWMutex
+	jobs map[string]*ExportJob
+}
+
+// NewExporter returns an exporter reading stored messages from the
+// given repository and writing Parquet files into the given directory.
+func NewExporter(repo MessageRepository, idProvider smq.IDProvider, dir string) *Exporter {
+	return &Exporter{
+		repo:       repo,
+		idProvider: idProvider,
+		dir:        dir,
+		jobs:       make(map[string]*ExportJob),
+	}
+}
+
+// Start starts an export job of the given user for the given request
+// and returns it. The file is written in the background.
+func (e *Exporter) Start(ctx context.Context, userID string, req ExportRequest) (ExportJob, error) {
+	if len(req.Channels) == 0 {
+		return ExportJob{}, errors.Wrap(svcerr.ErrMalformedEntity, errMissingExportChannel)
+	}
+
+	id, err := e.idProvider.ID()
+	if err != nil {
+		return ExportJob{}, err
+	}
+	job := &ExportJob{
+		ID:        id,
+		Status:    ExportRunning,
+		UserID:    userID,
+		StartedAt: time.Now(),
+		Request:   req,
+		file:      filepath.Join(e.dir, id+".parquet"),
+	}
+
+	e.mu.Lock()
+	e.jobs[id] = job
+	e.mu.Unlock()
+
+	// The job outlives the request that started it.
+	go e.export(context.WithoutCancel(ctx), job)
+
+	return *job, nil
+}
+
+// Job returns the export job with the given ID, provided it was
+// started by the given user.
+func (e *Exporter) Job(id, userID string) (ExportJob, error) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	job, ok := e.jobs[id]
+	if !ok {
+		return ExportJob{}, svcerr.ErrNotFound
+	}
+	if job.UserID != userID {
+		return ExportJob{}, svcerr.ErrAuthorization
+	}
+	return *job, nil
+}
+
+// File returns the path of the file written by a completed export job,
+// provided the job was started by the given user.
+func (e *Exporter) File(id, userID string) (string, error) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	job, ok := e.jobs[id]
+	if !ok {
+		return "", svcerr.ErrNotFound
+	}
+	if job.UserID != userID {
+		return "", svcerr.ErrAuthorization
+	}
+	if job.Status != ExportCompleted {
+		return "", errExportNotReady
+	}
+	return job.file, nil
+}
+
+func (e *Exporter) export(ctx context.Context, job *ExportJob) {
+	f, err := os.Create(job.file)
+	if err != nil {
+		e.finish(job, err)
+		return
+	}
+	w := parquet.NewGenericWriter[exportRow](f)
+
+	req := job.Request
+	pm := PageMetadata{
+		Limit:    exportPageLimit,
+		Channels: req.Channels,
+		Subtopic: req.Subtopic,
+		From:     req.From,
+		To:       req.To,
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			e.fail(job, f, ctx.Err())
+			return
+		default:
+		}
+
+		page, err := e.repo.ReadAll("", pm)
+		if err != nil {
+			e.fail(job, f, err)
+			return
+		}
+		rows := make([]exportRow, 0, len(page.Messages))
+		for _, m := range page.Messages {
+			rows = append(rows, exportRowOf(m))
+		}
+		if len(rows) > 0 {
+			if _, err := w.Write(rows); err != nil {
+				e.fail(job, f, err)
+				return
+			}
+		}
+
+		e.mu.Lock()
+		job.Exported += uint64(len(rows))
+		e.mu.Unlock()
+
+		if uint64(len(page.Messages)) < pm.Limit || page.NextCursor == 0 {
+			break
+		}
+		// Paginate by cursor so that deep exports do not rescan the
+		// already exported rows.
+		pm.Cursor = page.NextCursor
+	}
+
+	if err := w.Close(); err != nil {
+		e.fail(job, f, err)
+		return
+	}
+	if err := f.Close(); err != nil {
+		e.fail(job, nil, err)
+		return
+	}
+	e.finish(job, nil)
+}
+
+func (e *Exporter) fail(job *ExportJob, f *os.File, err error) {
+	if f != nil {
+		f.Close()
+	}
+	os.Remove(job.file)
+	e.finish(job, err)
+}
+
+func (e *Exporter) finish(job *ExportJob, err error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if err != nil {
+		job.Status = ExportFailed
+		job.Error = err.Error()
+		return
+	}
+	job.Status = ExportCompleted
+	job.Download = "/export/" + job.ID + "/download"
+}
+
+// exportRow is the flat form stored messages are exported in, shared by
+// the Parquet and CSV encodings.
+type exportRow struct {
+	Time        float64  `parquet:"time" json:"time"`
+	Channel     string   `parquet:"channel" json:"channel"`
+	Subtopic    string   `parquet:"subtopic" json:"subtopic"`
+	Publisher   string   `parquet:"publisher" json:"publisher"`
+	Protocol    string   `parquet:"protocol" json:"protocol"`
+	Name        string   `parquet:"name" json:"name"`
+	Unit        string   `parquet:"unit" json:"unit"`
+	Value       *float64 `parquet:"value,optional" json:"value"`
+	StringValue *string  `parquet:"string_value,optional" json:"string_value"`
+	BoolValue   *bool    `parquet:"bool_value,optional" json:"bool_value"`
+	DataValue   *string  `parquet:"data_value,optional" json:"data_value"`
+	Sum         *float64 `parquet:"sum,optional" json:"sum"`
+}
+
+// exportRowOf flattens a stored message. Messages of other formats are
+// funnelled through their JSON form into the SenML shape on a
+// best-effort basis.
+func exportRowOf(m Message) exportRow {
+	msg, ok := m.(senml.Message)
+	if !ok {
+		if raw, err := json.Marshal(m); err == nil {
+			_ = json.Unmarshal(raw, &msg)
+		}
+	}
+	return exportRow{
+		Time:        msg.Time,
+		Channel:     msg.Channel,
+		Subtopic:    msg.Subtopic,
+		Publisher:   msg.Publisher,
+		Protocol:    msg.Protocol,
+		Name:        msg.Name,
+		Unit:        msg.Unit,
+		Value:       msg.Value,
+		StringValue: msg.StringValue,
+		BoolValue:   msg.BoolValue,
+		DataValue:   msg.DataValue,
+		Sum:         msg.Sum,
+	}
+}
+
+var csvHeader = []string{
+	"time", "channel", "subtopic", "publisher", "protocol", "name",
+	"unit", "value", "string_value", "bool_value", "data_value", "sum",
+}
+
+// WriteCSV writes the messages out as CSV, one row per message, with
+// the columns of the header row. Unset values are left empty.
+func WriteCSV(w io.Writer, messages []Message) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvHeader); err != nil {
+		return err
+	}
+	for _, m := range messages {
+		row := exportRowOf(m)
+		record := []string{
+			strconv.FormatFloat(row.Time, 'f', -1, 64),
+			row.Channel,
+			row.Subtopic,
+			row.Publisher,
+			row.Protocol,
+			row.Name,
+			row.Unit,
+			csvFloat(row.Value),
+			csvString(row.StringValue),
+			csvBool(row.BoolValue),
+			csvString(row.DataValue),
+			csvFloat(row.Sum),
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func csvFloat(v *float64) string {
+	if v == nil {
+		return ""
+	}
+	return strconv.FormatFloat(*v, 'f', -1, 64)
+}
+
+func csvString(v *string) string {
+	if v == nil {
+		return ""
+	}
+	return *v
+}
+
+func csvBool(v *bool) string {
+	if v == nil {
+		return ""
+	}
+	return strconv.FormatBool(*v)
+}
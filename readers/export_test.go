@@ -0,0 +1,117 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package readers_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/absmach/supermq/pkg/errors"
+	svcerr "github.com/absmach/supermq/pkg/errors/service"
+	"github.com/absmach/supermq/pkg/uuid"
+	"github.com/absmach/supermq/readers"
+	"github.com/absmach/supermq/readers/mocks"
+	"github.com/parquet-go/parquet-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const exportUserID = "export-user"
+
+func waitExportStatus(t *testing.T, exporter *readers.Exporter, id, status string) readers.ExportJob {
+	var job readers.ExportJob
+	require.Eventually(t, func() bool {
+		var err error
+		job, err = exporter.Job(id, exportUserID)
+		require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+		return job.Status == status
+	}, time.Second, 5*time.Millisecond, "expected export job status %s", status)
+	return job
+}
+
+func TestExport(t *testing.T) {
+	repo := new(mocks.MessageRepository)
+	exporter := readers.NewExporter(repo, uuid.NewMock(), t.TempDir())
+
+	repo.On("ReadAll", "", mock.Anything).Return(readers.MessagesPage{Total: 3, Messages: storedMessages(3)}, nil)
+
+	job, err := exporter.Start(context.Background(), exportUserID, readers.ExportRequest{Channels: []string{chanID}, From: 0, To: 10})
+	require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+
+	job = waitExportStatus(t, exporter, job.ID, readers.ExportCompleted)
+	assert.Equal(t, uint64(3), job.Exported)
+	assert.Equal(t, "/export/"+job.ID+"/download", job.Download)
+	assert.Equal(t, exportUserID, job.UserID)
+
+	path, err := exporter.File(job.ID, exportUserID)
+	require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+
+	type row struct {
+		Time      float64 `parquet:"time"`
+		Channel   string  `parquet:"channel"`
+		Publisher string  `parquet:"publisher"`
+	}
+	rows, err := parquet.ReadFile[row](path)
+	require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+	require.Len(t, rows, 3)
+	assert.Equal(t, chanID, rows[0].Channel)
+	assert.Equal(t, "publisher", rows[0].Publisher)
+}
+
+func TestExportFailures(t *testing.T) {
+	repo := new(mocks.MessageRepository)
+	exporter := readers.NewExporter(repo, uuid.NewMock(), t.TempDir())
+
+	_, err := exporter.Start(context.Background(), exportUserID, readers.ExportRequest{})
+	assert.True(t, errors.Contains(err, svcerr.ErrMalformedEntity), fmt.Sprintf("expected error %v got %v", svcerr.ErrMalformedEntity, err))
+
+	repo.On("ReadAll", "", mock.Anything).Return(readers.MessagesPage{}, readers.ErrReadMessages)
+
+	job, err := exporter.Start(context.Background(), exportUserID, readers.ExportRequest{Channels: []string{chanID}})
+	require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+
+	job = waitExportStatus(t, exporter, job.ID, readers.ExportFailed)
+	assert.NotEmpty(t, job.Error)
+
+	_, err = exporter.File(job.ID, exportUserID)
+	assert.NotNil(t, err, "expected error downloading a failed export")
+
+	_, err = exporter.Job(job.ID, "other-user")
+	assert.True(t, errors.Contains(err, svcerr.ErrAuthorization), fmt.Sprintf("expected error %v got %v", svcerr.ErrAuthorization, err))
+
+	_, err = exporter.File(job.ID, "other-user")
+	assert.True(t, errors.Contains(err, svcerr.ErrAuthorization), fmt.Sprintf("expected error %v got %v", svcerr.ErrAuthorization, err))
+
+	_, err = exporter.Job("unknown", exportUserID)
+	assert.True(t, errors.Contains(err, svcerr.ErrNotFound), fmt.Sprintf("expected error %v got %v", svcerr.ErrNotFound, err))
+
+	_, err = exporter.File("unknown", exportUserID)
+	assert.True(t, errors.Contains(err, svcerr.ErrNotFound), fmt.Sprintf("expected error %v got %v", svcerr.ErrNotFound, err))
+}
+
+func TestWriteCSV(t *testing.T) {
+	messages := storedMessages(2)
+	messages = append(messages, map[string]interface{}{
+		"channel":      chanID,
+		"publisher":    "publisher",
+		"time":         float64(7),
+		"string_value": "on",
+	})
+
+	var buf bytes.Buffer
+	err := readers.WriteCSV(&buf, messages)
+	require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 4)
+	assert.Equal(t, "time,channel,subtopic,publisher,protocol,name,unit,value,string_value,bool_value,data_value,sum", lines[0])
+	assert.Contains(t, lines[1], "temperature")
+	assert.Contains(t, lines[1], "42")
+	assert.Contains(t, lines[3], "on")
+}
@@ -0,0 +1,44 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package lastvalue
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/absmach/supermq"
+	api "github.com/absmach/supermq/api/http"
+	smqauthn "github.com/absmach/supermq/pkg/authn"
+	"github.com/go-chi/chi/v5"
+)
+
+// MakeHandler returns a HTTP API handler with health check.
+func MakeHandler(cache Cache, authn smqauthn.Authentication, instanceID string) http.Handler {
+	mux := chi.NewRouter()
+
+	mux.Group(func(r chi.Router) {
+		r.Use(api.AuthenticateMiddleware(authn, false))
+		r.Get("/channels/{chanID}/messages/last", lastHandler(cache))
+	})
+
+	mux.Get("/health", supermq.Health("lastvalue", instanceID))
+
+	return mux
+}
+
+func lastHandler(cache Cache) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		values, err := cache.Last(r.Context(), chi.URLParam(r, "chanID"))
+		if err != nil {
+			api.EncodeError(r.Context(), err, w)
+			return
+		}
+
+		w.Header().Set("Content-Type", api.ContentType)
+		w.WriteHeader(http.StatusOK)
+		if err := json.NewEncoder(w).Encode(map[string]interface{}{"values": values}); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}
+}
@@ -0,0 +1,8 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package lastvalue provides a last-value cache populated from the
+// message stream. It keeps the most recent message of every publisher
+// per channel in a Redis hash, so UIs showing current sensor values can
+// read them without querying the time-series readers.
+package lastvalue
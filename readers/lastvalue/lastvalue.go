@@ -0,0 +1,70 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package lastvalue
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/absmach/supermq/pkg/messaging"
+)
+
+// Value is the last message of one publisher on a channel.
+type Value struct {
+	Channel   string          `json:"channel"`
+	Subtopic  string          `json:"subtopic,omitempty"`
+	Publisher string          `json:"publisher"`
+	Protocol  string          `json:"protocol"`
+	Payload   json.RawMessage `json:"payload"`
+	Time      float64         `json:"time"`
+}
+
+// Cache specifies the last-value cache API.
+type Cache interface {
+	// Update stores the message as the last value of its publisher on
+	// the channel.
+	Update(ctx context.Context, msg *messaging.Message) error
+
+	// Last retrieves the last values of all publishers on the channel.
+	Last(ctx context.Context, channelID string) ([]Value, error)
+}
+
+// NewMessageHandler returns a message handler that keeps the cache
+// up to date with the message stream.
+func NewMessageHandler(cache Cache) messaging.MessageHandler {
+	return messageHandler{cache: cache}
+}
+
+type messageHandler struct {
+	cache Cache
+}
+
+func (h messageHandler) Handle(msg *messaging.Message) error {
+	return h.cache.Update(context.Background(), msg)
+}
+
+func (h messageHandler) Cancel() error {
+	return nil
+}
+
+// newValue converts a message to a cache value. Payloads that are not
+// valid JSON are stored as base64-encoded strings.
+func newValue(msg *messaging.Message) Value {
+	payload := msg.GetPayload()
+	if !json.Valid(payload) {
+		encoded, err := json.Marshal(base64.StdEncoding.EncodeToString(payload))
+		if err == nil {
+			payload = encoded
+		}
+	}
+	return Value{
+		Channel:   msg.GetChannel(),
+		Subtopic:  msg.GetSubtopic(),
+		Publisher: msg.GetPublisher(),
+		Protocol:  msg.GetProtocol(),
+		Payload:   payload,
+		Time:      float64(msg.GetCreated()) / 1e9,
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package lastvalue
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/absmach/supermq/pkg/errors"
+	repoerr "github.com/absmach/supermq/pkg/errors/repository"
+	"github.com/absmach/supermq/pkg/messaging"
+	"github.com/redis/go-redis/v9"
+)
+
+const keyPrefix = "last_value"
+
+var _ Cache = (*lastValueCache)(nil)
+
+type lastValueCache struct {
+	client redis.UniversalClient
+}
+
+// NewCache returns redis last-value cache implementation.
+func NewCache(client redis.UniversalClient) Cache {
+	return &lastValueCache{client: client}
+}
+
+func (lc *lastValueCache) Update(ctx context.Context, msg *messaging.Message) error {
+	if msg.GetChannel() == "" || msg.GetPublisher() == "" {
+		return errors.Wrap(repoerr.ErrCreateEntity, errors.New("channel or publisher is empty"))
+	}
+	data, err := json.Marshal(newValue(msg))
+	if err != nil {
+		return errors.Wrap(repoerr.ErrCreateEntity, err)
+	}
+
+	key := fmt.Sprintf("%s:%s", keyPrefix, msg.GetChannel())
+	field := msg.GetPublisher()
+	if msg.GetSubtopic() != "" {
+		field = fmt.Sprintf("%s:%s", field, msg.GetSubtopic())
+	}
+	if err := lc.client.HSet(ctx, key, field, data).Err(); err != nil {
+		return errors.Wrap(repoerr.ErrCreateEntity, err)
+	}
+
+	return nil
+}
+
+func (lc *lastValueCache) Last(ctx context.Context, channelID string) ([]Value, error) {
+	key := fmt.Sprintf("%s:%s", keyPrefix, channelID)
+	fields, err := lc.client.HGetAll(ctx, key).Result()
+	if err != nil {
+		return nil, errors.Wrap(repoerr.ErrViewEntity, err)
+	}
+
+	values := []Value{}
+	for _, data := range fields {
+		var value Value
+		if err := json.Unmarshal([]byte(data), &value); err != nil {
+			return nil, errors.Wrap(repoerr.ErrViewEntity, err)
+		}
+		values = append(values, value)
+	}
+	sort.Slice(values, func(i, j int) bool {
+		if values[i].Publisher != values[j].Publisher {
+			return values[i].Publisher < values[j].Publisher
+		}
+		return values[i].Subtopic < values[j].Subtopic
+	})
+
+	return values, nil
+}
@@ -26,7 +26,8 @@ var ErrReadMessages = errors.New("failed to read messages from database")
 //go:generate mockery --name MessageRepository --output=./mocks --filename messages.go --quiet --note "Copyright (c) Abstract Machines"
 type MessageRepository interface {
 	// ReadAll skips given number of messages for given channel and returns next
-	// limited number of messages.
+	// limited number of messages. An empty chanID reads across the channels of
+	// the Channels filter instead, interleaved in time order.
 	ReadAll(chanID string, pm PageMetadata) (MessagesPage, error)
 }
 
@@ -39,6 +40,9 @@ type MessagesPage struct {
 	PageMetadata
 	Total    uint64
 	Messages []Message
+	// NextCursor is the time of the last message of the page, passed
+	// back as the cursor of the next one. It is unset on the last page.
+	NextCursor float64 `json:"next_cursor,omitempty"`
 }
 
 // PageMetadata represents the parameters used to create database queries.
@@ -59,6 +63,17 @@ type PageMetadata struct {
 	Format      string  `json:"format,omitempty"`
 	Aggregation string  `json:"aggregation,omitempty"`
 	Interval    string  `json:"interval,omitempty"`
+	// Channels and Publishers restrict multi-entity reads to the given
+	// channel and publisher IDs.
+	Channels   []string `json:"channels,omitempty"`
+	Publishers []string `json:"publishers,omitempty"`
+	// ValueGT and ValueLT bound the numeric value of the messages.
+	ValueGT float64 `json:"v_gt,omitempty"`
+	ValueLT float64 `json:"v_lt,omitempty"`
+	// Cursor is the time of the last message of the previous page; the
+	// page continues strictly before it, so deep pagination does not
+	// scan the skipped rows the way offset does.
+	Cursor float64 `json:"cursor,omitempty"`
 }
 
 // ParseValueComparator convert comparison operator keys into mathematic anotation.
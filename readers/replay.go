@@ -0,0 +1,227 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package readers
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	smq "github.com/absmach/supermq"
+	"github.com/absmach/supermq/pkg/errors"
+	svcerr "github.com/absmach/supermq/pkg/errors/service"
+	"github.com/absmach/supermq/pkg/messaging"
+	"github.com/absmach/supermq/pkg/transformers/senml"
+)
+
+// Replay job statuses.
+const (
+	ReplayRunning   = "running"
+	ReplayCompleted = "completed"
+	ReplayFailed    = "failed"
+)
+
+const (
+	replayProtocol  = "replay"
+	replayPageLimit = uint64(100)
+)
+
+var errMissingReplayChannel = errors.New("missing replay channel")
+
+// ReplayRequest designates the time range of stored messages
+// republished back onto a channel.
+type ReplayRequest struct {
+	// ChannelID is the channel the messages were stored from.
+	ChannelID string `json:"channel_id"`
+
+	// Subtopic optionally narrows the replayed messages to one
+	// subtopic.
+	Subtopic string `json:"subtopic,omitempty"`
+
+	// From and To bound the replayed time range, in seconds.
+	From float64 `json:"from,omitempty"`
+	To   float64 `json:"to,omitempty"`
+
+	// TargetChannel is the channel messages are republished to. It
+	// defaults to the source channel.
+	TargetChannel string `json:"target_channel,omitempty"`
+
+	// TargetSubtopic overrides the subtopic messages are republished
+	// under.
+	TargetSubtopic string `json:"target_subtopic,omitempty"`
+
+	// Speed is the replay pace: 1 replays at the original pace,
+	// higher values accelerate it, and 0 replays without pacing.
+	Speed float64 `json:"speed,omitempty"`
+}
+
+// ReplayJob is the state of one replay run.
+type ReplayJob struct {
+	ID       string `json:"id"`
+	Status   string `json:"status"`
+	Replayed uint64 `json:"replayed"`
+	Error    string `json:"error,omitempty"`
+
+	// UserID is the user that started the job. Only that user may
+	// inspect it.
+	UserID string `json:"user_id,omitempty"`
+
+	StartedAt time.Time     `json:"started_at"`
+	Request   ReplayRequest `json:"request"`
+}
+
+// Replayer republishes stored messages back onto channels.
+type Replayer struct {
+	repo       MessageRepository
+	publisher  messaging.Publisher
+	idProvider smq.IDProvider
+
+	mu   sync.RWMutex
+	jobs map[string]*ReplayJob
+}
+
+// NewReplayer returns a replayer reading stored messages from the
+// given repository and republishing them with the given publisher.
+func NewReplayer(repo MessageRepository, publisher messaging.Publisher, idProvider smq.IDProvider) *Replayer {
+	return &Replayer{
+		repo:       repo,
+		publisher:  publisher,
+		idProvider: idProvider,
+		jobs:       make(map[string]*ReplayJob),
+	}
+}
+
+// Start starts a replay job of the given user for the given request
+// and returns it. The messages are republished in the background.
+func (r *Replayer) Start(ctx context.Context, userID string, req ReplayRequest) (ReplayJob, error) {
+	if req.ChannelID == "" {
+		return ReplayJob{}, errors.Wrap(svcerr.ErrMalformedEntity, errMissingReplayChannel)
+	}
+	if req.TargetChannel == "" {
+		req.TargetChannel = req.ChannelID
+	}
+
+	id, err := r.idProvider.ID()
+	if err != nil {
+		return ReplayJob{}, err
+	}
+	job := &ReplayJob{
+		ID:        id,
+		Status:    ReplayRunning,
+		UserID:    userID,
+		StartedAt: time.Now(),
+		Request:   req,
+	}
+
+	r.mu.Lock()
+	r.jobs[id] = job
+	r.mu.Unlock()
+
+	// The job outlives the request that started it.
+	go r.replay(context.WithoutCancel(ctx), job)
+
+	return *job, nil
+}
+
+// Job returns the replay job with the given ID, provided it was
+// started by the given user.
+func (r *Replayer) Job(id, userID string) (ReplayJob, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	job, ok := r.jobs[id]
+	if !ok {
+		return ReplayJob{}, svcerr.ErrNotFound
+	}
+	if job.UserID != userID {
+		return ReplayJob{}, svcerr.ErrAuthorization
+	}
+	return *job, nil
+}
+
+func (r *Replayer) replay(ctx context.Context, job *ReplayJob) {
+	req := job.Request
+	pm := PageMetadata{
+		Limit:    replayPageLimit,
+		Subtopic: req.Subtopic,
+		From:     req.From,
+		To:       req.To,
+	}
+
+	prev := float64(0)
+	for {
+		page, err := r.repo.ReadAll(req.ChannelID, pm)
+		if err != nil {
+			r.finish(job, err)
+			return
+		}
+		for _, m := range page.Messages {
+			payload, at, err := replayPayload(m)
+			if err != nil {
+				r.finish(job, err)
+				return
+			}
+			if req.Speed > 0 && prev > 0 && at > prev {
+				select {
+				case <-ctx.Done():
+					r.finish(job, ctx.Err())
+					return
+				case <-time.After(time.Duration((at - prev) / req.Speed * float64(time.Second))):
+				}
+			}
+			if at > 0 {
+				prev = at
+			}
+
+			msg := messaging.Message{
+				Protocol: replayProtocol,
+				Channel:  req.TargetChannel,
+				Subtopic: req.TargetSubtopic,
+				Payload:  payload,
+				Created:  time.Now().UnixNano(),
+			}
+			if err := r.publisher.Publish(ctx, req.TargetChannel, &msg); err != nil {
+				r.finish(job, err)
+				return
+			}
+
+			r.mu.Lock()
+			job.Replayed++
+			r.mu.Unlock()
+		}
+
+		if uint64(len(page.Messages)) < pm.Limit {
+			r.finish(job, nil)
+			return
+		}
+		pm.Offset += pm.Limit
+	}
+}
+
+func (r *Replayer) finish(job *ReplayJob, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err != nil {
+		job.Status = ReplayFailed
+		job.Error = err.Error()
+		return
+	}
+	job.Status = ReplayCompleted
+}
+
+// replayPayload marshals a stored message back into a publishable
+// payload and returns its stored time in seconds, when known.
+func replayPayload(m Message) ([]byte, float64, error) {
+	at := float64(0)
+	switch msg := m.(type) {
+	case senml.Message:
+		at = msg.Time
+	case map[string]interface{}:
+		if t, ok := msg["time"].(float64); ok {
+			at = t
+		}
+	}
+	payload, err := json.Marshal(m)
+	return payload, at, err
+}
@@ -0,0 +1,123 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package readers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	grpcChannelsV1 "github.com/absmach/supermq/api/grpc/channels/v1"
+	api "github.com/absmach/supermq/api/http"
+	apiutil "github.com/absmach/supermq/api/http/util"
+	smqauthn "github.com/absmach/supermq/pkg/authn"
+	"github.com/absmach/supermq/pkg/connections"
+	"github.com/absmach/supermq/pkg/errors"
+	svcerr "github.com/absmach/supermq/pkg/errors/service"
+	"github.com/absmach/supermq/pkg/policies"
+	"github.com/go-chi/chi/v5"
+)
+
+// MakeReplayHandler returns a HTTP API handler for starting and
+// inspecting replay jobs. The routes are mount-relative, for mounting
+// under /replay in reader services.
+func MakeReplayHandler(replayer *Replayer, authn smqauthn.Authentication, channels grpcChannelsV1.ChannelsServiceClient) http.Handler {
+	mux := chi.NewRouter()
+
+	mux.Group(func(r chi.Router) {
+		r.Use(api.AuthenticateMiddleware(authn, false))
+		r.Post("/", startReplayHandler(replayer, channels))
+		r.Get("/{jobID}", replayJobHandler(replayer))
+	})
+
+	return mux
+}
+
+func startReplayHandler(replayer *Replayer, channels grpcChannelsV1.ChannelsServiceClient) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		session, ok := r.Context().Value(api.SessionKey).(smqauthn.Session)
+		if !ok {
+			api.EncodeError(r.Context(), svcerr.ErrAuthentication, w)
+			return
+		}
+		var req ReplayRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			api.EncodeError(r.Context(), errors.Wrap(apiutil.ErrValidation, err), w)
+			return
+		}
+		if err := authorizeReplay(r, channels, session, req); err != nil {
+			api.EncodeError(r.Context(), err, w)
+			return
+		}
+		job, err := replayer.Start(r.Context(), replayUserID(session), req)
+		if err != nil {
+			api.EncodeError(r.Context(), err, w)
+			return
+		}
+		encodeReplayJSON(w, http.StatusAccepted, job)
+	}
+}
+
+// authorizeReplay checks that the session user may read the source
+// channel and publish to the target channel of the replay.
+func authorizeReplay(r *http.Request, channels grpcChannelsV1.ChannelsServiceClient, session smqauthn.Session, req ReplayRequest) error {
+	userID := replayUserID(session)
+	target := req.TargetChannel
+	if target == "" {
+		target = req.ChannelID
+	}
+	checks := []struct {
+		chanID string
+		conn   connections.ConnType
+	}{
+		{req.ChannelID, connections.Subscribe},
+		{target, connections.Publish},
+	}
+	for _, check := range checks {
+		res, err := channels.Authorize(r.Context(), &grpcChannelsV1.AuthzReq{
+			ChannelId:  check.chanID,
+			ClientId:   userID,
+			ClientType: policies.UserType,
+			Type:       uint32(check.conn),
+		})
+		if err != nil {
+			return errors.Wrap(svcerr.ErrAuthorization, err)
+		}
+		if !res.GetAuthorized() {
+			return svcerr.ErrAuthorization
+		}
+	}
+	return nil
+}
+
+func replayJobHandler(replayer *Replayer) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		session, ok := r.Context().Value(api.SessionKey).(smqauthn.Session)
+		if !ok {
+			api.EncodeError(r.Context(), svcerr.ErrAuthentication, w)
+			return
+		}
+		job, err := replayer.Job(chi.URLParam(r, "jobID"), replayUserID(session))
+		if err != nil {
+			api.EncodeError(r.Context(), err, w)
+			return
+		}
+		encodeReplayJSON(w, http.StatusOK, job)
+	}
+}
+
+// replayUserID returns the ID the session user acts under.
+func replayUserID(session smqauthn.Session) string {
+	if session.DomainUserID != "" {
+		return session.DomainUserID
+	}
+	return session.UserID
+}
+
+func encodeReplayJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", api.ContentType)
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package readers_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/absmach/supermq/pkg/errors"
+	svcerr "github.com/absmach/supermq/pkg/errors/service"
+	"github.com/absmach/supermq/pkg/messaging"
+	pubsubmocks "github.com/absmach/supermq/pkg/messaging/mocks"
+	"github.com/absmach/supermq/pkg/transformers/senml"
+	"github.com/absmach/supermq/pkg/uuid"
+	"github.com/absmach/supermq/readers"
+	"github.com/absmach/supermq/readers/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	chanID       = "channel"
+	replayUserID = "replay-user"
+)
+
+func storedMessages(total int) []readers.Message {
+	messages := []readers.Message{}
+	value := float64(42)
+	for i := 0; i < total; i++ {
+		messages = append(messages, senml.Message{
+			Channel:   chanID,
+			Publisher: "publisher",
+			Name:      "temperature",
+			Time:      float64(i),
+			Value:     &value,
+		})
+	}
+	return messages
+}
+
+func waitStatus(t *testing.T, replayer *readers.Replayer, id, status string) readers.ReplayJob {
+	var job readers.ReplayJob
+	require.Eventually(t, func() bool {
+		var err error
+		job, err = replayer.Job(id, replayUserID)
+		require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+		return job.Status == status
+	}, time.Second, 5*time.Millisecond, "expected replay job status %s", status)
+	return job
+}
+
+func TestReplay(t *testing.T) {
+	repo := new(mocks.MessageRepository)
+	pubsub := new(pubsubmocks.PubSub)
+	replayer := readers.NewReplayer(repo, pubsub, uuid.NewMock())
+
+	messages := storedMessages(3)
+	repo.On("ReadAll", chanID, mock.Anything).Return(readers.MessagesPage{Total: 3, Messages: messages}, nil)
+	pubsub.On("Publish", mock.Anything, chanID, mock.Anything).Return(nil)
+
+	job, err := replayer.Start(context.Background(), replayUserID, readers.ReplayRequest{ChannelID: chanID, From: 0, To: 10})
+	require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+
+	job = waitStatus(t, replayer, job.ID, readers.ReplayCompleted)
+	assert.Equal(t, uint64(3), job.Replayed)
+	assert.Equal(t, replayUserID, job.UserID)
+	pubsub.AssertNumberOfCalls(t, "Publish", 3)
+}
+
+func TestReplayToTarget(t *testing.T) {
+	repo := new(mocks.MessageRepository)
+	pubsub := new(pubsubmocks.PubSub)
+	replayer := readers.NewReplayer(repo, pubsub, uuid.NewMock())
+
+	repo.On("ReadAll", chanID, mock.Anything).Return(readers.MessagesPage{Total: 1, Messages: storedMessages(1)}, nil)
+	pubsub.On("Publish", mock.Anything, "target", mock.MatchedBy(func(msg *messaging.Message) bool {
+		return msg.GetChannel() == "target" && msg.GetSubtopic() == "replayed"
+	})).Return(nil)
+
+	job, err := replayer.Start(context.Background(), replayUserID, readers.ReplayRequest{ChannelID: chanID, TargetChannel: "target", TargetSubtopic: "replayed"})
+	require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+
+	waitStatus(t, replayer, job.ID, readers.ReplayCompleted)
+	pubsub.AssertNumberOfCalls(t, "Publish", 1)
+}
+
+func TestReplayFailures(t *testing.T) {
+	repo := new(mocks.MessageRepository)
+	pubsub := new(pubsubmocks.PubSub)
+	replayer := readers.NewReplayer(repo, pubsub, uuid.NewMock())
+
+	_, err := replayer.Start(context.Background(), replayUserID, readers.ReplayRequest{})
+	assert.True(t, errors.Contains(err, svcerr.ErrMalformedEntity), fmt.Sprintf("expected error %v got %v", svcerr.ErrMalformedEntity, err))
+
+	repo.On("ReadAll", chanID, mock.Anything).Return(readers.MessagesPage{}, readers.ErrReadMessages)
+
+	job, err := replayer.Start(context.Background(), replayUserID, readers.ReplayRequest{ChannelID: chanID})
+	require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+
+	job = waitStatus(t, replayer, job.ID, readers.ReplayFailed)
+	assert.NotEmpty(t, job.Error)
+
+	_, err = replayer.Job(job.ID, "other-user")
+	assert.True(t, errors.Contains(err, svcerr.ErrAuthorization), fmt.Sprintf("expected error %v got %v", svcerr.ErrAuthorization, err))
+
+	_, err = replayer.Job("unknown", replayUserID)
+	assert.True(t, errors.Contains(err, svcerr.ErrNotFound), fmt.Sprintf("expected error %v got %v", svcerr.ErrNotFound, err))
+}
@@ -0,0 +1,69 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package readers
+
+import (
+	"context"
+	"time"
+
+	"github.com/absmach/supermq/pkg/errors"
+	svcerr "github.com/absmach/supermq/pkg/errors/service"
+)
+
+var (
+	errMissingRetentionChannel = errors.New("missing retention channel")
+	errInvalidRetention        = errors.New("invalid retention duration")
+)
+
+// RetentionPolicy configures how long the stored messages of one
+// channel are kept. Durations are Go duration strings, e.g. "720h"; an
+// unset duration keeps its rows forever.
+type RetentionPolicy struct {
+	ChannelID string `json:"channel_id"`
+
+	// Raw is how long raw messages are kept.
+	Raw string `json:"raw,omitempty"`
+
+	// Rollups is how long the rollup rows are kept.
+	Rollups string `json:"rollups,omitempty"`
+}
+
+// Validate checks that the policy addresses a channel and that its set
+// durations parse to positive values.
+func (p RetentionPolicy) Validate() error {
+	if p.ChannelID == "" {
+		return errors.Wrap(svcerr.ErrMalformedEntity, errMissingRetentionChannel)
+	}
+	for _, retention := range []string{p.Raw, p.Rollups} {
+		if retention == "" {
+			continue
+		}
+		d, err := time.ParseDuration(retention)
+		if err != nil {
+			return errors.Wrap(svcerr.ErrMalformedEntity, errors.Wrap(errInvalidRetention, err))
+		}
+		if d <= 0 {
+			return errors.Wrap(svcerr.ErrMalformedEntity, errInvalidRetention)
+		}
+	}
+	return nil
+}
+
+// RetentionRepository persists per-channel retention policies. The
+// policies are managed through the reader API and enforced by the
+// writer backends.
+type RetentionRepository interface {
+	// SaveRetention persists the policy, replacing an earlier policy of
+	// the same channel.
+	SaveRetention(ctx context.Context, policy RetentionPolicy) error
+
+	// RetrieveRetention returns the policy of the given channel.
+	RetrieveRetention(ctx context.Context, chanID string) (RetentionPolicy, error)
+
+	// ListRetention returns all persisted policies.
+	ListRetention(ctx context.Context) ([]RetentionPolicy, error)
+
+	// RemoveRetention removes the policy of the given channel.
+	RemoveRetention(ctx context.Context, chanID string) error
+}
@@ -0,0 +1,52 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package readers_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/absmach/supermq/pkg/errors"
+	svcerr "github.com/absmach/supermq/pkg/errors/service"
+	"github.com/absmach/supermq/readers"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetentionPolicyValidate(t *testing.T) {
+	cases := []struct {
+		desc   string
+		policy readers.RetentionPolicy
+		err    error
+	}{
+		{
+			desc:   "valid policy",
+			policy: readers.RetentionPolicy{ChannelID: chanID, Raw: "720h", Rollups: "8760h"},
+		},
+		{
+			desc:   "unset durations keep rows forever",
+			policy: readers.RetentionPolicy{ChannelID: chanID},
+		},
+		{
+			desc:   "missing channel",
+			policy: readers.RetentionPolicy{Raw: "720h"},
+			err:    svcerr.ErrMalformedEntity,
+		},
+		{
+			desc:   "unparsable duration",
+			policy: readers.RetentionPolicy{ChannelID: chanID, Raw: "thirty days"},
+			err:    svcerr.ErrMalformedEntity,
+		},
+		{
+			desc:   "negative duration",
+			policy: readers.RetentionPolicy{ChannelID: chanID, Rollups: "-1h"},
+			err:    svcerr.ErrMalformedEntity,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.policy.Validate()
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("expected error %v got %v", tc.err, err))
+		})
+	}
+}
@@ -0,0 +1,7 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package timescale contains the Timescale message reader. Queries with
+// a resolution transparently read the cheapest continuous aggregate
+// maintained by the Timescale writer instead of the raw hypertable.
+package timescale
@@ -0,0 +1,208 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package timescale
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/absmach/supermq/pkg/errors"
+	"github.com/absmach/supermq/pkg/transformers/senml"
+	"github.com/absmach/supermq/readers"
+	"github.com/jmoiron/sqlx"
+)
+
+type rollup struct {
+	view  string
+	width time.Duration
+}
+
+// rollups are the continuous aggregates maintained by the Timescale
+// writer, ordered from coarsest to finest so that selection picks the
+// cheapest one that still satisfies the requested resolution.
+var rollups = []rollup{
+	{view: "messages_1h", width: time.Hour},
+	{view: "messages_5m", width: 5 * time.Minute},
+	{view: "messages_1m", width: time.Minute},
+}
+
+// aggregations maps the aggregation parameter to the rollup columns.
+var aggregations = map[string]string{
+	"":      "value_avg",
+	"avg":   "value_avg",
+	"min":   "value_min",
+	"max":   "value_max",
+	"sum":   "value_sum",
+	"count": "msg_count",
+}
+
+var _ readers.MessageRepository = (*timescaleRepo)(nil)
+
+type timescaleRepo struct {
+	db *sqlx.DB
+}
+
+// New returns new Timescale reader.
+func New(db *sqlx.DB) readers.MessageRepository {
+	return &timescaleRepo{db: db}
+}
+
+func (tr *timescaleRepo) ReadAll(chanID string, pm readers.PageMetadata) (readers.MessagesPage, error) {
+	if view, ok := selectRollup(pm.Interval); ok {
+		return tr.read(chanID, pm, view, "bucket", aggregations[pm.Aggregation])
+	}
+	return tr.read(chanID, pm, "messages", "time", "value")
+}
+
+// selectRollup returns the coarsest rollup that still satisfies the
+// requested resolution. Queries without a resolution, with one finer
+// than any rollup, or with an unknown aggregation read the raw table.
+func selectRollup(resolution string) (string, bool) {
+	if resolution == "" {
+		return "", false
+	}
+	width, err := time.ParseDuration(resolution)
+	if err != nil {
+		return "", false
+	}
+	for _, r := range rollups {
+		if r.width <= width {
+			return r.view, true
+		}
+	}
+	return "", false
+}
+
+func (tr *timescaleRepo) read(chanID string, pm readers.PageMetadata, table, timeCol, valueCol string) (readers.MessagesPage, error) {
+	if _, ok := aggregations[pm.Aggregation]; !ok {
+		return readers.MessagesPage{}, errors.Wrap(readers.ErrReadMessages, errors.New("unknown aggregation "+pm.Aggregation))
+	}
+	if chanID == "" && len(pm.Channels) == 0 {
+		return readers.MessagesPage{}, errors.Wrap(readers.ErrReadMessages, errors.New("missing channel"))
+	}
+	if table != "messages" && (pm.StringValue != "" || pm.BoolValue) {
+		return readers.MessagesPage{}, errors.Wrap(readers.ErrReadMessages, errors.New("string and boolean filters require raw reads"))
+	}
+
+	params := map[string]interface{}{
+		"channel":      chanID,
+		"limit":        pm.Limit,
+		"offset":       pm.Offset,
+		"subtopic":     pm.Subtopic,
+		"publisher":    pm.Publisher,
+		"protocol":     pm.Protocol,
+		"name":         pm.Name,
+		"from":         int64(pm.From * 1e9),
+		"to":           int64(pm.To * 1e9),
+		"v_gt":         pm.ValueGT,
+		"v_lt":         pm.ValueLT,
+		"string_value": pm.StringValue,
+		"bool_value":   pm.BoolValue,
+		"cursor":       int64(pm.Cursor * 1e9),
+	}
+	chanCond := "channel = :channel"
+	if chanID == "" {
+		chanCond = inCondition("channel", pm.Channels, params)
+	}
+	condition := fmtCondition(pm, timeCol, valueCol)
+	if len(pm.Publishers) > 0 {
+		condition = fmt.Sprintf("%s AND %s", condition, inCondition("publisher", pm.Publishers, params))
+	}
+
+	q := fmt.Sprintf(`SELECT %s / 1000000000.0 AS time, channel, subtopic, publisher,
+        protocol, name, unit, %s AS value FROM %s
+        WHERE %s %s ORDER BY %s DESC LIMIT :limit OFFSET :offset;`,
+		timeCol, valueCol, table, chanCond, condition, timeCol)
+
+	rows, err := tr.db.NamedQuery(q, params)
+	if err != nil {
+		return readers.MessagesPage{}, errors.Wrap(readers.ErrReadMessages, err)
+	}
+	defer rows.Close()
+
+	page := readers.MessagesPage{
+		PageMetadata: pm,
+		Messages:     []readers.Message{},
+	}
+	for rows.Next() {
+		msg := senml.Message{}
+		if err := rows.StructScan(&msg); err != nil {
+			return readers.MessagesPage{}, errors.Wrap(readers.ErrReadMessages, err)
+		}
+		page.Messages = append(page.Messages, msg)
+	}
+	// A full page may have more rows behind it, so hand out the time of
+	// its last message as the cursor of the next one.
+	if uint64(len(page.Messages)) == pm.Limit && pm.Limit > 0 {
+		if last, ok := page.Messages[len(page.Messages)-1].(senml.Message); ok {
+			page.NextCursor = last.Time
+		}
+	}
+
+	total := fmt.Sprintf(`SELECT COUNT(*) FROM %s WHERE %s %s;`, table, chanCond, condition)
+	totalRows, err := tr.db.NamedQuery(total, params)
+	if err != nil {
+		return readers.MessagesPage{}, errors.Wrap(readers.ErrReadMessages, err)
+	}
+	defer totalRows.Close()
+	if totalRows.Next() {
+		if err := totalRows.Scan(&page.Total); err != nil {
+			return readers.MessagesPage{}, errors.Wrap(readers.ErrReadMessages, err)
+		}
+	}
+
+	return page, nil
+}
+
+// inCondition builds an IN condition over the given IDs, adding one
+// named parameter per ID to params, so that the IDs never end up
+// interpolated into the query text.
+func inCondition(field string, ids []string, params map[string]interface{}) string {
+	names := make([]string, 0, len(ids))
+	for i, id := range ids {
+		name := fmt.Sprintf("%s_%d", field, i)
+		params[name] = id
+		names = append(names, ":"+name)
+	}
+	return fmt.Sprintf("%s IN (%s)", field, strings.Join(names, ", "))
+}
+
+// fmtCondition builds the filter condition from the set page metadata
+// fields. The metadata is inspected through its JSON form, so that only
+// explicitly set fields produce conditions.
+func fmtCondition(pm readers.PageMetadata, timeCol, valueCol string) string {
+	condition := ""
+	meta, err := json.Marshal(pm)
+	if err != nil {
+		return condition
+	}
+	query := map[string]interface{}{}
+	if err := json.Unmarshal(meta, &query); err != nil {
+		return condition
+	}
+
+	for name := range query {
+		switch name {
+		case "subtopic", "publisher", "protocol", "name":
+			condition = fmt.Sprintf(`%s AND %s = :%s`, condition, name, name)
+		case "from":
+			condition = fmt.Sprintf(`%s AND %s >= :from`, condition, timeCol)
+		case "to":
+			condition = fmt.Sprintf(`%s AND %s < :to`, condition, timeCol)
+		case "v_gt":
+			condition = fmt.Sprintf(`%s AND %s > :v_gt`, condition, valueCol)
+		case "v_lt":
+			condition = fmt.Sprintf(`%s AND %s < :v_lt`, condition, valueCol)
+		case "vs":
+			condition = fmt.Sprintf(`%s AND string_value = :string_value`, condition)
+		case "vb":
+			condition = fmt.Sprintf(`%s AND bool_value = :bool_value`, condition)
+		case "cursor":
+			condition = fmt.Sprintf(`%s AND %s < :cursor`, condition, timeCol)
+		}
+	}
+	return condition
+}
@@ -0,0 +1,102 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package timescale
+
+import (
+	"testing"
+
+	"github.com/absmach/supermq/readers"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSelectRollup(t *testing.T) {
+	cases := []struct {
+		desc       string
+		resolution string
+		view       string
+		ok         bool
+	}{
+		{
+			desc:       "no resolution reads raw table",
+			resolution: "",
+		},
+		{
+			desc:       "invalid resolution reads raw table",
+			resolution: "fast",
+		},
+		{
+			desc:       "resolution finer than any rollup reads raw table",
+			resolution: "10s",
+		},
+		{
+			desc:       "one minute resolution",
+			resolution: "1m",
+			view:       "messages_1m",
+			ok:         true,
+		},
+		{
+			desc:       "resolution between rollups picks the cheapest one below it",
+			resolution: "30m",
+			view:       "messages_5m",
+			ok:         true,
+		},
+		{
+			desc:       "coarse resolution picks the hourly rollup",
+			resolution: "24h",
+			view:       "messages_1h",
+			ok:         true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			view, ok := selectRollup(tc.resolution)
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.view, view)
+		})
+	}
+}
+
+func TestFmtCondition(t *testing.T) {
+	cases := []struct {
+		desc     string
+		pm       readers.PageMetadata
+		contains []string
+	}{
+		{
+			desc:     "value bounds",
+			pm:       readers.PageMetadata{ValueGT: 10, ValueLT: 20},
+			contains: []string{"value > :v_gt", "value < :v_lt"},
+		},
+		{
+			desc:     "string and boolean values",
+			pm:       readers.PageMetadata{StringValue: "on", BoolValue: true},
+			contains: []string{"string_value = :string_value", "bool_value = :bool_value"},
+		},
+		{
+			desc:     "cursor",
+			pm:       readers.PageMetadata{Cursor: 42},
+			contains: []string{"time < :cursor"},
+		},
+		{
+			desc:     "time range",
+			pm:       readers.PageMetadata{From: 1, To: 2},
+			contains: []string{"time >= :from", "time < :to"},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			condition := fmtCondition(tc.pm, "time", "value")
+			for _, c := range tc.contains {
+				assert.Contains(t, condition, c)
+			}
+		})
+	}
+}
+
+func TestInCondition(t *testing.T) {
+	params := map[string]interface{}{}
+	cond := inCondition("channel", []string{"c1", "c2"}, params)
+	assert.Equal(t, "channel IN (:channel_0, :channel_1)", cond)
+	assert.Equal(t, map[string]interface{}{"channel_0": "c1", "channel_1": "c2"}, params)
+}
@@ -0,0 +1,111 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package timescale
+
+import (
+	"context"
+
+	"github.com/absmach/supermq/pkg/errors"
+	svcerr "github.com/absmach/supermq/pkg/errors/service"
+	"github.com/absmach/supermq/readers"
+	"github.com/jmoiron/sqlx"
+)
+
+var errRetention = errors.New("failed to access retention policies")
+
+var _ readers.RetentionRepository = (*retentionRepo)(nil)
+
+type retentionRepo struct {
+	db *sqlx.DB
+}
+
+// NewRetentionRepository returns a retention policy repository backed
+// by the retention_policies table of the writer schema.
+func NewRetentionRepository(db *sqlx.DB) readers.RetentionRepository {
+	return &retentionRepo{db: db}
+}
+
+func (rr *retentionRepo) SaveRetention(ctx context.Context, policy readers.RetentionPolicy) error {
+	q := `INSERT INTO retention_policies (channel, raw_retention, rollup_retention)
+          VALUES (:channel, :raw_retention, :rollup_retention)
+          ON CONFLICT (channel) DO UPDATE SET
+          raw_retention = EXCLUDED.raw_retention,
+          rollup_retention = EXCLUDED.rollup_retention;`
+
+	if _, err := rr.db.NamedExecContext(ctx, q, dbRetention(policy)); err != nil {
+		return errors.Wrap(errRetention, err)
+	}
+	return nil
+}
+
+func (rr *retentionRepo) RetrieveRetention(ctx context.Context, chanID string) (readers.RetentionPolicy, error) {
+	q := `SELECT channel, raw_retention, rollup_retention FROM retention_policies
+          WHERE channel = :channel;`
+
+	rows, err := rr.db.NamedQueryContext(ctx, q, map[string]interface{}{"channel": chanID})
+	if err != nil {
+		return readers.RetentionPolicy{}, errors.Wrap(errRetention, err)
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		return readers.RetentionPolicy{}, svcerr.ErrNotFound
+	}
+	var dbp dbRetentionPolicy
+	if err := rows.StructScan(&dbp); err != nil {
+		return readers.RetentionPolicy{}, errors.Wrap(errRetention, err)
+	}
+	return dbp.policy(), nil
+}
+
+func (rr *retentionRepo) ListRetention(ctx context.Context) ([]readers.RetentionPolicy, error) {
+	q := `SELECT channel, raw_retention, rollup_retention FROM retention_policies;`
+
+	rows, err := rr.db.QueryxContext(ctx, q)
+	if err != nil {
+		return nil, errors.Wrap(errRetention, err)
+	}
+	defer rows.Close()
+
+	policies := []readers.RetentionPolicy{}
+	for rows.Next() {
+		var dbp dbRetentionPolicy
+		if err := rows.StructScan(&dbp); err != nil {
+			return nil, errors.Wrap(errRetention, err)
+		}
+		policies = append(policies, dbp.policy())
+	}
+	return policies, nil
+}
+
+func (rr *retentionRepo) RemoveRetention(ctx context.Context, chanID string) error {
+	q := `DELETE FROM retention_policies WHERE channel = :channel;`
+
+	if _, err := rr.db.NamedExecContext(ctx, q, map[string]interface{}{"channel": chanID}); err != nil {
+		return errors.Wrap(errRetention, err)
+	}
+	return nil
+}
+
+type dbRetentionPolicy struct {
+	Channel string `db:"channel"`
+	Raw     string `db:"raw_retention"`
+	Rollups string `db:"rollup_retention"`
+}
+
+func dbRetention(policy readers.RetentionPolicy) dbRetentionPolicy {
+	return dbRetentionPolicy{
+		Channel: policy.ChannelID,
+		Raw:     policy.Raw,
+		Rollups: policy.Rollups,
+	}
+}
+
+func (dbp dbRetentionPolicy) policy() readers.RetentionPolicy {
+	return readers.RetentionPolicy{
+		ChannelID: dbp.Channel,
+		Raw:       dbp.Raw,
+		Rollups:   dbp.Rollups,
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/absmach/supermq"
+	api "github.com/absmach/supermq/api/http"
+	apiutil "github.com/absmach/supermq/api/http/util"
+	smqauthn "github.com/absmach/supermq/pkg/authn"
+	"github.com/absmach/supermq/pkg/errors"
+	svcerr "github.com/absmach/supermq/pkg/errors/service"
+	"github.com/go-chi/chi/v5"
+)
+
+// MakeHandler returns a HTTP API handler with health check.
+func MakeHandler(svc Service, authn smqauthn.Authentication, instanceID string) http.Handler {
+	mux := chi.NewRouter()
+
+	mux.Group(func(r chi.Router) {
+		r.Use(api.AuthenticateMiddleware(authn, false))
+		r.Post("/jobs", createJobHandler(svc))
+		r.Get("/jobs", listJobsHandler(svc))
+		r.Delete("/jobs/{jobID}", removeJobHandler(svc))
+		r.Post("/jobs/{jobID}/enable", enableJobHandler(svc))
+		r.Post("/jobs/{jobID}/disable", disableJobHandler(svc))
+		r.Get("/executions", executionsHandler(svc))
+	})
+
+	mux.Get("/health", supermq.Health("scheduler", instanceID))
+
+	return mux
+}
+
+func createJobHandler(svc Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		session, ok := r.Context().Value(api.SessionKey).(smqauthn.Session)
+		if !ok {
+			api.EncodeError(r.Context(), svcerr.ErrAuthorization, w)
+			return
+		}
+		var job Job
+		if err := json.NewDecoder(r.Body).Decode(&job); err != nil {
+			api.EncodeError(r.Context(), errors.Wrap(apiutil.ErrValidation, err), w)
+			return
+		}
+		job.UserID = session.UserID
+
+		job, err := svc.CreateJob(r.Context(), job)
+		if err != nil {
+			api.EncodeError(r.Context(), err, w)
+			return
+		}
+		encodeJSON(w, http.StatusCreated, job)
+	}
+}
+
+func listJobsHandler(svc Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		session, ok := r.Context().Value(api.SessionKey).(smqauthn.Session)
+		if !ok {
+			api.EncodeError(r.Context(), svcerr.ErrAuthorization, w)
+			return
+		}
+		jobs, err := svc.ListJobs(r.Context(), session.UserID)
+		if err != nil {
+			api.EncodeError(r.Context(), err, w)
+			return
+		}
+		encodeJSON(w, http.StatusOK, map[string]interface{}{"jobs": jobs})
+	}
+}
+
+func removeJobHandler(svc Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		session, ok := r.Context().Value(api.SessionKey).(smqauthn.Session)
+		if !ok {
+			api.EncodeError(r.Context(), svcerr.ErrAuthorization, w)
+			return
+		}
+		if err := svc.RemoveJob(r.Context(), session.UserID, chi.URLParam(r, "jobID")); err != nil {
+			api.EncodeError(r.Context(), err, w)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func enableJobHandler(svc Service) http.HandlerFunc {
+	return toggleJobHandler(func(ctx context.Context, svc Service, userID, id string) (Job, error) {
+		return svc.EnableJob(ctx, userID, id)
+	}, svc)
+}
+
+func disableJobHandler(svc Service) http.HandlerFunc {
+	return toggleJobHandler(func(ctx context.Context, svc Service, userID, id string) (Job, error) {
+		return svc.DisableJob(ctx, userID, id)
+	}, svc)
+}
+
+func toggleJobHandler(toggle func(ctx context.Context, svc Service, userID, id string) (Job, error), svc Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		session, ok := r.Context().Value(api.SessionKey).(smqauthn.Session)
+		if !ok {
+			api.EncodeError(r.Context(), svcerr.ErrAuthorization, w)
+			return
+		}
+		job, err := toggle(r.Context(), svc, session.UserID, chi.URLParam(r, "jobID"))
+		if err != nil {
+			api.EncodeError(r.Context(), err, w)
+			return
+		}
+		encodeJSON(w, http.StatusOK, job)
+	}
+}
+
+func executionsHandler(svc Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		session, ok := r.Context().Value(api.SessionKey).(smqauthn.Session)
+		if !ok {
+			api.EncodeError(r.Context(), svcerr.ErrAuthorization, w)
+			return
+		}
+		jobID, err := apiutil.ReadStringQuery(r, "job_id", "")
+		if err != nil {
+			api.EncodeError(r.Context(), errors.Wrap(apiutil.ErrValidation, err), w)
+			return
+		}
+		limit, err := apiutil.ReadNumQuery[uint64](r, "limit", defLimit)
+		if err != nil {
+			api.EncodeError(r.Context(), errors.Wrap(apiutil.ErrValidation, err), w)
+			return
+		}
+		executions, err := svc.Executions(r.Context(), session.UserID, jobID, limit)
+		if err != nil {
+			api.EncodeError(r.Context(), err, w)
+			return
+		}
+		encodeJSON(w, http.StatusOK, map[string]interface{}{"executions": executions})
+	}
+}
+
+func encodeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", api.ContentType)
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package scheduler
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/absmach/supermq/pkg/errors"
+)
+
+var (
+	errCronFields = errors.New("cron expression must have 5 fields")
+	errCronField  = errors.New("invalid cron field")
+)
+
+// cronField bounds, in field order: minute, hour, day of month, month
+// and day of week.
+var cronBounds = [5][2]int{{0, 59}, {0, 23}, {1, 31}, {1, 12}, {0, 6}}
+
+// Schedule is a parsed cron expression.
+type Schedule struct {
+	fields [5]map[int]bool
+}
+
+// ParseCron parses a standard 5-field cron expression with support for
+// "*", lists, ranges and steps, e.g. "0 3 * * *" or "*/15 * * * 1-5".
+func ParseCron(spec string) (Schedule, error) {
+	parts := strings.Fields(spec)
+	if len(parts) != 5 {
+		return Schedule{}, errCronFields
+	}
+
+	s := Schedule{}
+	for i, part := range parts {
+		field, err := parseCronField(part, cronBounds[i][0], cronBounds[i][1])
+		if err != nil {
+			return Schedule{}, err
+		}
+		s.fields[i] = field
+	}
+
+	return s, nil
+}
+
+// Matches reports whether the schedule fires at the given time,
+// ignoring seconds.
+func (s Schedule) Matches(t time.Time) bool {
+	values := [5]int{t.Minute(), t.Hour(), t.Day(), int(t.Month()), int(t.Weekday())}
+	for i, field := range s.fields {
+		if !field[values[i]] {
+			return false
+		}
+	}
+	return true
+}
+
+func parseCronField(spec string, lo, hi int) (map[int]bool, error) {
+	values := map[int]bool{}
+	for _, part := range strings.Split(spec, ",") {
+		step := 1
+		if r, s, ok := strings.Cut(part, "/"); ok {
+			parsed, err := strconv.Atoi(s)
+			if err != nil || parsed < 1 {
+				return nil, errors.Wrap(errCronField, err)
+			}
+			step = parsed
+			part = r
+		}
+
+		start, end := lo, hi
+		switch {
+		case part == "*":
+		case strings.Contains(part, "-"):
+			from, to, _ := strings.Cut(part, "-")
+			parsedFrom, err := strconv.Atoi(from)
+			if err != nil {
+				return nil, errors.Wrap(errCronField, err)
+			}
+			parsedTo, err := strconv.Atoi(to)
+			if err != nil {
+				return nil, errors.Wrap(errCronField, err)
+			}
+			start, end = parsedFrom, parsedTo
+		default:
+			parsed, err := strconv.Atoi(part)
+			if err != nil {
+				return nil, errors.Wrap(errCronField, err)
+			}
+			start, end = parsed, parsed
+		}
+		if start < lo || end > hi || start > end {
+			return nil, errCronField
+		}
+
+		for v := start; v <= end; v += step {
+			values[v] = true
+		}
+	}
+
+	return values, nil
+}
@@ -0,0 +1,98 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package scheduler_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/absmach/supermq/scheduler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCron(t *testing.T) {
+	// 2026-08-26 is a Wednesday.
+	at := func(hour, minute int) time.Time {
+		return time.Date(2026, 8, 26, hour, minute, 0, 0, time.UTC)
+	}
+
+	cases := []struct {
+		desc    string
+		spec    string
+		time    time.Time
+		matches bool
+		err     bool
+	}{
+		{
+			desc:    "every minute",
+			spec:    "* * * * *",
+			time:    at(12, 34),
+			matches: true,
+		},
+		{
+			desc:    "nightly at three",
+			spec:    "0 3 * * *",
+			time:    at(3, 0),
+			matches: true,
+		},
+		{
+			desc:    "nightly at three later in the hour",
+			spec:    "0 3 * * *",
+			time:    at(3, 15),
+			matches: false,
+		},
+		{
+			desc:    "every fifteen minutes",
+			spec:    "*/15 * * * *",
+			time:    at(9, 45),
+			matches: true,
+		},
+		{
+			desc:    "every fifteen minutes off step",
+			spec:    "*/15 * * * *",
+			time:    at(9, 40),
+			matches: false,
+		},
+		{
+			desc:    "weekdays at nine",
+			spec:    "0 9 * * 1-5",
+			time:    at(9, 0),
+			matches: true,
+		},
+		{
+			desc:    "list of hours",
+			spec:    "0 6,18 * * *",
+			time:    at(18, 0),
+			matches: true,
+		},
+		{
+			desc: "too few fields",
+			spec: "* * * *",
+			err:  true,
+		},
+		{
+			desc: "value out of range",
+			spec: "60 * * * *",
+			err:  true,
+		},
+		{
+			desc: "malformed field",
+			spec: "a * * * *",
+			err:  true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			s, err := scheduler.ParseCron(tc.spec)
+			if tc.err {
+				assert.NotNil(t, err, "expected parse error")
+				return
+			}
+			require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+			assert.Equal(t, tc.matches, s.Matches(tc.time))
+		})
+	}
+}
@@ -0,0 +1,7 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package scheduler provides scheduled command dispatch. Users define
+// one-off or cron-style jobs publishing command payloads to channels,
+// with execution history, a retry policy and per-job enable/disable.
+package scheduler
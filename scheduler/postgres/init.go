@@ -0,0 +1,49 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	_ "github.com/jackc/pgx/v5/stdlib" // required for SQL access
+	migrate "github.com/rubenv/sql-migrate"
+)
+
+func Migration() *migrate.MemoryMigrationSource {
+	return &migrate.MemoryMigrationSource{
+		Migrations: []*migrate.Migration{
+			{
+				Id: "scheduler_01",
+				Up: []string{
+					`CREATE TABLE IF NOT EXISTS jobs (
+						id          VARCHAR(36) PRIMARY KEY,
+						user_id     VARCHAR(36) NOT NULL,
+						name        VARCHAR(1024),
+						channel_id  VARCHAR(36) NOT NULL,
+						subtopic    VARCHAR(1024),
+						payload     JSONB NOT NULL,
+						cron        VARCHAR(254),
+						run_at      TIMESTAMP,
+						max_retries BIGINT NOT NULL DEFAULT 0,
+						enabled     BOOLEAN NOT NULL DEFAULT TRUE,
+						created_at  TIMESTAMP,
+						updated_at  TIMESTAMP
+					)`,
+					`CREATE INDEX idx_jobs_user ON jobs (user_id)`,
+					`CREATE TABLE IF NOT EXISTS executions (
+						job_id      VARCHAR(36) NOT NULL,
+						attempts    BIGINT NOT NULL,
+						status      VARCHAR(36) NOT NULL,
+						error       TEXT,
+						started_at  TIMESTAMP,
+						FOREIGN KEY (job_id) REFERENCES jobs (id) ON DELETE CASCADE
+					)`,
+					`CREATE INDEX idx_executions_job ON executions (job_id, started_at DESC)`,
+				},
+				Down: []string{
+					`DROP TABLE IF EXISTS executions`,
+					`DROP TABLE IF EXISTS jobs`,
+				},
+			},
+		},
+	}
+}
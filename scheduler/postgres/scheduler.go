@@ -0,0 +1,241 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package postgres contains the Postgres repository of the scheduler
+// service.
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/absmach/supermq/pkg/errors"
+	svcerr "github.com/absmach/supermq/pkg/errors/service"
+	"github.com/absmach/supermq/scheduler"
+	"github.com/jmoiron/sqlx"
+)
+
+var errScheduler = errors.New("failed to access scheduler jobs")
+
+var _ scheduler.Repository = (*schedulerRepo)(nil)
+
+type schedulerRepo struct {
+	db *sqlx.DB
+}
+
+// NewRepository returns a scheduler repository backed by the jobs and
+// executions tables.
+func NewRepository(db *sqlx.DB) scheduler.Repository {
+	return &schedulerRepo{db: db}
+}
+
+func (sr *schedulerRepo) Save(ctx context.Context, job scheduler.Job) error {
+	q := `INSERT INTO jobs (id, user_id, name, channel_id, subtopic, payload, cron, run_at, max_retries, enabled, created_at, updated_at)
+          VALUES (:id, :user_id, :name, :channel_id, :subtopic, :payload, :cron, :run_at, :max_retries, :enabled, :created_at, :updated_at);`
+
+	if _, err := sr.db.NamedExecContext(ctx, q, dbJobOf(job)); err != nil {
+		return errors.Wrap(errScheduler, err)
+	}
+	return nil
+}
+
+func (sr *schedulerRepo) RetrieveAll(ctx context.Context) ([]scheduler.Job, error) {
+	q := `SELECT id, user_id, name, channel_id, subtopic, payload, cron, run_at, max_retries, enabled, created_at, updated_at
+          FROM jobs;`
+
+	rows, err := sr.db.QueryxContext(ctx, q)
+	if err != nil {
+		return nil, errors.Wrap(errScheduler, err)
+	}
+	defer rows.Close()
+
+	return scanJobs(rows)
+}
+
+func (sr *schedulerRepo) RetrieveByUser(ctx context.Context, userID string) ([]scheduler.Job, error) {
+	q := `SELECT id, user_id, name, channel_id, subtopic, payload, cron, run_at, max_retries, enabled, created_at, updated_at
+          FROM jobs WHERE user_id = :user_id;`
+
+	rows, err := sr.db.NamedQueryContext(ctx, q, map[string]interface{}{"user_id": userID})
+	if err != nil {
+		return nil, errors.Wrap(errScheduler, err)
+	}
+	defer rows.Close()
+
+	return scanJobs(rows)
+}
+
+func (sr *schedulerRepo) RetrieveByID(ctx context.Context, userID, id string) (scheduler.Job, error) {
+	q := `SELECT id, user_id, name, channel_id, subtopic, payload, cron, run_at, max_retries, enabled, created_at, updated_at
+          FROM jobs WHERE id = :id AND user_id = :user_id;`
+
+	rows, err := sr.db.NamedQueryContext(ctx, q, map[string]interface{}{"id": id, "user_id": userID})
+	if err != nil {
+		return scheduler.Job{}, errors.Wrap(errScheduler, err)
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		return scheduler.Job{}, svcerr.ErrNotFound
+	}
+	var dbj dbJob
+	if err := rows.StructScan(&dbj); err != nil {
+		return scheduler.Job{}, errors.Wrap(errScheduler, err)
+	}
+	return dbj.job(), nil
+}
+
+func (sr *schedulerRepo) Update(ctx context.Context, job scheduler.Job) error {
+	q := `UPDATE jobs SET name = :name, channel_id = :channel_id, subtopic = :subtopic, payload = :payload,
+          cron = :cron, run_at = :run_at, max_retries = :max_retries, enabled = :enabled, updated_at = :updated_at
+          WHERE id = :id;`
+
+	res, err := sr.db.NamedExecContext(ctx, q, dbJobOf(job))
+	if err != nil {
+		return errors.Wrap(errScheduler, err)
+	}
+	if count, err := res.RowsAffected(); err == nil && count == 0 {
+		return svcerr.ErrNotFound
+	}
+	return nil
+}
+
+func (sr *schedulerRepo) Remove(ctx context.Context, userID, id string) error {
+	q := `DELETE FROM jobs WHERE id = :id AND user_id = :user_id;`
+
+	res, err := sr.db.NamedExecContext(ctx, q, map[string]interface{}{"id": id, "user_id": userID})
+	if err != nil {
+		return errors.Wrap(errScheduler, err)
+	}
+	if count, err := res.RowsAffected(); err == nil && count == 0 {
+		return svcerr.ErrNotFound
+	}
+	return nil
+}
+
+func (sr *schedulerRepo) SaveExecution(ctx context.Context, execution scheduler.Execution) error {
+	q := `INSERT INTO executions (job_id, attempts, status, error, started_at)
+          VALUES (:job_id, :attempts, :status, :error, :started_at);`
+
+	if _, err := sr.db.NamedExecContext(ctx, q, dbExecutionOf(execution)); err != nil {
+		return errors.Wrap(errScheduler, err)
+	}
+	return nil
+}
+
+func (sr *schedulerRepo) RetrieveExecutions(ctx context.Context, userID, jobID string, limit uint64) ([]scheduler.Execution, error) {
+	q := `SELECT e.job_id, e.attempts, e.status, e.error, e.started_at
+          FROM executions e JOIN jobs j ON e.job_id = j.id
+          WHERE j.user_id = :user_id`
+	args := map[string]interface{}{"user_id": userID, "limit": limit}
+	if jobID != "" {
+		q += ` AND e.job_id = :job_id`
+		args["job_id"] = jobID
+	}
+	q += ` ORDER BY e.started_at DESC LIMIT :limit;`
+
+	rows, err := sr.db.NamedQueryContext(ctx, q, args)
+	if err != nil {
+		return nil, errors.Wrap(errScheduler, err)
+	}
+	defer rows.Close()
+
+	executions := []scheduler.Execution{}
+	for rows.Next() {
+		var dbe dbExecution
+		if err := rows.StructScan(&dbe); err != nil {
+			return nil, errors.Wrap(errScheduler, err)
+		}
+		executions = append(executions, dbe.execution())
+	}
+	return executions, nil
+}
+
+func scanJobs(rows *sqlx.Rows) ([]scheduler.Job, error) {
+	jobs := []scheduler.Job{}
+	for rows.Next() {
+		var dbj dbJob
+		if err := rows.StructScan(&dbj); err != nil {
+			return nil, errors.Wrap(errScheduler, err)
+		}
+		jobs = append(jobs, dbj.job())
+	}
+	return jobs, nil
+}
+
+type dbJob struct {
+	ID         string     `db:"id"`
+	UserID     string     `db:"user_id"`
+	Name       string     `db:"name"`
+	ChannelID  string     `db:"channel_id"`
+	Subtopic   string     `db:"subtopic"`
+	Payload    []byte     `db:"payload"`
+	Cron       string     `db:"cron"`
+	RunAt      *time.Time `db:"run_at"`
+	MaxRetries uint64     `db:"max_retries"`
+	Enabled    bool       `db:"enabled"`
+	CreatedAt  time.Time  `db:"created_at"`
+	UpdatedAt  time.Time  `db:"updated_at"`
+}
+
+func dbJobOf(job scheduler.Job) dbJob {
+	return dbJob{
+		ID:         job.ID,
+		UserID:     job.UserID,
+		Name:       job.Name,
+		ChannelID:  job.ChannelID,
+		Subtopic:   job.Subtopic,
+		Payload:    job.Payload,
+		Cron:       job.Cron,
+		RunAt:      job.RunAt,
+		MaxRetries: job.MaxRetries,
+		Enabled:    job.Enabled,
+		CreatedAt:  job.CreatedAt,
+		UpdatedAt:  job.UpdatedAt,
+	}
+}
+
+func (dbj dbJob) job() scheduler.Job {
+	return scheduler.Job{
+		ID:         dbj.ID,
+		UserID:     dbj.UserID,
+		Name:       dbj.Name,
+		ChannelID:  dbj.ChannelID,
+		Subtopic:   dbj.Subtopic,
+		Payload:    dbj.Payload,
+		Cron:       dbj.Cron,
+		RunAt:      dbj.RunAt,
+		MaxRetries: dbj.MaxRetries,
+		Enabled:    dbj.Enabled,
+		CreatedAt:  dbj.CreatedAt,
+		UpdatedAt:  dbj.UpdatedAt,
+	}
+}
+
+type dbExecution struct {
+	JobID     string    `db:"job_id"`
+	Attempts  uint64    `db:"attempts"`
+	Status    string    `db:"status"`
+	Error     string    `db:"error"`
+	StartedAt time.Time `db:"started_at"`
+}
+
+func dbExecutionOf(execution scheduler.Execution) dbExecution {
+	return dbExecution{
+		JobID:     execution.JobID,
+		Attempts:  execution.Attempts,
+		Status:    execution.Status,
+		Error:     execution.Error,
+		StartedAt: execution.StartedAt,
+	}
+}
+
+func (dbe dbExecution) execution() scheduler.Execution {
+	return scheduler.Execution{
+		JobID:     dbe.JobID,
+		Attempts:  dbe.Attempts,
+		Status:    dbe.Status,
+		Error:     dbe.Error,
+		StartedAt: dbe.StartedAt,
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// Execution statuses.
+const (
+	StatusCompleted = "completed"
+	StatusFailed    = "failed"
+)
+
+// Job publishes its command payload to a channel when due. Either Cron
+// or RunAt designates the schedule: cron jobs run repeatedly, one-off
+// jobs are disabled after their run.
+type Job struct {
+	ID         string          `json:"id"`
+	UserID     string          `json:"user_id"`
+	Name       string          `json:"name"`
+	ChannelID  string          `json:"channel_id"`
+	Subtopic   string          `json:"subtopic,omitempty"`
+	Payload    json.RawMessage `json:"payload"`
+	Cron       string          `json:"cron,omitempty"`
+	RunAt      *time.Time      `json:"run_at,omitempty"`
+	MaxRetries uint64          `json:"max_retries,omitempty"`
+	Enabled    bool            `json:"enabled"`
+	CreatedAt  time.Time       `json:"created_at"`
+	UpdatedAt  time.Time       `json:"updated_at"`
+}
+
+// Execution is a record of one job run, including its retries.
+type Execution struct {
+	JobID     string    `json:"job_id"`
+	Attempts  uint64    `json:"attempts"`
+	Status    string    `json:"status"`
+	Error     string    `json:"error,omitempty"`
+	StartedAt time.Time `json:"started_at"`
+}
+
+// Repository specifies the job and execution persistence API.
+type Repository interface {
+	// Save persists the job.
+	Save(ctx context.Context, job Job) error
+
+	// RetrieveAll retrieves all persisted jobs.
+	RetrieveAll(ctx context.Context) ([]Job, error)
+
+	// RetrieveByUser retrieves the jobs created by the given user.
+	RetrieveByUser(ctx context.Context, userID string) ([]Job, error)
+
+	// RetrieveByID retrieves a job created by the given user.
+	RetrieveByID(ctx context.Context, userID, id string) (Job, error)
+
+	// Update updates the persisted job.
+	Update(ctx context.Context, job Job) error
+
+	// Remove removes a job created by the given user, along with its
+	// execution history.
+	Remove(ctx context.Context, userID, id string) error
+
+	// SaveExecution records one job run.
+	SaveExecution(ctx context.Context, execution Execution) error
+
+	// RetrieveExecutions retrieves the execution history of the given
+	// user's jobs, newest first, optionally filtered by job.
+	RetrieveExecutions(ctx context.Context, userID, jobID string, limit uint64) ([]Execution, error)
+}
+
+// Service specifies the scheduler service API.
+type Service interface {
+	// CreateJob creates a new job. Jobs are enabled on creation.
+	CreateJob(ctx context.Context, job Job) (Job, error)
+
+	// ListJobs retrieves the jobs created by the given user.
+	ListJobs(ctx context.Context, userID string) ([]Job, error)
+
+	// RemoveJob removes a job created by the given user.
+	RemoveJob(ctx context.Context, userID, id string) error
+
+	// EnableJob enables a disabled job.
+	EnableJob(ctx context.Context, userID, id string) (Job, error)
+
+	// DisableJob disables a job without removing it.
+	DisableJob(ctx context.Context, userID, id string) (Job, error)
+
+	// Executions retrieves the execution history, newest first,
+	// optionally filtered by job.
+	Executions(ctx context.Context, userID, jobID string, limit uint64) ([]Execution, error)
+
+	// Start dispatches due jobs every tick until the context is
+	// canceled.
+	Start(ctx context.Context, tick time.Duration)
+}
@@ -0,0 +1,260 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	smq "github.com/absmach/supermq"
+	"github.com/absmach/supermq/pkg/errors"
+	svcerr "github.com/absmach/supermq/pkg/errors/service"
+	"github.com/absmach/supermq/pkg/messaging"
+)
+
+const (
+	protocol = "scheduler"
+	defLimit = uint64(10)
+)
+
+var (
+	errMissingChannel = errors.New("missing job channel")
+	errMissingPayload = errors.New("missing job payload")
+	errNoSchedule     = errors.New("job requires either a cron expression or a run time")
+	errBothSchedules  = errors.New("job cannot have both a cron expression and a run time")
+)
+
+type service struct {
+	repo       Repository
+	idProvider smq.IDProvider
+	publisher  messaging.Publisher
+
+	// Parsed cron schedules and per-minute dispatch deduplication are
+	// runtime state of this instance, not persisted.
+	mu      sync.Mutex
+	crons   map[string]Schedule
+	lastRun map[string]time.Time
+}
+
+var _ Service = (*service)(nil)
+
+// NewService returns a new scheduler service persisting jobs in the
+// given repository and publishing job commands with the given
+// publisher.
+func NewService(repo Repository, idProvider smq.IDProvider, publisher messaging.Publisher) Service {
+	return &service{
+		repo:       repo,
+		idProvider: idProvider,
+		publisher:  publisher,
+		crons:      make(map[string]Schedule),
+		lastRun:    make(map[string]time.Time),
+	}
+}
+
+func (svc *service) CreateJob(ctx context.Context, job Job) (Job, error) {
+	var schedule Schedule
+	if err := func() error {
+		if job.ChannelID == "" {
+			return errMissingChannel
+		}
+		if len(job.Payload) == 0 {
+			return errMissingPayload
+		}
+		switch {
+		case job.Cron == "" && job.RunAt == nil:
+			return errNoSchedule
+		case job.Cron != "" && job.RunAt != nil:
+			return errBothSchedules
+		case job.Cron != "":
+			var err error
+			if schedule, err = ParseCron(job.Cron); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return Job{}, errors.Wrap(svcerr.ErrMalformedEntity, err)
+	}
+
+	id, err := svc.idProvider.ID()
+	if err != nil {
+		return Job{}, err
+	}
+	job.ID = id
+	job.Enabled = true
+	job.CreatedAt = time.Now()
+	job.UpdatedAt = job.CreatedAt
+
+	if err := svc.repo.Save(ctx, job); err != nil {
+		return Job{}, errors.Wrap(svcerr.ErrCreateEntity, err)
+	}
+	if job.Cron != "" {
+		svc.mu.Lock()
+		svc.crons[job.ID] = schedule
+		svc.mu.Unlock()
+	}
+
+	return job, nil
+}
+
+func (svc *service) ListJobs(ctx context.Context, userID string) ([]Job, error) {
+	jobs, err := svc.repo.RetrieveByUser(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+	return jobs, nil
+}
+
+func (svc *service) RemoveJob(ctx context.Context, userID, id string) error {
+	if err := svc.repo.Remove(ctx, userID, id); err != nil {
+		return err
+	}
+	svc.mu.Lock()
+	delete(svc.crons, id)
+	delete(svc.lastRun, id)
+	svc.mu.Unlock()
+	return nil
+}
+
+func (svc *service) EnableJob(ctx context.Context, userID, id string) (Job, error) {
+	return svc.setEnabled(ctx, userID, id, true)
+}
+
+func (svc *service) DisableJob(ctx context.Context, userID, id string) (Job, error) {
+	return svc.setEnabled(ctx, userID, id, false)
+}
+
+func (svc *service) setEnabled(ctx context.Context, userID, id string, enabled bool) (Job, error) {
+	job, err := svc.repo.RetrieveByID(ctx, userID, id)
+	if err != nil {
+		return Job{}, err
+	}
+	job.Enabled = enabled
+	job.UpdatedAt = time.Now()
+	if err := svc.repo.Update(ctx, job); err != nil {
+		return Job{}, errors.Wrap(svcerr.ErrUpdateEntity, err)
+	}
+	return job, nil
+}
+
+func (svc *service) Executions(ctx context.Context, userID, jobID string, limit uint64) ([]Execution, error) {
+	if limit == 0 {
+		limit = defLimit
+	}
+	executions, err := svc.repo.RetrieveExecutions(ctx, userID, jobID, limit)
+	if err != nil {
+		return nil, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+	return executions, nil
+}
+
+// Start dispatches due jobs every tick until the context is canceled.
+func (svc *service) Start(ctx context.Context, tick time.Duration) {
+	go func() {
+		ticker := time.NewTicker(tick)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				svc.dispatch(ctx, time.Now())
+			}
+		}
+	}()
+}
+
+// dispatch runs every enabled job due at the given time. One-off jobs
+// are disabled after their run, cron jobs run at most once per minute.
+func (svc *service) dispatch(ctx context.Context, now time.Time) {
+	jobs, err := svc.repo.RetrieveAll(ctx)
+	if err != nil {
+		return
+	}
+
+	due := []Job{}
+	for _, job := range jobs {
+		if !job.Enabled {
+			continue
+		}
+		switch {
+		case job.RunAt != nil:
+			if now.Before(*job.RunAt) {
+				continue
+			}
+			job.Enabled = false
+			job.UpdatedAt = now
+			if err := svc.repo.Update(ctx, job); err != nil {
+				continue
+			}
+		default:
+			if !svc.cronDue(job, now) {
+				continue
+			}
+		}
+		due = append(due, job)
+	}
+
+	for _, job := range due {
+		svc.execute(ctx, job)
+	}
+}
+
+// cronDue reports whether the cron job is due, recording the dispatch
+// minute so that one schedule match runs the job only once.
+func (svc *service) cronDue(job Job, now time.Time) bool {
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+
+	schedule, ok := svc.crons[job.ID]
+	if !ok {
+		var err error
+		if schedule, err = ParseCron(job.Cron); err != nil {
+			return false
+		}
+		svc.crons[job.ID] = schedule
+	}
+
+	minute := now.Truncate(time.Minute)
+	if !schedule.Matches(now) || svc.lastRun[job.ID].Equal(minute) {
+		return false
+	}
+	svc.lastRun[job.ID] = minute
+	return true
+}
+
+// execute publishes the job payload, retrying up to the job retry
+// policy, and records the run in the execution history.
+func (svc *service) execute(ctx context.Context, job Job) {
+	execution := Execution{
+		JobID:     job.ID,
+		Status:    StatusCompleted,
+		StartedAt: time.Now(),
+	}
+
+	msg := messaging.Message{
+		Protocol:  protocol,
+		Channel:   job.ChannelID,
+		Subtopic:  job.Subtopic,
+		Publisher: job.UserID,
+		Payload:   job.Payload,
+		Created:   time.Now().UnixNano(),
+	}
+
+	for execution.Attempts = 1; ; execution.Attempts++ {
+		err := svc.publisher.Publish(ctx, job.ChannelID, &msg)
+		if err == nil {
+			execution.Error = ""
+			break
+		}
+		execution.Error = err.Error()
+		if execution.Attempts > job.MaxRetries {
+			execution.Status = StatusFailed
+			break
+		}
+	}
+
+	_ = svc.repo.SaveExecution(ctx, execution)
+}
@@ -0,0 +1,284 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package scheduler_test
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/absmach/supermq/pkg/errors"
+	svcerr "github.com/absmach/supermq/pkg/errors/service"
+	"github.com/absmach/supermq/pkg/messaging/mocks"
+	"github.com/absmach/supermq/pkg/uuid"
+	"github.com/absmach/supermq/scheduler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	userID    = "user"
+	channelID = "channel"
+)
+
+var payload = []byte(`{"command": "reboot"}`)
+
+// memRepo is an in-memory scheduler.Repository. The dispatch tests
+// exercise the service end to end, so the repository has to keep real
+// state rather than replay canned responses.
+type memRepo struct {
+	mu         sync.Mutex
+	jobs       map[string]scheduler.Job
+	executions []scheduler.Execution
+}
+
+func newMemRepo() *memRepo {
+	return &memRepo{jobs: make(map[string]scheduler.Job)}
+}
+
+func (mr *memRepo) Save(_ context.Context, job scheduler.Job) error {
+	mr.mu.Lock()
+	defer mr.mu.Unlock()
+	mr.jobs[job.ID] = job
+	return nil
+}
+
+func (mr *memRepo) RetrieveAll(_ context.Context) ([]scheduler.Job, error) {
+	mr.mu.Lock()
+	defer mr.mu.Unlock()
+	jobs := []scheduler.Job{}
+	for _, job := range mr.jobs {
+		jobs = append(jobs, job)
+	}
+	return jobs, nil
+}
+
+func (mr *memRepo) RetrieveByUser(_ context.Context, userID string) ([]scheduler.Job, error) {
+	mr.mu.Lock()
+	defer mr.mu.Unlock()
+	jobs := []scheduler.Job{}
+	for _, job := range mr.jobs {
+		if job.UserID == userID {
+			jobs = append(jobs, job)
+		}
+	}
+	return jobs, nil
+}
+
+func (mr *memRepo) RetrieveByID(_ context.Context, userID, id string) (scheduler.Job, error) {
+	mr.mu.Lock()
+	defer mr.mu.Unlock()
+	job, ok := mr.jobs[id]
+	if !ok || job.UserID != userID {
+		return scheduler.Job{}, svcerr.ErrNotFound
+	}
+	return job, nil
+}
+
+func (mr *memRepo) Update(_ context.Context, job scheduler.Job) error {
+	mr.mu.Lock()
+	defer mr.mu.Unlock()
+	if _, ok := mr.jobs[job.ID]; !ok {
+		return svcerr.ErrNotFound
+	}
+	mr.jobs[job.ID] = job
+	return nil
+}
+
+func (mr *memRepo) Remove(_ context.Context, userID, id string) error {
+	mr.mu.Lock()
+	defer mr.mu.Unlock()
+	job, ok := mr.jobs[id]
+	if !ok || job.UserID != userID {
+		return svcerr.ErrNotFound
+	}
+	delete(mr.jobs, id)
+	return nil
+}
+
+func (mr *memRepo) SaveExecution(_ context.Context, execution scheduler.Execution) error {
+	mr.mu.Lock()
+	defer mr.mu.Unlock()
+	mr.executions = append(mr.executions, execution)
+	return nil
+}
+
+func (mr *memRepo) RetrieveExecutions(_ context.Context, userID, jobID string, limit uint64) ([]scheduler.Execution, error) {
+	mr.mu.Lock()
+	defer mr.mu.Unlock()
+	executions := []scheduler.Execution{}
+	for _, execution := range mr.executions {
+		job, ok := mr.jobs[execution.JobID]
+		if !ok || job.UserID != userID {
+			continue
+		}
+		if jobID != "" && execution.JobID != jobID {
+			continue
+		}
+		executions = append(executions, execution)
+	}
+	sort.Slice(executions, func(i, j int) bool {
+		return executions[i].StartedAt.After(executions[j].StartedAt)
+	})
+	if uint64(len(executions)) > limit {
+		executions = executions[:limit]
+	}
+	return executions, nil
+}
+
+func newService() (scheduler.Service, *mocks.PubSub) {
+	pubsub := new(mocks.PubSub)
+	return scheduler.NewService(newMemRepo(), uuid.NewMock(), pubsub), pubsub
+}
+
+func TestCreateJob(t *testing.T) {
+	svc, _ := newService()
+	runAt := time.Now().Add(time.Hour)
+
+	cases := []struct {
+		desc string
+		job  scheduler.Job
+		err  error
+	}{
+		{
+			desc: "create cron job",
+			job:  scheduler.Job{UserID: userID, Name: "nightly reboot", ChannelID: channelID, Payload: payload, Cron: "0 3 * * *"},
+			err:  nil,
+		},
+		{
+			desc: "create one-off job",
+			job:  scheduler.Job{UserID: userID, Name: "firmware check", ChannelID: channelID, Payload: payload, RunAt: &runAt},
+			err:  nil,
+		},
+		{
+			desc: "create job without channel",
+			job:  scheduler.Job{UserID: userID, Payload: payload, Cron: "0 3 * * *"},
+			err:  svcerr.ErrMalformedEntity,
+		},
+		{
+			desc: "create job without payload",
+			job:  scheduler.Job{UserID: userID, ChannelID: channelID, Cron: "0 3 * * *"},
+			err:  svcerr.ErrMalformedEntity,
+		},
+		{
+			desc: "create job without schedule",
+			job:  scheduler.Job{UserID: userID, ChannelID: channelID, Payload: payload},
+			err:  svcerr.ErrMalformedEntity,
+		},
+		{
+			desc: "create job with both schedules",
+			job:  scheduler.Job{UserID: userID, ChannelID: channelID, Payload: payload, Cron: "0 3 * * *", RunAt: &runAt},
+			err:  svcerr.ErrMalformedEntity,
+		},
+		{
+			desc: "create job with invalid cron expression",
+			job:  scheduler.Job{UserID: userID, ChannelID: channelID, Payload: payload, Cron: "not a cron"},
+			err:  svcerr.ErrMalformedEntity,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			job, err := svc.CreateJob(context.Background(), tc.job)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("expected error %v got %v", tc.err, err))
+			if tc.err == nil {
+				assert.NotEmpty(t, job.ID)
+				assert.True(t, job.Enabled)
+			}
+		})
+	}
+}
+
+func TestJobLifecycle(t *testing.T) {
+	svc, _ := newService()
+
+	job, err := svc.CreateJob(context.Background(), scheduler.Job{UserID: userID, ChannelID: channelID, Payload: payload, Cron: "* * * * *"})
+	require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+
+	jobs, err := svc.ListJobs(context.Background(), userID)
+	require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+	assert.Len(t, jobs, 1)
+
+	jobs, err = svc.ListJobs(context.Background(), "other")
+	require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+	assert.Empty(t, jobs)
+
+	job, err = svc.DisableJob(context.Background(), userID, job.ID)
+	require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+	assert.False(t, job.Enabled)
+
+	job, err = svc.EnableJob(context.Background(), userID, job.ID)
+	require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+	assert.True(t, job.Enabled)
+
+	_, err = svc.DisableJob(context.Background(), "other", job.ID)
+	assert.True(t, errors.Contains(err, svcerr.ErrNotFound), fmt.Sprintf("expected error %v got %v", svcerr.ErrNotFound, err))
+
+	err = svc.RemoveJob(context.Background(), userID, job.ID)
+	assert.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+
+	err = svc.RemoveJob(context.Background(), userID, job.ID)
+	assert.True(t, errors.Contains(err, svcerr.ErrNotFound), fmt.Sprintf("expected error %v got %v", svcerr.ErrNotFound, err))
+}
+
+func TestDispatchOneOff(t *testing.T) {
+	svc, pubsub := newService()
+	pubsub.On("Publish", mock.Anything, channelID, mock.Anything).Return(nil)
+
+	runAt := time.Now().Add(-time.Second)
+	job, err := svc.CreateJob(context.Background(), scheduler.Job{UserID: userID, ChannelID: channelID, Payload: payload, RunAt: &runAt})
+	require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	svc.Start(ctx, time.Millisecond)
+
+	assert.Eventually(t, func() bool {
+		executions, err := svc.Executions(context.Background(), userID, job.ID, 10)
+		require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+		return len(executions) == 1
+	}, time.Second, 5*time.Millisecond, "expected one execution")
+
+	// One-off jobs are disabled after their run.
+	time.Sleep(50 * time.Millisecond)
+	executions, err := svc.Executions(context.Background(), userID, job.ID, 10)
+	require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+	require.Len(t, executions, 1)
+	assert.Equal(t, scheduler.StatusCompleted, executions[0].Status)
+	assert.Equal(t, uint64(1), executions[0].Attempts)
+
+	jobs, err := svc.ListJobs(context.Background(), userID)
+	require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+	require.Len(t, jobs, 1)
+	assert.False(t, jobs[0].Enabled)
+}
+
+func TestDispatchRetries(t *testing.T) {
+	svc, pubsub := newService()
+	pubsub.On("Publish", mock.Anything, channelID, mock.Anything).Return(errors.New("broker down"))
+
+	runAt := time.Now().Add(-time.Second)
+	job, err := svc.CreateJob(context.Background(), scheduler.Job{UserID: userID, ChannelID: channelID, Payload: payload, RunAt: &runAt, MaxRetries: 2})
+	require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	svc.Start(ctx, time.Millisecond)
+
+	assert.Eventually(t, func() bool {
+		executions, err := svc.Executions(context.Background(), userID, job.ID, 10)
+		require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+		return len(executions) == 1
+	}, time.Second, 5*time.Millisecond, "expected one execution")
+
+	executions, err := svc.Executions(context.Background(), userID, job.ID, 10)
+	require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+	require.Len(t, executions, 1)
+	assert.Equal(t, scheduler.StatusFailed, executions[0].Status)
+	assert.Equal(t, uint64(3), executions[0].Attempts)
+	assert.NotEmpty(t, executions[0].Error)
+}
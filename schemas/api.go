@@ -0,0 +1,138 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package schemas
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/absmach/supermq"
+	api "github.com/absmach/supermq/api/http"
+	apiutil "github.com/absmach/supermq/api/http/util"
+	smqauthn "github.com/absmach/supermq/pkg/authn"
+	"github.com/absmach/supermq/pkg/errors"
+	"github.com/go-chi/chi/v5"
+)
+
+// MakeHandler returns a HTTP API handler with health check.
+func MakeHandler(svc Service, authn smqauthn.Authentication, instanceID string) http.Handler {
+	mux := chi.NewRouter()
+
+	mux.Group(func(r chi.Router) {
+		r.Use(api.AuthenticateMiddleware(authn, false))
+		r.Post("/schemas", createSchemaHandler(svc))
+		r.Get("/schemas", listSchemasHandler(svc))
+		r.Get("/schemas/{schemaID}", viewSchemaHandler(svc))
+		r.Post("/channels/{chanID}/schema", bindChannelHandler(svc))
+		r.Delete("/channels/{chanID}/schema", unbindChannelHandler(svc))
+		r.Get("/channels/{chanID}/schema", bindingHandler(svc))
+		r.Post("/channels/{chanID}/validate", validateHandler(svc))
+	})
+
+	mux.Get("/health", supermq.Health("schemas", instanceID))
+
+	return mux
+}
+
+func createSchemaHandler(svc Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var schema Schema
+		if err := json.NewDecoder(r.Body).Decode(&schema); err != nil {
+			api.EncodeError(r.Context(), errors.Wrap(apiutil.ErrValidation, err), w)
+			return
+		}
+		schema, err := svc.CreateSchema(r.Context(), schema)
+		if err != nil {
+			api.EncodeError(r.Context(), err, w)
+			return
+		}
+		encodeJSON(w, http.StatusCreated, schema)
+	}
+}
+
+func listSchemasHandler(svc Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		schemas, err := svc.ListSchemas(r.Context())
+		if err != nil {
+			api.EncodeError(r.Context(), err, w)
+			return
+		}
+		encodeJSON(w, http.StatusOK, map[string]interface{}{"schemas": schemas})
+	}
+}
+
+func viewSchemaHandler(svc Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		schema, err := svc.ViewSchema(r.Context(), chi.URLParam(r, "schemaID"))
+		if err != nil {
+			api.EncodeError(r.Context(), err, w)
+			return
+		}
+		encodeJSON(w, http.StatusOK, schema)
+	}
+}
+
+func bindChannelHandler(svc Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			SchemaID string `json:"schema_id"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			api.EncodeError(r.Context(), errors.Wrap(apiutil.ErrValidation, err), w)
+			return
+		}
+		if err := svc.BindChannel(r.Context(), chi.URLParam(r, "chanID"), req.SchemaID); err != nil {
+			api.EncodeError(r.Context(), err, w)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func unbindChannelHandler(svc Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if err := svc.UnbindChannel(r.Context(), chi.URLParam(r, "chanID")); err != nil {
+			api.EncodeError(r.Context(), err, w)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func bindingHandler(svc Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		schema, err := svc.Binding(r.Context(), chi.URLParam(r, "chanID"))
+		if err != nil {
+			api.EncodeError(r.Context(), err, w)
+			return
+		}
+		encodeJSON(w, http.StatusOK, schema)
+	}
+}
+
+func validateHandler(svc Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Payload json.RawMessage `json:"payload"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			api.EncodeError(r.Context(), errors.Wrap(apiutil.ErrValidation, err), w)
+			return
+		}
+		result := map[string]interface{}{"valid": true}
+		if err := svc.Validate(r.Context(), chi.URLParam(r, "chanID"), req.Payload); err != nil {
+			result["valid"] = false
+			result["error"] = err.Error()
+		}
+		encodeJSON(w, http.StatusOK, result)
+	}
+}
+
+func encodeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", api.ContentType)
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
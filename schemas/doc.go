@@ -0,0 +1,7 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package schemas provides the payload schema registry. Channels are
+// bound to a registered schema version, adapters validate messages
+// against the binding, and consumers fetch schemas by ID for decoding.
+package schemas
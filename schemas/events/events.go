@@ -0,0 +1,62 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package events
+
+import (
+	"github.com/absmach/supermq/pkg/events"
+	"github.com/absmach/supermq/schemas"
+)
+
+const (
+	schemaPrefix  = "schemas."
+	schemaCreate  = schemaPrefix + "create"
+	channelBind   = schemaPrefix + "bind"
+	channelUnbind = schemaPrefix + "unbind"
+)
+
+var (
+	_ events.Event = (*createSchemaEvent)(nil)
+	_ events.Event = (*bindChannelEvent)(nil)
+	_ events.Event = (*unbindChannelEvent)(nil)
+)
+
+type createSchemaEvent struct {
+	schemas.Schema
+}
+
+func (cse createSchemaEvent) Encode() (map[string]interface{}, error) {
+	return map[string]interface{}{
+		"operation":  schemaCreate,
+		"id":         cse.ID,
+		"name":       cse.Name,
+		"version":    cse.Version,
+		"format":     cse.Format,
+		"definition": cse.Definition,
+		"created_at": cse.CreatedAt,
+	}, nil
+}
+
+type bindChannelEvent struct {
+	channelID string
+	schemaID  string
+}
+
+func (bce bindChannelEvent) Encode() (map[string]interface{}, error) {
+	return map[string]interface{}{
+		"operation":  channelBind,
+		"channel_id": bce.channelID,
+		"schema_id":  bce.schemaID,
+	}, nil
+}
+
+type unbindChannelEvent struct {
+	channelID string
+}
+
+func (uce unbindChannelEvent) Encode() (map[string]interface{}, error) {
+	return map[string]interface{}{
+		"operation":  channelUnbind,
+		"channel_id": uce.channelID,
+	}, nil
+}
@@ -0,0 +1,84 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package events provides the event-sourcing middleware of the schema
+// registry. Registered schemas and channel bindings are published to
+// the event store, where the messaging adapters consume them for
+// publish-path validation.
+package events
+
+import (
+	"context"
+
+	"github.com/absmach/supermq/pkg/events"
+	"github.com/absmach/supermq/pkg/events/store"
+	"github.com/absmach/supermq/schemas"
+)
+
+const streamID = "supermq.schemas"
+
+var _ schemas.Service = (*eventStore)(nil)
+
+type eventStore struct {
+	events.Publisher
+	svc schemas.Service
+}
+
+// NewEventStoreMiddleware returns wrapper around schemas service that
+// sends events to event store.
+func NewEventStoreMiddleware(ctx context.Context, svc schemas.Service, url string) (schemas.Service, error) {
+	publisher, err := store.NewPublisher(ctx, url, streamID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &eventStore{
+		svc:       svc,
+		Publisher: publisher,
+	}, nil
+}
+
+func (es *eventStore) CreateSchema(ctx context.Context, schema schemas.Schema) (schemas.Schema, error) {
+	schema, err := es.svc.CreateSchema(ctx, schema)
+	if err != nil {
+		return schema, err
+	}
+
+	if err := es.Publish(ctx, createSchemaEvent{schema}); err != nil {
+		return schema, err
+	}
+
+	return schema, nil
+}
+
+func (es *eventStore) ListSchemas(ctx context.Context) ([]schemas.Schema, error) {
+	return es.svc.ListSchemas(ctx)
+}
+
+func (es *eventStore) ViewSchema(ctx context.Context, id string) (schemas.Schema, error) {
+	return es.svc.ViewSchema(ctx, id)
+}
+
+func (es *eventStore) BindChannel(ctx context.Context, channelID, schemaID string) error {
+	if err := es.svc.BindChannel(ctx, channelID, schemaID); err != nil {
+		return err
+	}
+
+	return es.Publish(ctx, bindChannelEvent{channelID: channelID, schemaID: schemaID})
+}
+
+func (es *eventStore) UnbindChannel(ctx context.Context, channelID string) error {
+	if err := es.svc.UnbindChannel(ctx, channelID); err != nil {
+		return err
+	}
+
+	return es.Publish(ctx, unbindChannelEvent{channelID: channelID})
+}
+
+func (es *eventStore) Binding(ctx context.Context, channelID string) (schemas.Schema, error) {
+	return es.svc.Binding(ctx, channelID)
+}
+
+func (es *eventStore) Validate(ctx context.Context, channelID string, payload []byte) error {
+	return es.svc.Validate(ctx, channelID, payload)
+}
@@ -0,0 +1,39 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	_ "github.com/jackc/pgx/v5/stdlib" // required for SQL access
+	migrate "github.com/rubenv/sql-migrate"
+)
+
+func Migration() *migrate.MemoryMigrationSource {
+	return &migrate.MemoryMigrationSource{
+		Migrations: []*migrate.Migration{
+			{
+				Id: "schemas_01",
+				Up: []string{
+					`CREATE TABLE IF NOT EXISTS schemas (
+						id          VARCHAR(36) PRIMARY KEY,
+						name        VARCHAR(1024) NOT NULL,
+						version     BIGINT NOT NULL,
+						format      VARCHAR(36) NOT NULL,
+						definition  TEXT NOT NULL,
+						created_at  TIMESTAMP,
+						UNIQUE      (name, version)
+					)`,
+					`CREATE TABLE IF NOT EXISTS schema_bindings (
+						channel_id  VARCHAR(36) PRIMARY KEY,
+						schema_id   VARCHAR(36) NOT NULL,
+						FOREIGN KEY (schema_id) REFERENCES schemas (id) ON DELETE CASCADE
+					)`,
+				},
+				Down: []string{
+					`DROP TABLE IF EXISTS schema_bindings`,
+					`DROP TABLE IF EXISTS schemas`,
+				},
+			},
+		},
+	}
+}
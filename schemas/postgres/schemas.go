@@ -0,0 +1,166 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package postgres contains the Postgres repository of the schema
+// registry.
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/absmach/supermq/pkg/errors"
+	svcerr "github.com/absmach/supermq/pkg/errors/service"
+	"github.com/absmach/supermq/schemas"
+	"github.com/jmoiron/sqlx"
+)
+
+var errSchemas = errors.New("failed to access schemas")
+
+var _ schemas.Repository = (*schemasRepo)(nil)
+
+type schemasRepo struct {
+	db *sqlx.DB
+}
+
+// NewRepository returns a schema repository backed by the schemas and
+// schema_bindings tables.
+func NewRepository(db *sqlx.DB) schemas.Repository {
+	return &schemasRepo{db: db}
+}
+
+func (sr *schemasRepo) Save(ctx context.Context, schema schemas.Schema) error {
+	q := `INSERT INTO schemas (id, name, version, format, definition, created_at)
+          VALUES (:id, :name, :version, :format, :definition, :created_at);`
+
+	if _, err := sr.db.NamedExecContext(ctx, q, dbSchemaOf(schema)); err != nil {
+		return errors.Wrap(errSchemas, err)
+	}
+	return nil
+}
+
+func (sr *schemasRepo) RetrieveAll(ctx context.Context) ([]schemas.Schema, error) {
+	q := `SELECT id, name, version, format, definition, created_at FROM schemas;`
+
+	rows, err := sr.db.QueryxContext(ctx, q)
+	if err != nil {
+		return nil, errors.Wrap(errSchemas, err)
+	}
+	defer rows.Close()
+
+	all := []schemas.Schema{}
+	for rows.Next() {
+		var dbs dbSchema
+		if err := rows.StructScan(&dbs); err != nil {
+			return nil, errors.Wrap(errSchemas, err)
+		}
+		all = append(all, dbs.schema())
+	}
+	return all, nil
+}
+
+func (sr *schemasRepo) RetrieveByID(ctx context.Context, id string) (schemas.Schema, error) {
+	q := `SELECT id, name, version, format, definition, created_at FROM schemas
+          WHERE id = :id;`
+
+	return sr.retrieveOne(ctx, q, map[string]interface{}{"id": id})
+}
+
+func (sr *schemasRepo) LatestVersion(ctx context.Context, name string) (uint64, error) {
+	q := `SELECT COALESCE(MAX(version), 0) FROM schemas WHERE name = :name;`
+
+	rows, err := sr.db.NamedQueryContext(ctx, q, map[string]interface{}{"name": name})
+	if err != nil {
+		return 0, errors.Wrap(errSchemas, err)
+	}
+	defer rows.Close()
+
+	version := uint64(0)
+	if rows.Next() {
+		if err := rows.Scan(&version); err != nil {
+			return 0, errors.Wrap(errSchemas, err)
+		}
+	}
+	return version, nil
+}
+
+func (sr *schemasRepo) SaveBinding(ctx context.Context, channelID, schemaID string) error {
+	q := `INSERT INTO schema_bindings (channel_id, schema_id)
+          VALUES (:channel_id, :schema_id)
+          ON CONFLICT (channel_id) DO UPDATE SET schema_id = EXCLUDED.schema_id;`
+
+	args := map[string]interface{}{"channel_id": channelID, "schema_id": schemaID}
+	if _, err := sr.db.NamedExecContext(ctx, q, args); err != nil {
+		return errors.Wrap(errSchemas, err)
+	}
+	return nil
+}
+
+func (sr *schemasRepo) RemoveBinding(ctx context.Context, channelID string) error {
+	q := `DELETE FROM schema_bindings WHERE channel_id = :channel_id;`
+
+	res, err := sr.db.NamedExecContext(ctx, q, map[string]interface{}{"channel_id": channelID})
+	if err != nil {
+		return errors.Wrap(errSchemas, err)
+	}
+	if count, err := res.RowsAffected(); err == nil && count == 0 {
+		return svcerr.ErrNotFound
+	}
+	return nil
+}
+
+func (sr *schemasRepo) RetrieveBinding(ctx context.Context, channelID string) (schemas.Schema, error) {
+	q := `SELECT s.id, s.name, s.version, s.format, s.definition, s.created_at
+          FROM schemas s JOIN schema_bindings b ON s.id = b.schema_id
+          WHERE b.channel_id = :channel_id;`
+
+	return sr.retrieveOne(ctx, q, map[string]interface{}{"channel_id": channelID})
+}
+
+func (sr *schemasRepo) retrieveOne(ctx context.Context, q string, args map[string]interface{}) (schemas.Schema, error) {
+	rows, err := sr.db.NamedQueryContext(ctx, q, args)
+	if err != nil {
+		return schemas.Schema{}, errors.Wrap(errSchemas, err)
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		return schemas.Schema{}, svcerr.ErrNotFound
+	}
+	var dbs dbSchema
+	if err := rows.StructScan(&dbs); err != nil {
+		return schemas.Schema{}, errors.Wrap(errSchemas, err)
+	}
+	return dbs.schema(), nil
+}
+
+type dbSchema struct {
+	ID         string    `db:"id"`
+	Name       string    `db:"name"`
+	Version    uint64    `db:"version"`
+	Format     string    `db:"format"`
+	Definition string    `db:"definition"`
+	CreatedAt  time.Time `db:"created_at"`
+}
+
+func dbSchemaOf(schema schemas.Schema) dbSchema {
+	return dbSchema{
+		ID:         schema.ID,
+		Name:       schema.Name,
+		Version:    schema.Version,
+		Format:     schema.Format,
+		Definition: schema.Definition,
+		CreatedAt:  schema.CreatedAt,
+	}
+}
+
+func (dbs dbSchema) schema() schemas.Schema {
+	return schemas.Schema{
+		ID:         dbs.ID,
+		Name:       dbs.Name,
+		Version:    dbs.Version,
+		Format:     dbs.Format,
+		Definition: dbs.Definition,
+		CreatedAt:  dbs.CreatedAt,
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package schemas
+
+import (
+	"context"
+	"time"
+)
+
+// Schema formats.
+const (
+	FormatProtobuf   = "protobuf"
+	FormatAvro       = "avro"
+	FormatJSONSchema = "json"
+)
+
+// Schema is one registered version of a payload schema. Versions of
+// the same name are numbered sequentially.
+type Schema struct {
+	ID         string    `json:"id"`
+	Name       string    `json:"name"`
+	Version    uint64    `json:"version"`
+	Format     string    `json:"format"`
+	Definition string    `json:"definition"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// Repository specifies the schema persistence API.
+type Repository interface {
+	// Save persists the schema.
+	Save(ctx context.Context, schema Schema) error
+
+	// RetrieveAll retrieves all persisted schemas.
+	RetrieveAll(ctx context.Context) ([]Schema, error)
+
+	// RetrieveByID retrieves the schema with the given ID.
+	RetrieveByID(ctx context.Context, id string) (Schema, error)
+
+	// LatestVersion retrieves the highest registered version of the
+	// named schema, or zero if none is registered.
+	LatestVersion(ctx context.Context, name string) (uint64, error)
+
+	// SaveBinding persists the schema binding of a channel, replacing
+	// any previous one.
+	SaveBinding(ctx context.Context, channelID, schemaID string) error
+
+	// RemoveBinding removes the schema binding of a channel.
+	RemoveBinding(ctx context.Context, channelID string) error
+
+	// RetrieveBinding retrieves the schema a channel is bound to.
+	RetrieveBinding(ctx context.Context, channelID string) (Schema, error)
+}
+
+// Service specifies the schema registry API.
+type Service interface {
+	// CreateSchema registers a new schema version under its name.
+	CreateSchema(ctx context.Context, schema Schema) (Schema, error)
+
+	// ListSchemas retrieves all registered schemas.
+	ListSchemas(ctx context.Context) ([]Schema, error)
+
+	// ViewSchema retrieves the schema with the given ID.
+	ViewSchema(ctx context.Context, id string) (Schema, error)
+
+	// BindChannel binds a channel to the given schema version.
+	BindChannel(ctx context.Context, channelID, schemaID string) error
+
+	// UnbindChannel removes the schema binding of a channel.
+	UnbindChannel(ctx context.Context, channelID string) error
+
+	// Binding retrieves the schema a channel is bound to.
+	Binding(ctx context.Context, channelID string) (Schema, error)
+
+	// Validate validates the payload against the schema the channel
+	// is bound to. Unbound channels and non-validatable formats pass.
+	Validate(ctx context.Context, channelID string, payload []byte) error
+}
@@ -0,0 +1,159 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package schemas
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"time"
+
+	smq "github.com/absmach/supermq"
+	"github.com/absmach/supermq/pkg/errors"
+	svcerr "github.com/absmach/supermq/pkg/errors/service"
+	"github.com/xeipuuv/gojsonschema"
+)
+
+var (
+	errMissingName       = errors.New("missing schema name")
+	errMissingDefinition = errors.New("missing schema definition")
+	errInvalidFormat     = errors.New("invalid schema format")
+	errInvalidDefinition = errors.New("invalid schema definition")
+	errValidation        = errors.New("payload does not conform to the channel schema")
+)
+
+type service struct {
+	repo       Repository
+	idProvider smq.IDProvider
+}
+
+var _ Service = (*service)(nil)
+
+// NewService returns a new schema registry service.
+func NewService(repo Repository, idProvider smq.IDProvider) Service {
+	return &service{
+		repo:       repo,
+		idProvider: idProvider,
+	}
+}
+
+func (svc *service) CreateSchema(ctx context.Context, schema Schema) (Schema, error) {
+	if err := validateSchema(schema); err != nil {
+		return Schema{}, errors.Wrap(svcerr.ErrMalformedEntity, err)
+	}
+
+	id, err := svc.idProvider.ID()
+	if err != nil {
+		return Schema{}, err
+	}
+	schema.ID = id
+	schema.CreatedAt = time.Now()
+
+	version, err := svc.repo.LatestVersion(ctx, schema.Name)
+	if err != nil {
+		return Schema{}, errors.Wrap(svcerr.ErrCreateEntity, err)
+	}
+	schema.Version = version + 1
+
+	if err := svc.repo.Save(ctx, schema); err != nil {
+		return Schema{}, errors.Wrap(svcerr.ErrCreateEntity, err)
+	}
+
+	return schema, nil
+}
+
+func (svc *service) ListSchemas(ctx context.Context) ([]Schema, error) {
+	schemas, err := svc.repo.RetrieveAll(ctx)
+	if err != nil {
+		return nil, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+	return schemas, nil
+}
+
+func (svc *service) ViewSchema(ctx context.Context, id string) (Schema, error) {
+	return svc.repo.RetrieveByID(ctx, id)
+}
+
+func (svc *service) BindChannel(ctx context.Context, channelID, schemaID string) error {
+	if _, err := svc.repo.RetrieveByID(ctx, schemaID); err != nil {
+		return err
+	}
+	return svc.repo.SaveBinding(ctx, channelID, schemaID)
+}
+
+func (svc *service) UnbindChannel(ctx context.Context, channelID string) error {
+	return svc.repo.RemoveBinding(ctx, channelID)
+}
+
+func (svc *service) Binding(ctx context.Context, channelID string) (Schema, error) {
+	return svc.repo.RetrieveBinding(ctx, channelID)
+}
+
+// Validate validates the payload against the schema the channel is
+// bound to. JSON Schema bindings are validated structurally; Protobuf
+// and Avro schemas are served for consumer-side decoding only, so
+// their bindings pass.
+func (svc *service) Validate(ctx context.Context, channelID string, payload []byte) error {
+	schema, err := svc.Binding(ctx, channelID)
+	if err != nil {
+		return nil
+	}
+	if schema.Format != FormatJSONSchema {
+		return nil
+	}
+	if err := ValidateJSON(schema.Definition, payload); err != nil {
+		return errors.Wrap(errValidation, err)
+	}
+	return nil
+}
+
+func validateSchema(schema Schema) error {
+	if schema.Name == "" {
+		return errMissingName
+	}
+	if schema.Definition == "" {
+		return errMissingDefinition
+	}
+	switch schema.Format {
+	case FormatJSONSchema:
+		if _, err := gojsonschema.NewSchema(gojsonschema.NewStringLoader(schema.Definition)); err != nil {
+			return errors.Wrap(errInvalidDefinition, err)
+		}
+	case FormatAvro:
+		definition := map[string]interface{}{}
+		if err := json.Unmarshal([]byte(schema.Definition), &definition); err != nil {
+			return errors.Wrap(errInvalidDefinition, err)
+		}
+	case FormatProtobuf:
+		// Protobuf descriptors are registered base64-encoded.
+		if _, err := base64.StdEncoding.DecodeString(schema.Definition); err != nil {
+			return errors.Wrap(errInvalidDefinition, err)
+		}
+	default:
+		return errInvalidFormat
+	}
+	return nil
+}
+
+// ValidateJSON validates the payload against the JSON Schema
+// definition.
+func ValidateJSON(definition string, payload []byte) error {
+	schema, err := gojsonschema.NewSchema(gojsonschema.NewStringLoader(definition))
+	if err != nil {
+		return err
+	}
+	result, err := schema.Validate(gojsonschema.NewBytesLoader(payload))
+	if err != nil {
+		return err
+	}
+	if result.Valid() {
+		return nil
+	}
+	reasons := make([]string, 0, len(result.Errors()))
+	for _, desc := range result.Errors() {
+		reasons = append(reasons, desc.String())
+	}
+	return errors.New(strings.Join(reasons, "; "))
+}
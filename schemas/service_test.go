@@ -0,0 +1,214 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package schemas_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/absmach/supermq/pkg/errors"
+	svcerr "github.com/absmach/supermq/pkg/errors/service"
+	"github.com/absmach/supermq/pkg/uuid"
+	"github.com/absmach/supermq/schemas"
+	"github.com/absmach/supermq/schemas/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	channelID  = "channel"
+	definition = `{"type": "object", "required": ["temperature"], "properties": {"temperature": {"type": "number"}, "unit": {"type": "string"}}}`
+)
+
+func newService() (schemas.Service, *mocks.Repository) {
+	repo := new(mocks.Repository)
+	return schemas.NewService(repo, uuid.NewMock()), repo
+}
+
+func createSchema(t *testing.T, svc schemas.Service) schemas.Schema {
+	schema, err := svc.CreateSchema(context.Background(), schemas.Schema{
+		Name:       "measurement",
+		Format:     schemas.FormatJSONSchema,
+		Definition: definition,
+	})
+	require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+	return schema
+}
+
+func TestCreateSchema(t *testing.T) {
+	svc, repo := newService()
+	repo.On("LatestVersion", mock.Anything, mock.Anything).Return(uint64(0), nil)
+	repo.On("Save", mock.Anything, mock.Anything).Return(nil)
+
+	cases := []struct {
+		desc   string
+		schema schemas.Schema
+		err    error
+	}{
+		{
+			desc:   "create JSON schema",
+			schema: schemas.Schema{Name: "measurement", Format: schemas.FormatJSONSchema, Definition: definition},
+			err:    nil,
+		},
+		{
+			desc:   "create Avro schema",
+			schema: schemas.Schema{Name: "avro", Format: schemas.FormatAvro, Definition: `{"type": "record", "name": "m", "fields": []}`},
+			err:    nil,
+		},
+		{
+			desc:   "create Protobuf schema",
+			schema: schemas.Schema{Name: "proto", Format: schemas.FormatProtobuf, Definition: "CgZtLnByb3Rv"},
+			err:    nil,
+		},
+		{
+			desc:   "create schema without name",
+			schema: schemas.Schema{Format: schemas.FormatJSONSchema, Definition: definition},
+			err:    svcerr.ErrMalformedEntity,
+		},
+		{
+			desc:   "create schema without definition",
+			schema: schemas.Schema{Name: "measurement", Format: schemas.FormatJSONSchema},
+			err:    svcerr.ErrMalformedEntity,
+		},
+		{
+			desc:   "create schema with invalid format",
+			schema: schemas.Schema{Name: "measurement", Format: "xml", Definition: definition},
+			err:    svcerr.ErrMalformedEntity,
+		},
+		{
+			desc:   "create JSON schema with malformed definition",
+			schema: schemas.Schema{Name: "measurement", Format: schemas.FormatJSONSchema, Definition: "not json"},
+			err:    svcerr.ErrMalformedEntity,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			schema, err := svc.CreateSchema(context.Background(), tc.schema)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("expected error %v got %v", tc.err, err))
+			if tc.err == nil {
+				assert.NotEmpty(t, schema.ID)
+				assert.Equal(t, uint64(1), schema.Version)
+			}
+		})
+	}
+}
+
+func TestSchemaVersions(t *testing.T) {
+	svc, repo := newService()
+	repo.On("Save", mock.Anything, mock.Anything).Return(nil)
+	repo.On("LatestVersion", mock.Anything, "measurement").Return(uint64(0), nil).Once()
+	repo.On("LatestVersion", mock.Anything, "measurement").Return(uint64(1), nil).Once()
+
+	first := createSchema(t, svc)
+	second := createSchema(t, svc)
+	assert.Equal(t, uint64(1), first.Version)
+	assert.Equal(t, uint64(2), second.Version)
+
+	repo.On("RetrieveByID", mock.Anything, second.ID).Return(second, nil)
+	schema, err := svc.ViewSchema(context.Background(), second.ID)
+	require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+	assert.Equal(t, second, schema)
+
+	repo.On("RetrieveByID", mock.Anything, "unknown").Return(schemas.Schema{}, svcerr.ErrNotFound)
+	_, err = svc.ViewSchema(context.Background(), "unknown")
+	assert.True(t, errors.Contains(err, svcerr.ErrNotFound), fmt.Sprintf("expected error %v got %v", svcerr.ErrNotFound, err))
+
+	repo.On("RetrieveAll", mock.Anything).Return([]schemas.Schema{first, second}, nil)
+	all, err := svc.ListSchemas(context.Background())
+	require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+	assert.Len(t, all, 2)
+}
+
+func TestBinding(t *testing.T) {
+	svc, repo := newService()
+	repo.On("Save", mock.Anything, mock.Anything).Return(nil)
+	repo.On("LatestVersion", mock.Anything, mock.Anything).Return(uint64(0), nil)
+	schema := createSchema(t, svc)
+
+	repo.On("RetrieveByID", mock.Anything, "unknown").Return(schemas.Schema{}, svcerr.ErrNotFound)
+	err := svc.BindChannel(context.Background(), channelID, "unknown")
+	assert.True(t, errors.Contains(err, svcerr.ErrNotFound), fmt.Sprintf("expected error %v got %v", svcerr.ErrNotFound, err))
+
+	repo.On("RetrieveByID", mock.Anything, schema.ID).Return(schema, nil)
+	repo.On("SaveBinding", mock.Anything, channelID, schema.ID).Return(nil)
+	err = svc.BindChannel(context.Background(), channelID, schema.ID)
+	require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+
+	repo.On("RetrieveBinding", mock.Anything, channelID).Return(schema, nil).Once()
+	bound, err := svc.Binding(context.Background(), channelID)
+	require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+	assert.Equal(t, schema.ID, bound.ID)
+
+	repo.On("RemoveBinding", mock.Anything, channelID).Return(nil).Once()
+	err = svc.UnbindChannel(context.Background(), channelID)
+	require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+
+	repo.On("RetrieveBinding", mock.Anything, channelID).Return(schemas.Schema{}, svcerr.ErrNotFound).Once()
+	_, err = svc.Binding(context.Background(), channelID)
+	assert.True(t, errors.Contains(err, svcerr.ErrNotFound), fmt.Sprintf("expected error %v got %v", svcerr.ErrNotFound, err))
+
+	repo.On("RemoveBinding", mock.Anything, channelID).Return(svcerr.ErrNotFound).Once()
+	err = svc.UnbindChannel(context.Background(), channelID)
+	assert.True(t, errors.Contains(err, svcerr.ErrNotFound), fmt.Sprintf("expected error %v got %v", svcerr.ErrNotFound, err))
+}
+
+func TestValidate(t *testing.T) {
+	svc, repo := newService()
+	repo.On("Save", mock.Anything, mock.Anything).Return(nil)
+	repo.On("LatestVersion", mock.Anything, mock.Anything).Return(uint64(0), nil)
+	schema := createSchema(t, svc)
+
+	repo.On("RetrieveBinding", mock.Anything, channelID).Return(schema, nil)
+	repo.On("RetrieveBinding", mock.Anything, "other").Return(schemas.Schema{}, svcerr.ErrNotFound)
+
+	cases := []struct {
+		desc    string
+		channel string
+		payload string
+		err     bool
+	}{
+		{
+			desc:    "valid payload",
+			channel: channelID,
+			payload: `{"temperature": 42, "unit": "C"}`,
+		},
+		{
+			desc:    "missing required field",
+			channel: channelID,
+			payload: `{"unit": "C"}`,
+			err:     true,
+		},
+		{
+			desc:    "wrong field type",
+			channel: channelID,
+			payload: `{"temperature": "hot"}`,
+			err:     true,
+		},
+		{
+			desc:    "wrong payload type",
+			channel: channelID,
+			payload: `[1, 2]`,
+			err:     true,
+		},
+		{
+			desc:    "payload is not JSON",
+			channel: channelID,
+			payload: "not json",
+			err:     true,
+		},
+		{
+			desc:    "unbound channel passes",
+			channel: "other",
+			payload: "anything",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := svc.Validate(context.Background(), tc.channel, []byte(tc.payload))
+			assert.Equal(t, tc.err, err != nil, fmt.Sprintf("unexpected error %v", err))
+		})
+	}
+}
@@ -0,0 +1,21 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	apiutil "github.com/absmach/supermq/api/http/util"
+	"github.com/absmach/supermq/search"
+)
+
+type searchReq struct {
+	query search.Query
+}
+
+func (req searchReq) validate() error {
+	if req.query.Text == "" {
+		return apiutil.ErrEmptySearchQuery
+	}
+
+	return nil
+}
@@ -0,0 +1,29 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"net/http"
+
+	"github.com/absmach/supermq"
+	"github.com/absmach/supermq/search"
+)
+
+var _ supermq.Response = (*pageRes)(nil)
+
+type pageRes struct {
+	search.DocumentsPage `json:",inline"`
+}
+
+func (res pageRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res pageRes) Code() int {
+	return http.StatusOK
+}
+
+func (res pageRes) Empty() bool {
+	return false
+}
@@ -0,0 +1,80 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/absmach/supermq"
+	api "github.com/absmach/supermq/api/http"
+	apiutil "github.com/absmach/supermq/api/http/util"
+	smqauthn "github.com/absmach/supermq/pkg/authn"
+	"github.com/absmach/supermq/pkg/errors"
+	"github.com/absmach/supermq/search"
+	"github.com/go-chi/chi/v5"
+	kithttp "github.com/go-kit/kit/transport/http"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+)
+
+const (
+	queryKey = "q"
+	typesKey = "types"
+	fuzzyKey = "fuzzy"
+	limitKey = "limit"
+)
+
+// MakeHandler returns a HTTP API handler with health check and metrics.
+func MakeHandler(svc search.Service, authn smqauthn.Authentication, logger *slog.Logger, svcName, instanceID string) http.Handler {
+	opts := []kithttp.ServerOption{
+		kithttp.ServerErrorEncoder(apiutil.LoggingErrorEncoder(logger, api.EncodeError)),
+	}
+
+	mux := chi.NewRouter()
+
+	mux.With(api.AuthenticateMiddleware(authn, true)).Get("/{domainID}/search", otelhttp.NewHandler(kithttp.NewServer(
+		searchEndpoint(svc),
+		decodeSearchReq,
+		api.EncodeResponse,
+		opts...,
+	), "search").ServeHTTP)
+
+	mux.Get("/health", supermq.Health(svcName, instanceID))
+	mux.Handle("/metrics", promhttp.Handler())
+
+	return mux
+}
+
+func decodeSearchReq(_ context.Context, r *http.Request) (interface{}, error) {
+	text, err := apiutil.ReadStringQuery(r, queryKey, "")
+	if err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	types, err := apiutil.ReadStringQuery(r, typesKey, "")
+	if err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	fuzzy, err := apiutil.ReadBoolQuery(r, fuzzyKey, false)
+	if err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	limit, err := apiutil.ReadNumQuery[uint64](r, limitKey, 0)
+	if err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, err)
+	}
+
+	query := search.Query{
+		Text:  text,
+		Fuzzy: fuzzy,
+		Limit: limit,
+	}
+	if types != "" {
+		query.Types = strings.Split(types, ",")
+	}
+
+	return searchReq{query: query}, nil
+}
@@ -0,0 +1,7 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package search contains the search service, which consumes entity
+// events into an inverted index and exposes full-text search over
+// users, clients, groups and channels.
+package search
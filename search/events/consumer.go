@@ -0,0 +1,94 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package events
+
+import (
+	"context"
+	"strings"
+
+	"github.com/absmach/supermq/pkg/events"
+	"github.com/absmach/supermq/pkg/events/store"
+	"github.com/absmach/supermq/pkg/policies"
+	"github.com/absmach/supermq/search"
+)
+
+// entityTypes maps event operation prefixes to indexed entity types.
+var entityTypes = map[string]string{
+	"user":    policies.UserType,
+	"client":  policies.ClientType,
+	"group":   policies.GroupType,
+	"channel": policies.ChannelType,
+}
+
+// Start method starts consuming messages received from Event store.
+func Start(ctx context.Context, consumer string, sub events.Subscriber, svc search.Service) error {
+	subCfg := events.SubscriberConfig{
+		Consumer: consumer,
+		Stream:   store.StreamAllEvents,
+		Handler:  Handle(svc),
+	}
+
+	return sub.Subscribe(ctx, subCfg)
+}
+
+// Handle returns an event handler indexing entity creations and
+// updates and removing deleted entities. Events of other operations are
+// ignored.
+func Handle(svc search.Service) handleFunc {
+	return func(ctx context.Context, event events.Event) error {
+		data, err := event.Encode()
+		if err != nil {
+			return err
+		}
+
+		operation, _ := data["operation"].(string)
+		prefix, action, ok := strings.Cut(operation, ".")
+		if !ok {
+			return nil
+		}
+		entityType, ok := entityTypes[prefix]
+		if !ok {
+			return nil
+		}
+		id, _ := data["id"].(string)
+		if id == "" {
+			return nil
+		}
+
+		switch {
+		case action == "create" || strings.HasPrefix(action, "update"):
+			doc := search.Document{
+				ID:   id,
+				Type: entityType,
+			}
+			doc.Name, _ = data["name"].(string)
+			doc.Domain, _ = data["domain"].(string)
+			switch tags := data["tags"].(type) {
+			case []interface{}:
+				for _, tag := range tags {
+					if t, ok := tag.(string); ok {
+						doc.Tags = append(doc.Tags, t)
+					}
+				}
+			case []string:
+				doc.Tags = tags
+			}
+			return svc.Index(ctx, doc)
+		case action == "remove" || action == "delete":
+			return svc.Remove(ctx, entityType, id)
+		default:
+			return nil
+		}
+	}
+}
+
+type handleFunc func(ctx context.Context, event events.Event) error
+
+func (h handleFunc) Handle(ctx context.Context, event events.Event) error {
+	return h(ctx, event)
+}
+
+func (h handleFunc) Cancel() error {
+	return nil
+}
@@ -0,0 +1,105 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package events_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/absmach/supermq/pkg/authn"
+	authzmocks "github.com/absmach/supermq/pkg/authz/mocks"
+	"github.com/absmach/supermq/pkg/policies"
+	"github.com/absmach/supermq/search"
+	"github.com/absmach/supermq/search/events"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type testEvent map[string]interface{}
+
+func (e testEvent) Encode() (map[string]interface{}, error) {
+	return e, nil
+}
+
+var session = authn.Session{
+	UserID:       "user",
+	DomainID:     "domain",
+	DomainUserID: "domain_user",
+}
+
+func TestHandle(t *testing.T) {
+	authz := new(authzmocks.Authorization)
+	authz.On("Authorize", mock.Anything, mock.Anything).Return(nil)
+	svc := search.NewService(authz)
+	handler := events.Handle(svc)
+
+	cases := []struct {
+		desc     string
+		event    testEvent
+		query    string
+		expected int
+	}{
+		{
+			desc: "client create event is indexed",
+			event: testEvent{
+				"operation": "client.create",
+				"id":        "client-1",
+				"name":      "temperature sensor",
+				"tags":      []interface{}{"lora"},
+				"domain":    "domain",
+			},
+			query:    "temperature",
+			expected: 1,
+		},
+		{
+			desc: "client update event reindexes",
+			event: testEvent{
+				"operation": "client.update",
+				"id":        "client-1",
+				"name":      "humidity sensor",
+			},
+			query:    "humidity",
+			expected: 1,
+		},
+		{
+			desc: "view event is ignored",
+			event: testEvent{
+				"operation": "client.view",
+				"id":        "client-2",
+				"name":      "ignored",
+			},
+			query:    "ignored",
+			expected: 0,
+		},
+		{
+			desc: "unknown operation is ignored",
+			event: testEvent{
+				"operation": "something",
+			},
+			query:    "something",
+			expected: 0,
+		},
+		{
+			desc: "client remove event deletes the document",
+			event: testEvent{
+				"operation": "client.remove",
+				"id":        "client-1",
+			},
+			query:    "humidity",
+			expected: 0,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := handler.Handle(context.Background(), tc.event)
+			require.NoError(t, err)
+
+			page, err := svc.Search(context.Background(), session, search.Query{Text: tc.query, Types: []string{policies.ClientType}})
+			require.NoError(t, err)
+			assert.Len(t, page.Documents, tc.expected)
+		})
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package search
+
+import (
+	"context"
+
+	"github.com/absmach/supermq/pkg/authn"
+)
+
+// Document is an indexed entity.
+type Document struct {
+	ID     string   `json:"id"`
+	Type   string   `json:"type"`
+	Domain string   `json:"domain,omitempty"`
+	Name   string   `json:"name,omitempty"`
+	Tags   []string `json:"tags,omitempty"`
+}
+
+// Query is a search query. Types narrows the search to the given entity
+// types and Fuzzy additionally matches terms by prefix and single-edit
+// typos.
+type Query struct {
+	Text  string
+	Types []string
+	Fuzzy bool
+	Limit uint64
+}
+
+// DocumentsPage contains a page of matched documents.
+type DocumentsPage struct {
+	Total     uint64     `json:"total"`
+	Limit     uint64     `json:"limit"`
+	Documents []Document `json:"documents"`
+}
+
+// Service specifies the search service API.
+type Service interface {
+	// Index adds an entity to the index or updates it in place.
+	Index(ctx context.Context, doc Document) error
+
+	// Remove deletes an entity from the index.
+	Remove(ctx context.Context, docType, id string) error
+
+	// Search retrieves documents matching the query, filtered to those
+	// the session is authorized to view.
+	Search(ctx context.Context, session authn.Session, q Query) (DocumentsPage, error)
+}
@@ -0,0 +1,252 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package search
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/absmach/supermq/pkg/authn"
+	smqauthz "github.com/absmach/supermq/pkg/authz"
+	"github.com/absmach/supermq/pkg/policies"
+)
+
+const (
+	defLimit = 10
+	maxLimit = 100
+
+	readPermission = "read_permission"
+
+	exactWeight = 2
+	fuzzyWeight = 1
+)
+
+type service struct {
+	authz smqauthz.Authorization
+
+	mu    sync.RWMutex
+	docs  map[string]Document
+	terms map[string]map[string]bool
+}
+
+var _ Service = (*service)(nil)
+
+// NewService returns a new search service backed by an in-memory
+// inverted index, filtering results with the given authorization
+// service.
+func NewService(authz smqauthz.Authorization) Service {
+	return &service{
+		authz: authz,
+		docs:  make(map[string]Document),
+		terms: make(map[string]map[string]bool),
+	}
+}
+
+func (svc *service) Index(ctx context.Context, doc Document) error {
+	key := docKey(doc.Type, doc.ID)
+
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+
+	// Partial events, such as tag updates, carry only the changed
+	// fields; keep the indexed values for the missing ones.
+	if existing, ok := svc.docs[key]; ok {
+		if doc.Name == "" {
+			doc.Name = existing.Name
+		}
+		if doc.Tags == nil {
+			doc.Tags = existing.Tags
+		}
+		if doc.Domain == "" {
+			doc.Domain = existing.Domain
+		}
+		svc.unindex(key, existing)
+	}
+
+	svc.docs[key] = doc
+	for _, term := range docTerms(doc) {
+		if svc.terms[term] == nil {
+			svc.terms[term] = make(map[string]bool)
+		}
+		svc.terms[term][key] = true
+	}
+
+	return nil
+}
+
+func (svc *service) Remove(ctx context.Context, docType, id string) error {
+	key := docKey(docType, id)
+
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+
+	doc, ok := svc.docs[key]
+	if !ok {
+		return nil
+	}
+	svc.unindex(key, doc)
+	delete(svc.docs, key)
+
+	return nil
+}
+
+func (svc *service) Search(ctx context.Context, session authn.Session, q Query) (DocumentsPage, error) {
+	if q.Limit == 0 {
+		q.Limit = defLimit
+	}
+	if q.Limit > maxLimit {
+		q.Limit = maxLimit
+	}
+
+	svc.mu.RLock()
+	scores := map[string]int{}
+	for _, token := range tokenize(q.Text) {
+		for key := range svc.terms[token] {
+			scores[key] += exactWeight
+		}
+		if q.Fuzzy {
+			for term, keys := range svc.terms {
+				if term == token || !fuzzyMatch(term, token) {
+					continue
+				}
+				for key := range keys {
+					scores[key] += fuzzyWeight
+				}
+			}
+		}
+	}
+	keys := make([]string, 0, len(scores))
+	for key := range scores {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if scores[keys[i]] != scores[keys[j]] {
+			return scores[keys[i]] > scores[keys[j]]
+		}
+		return keys[i] < keys[j]
+	})
+	candidates := make([]Document, 0, len(keys))
+	for _, key := range keys {
+		candidates = append(candidates, svc.docs[key])
+	}
+	svc.mu.RUnlock()
+
+	page := DocumentsPage{
+		Limit:     q.Limit,
+		Documents: []Document{},
+	}
+	for _, doc := range candidates {
+		if !matchesType(doc, q.Types) {
+			continue
+		}
+		if !svc.authorized(ctx, session, doc) {
+			continue
+		}
+		page.Total++
+		if uint64(len(page.Documents)) < q.Limit {
+			page.Documents = append(page.Documents, doc)
+		}
+	}
+
+	return page, nil
+}
+
+// unindex removes the postings of a document. The caller must hold the
+// write lock.
+func (svc *service) unindex(key string, doc Document) {
+	for _, term := range docTerms(doc) {
+		delete(svc.terms[term], key)
+		if len(svc.terms[term]) == 0 {
+			delete(svc.terms, term)
+		}
+	}
+}
+
+// authorized reports whether the session may view the document. Users
+// are only visible to themselves and to platform administrators; other
+// entities are checked against the policy store within the session
+// domain.
+func (svc *service) authorized(ctx context.Context, session authn.Session, doc Document) bool {
+	if doc.Type == policies.UserType {
+		return doc.ID == session.UserID || session.SuperAdmin
+	}
+	if doc.Domain != session.DomainID {
+		return false
+	}
+	err := svc.authz.Authorize(ctx, smqauthz.PolicyReq{
+		Domain:      session.DomainID,
+		SubjectType: policies.UserType,
+		Subject:     session.DomainUserID,
+		ObjectType:  doc.Type,
+		Object:      doc.ID,
+		Permission:  readPermission,
+	})
+	return err == nil
+}
+
+func matchesType(doc Document, types []string) bool {
+	if len(types) == 0 {
+		return true
+	}
+	for _, t := range types {
+		if doc.Type == t {
+			return true
+		}
+	}
+	return false
+}
+
+func docKey(docType, id string) string {
+	return docType + "/" + id
+}
+
+func docTerms(doc Document) []string {
+	terms := tokenize(doc.Name)
+	for _, tag := range doc.Tags {
+		terms = append(terms, tokenize(tag)...)
+	}
+	return terms
+}
+
+// tokenize lowercases the text and splits it on any character that is
+// neither a letter nor a digit.
+func tokenize(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// fuzzyMatch reports whether a term matches a query token by prefix or
+// within a single edit.
+func fuzzyMatch(term, token string) bool {
+	if strings.HasPrefix(term, token) {
+		return true
+	}
+	return editDistance(term, token) <= 1
+}
+
+// editDistance is the Levenshtein distance between two strings.
+func editDistance(a, b string) int {
+	ar, br := []rune(a), []rune(b)
+	prev := make([]int, len(br)+1)
+	curr := make([]int, len(br)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ar); i++ {
+		curr[0] = i
+		for j := 1; j <= len(br); j++ {
+			cost := 1
+			if ar[i-1] == br[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, min(curr[j-1]+1, prev[j-1]+cost))
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(br)]
+}
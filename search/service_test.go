@@ -0,0 +1,163 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package search_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/absmach/supermq/pkg/authn"
+	smqauthz "github.com/absmach/supermq/pkg/authz"
+	authzmocks "github.com/absmach/supermq/pkg/authz/mocks"
+	svcerr "github.com/absmach/supermq/pkg/errors/service"
+	"github.com/absmach/supermq/pkg/policies"
+	"github.com/absmach/supermq/search"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var session = authn.Session{
+	UserID:       "user",
+	DomainID:     "domain",
+	DomainUserID: "domain_user",
+}
+
+func newService(t *testing.T) (search.Service, *authzmocks.Authorization) {
+	authz := new(authzmocks.Authorization)
+	return search.NewService(authz), authz
+}
+
+func TestSearch(t *testing.T) {
+	svc, authz := newService(t)
+	authz.On("Authorize", mock.Anything, mock.Anything).Return(nil)
+
+	docs := []search.Document{
+		{ID: "client-1", Type: policies.ClientType, Domain: "domain", Name: "temperature sensor", Tags: []string{"lora"}},
+		{ID: "client-2", Type: policies.ClientType, Domain: "domain", Name: "humidity sensor"},
+		{ID: "channel-1", Type: policies.ChannelType, Domain: "domain", Name: "sensor readings"},
+		{ID: "client-3", Type: policies.ClientType, Domain: "other", Name: "remote sensor"},
+	}
+	for _, doc := range docs {
+		require.NoError(t, svc.Index(context.Background(), doc))
+	}
+
+	cases := []struct {
+		desc     string
+		query    search.Query
+		expected []string
+	}{
+		{
+			desc:     "matching documents in the session domain",
+			query:    search.Query{Text: "sensor"},
+			expected: []string{"channel-1", "client-1", "client-2"},
+		},
+		{
+			desc:     "type filter",
+			query:    search.Query{Text: "sensor", Types: []string{policies.ChannelType}},
+			expected: []string{"channel-1"},
+		},
+		{
+			desc:     "tag match",
+			query:    search.Query{Text: "lora"},
+			expected: []string{"client-1"},
+		},
+		{
+			desc:     "exact matches rank above fuzzy ones",
+			query:    search.Query{Text: "humidity", Fuzzy: true},
+			expected: []string{"client-2"},
+		},
+		{
+			desc:     "fuzzy match with a typo",
+			query:    search.Query{Text: "sensir", Fuzzy: true},
+			expected: []string{"channel-1", "client-1", "client-2"},
+		},
+		{
+			desc:     "no match",
+			query:    search.Query{Text: "nonexistent"},
+			expected: []string{},
+		},
+		{
+			desc:     "limited results",
+			query:    search.Query{Text: "sensor", Limit: 1},
+			expected: []string{"channel-1", "client-1", "client-2"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			page, err := svc.Search(context.Background(), session, tc.query)
+			require.NoError(t, err)
+			assert.Equal(t, uint64(len(tc.expected)), page.Total)
+
+			expected := tc.expected
+			if tc.query.Limit != 0 && uint64(len(expected)) > tc.query.Limit {
+				expected = expected[:tc.query.Limit]
+			}
+			ids := []string{}
+			for _, doc := range page.Documents {
+				ids = append(ids, doc.ID)
+			}
+			assert.ElementsMatch(t, expected, ids)
+		})
+	}
+}
+
+func TestSearchAuthzFiltering(t *testing.T) {
+	svc, authz := newService(t)
+	authz.On("Authorize", mock.Anything, mock.MatchedBy(func(pr smqauthz.PolicyReq) bool {
+		return pr.Object == "client-1"
+	})).Return(nil)
+	authz.On("Authorize", mock.Anything, mock.Anything).Return(svcerr.ErrAuthorization)
+
+	require.NoError(t, svc.Index(context.Background(), search.Document{ID: "client-1", Type: policies.ClientType, Domain: "domain", Name: "sensor"}))
+	require.NoError(t, svc.Index(context.Background(), search.Document{ID: "client-2", Type: policies.ClientType, Domain: "domain", Name: "sensor"}))
+
+	page, err := svc.Search(context.Background(), session, search.Query{Text: "sensor"})
+	require.NoError(t, err)
+	require.Len(t, page.Documents, 1)
+	assert.Equal(t, "client-1", page.Documents[0].ID)
+}
+
+func TestSearchUsers(t *testing.T) {
+	svc, _ := newService(t)
+
+	require.NoError(t, svc.Index(context.Background(), search.Document{ID: "user", Type: policies.UserType, Name: "john"}))
+	require.NoError(t, svc.Index(context.Background(), search.Document{ID: "other", Type: policies.UserType, Name: "john"}))
+
+	page, err := svc.Search(context.Background(), session, search.Query{Text: "john"})
+	require.NoError(t, err)
+	require.Len(t, page.Documents, 1)
+	assert.Equal(t, "user", page.Documents[0].ID)
+
+	admin := session
+	admin.SuperAdmin = true
+	page, err = svc.Search(context.Background(), admin, search.Query{Text: "john"})
+	require.NoError(t, err)
+	assert.Len(t, page.Documents, 2)
+}
+
+func TestIndexUpdateAndRemove(t *testing.T) {
+	svc, authz := newService(t)
+	authz.On("Authorize", mock.Anything, mock.Anything).Return(nil)
+
+	require.NoError(t, svc.Index(context.Background(), search.Document{ID: "client-1", Type: policies.ClientType, Domain: "domain", Name: "old name", Tags: []string{"lora"}}))
+	// A partial update without tags keeps the indexed ones.
+	require.NoError(t, svc.Index(context.Background(), search.Document{ID: "client-1", Type: policies.ClientType, Name: "new name"}))
+
+	page, err := svc.Search(context.Background(), session, search.Query{Text: "old"})
+	require.NoError(t, err)
+	assert.Empty(t, page.Documents)
+
+	for _, text := range []string{"new", "lora"} {
+		page, err = svc.Search(context.Background(), session, search.Query{Text: text})
+		require.NoError(t, err)
+		require.Len(t, page.Documents, 1, text)
+	}
+
+	require.NoError(t, svc.Remove(context.Background(), policies.ClientType, "client-1"))
+	page, err = svc.Search(context.Background(), session, search.Query{Text: "new"})
+	require.NoError(t, err)
+	assert.Empty(t, page.Documents)
+}
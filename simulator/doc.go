@@ -0,0 +1,7 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package simulator provides a load generator that spawns virtual
+// clients publishing SenML messages over MQTT, HTTP or CoAP against a
+// deployment, with ramp-up profiles and latency and error reporting.
+package simulator
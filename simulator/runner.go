@@ -0,0 +1,166 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package simulator
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/absmach/supermq/pkg/errors"
+	svcerr "github.com/absmach/supermq/pkg/errors/service"
+)
+
+// maxSamples bounds the number of latencies kept for the percentile
+// report. Messages past the bound still count towards totals.
+const maxSamples = 100000
+
+var (
+	errNoThings   = errors.New("number of things must be greater than zero")
+	errNoChannel  = errors.New("missing channel")
+	errNoInterval = errors.New("interval must be greater than zero")
+	errNoDuration = errors.New("duration must be greater than zero")
+)
+
+// Runner drives one simulation run.
+type Runner struct {
+	profile Profile
+	factory TransportFactory
+	st      *stats
+}
+
+// NewRunner returns a runner for the given profile.
+func NewRunner(profile Profile, factory TransportFactory) (*Runner, error) {
+	if err := validateProfile(profile); err != nil {
+		return nil, errors.Wrap(svcerr.ErrMalformedEntity, err)
+	}
+	return &Runner{
+		profile: profile,
+		factory: factory,
+		st:      &stats{},
+	}, nil
+}
+
+// Run spawns the virtual clients, spreading their starts over the ramp
+// period, and blocks until the run duration elapses or the context is
+// canceled. It returns the latency and error report of the run.
+func (r *Runner) Run(ctx context.Context) Report {
+	ctx, cancel := context.WithTimeout(ctx, r.profile.Duration)
+	defer cancel()
+
+	stagger := r.profile.Ramp / time.Duration(r.profile.Things)
+
+	var wg sync.WaitGroup
+	for i := uint64(0); i < r.profile.Things; i++ {
+		wg.Add(1)
+		go func(i uint64) {
+			defer wg.Done()
+			select {
+			case <-time.After(stagger * time.Duration(i)):
+			case <-ctx.Done():
+				return
+			}
+			r.simulate(ctx, fmt.Sprintf("simulator-%d", i))
+		}(i)
+	}
+	wg.Wait()
+
+	return r.st.report()
+}
+
+func (r *Runner) simulate(ctx context.Context, id string) {
+	transport, err := r.factory(id)
+	if err != nil {
+		r.st.recordError()
+		return
+	}
+	defer transport.Close()
+
+	ticker := time.NewTicker(r.profile.Interval)
+	defer ticker.Stop()
+
+	for {
+		start := time.Now()
+		err := transport.Publish(ctx, r.profile.Channel, r.profile.Subtopic, payload(r.profile.Pattern))
+		switch err {
+		case nil:
+			r.st.recordSent(time.Since(start))
+		default:
+			if ctx.Err() != nil {
+				return
+			}
+			r.st.recordError()
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func validateProfile(profile Profile) error {
+	if profile.Things == 0 {
+		return errNoThings
+	}
+	if profile.Channel == "" {
+		return errNoChannel
+	}
+	if profile.Interval <= 0 {
+		return errNoInterval
+	}
+	if profile.Duration <= 0 {
+		return errNoDuration
+	}
+	return nil
+}
+
+type stats struct {
+	mu        sync.Mutex
+	sent      uint64
+	errs      uint64
+	latencies []time.Duration
+}
+
+func (s *stats) recordSent(latency time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sent++
+	if len(s.latencies) < maxSamples {
+		s.latencies = append(s.latencies, latency)
+	}
+}
+
+func (s *stats) recordError() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.errs++
+}
+
+func (s *stats) report() Report {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	report := Report{Sent: s.sent, Errors: s.errs}
+	if len(s.latencies) == 0 {
+		return report
+	}
+
+	latencies := make([]time.Duration, len(s.latencies))
+	copy(latencies, s.latencies)
+	sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+
+	var total time.Duration
+	for _, latency := range latencies {
+		total += latency
+	}
+	report.MinLatency = latencies[0]
+	report.MaxLatency = latencies[len(latencies)-1]
+	report.AvgLatency = total / time.Duration(len(latencies))
+	report.P95Latency = latencies[len(latencies)*95/100]
+	return report
+}
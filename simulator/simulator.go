@@ -0,0 +1,81 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package simulator
+
+import (
+	"encoding/json"
+	"math/rand"
+	"time"
+)
+
+// Supported transports.
+const (
+	TransportHTTP = "http"
+	TransportMQTT = "mqtt"
+	TransportCoAP = "coap"
+)
+
+// Pattern describes the SenML records the virtual clients publish.
+// Values are drawn uniformly from the [Min, Max] interval.
+type Pattern struct {
+	Name string  `json:"name"`
+	Unit string  `json:"unit"`
+	Min  float64 `json:"min"`
+	Max  float64 `json:"max"`
+}
+
+// Profile configures one simulation run.
+type Profile struct {
+	// Things is the number of virtual clients to spawn.
+	Things uint64 `json:"things"`
+
+	// Channel and Subtopic the clients publish to.
+	Channel  string `json:"channel"`
+	Subtopic string `json:"subtopic,omitempty"`
+
+	// Transport the clients publish over.
+	Transport string `json:"transport"`
+
+	// Interval between two messages of one client.
+	Interval time.Duration `json:"interval"`
+
+	// Ramp is the period over which client starts are spread.
+	Ramp time.Duration `json:"ramp"`
+
+	// Duration of the run.
+	Duration time.Duration `json:"duration"`
+
+	Pattern Pattern `json:"pattern"`
+}
+
+// Report summarizes a finished simulation run.
+type Report struct {
+	Sent       uint64        `json:"sent"`
+	Errors     uint64        `json:"errors"`
+	MinLatency time.Duration `json:"min_latency"`
+	MaxLatency time.Duration `json:"max_latency"`
+	AvgLatency time.Duration `json:"avg_latency"`
+	P95Latency time.Duration `json:"p95_latency"`
+}
+
+type senMLRecord struct {
+	Name  string  `json:"n"`
+	Unit  string  `json:"u,omitempty"`
+	Value float64 `json:"v"`
+	Time  float64 `json:"t"`
+}
+
+func payload(pattern Pattern) []byte {
+	record := senMLRecord{
+		Name:  pattern.Name,
+		Unit:  pattern.Unit,
+		Value: pattern.Min + rand.Float64()*(pattern.Max-pattern.Min),
+		Time:  float64(time.Now().UnixNano()) / float64(time.Second),
+	}
+	data, err := json.Marshal([]senMLRecord{record})
+	if err != nil {
+		return nil
+	}
+	return data
+}
@@ -0,0 +1,167 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package simulator_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/absmach/supermq/pkg/errors"
+	svcerr "github.com/absmach/supermq/pkg/errors/service"
+	"github.com/absmach/supermq/simulator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func validProfile() simulator.Profile {
+	return simulator.Profile{
+		Things:   3,
+		Channel:  "channel",
+		Interval: 10 * time.Millisecond,
+		Duration: 100 * time.Millisecond,
+		Pattern:  simulator.Pattern{Name: "temperature", Unit: "C", Min: 10, Max: 20},
+	}
+}
+
+type fakeTransport struct {
+	mu       sync.Mutex
+	err      error
+	payloads [][]byte
+}
+
+func (t *fakeTransport) Publish(ctx context.Context, channel, subtopic string, payload []byte) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.err != nil {
+		return t.err
+	}
+	t.payloads = append(t.payloads, payload)
+	return nil
+}
+
+func (t *fakeTransport) Close() error {
+	return nil
+}
+
+func TestNewRunner(t *testing.T) {
+	factory := func(id string) (simulator.Transport, error) {
+		return &fakeTransport{}, nil
+	}
+
+	cases := []struct {
+		desc    string
+		profile func(p simulator.Profile) simulator.Profile
+		err     error
+	}{
+		{
+			desc:    "valid profile",
+			profile: func(p simulator.Profile) simulator.Profile { return p },
+			err:     nil,
+		},
+		{
+			desc: "profile without things",
+			profile: func(p simulator.Profile) simulator.Profile {
+				p.Things = 0
+				return p
+			},
+			err: svcerr.ErrMalformedEntity,
+		},
+		{
+			desc: "profile without channel",
+			profile: func(p simulator.Profile) simulator.Profile {
+				p.Channel = ""
+				return p
+			},
+			err: svcerr.ErrMalformedEntity,
+		},
+		{
+			desc: "profile without interval",
+			profile: func(p simulator.Profile) simulator.Profile {
+				p.Interval = 0
+				return p
+			},
+			err: svcerr.ErrMalformedEntity,
+		},
+		{
+			desc: "profile without duration",
+			profile: func(p simulator.Profile) simulator.Profile {
+				p.Duration = 0
+				return p
+			},
+			err: svcerr.ErrMalformedEntity,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := simulator.NewRunner(tc.profile(validProfile()), factory)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("expected error %v got %v", tc.err, err))
+		})
+	}
+}
+
+func TestRun(t *testing.T) {
+	transport := &fakeTransport{}
+	var mu sync.Mutex
+	ids := []string{}
+	factory := func(id string) (simulator.Transport, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		ids = append(ids, id)
+		return transport, nil
+	}
+
+	runner, err := simulator.NewRunner(validProfile(), factory)
+	require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+
+	report := runner.Run(context.Background())
+	assert.Len(t, ids, 3)
+	assert.Zero(t, report.Errors)
+	assert.GreaterOrEqual(t, report.Sent, uint64(3))
+	assert.LessOrEqual(t, report.MinLatency, report.AvgLatency)
+	assert.LessOrEqual(t, report.AvgLatency, report.MaxLatency)
+
+	transport.mu.Lock()
+	defer transport.mu.Unlock()
+	require.NotEmpty(t, transport.payloads)
+	records := []map[string]interface{}{}
+	err = json.Unmarshal(transport.payloads[0], &records)
+	require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+	require.Len(t, records, 1)
+	assert.Equal(t, "temperature", records[0]["n"])
+	value, ok := records[0]["v"].(float64)
+	require.True(t, ok)
+	assert.GreaterOrEqual(t, value, 10.0)
+	assert.LessOrEqual(t, value, 20.0)
+}
+
+func TestRunPublishErrors(t *testing.T) {
+	transport := &fakeTransport{err: errors.New("publish failed")}
+	factory := func(id string) (simulator.Transport, error) {
+		return transport, nil
+	}
+
+	runner, err := simulator.NewRunner(validProfile(), factory)
+	require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+
+	report := runner.Run(context.Background())
+	assert.Zero(t, report.Sent)
+	assert.NotZero(t, report.Errors)
+}
+
+func TestRunConnectErrors(t *testing.T) {
+	factory := func(id string) (simulator.Transport, error) {
+		return nil, errors.New("connect failed")
+	}
+
+	runner, err := simulator.NewRunner(validProfile(), factory)
+	require.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+
+	report := runner.Run(context.Background())
+	assert.Zero(t, report.Sent)
+	assert.Equal(t, uint64(3), report.Errors)
+}
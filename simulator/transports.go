@@ -0,0 +1,182 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package simulator
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/absmach/supermq/pkg/errors"
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+	"github.com/plgd-dev/go-coap/v3/message"
+	"github.com/plgd-dev/go-coap/v3/message/codes"
+	"github.com/plgd-dev/go-coap/v3/udp"
+	coapclient "github.com/plgd-dev/go-coap/v3/udp/client"
+)
+
+const (
+	senMLContentType = "application/senml+json"
+	connectTimeout   = 15 * time.Second
+)
+
+var errUnknownTransport = errors.New("unknown transport")
+
+// Transport publishes messages on behalf of one virtual client.
+type Transport interface {
+	// Publish sends the payload to the channel.
+	Publish(ctx context.Context, channel, subtopic string, payload []byte) error
+
+	// Close releases the underlying connection.
+	Close() error
+}
+
+// TransportFactory creates a transport for the virtual client with the
+// given ID. Connection-oriented transports dial one connection per
+// client so that connection load matches the simulated fleet.
+type TransportFactory func(id string) (Transport, error)
+
+// NewTransportFactory returns a factory for the transport named in the
+// profile.
+func NewTransportFactory(transport, address, secret string) (TransportFactory, error) {
+	switch transport {
+	case TransportHTTP:
+		return NewHTTPFactory(address, secret), nil
+	case TransportMQTT:
+		return NewMQTTFactory(address, secret), nil
+	case TransportCoAP:
+		return NewCoAPFactory(address, secret), nil
+	default:
+		return nil, errors.Wrap(errUnknownTransport, errors.New(transport))
+	}
+}
+
+type httpTransport struct {
+	client  *http.Client
+	address string
+	secret  string
+}
+
+// NewHTTPFactory returns a factory creating transports that publish
+// over the HTTP adapter.
+func NewHTTPFactory(address, secret string) TransportFactory {
+	return func(id string) (Transport, error) {
+		return &httpTransport{
+			client:  &http.Client{Timeout: connectTimeout},
+			address: address,
+			secret:  secret,
+		}, nil
+	}
+}
+
+func (t *httpTransport) Publish(ctx context.Context, channel, subtopic string, payload []byte) error {
+	target := fmt.Sprintf("%s/channels/%s/messages", t.address, channel)
+	if subtopic != "" {
+		target = target + "/" + subtopic
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, target, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", senMLContentType)
+	req.SetBasicAuth("", t.secret)
+
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		return errors.New("unexpected status " + resp.Status)
+	}
+	return nil
+}
+
+func (t *httpTransport) Close() error {
+	t.client.CloseIdleConnections()
+	return nil
+}
+
+type mqttTransport struct {
+	client mqtt.Client
+}
+
+// NewMQTTFactory returns a factory creating transports that hold one
+// MQTT connection per virtual client.
+func NewMQTTFactory(address, secret string) TransportFactory {
+	return func(id string) (Transport, error) {
+		opts := mqtt.NewClientOptions().
+			AddBroker(address).
+			SetClientID(id).
+			SetUsername(id).
+			SetPassword(secret)
+		client := mqtt.NewClient(opts)
+		token := client.Connect()
+		if !token.WaitTimeout(connectTimeout) {
+			return nil, errors.New("timed out connecting to " + address)
+		}
+		if err := token.Error(); err != nil {
+			return nil, err
+		}
+		return &mqttTransport{client: client}, nil
+	}
+}
+
+func (t *mqttTransport) Publish(ctx context.Context, channel, subtopic string, payload []byte) error {
+	topic := fmt.Sprintf("channels/%s/messages", channel)
+	if subtopic != "" {
+		topic = topic + "/" + subtopic
+	}
+	token := t.client.Publish(topic, 0, false, payload)
+	if !token.WaitTimeout(connectTimeout) {
+		return errors.New("timed out publishing to " + topic)
+	}
+	return token.Error()
+}
+
+func (t *mqttTransport) Close() error {
+	t.client.Disconnect(uint(connectTimeout.Milliseconds()))
+	return nil
+}
+
+type coapTransport struct {
+	conn   *coapclient.Conn
+	secret string
+}
+
+// NewCoAPFactory returns a factory creating transports that hold one
+// CoAP connection per virtual client.
+func NewCoAPFactory(address, secret string) TransportFactory {
+	return func(id string) (Transport, error) {
+		conn, err := udp.Dial(address)
+		if err != nil {
+			return nil, err
+		}
+		return &coapTransport{conn: conn, secret: secret}, nil
+	}
+}
+
+func (t *coapTransport) Publish(ctx context.Context, channel, subtopic string, payload []byte) error {
+	path := fmt.Sprintf("/channels/%s/messages", channel)
+	if subtopic != "" {
+		path = path + "/" + subtopic
+	}
+	auth := message.Option{ID: message.URIQuery, Value: []byte("auth=" + t.secret)}
+	resp, err := t.conn.Post(ctx, path, message.AppJSON, bytes.NewReader(payload), auth)
+	if err != nil {
+		return err
+	}
+	switch resp.Code() {
+	case codes.Created, codes.Changed, codes.Content:
+		return nil
+	default:
+		return errors.New("unexpected response code " + resp.Code().String())
+	}
+}
+
+func (t *coapTransport) Close() error {
+	return t.conn.Close()
+}
@@ -0,0 +1,107 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package main contains sqlcheck, a vet-style check that rejects SQL built
+// by formatting values into string literals. Repositories compose named
+// queries from trusted fragments, which is fine, but formatting a value
+// inside SQL quotes, e.g.
+//
+//	fmt.Sprintf("id IN ('%s')", strings.Join(ids, "','"))
+//
+// bypasses parameter binding and is an injection risk. Such filters belong
+// in bound parameters, e.g. through postgres.CreateIDsQuery.
+//
+// Usage:
+//
+//	go run ./tools/sqlcheck [dir ...]
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// quotedVerb matches a formatting verb enclosed in SQL single quotes,
+// i.e. a value formatted into a SQL string literal.
+var quotedVerb = regexp.MustCompile(`'%[-+ #0.]*[0-9*]*(?:\.[0-9*]+)?[a-zA-Z]'`)
+
+func main() {
+	dirs := os.Args[1:]
+	if len(dirs) == 0 {
+		dirs = []string{"."}
+	}
+
+	var failed bool
+	for _, dir := range dirs {
+		if err := checkDir(dir, &failed); err != nil {
+			fmt.Fprintf(os.Stderr, "sqlcheck: %s\n", err)
+			os.Exit(2)
+		}
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
+
+func checkDir(dir string, failed *bool) error {
+	return filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if name := d.Name(); name == "vendor" || strings.HasPrefix(name, ".") && name != "." {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+			return nil
+		}
+		return checkFile(path, failed)
+	})
+}
+
+func checkFile(path string, failed *bool) error {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, path, nil, 0)
+	if err != nil {
+		return err
+	}
+
+	ast.Inspect(f, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || len(call.Args) == 0 {
+			return true
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok || !strings.HasPrefix(sel.Sel.Name, "Sprintf") {
+			return true
+		}
+		if pkg, ok := sel.X.(*ast.Ident); !ok || pkg.Name != "fmt" {
+			return true
+		}
+		lit, ok := call.Args[0].(*ast.BasicLit)
+		if !ok || lit.Kind != token.STRING {
+			return true
+		}
+		format, err := strconv.Unquote(lit.Value)
+		if err != nil {
+			return true
+		}
+		if quotedVerb.MatchString(format) {
+			fmt.Printf("%s: value formatted inside SQL quotes; bind it as a parameter instead\n", fset.Position(lit.Pos()))
+			*failed = true
+		}
+		return true
+	})
+
+	return nil
+}
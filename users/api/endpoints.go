@@ -126,6 +126,64 @@ func listUsersEndpoint(svc users.Service) endpoint.Endpoint {
 	}
 }
 
+func listUserTrashEndpoint(svc users.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(listUserTrashReq)
+		if err := req.validate(); err != nil {
+			return nil, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(api.SessionKey).(authn.Session)
+		if !ok {
+			return nil, svcerr.ErrAuthentication
+		}
+
+		pm := users.Page{
+			Offset: req.offset,
+			Limit:  req.limit,
+		}
+		page, err := svc.ListTrash(ctx, session, pm)
+		if err != nil {
+			return nil, err
+		}
+
+		res := usersPageRes{
+			pageRes: pageRes{
+				Total:  page.Total,
+				Offset: page.Offset,
+				Limit:  page.Limit,
+			},
+			Users: []viewUserRes{},
+		}
+		for _, user := range page.Users {
+			res.Users = append(res.Users, viewUserRes{User: user})
+		}
+
+		return res, nil
+	}
+}
+
+func restoreUserEndpoint(svc users.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(changeUserStatusReq)
+		if err := req.validate(); err != nil {
+			return nil, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(api.SessionKey).(authn.Session)
+		if !ok {
+			return nil, svcerr.ErrAuthentication
+		}
+
+		user, err := svc.Restore(ctx, session, req.id)
+		if err != nil {
+			return nil, err
+		}
+
+		return changeUserStatusRes{User: user}, nil
+	}
+}
+
 func searchUsersEndpoint(svc users.Service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(searchUsersReq)
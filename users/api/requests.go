@@ -49,7 +49,7 @@ func (req createUserReq) validate() error {
 	if req.User.Credentials.Secret == "" {
 		return apiutil.ErrMissingPass
 	}
-	if !passRegex.MatchString(req.User.Credentials.Secret) {
+	if !passRegex.Load().MatchString(req.User.Credentials.Secret) {
 		return apiutil.ErrPasswordFormat
 	}
 	if req.User.Status == users.AllStatus {
@@ -102,6 +102,19 @@ func (req listUsersReq) validate() error {
 	return nil
 }
 
+type listUserTrashReq struct {
+	offset uint64
+	limit  uint64
+}
+
+func (req listUserTrashReq) validate() error {
+	if req.limit > maxLimitSize || req.limit < 1 {
+		return apiutil.ErrLimitSize
+	}
+
+	return nil
+}
+
 type searchUsersReq struct {
 	Offset    uint64
 	Limit     uint64
@@ -205,7 +218,7 @@ func (req updateUserSecretReq) validate() error {
 	if req.OldSecret == "" || req.NewSecret == "" {
 		return apiutil.ErrMissingPass
 	}
-	if !passRegex.MatchString(req.NewSecret) {
+	if !passRegex.Load().MatchString(req.NewSecret) {
 		return apiutil.ErrPasswordFormat
 	}
 
@@ -321,7 +334,7 @@ func (req resetTokenReq) validate() error {
 	if req.Password != req.ConfPass {
 		return apiutil.ErrInvalidResetPass
 	}
-	if !passRegex.MatchString(req.ConfPass) {
+	if !passRegex.Load().MatchString(req.ConfPass) {
 		return apiutil.ErrPasswordFormat
 	}
 
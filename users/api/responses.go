@@ -6,8 +6,10 @@ package api
 import (
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/absmach/supermq"
+	api "github.com/absmach/supermq/api/http"
 	"github.com/absmach/supermq/users"
 )
 
@@ -28,6 +30,8 @@ var (
 	_ supermq.Response = (*updateUserRes)(nil)
 	_ supermq.Response = (*tokenRes)(nil)
 	_ supermq.Response = (*deleteUserRes)(nil)
+
+	_ api.Streamable = (*usersPageRes)(nil)
 )
 
 type pageRes struct {
@@ -122,7 +126,24 @@ func (res usersPageRes) Code() int {
 	return http.StatusOK
 }
 
+func (res usersPageRes) StreamItems() []interface{} {
+	items := make([]interface{}, 0, len(res.Users))
+	for _, u := range res.Users {
+		items = append(items, u)
+	}
+
+	return items
+}
+
 func (res usersPageRes) Headers() map[string]string {
+	times := make([]time.Time, 0, 2*len(res.Users))
+	for _, u := range res.Users {
+		times = append(times, u.CreatedAt, u.UpdatedAt)
+	}
+	if lm := api.LastModified(times...); lm != "" {
+		return map[string]string{"Last-Modified": lm}
+	}
+
 	return map[string]string{}
 }
 
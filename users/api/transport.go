@@ -10,6 +10,7 @@ import (
 
 	"github.com/absmach/supermq"
 	grpcTokenV1 "github.com/absmach/supermq/api/grpc/token/v1"
+	"github.com/absmach/supermq/apidocs"
 	smqauthn "github.com/absmach/supermq/pkg/authn"
 	"github.com/absmach/supermq/pkg/oauth2"
 	"github.com/absmach/supermq/users"
@@ -22,6 +23,7 @@ func MakeHandler(cls users.Service, authn smqauthn.Authentication, tokensvc grpc
 	mux = usersHandler(cls, authn, tokensvc, selfRegister, mux, logger, pr, providers...)
 
 	mux.Get("/health", supermq.Health("users", instanceID))
+	mux.Get("/api/openapi.json", apidocs.Handler("users"))
 	mux.Handle("/metrics", promhttp.Handler())
 
 	return mux
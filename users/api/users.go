@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"regexp"
 	"strings"
+	"sync/atomic"
 
 	grpcTokenV1 "github.com/absmach/supermq/api/grpc/token/v1"
 	api "github.com/absmach/supermq/api/http"
@@ -25,14 +26,24 @@ import (
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 )
 
-var passRegex = regexp.MustCompile("^.{8,}$")
+var passRegex atomic.Pointer[regexp.Regexp]
+
+func init() {
+	passRegex.Store(regexp.MustCompile("^.{8,}$"))
+}
+
+// SetPassRegex replaces the password validation pattern at runtime.
+func SetPassRegex(pr *regexp.Regexp) {
+	passRegex.Store(pr)
+}
 
 // usersHandler returns a HTTP handler for API endpoints.
 func usersHandler(svc users.Service, authn smqauthn.Authentication, tokenClient grpcTokenV1.TokenServiceClient, selfRegister bool, r *chi.Mux, logger *slog.Logger, pr *regexp.Regexp, providers ...oauth2.Provider) *chi.Mux {
-	passRegex = pr
+	SetPassRegex(pr)
 
 	opts := []kithttp.ServerOption{
 		kithttp.ServerErrorEncoder(apiutil.LoggingErrorEncoder(logger, api.EncodeError)),
+		kithttp.ServerBefore(api.AcceptToContext),
 	}
 
 	r.Route("/users", func(r chi.Router) {
@@ -77,6 +88,20 @@ func usersHandler(svc users.Service, authn smqauthn.Authentication, tokenClient
 				opts...,
 			), "list_users").ServeHTTP)
 
+			r.Get("/trash", otelhttp.NewHandler(kithttp.NewServer(
+				listUserTrashEndpoint(svc),
+				decodeListUserTrash,
+				api.EncodeResponse,
+				opts...,
+			), "list_user_trash").ServeHTTP)
+
+			r.Post("/trash/{id}/restore", otelhttp.NewHandler(kithttp.NewServer(
+				restoreUserEndpoint(svc),
+				decodeChangeUserStatus,
+				api.EncodeResponse,
+				opts...,
+			), "restore_user").ServeHTTP)
+
 			r.Get("/search", otelhttp.NewHandler(kithttp.NewServer(
 				searchUsersEndpoint(svc),
 				decodeSearchUsers,
@@ -542,6 +567,23 @@ func decodeCreateUserReq(_ context.Context, r *http.Request) (interface{}, error
 	return req, nil
 }
 
+func decodeListUserTrash(_ context.Context, r *http.Request) (interface{}, error) {
+	o, err := apiutil.ReadNumQuery[uint64](r, api.OffsetKey, api.DefOffset)
+	if err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	l, err := apiutil.ReadNumQuery[uint64](r, api.LimitKey, api.DefLimit)
+	if err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, err)
+	}
+
+	req := listUserTrashReq{
+		offset: o,
+		limit:  l,
+	}
+	return req, nil
+}
+
 func decodeChangeUserStatus(_ context.Context, r *http.Request) (interface{}, error) {
 	req := changeUserStatusReq{
 		id: chi.URLParam(r, "id"),
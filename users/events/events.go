@@ -15,6 +15,7 @@ const (
 	userCreate               = userPrefix + "create"
 	userUpdate               = userPrefix + "update"
 	userRemove               = userPrefix + "remove"
+	userRestore              = userPrefix + "restore"
 	userView                 = userPrefix + "view"
 	profileView              = userPrefix + "view_profile"
 	userList                 = userPrefix + "list"
@@ -52,6 +53,7 @@ var (
 	_ events.Event = (*sendPasswordResetEvent)(nil)
 	_ events.Event = (*oauthCallbackEvent)(nil)
 	_ events.Event = (*deleteUserEvent)(nil)
+	_ events.Event = (*restoreUserEvent)(nil)
 	_ events.Event = (*addUserPolicyEvent)(nil)
 )
 
@@ -200,6 +202,21 @@ func (rce removeUserEvent) Encode() (map[string]interface{}, error) {
 	}, nil
 }
 
+type restoreUserEvent struct {
+	id        string
+	updatedAt time.Time
+	updatedBy string
+}
+
+func (rue restoreUserEvent) Encode() (map[string]interface{}, error) {
+	return map[string]interface{}{
+		"operation":  userRestore,
+		"id":         rue.id,
+		"updated_at": rue.updatedAt,
+		"updated_by": rue.updatedBy,
+	}, nil
+}
+
 type viewUserEvent struct {
 	users.User
 }
@@ -375,6 +375,29 @@ func (es *eventStore) Delete(ctx context.Context, session authn.Session, id stri
 	return es.Publish(ctx, event)
 }
 
+func (es *eventStore) ListTrash(ctx context.Context, session authn.Session, pm users.Page) (users.UsersPage, error) {
+	return es.svc.ListTrash(ctx, session, pm)
+}
+
+func (es *eventStore) Restore(ctx context.Context, session authn.Session, id string) (users.User, error) {
+	user, err := es.svc.Restore(ctx, session, id)
+	if err != nil {
+		return user, err
+	}
+
+	event := restoreUserEvent{
+		id:        user.ID,
+		updatedAt: user.UpdatedAt,
+		updatedBy: user.UpdatedBy,
+	}
+
+	if err := es.Publish(ctx, event); err != nil {
+		return user, err
+	}
+
+	return user, nil
+}
+
 func (es *eventStore) OAuthAddUserPolicy(ctx context.Context, user users.User) error {
 	if err := es.svc.OAuthAddUserPolicy(ctx, user); err != nil {
 		return err
@@ -392,6 +392,47 @@ func (am *authorizationMiddleware) Delete(ctx context.Context, session authn.Ses
 	return am.svc.Delete(ctx, session, id)
 }
 
+func (am *authorizationMiddleware) ListTrash(ctx context.Context, session authn.Session, pm users.Page) (users.UsersPage, error) {
+	if session.Type == authn.PersonalAccessToken {
+		if err := am.authz.AuthorizePAT(ctx, smqauthz.PatReq{
+			UserID:                   session.UserID,
+			PatID:                    session.ID,
+			PlatformEntityType:       smqauth.PlatformUsersScope,
+			OptionalDomainEntityType: smqauth.DomainNullScope,
+			Operation:                smqauth.ListOp,
+			EntityIDs:                smqauth.AnyIDs{}.Values(),
+		}); err != nil {
+			return users.UsersPage{}, errors.Wrap(svcerr.ErrUnauthorizedPAT, err)
+		}
+	}
+	if err := am.checkSuperAdmin(ctx, session.UserID); err == nil {
+		session.SuperAdmin = true
+	}
+
+	return am.svc.ListTrash(ctx, session, pm)
+}
+
+func (am *authorizationMiddleware) Restore(ctx context.Context, session authn.Session, id string) (users.User, error) {
+	if session.Type == authn.PersonalAccessToken {
+		if err := am.authz.AuthorizePAT(ctx, smqauthz.PatReq{
+			UserID:                   session.UserID,
+			PatID:                    session.ID,
+			PlatformEntityType:       smqauth.PlatformUsersScope,
+			OptionalDomainEntityType: smqauth.DomainNullScope,
+			Operation:                smqauth.UpdateOp,
+			EntityIDs:                []string{id},
+		}); err != nil {
+			return users.User{}, errors.Wrap(svcerr.ErrUnauthorizedPAT, err)
+		}
+	}
+
+	if err := am.checkSuperAdmin(ctx, session.UserID); err == nil {
+		session.SuperAdmin = true
+	}
+
+	return am.svc.Restore(ctx, session, id)
+}
+
 func (am *authorizationMiddleware) Identify(ctx context.Context, session authn.Session) (string, error) {
 	return am.svc.Identify(ctx, session)
 }
@@ -39,11 +39,11 @@ func (lm *loggingMiddleware) Register(ctx context.Context, session authn.Session
 		}
 		if err != nil {
 			args = append(args, slog.Any("error", err))
-			lm.logger.Warn("Register user failed", args...)
+			lm.logger.WarnContext(ctx, "Register user failed", args...)
 			return
 		}
 		args = append(args, slog.String("user_id", u.ID))
-		lm.logger.Info("Register user completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Register user completed successfully", args...)
 	}(time.Now())
 	return lm.svc.Register(ctx, session, user, selfRegister)
 }
@@ -60,10 +60,10 @@ func (lm *loggingMiddleware) IssueToken(ctx context.Context, username, secret st
 		}
 		if err != nil {
 			args = append(args, slog.Any("error", err))
-			lm.logger.Warn("Issue token failed", args...)
+			lm.logger.WarnContext(ctx, "Issue token failed", args...)
 			return
 		}
-		lm.logger.Info("Issue token completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Issue token completed successfully", args...)
 	}(time.Now())
 	return lm.svc.IssueToken(ctx, username, secret)
 }
@@ -80,10 +80,10 @@ func (lm *loggingMiddleware) RefreshToken(ctx context.Context, session authn.Ses
 		}
 		if err != nil {
 			args = append(args, slog.Any("error", err))
-			lm.logger.Warn("Refresh token failed", args...)
+			lm.logger.WarnContext(ctx, "Refresh token failed", args...)
 			return
 		}
-		lm.logger.Info("Refresh token completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Refresh token completed successfully", args...)
 	}(time.Now())
 	return lm.svc.RefreshToken(ctx, session, refreshToken)
 }
@@ -100,10 +100,10 @@ func (lm *loggingMiddleware) View(ctx context.Context, session authn.Session, id
 		}
 		if err != nil {
 			args = append(args, slog.Any("error", err))
-			lm.logger.Warn("View user failed", args...)
+			lm.logger.WarnContext(ctx, "View user failed", args...)
 			return
 		}
-		lm.logger.Info("View user completed successfully", args...)
+		lm.logger.InfoContext(ctx, "View user completed successfully", args...)
 	}(time.Now())
 	return lm.svc.View(ctx, session, id)
 }
@@ -121,10 +121,10 @@ func (lm *loggingMiddleware) ViewProfile(ctx context.Context, session authn.Sess
 		}
 		if err != nil {
 			args = append(args, slog.Any("error", err))
-			lm.logger.Warn("View profile failed", args...)
+			lm.logger.WarnContext(ctx, "View profile failed", args...)
 			return
 		}
-		lm.logger.Info("View profile completed successfully", args...)
+		lm.logger.InfoContext(ctx, "View profile completed successfully", args...)
 	}(time.Now())
 	return lm.svc.ViewProfile(ctx, session)
 }
@@ -143,10 +143,10 @@ func (lm *loggingMiddleware) ListUsers(ctx context.Context, session authn.Sessio
 		}
 		if err != nil {
 			args = append(args, slog.Any("error", err))
-			lm.logger.Warn("List users failed", args...)
+			lm.logger.WarnContext(ctx, "List users failed", args...)
 			return
 		}
-		lm.logger.Info("List users completed successfully", args...)
+		lm.logger.InfoContext(ctx, "List users completed successfully", args...)
 	}(time.Now())
 	return lm.svc.ListUsers(ctx, session, pm)
 }
@@ -164,10 +164,10 @@ func (lm *loggingMiddleware) SearchUsers(ctx context.Context, cp users.Page) (mp
 		}
 		if err != nil {
 			args = append(args, slog.Any("error", err))
-			lm.logger.Warn("Search users failed to complete successfully", args...)
+			lm.logger.WarnContext(ctx, "Search users failed to complete successfully", args...)
 			return
 		}
-		lm.logger.Info("Search users completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Search users completed successfully", args...)
 	}(time.Now())
 	return lm.svc.SearchUsers(ctx, cp)
 }
@@ -188,10 +188,10 @@ func (lm *loggingMiddleware) Update(ctx context.Context, session authn.Session,
 		}
 		if err != nil {
 			args = append(args, slog.Any("error", err))
-			lm.logger.Warn("Update user failed", args...)
+			lm.logger.WarnContext(ctx, "Update user failed", args...)
 			return
 		}
-		lm.logger.Info("Update user completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Update user completed successfully", args...)
 	}(time.Now())
 	return lm.svc.Update(ctx, session, user)
 }
@@ -209,10 +209,10 @@ func (lm *loggingMiddleware) UpdateTags(ctx context.Context, session authn.Sessi
 		}
 		if err != nil {
 			args = append(args, slog.Any("error", err))
-			lm.logger.Warn("Update user tags failed", args...)
+			lm.logger.WarnContext(ctx, "Update user tags failed", args...)
 			return
 		}
-		lm.logger.Info("Update user tags completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Update user tags completed successfully", args...)
 	}(time.Now())
 	return lm.svc.UpdateTags(ctx, session, user)
 }
@@ -230,10 +230,10 @@ func (lm *loggingMiddleware) UpdateEmail(ctx context.Context, session authn.Sess
 		}
 		if err != nil {
 			args = append(args, slog.Any("error", err))
-			lm.logger.Warn("Update user email failed", args...)
+			lm.logger.WarnContext(ctx, "Update user email failed", args...)
 			return
 		}
-		lm.logger.Info("Update user email completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Update user email completed successfully", args...)
 	}(time.Now())
 	return lm.svc.UpdateEmail(ctx, session, id, email)
 }
@@ -250,10 +250,10 @@ func (lm *loggingMiddleware) UpdateSecret(ctx context.Context, session authn.Ses
 		}
 		if err != nil {
 			args = append(args, slog.Any("error", err))
-			lm.logger.Warn("Update user secret failed", args...)
+			lm.logger.WarnContext(ctx, "Update user secret failed", args...)
 			return
 		}
-		lm.logger.Info("Update user secret completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Update user secret completed successfully", args...)
 	}(time.Now())
 	return lm.svc.UpdateSecret(ctx, session, oldSecret, newSecret)
 }
@@ -271,10 +271,10 @@ func (lm *loggingMiddleware) UpdateUsername(ctx context.Context, session authn.S
 		}
 		if err != nil {
 			args = append(args, slog.Any("error", err))
-			lm.logger.Warn("Update user names failed", args...)
+			lm.logger.WarnContext(ctx, "Update user names failed", args...)
 			return
 		}
-		lm.logger.Info("Update user names completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Update user names completed successfully", args...)
 	}(time.Now())
 	return lm.svc.UpdateUsername(ctx, session, id, username)
 }
@@ -292,10 +292,10 @@ func (lm *loggingMiddleware) UpdateProfilePicture(ctx context.Context, session a
 		}
 		if err != nil {
 			args = append(args, slog.Any("error", err))
-			lm.logger.Warn("Update profile picture failed", args...)
+			lm.logger.WarnContext(ctx, "Update profile picture failed", args...)
 			return
 		}
-		lm.logger.Info("Update profile picture completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Update profile picture completed successfully", args...)
 	}(time.Now())
 	return lm.svc.UpdateProfilePicture(ctx, session, user)
 }
@@ -310,10 +310,10 @@ func (lm *loggingMiddleware) GenerateResetToken(ctx context.Context, email, host
 		}
 		if err != nil {
 			args = append(args, slog.Any("error", err))
-			lm.logger.Warn("Generate reset token failed", args...)
+			lm.logger.WarnContext(ctx, "Generate reset token failed", args...)
 			return
 		}
-		lm.logger.Info("Generate reset token completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Generate reset token completed successfully", args...)
 	}(time.Now())
 	return lm.svc.GenerateResetToken(ctx, email, host)
 }
@@ -327,10 +327,10 @@ func (lm *loggingMiddleware) ResetSecret(ctx context.Context, session authn.Sess
 		}
 		if err != nil {
 			args = append(args, slog.Any("error", err))
-			lm.logger.Warn("Reset secret failed", args...)
+			lm.logger.WarnContext(ctx, "Reset secret failed", args...)
 			return
 		}
-		lm.logger.Info("Reset secret completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Reset secret completed successfully", args...)
 	}(time.Now())
 	return lm.svc.ResetSecret(ctx, session, secret)
 }
@@ -345,10 +345,10 @@ func (lm *loggingMiddleware) SendPasswordReset(ctx context.Context, host, email,
 		}
 		if err != nil {
 			args = append(args, slog.Any("error", err))
-			lm.logger.Warn("Send password reset failed", args...)
+			lm.logger.WarnContext(ctx, "Send password reset failed", args...)
 			return
 		}
-		lm.logger.Info("Send password reset completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Send password reset completed successfully", args...)
 	}(time.Now())
 	return lm.svc.SendPasswordReset(ctx, host, email, user, token)
 }
@@ -366,16 +366,58 @@ func (lm *loggingMiddleware) UpdateRole(ctx context.Context, session authn.Sessi
 		}
 		if err != nil {
 			args = append(args, slog.Any("error", err))
-			lm.logger.Warn("Update user role failed", args...)
+			lm.logger.WarnContext(ctx, "Update user role failed", args...)
 			return
 		}
-		lm.logger.Info("Update user role completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Update user role completed successfully", args...)
 	}(time.Now())
 	return lm.svc.UpdateRole(ctx, session, user)
 }
 
 // Enable logs the enable_user request. It logs the user id and the time it took to complete the request.
 // If the request fails, it logs the error.
+// ListTrash logs the list_user_trash request. It logs the page metadata and the time it took to complete the request.
+// If the request fails, it logs the error.
+func (lm *loggingMiddleware) ListTrash(ctx context.Context, session authn.Session, pm users.Page) (up users.UsersPage, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.Group("page",
+				slog.Uint64("offset", pm.Offset),
+				slog.Uint64("limit", pm.Limit),
+				slog.Uint64("total", up.Total),
+			),
+		}
+		if err != nil {
+			args = append(args, slog.Any("error", err))
+			lm.logger.WarnContext(ctx, "List users in trash failed", args...)
+			return
+		}
+		lm.logger.InfoContext(ctx, "List users in trash completed successfully", args...)
+	}(time.Now())
+	return lm.svc.ListTrash(ctx, session, pm)
+}
+
+// Restore logs the restore_user request. It logs the user id and the time it took to complete the request.
+// If the request fails, it logs the error.
+func (lm *loggingMiddleware) Restore(ctx context.Context, session authn.Session, id string) (c users.User, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.Group("user",
+				slog.String("id", id),
+			),
+		}
+		if err != nil {
+			args = append(args, slog.Any("error", err))
+			lm.logger.WarnContext(ctx, "Restore user failed", args...)
+			return
+		}
+		lm.logger.InfoContext(ctx, "Restore user completed successfully", args...)
+	}(time.Now())
+	return lm.svc.Restore(ctx, session, id)
+}
+
 func (lm *loggingMiddleware) Enable(ctx context.Context, session authn.Session, id string) (c users.User, err error) {
 	defer func(begin time.Time) {
 		args := []any{
@@ -386,10 +428,10 @@ func (lm *loggingMiddleware) Enable(ctx context.Context, session authn.Session,
 		}
 		if err != nil {
 			args = append(args, slog.Any("error", err))
-			lm.logger.Warn("Enable user failed", args...)
+			lm.logger.WarnContext(ctx, "Enable user failed", args...)
 			return
 		}
-		lm.logger.Info("Enable user completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Enable user completed successfully", args...)
 	}(time.Now())
 	return lm.svc.Enable(ctx, session, id)
 }
@@ -406,10 +448,10 @@ func (lm *loggingMiddleware) Disable(ctx context.Context, session authn.Session,
 		}
 		if err != nil {
 			args = append(args, slog.Any("error", err))
-			lm.logger.Warn("Disable user failed", args...)
+			lm.logger.WarnContext(ctx, "Disable user failed", args...)
 			return
 		}
-		lm.logger.Info("Disable user completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Disable user completed successfully", args...)
 	}(time.Now())
 	return lm.svc.Disable(ctx, session, id)
 }
@@ -432,10 +474,10 @@ func (lm *loggingMiddleware) ListMembers(ctx context.Context, session authn.Sess
 		}
 		if err != nil {
 			args = append(args, slog.Any("error", err))
-			lm.logger.Warn("List members failed", args...)
+			lm.logger.WarnContext(ctx, "List members failed", args...)
 			return
 		}
-		lm.logger.Info("List members completed successfully", args...)
+		lm.logger.InfoContext(ctx, "List members completed successfully", args...)
 	}(time.Now())
 	return lm.svc.ListMembers(ctx, session, objectKind, objectID, cp)
 }
@@ -449,10 +491,10 @@ func (lm *loggingMiddleware) Identify(ctx context.Context, session authn.Session
 		}
 		if err != nil {
 			args = append(args, slog.Any("error", err))
-			lm.logger.Warn("Identify user failed", args...)
+			lm.logger.WarnContext(ctx, "Identify user failed", args...)
 			return
 		}
-		lm.logger.Info("Identify user completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Identify user completed successfully", args...)
 	}(time.Now())
 	return lm.svc.Identify(ctx, session)
 }
@@ -465,10 +507,10 @@ func (lm *loggingMiddleware) OAuthCallback(ctx context.Context, user users.User)
 		}
 		if err != nil {
 			args = append(args, slog.Any("error", err))
-			lm.logger.Warn("OAuth callback failed", args...)
+			lm.logger.WarnContext(ctx, "OAuth callback failed", args...)
 			return
 		}
-		lm.logger.Info("OAuth callback completed successfully", args...)
+		lm.logger.InfoContext(ctx, "OAuth callback completed successfully", args...)
 	}(time.Now())
 	return lm.svc.OAuthCallback(ctx, user)
 }
@@ -482,10 +524,10 @@ func (lm *loggingMiddleware) Delete(ctx context.Context, session authn.Session,
 		}
 		if err != nil {
 			args = append(args, slog.Any("error", err))
-			lm.logger.Warn("Delete user failed to complete successfully", args...)
+			lm.logger.WarnContext(ctx, "Delete user failed to complete successfully", args...)
 			return
 		}
-		lm.logger.Info("Delete user completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Delete user completed successfully", args...)
 	}(time.Now())
 	return lm.svc.Delete(ctx, session, id)
 }
@@ -499,10 +541,10 @@ func (lm *loggingMiddleware) OAuthAddUserPolicy(ctx context.Context, user users.
 		}
 		if err != nil {
 			args = append(args, slog.Any("error", err))
-			lm.logger.Warn("Add user policy failed", args...)
+			lm.logger.WarnContext(ctx, "Add user policy failed", args...)
 			return
 		}
-		lm.logger.Info("Add user policy completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Add user policy completed successfully", args...)
 	}(time.Now())
 	return lm.svc.OAuthAddUserPolicy(ctx, user)
 }
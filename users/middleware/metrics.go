@@ -9,6 +9,7 @@ import (
 
 	grpcTokenV1 "github.com/absmach/supermq/api/grpc/token/v1"
 	"github.com/absmach/supermq/pkg/authn"
+	"github.com/absmach/supermq/pkg/prometheus"
 	"github.com/absmach/supermq/users"
 	"github.com/go-kit/kit/metrics"
 )
@@ -31,18 +32,18 @@ func MetricsMiddleware(svc users.Service, counter metrics.Counter, latency metri
 }
 
 // Register instruments Register method with metrics.
-func (ms *metricsMiddleware) Register(ctx context.Context, session authn.Session, user users.User, selfRegister bool) (users.User, error) {
+func (ms *metricsMiddleware) Register(ctx context.Context, session authn.Session, user users.User, selfRegister bool) (u users.User, err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "register_user").Add(1)
+		ms.counter.With("method", "register_user", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "register_user").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.Register(ctx, session, user, selfRegister)
 }
 
 // IssueToken instruments IssueToken method with metrics.
-func (ms *metricsMiddleware) IssueToken(ctx context.Context, username, secret string) (*grpcTokenV1.Token, error) {
+func (ms *metricsMiddleware) IssueToken(ctx context.Context, username, secret string) (t *grpcTokenV1.Token, err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "issue_token").Add(1)
+		ms.counter.With("method", "issue_token", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "issue_token").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.IssueToken(ctx, username, secret)
@@ -51,34 +52,34 @@ func (ms *metricsMiddleware) IssueToken(ctx context.Context, username, secret st
 // RefreshToken instruments RefreshToken method with metrics.
 func (ms *metricsMiddleware) RefreshToken(ctx context.Context, session authn.Session, refreshToken string) (token *grpcTokenV1.Token, err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "refresh_token").Add(1)
+		ms.counter.With("method", "refresh_token", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "refresh_token").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.RefreshToken(ctx, session, refreshToken)
 }
 
 // View instruments View method with metrics.
-func (ms *metricsMiddleware) View(ctx context.Context, session authn.Session, id string) (users.User, error) {
+func (ms *metricsMiddleware) View(ctx context.Context, session authn.Session, id string) (c users.User, err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "view_user").Add(1)
+		ms.counter.With("method", "view_user", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "view_user").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.View(ctx, session, id)
 }
 
 // ViewProfile instruments ViewProfile method with metrics.
-func (ms *metricsMiddleware) ViewProfile(ctx context.Context, session authn.Session) (users.User, error) {
+func (ms *metricsMiddleware) ViewProfile(ctx context.Context, session authn.Session) (c users.User, err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "view_profile").Add(1)
+		ms.counter.With("method", "view_profile", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "view_profile").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.ViewProfile(ctx, session)
 }
 
 // ListUsers instruments ListUsers method with metrics.
-func (ms *metricsMiddleware) ListUsers(ctx context.Context, session authn.Session, pm users.Page) (users.UsersPage, error) {
+func (ms *metricsMiddleware) ListUsers(ctx context.Context, session authn.Session, pm users.Page) (cp users.UsersPage, err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "list_users").Add(1)
+		ms.counter.With("method", "list_users", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "list_users").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.ListUsers(ctx, session, pm)
@@ -87,115 +88,133 @@ func (ms *metricsMiddleware) ListUsers(ctx context.Context, session authn.Sessio
 // SearchUsers instruments SearchUsers method with metrics.
 func (ms *metricsMiddleware) SearchUsers(ctx context.Context, pm users.Page) (mp users.UsersPage, err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "search_users").Add(1)
+		ms.counter.With("method", "search_users", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "search_users").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.SearchUsers(ctx, pm)
 }
 
 // Update instruments Update method with metrics.
-func (ms *metricsMiddleware) Update(ctx context.Context, session authn.Session, user users.User) (users.User, error) {
+func (ms *metricsMiddleware) Update(ctx context.Context, session authn.Session, user users.User) (u users.User, err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "update_user").Add(1)
+		ms.counter.With("method", "update_user", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "update_user").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.Update(ctx, session, user)
 }
 
 // UpdateTags instruments UpdateTags method with metrics.
-func (ms *metricsMiddleware) UpdateTags(ctx context.Context, session authn.Session, user users.User) (users.User, error) {
+func (ms *metricsMiddleware) UpdateTags(ctx context.Context, session authn.Session, user users.User) (c users.User, err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "update_user_tags").Add(1)
+		ms.counter.With("method", "update_user_tags", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "update_user_tags").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.UpdateTags(ctx, session, user)
 }
 
 // UpdateEmail instruments UpdateEmail method with metrics.
-func (ms *metricsMiddleware) UpdateEmail(ctx context.Context, session authn.Session, id, email string) (users.User, error) {
+func (ms *metricsMiddleware) UpdateEmail(ctx context.Context, session authn.Session, id, email string) (c users.User, err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "update_user_email").Add(1)
+		ms.counter.With("method", "update_user_email", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "update_user_email").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.UpdateEmail(ctx, session, id, email)
 }
 
 // UpdateSecret instruments UpdateSecret method with metrics.
-func (ms *metricsMiddleware) UpdateSecret(ctx context.Context, session authn.Session, oldSecret, newSecret string) (users.User, error) {
+func (ms *metricsMiddleware) UpdateSecret(ctx context.Context, session authn.Session, oldSecret, newSecret string) (c users.User, err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "update_user_secret").Add(1)
+		ms.counter.With("method", "update_user_secret", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "update_user_secret").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.UpdateSecret(ctx, session, oldSecret, newSecret)
 }
 
 // UpdateUsername instruments UpdateUsername method with metrics.
-func (ms *metricsMiddleware) UpdateUsername(ctx context.Context, session authn.Session, id, username string) (users.User, error) {
+func (ms *metricsMiddleware) UpdateUsername(ctx context.Context, session authn.Session, id, username string) (u users.User, err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "update_usernames").Add(1)
+		ms.counter.With("method", "update_usernames", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "update_usernames").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.UpdateUsername(ctx, session, id, username)
 }
 
 // UpdateProfilePicture instruments UpdateProfilePicture method with metrics.
-func (ms *metricsMiddleware) UpdateProfilePicture(ctx context.Context, session authn.Session, user users.User) (users.User, error) {
+func (ms *metricsMiddleware) UpdateProfilePicture(ctx context.Context, session authn.Session, user users.User) (u users.User, err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "update_profile_picture").Add(1)
+		ms.counter.With("method", "update_profile_picture", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "update_profile_picture").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.UpdateProfilePicture(ctx, session, user)
 }
 
 // GenerateResetToken instruments GenerateResetToken method with metrics.
-func (ms *metricsMiddleware) GenerateResetToken(ctx context.Context, email, host string) error {
+func (ms *metricsMiddleware) GenerateResetToken(ctx context.Context, email, host string) (err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "generate_reset_token").Add(1)
+		ms.counter.With("method", "generate_reset_token", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "generate_reset_token").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.GenerateResetToken(ctx, email, host)
 }
 
 // ResetSecret instruments ResetSecret method with metrics.
-func (ms *metricsMiddleware) ResetSecret(ctx context.Context, session authn.Session, secret string) error {
+func (ms *metricsMiddleware) ResetSecret(ctx context.Context, session authn.Session, secret string) (err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "reset_secret").Add(1)
+		ms.counter.With("method", "reset_secret", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "reset_secret").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.ResetSecret(ctx, session, secret)
 }
 
 // SendPasswordReset instruments SendPasswordReset method with metrics.
-func (ms *metricsMiddleware) SendPasswordReset(ctx context.Context, host, email, user, token string) error {
+func (ms *metricsMiddleware) SendPasswordReset(ctx context.Context, host, email, user, token string) (err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "send_password_reset").Add(1)
+		ms.counter.With("method", "send_password_reset", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "send_password_reset").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.SendPasswordReset(ctx, host, email, user, token)
 }
 
 // UpdateRole instruments UpdateRole method with metrics.
-func (ms *metricsMiddleware) UpdateRole(ctx context.Context, session authn.Session, user users.User) (users.User, error) {
+func (ms *metricsMiddleware) UpdateRole(ctx context.Context, session authn.Session, user users.User) (c users.User, err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "update_user_role").Add(1)
+		ms.counter.With("method", "update_user_role", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "update_user_role").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.UpdateRole(ctx, session, user)
 }
 
 // Enable instruments Enable method with metrics.
-func (ms *metricsMiddleware) Enable(ctx context.Context, session authn.Session, id string) (users.User, error) {
+// ListTrash instruments ListTrash method with metrics.
+func (ms *metricsMiddleware) ListTrash(ctx context.Context, session authn.Session, pm users.Page) (up users.UsersPage, err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "enable_user").Add(1)
+		ms.counter.With("method", "list_user_trash", "error", prometheus.ErrorClass(err)).Add(1)
+		ms.latency.With("method", "list_user_trash").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+	return ms.svc.ListTrash(ctx, session, pm)
+}
+
+// Restore instruments Restore method with metrics.
+func (ms *metricsMiddleware) Restore(ctx context.Context, session authn.Session, id string) (c users.User, err error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "restore_user", "error", prometheus.ErrorClass(err)).Add(1)
+		ms.latency.With("method", "restore_user").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+	return ms.svc.Restore(ctx, session, id)
+}
+
+func (ms *metricsMiddleware) Enable(ctx context.Context, session authn.Session, id string) (c users.User, err error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "enable_user", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "enable_user").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.Enable(ctx, session, id)
 }
 
 // Disable instruments Disable method with metrics.
-func (ms *metricsMiddleware) Disable(ctx context.Context, session authn.Session, id string) (users.User, error) {
+func (ms *metricsMiddleware) Disable(ctx context.Context, session authn.Session, id string) (c users.User, err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "disable_user").Add(1)
+		ms.counter.With("method", "disable_user", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "disable_user").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.Disable(ctx, session, id)
@@ -204,43 +223,43 @@ func (ms *metricsMiddleware) Disable(ctx context.Context, session authn.Session,
 // ListMembers instruments ListMembers method with metrics.
 func (ms *metricsMiddleware) ListMembers(ctx context.Context, session authn.Session, objectKind, objectID string, pm users.Page) (mp users.MembersPage, err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "list_members").Add(1)
+		ms.counter.With("method", "list_members", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "list_members").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.ListMembers(ctx, session, objectKind, objectID, pm)
 }
 
 // Identify instruments Identify method with metrics.
-func (ms *metricsMiddleware) Identify(ctx context.Context, session authn.Session) (string, error) {
+func (ms *metricsMiddleware) Identify(ctx context.Context, session authn.Session) (id string, err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "identify").Add(1)
+		ms.counter.With("method", "identify", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "identify").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.Identify(ctx, session)
 }
 
 // OAuthCallback instruments OAuthCallback method with metrics.
-func (ms *metricsMiddleware) OAuthCallback(ctx context.Context, user users.User) (users.User, error) {
+func (ms *metricsMiddleware) OAuthCallback(ctx context.Context, user users.User) (c users.User, err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "oauth_callback").Add(1)
+		ms.counter.With("method", "oauth_callback", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "oauth_callback").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.OAuthCallback(ctx, user)
 }
 
 // Delete instruments Delete method with metrics.
-func (ms *metricsMiddleware) Delete(ctx context.Context, session authn.Session, id string) error {
+func (ms *metricsMiddleware) Delete(ctx context.Context, session authn.Session, id string) (err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "delete_user").Add(1)
+		ms.counter.With("method", "delete_user", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "delete_user").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.Delete(ctx, session, id)
 }
 
 // OAuthAddUserPolicy instruments OAuthAddUserPolicy method with metrics.
-func (ms *metricsMiddleware) OAuthAddUserPolicy(ctx context.Context, user users.User) error {
+func (ms *metricsMiddleware) OAuthAddUserPolicy(ctx context.Context, user users.User) (err error) {
 	defer func(begin time.Time) {
-		ms.counter.With("method", "add_user_policy").Add(1)
+		ms.counter.With("method", "add_user_policy", "error", prometheus.ErrorClass(err)).Add(1)
 		ms.latency.With("method", "add_user_policy").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 	return ms.svc.OAuthAddUserPolicy(ctx, user)
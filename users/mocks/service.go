@@ -199,6 +199,34 @@ func (_m *Service) ListMembers(ctx context.Context, session authn.Session, objec
 	return r0, r1
 }
 
+// ListTrash provides a mock function with given fields: ctx, session, pm
+func (_m *Service) ListTrash(ctx context.Context, session authn.Session, pm users.Page) (users.UsersPage, error) {
+	ret := _m.Called(ctx, session, pm)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTrash")
+	}
+
+	var r0 users.UsersPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, users.Page) (users.UsersPage, error)); ok {
+		return rf(ctx, session, pm)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, users.Page) users.UsersPage); ok {
+		r0 = rf(ctx, session, pm)
+	} else {
+		r0 = ret.Get(0).(users.UsersPage)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, authn.Session, users.Page) error); ok {
+		r1 = rf(ctx, session, pm)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListUsers provides a mock function with given fields: ctx, session, pm
 func (_m *Service) ListUsers(ctx context.Context, session authn.Session, pm users.Page) (users.UsersPage, error) {
 	ret := _m.Called(ctx, session, pm)
@@ -331,6 +359,34 @@ func (_m *Service) Register(ctx context.Context, session authn.Session, user use
 	return r0, r1
 }
 
+// Restore provides a mock function with given fields: ctx, session, id
+func (_m *Service) Restore(ctx context.Context, session authn.Session, id string) (users.User, error) {
+	ret := _m.Called(ctx, session, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restore")
+	}
+
+	var r0 users.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, string) (users.User, error)); ok {
+		return rf(ctx, session, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, authn.Session, string) users.User); ok {
+		r0 = rf(ctx, session, id)
+	} else {
+		r0 = ret.Get(0).(users.User)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, authn.Session, string) error); ok {
+		r1 = rf(ctx, session, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ResetSecret provides a mock function with given fields: ctx, session, secret
 func (_m *Service) ResetSecret(ctx context.Context, session authn.Session, secret string) error {
 	ret := _m.Called(ctx, session, secret)
@@ -18,6 +18,7 @@ import (
 	"github.com/absmach/supermq/pkg/postgres"
 	"github.com/absmach/supermq/users"
 	"github.com/jackc/pgtype"
+	"github.com/lib/pq"
 )
 
 type userRepo struct {
@@ -563,19 +564,20 @@ func ToUser(dbu DBUser) (users.User, error) {
 }
 
 type DBUsersPage struct {
-	Total     uint64       `db:"total"`
-	Limit     uint64       `db:"limit"`
-	Offset    uint64       `db:"offset"`
-	FirstName string       `db:"first_name"`
-	LastName  string       `db:"last_name"`
-	Username  string       `db:"username"`
-	Id        string       `db:"id"`
-	Email     string       `db:"email"`
-	Metadata  []byte       `db:"metadata"`
-	Tag       string       `db:"tag"`
-	GroupID   string       `db:"group_id"`
-	Role      users.Role   `db:"role"`
-	Status    users.Status `db:"status"`
+	Total     uint64         `db:"total"`
+	Limit     uint64         `db:"limit"`
+	Offset    uint64         `db:"offset"`
+	FirstName string         `db:"first_name"`
+	LastName  string         `db:"last_name"`
+	Username  string         `db:"username"`
+	Id        string         `db:"id"`
+	Ids       pq.StringArray `db:"ids"`
+	Email     string         `db:"email"`
+	Metadata  []byte         `db:"metadata"`
+	Tag       string         `db:"tag"`
+	GroupID   string         `db:"group_id"`
+	Role      users.Role     `db:"role"`
+	Status    users.Status   `db:"status"`
 }
 
 func ToDBUsersPage(pm users.Page) (DBUsersPage, error) {
@@ -583,6 +585,7 @@ func ToDBUsersPage(pm users.Page) (DBUsersPage, error) {
 	if err != nil {
 		return DBUsersPage{}, errors.Wrap(repoerr.ErrViewEntity, err)
 	}
+	_, ids := postgres.CreateIDsQuery("", pm.IDs)
 
 	return DBUsersPage{
 		FirstName: pm.FirstName,
@@ -590,6 +593,7 @@ func ToDBUsersPage(pm users.Page) (DBUsersPage, error) {
 		Username:  pm.Username,
 		Email:     pm.Email,
 		Id:        pm.Id,
+		Ids:       ids,
 		Metadata:  data,
 		Total:     pm.Total,
 		Offset:    pm.Offset,
@@ -633,8 +637,8 @@ func PageQuery(pm users.Page) (string, error) {
 		query = append(query, mq)
 	}
 
-	if len(pm.IDs) != 0 {
-		query = append(query, fmt.Sprintf("id IN ('%s')", strings.Join(pm.IDs, "','")))
+	if iq, _ := postgres.CreateIDsQuery("", pm.IDs); iq != "" {
+		query = append(query, iq)
 	}
 	if pm.Status != users.AllStatus {
 		query = append(query, "u.status = :status")
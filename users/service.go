@@ -25,20 +25,39 @@ var (
 	errFailedPermissionsList = errors.New("failed to list permissions")
 	errRecoveryToken         = errors.New("failed to generate password recovery token")
 	errLoginDisableUser      = errors.New("failed to login in disabled user")
+	errNotInTrash            = errors.New("user is not in the trash")
 )
 
 type service struct {
-	token      grpcTokenV1.TokenServiceClient
-	users      Repository
-	idProvider supermq.IDProvider
-	policies   policies.Service
-	hasher     Hasher
-	email      Emailer
+	token             grpcTokenV1.TokenServiceClient
+	users             Repository
+	idProvider        supermq.IDProvider
+	policies          policies.Service
+	hasher            Hasher
+	email             Emailer
+	metadataValidator MetadataValidator
+}
+
+// MetadataValidator validates entity metadata against the schema
+// registered for the owner.
+type MetadataValidator interface {
+	Validate(ctx context.Context, ownerID string, metadata map[string]interface{}) error
+}
+
+// Option is a functional option for the Users service.
+type Option func(*service)
+
+// WithMetadataValidator configures the service to validate user metadata
+// on register and update against the platform schema.
+func WithMetadataValidator(mv MetadataValidator) Option {
+	return func(svc *service) {
+		svc.metadataValidator = mv
+	}
 }
 
 // NewService returns a new Users service implementation.
-func NewService(token grpcTokenV1.TokenServiceClient, urepo Repository, policyService policies.Service, emailer Emailer, hasher Hasher, idp supermq.IDProvider) Service {
-	return service{
+func NewService(token grpcTokenV1.TokenServiceClient, urepo Repository, policyService policies.Service, emailer Emailer, hasher Hasher, idp supermq.IDProvider, opts ...Option) Service {
+	svc := service{
 		token:      token,
 		users:      urepo,
 		policies:   policyService,
@@ -46,6 +65,10 @@ func NewService(token grpcTokenV1.TokenServiceClient, urepo Repository, policySe
 		email:      emailer,
 		idProvider: idp,
 	}
+	for _, opt := range opts {
+		opt(&svc)
+	}
+	return svc
 }
 
 func (svc service) Register(ctx context.Context, session authn.Session, u User, selfRegister bool) (uc User, err error) {
@@ -74,6 +97,11 @@ func (svc service) Register(ctx context.Context, session authn.Session, u User,
 	if u.Role != UserRole && u.Role != AdminRole {
 		return User{}, errors.Wrap(svcerr.ErrMalformedEntity, svcerr.ErrInvalidRole)
 	}
+	if svc.metadataValidator != nil {
+		if err := svc.metadataValidator.Validate(ctx, "", u.Metadata); err != nil {
+			return User{}, errors.Wrap(svcerr.ErrMalformedEntity, err)
+		}
+	}
 	u.ID = userID
 	u.CreatedAt = time.Now()
 
@@ -112,6 +140,10 @@ func (svc service) IssueToken(ctx context.Context, identity, secret string) (*gr
 		return &grpcTokenV1.Token{}, errors.Wrap(svcerr.ErrLogin, err)
 	}
 
+	if dbUser.Status == DisabledStatus {
+		return &grpcTokenV1.Token{}, errors.Wrap(svcerr.ErrAuthentication, errLoginDisableUser)
+	}
+
 	token, err := svc.token.Issue(ctx, &grpcTokenV1.IssueReq{UserId: dbUser.ID, Type: uint32(smqauth.AccessKey)})
 	if err != nil {
 		return &grpcTokenV1.Token{}, errors.Wrap(errIssueToken, err)
@@ -202,6 +234,11 @@ func (svc service) Update(ctx context.Context, session authn.Session, usr User)
 			return User{}, err
 		}
 	}
+	if svc.metadataValidator != nil {
+		if err := svc.metadataValidator.Validate(ctx, "", usr.Metadata); err != nil {
+			return User{}, errors.Wrap(svcerr.ErrMalformedEntity, err)
+		}
+	}
 
 	user := User{
 		ID:        usr.ID,
@@ -469,6 +506,46 @@ func (svc service) Delete(ctx context.Context, session authn.Session, id string)
 	return nil
 }
 
+func (svc service) ListTrash(ctx context.Context, session authn.Session, pm Page) (UsersPage, error) {
+	if err := svc.checkSuperAdmin(ctx, session); err != nil {
+		return UsersPage{}, err
+	}
+
+	pm.Status = DeletedStatus
+	pm.Role = AllRole
+	pg, err := svc.users.RetrieveAll(ctx, pm)
+	if err != nil {
+		return UsersPage{}, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+	return pg, nil
+}
+
+func (svc service) Restore(ctx context.Context, session authn.Session, id string) (User, error) {
+	if err := svc.checkSuperAdmin(ctx, session); err != nil {
+		return User{}, err
+	}
+
+	dbUser, err := svc.users.RetrieveByID(ctx, id)
+	if err != nil {
+		return User{}, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+	if dbUser.Status != DeletedStatus {
+		return User{}, errors.Wrap(svcerr.ErrUpdateEntity, errNotInTrash)
+	}
+
+	user := User{
+		ID:        id,
+		Status:    EnabledStatus,
+		UpdatedAt: time.Now(),
+		UpdatedBy: session.UserID,
+	}
+	user, err = svc.users.ChangeStatus(ctx, user)
+	if err != nil {
+		return User{}, errors.Wrap(svcerr.ErrUpdateEntity, err)
+	}
+	return user, nil
+}
+
 func (svc service) ListMembers(ctx context.Context, session authn.Session, objectKind, objectID string, pm Page) (MembersPage, error) {
 	var objectType string
 	switch objectKind {
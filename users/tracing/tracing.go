@@ -195,6 +195,25 @@ func (tm *tracingMiddleware) UpdateRole(ctx context.Context, session authn.Sessi
 }
 
 // Enable traces the "Enable" operation of the wrapped users.Service.
+// ListTrash traces the "ListTrash" operation of the wrapped users.Service.
+func (tm *tracingMiddleware) ListTrash(ctx context.Context, session authn.Session, pm users.Page) (users.UsersPage, error) {
+	ctx, span := tm.tracer.Start(ctx, "svc_list_user_trash", trace.WithAttributes(
+		attribute.Int64("offset", int64(pm.Offset)),
+		attribute.Int64("limit", int64(pm.Limit)),
+	))
+	defer span.End()
+
+	return tm.svc.ListTrash(ctx, session, pm)
+}
+
+// Restore traces the "Restore" operation of the wrapped users.Service.
+func (tm *tracingMiddleware) Restore(ctx context.Context, session authn.Session, id string) (users.User, error) {
+	ctx, span := tm.tracer.Start(ctx, "svc_restore_user", trace.WithAttributes(attribute.String("id", id)))
+	defer span.End()
+
+	return tm.svc.Restore(ctx, session, id)
+}
+
 func (tm *tracingMiddleware) Enable(ctx context.Context, session authn.Session, id string) (users.User, error) {
 	ctx, span := tm.tracer.Start(ctx, "svc_enable_user", trace.WithAttributes(attribute.String("id", id)))
 	defer span.End()
@@ -195,9 +195,18 @@ type Service interface {
 	// Disable logically disables the user identified with the provided ID.
 	Disable(ctx context.Context, session authn.Session, id string) (User, error)
 
-	// Delete deletes user with given ID.
+	// Delete moves the user with given ID to the trash. Trashed users are
+	// purged permanently by the delete handler once the retention period
+	// expires.
 	Delete(ctx context.Context, session authn.Session, id string) error
 
+	// ListTrash retrieves users in the trash awaiting permanent deletion.
+	ListTrash(ctx context.Context, session authn.Session, pm Page) (UsersPage, error)
+
+	// Restore moves the user with given ID out of the trash back to the
+	// enabled state.
+	Restore(ctx context.Context, session authn.Session, id string) (User, error)
+
 	// Identify returns the user id from the given token.
 	Identify(ctx context.Context, session authn.Session) (string, error)
 
@@ -6,6 +6,7 @@ package ws
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	grpcChannelsV1 "github.com/absmach/supermq/api/grpc/channels/v1"
 	grpcClientsV1 "github.com/absmach/supermq/api/grpc/clients/v1"
@@ -30,6 +31,9 @@ var (
 
 	// ErrEmptyTopic indicate absence of clientKey in the request.
 	ErrEmptyTopic = errors.New("empty topic")
+
+	// errFailedDisconnect indicates that client connection couldn't be closed.
+	errFailedDisconnect = errors.New("failed to disconnect client")
 )
 
 // Service specifies web socket service API.
@@ -38,14 +42,25 @@ type Service interface {
 	// and the channelID for subscription. Subtopic is optional.
 	// If the subscription is successful, nil is returned otherwise error is returned.
 	Subscribe(ctx context.Context, clientKey, chanID, subtopic string, client *Client) error
+
+	// DisconnectClient terminates all live subscriptions of the given client
+	// and closes its websocket connections.
+	DisconnectClient(ctx context.Context, clientID string) error
 }
 
 var _ Service = (*adapterService)(nil)
 
+type subscription struct {
+	topic  string
+	client *Client
+}
+
 type adapterService struct {
 	clients  grpcClientsV1.ClientsServiceClient
 	channels grpcChannelsV1.ChannelsServiceClient
 	pubsub   messaging.PubSub
+	mu       sync.Mutex
+	subs     map[string][]subscription
 }
 
 // New instantiates the WS adapter implementation.
@@ -54,6 +69,7 @@ func New(clients grpcClientsV1.ClientsServiceClient, channels grpcChannelsV1.Cha
 		clients:  clients,
 		channels: channels,
 		pubsub:   pubsub,
+		subs:     make(map[string][]subscription),
 	}
 }
 
@@ -83,9 +99,32 @@ func (svc *adapterService) Subscribe(ctx context.Context, clientKey, chanID, sub
 		return ErrFailedSubscription
 	}
 
+	svc.mu.Lock()
+	svc.subs[clientID] = append(svc.subs[clientID], subscription{topic: subject, client: c})
+	svc.mu.Unlock()
+
 	return nil
 }
 
+func (svc *adapterService) DisconnectClient(ctx context.Context, clientID string) error {
+	svc.mu.Lock()
+	subs := svc.subs[clientID]
+	delete(svc.subs, clientID)
+	svc.mu.Unlock()
+
+	var err error
+	for _, sub := range subs {
+		if errU := svc.pubsub.Unsubscribe(ctx, clientID, sub.topic); errU != nil {
+			err = errors.Wrap(errFailedUnsubscribe, errU)
+		}
+		if errC := sub.client.Cancel(); errC != nil {
+			err = errors.Wrap(errFailedDisconnect, errC)
+		}
+	}
+
+	return err
+}
+
 // authorize checks if the clientKey is authorized to access the channel
 // and returns the clientID if it is.
 func (svc *adapterService) authorize(ctx context.Context, clientKey, chanID string, msgType connections.ConnType) (string, error) {
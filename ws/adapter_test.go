@@ -177,3 +177,30 @@ func TestSubscribe(t *testing.T) {
 		channelsCall.Unset()
 	}
 }
+
+func TestDisconnectClient(t *testing.T) {
+	svc, pubsub, clients, channels := newService()
+
+	c := ws.NewClient(nil)
+	topic := "channels." + chanID + "." + subTopic
+
+	clientsCall := clients.On("Authenticate", mock.Anything, &grpcClientsV1.AuthnReq{ClientSecret: clientKey}).Return(&grpcClientsV1.AuthnRes{Id: clientID, Authenticated: true}, nil)
+	channelsCall := channels.On("Authorize", mock.Anything, mock.Anything).Return(&grpcChannelsV1.AuthzRes{Authorized: true}, nil)
+	subCall := pubsub.On("Subscribe", mock.Anything, mock.Anything).Return(nil)
+	err := svc.Subscribe(context.Background(), clientKey, chanID, subTopic, c)
+	assert.Nil(t, err, fmt.Sprintf("expected nil got %s\n", err))
+
+	unsubCall := pubsub.On("Unsubscribe", mock.Anything, clientID, topic).Return(nil)
+	err = svc.DisconnectClient(context.Background(), clientID)
+	assert.Nil(t, err, fmt.Sprintf("expected nil got %s\n", err))
+	pubsub.AssertCalled(t, "Unsubscribe", mock.Anything, clientID, topic)
+
+	err = svc.DisconnectClient(context.Background(), clientID)
+	assert.Nil(t, err, fmt.Sprintf("expected nil got %s\n", err))
+	pubsub.AssertNumberOfCalls(t, "Unsubscribe", 1)
+
+	unsubCall.Unset()
+	subCall.Unset()
+	clientsCall.Unset()
+	channelsCall.Unset()
+}
@@ -100,7 +100,7 @@ func TestHandshake(t *testing.T) {
 	svc, pubsub := newService(clients, channels)
 	target := newHTTPServer(svc)
 	defer target.Close()
-	handler := ws.NewHandler(pubsub, smqlog.NewMock(), authn, clients, channels)
+	handler := ws.NewHandler(pubsub, smqlog.NewMock(), authn, clients, channels, 1024*1024)
 	ts, err := newProxyHTPPServer(handler, target)
 	require.Nil(t, err)
 	defer ts.Close()
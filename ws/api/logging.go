@@ -36,11 +36,30 @@ func (lm *loggingMiddleware) Subscribe(ctx context.Context, clientKey, chanID, s
 		}
 		if err != nil {
 			args = append(args, slog.Any("error", err))
-			lm.logger.Warn("Subscibe failed", args...)
+			lm.logger.WarnContext(ctx, "Subscibe failed", args...)
 			return
 		}
-		lm.logger.Info("Subscribe completed successfully", args...)
+		lm.logger.InfoContext(ctx, "Subscribe completed successfully", args...)
 	}(time.Now())
 
 	return lm.svc.Subscribe(ctx, clientKey, chanID, subtopic, c)
 }
+
+// DisconnectClient logs the disconnect request. It logs the client ID and the time it took to complete the request.
+// If the request fails, it logs the error.
+func (lm *loggingMiddleware) DisconnectClient(ctx context.Context, clientID string) (err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("client_id", clientID),
+		}
+		if err != nil {
+			args = append(args, slog.Any("error", err))
+			lm.logger.WarnContext(ctx, "Disconnect client failed", args...)
+			return
+		}
+		lm.logger.InfoContext(ctx, "Disconnect client completed successfully", args...)
+	}(time.Now())
+
+	return lm.svc.DisconnectClient(ctx, clientID)
+}
@@ -9,6 +9,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/absmach/supermq/pkg/prometheus"
 	"github.com/absmach/supermq/ws"
 	"github.com/go-kit/kit/metrics"
 )
@@ -31,11 +32,21 @@ func MetricsMiddleware(svc ws.Service, counter metrics.Counter, latency metrics.
 }
 
 // Subscribe instruments Subscribe method with metrics.
-func (mm *metricsMiddleware) Subscribe(ctx context.Context, clientKey, chanID, subtopic string, c *ws.Client) error {
+func (mm *metricsMiddleware) Subscribe(ctx context.Context, clientKey, chanID, subtopic string, c *ws.Client) (err error) {
 	defer func(begin time.Time) {
-		mm.counter.With("method", "subscribe").Add(1)
+		mm.counter.With("method", "subscribe", "error", prometheus.ErrorClass(err)).Add(1)
 		mm.latency.With("method", "subscribe").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 
 	return mm.svc.Subscribe(ctx, clientKey, chanID, subtopic, c)
 }
+
+// DisconnectClient instruments DisconnectClient method with metrics.
+func (mm *metricsMiddleware) DisconnectClient(ctx context.Context, clientID string) (err error) {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "disconnect_client", "error", prometheus.ErrorClass(err)).Add(1)
+		mm.latency.With("method", "disconnect_client").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.svc.DisconnectClient(ctx, clientID)
+}
@@ -22,6 +22,8 @@ import (
 	svcerr "github.com/absmach/supermq/pkg/errors/service"
 	"github.com/absmach/supermq/pkg/messaging"
 	"github.com/absmach/supermq/pkg/policies"
+	"github.com/absmach/supermq/pkg/ratelimit"
+	smqtopics "github.com/absmach/supermq/pkg/topics"
 )
 
 var _ session.Handler = (*handler)(nil)
@@ -48,27 +50,42 @@ var (
 	errFailedPublish            = errors.New("failed to publish")
 	errFailedParseSubtopic      = errors.New("failed to parse subtopic")
 	errFailedPublishToMsgBroker = errors.New("failed to publish to supermq message broker")
+	errRateLimitExceeded        = errors.New("anonymous access rate limit exceeded")
+	errPayloadTooLarge          = errors.New("payload size exceeds the maximum allowed")
+)
+
+// Rate limit for anonymous subscriptions, per channel.
+const (
+	anonRateLimit = 10
+	anonRateBurst = 20
 )
 
 var channelRegExp = regexp.MustCompile(`^\/?channels\/([\w\-]+)\/messages(\/[^?]*)?(\?.*)?$`)
 
 // Event implements events.Event interface.
 type handler struct {
-	pubsub   messaging.PubSub
-	clients  grpcClientsV1.ClientsServiceClient
-	channels grpcChannelsV1.ChannelsServiceClient
-	authn    smqauthn.Authentication
-	logger   *slog.Logger
+	pubsub     messaging.PubSub
+	clients    grpcClientsV1.ClientsServiceClient
+	channels   grpcChannelsV1.ChannelsServiceClient
+	authn      smqauthn.Authentication
+	logger     *slog.Logger
+	topics     *smqtopics.Cache
+	limiter    *ratelimit.Limiter
+	maxPayload uint64
 }
 
-// NewHandler creates new Handler entity.
-func NewHandler(pubsub messaging.PubSub, logger *slog.Logger, authn smqauthn.Authentication, clients grpcClientsV1.ClientsServiceClient, channels grpcChannelsV1.ChannelsServiceClient) session.Handler {
+// NewHandler creates new Handler entity. A zero maxPayloadSize disables the
+// payload size limit.
+func NewHandler(pubsub messaging.PubSub, logger *slog.Logger, authn smqauthn.Authentication, clients grpcClientsV1.ClientsServiceClient, channels grpcChannelsV1.ChannelsServiceClient, maxPayloadSize uint64) session.Handler {
 	return &handler{
-		logger:   logger,
-		pubsub:   pubsub,
-		authn:    authn,
-		clients:  clients,
-		channels: channels,
+		logger:     logger,
+		pubsub:     pubsub,
+		authn:      authn,
+		clients:    clients,
+		channels:   channels,
+		topics:     smqtopics.NewCache(),
+		limiter:    ratelimit.New(anonRateLimit, anonRateBurst),
+		maxPayload: maxPayloadSize,
 	}
 }
 
@@ -84,6 +101,9 @@ func (h *handler) AuthPublish(ctx context.Context, topic *string, payload *[]byt
 	if topic == nil {
 		return errMissingTopicPub
 	}
+	if h.maxPayload > 0 && payload != nil && uint64(len(*payload)) > h.maxPayload {
+		return errPayloadTooLarge
+	}
 	s, ok := session.FromContext(ctx)
 	if !ok {
 		return errClientNotInitialized
@@ -197,6 +217,17 @@ func (h *handler) Publish(ctx context.Context, topic *string, payload *[]byte) e
 		return svcerr.ErrAuthorization
 	}
 
+	if patterns := res.GetSubtopicPatterns(); len(patterns) > 0 {
+		key := fmt.Sprintf("%s:%s:%d", string(s.Password), chanID, connections.Publish)
+		matcher, err := h.topics.Matcher(key, patterns)
+		if err != nil {
+			return errors.Wrap(svcerr.ErrAuthorization, err)
+		}
+		if !matcher.Match(subtopic) {
+			return svcerr.ErrAuthorization
+		}
+	}
+
 	msg := messaging.Message{
 		Protocol: protocol,
 		Channel:  chanID,
@@ -245,6 +276,11 @@ func (h *handler) Disconnect(ctx context.Context) error {
 func (h *handler) authAccess(ctx context.Context, token, topic string, msgType connections.ConnType) error {
 	var clientID, clientType string
 	switch {
+	case token == "":
+		// Anonymous access is allowed only for subscribing to public channels.
+		if msgType != connections.Subscribe {
+			return svcerr.ErrAuthorization
+		}
 	case strings.HasPrefix(token, "Client"):
 		clientKey := extractClientSecret(token)
 		authnRes, err := h.clients.Authenticate(ctx, &grpcClientsV1.AuthnReq{ClientSecret: clientKey})
@@ -277,6 +313,19 @@ func (h *handler) authAccess(ctx context.Context, token, topic string, msgType c
 	}
 
 	chanID := channelParts[1]
+	subtopic := ""
+	if len(channelParts) > 2 {
+		subtopic = channelParts[2]
+	}
+
+	subtopic, err := parseSubtopic(subtopic)
+	if err != nil {
+		return errors.Wrap(errFailedParseSubtopic, err)
+	}
+
+	if clientType == "" && !h.limiter.Allow(chanID) {
+		return errors.Wrap(svcerr.ErrAuthorization, errRateLimitExceeded)
+	}
 
 	ar := &grpcChannelsV1.AuthzReq{
 		Type:       uint32(msgType),
@@ -292,6 +341,17 @@ func (h *handler) authAccess(ctx context.Context, token, topic string, msgType c
 		return errors.Wrap(svcerr.ErrAuthorization, err)
 	}
 
+	if patterns := res.GetSubtopicPatterns(); len(patterns) > 0 {
+		key := fmt.Sprintf("%s:%s:%d", token, chanID, msgType)
+		matcher, err := h.topics.Matcher(key, patterns)
+		if err != nil {
+			return errors.Wrap(svcerr.ErrAuthorization, err)
+		}
+		if !matcher.Match(subtopic) {
+			return svcerr.ErrAuthorization
+		}
+	}
+
 	return nil
 }
 
@@ -16,6 +16,7 @@ const (
 	publishOP     = "publish_op"
 	subscribeOP   = "subscribe_op"
 	unsubscribeOP = "unsubscribe_op"
+	disconnectOP  = "disconnect_client_op"
 )
 
 type tracingMiddleware struct {
@@ -38,3 +39,11 @@ func (tm *tracingMiddleware) Subscribe(ctx context.Context, clientKey, chanID, s
 
 	return tm.svc.Subscribe(ctx, clientKey, chanID, subtopic, client)
 }
+
+// DisconnectClient traces the "DisconnectClient" operation of the wrapped ws.Service.
+func (tm *tracingMiddleware) DisconnectClient(ctx context.Context, clientID string) error {
+	ctx, span := tm.tracer.Start(ctx, disconnectOP)
+	defer span.End()
+
+	return tm.svc.DisconnectClient(ctx, clientID)
+}